@@ -19,142 +19,164 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9e3PcNvLgV8Fxf1W2s/OQ5CSVqOpXWUV+RBfL0umR1K5Ht8aQPTOISIAGwJEnKVXd",
-	"d7hveJ/kCi8SJMEZzljWbm2c/GEN8Wo0Go1Gv/BHFLMsZxSoFNHhH5GIF5Bh/efRVLC0kHCO5UL9TkDE",
-	"nOSSMBodRheQcxCqGcIUYVsXzUgKKMdyMYoGUc5ZDlwS0P3lwX6uFlC1VlWQZAibfhhFcgFIrISEbITe",
-	"MglILrBEmK4QfCRCEjo3Ve9ImqIpILYEfseJlEAVBPARZ3kK0WE0XmI+Ttl8jPN8lLJ5NIjkKlclQnJC",
-	"59H9ffmFTX+DWEb3g+goz6/0txDYqjZiMw0jzvOUxFiV6nFpkUWH7wxyBUSD6EOBkxRkdNMcdxB9HKrq",
-	"wyXmFGcKV+/cuMdlc/vhf7leDGxuyGNGJVCpwMRpejaLDt/9Ef0Xh1l0GP1lXK3w2C7v+BVJwTW6H6yv",
-	"ewEplmRp6EBV5vChIBwSBahe1JsW5hrwvaTLXzA3VFCjCagKcJIQVRen57UqjVUaNBbiJV0SzmgGVKIl",
-	"5gRPU0C3sBoucVooiiJcDBChCi5IUFKobhAvqCQZjJBax1tYIUwTZFoAjhcoK4RU5DQFeQdA0b6ucPDN",
-	"cxQvMMexBC5GUWvaHSTk0HDO2ZIkwC9ziPuvVQCPahXqiMQVoW7oS1e7H0SK1jq2YzUgUrVKbOz/v//z",
-	"f+s4QCmj8wESEnOJ7ohcIIxSkBI4YhzRIpsCH2jcxYxKTCiiDN0tiASR4xhGvXbhHxGj0ANRJxmeQxe6",
-	"N1H5CU0J7W59c3+zfm0vJZaFCDMLU6ZYBUaC0Hlax7FlcwksiUGJ4x7nHHJsmcSlQrH586Kg1Pz1knPG",
-	"o0F0TW8pu6PRIFIcIwUJSX9GU5+BP2ar0AOiVVZB1SpyYLYKKrhbRd5E6oj+haWFodxq+9TR/QJmhIJA",
-	"WFNvgpa6BSoEJGi60sdVnVvXt1J4Y1xT8qEAsx8sz/f7VbRPaOgoaNO3zz/1YDefSPMGJS2CDeGtyYLq",
-	"UzczEu3ZvyFCavr1yNZWVnMkEjLRg/c01rDa65hzvNrIP00zQx/rd9mDLPnb1loH1lMt5ww40BhCQpIt",
-	"UkKN2eN5ylaQoLPjk6HCUUowlYioVVQcU22vGY4lmuL4Vh1Ua8cO0ZIPzwaWJS6LLMN81ZN1pamPRNHN",
-	"tn4CnMrFKhpEL2DOcQJJgFVtzZ7q0FZjdFbxBu+sE+BM9QoluPeD6FgRzExVg0syV8zuAj4UIGQbbZ1V",
-	"EffkZsTtx5laeiTInEKC4qotmnGWaSwfH7WpFufkF+BCj9gE4Oj8xJahxLJDTUrmGyTI7EpD3kRUYNkj",
-	"aab2jKGaEboErhoisWBFqo/yJXA1lZjNKfm97E04Mk+xVNMiVAKnODWSlZEDMrxCHFS/qKBeD7qKGKFT",
-	"xgEROmOHaCFlLg7H4zmRo9vvxIgwxVayghK5Git5gpNpIRkX4wSWkI4FmQ8xj5V0EcuCwxjnZKiBpXph",
-	"R1nyFw6CFTwGEdzKt4QmbVz+TGiCiFovU9MKiiXK3D69eHl5hdwABq0Gg96iV8hUiCB0BtzULFcaaJIz",
-	"QqX+EadECbaimGZECkcvCs8jdIwpZVouK/IES0hG6ISiY5xBeowFfHZUKuyJoUJZGJkZSJxgiTedC2ca",
-	"R6cgsWol7MG0rkXn7rKSXiTKI2K3bkzzJn/19pslFW+SFvIQy10PbovcfuU4z0GdBaygCcLqFOPDmINa",
-	"Y3R8eTFAGUsghUSx4NtiCpyCBIEI02uLczLyeIgYLfdHa0Focxb4mBNuhDGIGU1E6GjT7c2VqmQaS5yS",
-	"hMiV5miagKuB1TAzxjMso8OIUPn8oKIaxSnmwBW24KPkeN2FsBQ2WhRXFyZaN0XVMcLS0DoId7Qr9Bq1",
-	"gsOxZrgKzznLi1R/mq7016PzEyT0Bla41/XVzBVjI1lWSHX7DNwLDR0FTwp14ZpiAd9+PQQaswQSdP7y",
-	"tPr75+PLv+zvKXBG6BTLeGE5uaK2UXl+EEgTRCjCPj2sO4QMk6otyXQlIbSP9bHE3wZFpBOaGCLTMPGS",
-	"Jkwbw/E15/xQ4JTMCCRagg7yi4IEeO/1yYtHWCcPCIHnIQH4Wn/XWFfT0IcBaJH4FlbItPLmb68CRIii",
-	"fqLXpOWNBKymvFk2fQTENDiho+YacWzH+jqE+IqgcJ5ztsTpOAFKcDqeYZIWHJAoJdJylp5+QXTgHZFZ",
-	"pTUUbY7nVQ3vUdtlW0YbVIhDTIn6Jc577S7FXjWbCyDjuCwzkrdaWebvtBH6WUmnKPYqckBHGnWQDNAL",
-	"oET9qzD0CpPUANXrulYOHrym+dTgTSFIA2VH3ROsli8BiUkq9AHCKCCstpx0yx0XnGuBSKo1dcKrIuoL",
-	"j6XVlzbFQl5xTIUe6Yp0qb1UPSRJBmakEjRZtoXEiGkKLkuGkiFMmVwAr622kseGqq+wYCQUv2hD8VOR",
-	"YYo44ERTk62HiNkTSsx02MFTVkgLcQlekKGxqd7uyWugYM7p8OxHTpIZzcuahqnUsXGHheZ86sxKUJGb",
-	"Yf1z/duvg+c6ByyCNxX0dMoJzJ4hU6MSHdyYT0SvmfYU+lyvTshzPfVsZtSnjR2geyghGIRIrkRAtf5r",
-	"N8tmLUcNRwNNlGyGrri6ab3CqYABsrdX/3KuyqNBpCtsfR1vQGf7anx1XTc+127SNWy26dEaViqqI/7F",
-	"xpuN43TRILo6P/0FuJYxzIXeFRgeqOesGGC7ahyDEGSaQvOH4ynnmAtd9XJFY/3HL0rOVTVYmrJCntBz",
-	"zuYchFr8a3Ubs0rbHGJX9bRIJclTOLujwIWGa0lieAHqIkaEulaoRv0W4iXlLE0zoNKep958W2X16XYe",
-	"yV4XnXVKXHbWKJHcWaMOzgXkTBDJ+CqIeoXxzoLW+viF5Vq9SgGkWwX9I7RqZjW8tTMf/BU0X/quoyHz",
-	"GZk3Va79FLuviQw032TF+LmU/i8h5iB3MIHsMOpPUuahZhoHeeFW5ZRRtdBt61f9vM5Mtc1210rVwpBt",
-	"tFl09XsP6t3Xm0LbMzGz5Iy+/JgrWgoLOpxRBGUFZM5LfdSpvpMi1UokkoEYTaiapK1BBHr/FbL/vz9E",
-	"Q3RKqLq9HqL3X71Hmb0R7g2/+X6EhugnVvBW0cFzVfQCrxTSThmVi3qN/eHzfVUjWLR/4DX+FeC22fu3",
-	"owm9LPKccXXNUAuJJVNADFXFw/LSqqRvozh7CqP5aKC7IRQtFMhlf7AEvtLfnqlx3w/fH6ILTOdVq73h",
-	"d+814vYP0NGpWvvv0NGpqT14f4i0qcJV3h/sH9jaQmopeP9ALlCmcWjajN8foksJeQXW2LUxwDRbXBoL",
-	"Xn0u31UoUefyd16TCX1pvBAU5tDe8LvB/rfDg+d2SYOizHEhJMsMYzmhM7ZOHdKUprS2yOh8ExTrjpDd",
-	"YHYBgkM2r7teJ4QaYtQXRS141vX+LSHGAN4Gznyvq8DzxUqQGKdef1+03F+03F+03ONKAOl/u7FtdtBf",
-	"33Tu45Zpvm03DuuoGtdZ33S+3kau70rJKnz6G6cSez2QmCgpDN0tSLzQug/dEvUyxathtOdKgI++LUdx",
-	"dZC7SZcX1HDv3pW335qFnUjuB93W+OoOaKuUhm69yRpw7Wacb16PO3Q/pc1ZrZeH0HLyveiqbnMNnWrC",
-	"VHD0s9Dm34ZHQsAkXSdTYo/StWTqn3ZG3eI4n1ZC+B4QD6KQWG+Qb+J7I1aNHN6FyGNPf1ZpEQy+1H6a",
-	"kXkbbRxoAhySzmP4wlZwB29nv5u0yvVx1k5SsLRTwrDFvqBhlSX6c8wohdjqFcrFbs9bGGH95EWYEdli",
-	"dPLCV1k1RggThml56h0dDXovZb1yFMeoHWtTcFvzw3/XXB1jTPVpKYy2mFAiCU7J70atWfqsAs8Ixemg",
-	"hFky12yAQMZdy4WTM5quokPJC2iQZmNWAw+B3Uvp35vbiHCdWbkTO5JK6rftUh/eWkOJ+Rxkv2PTB+VK",
-	"twsr+0yX/abk9dNm46UxyWwWoUZoTS0DuWBJfUv5KrBrClrhoxVcsWR8dQGit6PxOoi9ntdVq49aYuFE",
-	"nYOcyNXxAuLbLobUXbe5e+ssi7gWKFZNUA5c7QhjE9/xDBgGz4DqxtMc00D0Cay/e/K78f7OnjZokbdA",
-	"ZkV1znPzmgp3+/d1rKWKbxs6DE2gGmldHR+G7noldN1VKrjbaO3UyVvhpItEW3s3b9zg9SiJunXI1e5E",
-	"owhhaxGnIm8t3lRAbxBuVO0SV+3zkWRKEsxyN/dG50vdspJR+xnOdtpV1nPZLJETrWWefQqed96YbWB6",
-	"b83OA8BTppf0Hd6eO23FxrbomFLXztqwh9vbt9p2b8gM4lWcwk7CbOpaP8A1oKn0qjp/qDOgMdfd2H+o",
-	"ky7y8mOXQhhr83ljVrJrXLd11L9sSWgNqJuk0iiuQREoD4G2oVqN6M5E2CnPL0WmaGoFNyMPorPL8hrQ",
-	"KXtkQbP/Va0T4wtulCUcXV+82XxxMv12E8aZ2GkLnV32nsIv9Yufm0ZwX+iSF2Te6Q6X6LJmX0bVjsQC",
-	"H3zz7SHeG41Gz/qipj5oN6JK++FW6Cq1rZsO+jgv+rGDOhzu0EqIuP2U9hlkjK9276Hp9pMXUdmpha4v",
-	"atcb90TNumeQbXTZ7RCDXzG3G/2YE0linO4cbBAC1I9laJdWg4dKPYBCxQ7IUJnvLOHpgTvYUoMp4TW2",
-	"lErX1C/IJ7cG3Z3CfBpG5JZ7o1EMdQNiyneAIWjDDg0vWBpSsGp3MIeNWJJlpdqxOo3+sNQ1VkEv5fqR",
-	"tbWuQrt59YTDnm9G9224VkD9pECr7UFrNbcrYh2+++OgYTcPYcEEeScdqjZTqD1XiWK0dYt/w38Ay3hx",
-	"jqUEToO6ZLeyuiLKbc3aZFrqZ2PzdXAUlEh9RA9M0Cvj+l8lJYpiNiMfB8hE9CwgTYdCrlJA85RN3WAa",
-	"fj06nmNChXSOjekKpQwnYIbQMGX44xugc7mIDg+++XYQ2S6iw+h/v9sbfo+Hvx8N/3E4mQz/OZro/95N",
-	"Jjf/YzIZTiZfTSY/3Pz16d/61Xv2w9PJZPTOVAwV/1foNN8cwGfsducsJXHPw+faa2HI9b7zXFmvWGqr",
-	"ksJyvfBiBy3zRLZthvVdmKTG5hPLAqeV/+mn8loravkst7pSbMFf2ka6wB7DbVPD1r03TDX9PZjLNdB4",
-	"NMY0Z7ZReAy69/ro/VSvZf+86cWwKzuKVqzYK+tO6genMbkEoH28jy1ZGGdboM573/I/9PTt2dXLQ2Pt",
-	"K307iECUScRBFpzWPP6f9VSxKKlozoa/CUaHZE4ZB6PnV8C7+9NO99ktT6iyTe2M2lZo1afKNlTeomzD",
-	"7p0DTo8Oqvol30u2YXlJh4LJ22I1qOpbOgrvcB+NPh2X+0GvTQVvhTV/2bsl+91ttx6lLzBP7jAH7T1j",
-	"nMgIndsDF9X8WR7epmthcC79D2HVDaBmN6XOVjHaYQXhmfbrDIdjX8CUMevxes7ugENyNpvVNIhHd5hI",
-	"7b5rzZrGt3uWklie40Jsqe+pTcgDrVXmQRsord+eakX+nALFtWkGypsaqFphCBmBak38VMtZYyn9fPrO",
-	"cmcLNrvBC2GEjzkTFa/Hc6ByNKEvcbzQgWkx4xxEzmhiQlUqAd5sC+u5FuMcT0lK5Go0oZu9A80karsq",
-	"ZmlqMuyULl6dgpECstOXQJ2FR3OdzcdUCW5C32urow+vhpI9jHuqxVPLd7HqWZFOyOL/I2MSnbzYpivj",
-	"fNnn+Gj5e6rz0jFBg+3wLM9KTnnpOGVP8Jq+YT5CSyy0oRjUl6+bb7Vk+A3m71zX1CrUDFM8N9FOmi+b",
-	"g0lncYrTIlEldwug7rtz2JwCStgdtfcndY7YoLmAxc3WuzS+1xuFGjOZsnZ5uO/a/n4D2pKd9LUGpge1",
-	"d/jHo+n+IY/H2mR3Ox7bXWxh8agQVpo78iv2AutIzbNCns3s315YyC4qxRqQ3hCBUn/UYONGfEq91Nca",
-	"EnG7MfBi61iHwb9ZsEaQo9h7tGYlpgPNTIi4NYHa26QmTAgH7d5S5iZ0qjDVfb3P9XNZkynvReFHZs5w",
-	"kap72p4SytsQZfgjyYqsSruA05Td+W6pxrVNMhTbHFomzV3ZoOKXLpw9QVj74jO1sZfW6gxqjrbv6Urd",
-	"0NXttKBEjlAV8FF+1KHHh+i9MLETwiSOGKD3mflgwiHUh4X5oAM/9FpUGq2nPxy+2x9+fzOZJF89+2Ey",
-	"Sd6JbHETVDy9pDFTZ0Ef3yqwdQ01atc4vXxY4kZMgM8M8hQTJQ2Z9Ay9I/PMUOe2sfv9o+3kPhCg1wa/",
-	"VWVN3iAbJ68W3PhrrVVKfQmm+BJM8ScMpmhtqO3iKtrNHzZFUEc8L057sAZXtcqhEJblSkbh6VUtx9DZ",
-	"Uzt9aLELDF6TreNuAXIB3E9OgRZYoCkARa4Db82njKWAqdGLTiH9lBSwRy4Vi+lJX3TzPF1VSfc6ItVa",
-	"i2fnudUKVaJ6P7mqe6nbAs2GQTetuGfV+NS1P+rw1dDHP5Y2AMdf/Tssagvfz8XPtfixK/qnHkSk6vaQ",
-	"I71eB/6UAuLYYMsl2MG0FEB8uUCjIK2FL9DBaubU8SqakVt1nwjnc6SNLQFnFcHDSxBKT+Vn2hEm2t+n",
-	"qcAGrtvu+se0DSJ9x7nYFJNypUlxbVyKPj+t2/3oN8Eoesqsqu1Zh8/rQ3Mql1/FGXZ0GnOPeRFRmoIW",
-	"QJE60D3iISLEWju4m1rPXoytS/fQUXG7HdDqpIvlmKNvJ0bqmSY3ZXXyabmd2mm0dcKmdnoiCE/5cVMw",
-	"+eneg3iITaFZoxkJec3G69rrqxKS8FGip9dXr4bfPUOMN/PaeYPoCCaSdmJY1XM3p8104F0Eg6o1Nf3u",
-	"YCtVWoZXtec956zIw7NWM3gikK4x8C7TQLQshF0KapsLHTiJ0cmLEXph7vhaUplEnDE5icLyMEtg7dA5",
-	"cOsEpHNCjtDfWaGvCQYYowPOlFA/wxlJCeaIxRKnVa52rO/FvwNnLpfB3rdff62XD5uTJCaZbWBCsEJt",
-	"vj7Ye6buKbIgyViAnKt/JIlvV2hqVQOo9PEeoZOZNlWXGBsYFXB9Mvp2q+apeGCFMAVeONy2EMDXYovd",
-	"6aSED75QXTS3ncJtmzcZahS9qXLtIY/gAw7lnutQUIWT0bTCwOdEXigwwmexlwobo9dE1p3JbFbCbXRz",
-	"TiNnIy9nZO6CI6ukQh1h3654s5BZdVXLmtn2g9LC1AUsyTp5xJQqoAvhZXdeC28rSrYEvjXqoEvLuO65",
-	"B3+2DY/L3jnH7cqHBu5IDtQinoWUeU/qoeinq6vznvSj9n74CRv11VGMkbKeCPOajDW0Subda9zp1fSv",
-	"0aAIWAL3FKveEzSfRH28TX2OeLANi1zRGK2hS+OPGJo8L0/i64s3Nn8oy0AgPJP2Jq8OcB2CgE6kDiw2",
-	"FjhAHwrQKnGOM9DvgIgiXiAsDtEkGisaHEs2dmqpH3Tt/9a1u/hnJ4WXy/f4RO0oMjTy2rdGdnnaJfSG",
-	"Q8va0hF90XpmoMyMYUMnAhkrUI7j216Gi67okk60nBdpWrl7VgaNk9lbJs/NXaFl2nBODvVD94nf5skI",
-	"/apuQeoOpcqO0ju8Ek+M8GAmSgTKizRVR7vO3WQe+am1eqtKao308zY4NZlDdILY7rhXM2Y0aE5G99rT",
-	"RKDwU/ajfjT6Up9sfw6lfR7vKImj53shl5ppbBFM1G4b8Lr1I6MswzKWgQ0vXgQO/hoZbZyUR3VbPMqx",
-	"GTCTVpvDnAjJVyN0pS7lSsSegvGnqe001dDkgy0tn2fHJ2VnA8W2U0bn6l8rAjGelVYQVdd0JHxbZh+e",
-	"ue7Rj/WPG30+dmW8u9a44PsMyR6wuwSaVGLz+muyBagnL+tKh3i4/TzNNUYym6G7zV96zbi8UwR8mz/v",
-	"UdiJuEG0NuVkz0xR24M5iIQere99o4ISmYaBuBFWULmjpFjTgJsBPGlQ91zT0VXT6IeQCuZgB/oxte5e",
-	"dPHGrsIrX3U/8DB0s0nbaFtXixQinVMdO/gnSA/q2Svb3p1lmZJeyjQ/5p3LNEU5cEH0owJVnKmWWBZ4",
-	"CQNLdlYuF7qFgVYnBeO2rmE7AV0rpUxWMSo7qrWryiY1eC1YIZgh0j08UOaJWGNdMuEi2ih+h4V746C/",
-	"SSmBFHYZy74oqptvM958Tab1IyTgQ6HZks1jV3MJwOWxj7ws7KXVwiQIMvYadN582sE9k3oBOBkymq56",
-	"Jmb/ZKvGKdZpPqynwy2sRPWcprVxNJJSMT7HlPxuvP1jLGHOuPr5VMQsN18FpBDLZ46Yg1TUj3dal5Rg",
-	"cvw7GlIZHvkuGVgidqffldA+L+b7QJ0GE23hH6uxJpFN2NuVZlC36na9oYjl+EMBDol6WKIzwpROTEbB",
-	"8UR4PjKVWatyvemnnjzHMl547kel/BGmgZnOJ99gHqxjJ1mfYaPnsrmE/DsUThIdlJKn5ljhkLGl3kr1",
-	"6PwKgWEd4BH6n5dnb9E50wgpXx1utdaEGAbVuOUoCSFJlOxugRq1zgWWr1OuNYWx2pO5a59ORtxW3f7l",
-	"5KNA2wd9MlnweExoAh9Hv4l+VOUOwKMUuLywjtF5d2hDe0qLeiKuhh1b6zBU32GjctHFdp2DpTphpeP1",
-	"at6e1IaXwNXFqxBWkVDm+5zCjHE7MKHzEXqlt/rhen/JJ+JJ3RHySfak7gj5ZPGk0xFyMkn+2u37mAOP",
-	"gcrObCJVucKamZGxNXMynyupIIRJcyIZ2XoJfWIda+t9aRuFHbldj94y1eZRP1RuNhFXbbC296ctbdGM",
-	"Y0HBrBE68qKfCqcTlqrjzireiJ11DCjepF3kvJoqUVPNCMX2Q4bz3NpJj8+vO43J4UT8xlO80/eiw4vc",
-	"ye5d7bol+/uSua3Mk2I1kVudyb2eR+iYzSYJfR1cG7xQOjBxf7PmAtFewLWhMGHHdVwzPDXkd8do12Vx",
-	"0JUQV7VG6IymK/Owkv6aA0dub2p/EsPAts7sUHH8UG4HdaIQOj9R53TQqbFk0O7Bdedlr5squB+B55ae",
-	"512Md81dceAvRWDGIYa24YkfYsQDWXBq5RQFeIxT5ziUMPrE2aOQ0Tl60vIXx+/P6/gdB30zLov53DwB",
-	"qO2CdnFi586g8Wf8oQZoDxHrB2H0MT0ex/zibf6g3uYd77D1EUP9CDyFR3fn6Upn3/H2WYbjBaHQOdTd",
-	"YtUYQC20VUVOdMLHgqvrp80agk4sQIYEiECQ5VIrCDiYFBF1L70lJql+9BEdoQvzAFucYm4fubSWduHc",
-	"jRNA00JxHjDJJpQMzUkCiMgN0YDrQtYr5KEz/YrZIZpEl4V+9mkSqXuZN9PPTjYih3iIaTLszO3Yw+m/",
-	"fApOs4mej79dkQz+wZwlwZlN3zAjLLYzrKLf1UFeqgm4sIehRurJ0dsj94rQ0cXLo/Gbs+Ojq5OztwN0",
-	"p4lBfayHFSmEEapd9DhiMWBq+LBrWXv+NMdckrhIMUeCSNAOYsQ+GYg54IF5QsfcJtGRdqrC47dw98+/",
-	"M347QC8LdUCNzzEnTiwpKM6mZF6wQqDnw3iBOY61K4Kba8ORDD2dRK9PrybRAE2i66vjSRT2271uBes2",
-	"rZbVqWefYzLsGheSqY0Sl5HFWiCjSSgmWSqZfG4TWNiIfQU5K0JOrhtzkjeelDKbicvXHMfgBwyuFVpd",
-	"PSWUecS1rk1JhC0yD3mH3Xs5h7UtNNYTgwyTNDqMJODsb7OUzBcylumIsMhp7fQ99ZUuQcdqo7IUXQHO",
-	"okFUcNXUbe1a65bu8V29i5unoWbP7OFonax16B8oLme0JzreHDLrmjpLAaRmTZDMnbnHaDTlAghHd4zf",
-	"KlIQJldCSmKgAirjVnSU43gB6GC015rM3d3dCOviEePzsW0rxm9Ojl++vXw5PBjtjRYyS82CSa2GaSDp",
-	"6PwkGkRLJ8xFy32c5gu8b3MGUJyT6DB6Ptob7VuVlSY4xenGy/2x/zK4cdR3Z7bW1bBQNOKxMVrini9K",
-	"l+z8JCkbd7aMyrexf2TmyRfP0dgzD49/syeoodOd33Y3JFvRtc1vZLJkCLM5D/b2HwuQEKITtZRf7+09",
-	"GAxlsFprwB9xgkp41KD7jzDoNcWFXGiNv53q80cY9RXjU5IkQM2Q3z/CkPVcMHrcg8cY94oxdIrpyi2t",
-	"joX45lGwfGl47DUtBUujKcZzrTfr5D7Rjaq2mUmN/1BM9l57G4MM6dZxUr6Pb5y8O3dgm1e9BrmOUVX+",
-	"jlo/td7stJlXqhvw3Fz9iOrBumLbU6R8WcnnVANvgZpqyoKSDwWcGK2NZmtGN1VjbHv/GsZ29vOfjL18",
-	"/QhDvmXyFSto8oWx9GYsVpqzXGTsAgQ72clrkNYP20YSJu4Jyy5x5zVIF5ton8Hckm/YRzINb6gPLppq",
-	"4odhHfftwOMyI6UOtkSNl7nKYbVjdzVuMDJz3bifkz9Z7HcyowOzR5tbCnm+hP8qfvVIzANV3ONRxKF/",
-	"C0HI4xlmL69lEJX+KscyDnsGOq8/L8r1xSYuoZvVApt34xK+KKEhfCiOcLPNtWyoh/7rdqtW80DpdSl7",
-	"PN7w5fL1HyEdoT+deIS65KOS1w2ivAgIOtc2ud+2jOzCOEw9MCurEvM9Oi/bjYl8YV1/EkHp31RsqZKC",
-	"9NfmUhTKMrdejdtOXfd59lZ7nEdW23YA8EVd+x+srv0zKmo7BYYWR9nEcDZpZl+D3JbnvAYZYjhbSRfd",
-	"4z2o+vXz6jJ6caMvOtYvt4h/BVPQ/uLaZUxvR2PwHpuYWtOu/aCB3eUCMdqU/7XDjd2EVtRp6yfrPXTv",
-	"cb+zNvD3N/f/PwAA//9FlpiQAKsAAA==",
+	"H4sIAAAAAAAC/+y9e3PbtrYo/lXw4z4zSbopyXYf0+2ZMz2u86h/jWMfP9o5J/ZtIHJJwjYJMAAoR+14",
+	"5n6H+w3vJ7mDFwmSoEQpTrrnNO0fsYjXwsLCwsJ64Y8oYXnBKFAposM/IpEsIMf6z6OpYFkp4RzLhfqd",
+	"gkg4KSRhNDqMLqDgIFQzhCnCti6akQxQgeViHMVRwVkBXBLQ/RXBfq4WULdWVZBkCJt+GEVyAUishIR8",
+	"jN4wCUgusESYrhB8IEISOjdV70mWoSkgtgR+z4mUQBUE8AHnRQbRYTRZYj7J2HyCi2KcsXkUR3JVqBIh",
+	"OaHz6OGh+sKm/4RERg9xdFQUV/pbCGxVG7GZhhEXRUYSrEr1uLTMo8O3BrkCojh6X+I0AxndtseNow8j",
+	"VX20xJziXOHqrRv3uGpuP/yn68XA5oY8ZlQClQpMnGVns+jw7R/Rv3GYRYfR3yb1Ck/s8k5ekgxco4d4",
+	"fd0LyLAkS0MHqjKH9yXhkCpA9aLedjDXgu8FXf6CuaGCBk1AXYDTlKi6ODtvVGmtUtxaiBd0STijOVCJ",
+	"lpgTPM0A3cFqtMRZqSiKcBEjQhVckKK0VN0gXlJJchgjtY53sEKYpsi0AJwsUF4KqchpCvIegKJ9XeHg",
+	"269RssAcJxK4GEedafeQkEPDOWdLkgK/LCAZvlYBPD7EbUTimlA39KWrPcSRorWe7VgPiFStChv7//d/",
+	"/58mDlDG6DxGQmIu0T2RC4RRBlICR4wjWuZT4LHGXcKoxIQiytD9gkgQBU5gPGgX/hExCgMQdZLjOfSh",
+	"exOVn9CM0P7Wtw+369f2UmJZijCzMGWKVWAkCJ1nTRxbNpfCkhiUOO5xzqHAlklcKhSbPy9KSs1fLzhn",
+	"PIqja3pH2T2N4khxjAwkpMMZTXMG/pidQg+ITlkNVafIgdkpqOHuFHkTaSL6F5aVOTS3TxPdz2FGKAiE",
+	"NfWmaKlboFJAiqYrfVw1uXVzK4U3xjUl70sw+8HyfL9fRfuEho6CLn37/FMPdvuRNG9Q0iHYEN7aLKg5",
+	"dTMj0Z39ayKkpt+6Pzt9zQaJhFwM4D2tNaz3OuYcrzbyT9PM0Mf6XfYoS/6ms9aB9VTLOQMONIGQkGSL",
+	"kGR2jxcZW0GKzo5PRgpHGcFUIqJWETGO1Paa4USiKU7u1EG1duwQLfnwbGBZ4rLMc8xXA1lXlvlIFP1s",
+	"6yfAmVysojh6DnOOU0gDrGpr9tSEth6jt4o3eG+dAGdqVqjAfYijHzOc3LFSngMnLO3i6ggVukTTDMnB",
+	"CRv3C5IsUFmkWIJAmAOiTBpcQhqjGePIyqkIowXLSIpXaMYBfocuqTaG7EKwKHNMEQecakHIK3aUPLWz",
+	"sMAGSRpo2iPyqmlhaacU6A4BTTVLmDGeYxkdRmrWI9UuNJAWHHYdSjcePFhrs5iRzVRD++RYoXymaAIu",
+	"yVydbBfwvgQRgLa3KuLeJQlx+1GtN0aCzCmkKKnbohlnuZ7o8VF33XFBfgEuwst+fmLLUGrPPs03zDdI",
+	"kWHBhgKIqMHCjjAwRWbqY3QJXDVEYsHKTMttS+BqKgmbU/J71ZtwPC1TZC0RoRI4xZkRo43Ql+MV4qD6",
+	"RSX1etBVxBidMg6I0Bk7RAspC3E4mcyJHN99L8aEqTMkLymRq0nCqORkWkrGxSSFJWQTQeYjzJMFkZDI",
+	"ksMEF2SkgaV6F4/z9G8cBCt5AiJI5HckROU/E5oiotbL1DSw1ihzTPnixeUVcgMYtBoM1lVFjUyFCEJn",
+	"wE3NaqWBpgUjVOofSUaASiTKaU6kcPSi8DxGx5hSpoVww0fSMTqh6BjnkB1jAZ8clQp7YqRQFkZmDhKn",
+	"WOJNQsCZxtEpSKxaCSuFrGvRu7usWB+JSh7YrRvTvM0fvP1mScWbpIV8K77hJK4muf3KcVGAOvhZSVOE",
+	"USmAjxIOao3R8eVFjHKWQgYpYhTdlVPgFCQIRJheW1yQscdDxHi5P14LQpezwIeCcCN5Q8JoKkJyjG5v",
+	"7s8V01jijKRErjRH0wRcD9zgyoTKrw9qqiFUwhy4Pmg+SI7X3f4rybJDcU3JsaMWUB0jLA2tg3Cnn0Kv",
+	"0SE5HGuGq/BcsKLM9KfpSn89Oj9BQm9ghXtdX81cMTaS56VUJ2xACWDoCETPuTbFAr77ZgQ0YSmk6PzF",
+	"af33z8eXf9vfU+CM0SmWycJyckVt4+r8IJCliFCEfXpYdwgZJtVYkulKhg9kdSzxN0F5+ISmhsg0TLyi",
+	"CdPGcHzNOd+XOCMzAqm+LgX5RUkCvPf65PlnWCcPCIHnodvOtf6usa6moQ8D0PcfpSoyrbz523sfEaJs",
+	"nuiNq9FGAlZT3nwR+QyIaXFCR80N4tiO9fXc2GqCwkXB2RJnkxQowdlkhklWckCiun5Us/SUSaIH74jM",
+	"ahWx6HI8r2p4j9ouuzJaXCMOMZpAjfNBu0uxV83mAsg4rsrc1cAJWHYBxuhndRVBiVeRAzrSqFMXiedA",
+	"9YVCYeglJhmkDQJcezq6PoN3cp8avCkEaaDqqH+C9fKlIDHJhD5AGAWE1ZaTbrmTknMtEEm1pk54VUR9",
+	"4bG05tJmWMgrjqnQI12RPh2nqmeuGHqkCjRZtYXUiGkKLkuGkiFMmVwAH37DyUEoftGF4qfmTc3WQ8Ts",
+	"CSVmOuzgqbr0GIgr8IIMjU31dk9fAQVzTodnP3aSzHhe1TRMpYmNeyw051NnVorKgtHGxAmV330TPNc5",
+	"YBG+oD6dcgKzZ8jUqEUHN+YTMWimA4U+16sT8lxPA5sZXXlrB+geKgjiEMlVCKjXf+1m2azSauAo1kTJ",
+	"ZuiKq5vWS5wJiJFVVfiaGFUexZGusLXupQWd7av11XXd+uyrTZrY7NKjtaLVVEf8i403G8fpoji6Oj/9",
+	"BbiWMaLYLzA8UM+ZZKGqSQJCkGkG7R+Op5xjLnTVyxVNTJ+czKT+6xcl8aq6LMtYKU+UkWDOQSgyuFb3",
+	"MqurLyBxVU/LTJIig7N7ClxoCJW+7DmoKxkRgjCrNX8OGVmCXo5h6/OCcpZlOVBpj1kPDZ2yJhZ6T2qv",
+	"i946FYp7a1S4763RBOcCCiaIZHwVXBG1EL0FnWXzC6sl9D/Wy/kyA5BuofSP0MKaBfOW13zwF9l8GbzU",
+	"L9TN+rKcVtvAX3uzY2Zk3lbVDzMIvCIy0HyT9evn6iJxCQkHuYPpbIdRf5KyCDXTOChKt2injCri6FpN",
+	"m0d/bqptttfXWhuGbKPNUrDfe9Bes96E3p2JmSVn9MWHgoMIa/ZUOYKqAjJHr/pHa+HSMtP6KKLsMDdU",
+	"TdLWIAK9+wrZ/98dohE6JbSUIA7Ru6/eodxeLvdG3/5jjEboJ1byTtHB16roOV4ppJ0yKhfNGvujr/dV",
+	"jWDR/oHX+FeAu3bv341v6GVZFIxLSJFaSCyZAmKkKh5W918lyBsd3FMYz8ex7oZQtFAgV/3BEvhKf3um",
+	"xn03eneILjCd1632Rt+/04jbP0BHp2rtv0dHp6Z2/O4QaROXq7wf7x/Y2kJqgXr/QC5QrnFo2kzeHaJL",
+	"CUUN1sS1McC0W1way29zLt/XKFFH/Pdekxv6wlgFFObQ3uj7eP+70cHXdkmDUtFxKSTLDds5oTO2TrPS",
+	"Fsy04smoj1OU6I6Q3WB2AYJDtm/OXieEGmLUd04twzbtRR15yADeBc58b2rTi8VKkARnXn9fFOZfFOZf",
+	"FOaTWjwZflGybXZQhd/27uOOS0fX3yCs7mrdjH2Xi/W+Ffrala7Cp79xRrI3DYkJBS6scRFz0MOtEKED",
+	"hzG2x4CqrhrF1UHuUl7ddcO9e7fnYWsWdj56iPu9OOrrpK1SOUjoTdaCazenjvZNu0eNVPkqqPXyEFpN",
+	"fhBdNW31oVNNmAqOfhbabaDlyRJwZWiSKbFH6Voy9U87o7lxnE/rM7zxHke3sd6RI2Du3oBVKdUahHVG",
+	"nSqG4V+dn6L3JTPKOWzR90Sg8+MLETvtqfYKrLH7RCDs9XMHqy6+QTHS12we3shXx6/Q+TE6Nsxf10UZ",
+	"m1f+DYzJ2G5sc3RneGWUqckCkjuj5yISFZylZWIV6HoaqYLcnbVD9LmUBd1+NLtRRRXb8macLHCWgZJM",
+	"K4WbwSGm4h74sIGLhP+iwQwPbqbgRq/nJhyDu/zpaHTw7Xdoiuld7D4ynhrWVTUya4kEZJBsxRbOjy80",
+	"fCE7h+65Z2nPTw9+/O3o6krJCkLyUh+viIMsOTWcSlX57T9VF4PtWVh10jvg1W+XJ6/eHF1dX7x4jCHb",
+	"fE5TiJu0D4+/iIO25bEjnAH7s6prNqohRmvV0P71ZjPGnneN+YIITbIyBU0pRApE4YP0NrpkSGuI2sSr",
+	"ZTQQix7zMoijtW4+Mwncg8XBiyjTHsbAEU4SKNp2lrWa942bUzJ1/iEs7DyU0XKlFe9Y4sY+2H3d69n3",
+	"r7FRgfSdYceeFaTWBdvVSnTTLtI50BQ4pL03oAtbwd15evvdZBtsjrN2koJlvbRri/07nlV5688Jo9Sw",
+	"IP+c7c5bGD3JyfPwuttidPLcNzy0RgifyablqSe1t0SN6ppdjeJkZMdfFdzWiPzvjeiEBFN9URFmdxJK",
+	"JMEZ+d0Yp6owE+A5oTiLK5glc81iBDLpWy6cntFsFR1KZS1oSgWtWcUeAvuX0ldodhHhOrNXfsdqUNpU",
+	"g1ZWzc4aSsznIIfdWHxQrnS7sMnGdDlsSl4/XQm6cgkwm0WoETpTy0EurBto0EH2moLWz2szRSIZX12A",
+	"GBwbtA5ir+d11ZqjVlg4UVcQTuTqWAlKfQypv2579zZZFnEtrBxWAFc7wng27Sh+j4Lid61sao9pIPoI",
+	"qbt/8ruJ3b09bbAFboHMmupcsMU1FU7x6lvKKovMNnQYmkA90ro6Pgz99Sro+qvUcHfR2mtZtffCPhJl",
+	"s7Ukab6fpEAlkavdiUYRwta3y5q89c2yBnrDvVLVrnDVPR9JDkLivHBzb3W+1C1r9cBgB+/td5UNNjJL",
+	"5LQassg/Bs87b8wuMIO3Zu8B4Nk+K/oOb8+dtmJrW/RMqW9nbdjD3e1bb7vXZAbJKslgJ2E2c60fQQPT",
+	"tjfUnT/WGdCa627sP9RJH3n54cYhjHX5vPECsGvc9Teov2xJaC2o26TSKm5AESgPgbahWoPozkTYtdov",
+	"tYEwUyu4GXkQnV1W14Be2SMPOm9dNTrRlayemqPri9ebL06m337COBM7baGzy8FT+KV58XPTCO4LXfKc",
+	"zHudmlNd1u7LWDmRWOCDb787xHvj8fjZUNQ0B+1HVOXZsRW6KkPXpoM+Kcph7KAJhzu0UiLuPqZ9Djnj",
+	"q4/pgYK8Z/yjgCg4S0CIj+lCQq4N/FYRt1s3bU/UoowqDFlUD6WT9U4iouElYijHaIS7IY6/Ym651jEn",
+	"Ulmkdw52DAHqx1J2S+vBQ6UeQKFiB2SozPff8+yJPTy2xWHxGpt8bbMYFmRcWMegncKMW85IHU200XL1",
+	"A2LKd4Ah6AsVGl6wrE+HnzlsJJIsaz2VVdAMh6WpfgsGzjTP360VL6oTNhAOe1gbG6phwQFdmgKtsQet",
+	"95VdERuDNBwHLf+rEBZMkpmesFdbqIMpSAKi5TnW8kNT/jznWErgISo/qlZWV0SFrdmYTLuJzRrh4Cgp",
+	"kVreiI15jXH9rxJ5RTmbkQ8xMkGmC8iykZCrDNA8Y1M3mIZfj47nmFAhna99tkIZU0HTeggNU44/vAY6",
+	"l4vo8ODb7+LIdhEdRv/r7d7oH3j0+9Hovw9vbka/jW/0f29vbm7/v5ub0c3NVzc3P9z+/el/DKv37Ien",
+	"Nzfjt6ZiqPjfQqLJ5gQCxv/jnGUkGXiSXnstDLk+9J4r67VkXb1Y+JIivNwFlnki21Z5wkiOSaYr4kSW",
+	"OKtDIj6W15rWDZZb34+24C9dZ4/AHsNdk/XWvbdM/sODaqo10Hg0ThnO/K/wGIw48dH7sYE0/nkziGHX",
+	"RiGtJbL37510KU79cwlAhwTEWLIw8R9AXUCZ5X/o6ZuzqxeHxmuk8hHU5jrZsJzaILRnA/VFSiqas9E/",
+	"BaMjMqeMgzFaKODdZXCny/mWJ1TVpnFGbSu0qg7ENlTeoWzD7p0j54AO6voV30u3YXlpj7bM22INqJpb",
+	"OgrvcB+NPh1X+0GvTQ1vjTV/2fsl+919gDxKX2Ce3mMO2gvTOCMrS46ZK2r4RT6+b5CFwUWZPYZ3UAA1",
+	"u2motsoRE9Z2nun4gHA6mAtQ/jpm85+ze+CQns1mDXXo0T0mUoeOWButCTeaZSSR51hZP7e6XzUm5IHW",
+	"KfOgDZQ2b0+NIn9OgeLGNAPlbXVaozCEjEC1Nn7q5WywlGG+4WeFM2zrxn5UPXwomKh5PZ4DlcpxHScL",
+	"HSudMM5BFIymJnqyFuDNtrAe0Aku8JRkRK7GN3Szl7mZRGNXJUrFqDP8Va7CvYKRArLXMUKdhUdznU3Q",
+	"VAluQt/7t6cPrwbiYMMcpqsWaJ2eFemE3Bd+ZEwqv4UtujJO/EOOj07cgDovHRM02A7P8sxVQpeOUw4E",
+	"r+1j7CO0wkIXiri5fP18qyPDb7DlF7qm1gfnmOK5CcBVPbnMTbH1jTIpnYC6787xfwooZffU3p/UOWLj",
+	"uLskOG1kkgpsOVswMIMUhznmaQaiTnOhq7oYIbCxBYRKoJgqwY7QlN0PF/Jbqa9CWgQ79Us75KYezfpU",
+	"tZWus4bvVwPeWi8bOwVEaAs96sI0hQr56MitFCJ1aL1ewBCmGNcRRxaPAZypXrTb8mDkdafaRl7ZrLFl",
+	"fw8bNkG6kynBwPSopjhf2LF4f0RhpzHZ3YSdbhdbGONqhFWWuOKKPcfaW/CslGcz+7cXSrqLgrgBpDdE",
+	"oNQfNdi4FdPaLPV1wETcbQzH3DoCMv4XC+EMng9WK6IPBtOBPhqIuDOZYLZJdJ0SDtrzqsp0bbvU3Tf7",
+	"XD+XNXmXn5d+6ocZLjMZHUZ76orVhSjHH0he5nVeJ5xl7N4PVjFel5KhxGZkNUmTqwb16efy5aQI6wg9",
+	"pjb20jpEgJqj7Vtl5DS6hpISFUFXhYFWH/Upd4jeCRNRKUxmqhi9y80HEySpPizMBx0OOo4a+smnPxy+",
+	"3R/94/bmJv3q2Q83N+lbkS9ug2rEFzRh6mQf4vYHtq6hRu21qZcPS9yKFPSZQZFhomRbk/9pcIy/Gerc",
+	"Nna/f7SdPARC/bvgd6qsSUxoE/GoBTeuhGtVjF9CLL+EWP4FQyw7G2q7aMtu88fNQdiTGQRnA1iDq1on",
+	"aQrLchWj8LTkCKre+t27sUsxsiYd2P0C5AK4n/0KLbBAUwCKXAfemk8ZywBTo+WeQvYxDwocuVxvpiet",
+	"tiiKbFWncO6JX+8snp3nVitUi+rD5Kr+pe4KNBsG3bTino3qY9f+aHDOX7f6yhLhL/ww71PX4se+mOBm",
+	"aLGqO0CO9HqN/SkFxLF4yyXYwVAYQHy1QOMgrYXVIcFq5tRpZZHGqFP3iXDucArAkB+V4OElCOW/9FP5",
+	"CZM3yKepwAZuWmKHR7rHkb7jXGwKl7rSpLg2ZEqfnzYiZKxMWOgps4rTZz3u2I/NqVxInjPT6UdxPOZF",
+	"RGXYW4AJ7/OIh4gQa+3hbmo9BzG2Pt1DT8XtdkCnkz6Wg7NNdLGJIyt96qa0kT4td3NHjrfOCNnNfwjh",
+	"KX/eHI/+40FBPCSm0KzRjIQcupN17fVVCUkVefr0+url6PtniPF24lxvEB1cR7JeDKt67ua0mQ68i+DD",
+	"Q8/0++MAVWkV+ded95yzsgjPWs3giUC6RuxdpoFoWQi7B03syzrASYJOno/Rc3PH15LKTcQZkzdRWB5m",
+	"KawdugBuXbp00ukx+i9W6muCAcZo9HPGAc1wTjKCOWKJxFn98g/W9+LfgTOX4Wjvu2++0cuHzUmSkNw2",
+	"MNGBoTbfHOw9U/cUWZJ0IkDO1T+SJHcrNLWqAVSFH4zRyUyrwyuMxRrO1mT07VbNU/HAGmEKvHASjlIA",
+	"X4stdq+zHj/6QvXR3HYKt21e+GpQ9KbKjWfhgs+BVXuuR0EVTlHXSQ4zJ/ICZuEl4P7DKhi9IrLpGmjT",
+	"Hm+jm3MaORsUrBw/bdxunZ6wJxmMK94sZNZdNdJyd/o0wtQFLMk6ecSUujj26gK/Ft5OAHcFfGfUuE/L",
+	"uO7xMH+2Lf/ZwS/Y2JUPDdyTMrBDPErfMJB6KPrp6up8IP2ovR9+EFF9dRRTpTpR1Z3ZXDLvXuNOr7a3",
+	"lAZFwBK4p1j1HjT8KOrjXeprJUEQK5qgNXRpvEtDk+fVSXx98domKGc5CJvbQdrM96p0jE6kjnm3uSbQ",
+	"+xK0SpzjHKTW85XKNVUcoptoomhwItnEqaV+0LX/Xde+iTbTVIPCq+X7/ETtKDI08tqX63Z5KDD0IljH",
+	"2tITGNR5tKrKl2WjegJ5rFCBk7tBhou+wKdetJyXWVY779YGjZPZGybPzV0hintcVpqH7hO/zZMx+nUB",
+	"VN+hVNlRdo9X4okRHsxEiUBFqULXbEZH82Rko9UbVdJopB9LxJnJJ6Yz0PeHZJsxo7g9Gd3rQBOBwk/V",
+	"j/rR6kt9sv05lA55Cq4ijoGvz11qprFFnFu3bcCH2g/aswzLWAY2vJ8WOPgbZLRxUh7VbfHE22bAzLsd",
+	"HOZESL5SpjRSeyx0dhrjLuF8Zfk8Oz6pOosV21b5cdS/VgRiPK+sIKqu6Uj4tswhPHPdE3Lrn8r8dOxK",
+	"D7suoMJnSPaA3SVsqBab11+TLUADeVlfkuTD7edprjGS2SdAuvxl0IyrO0XAIeXTHoW9iIujtYmoB+aP",
+	"3B7MOBJ6tKH3jRpKZBoGooBYSeWOkmJDA24G8KRB3TOEH+4bhpAa5mAH+mne/l508cauwitfdx97GLrd",
+	"pG20retFCpHOqY4E/QskDX9jQnrt91OQnCT1BJx8dLXgrJwvilIGHT9sXDDKdXObFFG4qSm1St2BKjD3",
+	"hwTI0jo3as+tnEjj7Kn9ErXXB/AEqMTzikQyQu+QKED1qb2mhetvpjNQ6N5SzooCUi1Hggz2pR4gtcWN",
+	"Ny78eZr+B0pOQTz6vYUquBHqdfjiF+X5RTV4mqMxQiXwGe6Jn7Blm9lWpzt/lg1BX5FKPSiCDwkU1gWR",
+	"sUK9rVsX97kOcLLRDaB3IwZ0eIpgPEeDrpN9Vaa3oUuipvelpnzggujnxurcBfqqscDLKo9jlbxRtTAj",
+	"6xy/3NY18kJ3EbQjRx0quKM9qq5sHg1qxIwFE767J8mq3ENrzMImak+11MZgM5UtbMEpZLDLWMqGply7",
+	"IYOtxpuveYNJWe7el1qesGmpG748uJLXUd1LbW40SeeMoRWdtx99MzLEGF0ATkeMZquBTzZ9tDnyFOvU",
+	"UaZYRX8IzdltLlqjEWomOmR8jpXzla6XYAlzxtXPpyJhhflqUs8+c8QcpKJhQo+pH9ztWpMfWiXPlwpL",
+	"pfAXzlnNfNepc2+0a85EjXUT2fc3+rKG61b9PnMUsQKrZ+YtEvWwRGcZq7wPjWbyifCc22p7dO0zN8yu",
+	"UOXp7QqptsSd1XoZ9RAqJXAgI9qa7DAL+FCZ7ap+VKe2p1B643BPhKbwIdy6IuW2p2zCo9iBF5Ibz1Ug",
+	"vOc7WV2ewvtgpl/bas2e9XATG75ilPQ2R58vvOA01fGRRWZkYg45W6o/ZBNaDzXBK8UR+v8vz96gc6aJ",
+	"Qtsygohdhhe7yhmtD880RYwjC9S4g1BWrLMMtG+S5yZ5zBchqSUkmbw1VQJ+jGyWHZu4wUv0MEbXwmoj",
+	"vYY9wnYle0mmDLOm8hi9+IATma3cu3HeWGoEIxz4HtwB/VmdBGg9n3V918jVaqoqMIxQNFF1Jm8Lkt5q",
+	"L1DjUW4n5iR90w0IY1zWbFcPQ4QO6NXvEYZfdaVEbgbTx3ADVv85/oKlOabjrgjTz1OVnHcBGZZk2WMo",
+	"umj6Xpuq5v7v9u0Qm+VRoK3TBDkZ4A2T9uyqnkNV9KfrO8GGLYF7BiY7dTVHnkw0vx3/Uww7Tdw+PcqA",
+	"ywsbyVT0R5Z2p7RoJnVtOZ6pqWHVd9gLrOwTt1xEhNtCWsZT8/bULHgJXO2j0u216tmOKcwYtwMTOh+j",
+	"l/qIP1wf4PBEPGlGLjzJnzQjF54snvRGLtzcpH/vD1aot32PKbvBFsyMNBVITuZz4CKISSOJGmXYEoak",
+	"mmis96VtFI68cj16y9SYR1OYvN1EXI3BuuEatrRDM+7YDSbt0oGvwzQHvbDUHfdW8UbsrWNA8SZtTz49",
+	"VaKmmhOK7YccF4V1bDo+v+71/gq/p2dCu/oa9YV9OWVbX7t+VVylNdlwpw63PXeHULjtGlkjjq78FHTh",
+	"9l6VsMjhmNnKPJDeEAoe4oEvNPasxCZ1YD9ON7XsW8VN7TaiY5B2ZJemaxby4bbJWRpyWXfHrA39Dktn",
+	"uOGa01KUuJNtXdYyXQlxVWuMzqgVu8zXAjhyzFB73JoTY+tMZvURG8plpo5wQucnVAIPhn1UJ+IU5D0A",
+	"dfNHuimIz3LIVbF5fSfdGhE89pciMOPQCbLhlWVi5DFZcmovQwrwBGfOtTpl9Inz2EHGKuupJb6Exn3a",
+	"0Lgk6L16Wc7VxR5S4zllFydxDp8af8ZjPEZ7iFhPUWOx8pViXx8ElWJf4vEeNR6v5yn8IXK/n6NA4dEp",
+	"VvqeAex5fj7HyYJQ6B3qfrFqDaAW2hprb3S29pIrPZ+BRzsf6/pLpyyDvJCqD+DuBaNGHMMSk0wNPEZH",
+	"SkUrGEVJhrnR7zlfROECslJA01JxHhCactWlhZMUEJEb8iWsS9FUIw+daYWAcrS7LPUT2zcRYtyf6Scn",
+	"G1FAMsI0HfUmZh8QFlm9xq/ZxMD39zcIOH9p/ZSXsbmrW1oAV178vzMKoqWe8tv16KhczgGvaiiL6oer",
+	"ZtLoAMK8sYg6TOccQKBjyAQpncmhmYFqf2/PQWRTLTQMh5rF2gsakpwUyLBeC7k/cZ1iVXVHTJn6poNU",
+	"KaN6PjmhaprR4X7oZFHV15NBe8C1GjWxEpMkw0JMbBP372+q6VcT1WlTt/Xh++9+K+7mvykkdpGwYFLa",
+	"jO5KG9dc8aF6MGVq++9qns4x4TUzt9/u8yNmmpW9gwsrbGqITo7eHLnXzY8uXhxNXp8dH12dnL1Rxk/g",
+	"oD82ExsohkSoWmrGEUsAUyPnuJaVvlPTKeaSJGWGORJEglY7Emq1ZxxwE3tHOqwDT97A/W//xfhdjF6U",
+	"in4n55gTJ/aXFOdTMi9ZKdDXo2SBOU4kcCTdXFuhLOjpTfTq9OomitFNdH11fBOFIwevO+mC2n6TtVRZ",
+	"JTZS0ONSMnUQJVWmKn3hoWkox5UkuSt1gYzqG7AyFGa38cGu1lP35rDi8hXHCfgpS9beZF09RW8eca29",
+	"xbp6nWMkZA568B7k0d6YiZ4Y5Jhk0WEkAef/McvIfCETmY0Ji5z5UW/dl7oEKQdCzjJ0BTiP4qjkqqk7",
+	"OhutO0bUt80ubp+Gmj2zwqcN81Qrm4KSIow6WOcvg9wGx80yAKmPfkjnjokb06xcAOFIXdIVKQiTey8j",
+	"CVABtXtddFTgZAHoYLzXmcz9/f0Y6+Ix4/OJbSsmr0+OX7y5fDE6GO+NFzLPzIJJrVduIeno/CSKo6W7",
+	"LEXLfZwVC7xvc9BRXJDoMPp6vDfet3YnTXBKkpgs9ydegKUNFXYysapVsJBN8ti4TWJ0XDe+NI3rBCm1",
+	"CqASl07SqnFvy8gQGQj5I0tXjoxsqKPnoDr5p5VQDZ1u3D294z006drmyzVnnjCb82Bv/3MBEkK0zhf3",
+	"zd7eo8FQpcvoDPgjTlEFjxp0/zMMek1xKRfadcFO9evPMOpLxqckTYGaIf/xGYZs5hbV4x58jnGvGEOn",
+	"yoJ14bb2Qxx9+1mwfGl47DWtLm5G2MFzbQjo5T7Rraq2mUlN/lBM9kHHO4IMGQtxas7xKsy0dwd2edUr",
+	"kOsYVR1xpa846w2mm3klkgzNjWqFqB5sMKg9RaoX331OFXsL1La7lJS8L+HEaEU1W3u47TC2vT+HsZ39",
+	"/BdjL998hiHfMPmSlTT9wlgGMxYrzVkuMsHNR/zD0s+l1h12n+9/7j/fb1wynIRp3n4EHWTk3RZazx02",
+	"04g+r6JRQvJT58XybdmRmSqk3jgfz3QeHm4/jfjWne4gsW3vkQHovArRz9n+QtLaZ+Jt6M9nbh6DMZxj",
+	"KDdJFjjLgM6hn61cuNxT9nV9FyVMVRoXxWq87nyGM3bwGdsrhXvbAaFLnJGa0RQcloQpBkOHs5XjCvAt",
+	"+YvpSftD6t4elcF8orvZmtlvupx92Xn/SjvPpRrrvRa8AmkjskxFl9erX23xCqTLcmbIZPcNMW8PLtrG",
+	"hMfaLHHvS0U6bVsFwS/1Uw16WJ0ioh43mONtu0362Cdx/9F7YHZFm4iRF5X819mun0Wt8S+h0NiOQdR2",
+	"3kJFLwRjjF38sJcv7/kmLqGbNVIk7sYlfJWAhvBPkc9Heui/b7dqjXCQP0VK/yKW/w/XcqC/nJoD9ek5",
+	"Kl4XR0UZEHSu7TMh2zKyCxO99MisrH7i419e1/CFdX251/z5YkudXni4VZai0HsV682xnRafyAzbHecz",
+	"m197APhidv0fbHb9KxpcewWGDkfZxHA2WViVKmVLnvMKZIjhbCVd9I/3qGbUT6vLGMSNvthKv9wi/gym",
+	"oANZ+dJtR+O4NjHZ+fA8tEfP3C4XiNG2/K/dXu0mtKLOQ7y+h/497nfWBf7h9uH/DQDlwbDfmMkAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -16,6 +16,9 @@ type GetRenderedDeviceParams struct {
 // CreateCertificateSigningRequestJSONRequestBody defines body for CreateCertificateSigningRequest for application/json ContentType.
 type CreateCertificateSigningRequestJSONRequestBody = externalRef0.CertificateSigningRequest
 
+// CreateDeviceAttestationJSONRequestBody defines body for CreateDeviceAttestation for application/json ContentType.
+type CreateDeviceAttestationJSONRequestBody = externalRef0.DeviceAttestation
+
 // PatchDeviceStatusApplicationJSONPatchPlusJSONRequestBody defines body for PatchDeviceStatus for application/json-patch+json ContentType.
 type PatchDeviceStatusApplicationJSONPatchPlusJSONRequestBody = externalRef0.PatchRequest
 
@@ -36,6 +36,10 @@ const (
 	// When this annotation is present, it means that the device has been selected for rollout in a batch
 	DeviceAnnotationSelectedForRollout = "fleet-controller/selectedForRollout"
 	DeviceAnnotationLastRolloutError   = "fleet-controller/lastRolloutError"
+	// The name of the ResourceSync that manages labels and annotations of the device
+	DeviceAnnotationResourceSync = "resourcesync-controller/resourceSync"
+	// The keys of the labels and annotations of the device that are managed by the ResourceSync, as JSON
+	DeviceAnnotationResourceSyncManagedKeys = "resourcesync-controller/managedKeys"
 
 	// TODO: make configurable
 	// DeviceDisconnectedTimeout is the duration after which a device is considered to be not reporting and set to unknown status.
@@ -69,6 +73,14 @@ const (
 	FleetAnnotationLastBatchCompletionReport = "fleet-controller/lastBatchCompletionReport"
 	// A frozen digest of device selection definition during rollout
 	FleetAnnotationDeviceSelectionConfigDigest = "fleet-controller/deviceSelectionConfigDigest"
+	// The time at which the current batch started.  Contains an RFC 3339 timestamp
+	FleetAnnotationBatchStartTime = "fleet-controller/batchStartTime"
+	// The template version whose rollout failed and was automatically rolled back
+	FleetAnnotationRolledBackTemplateVersion = "fleet-controller/rolledBackTemplateVersion"
+	// Indicates that the rollout was paused by a user.  No further batches are dispatched while it is set
+	FleetAnnotationRolloutPaused = "fleet-controller/rolloutPaused"
+	// Indicates that the rollout of the current template version was aborted by a user
+	FleetAnnotationRolloutAborted = "fleet-controller/rolloutAborted"
 	// The requestID related to an event
 	EventAnnotationRequestID = "event-controller/requestID"
 
@@ -88,6 +100,22 @@ const (
 	EventKind       = "Event"
 	EventListKind   = "EventList"
 
+	EventSubscriptionAPIVersion = "v1alpha1"
+	EventSubscriptionKind       = "EventSubscription"
+	EventSubscriptionListKind   = "EventSubscriptionList"
+
+	BulkOperationAPIVersion = "v1alpha1"
+	BulkOperationKind       = "BulkOperation"
+	BulkOperationListKind   = "BulkOperationList"
+
+	EnrollmentPolicyAPIVersion = "v1alpha1"
+	EnrollmentPolicyKind       = "EnrollmentPolicy"
+	EnrollmentPolicyListKind   = "EnrollmentPolicyList"
+
+	ConsoleSessionAPIVersion = "v1alpha1"
+	ConsoleSessionKind       = "ConsoleSession"
+	ConsoleSessionListKind   = "ConsoleSessionList"
+
 	EventAnnotationDelayDeviceRender = "fleet-controller/delayDeviceRender"
 
 	OrganizationAPIVersion = "v1alpha1"
@@ -157,6 +185,21 @@ const (
 	RolloutSuspendedReason = "Suspended"
 	// Rollout is pending on user approval
 	RolloutWaitingReason = "Waiting"
+	// Rollout failed and the fleet was rolled back to its previous template version
+	RolloutRolledBackReason = "RolledBack"
+	// Rollout is paused by a user
+	RolloutPausedReason = "Paused"
+	// Rollout was aborted by a user
+	RolloutAbortedReason = "Aborted"
+
+	// ResourceSync changes are not applied because it is a dry run
+	ResourceSyncDryRunReason = "DryRun"
+	// Devices defined by a ResourceSync are not enrolled yet and are synced once they are
+	ResourceSyncDevicesNotEnrolledReason = "DevicesNotEnrolled"
+	// Resources managed by a ResourceSync were modified outside of the repository
+	ResourceSyncDriftedReason = "Drifted"
+	// Resources managed by a ResourceSync match the repository
+	ResourceSyncInSyncReason = "InSync"
 
 	// The name of the preliminary batch
 	PreliminaryBatchName = "preliminary batch"
@@ -176,6 +219,15 @@ const (
 	TPMChallengeFailedReason = "TPMChallengeFailed"
 	// TPMChallengeSucceededReason indicates that a TPM Challenge attempt succeed
 	TPMChallengeSucceededReason = "TPMChallengeSucceeded"
+
+	// Enrollment Approval Reasons
+
+	// EnrollmentRequestManuallyApprovedReason indicates that a user approved an enrollment request
+	EnrollmentRequestManuallyApprovedReason = "ManuallyApproved"
+	// EnrollmentRequestAutoApprovedReason indicates that an enrollment policy approved an enrollment request
+	EnrollmentRequestAutoApprovedReason = "AutoApproved"
+	// EnrollmentRequestRejectedReason indicates that an enrollment policy rejected an enrollment request
+	EnrollmentRequestRejectedReason = "Rejected"
 )
 
 const (
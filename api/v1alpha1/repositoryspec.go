@@ -3,6 +3,8 @@ package v1alpha1
 import (
 	"bytes"
 	"encoding/json"
+
+	"github.com/samber/lo"
 )
 
 // oapi-codegen generates AsGitHttpRepoSpec function but the generated function
@@ -40,3 +42,12 @@ func (t RepositorySpec) GetRepoURL() (string, error) {
 	}
 	return genericRepo.Url, nil
 }
+
+// loose decoder is fine here as all repo specs have `webhookSecret` field
+func (t RepositorySpec) GetWebhookSecret() (string, error) {
+	genericRepo, err := t.AsGenericRepoSpec()
+	if err != nil {
+		return "", err
+	}
+	return lo.FromPtr(genericRepo.WebhookSecret), nil
+}
@@ -18,329 +18,482 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9jXLcNpYw+iqY3q/K9myrZTuZ3IyqUvPJsuPoi3+0kpyp3ch3ApHobozYIAOAkjsp",
-	"V913uG94n+QWzgFIkAR/ut2SYoe7VROrid+Dg4Pzf36fROkqSwUTWk0Ofp+oaMlWFP55eKnSJNfshOql",
-	"+TtmKpI80zwVk4PJKcskU6YboYJQ25bMecJIRvVyNplOMplmTGrOYLwsOM75kpW9TROiU0JxnFQQvWRE",
-	"rZVmqxl5k2pG9JJqQsWasA9caS4W2PSGJwm5ZCS9ZvJGcq2ZMCtgH+gqS9jkYLJ/TeV+ki72aZbNknQx",
-	"mU70OjNflJZcLCYfPxa/pJf/ZpGefJxODrPsHH4LLdu0Jukc1kizLOERNV9hXpGvJgc/I3AVm0wnv+Y0",
-	"TpievK/PO5182DPN966pFHRlYPWzm/eo6G5/+C83Cq7NTXmUCs2ENsukSfJ2Pjn4+ffJ/5JsPjmY/Md+",
-	"ecL79nj3v+cJc50+TrvbnrKEan6NeGAaS/ZrziWLzULhUN83IFdb3wtx/ROViAUVnGDlBxrH3LSlyUml",
-	"Se2UprWDeCGuuUzFiglNrqnk9DJh5Iqt965pkhuM4lJNCRdmXSwmcW6GITIXmq/YjJhzvGJrQkVMsAej",
-	"0ZKscqUNOl0yfcOYIE+gwdO/fUWiJZU00kyq2aSx7RYUcmA4kek1j5k8y1g0/KwCcDSnUAUkLRG1Zyxo",
-	"9nE6MbjWch3LCYlpVUDjyf/3//y/VRiQJBWLKVGaSk1uuF4SShKmNZMklUTkq0smpwC7KBWackFESm6W",
-	"XDOV0YjNBt3C3yepYAMAdbyiC9YG7j4sPxYJF+2933983322Z5rqXIWJBX4zpIISxcUiqcLYkrmYXXME",
-	"iaMeJ5Jl1BKJMwNi/OdpLgT+64WUqZxMJ+/ElUhvxGQ6MRQjYZrFwwlNdQf+nI2P3iIa38pVNT65ZTY+",
-	"lOtufPI2UgX0T2mSI+aW16cK7udszgVThAL2xuQaepBcsZhcruG5qlLr6lUKX4x3gv+aM7wPlub74xrc",
-	"5yL0FDTx26efMNn7T8R5BEkDYUNwq5Og6tZxR6q5+1dcacBfD21tY7NHrtlKDaA9tTMs7zqVkq576Sd2",
-	"Q/zovmU7OfI3jbMOnKc5zjmTTEQsxCTZT4apwTueJemaxeTt0fGegVHCqdCEm1M0FNNcrzmNNLmk0ZV5",
-	"qDrnDuGSv54ekqXO8tWKyvVA0pUkPhBVO9n6gdFEL9eT6eQ5W0gaszhAqjYmT9XVlnO0NvEmb20ToEzV",
-	"BsVyDehyvTxKxZwvmnAy38wbN+eLJnrRXC/fygUV/Decohyl88K0dPs4hRHDBwYLMZAN4qrp9+70VUu3",
-	"d6ev+rGsmLocbdq6wyAGtkMjsCZpuE8Wk9TvYSGdy5b7zIRhA2Mcck7zRE8O5jRRrM49Hs+JljmbEpVn",
-	"WSo1maeSHMcnJEM6WZ+XK2LH9gB1maYJo6IBKbeKEBCeUcWAdp+yBVdaro8ki5nQnCYB0uZ9hBXSKGLK",
-	"cBKEOsaKSSLtUCHRS6mbVMbNkU/sFxjWDUDMcZr5Wl+x6URd8ez81dlPTPL5uh/QZ1c8I+evzkhkVjU3",
-	"IzNybTo3JyngOZ3kismW99h+2XDhH4NnoaOAZAo/mxOngrCEgYTBBbmEnxX7NTc0tgnrhK+4DjPWK/qB",
-	"r/KV5YsNvc+YjJjQQP3nlpQq81jkWWwgZFkKmNNMNYwpOClGBU5ixYWZdnLwpNg8F5otmERBTbGERTqV",
-	"ffToFb1kyZlrbDrmgIfnS8nUMk3ivgH8dbUexJmFbMuBuM8ktlyegU9i2ROAEwLwkhH2gUW5oR1cdJyX",
-	"ap3vsDouzggy6nCmB3Hr49QcwjF2eFLneqYGO6lmi3XfaKdpkqS5PnPN6xSnGCdIctJURy8+GDIXoDA+",
-	"QYU7xaAl0phL05XEXF0hqxJ44mRkpLpI55JVqMHkw7ff/Oubryd1gnBO5YJp4veDaYGlqEzk2IpiIGo6",
-	"ffN1k4UocKpLW1Pfi0EW3Ks/GVepmWnFJ9PJ9Sq+mkwnv0bpzVPDX9EbQ1eoDCygdh7wtfUsLP2f9/CN",
-	"lCyYYBJewW0OooLSPrgta1sdrUnodSoHrfNmySSDERGuXBHTt/JUbnJQQPgC+x0A8sqqQ/A/Kl+hM74w",
-	"cuupIQMqdDPamhLpqUCJtD/C80wUXwgWVx67uUxXsKejw8CpZfwnJhXM2Dizk2P7rULzrvE3FhOkDggy",
-	"rsplWe3C3DxguPUZOWPSdCRqmeYJaGWumTRbidKF4L8VoyknsRjuS5mHT5v3NkElGap0VnRNJDPjklx4",
-	"I0ATNSOvU2lesHl6QJZaZ+pgf3/B9ezqWzXjqSFvq1xwvd43HIzkl7lOpdqP2TVL9hVf7PmYvE8zvgeL",
-	"FUh/V/F/SKbSXEZMBfHriosAu/MjFzE86QRbWp1fATIncp2+ODsnbgIEK0LQO/QSmAYQXMyZxJbFSTMR",
-	"ZykXGv6IEm44CJVfrrhWDl8MnGfkiAqRgooN3/14Ro4FOaIrlhxRxW4dlAZ6as+ALAzMFdM0ppr2vU9v",
-	"AUavmaZAOayOoatH6+2ySruJKqT97YbB7g0hprxvFlW8TdqVb0Q3XvGNaAfoUwAPHYvR2nQkFrdPLApW",
-	"Lqz16jybQWxg+3vzsckNjqTrHkiXOWskXJuRCjz+jWiF071Wz/efkmYZk4TKNBcxocTIvnuRZMD4HZ2d",
-	"TskqjVnCYpIKcpVfMimYZorwFIBJMz7z+A01u34y61xCQG3yIeMoAZyxKBWxCnF80B8taQXNuKYJj7le",
-	"Fxy8txAzzTyVK6pR7vzq6aQphk4n7IOWtMsOWNyzFlayvD81A6EZmFCNyMWUYy0NeNGa7GAMzJmBc5Zm",
-	"OWqdLtfw6+HJMVFwYwzsob3ZuaFrfLXKNb1MWMAciIgU5CrPQapX7Juv95iI0pjF5OTF6/LfPx6d/ceT",
-	"x2Y5M/LaSbVLRszLNCt4Tc4SkG6pjw9dDCtShcqRXK41C/L9hoWVb4LKl2MRI5LBmmSBE9gHCT6Qql9z",
-	"mvA5ZzEYToIXNOcBYvfu+PkdnJO3CEUXIbvHO/gdoG62AdSXwZtwxdYEe3n7t+oarlRe5f4rD0UvArdr",
-	"vXyTxB0ApkYKHTZXkGMz0tdiuykRimaZTK9psh8zwWmyP6c8McKqKgwRxS49s7JqgTvh89JZRDUpntc0",
-	"fEftkE15bloCjqRGAi5gPuh2GfKKqqSgLsZ+Q4OLOdnUv2kz8qNIbwSJvIaSkUMAHYun5DkT3PzXQOh7",
-	"yq26ehin4sYMWud8bPC2EMSBYqD2DZbHFzNNudVup4IRaq6cdscd5VICB6LNmTre1SD1qUfSanpYqvS5",
-	"pELBTOe8zdvBtCOar1B1UWyK6KIvi5EvMuuyaKhTQkWql0xWTtswQHtmrDAnogy9aK7ih3xFBZGMxoBN",
-	"th3heCcMX+egQy/TXNsVF8sLErT0Eq57/BJVR8FjMLufOVZmtihaIlGpQuOGKqB85s2KSZ7htP67Dqq5",
-	"5rsuGVVBQYU8vJSczR8RbFGyDm7OB2rQTgcKiG5UJxCWGqhB3dBrpk3XBENOQyhXAKA8/87L0m/crsBo",
-	"CkiZzsk5WLG+B9MLsUZLX59pvk+mE2iwsRW2tjo7Vu1XN3TtZ9+AWoVmEx+t4q/EOu5LEt5uHKWbTCfn",
-	"J6/BBsWdodd9QBoIezYEsNkUbWiXCav/4WjKCZUKmp6tRQT/+MnwuaYF6uGPxYlMF5Ipc/jvjPhjfXUy",
-	"Frmmr/NE8yxhb28EkwrWdc0j9pwZyYcrI1eYTsMO4oWQaZKsmND2PfX22/hW3W7rk+wN0dqmgGVriwLI",
-	"rS2qyzllWaq4TuU6CHoD8dYPjfPxPxZn9X3CmHanAH+ETg1Pwzs7/ME/Qfxl6Dkims/5ou5pM8x095Lr",
-	"QPc+57UfC+7/jEWS6S0837aY9Qets1A3CwO0Shf27RYj/1HDfF017sO7kOVqad5BsAGE2Lgu4/lp2DhM",
-	"vE53YjG/E1t2LpNBMB7k6mEGC75WWe6u3OtUmFvc9GitgnOFzfp9qUvFVUpsp/51+qMHfem63ZubO0EU",
-	"lql48SEzhCLMxcpUEFY0IMgMwRmaseM8AZUcXzE1uxBmk7YFV+SXvxL7/78ckD3ymotcM3VAfvnrL2Rl",
-	"xf3He3/7+4zskR/SXDY+Pf3KfHpO1wZor1Ohl9UWT/a+emJaBD89eep1/idjV/XRv5ldiDP0iGExMQdJ",
-	"dWoWsWcaHhQaCSNaoRryIZstZlMYhguyNEsuxmPXTK7ht0dm3l/2fjkgp1Qsyl6P9779BQD35Ck5fG3O",
-	"/lty+BpbT385IKCIdY2fTJ88ta2VBhHnyVO9JCuAIfbZ/+WAnGmWlcvad31wMfUeZ+iVW93LtyVIDNP1",
-	"rdflQrzAyAIDOfJ479vpk2/2nn5ljzR4/Y9ypdMVvhrHYp526brqrDKoAlGfH5MIBiL2gtkDCE7ZpDLF",
-	"IFwgMoIWAKSKqi9f487jwpuLw9+rttBsuVY8ook33mjBGM2do7lzv+Quh4uuts8Whsz3rfe44W7f9AUP",
-	"syo1XYXvDt/t9w6CcLwOv/7OIW5eejMqcrPk0RIUW9CTDHKvN9NANEqAjr4pZnFtiFOTFNqH8OiePmPY",
-	"mYUDQz5O2z3sSwHfNimc1+GS1da1ncN9XffRotgr/MjNeXkALTY/CK+qftShV01hA4c/S3DprkUZBNzM",
-	"q2jK7VPaiab+a4e6NEf5QMPkRzXsRNvU7WTf9NrrgSrKTm2APPKUo6WKCOHV6pIumYiZZHHrM3xqG7iH",
-	"t3XcPpNBdZ7OTao0aeUw7Gef0bCaMPg5SoVgkVUaFYcd8vYEZv34eZgQ2c/k+Lmvj6zNEEYM7Pnaezpq",
-	"+F7wesUsjlA70mbWbW1L31XCFyMq4LVUaAoAL1Sa8N9QZ104zDG54oIm02LN6Adruk0J01HbcdH4rUjW",
-	"kwNwha+iZm1XUw+A7UfpK0WagHCDWb6TOpSKq6qUwtjROEMNrqPDnk1/KehyGtbk4pDDtuSN0yTjhaUQ",
-	"L4syMzS2tmJ6mcbVK+XrN98JBto80F5GOpXrU6YGBw93rdgbuatZddYCCsfmHZRcr4+WLLpqI0jtbeu3",
-	"t0qyuOtBItOFZEyaG4EOD1u+AXvBN6CUeOpz4oo+gfS3b3472t86Uo+JYANglljnojHfCeWkf1+BXuhv",
-	"N8HD0AbKmbra+Gtob1esrr1Jue4mWFsNLpY5aUPRxt3NahI8zAIKRr3eHmnAO21TFqdEb2BvykX3MDem",
-	"dQGr5vvIV4YTXGVu77XB69E8w6yiW90qG42MR+RYa52tPgXOW1/M5mIGX83WB8CzlBT4Hb6eW13F2rVo",
-	"2VLbzeq5w83rW167V1TpM8ZE26PhvtcfCkA1ZT5oHwtp6/1LWidq2vwt/4Embiacz4yRDHnNWaoDlWv4",
-	"UyygHYNe8TmL1lHCfkjTK4c4DgOesXkqfcPU4Vwz6f2NDU7ZZZr6LcofNsGMylIaUwfa1FfTOoy/wLZx",
-	"vDU3gbOV2JO43jsQGOvq0XLwXXELtb1uxyiEBmkjRH6ITQhiTY4ArcuWGlRNntVfNiRJtVXXiUrtc2UV",
-	"ge+hpfU0q5KnoBd/+a3qso+/q1G9fe8O+t5JDNKMWWPG6Hv/R/O9n06sUnDYCTqua3dO+yFnj+dMQ/Ko",
-	"5+iF11SVo0qx37iN7UCzFHPTaMUF1eDfILNUIQI72tu1kmDoK9gquViAr0vHZZmb76C5V+jpBx1rjOhQ",
-	"Z+Ua3D1INBY0FNynTKXJdQe4qUJ3XGgehjju0TUkVJHUNCYPRZ4khM+JSPGXR2az5kfz7DsNWMCQekcH",
-	"7PYePOBMsmue5ur1Jgdtz9j1TdbWph1veeBgD0iTvN2N74f0xilO5wmPNDDW0m7MBwAa3WE3k+nkTer+",
-	"Bft6zlrSWHWiXG1t7Sj3VoWjcPyvBD9d2icadYTk7VkZp96mj1oF/XzPK4NgkDQa0OQwfxwct3NT2zDL",
-	"b88Gb+GnqjHAbSP8Zpsvz/miNf4lhm/1sdD9gqglffq3bw7o49ls9mgoaKqTdgAKLtuSZ0dLKhb3Q9nr",
-	"awheecFuOqicYDeWriG9K6ibZKv0msXDiJsjDR0TuSbh2UQq2JCp2i9u+0kVrp0bIXbBTPap6aIsH8Zp",
-	"VNfhVE4xV1ef0n/FVulQXic0Qj0iI8snxaB2dUNB243jquKbh8CuInWZkOufVFrh60hyzSOabJ3+K7RQ",
-	"P7tY82s5eeirt6DQZ7fI0Dffj734nq+Ylzci7M1l0yFRsbaekVUtkZ9S5309uyoE+Hmf30/D4ZhGvJOw",
-	"nCKHEAZqpILAFMTl+DGi3n4qbeig+3VGDjVJGDXUWLCysUv86bJhVVLq/l5b/cGElblYv8tkGudgLp1q",
-	"zuR3cwnpZmOkOP4drG4y5CfgloO71NIwFX7aHy9vkoUCqvC43aeakXfKBVBaP9sVNfwhKT2nayBRzg3w",
-	"ohAcZgYvv8PJnkyt7idbUsX+8t0JEzEXi4vJoxbNegVSu90jDD5sj1Vk8PZ4xdZP0Ob8ZHrF1k//gn88",
-	"DW/oYxdRgUuhslQo1nsr6tiM3VAUhm1i9FMh3XvIh1CIrSA4OfjqY9PHodqi3R+oAK5hlW+YZMSmtprn",
-	"SbK2AI9DDkENd4fKlO3Et4v7rPGetMONsnQzGZaz015kuVXWzlpwQEMwiFpc/N1C8PsWawjGJoSmV2kS",
-	"Ouhz7x7RSPPr0qvDujNsqjpyzirB6POqDnJjNwUI3xu4DivGWKkMWJ5Q0ieJrt7FA24d5quRFsNhUHOZ",
-	"D0EBc7bHLV42+NFZWFTN2b8WOmCkwhOqNZNCdSVpQzVGZltWNtOgtuju7daRC44KkSnmsE4l/DfNNVH5",
-	"fM4/TAlmdVqyJNlTep0wskjSSzcZrB9mpwvKhdIuYDVZkySlMcMpYE0r+uEVEwu9nBw8/ds304kdYnIw",
-	"+b9/frz3d7r32+He/xxcXOz9a3YB//fzxcX7v1xc7F1c/PXi4h/v//Ph/x7W7tE/Hl5czH7GhqHP/6s9",
-	"LVdXPl5UNZ6kCY8Gcq7vvB6Iru3vR7dPSdOLJGyoUV4qYEs8ie27omAG5wm6e0Y6p0kZV/yptNZK1D7J",
-	"LW1EG9CXpn9u4I7RppfhxqPXvDSHR6YXZwBwRD9a57Fp4BgM26YhhdOW0ej+ezOIYJculOBTYa3VW3ke",
-	"OGeJ3ViYycM3b89fHKAVoAjr4IqIVBPJdC5FJZPDo4EmaSNSLdK9f6tU7PGFSKUVzM3inUFsKwPlhi9U",
-	"0afyRm0q8W5sHGhgNpJ7F3szYICyfUH34k1IXtziW+Jdscqqqld6Er7hPhh9PC7uA5xNud4Sav6xd3Cm",
-	"W7tte5i+pDK+oZKBZRHjxwwnj3slFVvf7t257RpcqoZdOHQHQLOdlX6jlOth36C3EK8bzq7ue1ucpEaS",
-	"id/O5xXnocMbyjWEZVuPZozZB1X9Cc3Vhgb8yoa8pTW+easNfK2qXiqfmh4klc+VbQa+110KKh9DwAg0",
-	"q8OnPM4KSRkWzvc2c27geBu81FTsQ5aqktbTBRN6diFe0GgJCYeiVEqQkWNMQVIy8HgtrFU/ohm95AnX",
-	"69mF6A8MxE1UblWUJgkWzCms0q2MkVlkq7uDeQsPF1CcB5uE09J3Jq2FMfzEtZLZyFQLp0bYYjmyQZ2Q",
-	"s/+zNNXk+PkmQ2Hc5ZDnoxHqad5LRwQR2uFdvi0o5ZmjlAOXV7d/+wAtoNBcxbR6fO10q8HD93i+Z9AS",
-	"DCIrKuii1ONYXwUoyhQleWy+3CyZcL87Z5ZLRuL0Rlj5ybwjNhlSwNnWtjvDsOtepgY3U7QuHvdt+3/s",
-	"AVu8lVkO17RTBzb/ebQZ5nf4PFY2u93z2BxiAxe2EmCF/1p2nj6nkIHrba7fzu2/Pb/FbewRlUV6UwS+",
-	"+rMGO9ccKKtfGyYHX8zrYcucStOFFoHJrhAm4MLNGfoRlFWpwPLeKfuWmNz22A3ICVUkh/+98RYdkkvJ",
-	"6JW50Z07uVyTC39dF5OmM2aJXKrO0/4BFm/X1L1wnWqatJjlzCcvcjY008AcXZb6/ZGgY6WXLujUQ7gA",
-	"VNMAstbPv7bhIDXi6qo3xcnGWUWmf7C0KMEHPCrT7tgB4O3m6grzXW5S2DPmEgxN66Kyp9M8W08Jb8zu",
-	"vXTUmXzOlcxh1md5bAMDa8rDWotq7RB2zRJb4Cq9YbFZlm2NZFJi2ijCAU8zmzuqCYaFTPPs2bpdOYjG",
-	"tyu2BubdBmQR6AZ1TMvqF27+S1huRVvm6Ysf/ny49z9077fHe39///Ne8e9/7c/e//XRP7yPAzS9oJh+",
-	"J+g15daFI1jABivJeFTHnREpehaX2pXaRPCB7rujEA18PeyZvlY/Z05y0Zy3OMeN5g/ycLmfOtEVNHms",
-	"msVMvMUVeZHdOorUAhierFMS2dqGWH606FAyvi7fLHguUgKp0vi1jRxi5vbYsS/XhKKaMRdcz0iZtKf4",
-	"EXKDHpBfFOa/UZjZeUp+WeEPmNLG/LDEHyB5D6C3h2r/OPj5yd7f319cxH999I+Li/hntVqG8eqFiFLD",
-	"1A+Jj2W2LdI5CG8GwkA1rTm++1xdllBuxFrMnzw4dR5OdWI7u7+f2UE++hn0ymRl9bpirsWe1d32ccbl",
-	"mGe2Q7NKWGPM0IvUSO/XhG2jSUeFEptlF4rfQLdO08cYzjBm6/kTZutpXKjNEvc0u++2GElLNtCQwNDa",
-	"tMzAHNYYFITCs96RkmS1J2mgLq1oR67vmyXTSyb91NZkSRW5ZEwQN0C4MCD6PH1C3fBDl8gdRwJ1apYl",
-	"67JSa0sqtMbh2X1udEKerDVInGg/6iYf3zNp34l7tvNPPfvDFsdv4E2othme/NO/oapy8MNiyF2PZ23p",
-	"papZqkzbAeKTN+rU31JACplueARbODAEAF8c0CyIa+FgxmCzalxjo8nIEtx7hGPwTAa5UDQZxzHs8Ust",
-	"ORRmWPppAHiccnQ1LRoi9Wm0faBcEBO49QRiKlRLFEmowI1fq0NhvnD/XQk84lUvseGJE6cT0Kaf9iU+",
-	"O4fnqDP5GaCsze00+7dKBXmYWqNuh/v3TrkVV6HBuRDdcFue3TIwXBVOR0smiLlD3gPCVYi9auFwzHkO",
-	"Q7YWK1dLw81ewUGPUsn+bsVMeU5wfXVhfFxuFoeZbVzypVnghH0Czd9ZEZem+qLjdG2TLgZzmd5YBZgh",
-	"wXDrweOWku8TvlhqcmRIcpr4yOrlYWmW1S8TpG+siTnMNVRm9hQwOd9zr1D42N+dvnKn8+64vIVgRCe5",
-	"QlfmTLpX7L9OiUER4D4SLq4wAzXM597ODoeDbVVMbZqmGrzKCVphMAglAI79aAHV/CvlmuwbX0vL7yMN",
-	"FtPdAjVw6D3vSu6FszIeQUOvaMVzqmm5TP+ag1YSSD91SzfjkzlPMHP/+auz8MXHxVyxdecifmTrjSa/",
-	"Yuu+ueuXvQUqzSUOOvjhJGEAZXDpNc21SLc8dG9fBqlSyXUryMu2h65pO/R9LqUYmVSqLbZd4FBILXLC",
-	"5hUG4hHHkqnC+6N34+ShY2qXqdJGtj3IUqkHBEl3AKhYbPDkDfcbOOZrFEY9HbP1I2DX6BhONUkj8AIv",
-	"auOh01uAmIcj4+riOxS9AzMGwgJJsOSLBfBrIEeYydG0gvIK8EYQxcjm/ANaTRgHzZMZ7oA8BLMHONCY",
-	"H9Qjbwb7leY6XUFFPRe0Eub0RsF414JxXMbmd76CZkQXxw8O/teQcAK1vsN0w0U5+1Ek3rlI3FL77hDv",
-	"XZmmtSaA1rPEGjhmtk7dDq0B7VXq1DKVekpWNFpywcp12uMH+lPNE1KrZ4fkyDNfOteQI6za6dWvOrJ1",
-	"OYvEi+7Du8KXv/pLo6HLmlL7xR+zGXDY8nOtx9HJu0b4/NHJu3rA/dHJuzfmaS8bvYZ8BI2++HO9O/5a",
-	"G+E5V1eN/ubHem/zW62vF+tU9TH3PjRc071v9XQDz7myrIqfGjPgpF7zGa//XGT68T7URj2CiHnd8DC0",
-	"vzd9C4sOQa/C4jw3qiLnZOMaNrTkperO6NRRXs38ciyu7W/H9pE6p+qqmNj/8YTJFRUQYendgZaScu7n",
-	"Y0GrHyy1j8sm5UVrlo8rl+dXkytvsf/rmaay+Wux1MoA1hmk/vszqqPlc64yTNdU+2qhxhIH90ZXf1y/",
-	"QN6RueDaO7FBNfcasCs/BcvwmR+5WDQIVKVEX/3HojV6p58ypVPZkhkHew7iCs6wqVfys93RzmMg32Jl",
-	"TaQnU2JpjU/JC1Lj6ND7fptSt2a3yrQEiodOi6KjuKmpZZxb2XYvtVGAe9+znkaRKzE7NQ9bDu98XOYQ",
-	"sfz8OgOpq5LhCEO0s8yGundSh049bXfOvR7CssHI9fRybTmhesIaWzJIdV7ElhHbe3SM6lGGocOWXcLj",
-	"brTQnjXW6NOAAas9wqNaAjFgNGwZHsUR5wHD2KblOIGXqbWsZr1leJTmUzZgwEancuyuZ63VWbm1iz9u",
-	"5Q3pxpRg4+ZYveuqNPOkOxclDRXoD/xUYh+nAyuttg4+KKq55foP691N6rYZo07U+mu+tiHnJj1bsXBo",
-	"zc0gevR37sXWviE6rvgmXTfbdCf13KRzCzHfeIhPWkSYXH98v1GOP+BBWvxU3Keab8o1aE5Gh5R7d0gp",
-	"DmKYFwqokEfPky/W88QTtIICVrEKVJnBNYNsb0aibCrLapYd17nfQLDhPD0Gk2Le0J6/54lTubTtGT6i",
-	"A8Och6pRRF39IdCBaPZBk4fvzr/f+xYMExj2UNqmykkghs5OE3I/MO1c3EO/VdkL4whGx5jtt5e7M1+L",
-	"AnctwVItubt5wh4ojIuaeqEw1mQDETEubbLIV0zyiBw/n5HnGKEDhPdiItNUX0zCtySNWefUGZNWB0pM",
-	"2xn57zQH4oGLwVD8lbnqc7riCaeSpJGmifNlSBiFqJbfmExdisXH33z9NRwfRTeriK9sByyCF+rz9dPH",
-	"jwz10jmP9xXTC/MfzaOrNbm0gT2kqLIzI8dzyBhUQGyKkfjVzcAVMPtUJPYAZpYXLniaq9Yk6wgtyAl8",
-	"CwfVhnNvnf7fL5cTFWo4m/vYy4AzLECoMrSn1fN/Pi3GrvzsxJj3doWbhYr6ZKSXA/PvXF/jw0tIhs5O",
-	"KDi6/N4MqCyoQktoJTB8gbttg8l9wy/zs5SO/NkYQzTGEJUy02ZxQ9hlt7FCMGZY0io+VSUtrC4x3uR7",
-	"l7TKgxgkaSHNHiWtL1XS6lfjNMKWL6GwSpCHg0/AhlYTxZRB83dTDKfDahKy/s2tpjwoDRbZAbBVPcsI",
-	"bHlgZhSbBvyEychQqbaqLraZ4bVtOyeObTHZPE/6Nla2/JTNabbKDM3sDIXwZevzagfn/8yVRSND0a1r",
-	"M7jwp0H80XzF4re57tsktIOBPmWPWyfQGT5LV0GiOoyn9jKGUGta5LDxMKHAdQ9wg8hCU0H8RdAFz+4Z",
-	"Igz3gtPbIEDfGfZT9VuHdzcJ3iGkK7hlIO4SpEA6kE8EeB+gw4aMu4d2zWIefPVMczR59gEbQVoEqNhY",
-	"MIPVDBLxMpfeMwjf3Z1ux9RQyUTEbNMDLqGw+WFXLXZ3f8jWwn+n98lyQbd/k2qW1LuHrnPsCIFXuiaS",
-	"arYIJAqwYxgxCFoU7k6ltxekNn52669P9cn55PemvvMBxxgM42y22SyCs8FB1CwhGAL5rI8nsQxbWWED",
-	"yQoWhq4BrDO7V6mZCW+1IygattIbCG23OqxUxmmlMQQPldWiOiXMSmkpDwlbLpn9Witi2cwbWctUeGsK",
-	"Mq8eUiNdbFibVU/86PbbitidGL01Kg8uKQKtp4SBhYQmyZrwUtrwrsaSXjOw4EAMGr6RkFhO0AWrRIBx",
-	"QSi5WbZZFDcLMy5O/NMrcsSNLLWb1HAuSNUgFVeVWm0Y14xBdpFOIDf5UUvhqiO/PFJxYeaurw37ZatL",
-	"FsdlhFtREbX++oHV69WnpgKw1jOXCaBZi7axWRYK4t4wad10kqSLV+yaBYTpV+nCZtFsAVGQw0yvmZQ8",
-	"Zi0h5jbbYrBO3D9d3qiUuFEsDBA0gZjJSqWrcEqpLE+Sc75iaVA1gR9gh6aheXJsqCiTeOQtMaAZi75n",
-	"OlqC41wwOZf7gqZZl5TZVbHIWNSRmRtNjwPHzm1gSLVCRnj0SmGDsGJaNesGYLlFDPGHCgKblZYuZ8UU",
-	"+h215OB7aAlRulpRq7bdZuZBKGB359U08ZYQFqiyVd+1Oz95bSlRkF95yQSTPDplWVoYmLtqK2YBqtLn",
-	"WYlDO0faXLaozh5mKYSCrKHSsGaPoFABumIOK2VthrZtQvT5JdeBon8NiWLB9anZTjiHi3UTxXjyl1xX",
-	"iQDBgOdN0hm7JMauujlfOJpfeqIGD7+ETr9IUA5VmFvCCAW85ym75l15bPCrWXTu6mr2rrdR07JYfGPW",
-	"aVti5ulEDNJT1GpC9q9GoOBvTz408Q9penUYOQeR0gejVkVh3lneDAQxV/52xXQgi+8lI+wDi3KNSXQG",
-	"cU9mbZ0clG6lPn/0FMPkgXpQzTD8YPWgmmGYipg8WD749CzDH0PZzIe5/ZfYcZqLycf34OdS/TGQ9vf6",
-	"Jyo/hWl7UVZGJtdUcgghvmLrPbS2ZpRLKIjyb1SNudTVuTAwDjJ1Mhetjpbm/athqF9thYo1oXKRr0Da",
-	"yRW8YpqKmMoYa1wStRaafjDIw4vCyHjuiqxs5EHx0pKMZ6DPWwBbNjUYxeF6r7GYrlsEyUXMJKHkkqol",
-	"2YvQd/FDmD+8SeXVc97iemY+Ylp6l2AetwsppDFrey6Es13bhQ4gdbloJSnltT3YBNeKbidUL99mvU5b",
-	"lT4vPhihFIvC9q7La9x0zBCEFZ894sYM/kEZmJRomTNzdIXoFKZ5Nm89i4OnFtpy4z6lLZ6fRWT/Q/WI",
-	"ADeZMEWoBq9XlqQ36NAIr7DZgqKaK+uOCb8WSx/uLVFxKAwQ5HZugFr3uoItQB9fAmrLAi0LUIN0H2G4",
-	"0CeCOVQbYWqgGsQRrbNSwN2gGndT+v3h/PwECwYZShBQPdBZJANvF+ZYJ85jWaapJkeHQfzJqFI3qYzb",
-	"GDD8SmxKFrRQBtZV2GyL8UIyzhXP0GHlJyaLyheBdD1XPLOMrmUaybXXISxN6kQNAsb5qzNMI+X8pAct",
-	"3Yx+xdbDR79i6+GDp1dtZU3h026gnysm23lE97V3rgFOw+UN6JYmllpnA8UJgSsZJlAYqnASJCPmVydC",
-	"oEz+QCERsVKlTr0kyc7Tv14SFpaimMHLkr+7kVxrJj5ZHJFNccRJE1TZjE4iIh2CCpbQDm1eFlEL705f",
-	"IamM0pUh+XNt04JfUgVfZ+RYk4gKy8Yw8mvOoKyMpCumwU0wj5aEqgNyMdk3FHFfp/vO3ewf0Po7aN3m",
-	"a94q8hTHd/dSjsPINrq+pS5gWXkSOrmRsqWXPmEnOgTAWjj3lEQ0Scy7GSWpQCk1iEmQfwMrO7XglBkP",
-	"8Q1ZwVQkWITQdTXsL/pqWTm+POoZeafAdxHyrxkEd5iJDDDISfB22VU7fvNy7Q7YenkScxaGqYaVMGX5",
-	"aMhDtmRJhrRMY4Iyu6OinK7WWeEmuZEeZeqfawhjjld04ae2qVPDYeEK3gA/pUm+YpVhmjWuQDcaMLf6",
-	"9NRRN0+bWnJFXi31jEZXg8pF4aTBTO1hsDzLeRKqVVB8q4Y7lIs1ohYUssJVX0Lbhrb5flyo7yls4G4d",
-	"7Msj2szL3uu3W1f7cmBUYvPfaKs5zv/eKEEBb1+LNSlKMwlKvHYV+dHbk9OSvHHMTcsEvUzYZrpx7PMi",
-	"Y8HCIqDKeHHy4lV1rocsY8meZAkzuzC3BH4Q7IN2vz4Kc8443Ukar6honRA/+7lAmwOB+NgOH5QuDdDj",
-	"2IG8gPYg4bE8aSNGhqVHIFgdq3AtzAq4UJomyYaWCxi0YwbbAPytcuF0Sx652mK/ZzBmcDlq+SNbdyzn",
-	"7OwHkuWXCY+KUng0jrex1sTvBO/cOLayKsrdHPRZOXNoYZA+tH1F8BkYHogv3GJ+w6IEk3p3kCFAzkBq",
-	"QxA0WsAyMEr5aGDwcUu4LyQvwUjf0nbcNkY4bBfijMsYV/BjwWBclEJdLO3F5PE3X399MYF//V9/+9vF",
-	"5FGLAiIkqD1nSnPhmBC97F9tOGwWNwxJbHtGCOt42sM1/QMPx3lVv1eDvSp8jher9MfhW4p7suGFuadI",
-	"qD9WzFCDcIcSncJf3a/EdlQBP21w20AtcrNkknn9izTOmBRwx1cm7AdY/V5BbFv+w/PzEt4tagLLMHPH",
-	"q9YIIuD16iKQkTHhTrZKxMWop2zBlZbrI8lig6u0P2Pys66+Zuw01dGLD2D0aX/SoJUvAUHkHLCaH5yO",
-	"btCVfVZOF7qzBWzcagc4qlU7lHqMYqx58GV8a2u5OCeTSnOnhQulW0hFmWx8wQSTVLeYSaKGZDCMmtUk",
-	"CogJsK5WwxQ6Qcc3cH5Sy/PUh23hgqVl3uWBZXqiuJLzRIdwGNUsOHKIU6/d2/Km9FzZFq/OeovKtU0v",
-	"QUu7wb01aGmvyVx16DEKjVJx8o27oTa7DG7W8HUA+z5PxTkPaiD5ypb+cUcTl1E1wyvrDXEmLds4gr+N",
-	"bNHpFVEgVQGSfn1SEB3D1ZnSRWB7yA2Zb6XbzL/TS5KlsSIPy7LT4LSHIRbgGOYil2D7WCVguGDTmif9",
-	"h2qWdNuOcKyliT59EHbheSxbF3mSLakK7xy+tLgR+J1bDtZFRpwwEWNeFQAa/vMkV0v810u8EFws4PjU",
-	"ZDqpJD128Y1HVEQsaYuPUZpKPRzZFcaCDEX1bgnKl/pCrJMnaPbp/gYzTf6YrVIG6kriDVxml+iy41mK",
-	"7Bjkcu0caMPqlLCp441n5vDXPNjGMYw/excUpw5RlKJRlOZCl4J1jys2CJwdPA1+L+uNFLBKUihPs9md",
-	"DsPtnTVwbmgF/4GqJYurhnC3zrD1bcmSJCTQoqs0uvv0j7KpVqc+4lBwhXCkFTNO8iQpo2vKCv3H8zep",
-	"PkFRrFGr33F31TxUD/w+D2bkn4aaKAY49eAwuaFr9WDq0UCuwA2cxYRdM7kGX7harzfmS6UT+IHQxFDx",
-	"NWEfAHSi5r3vaCrOOZnWNwOjDkxpZeBTjGP+qI1lfrLjOZAGTDoHrRadXp4VR3P51wfaaCoraUsp+LxS",
-	"csPK4sjNvT063oNnmFOhLeRTSajUfE6jgNtKVkGj3k15WAc7cjVjulmS/oVhVE/BKKPNcEXXhkbTBl9t",
-	"OooUabqNm3x7dFwMBk54QK6oIvZVSuWqYFJNWxzIZYBvc11vmMbdfoMnJxIu7sHGCNOG3gen4PKtiE6C",
-	"G8qaeqsp87R10y27oIEGSGg8xEOlf5+FUcM+hA36MtgrzlnThj1nu/J4aAVcKPX63UYaB1K/h/hUJmUq",
-	"X7fx8WZ2aFGw8LZ4kNMuGlEil2G2IJV8wQVNinpsgzLuSgbKjzzEdL6pZFtBYqqpuirL8JvevKLFGJT3",
-	"pAKF+sr7Trc1r/jdH3QzO/8tnHnmJvmjnP4NVe7gySWbp5LZKOsVlVfovpqVgLHi7yeiiLfQIfjyY37J",
-	"pGCaqTMWSaa7CeeuiNZ0omC2oVFH5SoJdgxEVpstb+keSLXnHogTeIIdjNyigBwGkHLNwQFURqOOUeBz",
-	"71Dhd6AcfupBqDcW3PYuDymEOhCCG7aRlQ9pzJXmInJxtlNrj2A0WhIOAX/KWhg1XoiLyRVbfwc2o4vJ",
-	"7EIYDP9AV1nCzMJYGQDyXSbTOI9sDV7D76Xiu1ztMar03hPI88Tkd5c0umICyM1wUbOaCyC0O9OAuNQC",
-	"1gYIv6E/ZXoN8Rk2m2tpCiSI28qIjOmcrKiOlhjJbNMr6mhZxh9gPNDhm+csnpEXq0yv90WeJLXZFXYj",
-	"hou1hZVqN6M2ah/Ne11v/3E68Vb6SeXXVzQzG//9iq2ncMYfMWgnXD69iXLOpBcUoM0Xr4igM+nZIIe1",
-	"0EumeVQeRxlQ4If1GMzF47imkqe5KlIWwDLUjByWZksjV0BEAnhIpljq6vfS+2pK3MI+hnVYXOSBq/8a",
-	"xRWDP9wr2Gr+piThK15IvGXeNUDvwqkZo8SsXpPVKt0zCYwJpN4GCBVqWL/eK9SIpL/mrEj96Tw1dUq4",
-	"UvABRSebc9JJRV56Soqx45CDgSskC5CxyLyK12iYFOyDdnelVAgX4D5CMIHPqXlbFFeg4YOxzLJshksb",
-	"TMscyOxOq87lZt8uesRwEikYR6kRGefsxsXY4ZlmVClz9eylhRN3xnn0ZS1cyEFriiFgsE93tLXSuTzG",
-	"Ct2Jg5SFtM0ZwqXSLs8+m5JcJEwpsk5zXI9kEeMFKG0MAdSyFlXGqMVbfUW54GJxrNmqhZOpp0e8VOZg",
-	"jZQNyGXXCYDHB9MQKAN+605ukze4g3ZbASNf0dMhixPFY0vQIKE6hMg5ygZKnzqeF/twi1IkF1civRGA",
-	"pwhIM4wDesLmmuQCLo+ISbri2gsOVExymlhTYHWhXgY18tBmY79kEc0VIxw+Q5aRZS4giC4tvwIIbF3q",
-	"hCrb6FG5H8ks6BAD63vCjRQxg1vtxOWQTZMYFNZUkOsnsyd/I3GKBlemvTkQyw3DKcwx5sqLXKjjjdnZ",
-	"X5nSfAXWiL/ibeO/2TwtLqjfLAJLshfJh828kgGlbBsbHcKBGsgi+NLqm4akkGy8GbXnrMnUBgOAzuHk",
-	"DFpesbVPPe2TD4oQUBGEhQyIhWurxF0E6JW+qkBA4JWtFUc8NtzNm1TDf198MI/TZDp5njL1JtXwd1CU",
-	"wozMLfuyvBm2MWtYuSSdW+qXDQi9Tb9vgl11MYnIwJSRlcMNvPXDNawKF8fY9UmTs8O6uK5u1utUcJ0G",
-	"lGp10QKa9YvHfmSP7dTPqfujvw8FZA+pAObvBEKxPQfwpmNG8c3c5CqbRJPEyKTwxsZhVgkpv6X4CnrY",
-	"t9o6ZkLb0jWz5vwmRKpLf40tOcmyMZAKG8HVdGMuyUBki0KfGxFd01VL7CwE46OHA/iiGRHeFsUYbu6P",
-	"WelTsMlclszHbEP3AusqwVtKb8MbHhVvaMUTjxaKa1KO4kh/xTlrRk7SLE/QI2PtGSpn5JTReM9wwAOz",
-	"CSefKki8RjHCOhiCpQwZdiRoEAFHhc+vpnJBhXmiTDvDEi9Saf58qKI0w1+Rtj8qGM/J1nFqHf6XUOUl",
-	"dEqeJyTVJL2B5BlYdgl+NyKKEY65iPfNXBcTKze3MHsVdjUYyW6Ze78CO/Knc+7sQcBCPFBeLQDL/vb4",
-	"mYbeYaQ6p+12nsO61sfP21J7sscc/LvLwT8Mp4uziTuPvcIVoGttq/H5Ld7JgnCNBTLGUjdjqZt9/1oE",
-	"83l2+q/3XbSwwrbeohrW4H8dS9ncfymbxnkMkpUq5HYsbPOlFrZpkI/Oy24DMpzO3Fw272sg/SpXWULX",
-	"4ez54F1LCu9aYB/UMr1xifNkGFbsA17P4wD6vbDfyPHzgruuLXAA73lCdbQ8RfypBD9tkO2hN9sSePFj",
-	"uiFfgUNjLKeYJWiGw8KKkGK8RXcTjuY5JP/n7O0bcpICNYPwubbsDnkLP4dX14YqpiAJmkXNGsiXZl0p",
-	"CeuEo6usT/nN6UMtGbFhhRU64tX9wVbBDQ4oXH43Dg4dCwnnzt8uXwxIZjdUFZRus0z23qyhwzxlCdX8",
-	"uiWzzqmfrUHapmg7dwg4JOvnYaCv0wE7JcKbVFvhlwrr1gl4Yto7zUh6zaSXkacwDE+UjPa5iNmH2b/V",
-	"MJJQSbAS2nfx1SGuw5FauhMPIRZc2/QhwfM/7Tj/SrpSD+YvufZxAdwVMeeLn9xk5MdGyWmUnPbLS7RZ",
-	"DhOv325zmJQDh8Wu6veq0HXqpQEZ7/i9y1yydhyDRC6P4o8C15cqcNWoTsclrwtbNd+UKlMxLDduPZt9",
-	"b15cP91dX+MztSzb9my9JYq43mKzAjFViHxigZbqYJ8aTbtZoRRnpz1MmNSnOYbL10UUbwdNBnpZjVyt",
-	"1VKCDG9m7HBJybzNOvjc4Z7jcfkKuWzPIZZeM2lEslxZKa4I/rY+zTCxkdbI93CeB91p0PsTnHclN7+4",
-	"iP+zLZ/5dJL1VJitVpbFHaFzh+SLBWQVCkASDafotnzNJNf9Zaj88z6znTCXY704kxvRO6bKPqq2z17k",
-	"qkzWzMFrvzZwxokw/6RSYCDckeTgqjaZTqB+ybBYuda1lAO3NvFmbG2DS/E2/WPwET0t3kVIo8E+GOE9",
-	"JtecwrYPT479TR8Z/IXYLXbGF2aZTlc0nZR1dcrfsOLSxJbFmlQku3JlZ2sRTaaT89byf75kWHHjsIq2",
-	"Uv2AbrxZZpof/D45OnnXSrGyPOQTMp085+qqtYIUV1fhXugv0+p90+pN87Gg1lZVWHFz+Tj0dWvZTd+7",
-	"1bWunlpaLZAwr1+r007zAMOMwJkfIogUD5ujI0a7uZu6VyPkRWWeI3gtE+DLTasZeevckfHXDJyH7dXn",
-	"yhW82ICPrT9foWxydJUlXCzaqygVr80l0zeMCbd/Al3Nuu/gASlKY3RUxWg76ql/FIEdd1FnIAethMp8",
-	"rWp+Ks4Q4Flu3ZUxNtFGuZZawhQzSINztSV+IP3x0Wo3aolGLVGTmJkrt6meyOu5a01ROXR/zXKMYOgN",
-	"R8Rm4L4FsVLOc48rUqE7iAGzoK+eOWiuf6BqGc6i4ThJdHOHxndX1j4ItfbQ0l6AQSsFZR9yQ302B1iX",
-	"JcYD5bRyhJXl9WGH0ySO1Pye9YG281pEG/NRwAuMGsEvVyNYe2E62b6aVtAls32oHhVMHUZ5darDesot",
-	"Yn3kRl0lLhrVG45Ny6IF5t0pO5TXXlMuMOwxxG9ibQaRGtRxvbm50y9otLTB19WhbOVZO4BZsM/0dt/V",
-	"u63EMqRkpHOdL0pHNiF9WxUjA1xKN/5toZj1+3+iapZuR0o7Ex06DeURvLht0V0Fw0KWVC1LPwuzjpZ4",
-	"fzfwy46Ii2JwL6AiMPaQYLYtNMz35AlTmTzIgQl28zYc/AA3lN0QiI0gD3mRZugyweIGIk8gJtglUW2q",
-	"fc01S3PVMYFr8gmz2GfueyjO3FUQAYs3w5EzWTyPJQkoaUuB6g6SGB9ShMhYiQH/M3PRh+7vQMn+gQF7",
-	"Fb60uq8gcmGReNR7YmKEsDmheMWW6Q28XtC2qNJvsMkWpze779JxPqM6Wp7Z0KX23Mt+o6bSUWlJNVus",
-	"h2scayN2AMPPmFchrf5nZ1exmyYZ/ury/CcslMrEZuDDy+TV0e5UXOZl7cW4eUydTGn4cD/C+cgc9vUs",
-	"jxesfxH19lC2DOpEnS8lU8sUiwN1jeE5E4b9tnC1Z+5kg5fdnTsKwSmPML+sq9fu9mhuZPVkfKJWRYXQ",
-	"FTtTyx2VhDw7+6GrImQm+TXV7Ee2PqFKZUvZmm82K76jilQtT4q+f4yKjpUl9VZetDsHAA0vvhhCHN+Y",
-	"vZnnr/KPucdefktV3sz2a66AruZbV623ripn5a5CRK6NcbTMIkcNtc6lsNIolPOnSWIfvjgVD1yJRYJJ",
-	"Qry4ylF3cbu6iyhY4+UsXywYxHWDA6k9HNPW5pnlLtfNlDwmfO7SRNS51a+eBjWFo/Jip8qLlix4QzxB",
-	"SkkN4egiFFpkZ6rCLicrGi25YK1T3SzXtQnMQVsu9wISkueSXUzsemxyFa7K/EJslem1zYcC6VSqomeZ",
-	"leiQnMIySZRQiQHKzg/abhbQ+DI3lIdhYpb0mknJY0ZaNNKqm8S5aJMCeOQtpHc6IBeTM2RqLiYklf5O",
-	"bx1tDNu/R0W8Z0HaS/JDOiy7cUsmCgwokS70IJyfvC4fwdoDdfK65slWFGVyZTIIXbCgq3quly82Tb5u",
-	"5jMdMZeSwzubfz3MdCDn15Ee0BJ8M3SZ5PXT88Sb8WzF/941Kp1KumDfd5c51ikOio07yl41T7DmiNI8",
-	"x2qDqjnaj/wnTvAc3/LRqjxalaFH7fJsZliud96tbbk2ejgUIdCoGo9QazDy8fdvgwwdySDlef0pGE2R",
-	"X6opMkSW+u5+I0yh8vZbJVo7CwBazbAaAz6Rm2WqygHcfZ9jTdd+lhbHH7LZgvYOK5dgNaDh2ggbhxts",
-	"WCug055lsfpQdyQSqySxLoB7QxUao7zg4YGFnAYbn95Pe/BpCwNjsQGLezM4X75i/5O6yg2uTM2rFH3G",
-	"A8WsfksFK5NaSWXdSGG248M3hy4K/fD0xeH+q7dHh+fHb9+4HNXmx2a5eg4J5lNJ0ohRgW+I61kUMTSN",
-	"Myo1j/KESqK4OQmul9xaAqlkdApSkg1hJocrJnlE99+wm3/9dyqvpuRFbvBv/4RK7hx6c0FXl3yRp7ki",
-	"X+1FSypppA3VdHvFjNFW4GAxeXgxefn6HKv1vjs/aivWi5aGs2jJ4jwJVokpX2xlW8Hqaa5Tc4wRidMb",
-	"kaQUMigbkCC6KT+xsuYr9zV1pSE1WjcCvESvseFIpqKa+RFqlb2UNGLPvYCXoVYT7SFX59vp2jVodJgo",
-	"eSxRdYvXbbzSS659khvObNhyUd2g7z9ikv1ccr02J7uydRgZlUwe5ihf4l/fO3rwf/55bvhIaG1kU/ha",
-	"TgmB7VBBYRFK1WEo0bt34Twdlax2ngmUkNc0U7awj9+hzEs5c+W9uJkE8qu7pGIHZin/4p4BgGb8R7ae",
-	"fDS7N0TGpdmmEeATW1GeTA4mmtHV/y6UEzOeliOaXWB1T0g8LdOEnDO6mljl/MSxD5XejQSFP1eHeP8w",
-	"1O2R5aTwbK2FKkqoxEwJKyrogq1sMTt49YA4snjBCouqTXnMJblJ5ZW5gQqT5ic8YgKtRHZnhxmNlow8",
-	"nT1ubObm5mZG4fMslYt921ftvzo+evHm7MXe09nj2VKvErwnGlIu1IB0eHI8mZY4Pbl+QpNsSZ/YbLiC",
-	"ZnxyMPlq9nj2xDqvAD4abmr/+sk+zfVyPyoUTIsQB/GS6brep1ElsFDXGQydGDy3WivIiQjZqGHep48f",
-	"18oIeqWN9v9tNaJ453sLG5WzAOLVsu38aEDw9ZNvdzZfIR42E+zn4HRVVk9kULj766d/v4PJz9OUvKZi",
-	"TWygFAqwmi4gMKx6cEifKod/TRNu3ozW4//JNjCkooYGkAs9fPyuFyCdpCummVTACjapV2hUQ5vc0goq",
-	"tGQ0Bsrorlaul6l0ubWmHijr1Pr9LeJh19GYncA2AB/uZNJnNHaogJM+ubOdclHu9U958aaTv93JGbsa",
-	"T1aUJy+kTOXgex+VcZcK4y6dVN9KBED10RqvWXUTrRID07O1o+ojD5Av1zKtRUNDG7Dgi/NRhTqYhf4C",
-	"fSL8mhqWNYIRzACQKhvTn+t6oweuiMQDWwbAWtYKT7ZqjYUWDqkoMtJFlaahrNE20z0GlGnJI12WRgAH",
-	"eFv7wiX/xqTQXNpSP9XCoFDesyhQE1poUim6c3erRXe9qZOaoJKDTWRvQHzFyIPvHkzJg+/M/xp268Ff",
-	"vntAHrLZYjbFakVPsFzRk+kVWz/9C/7x1MpaoZ3CjNvt1GDSin7gq3xVKYmBiFds0i/UURbhOC+LokBC",
-	"LawA0Y5ole6Ez6tYDhm6cNBatZMVXWOhi0tWlvWHYmfFxYHoRa++CECoFTP4ChJrlXDq9Ua41Xe2lYqA",
-	"Zr2dBfxyX913gloOyL57j7+6g1m/T+Ulj2Mm7v2pvYvdnlkx8Z0o/CIqD23rYwoB6lkaMvocYXVTOuBF",
-	"bT6o2Lkrd4JdwLM0Xt/+5UOYlboQLXP2sUEFntzVQkKAjkcycOtk4PFdkAEj7Scc9Xsj4ekgPIOY/f3f",
-	"zUP/EclTwnRAA42/VwkVsdeOlASnSqCeQ6cuAtWrEfADx/pppOE+caUFKwNRWgUnY+tqVonUH09d4PiV",
-	"Pw3N+PoOpnyTavJ9mot4JBq93EpQ9JeMYnm/UqaIOu52lRa8ZPqOCcECHac/nQpMJ7ngv+bMVjUDvuZ+",
-	"5JuRVoy04o8n2VAdhZ1lo+WWkg30vWNykRUlGHfFNgyVvfZg6v/c7DQrFRUGSV73TJ9GoevLIoqjnPcH",
-	"I8N5kGWDAiM1ru1oMNd2iv3vmBSX2Z3unBbfmR7sXqnxqIYbX4TxRRg1f07zt0+zTKY2ZWzwITmEBpi8",
-	"iol1F1/fZOfRvbW1w6GbfGePCVZ19xc8PiYjaz8S8pGQf96EHJ2OKeTOUfuSqRyrQIaNy6fwvfBUvqSK",
-	"xSQV6B5UeuxQEe+n1g2n+HUWEAXMaBiio27Jtoyj40z3RACrS8BJRto3upTcC1mo3PfJ++nkw568pJg+",
-	"yaYWs6Fp6F4PEjRYa+GWOgrxsUlDehw88Rb0eXOWxGB03RxdN0fXzS/EdTOAIzZLCpkndGHwBIMKGaYf",
-	"NqtZrahcVyNv1Yz80+wEQJUSkKxc8BmCBSBZyWQMN98O5sWo2vBLADgk6HyA2FTB+wcljOphmDdmHQ/s",
-	"wGaoB5AXUuatV99rG8KyImvMrdqBkb6OTq0jB3LPHMgQD9Yay9DmrlqU7ro9+eGuHVH9WUd19+h1+iej",
-	"DE3ZYoA/6XPnT9pLNrBlQTY20hDXBh/dQ0cV6Ojytem7354KoP/yvmR6Zzd3Z/6cd8G0j9d2vLb3zK53",
-	"u2X2Xl1ouLPLO3pX7pCAjJLEaG8dhZdd0cmQuwt6rAwhk9ZDcmeE8rPwfdxEz3J3hHHU6YyUeKTEX5wa",
-	"aT9mUCVQFVkcQxS7SItZGqBQ3eP1baqWyo87VDCVg34WZNyHwsjrjhR2lNDvmd4lVGnFmOjMw1mUfDct",
-	"IY2v0nSVtRCmDs3cK6r0mZltJxq61nXN0afw89DeFTDp4DW/DtSxTyEbq1nESEZGMnLPZEQyETOJxZI6",
-	"yYhr6GXMb9CKU9tml9r80OTO6Skq6ljtimpMW8tEX4n0RhQL+cnluw87BkHj02rbyR/V1jBSqVGcHOli",
-	"jS6WFaE6qaJfDGM4N3XmSvuN1s7R2jkyQX8Ma+fG19mzfe7sQo8W0FErNFKykZJ9ij1yY0JWsU7ujJSN",
-	"NsqRdI2ka5Tx/kAyHhMyTZIVE3pADauycSXILCTVvSiaFmWsBlNPOjDdFYbBQjk/QbhSeTWxKpTtz2R6",
-	"zWMWT/1qdDaAbsmiK8L7cqLYODsVngTi6SB2kSsSUcWKED/u9HQ2PrIOEaj0SpOEpFBw3fSd2uKxBZT9",
-	"iTBMElZ+yQhbZbo1eDFS8t5Ua42DH0n6yI3+SQhseXODWUgan3uSCZRXaWCVqEaHMcXAmGJgTDEwVofa",
-	"8OUeq0KNAfR/xLe0L5ZedDyZbXH1jR63FGLfnOeOo+1bFjA6aY+B9yN3HuTONwjH34zyYK8Q5dlIw9w+",
-	"5RiwP8rsoxr2s+Js2rMFbEZbKrrXWyEsn4mHzSB+ZyQwo1LwfgSZziwDm1156HTLl370wrkdwjPKWCM7",
-	"NbJTt0Bfu7ITbEZerS/QLRPYz8I3aEsl1r3Q1lF3NtL1ka7/+dR1W9RkCrwHzWfA9rqFZ+Czq7rU2EJR",
-	"ieq+nwO3kH6V4kigRzXDSC63Cuv7dIXkdh71o1pypBcjvbg/teQnkYGwkvI2CMGoqhxVlSMFHEXaL0FV",
-	"+Ukkt01xeRtEd1RfjszfyPx9KcLitZmnVSQ8ZVpyds0UoUUgAnaZXYhwYAoO2BeM8qeJdzhLpSapjJmE",
-	"8EVb3A/iD8yGXPK/aqzJAzPGA/JQsBtDfedcKt26OBi8sqgYh4IqpyqaTCdM5CuDDBT+gh/fT7eN1cDz",
-	"x3MzR+SCLfrieHZTZ/GLjmK6VW2EObYxzmOM87i/p8hgYPX5mSeM9cVGfm/a9MVDfo8DjTGQYwzkGAP5",
-	"5ZZZPrYZF9rqKbtNA11pWwmNbY5WdYaD3F/5YiBb46M8Psr39ijDTRlSvLj6DLfFWEKrW4qrxLHvOJbS",
-	"m3T0ARvjJ/9cRKHBqe//Dv/9uK/ZKkuoNgyh4qloZ+GB/XCtSdE8xMOf21Y/lY161dbpjUDuybz6jWla",
-	"lNRzj0htmRl9lCRGSWKUJMZsKobO1ujWyM6P7Pxn9HIPSH0Q29QHjQe2Jd1B7UJ88jt+e8943fI9cOYx",
-	"p8JoXh7Ny1X1QZD7l4zGyPoW734vDXnJ9EhA7pKA1KE9UpKRkvyhOJfBuZl6lZTY0CkpN3KKqw49pl0a",
-	"L/Z4sXfBIkDio96L+5LpHd3aHQYP/TnMkyPZGMnG/RomOxMo9ZIOaLcj4jEGHO2Odox60DHIaDTT7ohE",
-	"duVA6qWQNnpoRzTys4gP2sCX5M5I4ui2MpLgkQR/WVqrvpwboCAvwz6rqnJHkMOi8HaxnbcqEI+y6CiL",
-	"/oll0Xrt2eGS6a7u8iifjvLpSMRGIraFtChRCNyQGfFFx10RsVGAHHmgkXx8BpIOX9EFu8x5EveE8B6b",
-	"hs9Mw7443rLlGMw7uuCPLvijC/4gslaSjdH7fvS+v7c3snwQB5UwDTyLbXG1ZdNbCq71JrjjCNv6zKO9",
-	"Ygyz/ROSizBfvVFh0kH0BJtX6MlG8npgktEZdpSiRyl6Gw6hqxTooNv8kumdX+XPxCDYzTeMd3m8y3fM",
-	"7ffU+Rx0n6H1zm/0aBbcMVUZBZHRcWqUfXZJPLuLeA6indYWuXPq+VnYIzfV39wtxRz1RSOZHsn0F62i",
-	"6vN0Pe3ydK3Q7A4JdzsXk1HOHanOKOfeiZzbqGK0jdS701s+yr6j7DuSt5G8fZIketrjHNvBvzSk0p1S",
-	"t1E2HXmnkbh8fvITOmQOqrsWc6W5iHThOIl9i3JiJRUqCcM6Y20F2l7hzAPIjxnF+jIW9EbahRWLkOmq",
-	"zUnwiou4k/y4smSY7mZQSbJDMueJ9fOtryUVyRo9fQsnY6KX1PfmXfBrJrB94aB6K96vO1glOn72rXLn",
-	"nqsluuF676TO23byM/tAV1mCPXC1L/AXuNk2edLBxP5Y+s2am5O4awAOslgp8ZrLVKyY0N9lMo3zSGPu",
-	"SckWPBXf5WqPUaX3npgNcCa/u6TRFRP2Yg8jJHD5RhfV0UX13h4kwPvqW5TKBRX8N1jHZqVAKz1nhLw1",
-	"tA2phap+RBJnyEeumCRLqgiNIqYMfQlHgrytrOoWeUR/ovFqjlfzzq9m+VJBsFRaQ3x3c/3fqxdYsixV",
-	"XKeSs55ArFPXct0XiHXqjzlGYo2RWGMk1hiJNYD8lRRmfEvHt/Te2NziSVwPqW0YeBbbArHKprcUiOVN",
-	"cMeBWPWZR8eaMRDrT0gtWhjrTcoQDKIn2LpCTzayCAUmGQOxRsPMaJjZhkHoKE0w6DK/ZHrnN/kz8U/r",
-	"ZhvGqzxe5Tvm9bvLBQy6ztYLa8cXenRF2zFRGcWQ0b9/lHx2STs76wgMIp3W323nxPOz8HTbVHlztwRz",
-	"VBaNVHqk0l+UfsracNci6rX8YtOztYj6bb9l29H4Oxp/R+PvaPwdyBSUhGM0/47m33t8MMuHcZgBOPA6",
-	"tpuAy8a3ZgT2prhzM3B97pG3Hw3Bf0q60cZqb2YLHkRanDW4Qlo21JsEJhotwqNYP5qRtuMZOm3Cgy41",
-	"WIVv4UZ/Npbhbk5ivNTjpb5zQaDPOjzoYlvT6C1c7dFGvHPyMsooo/1hFIt2S0V77MSDiGhhKb4FMvqZ",
-	"WIs31fLcNfEc9UojzR5p9helymJScVxBq3yr7NC2bVCu/cmOc4skyk3RwdqNlpW7RiuHP++hLxpN8aXO",
-	"ZTI5mOxPzGtpW9eR663DIsxeZCghE9puYVY+0NUPk6a11xsoFeSISc3npjU74wvBxcLCrero4Oz+ZWuF",
-	"rWXxCHTPg3mKgoPGmMKocwSzZWxHaIQpM+oD2N97V/JCyDRJVkzorp2yotWgHZr12WxFXCwIuzYo4w9n",
-	"fuhdWrWes98fK8j29W+rFWsH8RJqbbIZm8yIRjJVisR8PmeSifA6MUXKJqP7KUSCQ1ZyN/RBoC1Jgx3L",
-	"cwbqH6nN6acYy3skBuw4Yhw2HHgh7IiOPnx8//H/DwAA//9GG7KwccACAA==",
+	"H4sIAAAAAAAC/+z9C3PcOJYgCv8VTM5GuKo3Jdmux9ejLxy9KslVpW0/tJJcfXdK3m6IRGZixATYACg5",
+	"q8MR9z/cf3h/yQ0cPAiSAMmU9XC5OBMzZSXxPDg4OO/zr1nG1yVnhCk52//XTGYrssbwz4NLyYtKkROs",
+	"VvrvnMhM0FJRzmb7s1NSCiJ1N4QZwrYtWtCCoBKr1e5sPisFL4lQlMB4ZXSc8xWpe+smSHGEzTicIbUi",
+	"SG6kIutd9IYrgtQKK4TZBpEPVCrKlqbpDS0KdEkQvybiRlClCNMrIB/wuizIbH+2d43FXsGXe7gsdwu+",
+	"nM1nalPqL1IJypazjx/9L/zyv0imZh/ns4OyPIffYsvWrRFfwBpxWRY0w/orzMuq9Wz/VwNcSWbz2T8r",
+	"nBdEzd63553PPuzo5jvXWDC81rD61c176LvbH/6XG8WszU15yJkiTOll4qJ4u5jt//qv2X8TZDHbn/37",
+	"Xn3Ce/Z4936kBXGdPs77256SAit6bfBANxbknxUVJNcLhUN934Fca30v2fUvWBgsaOAEqT/gPKe6LS5O",
+	"Gk1apzRvHcRLdk0FZ2vCFLrGguLLgqArstm5xkWlMYoKOUeU6XWRHOWVHgaJiim6JrtIn+MV2SDMcmR6",
+	"EJyt0LqSSqPTJVE3hDD0DBo8/+4blK2wwJkiQu7OOttOoJADw4ng1zQn4qwk2fizisDx47wNSFwj6sBY",
+	"0OzjfKZxLXEd6wmRbuWh8ez//b//nyYMUMHZco6kwkKhG6pWCKOCKEUE4gKxan1JxBxgl3GmMGWIcXSz",
+	"oorIEmdkd9Qt/NeMMzICUMdrvCQpcA9h+TErKEv3fv/xff/ZnimsKhknFuabJhUYScqWRRPGlszl5Joa",
+	"kDjqcSJIiS2RONMgNv88rRgz/3opBBez+ewdu2L8hs3mM00xCqJIPp7QNHcQztn5GCyi861eVeeTW2bn",
+	"Q73uzqdgI01A/8KLak2a16cJ7iOyoIxIhAF7c3QNPVAlSY4uN/BcNal18yrFL8Y7Rv9ZEXMfLM0Px9W4",
+	"T1nsKejid0g/YbL3n4jzBiQdhI3BrU2Cmls3O5Ld3b+iUgH+1uPZ7QMZpIqs5Qja0zrD+q5jIfBmkH6a",
+	"bgY/+m/ZnRz5m85ZR85TH+eCCMIyEmOS7CekuL3jZcE3JEdvD493NIwKiplCVJ+ippj6ei1wptAlzq70",
+	"Q9U7dwyXwvUMkCx5Vq3XWGxGkq6iCIEo02TrZ4ILtdrM5rMjshQ4J3mEVG1NnpqrredINgkmT7aJUKZm",
+	"A79cDbpKrQ45W9BlF076m37jFnTZRS9cqdVbscSM/mamqEfpvTCJbh/nMGL8wGAhGrJRXNX93p2+SnR7",
+	"d/pqGMv81PVo8+QOoxiYhkZkTUJznyRHPOxhIV2JxH0mTLOBuRlygatCzfYXuJCkzT0eL5ASFZkjWZUl",
+	"FwotuEDH+QkqDZ1sz0slsmMHgLrkvCCYdSDlVhEDwg9YEqDdp2RJpRKbQ0FywhTFRYS0BR9hhTjLiNSc",
+	"BMKOsSICCTtUTPSS8oaLvDvyif0Cw7oBkD5OPV/yFZvP5BUtz1+d/UIEXWyGAX12RUt0/uoMZXpVCz0y",
+	"QddEmH82J/HwnM8qSUTiPbZftlz4x+hZqCwimcLP+sQxQ6QgIGFQhi7hZ0n+WRGWkS6sC7qmKs5Yr/EH",
+	"uq7Wli/W9L4kIiNMAfVfWFIqkeKoKnMNIctSwJx6qnFMwYkfFTiJNWV62tn+M795yhRZEmEENUkKkiku",
+	"hujRK3xJijPXWHesAA/PV4LIFS/y2f74dSUP4sxCNnEg7jPKLZen4VNY9gTgZAB4SRD5QLJKkVxDMX1e",
+	"MjnfQXNcMyPIqOOZHoNbH+f6EI5Nh2dtrmeusRMrstwMjXbKi4JX6sw1b1McP06U5BQ4u+KVOiGC8jy2",
+	"3RK+6A0ruiZOVr5Z0WxlEVIiLAhiXBlWgORzuH9WzYIwWvGC5niDFoKQ3yLQbkzZXcGqWmOGBME5yPHB",
+	"Z8eIXdpd2MVGaRNheUJjo7eFld1SZDhEWA6Hu+BijdVsf6Z3vaP7xSYCufe2U0Hn0ZN1TlrPbLYaPWzO",
+	"VfbyQ8lj6zsMX097gLqleVAudVeUU3ll+NIIPyOyFVUkU5UgDdI/+/Dn7//+/bezNvU/x2JJFAr7wbTA",
+	"PzYmcjykHwjrTt9/2+UXPQHpU82194IUt3sNJ6OS65nWdDafXa/zK62uy/jNc81M4xt9FljM3g8dCXxN",
+	"noV97BcDQgJGS8KIAJbnNgfRuE7BV3d9mqNFEJqLUeu8WRFBYEQDVyqR7kviF1KN0qHG9jsC5I1VR+Ff",
+	"FVdvS2LQPfKshJ8tXYNHn/sf9cEURf0+ax30Gt4EjNzruYuOlQaDf3Ww3LBsJTjjlSw2RgtGlYYTiFSC",
+	"aDQ071cp+FIQKaFNLVQZZq+mIPWCFphaHrStBqS/ECHj1PXk2H5rvJ3X5jeSI/PKmNOgsECj4ceO/mqY",
+	"AFR30RkRuiOSK14VoN27JkIhQTK+ZPQ3P5p0kq/m4qVClCkiGC6MstUAZY03SBA9LqpYMAI0kbvoNRcE",
+	"Ubbg+2ilVCn39/aWVO1e/VnuUq6fyXXFqNrsZZwpQS8rxYXcy8k1KfYkXe6El2QPl3QHFsvMO77O/10Q",
+	"ySuRERlF3Ssae0z+SvVhSn380NKstQaZE91PX56dIzeBAauBYN1U1sDUgKBsQYRpuRB8DaMQlpecMgV/",
+	"ZAUlTCFZXa41NunrQKTGI76LDjFjHFS15rnOd9ExQ4d4TYpDLMm9g1JDT+5okMWBuSYK51jhIT7nLcDo",
+	"NVFY95JWV9XLZ4WX2Cp8Z9JrisZ3NV06Qm99ryxKBJuxKxwkPQdZnACdr0iL1pRlsdH/AFuEoQU1/Six",
+	"wGviLBCt+6/PH8b5FJuKXlAwkl6JJApxFi5oF51aAGkN2xlRB3WPiGlEz6Hxi0pHnfrO5AimOAp7fJzP",
+	"FgUhCXYr1MtCM73oNb8m4ZLhjpwDMbokhWy0fyI9JQceF+c5yWu1nTkCyQ3tD2bBV0QifsOIkCta6iGp",
+	"aoLmNb8m5/xH3Tx6KWAxfyUbGd/ZFdn4ldp1K44Egc0BiUgeyik0ArGtKbEkHuhaIDETfSIK1avFuQbl",
+	"HIGoXhY4I4izeXrhB3ler7qDSGqEoatx8YzJazS7Fnb9EdMizlw5nKqfaIwaXZOPtOk4jMe1ZjVCSKXE",
+	"y8QYgmBplbMxjqGfpbKLq6cYBJG2DQxwVbqJeS6dRN34LCdG5v4ZGX/545ad7oGM02+E3WKUZOKfHoF/",
+	"0odquKft+Bhz5IM3/mSFZYL2lPqTscE1uuyiA/QjkKCAJhGqVkSgDG6eBbyoGLwThl4ZbbdCBcEaTVnU",
+	"Sk5Y3jaM19bj+cxMq3/ELCNFzDTe3uBZoBHt7jFQ05q9EAQbDJdpSbhjKaT3oZBEGWLhRoEPRpi85GqF",
+	"6ML8Fwto3CWNC0qKPL3CAz+ps9BBB9l8VeTcuGvAIvWbIzdM4Q8IG5mzsL5VbpFfkd3l7hxdeGTZBZbn",
+	"BXA1eyUWVF7Mvk6zN9sst8mcfdpyJVXkBSxvrigR//aC5EsSX+nHIax3JvOed043sUrLS/sqRZHFy/k9",
+	"bL/Z/xrUCpmnS9kKs6W+RKCHWZEWy0ElygQBytXBGx6qQEbTdCu0bGEmiN+kjgLTfZgHCxukPCnbf6RR",
+	"XMESoUwdQGWOeBz06Xabx+f7zB3l0mwhliizRGe8Ulm/HZRVMZ6TIV5i7YZScklDrTgjH1Qt5OjtZkTG",
+	"3xCzPCNgJUQOZ59auIFIvoVGyu+SMvX9t/USvM3JrKESqekXVEg1csI5qkq942dPn96OZ3EMfoR1AaI8",
+	"GlRd5SDJG2+A1pm2ufIb3LiwI0CX5Pyt8WSnz3gCr3MUK0r3pI8GnGECPs5nHke2BJTvhyRHCyxGAgAs",
+	"H1vdTdsDCcMebGHe0fZNkt/DbfEjzxFlWVHl4esFGMTgM2LckvxR0GnRWHOoHUSOHFlkr21KMUib047S",
+	"g8qtkJ3zsr9m6AIFhvaBbCiZwL8oUBDp5rKCkwxULiMdjTo7CZfR+dhaV+d7Z6GdFq2VR2awW+l8aezt",
+	"43x2WDtUnNGlRvBTI9JE7H6ppoE0hbATiQzvjSRdMpI3/Da8YHV4MEnukwliMkFAj+Tt2s4ckR7mbk0T",
+	"yXni+rze5k3dXrLpRCweXc3XezajuOfkCJP274vV/vWTts75/k3gsiTajsYrliOMKknEjpVz0OHZ6Ryt",
+	"eU5ASmboqrokghFFJKIcgIlLuhvwG3L3+tlu7xK6hIV8KKnTQGSc5TLmzwL9TVCYpxnXuKA5VRvvnxQs",
+	"pM2Df/M8KqGQD0rgPtvZeDtcK9ZND4ywMshFvI5Mg9dIDQ7GwJxpOJe8rIwD9eUGfj04OUYSboyGPbSn",
+	"ViFF1+tKadExanUTKa7yHBxUJfn+2x3CMp6THJ28fF3/+6+HZ//+7Klezi567Rw0VwTpl2nX85qUFOCo",
+	"iUN86GNYDVVoHMnlRsXlOLpkRLyJ+hEfs9wgGaxJeJwwfazVXZOqf1a4oAtKcrDRRS9oRSPE7t3x0QOc",
+	"U7AIiZcxUfUd/A5Q19sA6kvgTdDxj6ZXsH/reUylrJrc/3aG5LQDdxhd8wCAaZFCh80N5NiO9CVUkTVC",
+	"4VJHEeBiLyeM4mLPar2cAxhf1LsMIiRlAu6ILuq454jFNGgav6N2yK48N68BZ/TKHuajbpcmr9S7nHQ8",
+	"Te035zDs+Ct7ALvorzq+BmVBQ0HQAYCO5HN0RBi4GWsI/eh1jOM4FTdmNNAsxIZgC1Ec8AOlN1gfX04U",
+	"pjZQgzOCsL5yyh13VgkBHIjSZ+p4V43UpwFJa4UUYKnOBWZG53tOU4G7ul2tAPObQsr3Jbnhi/S6LBoq",
+	"jjDjakXEeMVYUgn5c9N/27ZD1NwJo+Qy0MGXvFJ2xX55UYLGL+G65z8Zx9ikC9Wut00tfcvaXaeGhta5",
+	"SqLgzcpRVXLW2Hha82j8KmKa168uBSWLr53nhWcd3JxP5KidjhQQ3ahOIBznEeO7pb1h/ArmMZTzAOj3",
+	"DWkvryeksAGjubObnkNA1o8QRYRs/F2oINTfwaxbgHJzu4DC1ursWK1f3dCtn8NYwCY0u/ho3ZprrKOh",
+	"JBGqOy2lm81n5yevIZyKuphF98HQwNCU3WpqwsEuC9L+w9GUEywkND3bsMyMKejC2Md/0RyvbmuCS47Z",
+	"iTWWaeBqQcgGoJckc01fV4WiZUHegt8brLDtr2c6HZGCXhM4jnHn85IJXhRrwpR9ZgMwdL41oZB8qYMh",
+	"km08iJMtajeCVIvmck4JGOm42ERPRB9E8kPn2MKP/gjDH+vjBM2wOyj4I3aw5sCC4zU/hIdsfhl91C+1",
+	"KHtWXfprEJ69uTELumzHn48LaPuJqkj3oZQOf/WCxBnJBFG3yAdxi1l/VqqMdbMwkLwgZ0TKFDcRfAft",
+	"lMiNSn7NFRBL/R1J2wACRpxz6s0KXAwYyRFVwC+t+I3+J2E56DJU4B7guGn9tNKM1LZJNwWVbjA9Uu2A",
+	"sOa5kYLUSvBquXI8+aTbmwwBkyHAcVrBLd5S+9/se8cq/8bgCT1/p01Lud/4PnnufgYq/e6JjBWPg36T",
+	"9v7L1d43DvoUmAq9qKgUbaI4Leuhj1JfujbjEXFKteJzbEjzzQl8fuRQCsIyozTDUu1cPx8vycV29SNM",
+	"duDG++U5MJ+S/pbQmegvnaVBnoeNIvI2Xje2vZ10+EDiNpRum5ZjaYcX1JoOrdvwOtyoZ+Map4Lj7Uck",
+	"Kua0v3aOXfRyXVqTCGaGgOJM0WuCCr6kDMkVKYoo1hvm9E0y11w33qWxuRvs2VDFoxMotYkYoFYEPMvb",
+	"Q+Gi4BmwvxgpItaU4SKdeGR4xbpVyHeHMBsZafPGJG2C+UbgSkKnEmvlHWCBt+/cYQMQc4odYSGSUSdP",
+	"6x1DlWNniqzgUhPjd0wSSIdQECt5mDbUrWK8+pF80PJgnliN/ooynpNaxWQQmwuDqHNEGwhn4GTEpkuy",
+	"4KK5wGAT4+x/IqSw41/gmjA7L8dbQtwg46ckloCJE9hokg35tEWJ3E2HnaxEzZxNQEvKSq6A2upo/5hJ",
+	"oy8n0mk85w8KOj1IIqQHSVFUiWIUjEdl8NKDRQ+3rJxK6TVnVPFIotImONem2XCK3JoN5Mh2Gl5nOHo0",
+	"RWJ/1truTowORnD28kMpkjoYwRkivoHjXvQZ6rHzqgAGl66J3L1gAX9DJfrHn5D933/sox30mrJKEbmP",
+	"/vGnf1iXcIme7nz3H7toB/3MK9H59Pwb/ekIbzTQXnOmVs0Wz3a+eaZbRD89ex50/hshV+3Rv9+9YGcm",
+	"0ZmPw+J6ETu64b63zmszo2HqbSCNHoYytNJL9uORayI28NvXet5/7PxjH51itqx7Pd358z8AcM+eo4PX",
+	"+uz/jA5em9bzf+wjkGBc42fzZ89ta2nUV8+eqxVaAwxNn71/7KMzRcp6WXuuj1lMu8eZSbba3Mufa5Bo",
+	"CvrnoMsFe2kyGWnIoac7f54/+37n+Tf2SKPX/7CSiq+NUvSYLXif30fbbARuMUYQzlEGAyF7wewBJHiq",
+	"NpXxg1BmkBEs4mBhiwYS13f+KBGLfOSfxtovuFxtJM1wEYw3if6Txm/S+O3VxpPxmj7b5xYavvfJe9zJ",
+	"otxN8RtnVVp2+1DG6U9nrJeN881QZIxPUildrgRBYLoNomzkNCZfWsSRyM/i2iDnMuAt8fHRm1FFI84s",
+	"nu/74zydOLk2dtsmPicxXLLWum6XR7ntB5BwcvHpgfV5BQD1mx+FV830uNF4XtPA4c8KMvW2kkdHsgc3",
+	"0ZTap7QXTcPXzviVOMoH3hbBfHfjedGfOzkiSQ1AVSl9BnGPlk4TQ/DPT16jf1Zc2Rh7A74nEp0cnsq5",
+	"8+3yodL+Mw7GuSKRDLFEE9JXPKEUPD/8CZ0cokND/KGt1vyEidvm9mKbp7vAG+Pqla1IdmV0U1Rp5iav",
+	"MqsogW3keuXurR3jbcY4S+ZO0Z882Qp2nK1wURC2JLU7kIEhZvKGiHETl5n4BZYZn9xswc1e7006Anf2",
+	"88HO8+++R5eYXc3dj1zkhnT5TuYsbdDoNmTh5PAU1hdT5MPIiaM9ef38h78fnJ9rXkEqUcHzigRRlWCG",
+	"Uukmf/9feojR3rY4npHQTnj+97Pjn94cnL87fXkXU7bpHGCI23S4nvAQR13LQ4c4I+6nb2suqkFG63PZ",
+	"stO7eFDzi40AJYApVEkTzF1fdBPPfd1BXuDRiFwlnN+J7A2RxQtFwthUt17EOBT1ICbLdKm2CV0fvJyK",
+	"6/cPYWn3oV2qN+AWiBVu3IPbn3u9+/QZG7VVUpsa+GhWrUwUySTvgrCcCJInJaBT28DJPMlxhzyXm/P0",
+	"blLyIom79nMo44UaxYwzZkhQ+M529201j8dH8XO3n9HxUegW2Zoh/iabnq8Drr3Fangx28/ieGRHX/W6",
+	"rYv7i0ZBoAwzEFSkuZ2UUa0x1LYgFWYltTaCuV+z4q7bHBGVpY4L529ZsZntQ3L5JlfQ2tU8AGD6KI9a",
+	"ufOagHCDWZHfkRqUN520vM915wwV5OfdPimfyesbdyg1Q47bUjBOl4P2AQvmskg9Q2dra6JWNnV1tCbF",
+	"O0bAexCcKDPFxeaUyNHR4X0rDkbua9ac1UPhmCmyFFRtDjWjlCJI6bbt29skWdT1sHxYSYS+ESbu6pbs",
+	"906U/a6VTe05zYo+getOb/52bHdypAFP5S2AWWOdq2/0jkmneA39eL2/6DZ4GNtAPVNfm3AN6XZ+dekm",
+	"9bq7YE36fVu5MIWifNGLkub3Y7DtqM3tkUYjwtbSZY3eIFnWix6QK3VrD6vu+0jXRCq8Luv8no3B2/Ux",
+	"Riel3/5W2fpe5oicVkOV60+B860vZncxo69m8gEIPLM9fsev562uYutaJLaUulkDd7h7fetr9wpLdUZI",
+	"UoPgvrcfCkA1qT+oEAtx8v4VyYm6oUdmDBtpQ1jL2fiWlmm/gDQGvaILkm2ygvzM+ZVDHIcBP4BpP3B5",
+	"P9AiUPC3aXBKtDojaBH+YJo01YBCuZaR398y7X2vq3nBHzAbsWiwDaI1dtbZSaRNe3PJYcLtpcYZaJOE",
+	"Smq8cU0bsIt+b4Kziwa3EvAK1/sOtJJtG1w9+F3xRa293o4lig2SIrlhxYYYxLq8j4nbsXSvGyFU/3Lb",
+	"OxEnn63PjVVEvseWNtCshXQxd+r6W9ON2vw+uU8/vvt0cBKj9Kym/eQu/dm5S89n1vI07gQdf3l3ftax",
+	"gLkjomFA8iMT9ty1xxq71bAHlWkHOrSc6kZaMaXAiU6UXBoEdrS3byXR7MfgEKO9pwtCVM9lMUUPgA6H",
+	"VXCaLPfY7BAdf1gPic6CxoL7lEheXPeAG0tjI4PmCXd12KNriLAt74C+YlVRILpAjJtfIIuw/lE/+07X",
+	"F/HWeaADdnuPHnApyDXllXy9zUHbM3Z9i00jr+n2B67PGyr9J+Omf+Y3TkW8KGjmHNrNxkIAGM8u2M1s",
+	"PnvD3b9gX0ckUQK7F+Vaa0uj3FsZd9kPv7ac9Y02FL09q8uepTRv62Rdh3oQaGS9NMQ4p08zbu+mbsMs",
+	"vz0bvYVfmmYPt434m62/HNFlMuFQDt/aYxkfPyRX+Pl33+/jp7u7u1+PBU1z0h5AuVovhyYV92NQ9vYa",
+	"oleekZseKsfIjaVrht556mYqy+TjiJsjDT0TuSbx2RhnZMxU6YubPikfH78VYntmckghmZXVOE6juQ6n",
+	"XNN17j6l/5qsudh8ygiMqBsuPmkRNpHwpwyhyBocka3DwO2GaaGHPhoPIQvqsXjSf2Flw5vdYE7zhtaV",
+	"yf+GhStHIajSnrO3roMeW2hYZr37tZ489jVYUOyzW2TsW5gFxX+v1iTIOhz3f7Z1oTHb2FiCpnIvrBLx",
+	"/uO8+blZ8+L9x/fzeDI/UxpLL8dn0zZpfjgzdSWCIl8s3+PCJp6rizg2qnj4xq56hysLDgduXMWNbjRc",
+	"/f6MsGsqOFsTpl4Ynye9TChB8WIhOFOE5fb63Kqwh9mlEjRTjfrHQbEJCwWjeaV2n3IXvZMu/R5e+9AF",
+	"LFGdLKMFkljxD42XL8xkz+ZWkQXJxv/tha3G8unVQG6xx8LUDBuzxyYyBHu8IptnxlXg2fyKbJ7/m/nj",
+	"+fiiIc1LIUvOJBm8FW1sNt2MXA/bDBLEt5APPgc56r/52HVNabZIe9A2UtDfEEGQrfG9qIpiYwGe7w4H",
+	"wbamTBPfM6I1BRpaRVJ9FrYxqhQfKupEUJvPULfrK7g2TrFjR0zwM1it6tKBcKvMOqyPM/xuVkJypMgH",
+	"NddAXGkEbFygef2nRVxJFTD09kbJjVRkrYNKdi0ROSOC4mI7kUtmXCQLxRXkGgdOk3UFkXoTu+jlB5zV",
+	"YLZNnfCBC4olgknQii5X1r9kMEwyVXbOLLc+g3GY06d8bbfTGGQZPY1PgdEriUADKkSnrHC6xLnx6zSu",
+	"jDnJBMFSf4a9balobNyPyPF+clqBLVRbfWJvS+jFPUFCtRN1GqRBK/foCnkbF/lW7qYO/LJEAKtbiPl+",
+	"izVEU0fFppe8SNEa9+bZMO1WsO+2OmvnDxjNM9w0ftyqPCsfuQ6rP7HqIJC1YsXLhQlkdG1c3Ggzjng8",
+	"DFoBoVFSCQQ3kR7BfnRGbNkKZW0FxmqKcIKVIiKG5Qf+ZKEhKm3LxmbaXaQJZnTrqBg1mlhbGk1XmqFq",
+	"xSuFZLVY0A+aCiFsQt53pNoUBC0Lfukmg/XD7HiJKZPKpSYtNqjgOCdmCljTGn94RdhSrWb7z7/7fj6z",
+	"Q8z2Z//n16c7/4F3fjvY+c/9i4udv+9ewP/8enHx/t8uLnYuLv50cfGX9//9q/8xrt3Xf/nq4mL3V9Mw",
+	"9vm/pcvLB2rlbvw02DhOeEGzkSLzu6CHQdc0r9fvttd11ItbiGs1hCeeyPbV1h4ltJZIN8SZqnBRZ5D9",
+	"VFprejdIbm2c3oK+dKPPIncMd2Noth69FYM0PgexPwOAo4kSc/FIGo7RBL04pum+Zd7h8L0ZRbBrL3Vw",
+	"W7MOQbdy7nL+aHfjxIO+evP2/OW+MT/6oGWIH1CNUA6bH/DrkV4/Wv2x5Dv/JTnboUvGhdUI6sU7S/yt",
+	"PCO2fKF8n8Ybta12amurZAezayFg5AB1e0/38m1IXp5w3wuuWGNVzSs9i9/wEIwhHvv7AGdTr7eGWnjs",
+	"PZzprYMSA0xfYZHfQO135qvUaq4d9ooaTgZ3H6xo12AfgTsJV4yA5nbuQd0hBvwxu+6XbyGdKqgUl0Iz",
+	"F4EqNPQuO+Fa65C/XSwa/pkHN5gqyLRrg0ZMdmawEZ7gSm7pOdTYULC0zrdgtZGvTTVp41PXY67xubHN",
+	"yPe2L1PjYwwYkWZt+NTH2SAp45JVvC1NG3cbgiIk5EPJZU3r8ZIwpTNp4GwFpSUyLgTos3KTbL5m4M21",
+	"sO5EGS7xJS2o2uxesOG0F2YTjVuV8aIAF4faHSbJGOlFJv2s9Ft4oFs416roJQw9XBJjBC3qLFOXm9bS",
+	"OiNr1InFU/3AudKBVFsMZbKKjHk+OolMPvrKvGxpoB3f5VvXCJ05SjlyeW3HmxCgHgrdVcybx5emWx0e",
+	"fiC4qISWYIldY4aXtc7VOknJsFwnpFWzvzsvukuCcn7DrPyk3xFb9qKLgpcFzq54pU6IoDxWn8h+QHkl",
+	"zHQ0W/n59DPFuHLDz5EgSyzywtY41rsxTV3SImId9ShThIHO74aynN9sUeq/seCoFsFu/cxOOTSiOR/f",
+	"GjSvfn1/M8vrDfuzW0CUtcCjBaZL4oGPDtxJIVpXIvE5r9uQAuuQ5xUiMDNR7jjfjAZed6tt4FXNFluO",
+	"93HgEuS38u4wa7pTP+iQ2bFwv0Nmp7HZ2zE73SG28ISuAebdoMtzfoQhfPltpd4u7L8Dz/zbWIIbiwym",
+	"iHwNZ412boUINL92jL2h0D7AZDtjkovFBc8PLxrC7VsQqznHTr0FDly9mowak1Osy4haLjlZYGNx6nAW",
+	"B+hSEHyliVnvTi436CJc18Ws69NfI5dsSyifweLtmvoXrrjCRcIhQn+KVPYOZxpZW8dSv88JOlYW7YNO",
+	"O+YZQDWPIGv7/FsbjlIjKq8G0zFunQFx/pmlcIyyY1mdItQOAJwYlVemTl0sr6fWTccdBQWY+DdguA0W",
+	"7xzugjH79wJzdDfx3pyVqGDWH6rcRtK3VMGtFo0AjoJckwJUjUXBb0iOct/akElhKrggCnha2jIuXTAs",
+	"Ba/KHzZpVa9xe7giGxDFbAQzgm4axN45tZ7/Epbb4HMC7f9Xvx7s/Cfe+e3pzn+8/3XH//vve7vv//T1",
+	"X4KPI/T2YGZ4x/A1ptYTMHaea8rouloHVMedEfI9/aW2rLMFH1gyTPfZ/rMY6VhTdjAwPf7Qmr5i3Xn9",
+	"OW41f5SHE5vTiqUzXNfV2qlEnBUbU8DUBHSgg6LwfzcKXHpTUUmEpNIHoYRlNX2FqqIYy5vAYqG9XnoV",
+	"VmuzNHn2VBO/NFx9KVYHQoeS2KQiURxpUlcQRXYR3G/XoZbAXIlL8N3HCMow0WsbJUz0xbdjX24QNvru",
+	"ilEdEuVzo/ofQdLaR/+QJs2oNMVk5+gfa/ODyRyqf1iZHyBHKtzM4Jb8Zf/XZzv/8f7iIv/T13+5uMh/",
+	"letV/Eq8ZBnX0uWYXBjEtjUkGlKZAE3DCrdCv8LjLAtMmRavoWTr6LJcZqoT29n9/YMd5GNYnavOCd3O",
+	"Ie5a7FgjwhBTX495Zju0iXJkzNhjWg9U2wBbtXVbLWz1TiIRJG/WAzmou4ZBQFUdCgOBVlWhUcLdMauu",
+	"g8PBttYpd+PrMddTGOCUSnVKpdq68i3b+10UQGoPfpDFky8GrytozrYlBdS7y0VKPYLFA1a0bUnEcNH1",
+	"YPHvborIro9IRiUU6EsFyXmwbHNSFpj3FfKW2kU83A2ajCs70h5YF/SQxJx8TjKakzxx2tGrofuNmxhm",
+	"qJ+Obabpi2ML9h6sZu5Odcy9iLtTxlo1o9pbLegU4P4ZBLjHD2WU1rrDNk1R719qkbD2UZuA2kShKO9Q",
+	"BdQLswjRMiEza0KU5XsNteOeTd4F6bQzliBIEuUDbtZEmRuIA3Lp3mDHYAeDNItTRGgpOggG8g87TGfh",
+	"iFS5domckBIV2IPIVVD291jKigg5d3lMoXp6u4R92grakooiI0dSPNp9nZ+83rl2i4vxH+bxIiznQhL4",
+	"BDb4egpw6LJ5aS83rui2XuGSXhOGqNuc+RNLpGM/9AOtL21FpX6o4BmjDJ3+eIieP//uGxdk48NoDt+8",
+	"OGaagnOG3p6cH/908NX566/R6dkBeo1ZtdA+jEAXDg/Q06ffzN++e1E3m7+tO5+TbMV4wZeUSHTw0/zw",
+	"xdFLG4szPgbCxFf06ZUHvA0c+JMcH5ZXXlVhAOeCkUy+YSBdLkLkyZ+eoBta5BkWOiTrwGXk0h0s7sDY",
+	"beSZ639vIHcpqHr0/bCcw+XGSJ3hBYnbIUY5f3wCOCz35LBf1n4aaIVlACDzds710k3EGSCVx6CQlF7M",
+	"9C+NIJiLmb6WF4GDA4TPHOcaOYaBHgNNcO/TFzC9ZZ2xmdr0hHDJ3D019cagqr120sbGR7LxFaLAjELL",
+	"DwGupzBoPVRQp3mlN8VhYPPOUyUhUfvh2Sl0cXpA79/tEito3xuSw9CHBxKoayMPsVGvmaOqq2nVWdRj",
+	"FfM+jnhSTquYVjVKj28naN2tFPPJROO8vtRG31NsHKvZiLPzsoDbPqSHd5PW3KkZyfWIPLlYXklNhaLY",
+	"vXYP+jbQMFyAjhUfLdHMUcXoPysCr7O1qhmRxJUgN/XxSO5sblBPIHA9MHJdGzhrfBXlf+NVLrYQduKe",
+	"QbFWraQehuAaoSM4DbvZro1KUO6ct7tgNJUAvCOLhxr1U3CxxIz+ZtXjQsMNF5VhYh1nD0DXApn2L0Ru",
+	"xmZrtKBCWq6qbPTzIbO+Y6RMARVw5HIXHRmlPmD307G1EatksJMHZ40x82DVlJllGCoJe4AuBjob26MV",
+	"EhrlTI1QLzUPeltB6DTqxNOOgYW99qNgEDWfwj/bpFmLy+0FeGsgG7jQ52C22xsSMonfk2L5D61Ythdq",
+	"u3Jd3e53W5u/M/6BvdUjSINr6njdVISZJxRhzHNc39jxvtKMSQTNj1kOUqVmguuay3YgYPgvCWHIDRAv",
+	"tvzJnNYB6Aycf0OK3Rp24PH73OqEAq+lUY456aPuesQMTDp04kFM4aeefX81GRXwLd7HAMvGwY9LX+56",
+	"/LAZ5jdt2xGOSMGo83BLEX+e+ZZHcIvAzijzbkfdjeLakEY+aJZSyZ+mT3hiCR5JIx+cyZZ8qO05aeT/",
+	"ABr5kGEZpgG6mcuS4xsa6tNp+0S6rJKaSMWS3MlEWr+Tl693wAFJl7n76+HZvz972tAyS7ps1xyKPOLN",
+	"6Pnx5VLnM/BLPx2quQVyZX/dLUBZW1ZoV4cdo6+4DXbrSWF1p9yK03O40OobWhQhA0OlD8ZeEVMjLnhA",
+	"qIyxVwkOR5/nOGRLxIskGm73Co56lGr291bMVI0qAVoO47LN+hv0icfV9SUcaGcQaHoZbEvze9IJpEO0",
+	"+8/4rHa/S52ubdLHYK74jfXH1CTY2JaM+unHgi5XCh1qksyLEFmDEiCt867UijBlfb+3dgw8qBToKeup",
+	"diq6416h+LG/O33lTufdcX0LIbgQVdKkeCmFe8X+1ynSKALcR0GZsfeY+dzb2ROIeVuPx5TjYwte9QRJ",
+	"GIxCCYDjMFroZjVqBG98c1kNpDFm4Fughhl6J7iSO/GCgKY+bWBOPcIK18sMr7kewJB+7Jaux0cLWkDG",
+	"QHT+6ix+8c1irsimdxF/JZutJtdG2oG525c9AZXuEkcd/HiSMIIyuMqObGkivm9z6MG+NFKBPjoF8rrt",
+	"gWuahn4wMvIjh7/K5AWO5Tg2nLBLKofzXAQxuIMbR185pnbFpdKy7X7JhRqRtboHQH6x0ZPX3G/kmK+N",
+	"MBromG1Enin3DOSRZ5Adx1tujHE3anrjYlh8B1doLjwsYA4l6HIJ/Jq3DRmVv5FXgDeCTKxkQT8Yyzeh",
+	"oHnSw+2jr8ALH0JR9Q/y62AG+xVXiq/BqGB/l3FObxKM71owzmuX095XUI/o3FMh8dE1VAAwWt9xuuFT",
+	"siCCMJPRcxKJ71QkljKa4f8ArZoVQlsCaLtAqYajye2QCP2+nTVAECyjV1bfL6HmaI2zFWWkXqc9fqA/",
+	"LRsvjOVdeww5CrySXZDloSA2cVHjF8qZr/nnPrzzOY6av3QaujIWrV/CMbuJGBM/t3ocnrzrpAA/PHnX",
+	"Thp+ePLujX7a60avIad6p6/5ud3d/NoaQce1dvrrH9u99W+tvud1rvjOEMG39kjBp9aAb0wK/M5g9vf2",
+	"QPbn1iAnJgl+ZxD7e3sQ+3NrkCC9XTOtUPChk40o+NbOBn9EpeXCgvbHkbxErTRB7Z99VZngQ2tUzd0Q",
+	"pjppCOzv3QQEvkM09YBH1YRo3/cNFz29K8X7RjARE8G+EvWT+isPzcJkub9oH6zGL8fs2v52bN/ucyyv",
+	"/KLDH0+IWGMGCTkD0gDRjFxsDiAtN9WBquHPxwzHP5xUcnVKMkKv7UD2dczrJjVhgiQMbvHwR71u+PPU",
+	"RLTWVC/8FUo6dn71ewh/PIWSfD+YEo+Nka0XWrvDD9qz44jKEoPfY+urhTMp3El1uobj+lR9G5Ydakqp",
+	"gjMOP7ZgXX/oQLv+dIKFJHnkR118qU3p9Tf9f9Efo62PBF2klgvfAnxsD3jqvEt1QdOquHrr3uATG1je",
+	"/RKCrfHBL67ZHLOMFE0SIXlBzkzi4hpBYl9fsjz57RRcx0IQmpxQp0QqLhKFcMzuR/GcZ6apV7T1RS8F",
+	"4slb40RtSPocWYof8gme2ttvw7WphuwGTZbYcz01e2Yn8PufW7EsKRQG8WAR2XDHhlVnNmxLzpFUogIu",
+	"Mq+rbFhpcVOCTN8I6zKJkcvSJpjuJbK9VoD+EnsD9HmLkdvV5FIloAaSiSYKRqUD88Z6obUD+XrpXWLM",
+	"dI+eUQMCPHbYukt83K0WOrDG1jMwYsBmj/ioluSMGM20jI8SvHgjRqpbx0dzlHTEULZpPU6EAUkM020Z",
+	"H6XLsYwYsNOpHruPSUkmvUl2CcdtPPz9eBdt3B1rcF2NZoFuw+VONjGjYSinTsDIyBaZfjqDj8p1nCBP",
+	"43r3k+LbjNEmukNjpJFzm55JLBwapBc9hjsPYuvQED1XfJuu2226n0Rt03trkI14WLYe4pMWEX86hkYY",
+	"eso/vm9ygwMFD4FDS/iIuU8tv7Br0FpOzmCP7gzmD2KcB5huPnl9fbleX4EYmoi+tquwEXASmezaoITo",
+	"KqpbVlXXedg4t+U8A8ZKP296z9VlsJwoFQuboJwUFJAxCCFr5WFa0EJBC44wuiGXK86vJoo3RcRMETGB",
+	"xiu4U1tGxHS633FETHv8I4LzV0SpmBfGAQv9FDK4YPZYLaGI5WDHSpF1qRIBiWFiWxhig1yHkdGOsKRx",
+	"yYBShPQOjN8FlsrYlKKrIPqTW4ZNz93cbnRZKSvreW01HdwaxICko0p8ZwglMVkDqhJx1gB/TzRJCxPr",
+	"4wjUpR1FqkeKEHJ2raPwtIcPbzeL8ORhk4k//0z4886hjOfVw64T3/6F8+3tx3SYCrTyCpgoOsfOckeK",
+	"4RbdrIgg4Y/x1KFDZcTr1BI4KIfOWVjQOKTc4TrmrUQBcsMU/uDyIDULh4OMUNjkwnYkn91Fw++FtYWl",
+	"aoU3KbO+EYmHGvDBrdlfdpcKqbsHvTBbQNuW7G0mM8BFgTyOjc8yZJ4U2fck9kG2u6rQH6K7Qjvidmu0",
+	"UtDWlOtvtl/7vrjxxl2JVExJvOFwTImFXZ0jozNSTATeMnLDcCCyNeinV4LMPTubQJk1l/De6ulDATfO",
+	"3SK8UERYzFAaJnPEyA2RCpi62z9aAdsd3YWd/5BXSbWCZ6TtNupFh+XlR5aK0Ls5shzqeT8DGTK19Uye",
+	"v70lGzkUZpO6O9F12gsUqC4CcokFQSdvz85JDpdeov959vZNF6UlyQRJwN58M9nBFYfwJkRwtqqZfKDo",
+	"P78+ONw5+/ng+XffG29r3RA85hCVSN9B5/P9f+0Yp/ZMFTtnvtGK4Fxjn9RlRVb4+Xffv7ionj79JluR",
+	"DyinS2KYKT3AJc838I1czHZRsMZ08dDo81CJRFmSn8/PTxAX8N8ziPJpvpk18R1WXOlJYof8Iy2cG1sy",
+	"SaL+aOLdFrQgUUqU7g9p2pEiHxT66t35jzt/Bj92k7S9DmWoJzHvb5GMVtPtXNb24SCkIAn9x4+J7b8O",
+	"eK3m+vVX5LimRJWK+K71Dp5IU5BiHiTytx7+kM/fSM1CUxUiaIaOj5pv48VMcK4uZnEGkeekd+qSCOsy",
+	"i3TbXfS/eQV8s1mMQck1FwQt8JoWFAvEM4ULF/pWEKxBh34jgjt25+n3334Lx4dNVG5G17aDfsjifb59",
+	"/vRrzbiriuZ7kqil/o+i2dUGXdqyBEi6egW76HgBd8dDDFIFtjcDLIbep6bAQe6m77/9djdelUcS0Qst",
+	"rk2293BQKZzzHmamnoDxrcq8F6Iga+vdacWTkSm2G0MHTo3hz6d+7MbPzu7/3q5wuxo9IRkZNDqGd26o",
+	"8cGl5EWlyAmGuMh/dSvZeKqQqGkDNs7I3bZVvMI4IRKUEZ9UFpOCfVKwB24C2ynVTZe7VaTDmHGlpP/U",
+	"VETCz9NNfnzlY30Qo2Q3aD4pGb9YJSOc74kg15TcJC6z/doKY2vkm8UoA1c/YxeHiodPZKAJFEiRdVlA",
+	"fPRiQTJ9uOd+ECASPvEYwsroKZ49ffrUT6MFzP8/TOxKgjZyBZi6kZlWGESIjB3kJ0xZLOPceSx9LmwC",
+	"3QCx0MUdjS7E5klmSzJHl5VCOSeSPVHQgvGb7a6VBazRzMU1IbCoV1yqrVbNb5gWfG/MGm+scgcVnC2J",
+	"iO7mjhfOyI2L9pIjVm5WaLwsQBBSK0hI7vbTWa1GHiqMrIAonID5kqOKKVr4xNR6BdbRBBS/d7xPCbrV",
+	"xBY9yvt0QSZfhenUrhY6GvFutYUzmDO6hbr47ujBbJdOIWT7+7x14ZqI3MKOGohD5MkeQy+RMm1crir4",
+	"tyE4rjZfh05FlChGZQu+w/GTNXjXOEB0SRYGc2sCQRm6AGK8p8XCi5ktaRB/AayezjzPCQkZvlkjhCeu",
+	"4eUJ1tOLNoNK/XHJuXuS0zBysyUAuwQ2BT/0cl2qDaKN7jeBClsTOjhitqkPeUSS7yEMtLeoFwNNG5e8",
+	"Q3PQjTSNFk2g/ghsOERJ8DDrRcqzETJCHRqq10+E4ClzgPmGFrwCWyAtHK1ybJonYb6Mqz/xB8QlRz+3",
+	"3fytjjhVizzSqMmCdItWb015RvAp3VmiD4euzzDS+DHIZIycsuCSjJzSETtzWPJ204YMZkgDY4RvxJqG",
+	"WZbEggaYl1HTt2tct+Cz1YOaxPDe+IROxb7LdLUm+BQWYzBoENQkTmRpueP6feldRYN2FzaArP9sTat2",
+	"EXfY8khMsmbBEyIywlQ0rck5yDHQDJW+nTdnbT/ZoiqGNtawVt56c+5F6M2PGdL182YHd4mptGhEJXL5",
+	"7sAGz5NuffnbapBCQTsY6FP2qIXJBJ7qTxFKsPUsfeUW2zCe28sYQy232gYmeFwPADeKLHQjn74IulBv",
+	"K0oYHgWnb4MAQ2c4TNXvHd79JPgOId3ALQ1xV2Uqb9Urug3AhwAdj9B7eGg31xF/9XTzcQ7jBqSey8q9",
+	"LH1JNCpLO00Cvnd3uj1TK24llC0PuIbC9ofdDGR9+EM28z/sfbJc0P3fpG6s78MDuF5DFMgaJpc4uzr/",
+	"dGB7iQmbFwLSnGRXSa7nU2e0lRObhwqOv80FfPL7lILR0PG34ssf/uztApIHD00EVmQZ0QnYMZC0LXyS",
+	"mjpHD9Pw+uHemY8mx3EnxxnufMQxRt1wu222y+reYSCjSrEfhlhSy6/b5lDmVEPKXoAmwEIOPb7nuBu+",
+	"/5QolOB0Fv3FEWB2QdXGVsfeH843ETavkXZc/9NGYy1OBk7+vYZIXWfARwQEaDxgCmnqEb3clNCH3Z8f",
+	"RY+aMOH00Grl95u8Gr134taXYRfZrtomrgS2ti6cqQoXBquC1nNE9HYoLgrQlzuw1y3QCl8TUJuDMtMw",
+	"WSuC1pjhJWnklaYMYaMlvgMXeH/id+H1Dps6G2fIOmq2rm/MKAtYk95t6cbtvZwPljqpPNTNjYALfq9E",
+	"neLewMv2tcUEyPqS5EHFU7rGSxLTKINz5KtPLTBinSxdfZGuOaCzWRIrDbFVnQI9SsGXr8g1iWhjXvEl",
+	"KvSnFIiiHBW/JkLQnCQKV8AmZ/tQO7kNgr+5anQcuVEsDAxoIpnYs/Ao44XqyqoodMgBj+q2zAfYoW6o",
+	"Hy1rcyDCHHkis3xJsh+Jylb6gRDRkn/uCwy+IDZKwApeun98YEB846E6cuzK5mT1Y8MQ8dEblcTj/ktB",
+	"9W9fBsJEmEHhkKIgWZOuDNqn6lkPofJ3em5TGTy2BO0hhLcO76pnHoUCdncgEHeWEBcgyvXQtTs/eW0p",
+	"UZTj+YkwImimk0l5P+QoCbF3poxQlQEexA7tEpQlgzC+KjnkRd0gQdZcka+R8CmudExGFAI2GOZsOJ7F",
+	"Kx/KSq70i2hKIdAgxG5Jzd0mApzl6xLqVK2MQ5NuYad0nYJFQol9nGWkVBKmIdLVXzIxMU1rUYmlvOEi",
+	"HxVSYtvE3p2fqH1sTgS/pjkR7iRbQRRUB/mnYg1t9L+xX/5EVZO4WbB0HyBwDI8Oqb84X0szlnvL6sxl",
+	"CXOw+zwsLNVDeW/D+EUBrvyUXNO+ql/mq150JUnthti73tZRBYvvzDo30Iod4TgbugVjaY95dGlze/Kx",
+	"iX/m/OrAl72vQxBaYssiysdYZshGolUSPDDXRPkn29RU1wC9JIh8IFmltvDR0mvr5QxVkqoCh/uBrqs1",
+	"yh0K40LXOM8ji3OqZeu06HHeuBJfkiBaBcJU4IjpNUFWmEQLbscGTwC9LFQxqpl5F29T/2gCcZ7IJ7AQ",
+	"STRTKefoydr8sKasUkQaR+QnK/PjilfC+D1ipYjQW/w/X/1l/9dnO//x/uIi/9PXf7m4yH+V69X7/zYq",
+	"MuZfI9NE1thxWrHBCJK6tUmxnG/R40TwS6LDSN43kPJnpUrzqXPG8DMUbNdRe1+dfV27C9vAqp9enqeL",
+	"1JIPJaicU2KcDwuUrmxuTpDr5CiZzW9FYtFU2jnp+YcPjf5gxmCS5q1I1pHJYZJPp41YlITljXq6ijeD",
+	"xJ+slCr39/YKnuFixaXa//PTPz/dW0ElgN+e3D64sX2QncendD9vgQ5R8d0MNGINcTWOwxoEIKhRxriV",
+	"UutMivPNLnr5AWdamcRNqm4NOvB0zkpP6/xxd/FLNx+/3xrPIYtBivGu89xwdIOpXoO6IYTVWYY+bwrW",
+	"COZ7dmuKNp/ZePUx7gLSGJWUMIGYgD/eN8+pzA34QidP+zroBq11fxO9rWvK9HMz238au7kq2wIZzjOH",
+	"Cx970VzT5M5FI+z6Fyw+RRfwkl1TwRlIutdYUKh3o0vDmViPElMh54gyU/tCIxsoW/UNWsd1BaJiycyE",
+	"WqxqMQh68KyoIMBVU1EslpVejUSVroWgKSrLsciRXJGisBlFNOZTaWRFF+Yq0domCnYzSVTSEuyMS5D2",
+	"5/o6GEfTDbohol4EqhiEp+sA6hXayUzk9Ie42kGXeDmiCWdY/RHkYypAnbqx24UAexAPRMWYc8m0Cx3B",
+	"aVZsgAy6V7iDI7L+sNV7Hten2sFGraURjdsFla/tpEFUEqFvmcszY+cxZCmwwUiFhZrNZ1LxcqaX5n4Q",
+	"pOB4bDxve31ndpDu77yM/HzqZ+1+MauIQSPxRJl9A2tTA0QzsB0YNI+Vh8Dd6mDrY9FcBqMjkn2GS2kx",
+	"GeuNq9Fo/zuGsdCTzoMt9KOTJ5HpB/788KR+3i83GpRwybCvNRnL9WKrMcb3bz8akQHG0P90Ng6Nq0+A",
+	"qdK050kLKDXL9d233zwfARG3khQgaslofxt23nfTcd5vy1Esuu/z8kMpTFmV2cfBdQWNo8kX/edAfiT6",
+	"jcEKGBwlKqLJs9e6x8VKSyZIHqXMsS136CEvB8jRV7omJLOJELCCvBqk4DdIeUWH3oLEisrFpv7VL318",
+	"UE8jZUFE5k0rXLAN4PeaF5NFBIHN3D89HtRgGHJRVZ8G5jaLbpIF8DKOu0qVtW2kV9k4ZDgB+UwJzKS+",
+	"cRGrFd7NRISW/QA5UZDLiSI4V+jwIIo/XkeX0HGZr8jWCF3Z1DiddY3Q+c1n8oqWJiT2FyK8drI789kV",
+	"La2O1Kkrr4MOcUOEKuQoYJy/OjN1jV0mllFL16Nfkc340a/IZvzg/IqwlL/uFWF3A/1KEpFWw7mvg3MN",
+	"K1+CG9CvsNUi5EiNrVGCjNTZaqpwEiUj+lf3nhlzzhNpiIg1SChu8w2qIGFRO/0SLEUSjZe1AHojqFKE",
+	"fbLGV3Q1vk5ha3MKyg3LUI8uWFaLBf0Q27zweZFAoWIyp62JtOKi8QCX8HUXHSuUYWZFFYL+WRGxQSUW",
+	"eE0UJCKodLCK3EcXsz1NEfcU33P8yF+g9QtofTEbpqgNrbI/vodXJDuMTNH1W5qRVo0noZcbqVsGFdfu",
+	"xPwEWGsVaZnOiccFygqI8xF8HcUkKHBoxIIETunxDL4ZcQ8sRJqEuK6aITVxItZUUh/1LnonITsCFATX",
+	"CO4w0wi5oMiBt8uu2smUlxt3wC6vpT4LtrQrIdLKylAYe0WKsk7RWe/IoYo+G89HTya40l0Di4Wxm3Cs",
+	"DfdBodU2lR+X6CkY4BdeVGvSGEaz7S0bzToainQavhOOagcOBjW3V8+HSpxdWX+TfrCYSSPpoFJg+aGi",
+	"RYSZqr81E0XVi9VqopzKK7vqS2jbccB4nOQzj5Rw6WFTE9VHtF1+oqDf3SYpqgc2fh30N5z0UAu/A8NU",
+	"lsXG3Qh40xMOVhkvBdh/014jh29PTmsSRI3CmTCtNt3OXcT0eVnG3KFewjf08uTlq+ZcX5GSFDuCFETv",
+	"Qt8S+IGRD8r9+nVcIjDTnfB8jVlyQvPZeTzFBwKxOA0f+AxAz3MHcg/tUUJxfdJaPI5LxUCwelbhWhiN",
+	"jVS4KLY7HTNozwy2gZ5AVMzpxQNydYv9nsGY0eXI1V/Jpmc5Z2c/o7K6LGimhS13ALdxYMrfMdq78UAV",
+	"eFcHfVbPHFuYFsd6VgSfgZETBKvbzK9Zr+7EH3vJECBn96ExAlQCLCPzux6OTNuaSJQKqTpMjtTanTI1",
+	"Rjzhqd5ckB0UXLtNGlPDTNWJ2nVy0IsZ/Ov/9913qVTtcT3WEZGKMseEqNXwauMJR82G9behEeK6q3Si",
+	"y/DA4xnymt+bafIafE6Q5e3z4Vv8PdnywjxSDrnPK9tah3BHqIH5q/+VuB1VMJ+2uG2g7rE1Inx/IxZe",
+	"EmTqo9/xlYmbvJrfG4idE0nBWcaHPrDgFnWBpZm543UyK4P+3BGBtOwMdzIp6ftRT8mSSiU2h4LkGldx",
+	"MXhHfujrq8fmXGUvP4DBOv2kQatQAtJrNKzmB6d7HHVlf6ini91ZDxu32hGxG80OtX7Gj7WIvoxvS1tS",
+	"xPpdN5o77WLMtYoz4rF0SRgRWCXMP1lHMhhHzVoSBcRZ2+iDcYqqaCwIxAPI1TkPYeujEpSo+oISdE8j",
+	"rlS0UDEcVqAKMSPHOPXWva1vysCVTXjItVs0ri2/BC3OFvdWo6W9JgvZo8fwmjJ/8p27Ibe7DG7W+HUA",
+	"11Btco4XjqBrYhRMalVrJaCPQckx1SLmo+Kr6jaO4N9Gtuh1qPVI5UEyrE+KomP0MhZ8Gdme4Yb0t9rj",
+	"+r/4JSp5LtFX+BrTArv6odZdi4saxmb78uvtBJs1kTL6RvxcrTHbEQTnMKlthyjLQTcGYS4QyxwE8dm4",
+	"U1SusIzvHL4kXKDCzomDda4uJ4TlxoMFgGb+eVLJlfnXT+ZCULaE45Oz+axO1zGf/ehyxhxilpEilXMA",
+	"HFnGIzs0H4/q/RJUKPXFWKdA0BzS/Y1mmsIxk1KG0ZXkW0SRrYyvZGABs2No9bwdI65OiZtw3iR8b0bb",
+	"bsbxZ++i4tSBEaVwBhl4a8F6IDoRBM4ensZ8N7CSobXQJni8izyE76zhdkvr/s9YrkjeNPC7dUaHAl/E",
+	"mEALJ21dFYdH2Var0x5xLLjG5k4EzDipiqIOOPcXYHa8eMPViRHFZvMEd9d0nn0S9nmyi/6mqYkkgFNP",
+	"DoobvJFP5gENpBIiI0mOCJQeAh/TZq83+kujE/i34AL8uBH5AKBjrYBWR1PNnLN5ezMw6kjnQQ0fP47+",
+	"ozWW/smO50AaMensJy06gzyrGc3WAB9ro5nPun1jCpkgKbiVxQ039/bweAeeYYqZspDnAmGh6AJnEXec",
+	"soFGg5sKsA52ZPmOAZZkeGEm0N0zysYWqsPtL0nD4lR3ZNzQdJuM5O3hsR8MHIiBXGGJ7KsEzpuWO9Jt",
+	"zUBWfslS0Zwdk7/bb/TkWEHZI9gYYdrY++AUXKEV0UlwY1nTYDV1hZt+umUXNNIACY3HeN4M79MbNexD",
+	"2KEvo739LKhvmVb3tp4cScDNZ8fdHCXd8O+ggU3vr1dzcnhqi2QYW3+Qj6JOjmSos1ImQMn4HpyfvEb/",
+	"rLgyML0kYZgUltpXUbPeWs7TPyAlKqn/leA7/N35BdYy5EBlV2wXeXJ4ag52RbKrXfun3gwWJpGG3i7J",
+	"/Z/QbouoxpPD09PG+gaRvL2d1KEJhotzLK8eI6FZd/6ocEGE4OJ1Sviqa4s7uct8v3QqYS3/VSLOy3FB",
+	"l5ThAoopjipZ6QKJNolClG/acUQaOFheoRWW6JIQZstm5rtbJgBtQKG98qHTPSFijRnkOXrsg+4s5T7O",
+	"vHSTfC6nD7nVzMG7gDGTb2qNxZWhV2UNGKuz+EQUCRY6Bl/+Wl0SwYgi0nhl9b92d/XSzG1Z0bFZBupV",
+	"WgexSIYoveVb+qpiFfiqmgkCaRxGTmiNxwGkXnN0AFnirGcU+Dw4VPzxroefBxAazGlle9eHFEMdSCUU",
+	"N2zW3E9OpaIsc/mC5taIBFVi9SOIqLRmYWUuxMXsimxewEN7Mdu9YBrDP9hqC7/OSB1x+KIUPK9MQgK9",
+	"+iXl7EUldwiWaueZBhAl4oVORUgYkJvx+oFmTrPY7nSDOum8MdzCb8a5l4NXoiteWNtvkcFtqeV8vjCp",
+	"4mEyaauJqWxVB8OY6NmDN0ckt5Uu9lhVFK3ZpemGtOhB2TJyM1qjDtG81+32Lk//J+eOOkBrXOqN/+uK",
+	"bOZwxh9NlGgkGDSm//N22KjWQ38JalM5O6yNuNkwtSKKZvVx1NEtYRyp4VH1ceiQVl5Jn3oNliF30YEf",
+	"AoRBPYDxJrXx3/+qXebmyC3sY1zxSFkVufqvjYwpiXK1TYzWi0BFV7qmXk1RRzADensPexNTbZXRYcUE",
+	"6zKrGROoUQAQ8rpzg6FwMhqreYn/WRFf6c65DSuOqJQV8fJunUWgXY0NWyfd3JjlgSzYKGtKro01WXug",
+	"ubviV1KD+9CAyUfkSSpBLQtj6WXZgm42KRBxILM7bUY66H27UCYuDAigTANGC3LjItLNmZZYSpIbkLgT",
+	"dx4VxrHaQduouk3MMezTHa0FpTNBUrDmZrhwkDKfXVwgFVL5TBFzVLGCSIk2vDLrESQj1IPSBrQIvkaY",
+	"NRmjROjEGlOmVf6KrEcVVNdl6fXBMmWRy64TAG8eTCfamOvj0l24g24kvfA9HbI4/UluCRoXFqqesoGm",
+	"ro3nfh9uURJV7IpBgSyb1MIM44BekIVCFYPLw3LE11QF0eiSCIoLa79tLjRIJY6+ssWHL0mGK0msk7iR",
+	"6ioGUdu8/gogsIV/oEA8NPq63o8gFnQGA9t7Mhuh8lN24kom8iIHKwNm6PrZ7rPvUM5h3ZKoYA6D5ZQp",
+	"wvQxVjIIo2njjd7Zn4hUdA0mpD9BM0l/gy7YJyfTiziEUoy+1qaeVxCglKmxjVAP1ED4aH+rJBxTS6Hz",
+	"ZrSesy5TG41GO18Ri5ZXZBNST/vkg/aKyFS+ZhMPysWI4HXjYAwExFV7aUpRWsXMFfz3pdZQy9l8dsSJ",
+	"fMMV/B0Vpa579BmON3P6F24m/gSjgAZhsOn3XbDLPiYRpg/CfMdb5duH+xHSaxybrs+6nN1rsuZic2qJ",
+	"+WvOqOIRTWhbtIBmw+JxGGZmOw1z6uHo72MJmPpjlro7gcRIb4jS2STs76+JEjSrN+DMMucrwavlqqy6",
+	"Rhl4CswgaA3dfX5pu2LzNLoBHLnzjxTQMIGZtFTqcqOItDllOoVuCsqukCwJcLqmFpkdL0hVngtelmBq",
+	"y66Iio6lo7Hs5/ASNfZpxh9psInCMRwt1sDNUJ/DIMZtfcjzzwxLW4HHoQnD5PoxAzhdjZfDHY5RpohY",
+	"4KiXq/82LGp3hgt32bAvalSpJ0XkQ0ZKQ+QLzktIxu8/p5xHBR0MhklexMhjpREmCLfpusH5b4i25RvA",
+	"fCKkUTlHZRzDsllWTUIPM7O0bvDQtnaEbx4CFE6uveNuKQLWjeGNv9x4Vj2VPhjWY72qpMLrsi/Z1crJ",
+	"DaB7M1vZwrkqJwW5zVyWP4Pu28xnHdPi7tvIMN+ZZ34bfs/YmwlRPUpdQjFwhd1FJ7ysCuP/tgncQnbR",
+	"KcH5jhZdR9bDKj5VA/DayP/ms/FLMJJ2bQnKMAsFTS6WmGneUrfLsCJLLvSfX8mMl+ZXw5R97SXG2a2j",
+	"nXu83Xm8hulB6HeObcFl605vft+u+mtLzoxMyJxUboEI0xrBckGd9R14/ycyqFlvxhvy6o8x0IbqnKat",
+	"6gdtdW2YYLXFa0+14u+uVvw4nPZnk/cee4OdN4EMSVeft+ZOesL1ODEp/qCtcAnQEwoJkvGlJhluNO9i",
+	"oWmgVMGl0Gg29+otQQCXKhaMAE3kvR91I8TnMSKE/zi35v7insNrEU2A1xstNHTR4paWdotmEFn49RGj",
+	"x/44N9UrLeI+mZ3zGKXkCHs9UvDcRB7uPySwQz56L7sNf3PGLn3Zgq/du55TWRZ4E6//CLEMyMcyAPsg",
+	"V1qlbhImiTisyAdzPY8j6PfSfkPHR567bi1wBO/ZdYHqWvfbTQIvM5PexRMHo58+OTyNgWdJZKretx9m",
+	"RT7suARlHV8wZx45+/lg5/l336NLzK52wf5ntPPXRDhKJu2Qco5MLPQlt2l1XJCHM2WAbcy4hVo1P2b2",
+	"5mzn815mCWU0ZTn5EGxDD2sz4s/2n3/Tn6+4HeCZidncw/J9/EDT5/iLszvWprGhE4vvqXNOrf11oLUV",
+	"dLYAQhwGWml9aohiI356i0RYg4kog7y4oSYU5zl4S5SFcQoRZM2v9T8USVgS4gHBB+h/nr19g044PNEQ",
+	"gZ9KfFUlhBT45LIdcIHsonY7AIU8usmCGG1E76u2Xn9zWGbfRpuZoPE4Bppl0yq6wRPBMyLlpOBtKXjX",
+	"YGnxacYwKg2g9Em30hOjdzI4EdsxYSjwemNTf9007mTgD+bSM4zJwm+7DMvTbuwauODZL4hNfk8Z2tNt",
+	"9n4taf4eGBZjXLUbc1YKMwyRLqUalWYaKpGs1mtthC3jeSy2zrrcWGuYa7iEnD27XfVr+k1+b3B+CRa3",
+	"a3KIGY4lY+w0gRqb0lZBRxgtBb+Bd3GFRbMW4BNpT1lC6kQIG5R14jnKqKK4aOGG7WEkBJPK3SnDgoYm",
+	"OyM4n0lFykZIBgCtshn2VoLIFS9yq2ef26oP+uD8TKJmYSMWC1hkkxb1+nrXLVt1HXpLGFZ1+l+/a9Gw",
+	"8T2PGfZ0PAoX+l7Wfo9NeNqd+d3q45Jt+O2G1QyexxTGMqiYO6L8qC8zq7uaszh3R7ENBFu0za9iHjmY",
+	"2JviGcq8ruhkSt09sA91z0Kij/Ut86OCDllbTAKE3qJscDBrHJoFVvQ6kUn2NMziJ2xT457ruIoxhcQO",
+	"In2bBQF20RuurJoeMxvuB4+/bu9sOPyaiCADrfc9nUmR7QE3uPtfcpzw0kgoGtu3/+rePocjrfSeAUIs",
+	"Ifk9lIyJnf9pz/nX35qZE3UJt3oyE8ZmcpyGyTwnzdGk4510vHv1Jdout2XQ725zW9YDxxXEze9N9bD/",
+	"RsmkHX587bBoHcco5XBA8SfV8JeqGm5RnZ5L3lYLt9zfm0zFuFow7cK/g3VgwvTuQ43P5KpuO7D1RHap",
+	"dovtauk3IfKJteybg31qlqXtaso7JdJBQYQ6rQoSE1GCHXQZ6FUzo1Hw2e0P67Gjd8MVEozkhbBfPI9L",
+	"14bLDmRPrHXjS4IqpwjyScFs2CRMrFVw6Ec4z/3+uoTDFQf76qVeXOT/PV1QsOzRL5535WjYkfEfF3S5",
+	"JEJGIWlcvGYQGXlNBFXDInN43me2k/csbIi/bsTgmBr7aKoIBpGrMVm35oz92sEZJ8L8DQtm/G0PBYVo",
+	"GJ1ThS34SJfc5FrqgZNNghmTbcxSgk3/NfqInvp3UT8bkFtRakaDYtj2wclxuOlDImwNA3JGl3qZzgAw",
+	"n71kWje2JkzVvx2B3kXn4ioIUbOGZFev7GzDstl8dk7WpeaJ3OMSlwwbOmZrEqzVDyZSsCx18/1/zQ5P",
+	"3iUpVlnFFNbz2RGVV0lNFZVX8V7GJT/p4J902Pe+1QOet/G+J07dm1AmpbX6BtxEYFUJkuofNIkr9x1l",
+	"tgbZhvr949iXOXESQ29uGqZDPVOnONRvEByjfKhv07XnID++b5LJhgWke2PinFevHaTPExK7ZzoWGaPf",
+	"f2MoAEFIt9pFb12Iqfm1JAI5yg7MvHn+thAc2vxCLK271n7p+KxkoWH/vLsCw3b/CLoS+SAvti8G3FPZ",
+	"PHXU8/AoIjvuew6B/iZfBv21qWpr+Mnqo3QhqCZJkE03VatluSlRpXgthYG4TSeHrkktN6nlusRMX7lt",
+	"FXNBz7tWzdVDH0LMeT+tMG0MXpkYdSeihhEGkIKO4aWzq4cjRChClq6hbL759AiZC+CzT5Ym3M7+N0fG",
+	"6oQkUXXtPBe6bcyuQYCQudhHBEKnjYsJ9Gq4ohwKgpWmv2ZoYHp1hyj7Gr+Hehf6Sy1/91TzG+sunxyi",
+	"x0t+7gA9iAcuzW7SjmhOfjDVkGkGER6QB8UF91DZwIjAV6xrndUXniqdwDSe1tRHZ0IIOzSOC//3Y/qM",
+	"QC2dNmoQYNBKIsIgMy0R2wOszwQagHLeOMLG8oaww6nwp1f9kRXxtrMlqVvx08ATTqr4L1cV3+I0ep/0",
+	"ljreVRfSVcIdcw+H06+HzsXmtIpcfp2sROgLB8dpHwQnh4WPmU/dUs/iPYw7baw3GDg4hV5tgeb8slJI",
+	"44fJgpHHs5On64+X4JuxiJYd7ywUcrLgusW8sepQHkKQBUm64uvCMSmGIOkvsrp041DbhC4Z12+BTbaj",
+	"xw4WYxzrr4ko8Ma4oGF0FVb8gMl20UvtEqX/GdBOhX3OObdEvcJQ7OunUqWoGBks/HG8QMZDODhJn4XU",
+	"8F55TQFCBLC5e2zU8txjRJyns05CxINVY7BzDxs94RUpE8nsH7a0s8JiSdQpuaYyyR+7KGphW0Vwc7t6",
+	"zK1Je5yaI1JJP525heWrX3DYyvaFb/dk9lYYyQVdKO33ZgdOJYq0n0OhCB7MYHcB6q55bsoE80pJmscw",
+	"SlJQ0KzIxnQB7teg/PiQrVbMdGR/zsR1CJxjKgORZ7zRCsuV3QiWwXo6qO0G/qknuYAfPMgdEBl7REqA",
+	"0lQaMbJr4pDu/GmqzzN4heZB+q3W+QODlWtqUbG6maavkHNLanEESm7Mm1m8wiVYfKzRo0aMW/GIBmJ3",
+	"ZfV9JO/UxuRR4YyRm7fx1Al6WkZuEGRWQF9RnxL+sjCFaHWyS/2He/ciDyS5prySPRO4Jp8wi+WAf6Sk",
+	"yHuL1+rvAWrafjV+18+RRxYHSVjdzCfYsEol859dl3TM/a2suS8K715kaoiszX1Fkct4ZRtbpMmHGjfx",
+	"ewZ3xW+AsYW2njvQ2CTMWLsQGpW2O/6gY6PObOKTdJ28sFE0HiFpDWo17FriZOCDPs4M11zOCItUew09",
+	"sE9l1m989rm3zI+oNL+GQRURocIwlebu6oQ3vFLbxBvkXawY4eHfxqWPgA6ign39UOVLMryIdnuN5Lwo",
+	"dMqmt+xHk/p7uP6SF56wh9vNikuCLvVxopwTafMVYpcZOhYdovtqrkPni1K8BvgTWdOeln0eUSYVwaC1",
+	"NPnAZSVLSLwYZ40/MRQihVtnQXBGl3K6e2CUjZxmprCaOXZ7w81mWngXvhDDNzVGw87kqq6d2BsU2SnY",
+	"Ffi66dXqIkiQhM4VvmwHfdFrrMhfyeYES1muRLL4Wum/w7hSrk583wan5AtFRfYlr2hpVDS/EOGtxhGj",
+	"8hUtQZJSPovtddAhgSThkiLF87Ak33+LXFSs3TkA6Gr0FmLIFHrwbRfDKsNjHnASrOtiKsvDDDnF+sgL",
+	"/YSLIn6sevut+Id3p6/0Rc4Kzki6zOsNuVxxfmWS5icuEHyrc4dCWU7GlT9HHzy+pMqdMxbEnrXOKkzV",
+	"ysQO6hZ2yojEAsmnbUQ5TEOkSShqstRKosafb8guaLDZNvPgtGLPVUpINb8bBtxkS7YaTn2LMqxDAgFA",
+	"OWdPlGthkkoH6bwmffj96sOzaCH3s2q5JJBOEKKB7OHotraYHHW50efoKaILl1a4LTl+8zwqOU4K8TtV",
+	"iCeqpoxx6621QgaOLodAdCZBsIzePLTG2YoykpzqZrVpTaAP2opHFzPLuV3M7HpsMm4q63z0RBdBsPmz",
+	"qUSMN9VcdRb7A3QKy0RZgYXJi+eC2uxmAY21HtszevyaCEFzghJWTtlP4iwsa+ChtxCMvo8uZmeGgbuY",
+	"IS7Cnd472siSZDuY5TsWpIMkP2YXsRu3ZMJjQI10sQfh/OR1/bi3HseT162wBFeb0pfORnhJonGHlVq9",
+	"3LbCqp5PdzS59x3e2SKrcWbKcLk95WQswddD15XcPr0YrB5PVmXJhRpco1Rc4CXRleH6FwqDmsapurUx",
+	"xm7AafMPnd1C1bDpZqZYEbHGBfqNMyJbyS3CfokMFzb3Ttg0VtPlQ8MLOQqwYC7KUE6WghCJDkkhaeWS",
+	"rXJhijTkwMM8e/rUrchELjRTJsMjaT3okRK0RObxtCsPNw6KVD2cLcahf4PiG4wz0sgb8CzGG+jm/WjQ",
+	"nrA3H4fcyL2swFLu2S7uv3/XXf+0pwdtZsb48Ofv/15eLf+ugdgFwoqbooQ2l0fzxMdm0Wh77Xe322zQ",
+	"dCUNE7oipxGceOXJI3TyCPUhB8Hl2c4ptN35bv1CW6PH47YjjZrB260Gk5z8+H5jsSMZZRdsdZzcx75Y",
+	"97EYWRq6+52Y7sbbbxXyaRYAzBFxZgo+WbOHG8Dd9wURifJGLViY8cds1tPecYKDtRXF5YWtY7O3LLjd",
+	"65tisfpA9dSHaBQV9cDV/hXgNxBkWhpTK2Ibr4D38wF8uoWzkN+Axb1dOF+6Jv/JWz5qs1fcBNi21qBh",
+	"Aoy6r1UgpA0Bg9mOD94cuDyMB6cvD/ZevT08OD9++8bVDNU/NnlgU2VPnzQXiGcEM/OGuJ4+359uXGKh",
+	"aFYVWCBJ9UlQtaLMe67hJv9/sCaCZnjvDbn5+//m4mqOXlYa//ZOsKAuGK9ieH1JlxWvJPpmJ1thgTNF",
+	"BFJur9YL0Qj0JEdfXcx+en1+MZuji9m788OL2ddR8mRssmfZiuQ2jr9tb69fbGlbwepxpbg+xgzl/IYV",
+	"HOcmY2xu0U2GhS4VXbuvvDQmG6SMHTjCSwyaZQ8FZ81KXJC57yeBM3IUZAcYa19WAXL1vp2uXYdGx4lS",
+	"wBI1t3id4pW0gSggufGCNYmL6gbVAt/foFiZKzvd1p7aiJ9GAQ0klSB47UJ9blyZtubC7d6SxreY12jo",
+	"O2fT+tVOxCb05+jlq5fnL48Q0Ss2tRhNsA2wafax64TNQNeXp6dvT31HjCzFCep1G10wMVsyxXoKLhuq",
+	"rnaK1340qIEbzYFgjVh2zBhetAboVT6EZwU7CEouhebvg6Ojl0c6xv3t0fGPx/BPC1Ud9q+BNDLnQb24",
+	"gzwnmtuof3ltXRobP5pQquZvUJZs9v6jKb9dCao2msasDRZdEiyIOKjUqv7rR/cy/c+/nWuJBlrP9u3X",
+	"+qggHx3UVl8eJ8K03r2LJwJvlM0J8Qi9xqUp1NVMbV4XvtrV4IEHXU8ClZddPNa+XsrfaWDixCXVdvGP",
+	"HyG95oK7ArzY3ByyxrSY7c8Uwev/4dXQu5TXI+pd/AhfoCSt4AU6J3g9s+blmWNkG707FZB+bQ7x/qtY",
+	"t68tT2/dHY3fhTZlmASHxtV1TYzqDfgveKZJviSNTKhqRahAOnZevwXSlNMuaEaY8XOwOzsocbYi6Pnu",
+	"085mbm5udjF83uViuWf7yr1Xx4cv35y93Hm++3R3pdaFodhKv1azFpAOTo5n85q6zq6f4aJc4We2TibD",
+	"JZ3tz77Zfbr7zLpEAz5qvn7v+tme1tzvZd6UsIzxsj8R1dbwNwwMu746JeVMY+hM47m1T8xnRgUqzT14",
+	"/vSpww1Lqa3DoO6791/W9mXIzhBRCmYBxGul8/+rBsG3z/58Z/N5RUW39HalVqZcrYULyWHy5//xAJOf",
+	"c45e65SbNr+JUaUovATa1jw4Q58ah3+NCwoRoqnj/8U20KSihQZQJTl+/K4XIJ3Aa6KIkCCUdKlXbFRN",
+	"m9zSPBVaEZwDZXRXq1IrXbDMZd2pQdnmG97fIx72HY3eCWwD8OFBJv0B5w4VzKTPHmynlNV7/UNevPns",
+	"uwc542On2zNKJVPVdfS9v6yKK39jZfLig+Lth6q4euvaNkOqmpdet240lkNXH4rtWdHIN9T3HpgNH8MF",
+	"piGvJTOeXGElfZc5Xo+gB4B09KbosWo3euJKxz+xxb+tf4R3Jm1WVk9wP26QXoozj5WctPWtTcoRJWim",
+	"6oLofGGdgHwtOWnDbaiwUWNNW5lOT7NRrqR1bKHQ6yxwcH+g1QJs5dzJ5lC/3Zav1iC+IujJiydz9OSF",
+	"/v+alXryby+eoK9MtZYLXfD62Qs4t2fzK7J5/m/mj+dWoo/tFGa83U7PA7NwWAjfIJ7fZFie3yMIOvco",
+	"aXJcm7rvaURrdNfm2waWQ9JsM6jrb/FXK431hW7UWUBYBhcH8tvI6tIUNlXmFiUxg66pasBp0KfsXt/Q",
+	"BuUAm02apftyX9F3DFuOxr5jT795gFl/5OKS5jlhj/50PsRuz6zY9455j7bGw9l4HEGzVPKYCdEkWEE4",
+	"8UJ2H0jTodF66IX8m77xQFI4OigKF5wNIENUegY5B1+7RuQ2NW53vmh1ihDY6Pf5SLgemeYfXXo3ItUP",
+	"PN/cDx0wx1crmrSq7WOHCD27z8ljZ55PVOjeqdDTh6BCWnlQ0ExNdC9C95Lywt6/NOn4aCgipJSKmFAK",
+	"shVtNB22oo3trAXxqUwaBT24J4CQFsDTP/hPm8h8fpqEt3/9g93/bx9gyjdcoR95xfKJAEQZn7Q2ePTF",
+	"/omoe7rVS6J+D1d6kKeYbvZ0sz+zp30vwywjENSZkH3gOzjYQ34SKDBaMcj30UB4o8to5DGC7A24EATn",
+	"G1+nMff5koTWall5pSU/waT3RE3MjieCMhGUMQRlkk4emYRpNw5xibMwCa/1GzQWZ7jYs/1Zi75ZwvYx",
+	"JIFZXTxCmuIRztu23zKSLDoxZCVJdpwsJpPFZLKYTBaTUbQ0SUUm68lkPXm0dzr5mI6xpAy/qCmrSl8B",
+	"qMnCMo5uPLS1ZWAhk+VlsrxMNHOUnDLCIpM7i0xw65C9dqimlTGrzK1pa1v7MkzeJ2vNpIKZdLp3wGhF",
+	"tRZa5Wo0Al4cynrudseQ88CE4M4MPPNZxeg/K3JsIlN140cSzSZaMdGKz08o04q/aH6rbHVLoQz6PjC5",
+	"KF345l2wDfMvVU7cATD99+0wD45zKynxkWnpJCBO9rZJJr3HJ6OKspdlgTPS4jAPR3OYp6b/Az8bdUHC",
+	"6d34HPSLj/pyTOrN6fWaXq9Jo+od4XBZCm4r80cfvQNoYKosErbpk5e6YpLJRJTscOAmv7OHT3GEmwu+",
+	"Uz3r9JhMYshEyCdC/vCEnDPJCyJNdrQhvz3T+Mw0HnTWa7SePPQmD73beej5tFpQjPCFyb65t94Y3/jJ",
+	"T+/L9dNrEJDJOW9yznu817TxTPY+oYFLSU8UYOIpjYYBNttuLdPEZ/odBQK29j9ZgidL8O+BAuwJknGR",
+	"2wLCUVpwZLMhW/W1bW4yW7furX7JZUZphqVC189tRuoR9OLUr+KOCIdJy16vVnGf1flxqMmHHQ+ZJiZ4",
+	"9uWSQk3XblLkiZJMlOSxKUk0Ik+Ti0Y4XpvG1MSlEZJnhCIzjG4kqzVJRyOfwncfZXyJpZbfmJEva5EP",
+	"s3yPWznO/7obMUPq0Yx8Jmf3o9Y0o5uZHkmh2VyCmWQSTCbB5FGISeO+bxHda/p5ChGhIQO6QHMLhnSA",
+	"NTGYdH+T7m+Kzv1CtH4RHLEFYNGiwCASmHouxNaAR7Jar7HYuNtnScwuCr18QCpwnjwGLABJDQBMmRlK",
+	"f3aDBeWBbOUbADjoiZ8YbGrg/ZMaRu0KOFCW/okdWA/1BEqjiip59YO2MSzzBXG7wDqD4im2WIevPefr",
+	"I0jEyE2hC1bnBA6H5KguluGvGGVSEZzX10/fLVOGzyGj2bVs3llfusXOr8sY0aKBpwZyeuonACa6ZFyk",
+	"3aig0siWMIBzh46ULefmaGUHLv51gapIQQEaVyNwCd4/AqkVZkFNbijRXTFJ1DzYM4LCP24shqAKiqkG",
+	"Y2osaSpqbp6GZaiTi227tZZHS6Jv3thJPT5xoY/MhY4JVG+xjamodNNsCkGP3/WHjjcPZ528L6fg8j8Y",
+	"UeuKxtsk8h2keKblOIrX1tG3Bp+iwCct/KSF35Zl6THTD17en4i6s5v7OzHHp7mB6dpO1/YBJY3+6OvB",
+	"qwsN7+zyTkHUX2QQ9TCxm6SeKVRhErTuiqbHIsVMsNcYkm4Doe+MqE8hzo+ov3o4Ij7pyqZXY3o1vjj1",
+	"3F5OMr5eU+Nnl4pD1ivJq4IERlijRgv6dlV29cc7VNzVg37mwcVm9SEUJr58orCT5uOR6V2BpZKEsN7Y",
+	"I/A9wlIh3RIpuiZS4XWZIEw9Gs9XWKozPdudaD6T61pwcafU8H69MBxMenjNb7vn8oajQ7uIiYxMZOSR",
+	"yYggLCdwoQbIiGto2aYorTi1be7SShKb3PlCGnDeJdWIuokCpbpi/Ib5hVg/sJQkDo1Pm21nn6sNZ6JS",
+	"kzg50cUWXZRm8CGqaJqZIM7x3JRd+WRFnqzIExP0uViRt77OgU35zi70nVqWJ2vtpBWaKNkfzna6NSFr",
+	"WFLvjJTdrT31D2WjnEjXJONNMt69yXgEi2yVFO3O4HMn5l2HOWOkyAeFFgIv14TZcHOSI7zElEllw6A1",
+	"bZsjXFAs5zaCG6Is5UYqsoZA1l10amN/sSCIC6PcutwgQQpyjVmMSpt1jQyy1/QZ1qo4MvvVWjIdmq39",
+	"JSXC9SbgjZBEUFzYQOg5wgwdnyCc54JIibhAGK24VHpnLt4UxqQSZViSHcokYZIqek1gqxCMf0kQVqgg",
+	"WCr0jY70FDjTy0UFT8eu/7P3VVhT9oqwpVrN9r+Zf1I0u9mA1yh+pjH36VX+rmLtP8eUDxaD7yfpw3bJ",
+	"BdpL2Tq9wJRPNMFiGZppaO0UND0FTT86NxLN2VNozIxl7Gmm6iFM8KLQb3bG2YIue5XTdeNG5oyYTvql",
+	"b3poxt1C9sMj6wcZQr+A/GKISlk1q2pCDgddcYHmJJ978q8harKCrEh2palbf+EGmzxExieBZ5Da1BEZ",
+	"lsTnLaHOymjpchsiu+iYIVwUiKsVEdDXLDKAcjiRIc6w8kuCyLpUSbqZSfFohsHOwU/UcdKl/UEIcn1z",
+	"o6US6s8lL2hGhzKk1VfpRLffDOVKa7WnU9q0KW3alDZtKpaw7cNtqM0k2kyizWfwksJTuRmRGYql38tU",
+	"kqh2hyld1BBReOjEUfH5p7C4KYXUH5YO9goUW+SV2opemk7b0su2QT854ZR2atIxTDqG23NG6QRUW93y",
+	"ht72Hq7478SzeAzTMd306aY/igzUm7Nqq9sOfe71vk8prb5IJ+lt6OMkj00+h5MIePfPQF+aq61eAeun",
+	"fa/vwJQF67NQyz3GCzApA6fHZ3p8vnj9o3ArH+nQ0HbnGvRo8KCZPBomj4bJo2HyaNiKX7DUY3JpmFwa",
+	"PivnwG18GtpP5rBTg+0xeTUMEobHc2toLGASZSa/hslTOipY3NKxYZhotj0bRhLNtP4rFqky+TZM+o1J",
+	"v/G7YcrGuVMM05aGP8W9EJbfnUdFD78zEZjJpeJxZLDRPhXDV77lVHEvl35yq/jC3SpGEMlJHpxYv4n1",
+	"u4e3YKxjxfBT0PGsuJfHYPKt+DyUg4/yDkw6yekNmt6gP54adA+XOrENLpJFyQ6gAUFcoJywTfTt6j5Z",
+	"ttc9PFmKI9xc0meexLWzhQMH8sd+DtxChlW1E4Ge1DcTubxV4Y1PV/TeLuf1pO6d6MVELx5P3ftJZCCu",
+	"/L0PQjCV9JjUqhMFnETaL0Gt+kkkN6VkvQ+i+7soPvJ7Ul9OpG9i/h5NWLzW8yRFwlOiBCXXRCLsY1NM",
+	"l90LFo9VMgMOxSf9YUJgzrhQpgoJpOhWqzok5XJTl+duhh890WM8QV8xcqOp74IKqZKLg8Ebi8rNUJDs",
+	"XGaz+Yywaq2RAcNf8OP7+W3Dd8z5m3PTR9SulHCXcTHzP3hg25kSBK8dyLFEjNwUlJGdnAA4SY7+BtLW",
+	"NZSbsTeFMqkIzutbpK+IucC76C0rNm7AzNixEF4AFFcE3QAMbrBEUmEB3wRBEpZBcgNCAwV/gd3tlZaF",
+	"MU3tFHN0s6IFQU8AS/WtrKEJVxO28QRmoUvGRdpACkuLge+S84Jgdt/aHL2fKXRqCp16vKdcY2Ds+a4u",
+	"/TBDoce6/VnQfjD0uN1hCj2eQo+n0OMp9Hj8mxlSj+n9nN7Px30/w8dyROhxz4uZjDxu95gijwfpwoNH",
+	"HscXMHn5TZHHf1xa2CtXbBF5vBXNtIHH29LMjskiOeUUeDzZCiZbwScwSOkw4K0uunYOu99b/ntxCxvD",
+	"e0y3fbrtjyMO9UYBb3XjT7xl4v7u/BQE/GV6q21DIyfRbPJWm6TBe3gK+oKAt3oJnHva/b4FUwzw56Gm",
+	"e5RnYNIOTk/Q9AR9sQrJRUHIUF71H3WbIYeGH81AkxPD5MQwOTF8MU4MHcgds6yocgLTrtdYbNw1y4km",
+	"Q9JtGuhKaiU4z49M8zMzSL/3Ycp7M1thtiRwL/yUd+TMac7YoJZsXgXviGmn956Y9+mAGWeaoSNlyzni",
+	"2vlUdsDiaTa6oUqrmdwPv2iOmTO0BGZLu6hifW6G267ILjpeoIpJoubBno3rqhuLoYOjo5dHxh8VfLCB",
+	"OBmE1rAMxZbYtltrmT2W3hhersmBZnKgeTQeDSjXGKeZJieWcpSBVpNzTPSeP7RDTDDpJOZOTjB/LHrW",
+	"kTP3/gX//binyLossCLX5u1PC6DAPLvWyDePSaDnttUvdaNBJSi/YYb31wSuM01C5bmw9PUTdJ6THDzJ",
+	"wZMcPDnzazrboluTJDJJIr+jl3uE/2ru/FfbD2zCabV1IT75Hb+/Z7xtRx058+QZO9nDJl+5puYjyv0L",
+	"raBVq/DdH6QhPxE1EZCHJCBtaE+UZKIknxXnMj7AZki/ahqO0q+2b3Zz6Cl4ZrrY08W+CxbBBMwMXdyf",
+	"iLqjW3uHwTCfhXH93i2rE9mYyMbj2lT7I2+GSAe0uyPiMUXVfJFRNYN0btLZTm7Mk0n5jsh5b/TMEDW3",
+	"ETN3RM+nyJjH89F5MPI9uQNNz8X0XHxZ2sA98HohN3oFcZ/LE9OgIX6rFVYIWwdr53ZemybM+4AXC5JB",
+	"gl6qVrxSSO9/o/1AdGvTNyJlmOnuSs4wo93bwwQr8P4iDjzaB/xmRa3zkSAsJyK02NDapaTpzfNd6nWS",
+	"eF0W5Iz+Rvr9NawPy2z/2dOn89maMvPX05Qnx5f0cFnMmcSPyWXkMUntfPZhR1ziDJaR2b5Lax81lMmZ",
+	"TKWnvh/TBFpXV+CV2sOXXKg0mT7Qnw29MR0cUeyQZZZDE3SJs6suVb8hgiBcaIPzxrL1uaPwMMATWXtK",
+	"tiyekUKnelXQ7dSs6hNJ+s2Ky3qHiiOAyu/BdDSpRiZed+J1H40AG0oWpcGWmoyhwSWuJOlhlfXnETR4",
+	"F73haFEJtSICXWp9LZEQH5lTCSpfkqOKKVo0xgKuUVZrknfpLMx8n3QWdj7R2YnOTnR2orP3TmcNnUsT",
+	"2lP4jrAhS7kOMpGlFrNzpEVvTE3cdT8RjqjE9aj3SUXNviYyOpHRiYxOZPTeyOhAGX5wJq8rwUZoY9Jt",
+	"7HblXu/VeWzy25r8tv7Aflutqs5beHHd1V2eauRPXNVExCYidgtvJWGckLZkRkLXpbsiYr+LmvOfo1PQ",
+	"RD4m8vGQ3it0jZfksqJFPpCs9Vg3/EE3HMrYWrec0rZO6WqmdDVTuppRZK0mG1Ommsnt6NHeyPpBHJE4",
+	"k8WexVT6zLrp7H742WCCB85G2Z558kGfUlL+AclFnK/eIlHESHpimjfoyVbyemSSKXHEJEVPUvRtOIR0",
+	"9oiRt/knou78Kv9ODIL9fMN0l6e7/MDcfm9Kh5H3GVrf+Y2ezIJ3TFUmQWTyuJpkn7sknn0JFEbSTmuL",
+	"vHPq+buwR26rv3lYijnpiyYyPZHpL1pFNeTpetrn6dqg2T0S7u1cTCY5d6I6k5z7IHJuywX2dlLvnd7y",
+	"SfadZN+JvE3k7ZMk0dMB59ge/qUjld4pdZtk04l3mojL709+Mg6ZPcKSEpRcE4mwTkShKMuUd5w0fSGz",
+	"WZMK1YRhU5LdCxb1sH1lZh5BfvQo1pfR0xthF+YXIfg65SR4RVneS34Iq9YaSCY1vA6/HOFXuqCF9fNt",
+	"rwXqjesFBSXGIY9S7c27pNeEmfbeQfVevF/vYJXG8XNolXfuuVqjm1mv2UIlmPNBHXJvfjjfUPIBUvFB",
+	"D7Pal+YX/YOtVjDbn9kf/cLh5hTuGoCDrMZCwq6p4GxNmHpRCp5XJgBYr2xJOXtRyR2Cpdp5pjdAiXih",
+	"k3YRZi/2OEICl29yUZ1cVB/tQQK8b75FXCwxo7/BOsY9Se4lavTcReitpm2GWsjmR0PiNPmoJBFohSXC",
+	"WUakpi/xSJC3jVXdI48YTjRdzelqPvjVrF8qCJbiLcR3Nzf8vXmBBSm5pIoLSgYCsU5dy81QINZpOOYU",
+	"iTVFYk2RWFMk1gjyV1OY6S2d3tJHY3P9k7gZEYkVexZTgVh106FX8Y9XKCWAzQPHkLVnnnyCphiyPyCh",
+	"S8gE21QbHkUKTevxpLBtzIpMMsWQTTalyaZ0G96mpwLxqMv8E1F3fpN/J651/WzDdJWnq/zAYkp/VeBR",
+	"19k6kN3xhZ6KBH+RHn/jCOAkMk1hFJOUdpd0vrdc8Cgyb90K75zQT9WDH1kp9rDEfVLCTS/K9KJ8UXo/",
+	"a9bfsGzQGcA0PduwbNgdoG47+QNM/gCTP8DkDzCSKagJx+QRMHkEPOKDWT+M43wCIq9j2iugbjz5BfTR",
+	"gIf3DGjPPYklk2/AH5LkpaSE7dwDRlFF5yAwnip21VORiSYngUkjMVkWb8fu9LoJjLrU4ChwDzf6d+Ms",
+	"0M9JTJd6utQPLsMMOQyMutjWAn0PV3tyG/hC3QbGkcJJnprMPJMId7cUf8B1YBTB984D90DyJweCR9ee",
+	"PTShn/R10/syvS9flIpQ00izgqTeQNqhbduovuAXO849kig3RQ8bOhnbHhqtHP68h77Gjm64ikoUs/3Z",
+	"3uzje9+6jVxvHRaZHGeaEhKm7BZ263e6+WH2cd4zEGfoh6q48r80/V3sgJdVceVReHC8QyIUXejZyRld",
+	"MsqW9hyiY2d1a2laC/+oDADAZhDTzhaHnElekDMiZWoTmWkiTZPBXZjca9GRcvg0vD7TDmHIl9UdwP4+",
+	"uJKXTPCiWBOmTnhBs010TcQ3KqHRFqP2nU497G1OhVzraxMOp38YXppudVZd+hbxpcHgQavBcZsV9cOx",
+	"TA3vof6pat12kCCl4TZAsunkcCa4lCiniwURhMXXCW23Gj1M4hQdspE9ZwgCqTQ5dqzA9254pJSPnR8r",
+	"eIBH7DgjFDYceX3tiNfuQXz/8f8bAKohcuC19wMA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -52,6 +52,25 @@ const (
 	Vmdk  BootcExportType = "vmdk"
 )
 
+// Defines values for BulkOperationPhase.
+const (
+	BulkOperationPhaseCanceled  BulkOperationPhase = "Canceled"
+	BulkOperationPhaseCompleted BulkOperationPhase = "Completed"
+	BulkOperationPhaseFailed    BulkOperationPhase = "Failed"
+	BulkOperationPhasePending   BulkOperationPhase = "Pending"
+	BulkOperationPhaseRunning   BulkOperationPhase = "Running"
+)
+
+// Defines values for BulkOperationType.
+const (
+	BulkOperationTypeAddLabels      BulkOperationType = "AddLabels"
+	BulkOperationTypeDecommission   BulkOperationType = "Decommission"
+	BulkOperationTypeMoveToFleet    BulkOperationType = "MoveToFleet"
+	BulkOperationTypeRemoveLabels   BulkOperationType = "RemoveLabels"
+	BulkOperationTypeResume         BulkOperationType = "Resume"
+	BulkOperationTypeSetAnnotations BulkOperationType = "SetAnnotations"
+)
+
 // Defines values for ConditionStatus.
 const (
 	ConditionStatusFalse   ConditionStatus = "False"
@@ -71,14 +90,21 @@ const (
 	ConditionTypeDeviceUpdating                       ConditionType = "Updating"
 	ConditionTypeEnrollmentRequestApproved            ConditionType = "Approved"
 	ConditionTypeEnrollmentRequestTPMVerified         ConditionType = "TPMVerified"
+	ConditionTypeEventSubscriptionDelivering          ConditionType = "Delivering"
 	ConditionTypeFleetRolloutInProgress               ConditionType = "RolloutInProgress"
 	ConditionTypeFleetValid                           ConditionType = "Valid"
 	ConditionTypeRepositoryAccessible                 ConditionType = "Accessible"
 	ConditionTypeResourceSyncAccessible               ConditionType = "Accessible"
+	ConditionTypeResourceSyncDrifted                  ConditionType = "Drifted"
 	ConditionTypeResourceSyncResourceParsed           ConditionType = "ResourceParsed"
 	ConditionTypeResourceSyncSynced                   ConditionType = "Synced"
 )
 
+// Defines values for ConsoleSessionRecordingFormat.
+const (
+	ConsoleSessionRecordingFormatAsciicastV2 ConsoleSessionRecordingFormat = "asciicast-v2"
+)
+
 // Defines values for DeviceDecommissionTargetType.
 const (
 	DeviceDecommissionTargetTypeFactoryReset DeviceDecommissionTargetType = "FactoryReset"
@@ -103,10 +129,14 @@ const (
 
 // Defines values for DeviceLifecycleHookType.
 const (
-	DeviceLifecycleHookAfterRebooting  DeviceLifecycleHookType = "AfterRebooting"
-	DeviceLifecycleHookAfterUpdating   DeviceLifecycleHookType = "AfterUpdating"
-	DeviceLifecycleHookBeforeRebooting DeviceLifecycleHookType = "BeforeRebooting"
-	DeviceLifecycleHookBeforeUpdating  DeviceLifecycleHookType = "BeforeUpdating"
+	DeviceLifecycleHookAfterApplicationStart  DeviceLifecycleHookType = "AfterApplicationStart"
+	DeviceLifecycleHookAfterRebooting         DeviceLifecycleHookType = "AfterRebooting"
+	DeviceLifecycleHookAfterUpdating          DeviceLifecycleHookType = "AfterUpdating"
+	DeviceLifecycleHookBeforeApplicationStart DeviceLifecycleHookType = "BeforeApplicationStart"
+	DeviceLifecycleHookBeforeRebooting        DeviceLifecycleHookType = "BeforeRebooting"
+	DeviceLifecycleHookBeforeUpdating         DeviceLifecycleHookType = "BeforeUpdating"
+	DeviceLifecycleHookOnRollback             DeviceLifecycleHookType = "OnRollback"
+	DeviceLifecycleHookOnUpdateFailed         DeviceLifecycleHookType = "OnUpdateFailed"
 )
 
 // Defines values for DeviceLifecycleStatusType.
@@ -168,71 +198,110 @@ const (
 	DeviceUpdatedStatusUpdating  DeviceUpdatedStatusType = "Updating"
 )
 
+// Defines values for DryRun.
+const (
+	DryRunAll DryRun = "All"
+)
+
 // Defines values for EncodingType.
 const (
 	EncodingBase64 EncodingType = "base64"
 	EncodingPlain  EncodingType = "plain"
 )
 
+// Defines values for EnrollmentPolicyAction.
+const (
+	EnrollmentPolicyActionApprove EnrollmentPolicyAction = "Approve"
+	EnrollmentPolicyActionReject  EnrollmentPolicyAction = "Reject"
+)
+
+// Defines values for EnrollmentPolicyDecisionDetailsDetailType.
+const (
+	EnrollmentPolicyDecision EnrollmentPolicyDecisionDetailsDetailType = "EnrollmentPolicyDecision"
+)
+
 // Defines values for EventReason.
 const (
-	EventReasonDeviceApplicationDegraded       EventReason = "DeviceApplicationDegraded"
-	EventReasonDeviceApplicationError          EventReason = "DeviceApplicationError"
-	EventReasonDeviceApplicationHealthy        EventReason = "DeviceApplicationHealthy"
-	EventReasonDeviceCPUCritical               EventReason = "DeviceCPUCritical"
-	EventReasonDeviceCPUNormal                 EventReason = "DeviceCPUNormal"
-	EventReasonDeviceCPUWarning                EventReason = "DeviceCPUWarning"
-	EventReasonDeviceConflictPaused            EventReason = "DeviceConflictPaused"
-	EventReasonDeviceConflictResolved          EventReason = "DeviceConflictResolved"
-	EventReasonDeviceConnected                 EventReason = "DeviceConnected"
-	EventReasonDeviceContentOutOfDate          EventReason = "DeviceContentOutOfDate"
-	EventReasonDeviceContentUpToDate           EventReason = "DeviceContentUpToDate"
-	EventReasonDeviceContentUpdating           EventReason = "DeviceContentUpdating"
-	EventReasonDeviceDecommissionFailed        EventReason = "DeviceDecommissionFailed"
-	EventReasonDeviceDecommissioned            EventReason = "DeviceDecommissioned"
-	EventReasonDeviceDisconnected              EventReason = "DeviceDisconnected"
-	EventReasonDeviceDiskCritical              EventReason = "DeviceDiskCritical"
-	EventReasonDeviceDiskNormal                EventReason = "DeviceDiskNormal"
-	EventReasonDeviceDiskWarning               EventReason = "DeviceDiskWarning"
-	EventReasonDeviceIsRebooting               EventReason = "DeviceIsRebooting"
-	EventReasonDeviceMemoryCritical            EventReason = "DeviceMemoryCritical"
-	EventReasonDeviceMemoryNormal              EventReason = "DeviceMemoryNormal"
-	EventReasonDeviceMemoryWarning             EventReason = "DeviceMemoryWarning"
-	EventReasonDeviceMultipleOwnersDetected    EventReason = "DeviceMultipleOwnersDetected"
-	EventReasonDeviceMultipleOwnersResolved    EventReason = "DeviceMultipleOwnersResolved"
-	EventReasonDeviceSpecInvalid               EventReason = "DeviceSpecInvalid"
-	EventReasonDeviceSpecValid                 EventReason = "DeviceSpecValid"
-	EventReasonDeviceUpdateFailed              EventReason = "DeviceUpdateFailed"
-	EventReasonEnrollmentRequestApprovalFailed EventReason = "EnrollmentRequestApprovalFailed"
-	EventReasonEnrollmentRequestApproved       EventReason = "EnrollmentRequestApproved"
-	EventReasonFleetInvalid                    EventReason = "FleetInvalid"
-	EventReasonFleetRolloutBatchCompleted      EventReason = "FleetRolloutBatchCompleted"
-	EventReasonFleetRolloutBatchDispatched     EventReason = "FleetRolloutBatchDispatched"
-	EventReasonFleetRolloutCompleted           EventReason = "FleetRolloutCompleted"
-	EventReasonFleetRolloutCreated             EventReason = "FleetRolloutCreated"
-	EventReasonFleetRolloutDeviceSelected      EventReason = "FleetRolloutDeviceSelected"
-	EventReasonFleetRolloutFailed              EventReason = "FleetRolloutFailed"
-	EventReasonFleetRolloutStarted             EventReason = "FleetRolloutStarted"
-	EventReasonFleetValid                      EventReason = "FleetValid"
-	EventReasonInternalTaskFailed              EventReason = "InternalTaskFailed"
-	EventReasonInternalTaskPermanentlyFailed   EventReason = "InternalTaskPermanentlyFailed"
-	EventReasonReferencedRepositoryUpdated     EventReason = "ReferencedRepositoryUpdated"
-	EventReasonRepositoryAccessible            EventReason = "RepositoryAccessible"
-	EventReasonRepositoryInaccessible          EventReason = "RepositoryInaccessible"
-	EventReasonResourceCreated                 EventReason = "ResourceCreated"
-	EventReasonResourceCreationFailed          EventReason = "ResourceCreationFailed"
-	EventReasonResourceDeleted                 EventReason = "ResourceDeleted"
-	EventReasonResourceDeletionFailed          EventReason = "ResourceDeletionFailed"
-	EventReasonResourceSyncAccessible          EventReason = "ResourceSyncAccessible"
-	EventReasonResourceSyncCommitDetected      EventReason = "ResourceSyncCommitDetected"
-	EventReasonResourceSyncInaccessible        EventReason = "ResourceSyncInaccessible"
-	EventReasonResourceSyncParsed              EventReason = "ResourceSyncParsed"
-	EventReasonResourceSyncParsingFailed       EventReason = "ResourceSyncParsingFailed"
-	EventReasonResourceSyncSyncFailed          EventReason = "ResourceSyncSyncFailed"
-	EventReasonResourceSyncSynced              EventReason = "ResourceSyncSynced"
-	EventReasonResourceUpdateFailed            EventReason = "ResourceUpdateFailed"
-	EventReasonResourceUpdated                 EventReason = "ResourceUpdated"
-	EventReasonSystemRestored                  EventReason = "SystemRestored"
+	EventReasonBulkOperationCanceled               EventReason = "BulkOperationCanceled"
+	EventReasonBulkOperationCompleted              EventReason = "BulkOperationCompleted"
+	EventReasonBulkOperationFailed                 EventReason = "BulkOperationFailed"
+	EventReasonBulkOperationProgressed             EventReason = "BulkOperationProgressed"
+	EventReasonDeviceApplicationDegraded           EventReason = "DeviceApplicationDegraded"
+	EventReasonDeviceApplicationError              EventReason = "DeviceApplicationError"
+	EventReasonDeviceApplicationHealthy            EventReason = "DeviceApplicationHealthy"
+	EventReasonDeviceCPUCritical                   EventReason = "DeviceCPUCritical"
+	EventReasonDeviceCPUNormal                     EventReason = "DeviceCPUNormal"
+	EventReasonDeviceCPUWarning                    EventReason = "DeviceCPUWarning"
+	EventReasonDeviceConflictPaused                EventReason = "DeviceConflictPaused"
+	EventReasonDeviceConflictResolved              EventReason = "DeviceConflictResolved"
+	EventReasonDeviceConnected                     EventReason = "DeviceConnected"
+	EventReasonDeviceConsoleSessionEnded           EventReason = "DeviceConsoleSessionEnded"
+	EventReasonDeviceConsoleSessionRecordingFailed EventReason = "DeviceConsoleSessionRecordingFailed"
+	EventReasonDeviceConsoleSessionStarted         EventReason = "DeviceConsoleSessionStarted"
+	EventReasonDeviceContentOutOfDate              EventReason = "DeviceContentOutOfDate"
+	EventReasonDeviceContentUpToDate               EventReason = "DeviceContentUpToDate"
+	EventReasonDeviceContentUpdating               EventReason = "DeviceContentUpdating"
+	EventReasonDeviceDecommissionFailed            EventReason = "DeviceDecommissionFailed"
+	EventReasonDeviceDecommissioned                EventReason = "DeviceDecommissioned"
+	EventReasonDeviceDisconnected                  EventReason = "DeviceDisconnected"
+	EventReasonDeviceDiskCritical                  EventReason = "DeviceDiskCritical"
+	EventReasonDeviceDiskNormal                    EventReason = "DeviceDiskNormal"
+	EventReasonDeviceDiskWarning                   EventReason = "DeviceDiskWarning"
+	EventReasonDeviceIsRebooting                   EventReason = "DeviceIsRebooting"
+	EventReasonDeviceMemoryCritical                EventReason = "DeviceMemoryCritical"
+	EventReasonDeviceMemoryNormal                  EventReason = "DeviceMemoryNormal"
+	EventReasonDeviceMemoryWarning                 EventReason = "DeviceMemoryWarning"
+	EventReasonDeviceMultipleOwnersDetected        EventReason = "DeviceMultipleOwnersDetected"
+	EventReasonDeviceMultipleOwnersResolved        EventReason = "DeviceMultipleOwnersResolved"
+	EventReasonDeviceNetworkCritical               EventReason = "DeviceNetworkCritical"
+	EventReasonDeviceNetworkNormal                 EventReason = "DeviceNetworkNormal"
+	EventReasonDeviceNetworkWarning                EventReason = "DeviceNetworkWarning"
+	EventReasonDeviceProcessCritical               EventReason = "DeviceProcessCritical"
+	EventReasonDeviceProcessNormal                 EventReason = "DeviceProcessNormal"
+	EventReasonDeviceProcessWarning                EventReason = "DeviceProcessWarning"
+	EventReasonDeviceSpecInvalid                   EventReason = "DeviceSpecInvalid"
+	EventReasonDeviceSpecValid                     EventReason = "DeviceSpecValid"
+	EventReasonDeviceTemperatureCritical           EventReason = "DeviceTemperatureCritical"
+	EventReasonDeviceTemperatureNormal             EventReason = "DeviceTemperatureNormal"
+	EventReasonDeviceTemperatureWarning            EventReason = "DeviceTemperatureWarning"
+	EventReasonDeviceUpdateFailed                  EventReason = "DeviceUpdateFailed"
+	EventReasonEnrollmentRequestApprovalFailed     EventReason = "EnrollmentRequestApprovalFailed"
+	EventReasonEnrollmentRequestApproved           EventReason = "EnrollmentRequestApproved"
+	EventReasonEnrollmentRequestAutoApproved       EventReason = "EnrollmentRequestAutoApproved"
+	EventReasonEnrollmentRequestRejected           EventReason = "EnrollmentRequestRejected"
+	EventReasonFleetInvalid                        EventReason = "FleetInvalid"
+	EventReasonFleetRolloutBatchCompleted          EventReason = "FleetRolloutBatchCompleted"
+	EventReasonFleetRolloutBatchDispatched         EventReason = "FleetRolloutBatchDispatched"
+	EventReasonFleetRolloutCompleted               EventReason = "FleetRolloutCompleted"
+	EventReasonFleetRolloutCreated                 EventReason = "FleetRolloutCreated"
+	EventReasonFleetRolloutDeviceSelected          EventReason = "FleetRolloutDeviceSelected"
+	EventReasonFleetRolloutFailed                  EventReason = "FleetRolloutFailed"
+	EventReasonFleetRolloutRolledBack              EventReason = "FleetRolloutRolledBack"
+	EventReasonFleetRolloutStarted                 EventReason = "FleetRolloutStarted"
+	EventReasonFleetValid                          EventReason = "FleetValid"
+	EventReasonInternalTaskFailed                  EventReason = "InternalTaskFailed"
+	EventReasonInternalTaskPermanentlyFailed       EventReason = "InternalTaskPermanentlyFailed"
+	EventReasonReferencedRepositoryUpdated         EventReason = "ReferencedRepositoryUpdated"
+	EventReasonRepositoryAccessible                EventReason = "RepositoryAccessible"
+	EventReasonRepositoryInaccessible              EventReason = "RepositoryInaccessible"
+	EventReasonRepositoryPushReceived              EventReason = "RepositoryPushReceived"
+	EventReasonResourceCreated                     EventReason = "ResourceCreated"
+	EventReasonResourceCreationFailed              EventReason = "ResourceCreationFailed"
+	EventReasonResourceDeleted                     EventReason = "ResourceDeleted"
+	EventReasonResourceDeletionFailed              EventReason = "ResourceDeletionFailed"
+	EventReasonResourceSyncAccessible              EventReason = "ResourceSyncAccessible"
+	EventReasonResourceSyncCommitDetected          EventReason = "ResourceSyncCommitDetected"
+	EventReasonResourceSyncDriftDetected           EventReason = "ResourceSyncDriftDetected"
+	EventReasonResourceSyncDriftResolved           EventReason = "ResourceSyncDriftResolved"
+	EventReasonResourceSyncInaccessible            EventReason = "ResourceSyncInaccessible"
+	EventReasonResourceSyncParsed                  EventReason = "ResourceSyncParsed"
+	EventReasonResourceSyncParsingFailed           EventReason = "ResourceSyncParsingFailed"
+	EventReasonResourceSyncSyncFailed              EventReason = "ResourceSyncSyncFailed"
+	EventReasonResourceSyncSyncRequested           EventReason = "ResourceSyncSyncRequested"
+	EventReasonResourceSyncSynced                  EventReason = "ResourceSyncSynced"
+	EventReasonResourceUpdateFailed                EventReason = "ResourceUpdateFailed"
+	EventReasonResourceUpdated                     EventReason = "ResourceUpdated"
+	EventReasonSystemRestored                      EventReason = "SystemRestored"
 )
 
 // Defines values for EventType.
@@ -273,6 +342,11 @@ const (
 	FleetRolloutFailed FleetRolloutFailedDetailsDetailType = "FleetRolloutFailed"
 )
 
+// Defines values for FleetRolloutRolledBackDetailsDetailType.
+const (
+	FleetRolloutRolledBack FleetRolloutRolledBackDetailsDetailType = "FleetRolloutRolledBack"
+)
+
 // Defines values for FleetRolloutStartedDetailsDetailType.
 const (
 	FleetRolloutStarted FleetRolloutStartedDetailsDetailType = "FleetRolloutStarted"
@@ -284,15 +358,23 @@ const (
 	None    FleetRolloutStartedDetailsRolloutStrategy = "None"
 )
 
+// Defines values for HookActionSystemdOperation.
+const (
+	SystemdOperationReload  HookActionSystemdOperation = "reload"
+	SystemdOperationRestart HookActionSystemdOperation = "restart"
+	SystemdOperationStart   HookActionSystemdOperation = "start"
+	SystemdOperationStop    HookActionSystemdOperation = "stop"
+)
+
 // Defines values for ImageBuildStatusPhase.
 const (
-	Building         ImageBuildStatusPhase = "Building"
-	Cancelled        ImageBuildStatusPhase = "Cancelled"
-	Completed        ImageBuildStatusPhase = "Completed"
-	Failed           ImageBuildStatusPhase = "Failed"
-	GeneratingImages ImageBuildStatusPhase = "GeneratingImages"
-	Pending          ImageBuildStatusPhase = "Pending"
-	Pushing          ImageBuildStatusPhase = "Pushing"
+	ImageBuildStatusPhaseBuilding         ImageBuildStatusPhase = "Building"
+	ImageBuildStatusPhaseCancelled        ImageBuildStatusPhase = "Cancelled"
+	ImageBuildStatusPhaseCompleted        ImageBuildStatusPhase = "Completed"
+	ImageBuildStatusPhaseFailed           ImageBuildStatusPhase = "Failed"
+	ImageBuildStatusPhaseGeneratingImages ImageBuildStatusPhase = "GeneratingImages"
+	ImageBuildStatusPhasePending          ImageBuildStatusPhase = "Pending"
+	ImageBuildStatusPhasePushing          ImageBuildStatusPhase = "Pushing"
 )
 
 // Defines values for ImagePullPolicy.
@@ -320,6 +402,12 @@ const (
 	NotIn        MatchExpressionOperator = "NotIn"
 )
 
+// Defines values for NetworkMonitorMetricType.
+const (
+	NetworkMonitorMetricErrors     NetworkMonitorMetricType = "Errors"
+	NetworkMonitorMetricThroughput NetworkMonitorMetricType = "Throughput"
+)
+
 // Defines values for PatchRequestOp.
 const (
 	Add     PatchRequestOp = "add"
@@ -357,6 +445,13 @@ const (
 	ResourceKindTemplateVersion           ResourceKind = "TemplateVersion"
 )
 
+// Defines values for ResourceSyncChangeAction.
+const (
+	Create ResourceSyncChangeAction = "Create"
+	Delete ResourceSyncChangeAction = "Delete"
+	Update ResourceSyncChangeAction = "Update"
+)
+
 // Defines values for ResourceSyncCompletedDetailsDetailType.
 const (
 	ResourceSyncCompleted ResourceSyncCompletedDetailsDetailType = "ResourceSyncCompleted"
@@ -378,7 +473,16 @@ const (
 
 // Defines values for RolloutStrategy.
 const (
-	RolloutStrategyBatchSequence RolloutStrategy = "BatchSequence"
+	RolloutStrategyBatchSequence     RolloutStrategy = "BatchSequence"
+	RolloutStrategyProgressiveCanary RolloutStrategy = "ProgressiveCanary"
+)
+
+// Defines values for WatchEventType.
+const (
+	WatchEventAdded    WatchEventType = "ADDED"
+	WatchEventDeleted  WatchEventType = "DELETED"
+	WatchEventError    WatchEventType = "ERROR"
+	WatchEventModified WatchEventType = "MODIFIED"
 )
 
 // Defines values for ListEventsParamsOrder.
@@ -519,6 +623,18 @@ type BatchSequence struct {
 	Strategy RolloutStrategy `json:"strategy"`
 }
 
+// BlackoutPeriod A period of time during which updates are not applied, for example a holiday freeze.
+type BlackoutPeriod struct {
+	// Description A human readable description of the blackout period.
+	Description *string `json:"description,omitempty"`
+
+	// End The time at which the blackout period ends.
+	End time.Time `json:"end"`
+
+	// Start The time at which the blackout period starts.
+	Start time.Time `json:"start"`
+}
+
 // BootcExport Configuration for exporting a bootc disk image.
 type BootcExport struct {
 	// Architecture Target architecture for the disk image.
@@ -546,6 +662,126 @@ type BootcImageRef struct {
 	Type string `json:"type"`
 }
 
+// BulkOperation BulkOperation applies an operation to all devices that match a selector. It is executed asynchronously, and its status reports the progress and the devices for which the operation failed.
+type BulkOperation struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion string `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec BulkOperationSpec describes the devices to operate on and the operation to apply to each of them. It cannot be changed once the BulkOperation is created.
+	Spec BulkOperationSpec `json:"spec"`
+
+	// Status BulkOperationStatus reports the progress of a BulkOperation.
+	Status *BulkOperationStatus `json:"status,omitempty"`
+}
+
+// BulkOperationAction The operation to apply to each device, and its parameters.
+type BulkOperationAction struct {
+	// Annotations The annotations to set on each device. Required by SetAnnotations.
+	Annotations *map[string]string `json:"annotations,omitempty"`
+
+	// Decommission Metadata about a device decommissioning request.
+	Decommission *DeviceDecommission `json:"decommission,omitempty"`
+
+	// Fleet The name of the fleet to move each device to. The labels of the fleet's selector are added to the device, so that the fleet takes ownership of it. Required by MoveToFleet.
+	Fleet *string `json:"fleet,omitempty"`
+
+	// LabelKeys The keys of the labels to remove from each device. Required by RemoveLabels.
+	LabelKeys *[]string `json:"labelKeys,omitempty"`
+
+	// Labels The labels to add to, or replace on, each device. Required by AddLabels.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Type The operation to apply to each device.
+	Type BulkOperationType `json:"type"`
+}
+
+// BulkOperationFailure A device for which a BulkOperation failed.
+type BulkOperationFailure struct {
+	// Device The name of the device.
+	Device string `json:"device"`
+
+	// Message The reason the operation failed.
+	Message string `json:"message"`
+}
+
+// BulkOperationList BulkOperationList is a list of BulkOperations.
+type BulkOperationList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion string `json:"apiVersion"`
+
+	// Items List of BulkOperations.
+	Items []BulkOperation `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// BulkOperationPhase The phase of a BulkOperation. A Failed operation either could not be run, or failed for at least one device.
+type BulkOperationPhase string
+
+// BulkOperationSelector The devices to operate on. At least one of the selectors must be set, and devices must match both if both are set.
+type BulkOperationSelector struct {
+	// FieldSelector A selector on the fields of the devices, with the same syntax as for listing devices (e.g., "metadata.owner=Fleet/paris").
+	FieldSelector *string `json:"fieldSelector,omitempty"`
+
+	// LabelSelector A selector on the labels of the devices, with the same syntax as for listing devices (e.g., "site=paris,tier!=edge").
+	LabelSelector *string `json:"labelSelector,omitempty"`
+}
+
+// BulkOperationSpec BulkOperationSpec describes the devices to operate on and the operation to apply to each of them. It cannot be changed once the BulkOperation is created.
+type BulkOperationSpec struct {
+	// Operation The operation to apply to each device, and its parameters.
+	Operation BulkOperationAction `json:"operation"`
+
+	// Selector The devices to operate on. At least one of the selectors must be set, and devices must match both if both are set.
+	Selector BulkOperationSelector `json:"selector"`
+}
+
+// BulkOperationStatus BulkOperationStatus reports the progress of a BulkOperation.
+type BulkOperationStatus struct {
+	// CompletedAt The time the operation completed, failed or was canceled.
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// Continue An opaque position of the next device to process.
+	Continue *string `json:"continue,omitempty"`
+
+	// FailedDevices The number of processed devices for which the operation failed.
+	FailedDevices int64 `json:"failedDevices"`
+
+	// Failures The first devices for which the operation failed, up to 100.
+	Failures *[]BulkOperationFailure `json:"failures,omitempty"`
+
+	// MatchedDevices The number of devices that matched the selector when the operation was created.
+	MatchedDevices int64 `json:"matchedDevices"`
+
+	// Message A human-readable description of the phase.
+	Message *string `json:"message,omitempty"`
+
+	// Phase The phase of a BulkOperation. A Failed operation either could not be run, or failed for at least one device.
+	Phase BulkOperationPhase `json:"phase"`
+
+	// ProcessedDevices The number of devices processed so far.
+	ProcessedDevices int64 `json:"processedDevices"`
+
+	// StartedAt The time the operation started running.
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+
+	// SucceededDevices The number of processed devices for which the operation succeeded, including devices that needed no change.
+	SucceededDevices int64 `json:"succeededDevices"`
+}
+
+// BulkOperationType The operation to apply to each device.
+type BulkOperationType string
+
 // CertificateSigningRequest CertificateSigningRequest represents a request for a signed certificate from the CA.
 type CertificateSigningRequest struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -644,6 +880,81 @@ type ConfigProviderSpec struct {
 	union json.RawMessage
 }
 
+// ConsoleSession ConsoleSession records a remote console session to a device, who opened it and how it ended. It is created by the service when the console is opened and cannot be modified through the API.
+type ConsoleSession struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion string `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec ConsoleSessionSpec describes the console session that was requested.
+	Spec ConsoleSessionSpec `json:"spec"`
+
+	// Status ConsoleSessionStatus reports when a console session was active and how it ended.
+	Status *ConsoleSessionStatus `json:"status,omitempty"`
+}
+
+// ConsoleSessionList ConsoleSessionList is a list of ConsoleSessions.
+type ConsoleSessionList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion string `json:"apiVersion"`
+
+	// Items List of ConsoleSessions.
+	Items []ConsoleSession `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// ConsoleSessionRecording The stored recording of a console session.
+type ConsoleSessionRecording struct {
+	// Format The format of the recording.
+	Format ConsoleSessionRecordingFormat `json:"format"`
+
+	// Size The size of the recording in bytes.
+	Size int64 `json:"size"`
+}
+
+// ConsoleSessionRecordingFormat The format of the recording.
+type ConsoleSessionRecordingFormat string
+
+// ConsoleSessionSpec ConsoleSessionSpec describes the console session that was requested.
+type ConsoleSessionSpec struct {
+	// Command The command run in the console. Empty for an interactive login shell.
+	Command *string `json:"command,omitempty"`
+
+	// DeviceName The name of the device the console was opened to.
+	DeviceName string `json:"deviceName"`
+
+	// Tty Whether the console was allocated a terminal.
+	Tty *bool `json:"tty,omitempty"`
+
+	// User The name of the user who opened the console.
+	User string `json:"user"`
+}
+
+// ConsoleSessionStatus ConsoleSessionStatus reports when a console session was active and how it ended.
+type ConsoleSessionStatus struct {
+	// EndTime The time the console session was closed. Unset while the session is active.
+	EndTime *time.Time `json:"endTime,omitempty"`
+
+	// ExitCode The exit code of the command or shell, if the device reported it before the session was closed.
+	ExitCode *int32 `json:"exitCode,omitempty"`
+
+	// Recording The stored recording of a console session.
+	Recording *ConsoleSessionRecording `json:"recording,omitempty"`
+
+	// StartTime The time the console session was opened.
+	StartTime time.Time `json:"startTime"`
+}
+
 // ContainerRegistryConfig Container registry configuration for pushing images.
 type ContainerRegistryConfig struct {
 	// Credentials Registry authentication credentials.
@@ -725,6 +1036,33 @@ type DeviceApplicationsSummaryStatus struct {
 	Status ApplicationsSummaryStatusType `json:"status"`
 }
 
+// DeviceAttestation DeviceAttestation is a TPM quote of a device's PCRs, signed with the device's attestation key.
+type DeviceAttestation struct {
+	// EventLog The TCG PC Client event log of the boot, which is replayed to check that it produced the quoted PCR values.
+	EventLog *[]byte `json:"eventLog,omitempty"`
+
+	// Nonce The nonce of the attestation challenge that the quote answers.
+	Nonce []byte `json:"nonce"`
+
+	// PcrValues The values of the quoted PCRs in the SHA-256 bank, in the order of the quote's PCR selection.
+	PcrValues []PCRValue `json:"pcrValues"`
+
+	// Quote The TPM2B_ATTEST structure returned by TPM2_Quote.
+	Quote []byte `json:"quote"`
+
+	// Signature The TPMT_SIGNATURE structure returned by TPM2_Quote.
+	Signature []byte `json:"signature"`
+}
+
+// DeviceAttestationChallenge DeviceAttestationChallenge is a nonce issued to a device, which the device includes in its next TPM quote to prove that the quote is fresh.
+type DeviceAttestationChallenge struct {
+	// ExpiresAt The time after which the nonce is no longer accepted.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Nonce The nonce to use as the qualifying data of the quote.
+	Nonce []byte `json:"nonce"`
+}
+
 // DeviceConfigStatus Current status of the device config.
 type DeviceConfigStatus struct {
 	// RenderedVersion Rendered version of the device config.
@@ -894,6 +1232,15 @@ type DeviceResourceStatus struct {
 
 	// Memory The types of resource statuses.
 	Memory DeviceResourceStatusType `json:"memory"`
+
+	// Network The types of resource statuses.
+	Network *DeviceResourceStatusType `json:"network,omitempty"`
+
+	// Process The types of resource statuses.
+	Process *DeviceResourceStatusType `json:"process,omitempty"`
+
+	// Temperature The types of resource statuses.
+	Temperature *DeviceResourceStatusType `json:"temperature,omitempty"`
 }
 
 // DeviceResourceStatusType The types of resource statuses.
@@ -921,6 +1268,27 @@ type DeviceResumeResponse struct {
 	ResumedDevices int `json:"resumedDevices"`
 }
 
+// DeviceSearchResult DeviceSearchResult is a device that matches a search.
+type DeviceSearchResult struct {
+	// Device Device represents a physical device.
+	Device Device `json:"device"`
+
+	// Matches The paths of the fields that contain the searched text, such as metadata.name, metadata.labels.site or status.systemInfo.productSerial.
+	Matches []string `json:"matches"`
+
+	// Score The relevance of the device to the search. Exact matches of the name or alias score highest.
+	Score int32 `json:"score"`
+}
+
+// DeviceSearchResultList DeviceSearchResultList is the result of a device search.
+type DeviceSearchResultList struct {
+	// Items List of matching Devices, ordered by decreasing score.
+	Items []DeviceSearchResult `json:"items"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
 // DeviceSpec DeviceSpec describes a device.
 type DeviceSpec struct {
 	// Applications List of application providers.
@@ -1023,9 +1391,15 @@ type DeviceSystemInfo struct {
 
 // DeviceUpdatePolicySpec Specifies the policy for managing device updates, including when updates should be downloaded and applied.
 type DeviceUpdatePolicySpec struct {
+	// BlackoutPeriods Periods during which updates are not applied, regardless of the update schedule and maintenance windows.
+	BlackoutPeriods *[]BlackoutPeriod `json:"blackoutPeriods,omitempty"`
+
 	// DownloadSchedule Defines the schedule for automatic downloading and updates, including timing and optional timeout.
 	DownloadSchedule *UpdateSchedule `json:"downloadSchedule,omitempty"`
 
+	// MaintenanceWindows Additional windows in which updates may be applied. An update is applied when the update schedule or any of the maintenance windows is ready.
+	MaintenanceWindows *[]UpdateSchedule `json:"maintenanceWindows,omitempty"`
+
 	// UpdateSchedule Defines the schedule for automatic downloading and updates, including timing and optional timeout.
 	UpdateSchedule *UpdateSchedule `json:"updateSchedule,omitempty"`
 }
@@ -1084,6 +1458,9 @@ type DisruptionBudget struct {
 	MinAvailable *int `json:"minAvailable,omitempty"`
 }
 
+// DryRun Whether a request is only validated.  All validates the request without persisting the resource.
+type DryRun string
+
 // Duration The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
 type Duration = string
 
@@ -1096,6 +1473,96 @@ type EnrollmentConfig struct {
 	EnrollmentService EnrollmentService `json:"enrollment-service"`
 }
 
+// EnrollmentPolicy EnrollmentPolicy approves or rejects the enrollment requests that match its rules, without waiting for a user to approve them.
+type EnrollmentPolicy struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion string `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec EnrollmentPolicySpec describes the rules of an enrollment policy.
+	Spec EnrollmentPolicySpec `json:"spec"`
+}
+
+// EnrollmentPolicyAction Whether a rule approves or rejects the enrollment requests that it matches.
+type EnrollmentPolicyAction string
+
+// EnrollmentPolicyDecisionDetails defines model for EnrollmentPolicyDecisionDetails.
+type EnrollmentPolicyDecisionDetails struct {
+	// Action Whether a rule approves or rejects the enrollment requests that it matches.
+	Action EnrollmentPolicyAction `json:"action"`
+
+	// DetailType The type of detail for discriminator purposes.
+	DetailType EnrollmentPolicyDecisionDetailsDetailType `json:"detailType"`
+
+	// PolicyName The name of the EnrollmentPolicy whose rule decided the enrollment request.
+	PolicyName string `json:"policyName"`
+
+	// RuleName The name of the rule that matched the enrollment request.
+	RuleName string `json:"ruleName"`
+}
+
+// EnrollmentPolicyDecisionDetailsDetailType The type of detail for discriminator purposes.
+type EnrollmentPolicyDecisionDetailsDetailType string
+
+// EnrollmentPolicyList EnrollmentPolicyList is a list of EnrollmentPolicies.
+type EnrollmentPolicyList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion string `json:"apiVersion"`
+
+	// Items List of EnrollmentPolicies.
+	Items []EnrollmentPolicy `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// EnrollmentPolicyMatch The conditions that an enrollment request must meet for a rule to match it. All conditions that are set must be met, and a rule that rejects without conditions matches every enrollment request. A rule that approves must require tpmVerified true or ekCertificateIssuers, as the other conditions are reported by the device.
+type EnrollmentPolicyMatch struct {
+	// EkCertificateIssuers Matches TPM-verified enrollment requests whose endorsement key certificate was issued by one of the given issuers, given as full distinguished name in RFC 2253 format (e.g., "CN=Infineon OPTIGA(TM) RSA Manufacturing CA 003,OU=OPTIGA(TM),O=Infineon Technologies AG,C=DE").
+	EkCertificateIssuers *[]string `json:"ekCertificateIssuers,omitempty"`
+
+	// Labels Matches enrollment requests that ask for the given labels. Values may contain '*' wildcards. As the labels are requested by the device, they can only be matched by rules that reject.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// SystemInfo Matches enrollment requests whose reported system info has the given values, by field name (e.g., "architecture", "productSerial" or "customInfo.siteId"). Values may contain '*' wildcards.
+	SystemInfo *map[string]string `json:"systemInfo,omitempty"`
+
+	// TpmVerified Matches enrollment requests whose TPM identity was verified, if true, or was not verified, if false. The identity of a TPM is verified when the chain of trust of its TCG CSR is validated against the configured TPM CAs and the device completes the credential challenge.
+	TpmVerified *bool `json:"tpmVerified,omitempty"`
+}
+
+// EnrollmentPolicyRule A rule that approves or rejects the enrollment requests that it matches.
+type EnrollmentPolicyRule struct {
+	// Action Whether a rule approves or rejects the enrollment requests that it matches.
+	Action EnrollmentPolicyAction `json:"action"`
+
+	// Labels The labels to apply to the devices that the rule approves, in addition to the labels that the enrollment request asks for.
+	Labels *map[string]string `json:"labels,omitempty"`
+
+	// Match The conditions that an enrollment request must meet for a rule to match it. All conditions that are set must be met, and a rule that rejects without conditions matches every enrollment request. A rule that approves must require tpmVerified true or ekCertificateIssuers, as the other conditions are reported by the device.
+	Match *EnrollmentPolicyMatch `json:"match,omitempty"`
+
+	// Name The name of the rule, unique within the policy. It is recorded in the events of the decisions that the rule makes.
+	Name string `json:"name"`
+}
+
+// EnrollmentPolicySpec EnrollmentPolicySpec describes the rules of an enrollment policy.
+type EnrollmentPolicySpec struct {
+	// Priority The order in which the policies of an organization are evaluated. Policies with a lower priority are evaluated first, and policies with the same priority in the order of their names. Defaults to 0.
+	Priority *int32 `json:"priority,omitempty"`
+
+	// Rules The rules of the policy, evaluated in order. The first rule of any policy that matches an enrollment request decides it.
+	Rules []EnrollmentPolicyRule `json:"rules"`
+}
+
 // EnrollmentRequest EnrollmentRequest represents a request for approval to enroll a device.
 type EnrollmentRequest struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -1275,6 +1742,99 @@ type EventSource struct {
 	Component string `json:"component"`
 }
 
+// EventSubscription EventSubscription delivers the events that match its filters to a webhook.
+type EventSubscription struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion string `json:"apiVersion"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
+	Metadata ObjectMeta `json:"metadata"`
+
+	// Spec EventSubscriptionSpec describes which events to deliver and where to deliver them.
+	Spec EventSubscriptionSpec `json:"spec"`
+
+	// Status EventSubscriptionStatus represents information about the deliveries of an EventSubscription.
+	Status *EventSubscriptionStatus `json:"status,omitempty"`
+}
+
+// EventSubscriptionDeadLetter An event that could not be delivered.
+type EventSubscriptionDeadLetter struct {
+	// Attempts The number of delivery attempts.
+	Attempts int32 `json:"attempts"`
+
+	// EventName The name of the event.
+	EventName string `json:"eventName"`
+
+	// InvolvedObject A reference to a resource.
+	InvolvedObject ObjectReference `json:"involvedObject"`
+
+	// LastError The error of the last delivery attempt.
+	LastError string `json:"lastError"`
+
+	// Reason The reason of the event.
+	Reason string `json:"reason"`
+
+	// Time The time the event was given up on.
+	Time time.Time `json:"time"`
+}
+
+// EventSubscriptionList EventSubscriptionList is a list of EventSubscriptions.
+type EventSubscriptionList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion string `json:"apiVersion"`
+
+	// Items List of EventSubscriptions.
+	Items []EventSubscription `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
+// EventSubscriptionSpec EventSubscriptionSpec describes which events to deliver and where to deliver them.
+type EventSubscriptionSpec struct {
+	// FieldSelector An additional selector on the fields of the events to deliver, with the same syntax as the field selector for listing events (e.g., "type=Warning").
+	FieldSelector *string `json:"fieldSelector,omitempty"`
+
+	// InvolvedObjectKinds The kinds of the resources whose events to deliver, for example Device. Defaults to all kinds.
+	InvolvedObjectKinds *[]string `json:"involvedObjectKinds,omitempty"`
+
+	// Reasons The reasons of the events to deliver, for example DeviceUpdateFailed. Defaults to all reasons.
+	Reasons *[]string `json:"reasons,omitempty"`
+
+	// Webhook The webhook that matching events are POSTed to as JSON.
+	Webhook EventSubscriptionWebhook `json:"webhook"`
+}
+
+// EventSubscriptionStatus EventSubscriptionStatus represents information about the deliveries of an EventSubscription.
+type EventSubscriptionStatus struct {
+	// Conditions Current state of the event subscription.
+	Conditions []Condition `json:"conditions"`
+
+	// DeadLetters The most recent events that could not be delivered after all retries, newest last.
+	DeadLetters *[]EventSubscriptionDeadLetter `json:"deadLetters,omitempty"`
+
+	// DeliveredCount The number of events delivered successfully.
+	DeliveredCount *int64 `json:"deliveredCount,omitempty"`
+
+	// LastDeliveryTime The time of the last successful delivery.
+	LastDeliveryTime *time.Time `json:"lastDeliveryTime,omitempty"`
+}
+
+// EventSubscriptionWebhook The webhook that matching events are POSTed to as JSON.
+type EventSubscriptionWebhook struct {
+	// Secret The secret used to sign each delivery with HMAC-SHA256. The signature is sent in the X-Flightctl-Signature header as "sha256=<hex digest of the body>". The secret is not returned by the API.
+	Secret *string `json:"secret,omitempty"`
+
+	// Url The HTTP or HTTPS URL to deliver the events to.
+	Url string `json:"url"`
+}
+
 // FileContent The content of a file.
 type FileContent struct {
 	// Content The plain text (UTF-8) or base64-encoded content of the file.
@@ -1353,6 +1913,69 @@ type FleetList struct {
 	Metadata ListMeta `json:"metadata"`
 }
 
+// FleetPreview FleetPreview describes the devices that a change to a fleet's selector or template affects. The device lists contain at most 1000 devices each; the summary contains the total counts.
+type FleetPreview struct {
+	// DevicesGained The devices that the fleet would own after the change, but doesn't own now.
+	DevicesGained []FleetPreviewDevice `json:"devicesGained"`
+
+	// DevicesLost The devices that the fleet owns now, but would no longer own after the change.
+	DevicesLost []FleetPreviewDevice `json:"devicesLost"`
+
+	// NewConflicts The devices that would match more than one fleet after the change. Their owner isn't changed until the conflict is resolved.
+	NewConflicts []FleetPreviewDevice `json:"newConflicts"`
+
+	// Samples The template rendered for a sample of the devices that the fleet would own after the change.
+	Samples []FleetPreviewSample `json:"samples"`
+
+	// Summary FleetPreviewSummary contains the number of devices affected by a change to a fleet.
+	Summary FleetPreviewSummary `json:"summary"`
+}
+
+// FleetPreviewDevice FleetPreviewDevice is a device affected by a change to a fleet.
+type FleetPreviewDevice struct {
+	// CurrentOwner The owner of the device before the change, in "kind/name" format.
+	CurrentOwner *string `json:"currentOwner,omitempty"`
+
+	// MatchingFleets The fleets whose selector would match the device after the change.
+	MatchingFleets *[]string `json:"matchingFleets,omitempty"`
+
+	// Name The name of the device.
+	Name string `json:"name"`
+
+	// NewOwner The owner of the device after the change, in "kind/name" format. Empty if the device would not belong to any fleet.
+	NewOwner *string `json:"newOwner,omitempty"`
+}
+
+// FleetPreviewSample FleetPreviewSample is the spec of a device before and after a change to its fleet.
+type FleetPreviewSample struct {
+	// CurrentSpec DeviceSpec describes a device.
+	CurrentSpec *DeviceSpec `json:"currentSpec,omitempty"`
+
+	// Errors The errors found while rendering the template for the device.
+	Errors *[]string `json:"errors,omitempty"`
+
+	// Name The name of the device.
+	Name string `json:"name"`
+
+	// RenderedSpec DeviceSpec describes a device.
+	RenderedSpec *DeviceSpec `json:"renderedSpec,omitempty"`
+}
+
+// FleetPreviewSummary FleetPreviewSummary contains the number of devices affected by a change to a fleet.
+type FleetPreviewSummary struct {
+	// DevicesGained The number of devices that the fleet would gain.
+	DevicesGained int64 `json:"devicesGained"`
+
+	// DevicesLost The number of devices that the fleet would lose.
+	DevicesLost int64 `json:"devicesLost"`
+
+	// MatchingDevices The number of devices that the fleet's selector would match after the change.
+	MatchingDevices int64 `json:"matchingDevices"`
+
+	// NewConflicts The number of devices that would match more than one fleet.
+	NewConflicts int64 `json:"newConflicts"`
+}
+
 // FleetRolloutBatchCompletedDetails defines model for FleetRolloutBatchCompletedDetails.
 type FleetRolloutBatchCompletedDetails struct {
 	// Batch The batch within the fleet rollout.
@@ -1437,6 +2060,21 @@ type FleetRolloutFailedDetails struct {
 // FleetRolloutFailedDetailsDetailType The type of detail for discriminator purposes.
 type FleetRolloutFailedDetailsDetailType string
 
+// FleetRolloutRolledBackDetails defines model for FleetRolloutRolledBackDetails.
+type FleetRolloutRolledBackDetails struct {
+	// DetailType The type of detail for discriminator purposes.
+	DetailType FleetRolloutRolledBackDetailsDetailType `json:"detailType"`
+
+	// RollbackTemplateVersion The name of the TemplateVersion the fleet was rolled back to.
+	RollbackTemplateVersion string `json:"rollbackTemplateVersion"`
+
+	// TemplateVersion The name of the TemplateVersion whose rollout failed and was rolled back.
+	TemplateVersion string `json:"templateVersion"`
+}
+
+// FleetRolloutRolledBackDetailsDetailType The type of detail for discriminator purposes.
+type FleetRolloutRolledBackDetailsDetailType string
+
 // FleetRolloutStartedDetails defines model for FleetRolloutStartedDetails.
 type FleetRolloutStartedDetails struct {
 	// DetailType The type of detail for discriminator purposes.
@@ -1463,6 +2101,9 @@ type FleetRolloutStatus struct {
 
 // FleetSpec FleetSpec is a description of a fleet's target state.
 type FleetSpec struct {
+	// IntegrityPolicy IntegrityPolicy lists the PCR values that devices of the fleet must attest to with TPM quotes to be considered as having booted a trusted image.
+	IntegrityPolicy *IntegrityPolicy `json:"integrityPolicy,omitempty"`
+
 	// RolloutPolicy RolloutPolicy is the rollout policy of the fleet.
 	RolloutPolicy *RolloutPolicy `json:"rolloutPolicy,omitempty"`
 
@@ -1534,6 +2175,9 @@ type GenericRepoSpec struct {
 
 	// Url The (possibly remote) repository URL.
 	Url string `json:"url"`
+
+	// WebhookSecret The secret that the push notifications of the git server are verified with. The git webhook of the repository only accepts pushes when it is set.
+	WebhookSecret *string `json:"webhookSecret,omitempty"`
 }
 
 // GitConfigProviderSpec defines model for GitConfigProviderSpec.
@@ -1564,6 +2208,36 @@ type HookAction struct {
 	union   json.RawMessage
 }
 
+// HookActionHttpProbe Probes an HTTP(S) endpoint with a GET request.
+type HookActionHttpProbe struct {
+	// ExpectedStatus The HTTP status code expected in the response. If not specified, any 2xx status code is considered successful.
+	ExpectedStatus *int32 `json:"expectedStatus,omitempty"`
+
+	// Url The URL to send the request to, for example 'http://localhost:8080/healthz'.
+	Url string `json:"url"`
+}
+
+// HookActionProbe defines model for HookActionProbe.
+type HookActionProbe struct {
+	// Probe Probes a local endpoint until it is ready. Exactly one of http or tcp must be specified.
+	Probe HookActionProbeSpec `json:"probe"`
+}
+
+// HookActionProbeSpec Probes a local endpoint until it is ready. Exactly one of http or tcp must be specified.
+type HookActionProbeSpec struct {
+	// Http Probes an HTTP(S) endpoint with a GET request.
+	Http *HookActionHttpProbe `json:"http,omitempty"`
+
+	// Interval The time to wait between attempts. The duration should be specified as a positive integer followed by a time unit. Supported time units are 's' for seconds, 'm' for minutes, and 'h' for hours. Defaults to 1s.
+	Interval *string `json:"interval,omitempty"`
+
+	// Retries The number of times to retry the probe after a failed attempt before the action fails. Defaults to 3.
+	Retries *int32 `json:"retries,omitempty"`
+
+	// Tcp Probes a TCP endpoint by opening a connection.
+	Tcp *HookActionTcpProbe `json:"tcp,omitempty"`
+}
+
 // HookActionRun defines model for HookActionRun.
 type HookActionRun struct {
 	// EnvVars Environment variable key-value pairs, injected during runtime.
@@ -1576,6 +2250,30 @@ type HookActionRun struct {
 	WorkDir *string `json:"workDir,omitempty"`
 }
 
+// HookActionSystemd defines model for HookActionSystemd.
+type HookActionSystemd struct {
+	// Systemd Performs an operation on a systemd unit.
+	Systemd HookActionSystemdSpec `json:"systemd"`
+}
+
+// HookActionSystemdOperation The operation to perform on the systemd unit.
+type HookActionSystemdOperation string
+
+// HookActionSystemdSpec Performs an operation on a systemd unit.
+type HookActionSystemdSpec struct {
+	// Operation The operation to perform on the systemd unit.
+	Operation HookActionSystemdOperation `json:"operation"`
+
+	// Unit The name of the systemd unit, for example 'myservice.service'.
+	Unit string `json:"unit"`
+}
+
+// HookActionTcpProbe Probes a TCP endpoint by opening a connection.
+type HookActionTcpProbe struct {
+	// Address The address to connect to in the form 'host:port', for example 'localhost:5432'.
+	Address string `json:"address"`
+}
+
 // HookCondition defines model for HookCondition.
 type HookCondition struct {
 	union json.RawMessage
@@ -1648,6 +2346,9 @@ type HttpRepoSpec struct {
 
 	// ValidationSuffix URL suffix used only for validating access to the repository. Users might use the URL field as a root URL to be used by config sources adding suffixes. This will help with the validation of the http endpoint.
 	ValidationSuffix *string `json:"validationSuffix,omitempty"`
+
+	// WebhookSecret The secret that the push notifications of the git server are verified with. The git webhook of the repository only accepts pushes when it is set.
+	WebhookSecret *string `json:"webhookSecret,omitempty"`
 }
 
 // ImageApplicationProviderSpec defines model for ImageApplicationProviderSpec.
@@ -1870,6 +2571,12 @@ type InlineConfigProviderSpec struct {
 	Name string `json:"name"`
 }
 
+// IntegrityPolicy IntegrityPolicy lists the PCR values that devices of the fleet must attest to with TPM quotes to be considered as having booted a trusted image.
+type IntegrityPolicy struct {
+	// ReferenceValues The reference values of the PCRs to check. PCRs that are not listed are not checked.
+	ReferenceValues []PCRReferenceValue `json:"referenceValues"`
+}
+
 // InternalTaskFailedDetails defines model for InternalTaskFailedDetails.
 type InternalTaskFailedDetails struct {
 	// DetailType The type of detail for discriminator purposes.
@@ -1975,6 +2682,27 @@ type MemoryResourceMonitorSpec struct {
 	SamplingInterval string `json:"samplingInterval"`
 }
 
+// NetworkMonitorMetricType The network metric that is monitored. Throughput is the received and transmitted bytes as a percentage of the link speed. Errors is the failed and dropped packets as a percentage of all packets.
+type NetworkMonitorMetricType string
+
+// NetworkResourceMonitorSpec defines model for NetworkResourceMonitorSpec.
+type NetworkResourceMonitorSpec struct {
+	// AlertRules Array of alert rules. Only one alert per severity is allowed.
+	AlertRules []ResourceAlertRule `json:"alertRules"`
+
+	// Interface The name of the network interface to monitor. Defaults to all interfaces except the loopback interface.
+	Interface *string `json:"interface,omitempty"`
+
+	// Metric The network metric that is monitored. Throughput is the received and transmitted bytes as a percentage of the link speed. Errors is the failed and dropped packets as a percentage of all packets.
+	Metric *NetworkMonitorMetricType `json:"metric,omitempty"`
+
+	// MonitorType The type of resource to monitor.
+	MonitorType string `json:"monitorType"`
+
+	// SamplingInterval Duration between monitor samples. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	SamplingInterval string `json:"samplingInterval"`
+}
+
 // ObjectMeta ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create.
 type ObjectMeta struct {
 	// Annotations Properties set by the service.
@@ -2050,6 +2778,24 @@ type OrganizationSpec struct {
 	ExternalId *string `json:"externalId,omitempty"`
 }
 
+// PCRReferenceValue PCRReferenceValue lists the accepted values of a PCR.
+type PCRReferenceValue struct {
+	// Digests The accepted hex-encoded values of the PCR in the SHA-256 bank. Listing several values accepts, e.g., both the current and the next image during an update.
+	Digests []string `json:"digests"`
+
+	// Pcr The index of the PCR.
+	Pcr int `json:"pcr"`
+}
+
+// PCRValue PCRValue is the value of a PCR.
+type PCRValue struct {
+	// Digest The hex-encoded value of the PCR.
+	Digest string `json:"digest"`
+
+	// Pcr The index of the PCR.
+	Pcr int `json:"pcr"`
+}
+
 // PatchRequest defines model for PatchRequest.
 type PatchRequest = []struct {
 	// Op The operation to perform.
@@ -2068,6 +2814,42 @@ type PatchRequestOp string
 // Percentage Percentage is the string format representing percentage string.
 type Percentage = string
 
+// ProcessResourceMonitorSpec defines model for ProcessResourceMonitorSpec.
+type ProcessResourceMonitorSpec struct {
+	// AlertRules Array of alert rules. Only one alert per severity is allowed.
+	AlertRules []ResourceAlertRule `json:"alertRules"`
+
+	// MonitorType The type of resource to monitor.
+	MonitorType string `json:"monitorType"`
+
+	// Process The name of the process to monitor, as reported in /proc/[pid]/comm. The memory of all processes with this name is summed up.
+	Process *string `json:"process,omitempty"`
+
+	// SamplingInterval Duration between monitor samples. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	SamplingInterval string `json:"samplingInterval"`
+
+	// Unit The name of the systemd unit to monitor, for example podman.service.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// ProgressiveCanary ProgressiveCanary rolls out to a growing share of the fleet's devices. It starts with the initial percentage of devices and multiplies the percentage after each step, as long as the success threshold is met, until all devices are updated.
+type ProgressiveCanary struct {
+	// InitialPercentage Percentage is the string format representing percentage string.
+	InitialPercentage Percentage `json:"initialPercentage"`
+
+	// Interval The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
+	Interval *Duration `json:"interval,omitempty"`
+
+	// Multiplier The factor by which the percentage of updated devices grows after each step.
+	Multiplier *int `json:"multiplier,omitempty"`
+
+	// Strategy The strategy of choice for device selection in rollout policy.
+	Strategy RolloutStrategy `json:"strategy"`
+
+	// SuccessThreshold Percentage is the string format representing percentage string.
+	SuccessThreshold *Percentage `json:"successThreshold,omitempty"`
+}
+
 // ReferencedRepositoryUpdatedDetails defines model for ReferencedRepositoryUpdatedDetails.
 type ReferencedRepositoryUpdatedDetails struct {
 	// DetailType The type of detail for discriminator purposes.
@@ -2186,6 +2968,21 @@ type ResourceSync struct {
 	Status *ResourceSyncStatus `json:"status,omitempty"`
 }
 
+// ResourceSyncChange ResourceSyncChange is a change of a resource that is managed by a ResourceSync.
+type ResourceSyncChange struct {
+	// Action The action that syncs the resource. For devices, Update sets the synced labels and annotations, and Delete removes them.
+	Action ResourceSyncChangeAction `json:"action"`
+
+	// Kind The kind of the resource.
+	Kind string `json:"kind"`
+
+	// Name The name of the resource.
+	Name string `json:"name"`
+}
+
+// ResourceSyncChangeAction The action that syncs the resource. For devices, Update sets the synced labels and annotations, and Delete removes them.
+type ResourceSyncChangeAction string
+
 // ResourceSyncCompletedDetails defines model for ResourceSyncCompletedDetails.
 type ResourceSyncCompletedDetails struct {
 	// ChangeCount Number of changes introduced by this ResourceSync update.
@@ -2221,9 +3018,15 @@ type ResourceSyncList struct {
 
 // ResourceSyncSpec ResourceSyncSpec describes the file(s) to sync from a repository.
 type ResourceSyncSpec struct {
-	// Path The path of a file or directory in the repository. If a directory, the directory should contain only resource definitions with no subdirectories. Each file should contain the definition of one or more resources.
+	// DryRun If true, the changes between the resources in the repository and the resources in the service are reported in the status but not applied.
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Path The path of a file or directory in the repository. If a directory, the resource definitions of its files are synced, and its subdirectories are ignored unless the directory is an overlay with a kustomization file. Each file should contain the definition of one or more resources.
 	Path string `json:"path"`
 
+	// Prune If false, resources that are removed from the repository are not deleted, and the labels and annotations that were synced to devices that are removed from the repository are kept.
+	Prune *bool `json:"prune,omitempty"`
+
 	// Repository The name of the repository resource to use as the sync source.
 	Repository string `json:"repository"`
 
@@ -2236,11 +3039,17 @@ type ResourceSyncStatus struct {
 	// Conditions Current state of a resourcesync.
 	Conditions []Condition `json:"conditions"`
 
+	// DriftedResources The resources managed by the ResourceSync that were modified outside of the repository since they were last synced.
+	DriftedResources *[]ObjectReference `json:"driftedResources,omitempty"`
+
 	// ObservedCommit The last commit hash that was synced.
 	ObservedCommit *string `json:"observedCommit,omitempty"`
 
 	// ObservedGeneration The last generation that was synced.
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// PendingChanges The changes between the resources in the repository and the resources in the service that were not applied, because the ResourceSync is a dry run, because pruning is disabled, or because the resources drifted since the last sync.
+	PendingChanges *[]ResourceSyncChange `json:"pendingChanges,omitempty"`
 }
 
 // ResourceUpdatedDetails defines model for ResourceUpdatedDetails.
@@ -2280,6 +3089,9 @@ type RolloutPolicy struct {
 	// DisruptionBudget DisruptionBudget defines the level of allowed disruption when rollout is in progress.
 	DisruptionBudget *DisruptionBudget `json:"disruptionBudget,omitempty"`
 
+	// RollbackOnFailure If true, a rollout whose batch does not reach the success threshold is rolled back to the fleet's previous TemplateVersion instead of being suspended.
+	RollbackOnFailure *bool `json:"rollbackOnFailure,omitempty"`
+
 	// SuccessThreshold Percentage is the string format representing percentage string.
 	SuccessThreshold *Percentage `json:"successThreshold,omitempty"`
 }
@@ -2309,6 +3121,9 @@ type SshRepoSpec struct {
 
 	// Url The SSH Git repository URL to clone from.
 	Url string `json:"url"`
+
+	// WebhookSecret The secret that the push notifications of the git server are verified with. The git webhook of the repository only accepts pushes when it is set.
+	WebhookSecret *string `json:"webhookSecret,omitempty"`
 }
 
 // Status Status is a return value for calls that don't return other objects.
@@ -2347,6 +3162,24 @@ type TPMConfig struct {
 	StorageFilePath *string `json:"storageFilePath,omitempty"`
 }
 
+// TemperatureResourceMonitorSpec defines model for TemperatureResourceMonitorSpec.
+type TemperatureResourceMonitorSpec struct {
+	// AlertRules Array of alert rules. Only one alert per severity is allowed.
+	AlertRules []ResourceAlertRule `json:"alertRules"`
+
+	// MaxTemperature The temperature in degrees Celsius that corresponds to 100 percent usage. Defaults to the critical trip point of the thermal zone, or 100 if the zone has none.
+	MaxTemperature *int `json:"maxTemperature,omitempty"`
+
+	// MonitorType The type of resource to monitor.
+	MonitorType string `json:"monitorType"`
+
+	// SamplingInterval Duration between monitor samples. Format: positive integer followed by 's' for seconds, 'm' for minutes, 'h' for hours.
+	SamplingInterval string `json:"samplingInterval"`
+
+	// Zone The type of the thermal zone to monitor, as reported in /sys/class/thermal/thermal_zone*/type, for example x86_pkg_temp. Defaults to the hottest of all thermal zones.
+	Zone *string `json:"zone,omitempty"`
+}
+
 // TemplateVersion TemplateVersion represents a version of a template.
 type TemplateVersion struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -2440,27 +3273,93 @@ type UpdateSchedule struct {
 	TimeZone *TimeZone `json:"timeZone,omitempty"`
 }
 
-// Version defines model for Version.
-type Version struct {
-	// Version Git version of the service.
-	Version string `json:"version"`
+// Version defines model for Version.
+type Version struct {
+	// Version Git version of the service.
+	Version string `json:"version"`
+}
+
+// WatchEvent A change to a resource streamed by a watch.
+type WatchEvent struct {
+	// Object The resource after the change. For DELETED events, the kind and metadata of the resource. For ERROR events, a Status describing why the watch was closed.
+	Object map[string]interface{} `json:"object"`
+
+	// Type The type of change to a watched resource.
+	Type WatchEventType `json:"type"`
+}
+
+// WatchEventType The type of change to a watched resource.
+type WatchEventType string
+
+// AuthValidateParams defines parameters for AuthValidate.
+type AuthValidateParams struct {
+	// Authorization The authentication token to validate.
+	Authorization *string `json:"Authorization,omitempty"`
+}
+
+// ListBulkOperationsParams defines parameters for ListBulkOperations.
+type ListBulkOperationsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreateBulkOperationParams defines parameters for CreateBulkOperation.
+type CreateBulkOperationParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ListCertificateSigningRequestsParams defines parameters for ListCertificateSigningRequests.
+type ListCertificateSigningRequestsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreateCertificateSigningRequestParams defines parameters for CreateCertificateSigningRequest.
+type CreateCertificateSigningRequestParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// PatchCertificateSigningRequestParams defines parameters for PatchCertificateSigningRequest.
+type PatchCertificateSigningRequestParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
 }
 
-// AuthValidateParams defines parameters for AuthValidate.
-type AuthValidateParams struct {
-	// Authorization The authentication token to validate.
-	Authorization *string `json:"Authorization,omitempty"`
+// ReplaceCertificateSigningRequestParams defines parameters for ReplaceCertificateSigningRequest.
+type ReplaceCertificateSigningRequestParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
 }
 
-// ListCertificateSigningRequestsParams defines parameters for ListCertificateSigningRequests.
-type ListCertificateSigningRequestsParams struct {
+// ListConsoleSessionsParams defines parameters for ListConsoleSessions.
+type ListConsoleSessionsParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
 	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
 
 	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
 	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
 
-	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "metadata.owner=Device/mydevice").
 	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
 
 	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
@@ -2483,6 +3382,30 @@ type ListDevicesParams struct {
 
 	// SummaryOnly A boolean flag to include only a summary of the devices. When set to true, the response will contain only the summary information. Only the 'owner' and 'labelSelector' parameters are supported when 'summaryOnly' is true.
 	SummaryOnly *bool `form:"summaryOnly,omitempty" json:"summaryOnly,omitempty"`
+
+	// Watch Stream changes to the devices as newline-delimited WatchEvent objects instead of returning a list. The selectors restrict the streamed changes, while 'continue' and 'limit' are ignored.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ResourceVersion When watching, only stream changes to resources with a resourceVersion greater than this value. If unset, the stream starts with an ADDED event for every existing resource.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
+}
+
+// CreateDeviceParams defines parameters for CreateDevice.
+type CreateDeviceParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// PatchDeviceParams defines parameters for PatchDevice.
+type PatchDeviceParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ReplaceDeviceParams defines parameters for ReplaceDevice.
+type ReplaceDeviceParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
 }
 
 // GetRenderedDeviceParams defines parameters for GetRenderedDevice.
@@ -2491,12 +3414,63 @@ type GetRenderedDeviceParams struct {
 	KnownRenderedVersion *string `form:"knownRenderedVersion,omitempty" json:"knownRenderedVersion,omitempty"`
 }
 
+// SearchDevicesParams defines parameters for SearchDevices.
+type SearchDevicesParams struct {
+	// Q The text to search for, such as a fragment of a serial number, an IP address or a hostname. The search is case-insensitive and must be at least 3 characters long.
+	Q string `form:"q" json:"q"`
+
+	// LabelSelector A selector to restrict the searched devices by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the searched devices by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous search response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// Limit The maximum number of results returned in the search response. The server will set the 'continue' field in the response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetEnrollmentConfigParams defines parameters for GetEnrollmentConfig.
 type GetEnrollmentConfigParams struct {
 	// Csr The name of a CertificateSigningRequest resource to query for an issued certificate. If provided, the service will check if the CertificateSigningRequest contains an issued certificate and in this case include it the returned EnrollmentConfig. In all other case, the enrollment certificate field will be empty.
 	Csr *string `form:"csr,omitempty" json:"csr,omitempty"`
 }
 
+// ListEnrollmentPoliciesParams defines parameters for ListEnrollmentPolicies.
+type ListEnrollmentPoliciesParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreateEnrollmentPolicyParams defines parameters for CreateEnrollmentPolicy.
+type CreateEnrollmentPolicyParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// PatchEnrollmentPolicyParams defines parameters for PatchEnrollmentPolicy.
+type PatchEnrollmentPolicyParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ReplaceEnrollmentPolicyParams defines parameters for ReplaceEnrollmentPolicy.
+type ReplaceEnrollmentPolicyParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
 // ListEnrollmentRequestsParams defines parameters for ListEnrollmentRequests.
 type ListEnrollmentRequestsParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -2512,6 +3486,24 @@ type ListEnrollmentRequestsParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// CreateEnrollmentRequestParams defines parameters for CreateEnrollmentRequest.
+type CreateEnrollmentRequestParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// PatchEnrollmentRequestParams defines parameters for PatchEnrollmentRequest.
+type PatchEnrollmentRequestParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ReplaceEnrollmentRequestParams defines parameters for ReplaceEnrollmentRequest.
+type ReplaceEnrollmentRequestParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
 // ListEventsParams defines parameters for ListEvents.
 type ListEventsParams struct {
 	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
@@ -2525,11 +3517,47 @@ type ListEventsParams struct {
 
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
 	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// Watch Stream events as newline-delimited WatchEvent objects instead of returning a list. Only events created after the watch was started are streamed. The field selector restricts the streamed events, while 'order', 'continue' and 'limit' are ignored.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
 }
 
 // ListEventsParamsOrder defines parameters for ListEvents.
 type ListEventsParamsOrder string
 
+// ListEventSubscriptionsParams defines parameters for ListEventSubscriptions.
+type ListEventSubscriptionsParams struct {
+	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
+	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// LabelSelector A selector to restrict the list of returned objects by their labels. Defaults to everything.
+	LabelSelector *string `form:"labelSelector,omitempty" json:"labelSelector,omitempty"`
+
+	// FieldSelector A selector to restrict the list of returned objects by their fields, supporting operators like '=', '==', and '!=' (e.g., "key1=value1,key2!=value2").
+	FieldSelector *string `form:"fieldSelector,omitempty" json:"fieldSelector,omitempty"`
+
+	// Limit The maximum number of results returned in the list response. The server will set the 'continue' field in the list response if more results exist. The continue value may then be specified as parameter in a subsequent query.
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// CreateEventSubscriptionParams defines parameters for CreateEventSubscription.
+type CreateEventSubscriptionParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// PatchEventSubscriptionParams defines parameters for PatchEventSubscription.
+type PatchEventSubscriptionParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ReplaceEventSubscriptionParams defines parameters for ReplaceEventSubscription.
+type ReplaceEventSubscriptionParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
 // ListFleetsParams defines parameters for ListFleets.
 type ListFleetsParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -2546,6 +3574,18 @@ type ListFleetsParams struct {
 
 	// AddDevicesSummary Include a summary of the devices in the fleet.
 	AddDevicesSummary *bool `form:"addDevicesSummary,omitempty" json:"addDevicesSummary,omitempty"`
+
+	// Watch Stream changes to the fleets as newline-delimited WatchEvent objects instead of returning a list. The selectors restrict the streamed changes, while 'continue' and 'limit' are ignored.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ResourceVersion When watching, only stream changes to resources with a resourceVersion greater than this value. If unset, the stream starts with an ADDED event for every existing resource.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
+}
+
+// CreateFleetParams defines parameters for CreateFleet.
+type CreateFleetParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
 }
 
 // ListTemplateVersionsParams defines parameters for ListTemplateVersions.
@@ -2569,6 +3609,24 @@ type GetFleetParams struct {
 	AddDevicesSummary *bool `form:"addDevicesSummary,omitempty" json:"addDevicesSummary,omitempty"`
 }
 
+// PatchFleetParams defines parameters for PatchFleet.
+type PatchFleetParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ReplaceFleetParams defines parameters for ReplaceFleet.
+type ReplaceFleetParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// PreviewFleetParams defines parameters for PreviewFleet.
+type PreviewFleetParams struct {
+	// SampleSize The number of devices for which the rendered template is returned. Defaults to 5.
+	SampleSize *int32 `form:"sampleSize,omitempty" json:"sampleSize,omitempty"`
+}
+
 // ListImageBuildsParams defines parameters for ListImageBuilds.
 type ListImageBuildsParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -2617,6 +3675,24 @@ type ListRepositoriesParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// CreateRepositoryParams defines parameters for CreateRepository.
+type CreateRepositoryParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// PatchRepositoryParams defines parameters for PatchRepository.
+type PatchRepositoryParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ReplaceRepositoryParams defines parameters for ReplaceRepository.
+type ReplaceRepositoryParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
 // ListResourceSyncsParams defines parameters for ListResourceSyncs.
 type ListResourceSyncsParams struct {
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
@@ -2632,6 +3708,27 @@ type ListResourceSyncsParams struct {
 	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// CreateResourceSyncParams defines parameters for CreateResourceSync.
+type CreateResourceSyncParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// PatchResourceSyncParams defines parameters for PatchResourceSync.
+type PatchResourceSyncParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// ReplaceResourceSyncParams defines parameters for ReplaceResourceSync.
+type ReplaceResourceSyncParams struct {
+	// DryRun When set to All, the request is validated but the resource is not persisted.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// CreateBulkOperationJSONRequestBody defines body for CreateBulkOperation for application/json ContentType.
+type CreateBulkOperationJSONRequestBody = BulkOperation
+
 // CreateCertificateSigningRequestJSONRequestBody defines body for CreateCertificateSigningRequest for application/json ContentType.
 type CreateCertificateSigningRequestJSONRequestBody = CertificateSigningRequest
 
@@ -2665,6 +3762,15 @@ type PatchDeviceStatusApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceDeviceStatusJSONRequestBody defines body for ReplaceDeviceStatus for application/json ContentType.
 type ReplaceDeviceStatusJSONRequestBody = Device
 
+// CreateEnrollmentPolicyJSONRequestBody defines body for CreateEnrollmentPolicy for application/json ContentType.
+type CreateEnrollmentPolicyJSONRequestBody = EnrollmentPolicy
+
+// PatchEnrollmentPolicyApplicationJSONPatchPlusJSONRequestBody defines body for PatchEnrollmentPolicy for application/json-patch+json ContentType.
+type PatchEnrollmentPolicyApplicationJSONPatchPlusJSONRequestBody = PatchRequest
+
+// ReplaceEnrollmentPolicyJSONRequestBody defines body for ReplaceEnrollmentPolicy for application/json ContentType.
+type ReplaceEnrollmentPolicyJSONRequestBody = EnrollmentPolicy
+
 // CreateEnrollmentRequestJSONRequestBody defines body for CreateEnrollmentRequest for application/json ContentType.
 type CreateEnrollmentRequestJSONRequestBody = EnrollmentRequest
 
@@ -2683,6 +3789,15 @@ type PatchEnrollmentRequestStatusApplicationJSONPatchPlusJSONRequestBody = Patch
 // ReplaceEnrollmentRequestStatusJSONRequestBody defines body for ReplaceEnrollmentRequestStatus for application/json ContentType.
 type ReplaceEnrollmentRequestStatusJSONRequestBody = EnrollmentRequest
 
+// CreateEventSubscriptionJSONRequestBody defines body for CreateEventSubscription for application/json ContentType.
+type CreateEventSubscriptionJSONRequestBody = EventSubscription
+
+// PatchEventSubscriptionApplicationJSONPatchPlusJSONRequestBody defines body for PatchEventSubscription for application/json-patch+json ContentType.
+type PatchEventSubscriptionApplicationJSONPatchPlusJSONRequestBody = PatchRequest
+
+// ReplaceEventSubscriptionJSONRequestBody defines body for ReplaceEventSubscription for application/json ContentType.
+type ReplaceEventSubscriptionJSONRequestBody = EventSubscription
+
 // CreateFleetJSONRequestBody defines body for CreateFleet for application/json ContentType.
 type CreateFleetJSONRequestBody = Fleet
 
@@ -2692,6 +3807,9 @@ type PatchFleetApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceFleetJSONRequestBody defines body for ReplaceFleet for application/json ContentType.
 type ReplaceFleetJSONRequestBody = Fleet
 
+// PreviewFleetJSONRequestBody defines body for PreviewFleet for application/json ContentType.
+type PreviewFleetJSONRequestBody = Fleet
+
 // PatchFleetStatusApplicationJSONPatchPlusJSONRequestBody defines body for PatchFleetStatus for application/json-patch+json ContentType.
 type PatchFleetStatusApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 
@@ -3610,6 +4728,34 @@ func (t *EventDetails) MergeFleetRolloutFailedDetails(v FleetRolloutFailedDetail
 	return err
 }
 
+// AsFleetRolloutRolledBackDetails returns the union data inside the EventDetails as a FleetRolloutRolledBackDetails
+func (t EventDetails) AsFleetRolloutRolledBackDetails() (FleetRolloutRolledBackDetails, error) {
+	var body FleetRolloutRolledBackDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFleetRolloutRolledBackDetails overwrites any union data inside the EventDetails as the provided FleetRolloutRolledBackDetails
+func (t *EventDetails) FromFleetRolloutRolledBackDetails(v FleetRolloutRolledBackDetails) error {
+	v.DetailType = "FleetRolloutRolledBack"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFleetRolloutRolledBackDetails performs a merge with any union data inside the EventDetails, using the provided FleetRolloutRolledBackDetails
+func (t *EventDetails) MergeFleetRolloutRolledBackDetails(v FleetRolloutRolledBackDetails) error {
+	v.DetailType = "FleetRolloutRolledBack"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 // AsFleetRolloutCompletedDetails returns the union data inside the EventDetails as a FleetRolloutCompletedDetails
 func (t EventDetails) AsFleetRolloutCompletedDetails() (FleetRolloutCompletedDetails, error) {
 	var body FleetRolloutCompletedDetails
@@ -3722,6 +4868,34 @@ func (t *EventDetails) MergeFleetRolloutDeviceSelectedDetails(v FleetRolloutDevi
 	return err
 }
 
+// AsEnrollmentPolicyDecisionDetails returns the union data inside the EventDetails as a EnrollmentPolicyDecisionDetails
+func (t EventDetails) AsEnrollmentPolicyDecisionDetails() (EnrollmentPolicyDecisionDetails, error) {
+	var body EnrollmentPolicyDecisionDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromEnrollmentPolicyDecisionDetails overwrites any union data inside the EventDetails as the provided EnrollmentPolicyDecisionDetails
+func (t *EventDetails) FromEnrollmentPolicyDecisionDetails(v EnrollmentPolicyDecisionDetails) error {
+	v.DetailType = "EnrollmentPolicyDecision"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeEnrollmentPolicyDecisionDetails performs a merge with any union data inside the EventDetails, using the provided EnrollmentPolicyDecisionDetails
+func (t *EventDetails) MergeEnrollmentPolicyDecisionDetails(v EnrollmentPolicyDecisionDetails) error {
+	v.DetailType = "EnrollmentPolicyDecision"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t EventDetails) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"detailType"`
@@ -3742,6 +4916,8 @@ func (t EventDetails) ValueByDiscriminator() (interface{}, error) {
 		return t.AsDeviceMultipleOwnersResolvedDetails()
 	case "DeviceOwnershipChanged":
 		return t.AsDeviceOwnershipChangedDetails()
+	case "EnrollmentPolicyDecision":
+		return t.AsEnrollmentPolicyDecisionDetails()
 	case "FleetRolloutBatchCompleted":
 		return t.AsFleetRolloutBatchCompletedDetails()
 	case "FleetRolloutBatchDispatched":
@@ -3752,6 +4928,8 @@ func (t EventDetails) ValueByDiscriminator() (interface{}, error) {
 		return t.AsFleetRolloutDeviceSelectedDetails()
 	case "FleetRolloutFailed":
 		return t.AsFleetRolloutFailedDetails()
+	case "FleetRolloutRolledBack":
+		return t.AsFleetRolloutRolledBackDetails()
 	case "FleetRolloutStarted":
 		return t.AsFleetRolloutStartedDetails()
 	case "InternalTaskFailed":
@@ -3805,6 +4983,58 @@ func (t *HookAction) MergeHookActionRun(v HookActionRun) error {
 	return err
 }
 
+// AsHookActionSystemd returns the union data inside the HookAction as a HookActionSystemd
+func (t HookAction) AsHookActionSystemd() (HookActionSystemd, error) {
+	var body HookActionSystemd
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHookActionSystemd overwrites any union data inside the HookAction as the provided HookActionSystemd
+func (t *HookAction) FromHookActionSystemd(v HookActionSystemd) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHookActionSystemd performs a merge with any union data inside the HookAction, using the provided HookActionSystemd
+func (t *HookAction) MergeHookActionSystemd(v HookActionSystemd) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHookActionProbe returns the union data inside the HookAction as a HookActionProbe
+func (t HookAction) AsHookActionProbe() (HookActionProbe, error) {
+	var body HookActionProbe
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHookActionProbe overwrites any union data inside the HookAction as the provided HookActionProbe
+func (t *HookAction) FromHookActionProbe(v HookActionProbe) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHookActionProbe performs a merge with any union data inside the HookAction, using the provided HookActionProbe
+func (t *HookAction) MergeHookActionProbe(v HookActionProbe) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t HookAction) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	if err != nil {
@@ -4097,6 +5327,90 @@ func (t *ResourceMonitor) MergeDiskResourceMonitorSpec(v DiskResourceMonitorSpec
 	return err
 }
 
+// AsTemperatureResourceMonitorSpec returns the union data inside the ResourceMonitor as a TemperatureResourceMonitorSpec
+func (t ResourceMonitor) AsTemperatureResourceMonitorSpec() (TemperatureResourceMonitorSpec, error) {
+	var body TemperatureResourceMonitorSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromTemperatureResourceMonitorSpec overwrites any union data inside the ResourceMonitor as the provided TemperatureResourceMonitorSpec
+func (t *ResourceMonitor) FromTemperatureResourceMonitorSpec(v TemperatureResourceMonitorSpec) error {
+	v.MonitorType = "Temperature"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeTemperatureResourceMonitorSpec performs a merge with any union data inside the ResourceMonitor, using the provided TemperatureResourceMonitorSpec
+func (t *ResourceMonitor) MergeTemperatureResourceMonitorSpec(v TemperatureResourceMonitorSpec) error {
+	v.MonitorType = "Temperature"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsNetworkResourceMonitorSpec returns the union data inside the ResourceMonitor as a NetworkResourceMonitorSpec
+func (t ResourceMonitor) AsNetworkResourceMonitorSpec() (NetworkResourceMonitorSpec, error) {
+	var body NetworkResourceMonitorSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNetworkResourceMonitorSpec overwrites any union data inside the ResourceMonitor as the provided NetworkResourceMonitorSpec
+func (t *ResourceMonitor) FromNetworkResourceMonitorSpec(v NetworkResourceMonitorSpec) error {
+	v.MonitorType = "Network"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNetworkResourceMonitorSpec performs a merge with any union data inside the ResourceMonitor, using the provided NetworkResourceMonitorSpec
+func (t *ResourceMonitor) MergeNetworkResourceMonitorSpec(v NetworkResourceMonitorSpec) error {
+	v.MonitorType = "Network"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsProcessResourceMonitorSpec returns the union data inside the ResourceMonitor as a ProcessResourceMonitorSpec
+func (t ResourceMonitor) AsProcessResourceMonitorSpec() (ProcessResourceMonitorSpec, error) {
+	var body ProcessResourceMonitorSpec
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProcessResourceMonitorSpec overwrites any union data inside the ResourceMonitor as the provided ProcessResourceMonitorSpec
+func (t *ResourceMonitor) FromProcessResourceMonitorSpec(v ProcessResourceMonitorSpec) error {
+	v.MonitorType = "Process"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProcessResourceMonitorSpec performs a merge with any union data inside the ResourceMonitor, using the provided ProcessResourceMonitorSpec
+func (t *ResourceMonitor) MergeProcessResourceMonitorSpec(v ProcessResourceMonitorSpec) error {
+	v.MonitorType = "Process"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t ResourceMonitor) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"monitorType"`
@@ -4117,6 +5431,12 @@ func (t ResourceMonitor) ValueByDiscriminator() (interface{}, error) {
 		return t.AsDiskResourceMonitorSpec()
 	case "Memory":
 		return t.AsMemoryResourceMonitorSpec()
+	case "Network":
+		return t.AsNetworkResourceMonitorSpec()
+	case "Process":
+		return t.AsProcessResourceMonitorSpec()
+	case "Temperature":
+		return t.AsTemperatureResourceMonitorSpec()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
@@ -4160,6 +5480,34 @@ func (t *RolloutDeviceSelection) MergeBatchSequence(v BatchSequence) error {
 	return err
 }
 
+// AsProgressiveCanary returns the union data inside the RolloutDeviceSelection as a ProgressiveCanary
+func (t RolloutDeviceSelection) AsProgressiveCanary() (ProgressiveCanary, error) {
+	var body ProgressiveCanary
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromProgressiveCanary overwrites any union data inside the RolloutDeviceSelection as the provided ProgressiveCanary
+func (t *RolloutDeviceSelection) FromProgressiveCanary(v ProgressiveCanary) error {
+	v.Strategy = "ProgressiveCanary"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeProgressiveCanary performs a merge with any union data inside the RolloutDeviceSelection, using the provided ProgressiveCanary
+func (t *RolloutDeviceSelection) MergeProgressiveCanary(v ProgressiveCanary) error {
+	v.Strategy = "ProgressiveCanary"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t RolloutDeviceSelection) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"strategy"`
@@ -4176,6 +5524,8 @@ func (t RolloutDeviceSelection) ValueByDiscriminator() (interface{}, error) {
 	switch discriminator {
 	case "BatchSequence":
 		return t.AsBatchSequence()
+	case "ProgressiveCanary":
+		return t.AsProgressiveCanary()
 	default:
 		return nil, errors.New("unknown discriminator value: " + discriminator)
 	}
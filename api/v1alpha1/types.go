@@ -28,3 +28,8 @@ type RolloutBatchCompletionReport struct {
 	Failed            int64  `json:"failed"`
 	TimedOut          int64  `json:"timedOut"`
 }
+
+// CreateDeviceAttestationJSONRequestBody is the body of the agent-only createDeviceAttestation operation.  The
+// generated agent client resolves request bodies in this package, which only declares the bodies of the
+// operations that the user API shares with the agent API.
+type CreateDeviceAttestationJSONRequestBody = DeviceAttestation
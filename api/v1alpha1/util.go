@@ -3,9 +3,16 @@ package v1alpha1
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"hash/fnv"
 	"reflect"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"text/template"
@@ -28,7 +35,9 @@ type DeviceCompletionCount struct {
 type HookActionType string
 
 const (
-	HookActionTypeRun HookActionType = "run"
+	HookActionTypeRun     HookActionType = "run"
+	HookActionTypeSystemd HookActionType = "systemd"
+	HookActionTypeProbe   HookActionType = "probe"
 )
 
 type HookConditionType string
@@ -69,6 +78,8 @@ func (t HookAction) Type() (HookActionType, error) {
 
 	types := []HookActionType{
 		HookActionTypeRun,
+		HookActionTypeSystemd,
+		HookActionTypeProbe,
 	}
 	for _, t := range types {
 		if _, exists := data[t]; exists {
@@ -191,6 +202,18 @@ func FleetSpecsAreEqual(f1, f2 FleetSpec) bool {
 // "missingkey=error" is used, the template execution will fail and we
 // won't get to this point.
 func GetGoTemplateFuncMap() template.FuncMap {
+	return goTemplateFuncMap(false)
+}
+
+// getValidationGoTemplateFuncMap returns the functions used when validating a template.  The
+// template is then executed on a placeholder device whose labels, annotations and system info
+// are empty, so functions that parse a value accept an empty one: fromJson returns an empty
+// object and the arithmetic functions use 0.  When rendering, these are errors.
+func getValidationGoTemplateFuncMap() template.FuncMap {
+	return goTemplateFuncMap(true)
+}
+
+func goTemplateFuncMap(lenient bool) template.FuncMap {
 	stringOrDefault := func(s any) string {
 		str, ok := s.(string)
 		if ok {
@@ -225,26 +248,289 @@ func GetGoTemplateFuncMap() template.FuncMap {
 		return defaultValue
 	}
 
+	defaultValue := func(def any, input any) any {
+		if isEmptyTemplateValue(input) {
+			return def
+		}
+		return input
+	}
+
+	trim := func(input any) string {
+		return strings.TrimSpace(stringOrDefault(input))
+	}
+
+	split := func(sep string, input any) []string {
+		return strings.Split(stringOrDefault(input), sep)
+	}
+
+	join := func(sep string, input []string) string {
+		return strings.Join(input, sep)
+	}
+
+	hasPrefix := func(prefix string, input any) bool {
+		return strings.HasPrefix(stringOrDefault(input), prefix)
+	}
+
+	hasSuffix := func(suffix string, input any) bool {
+		return strings.HasSuffix(stringOrDefault(input), suffix)
+	}
+
+	regexMatch := func(pattern string, input any) (bool, error) {
+		return regexp.MatchString(pattern, stringOrDefault(input))
+	}
+
+	regexReplace := func(pattern string, replacement string, input any) (string, error) {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return "", err
+		}
+		return re.ReplaceAllString(stringOrDefault(input), replacement), nil
+	}
+
+	base64Encode := func(input any) string {
+		return base64.StdEncoding.EncodeToString([]byte(stringOrDefault(input)))
+	}
+
+	base64Decode := func(input any) (string, error) {
+		decoded, err := base64.StdEncoding.DecodeString(stringOrDefault(input))
+		if err != nil {
+			return "", err
+		}
+		return string(decoded), nil
+	}
+
+	sha256Sum := func(input any) string {
+		sum := sha256.Sum256([]byte(stringOrDefault(input)))
+		return hex.EncodeToString(sum[:])
+	}
+
+	toJson := func(input any) (string, error) {
+		out, err := json.Marshal(input)
+		if err != nil {
+			return "", err
+		}
+		return string(out), nil
+	}
+
+	fromJson := func(input any) (any, error) {
+		str := stringOrDefault(input)
+		if str == "" && lenient {
+			return map[string]any{}, nil
+		}
+		var out any
+		if err := json.Unmarshal([]byte(str), &out); err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+
+	add := func(a, b any) (int64, error) {
+		return applyIntOperation(a, b, lenient, func(x, y int64) (int64, error) { return x + y, nil })
+	}
+
+	sub := func(a, b any) (int64, error) {
+		return applyIntOperation(a, b, lenient, func(x, y int64) (int64, error) { return x - y, nil })
+	}
+
+	mul := func(a, b any) (int64, error) {
+		return applyIntOperation(a, b, lenient, func(x, y int64) (int64, error) { return x * y, nil })
+	}
+
+	div := func(a, b any) (int64, error) {
+		return applyIntOperation(a, b, lenient, func(x, y int64) (int64, error) {
+			if y == 0 {
+				return 0, fmt.Errorf("division by zero")
+			}
+			return x / y, nil
+		})
+	}
+
+	mod := func(a, b any) (int64, error) {
+		return applyIntOperation(a, b, lenient, func(x, y int64) (int64, error) {
+			if y == 0 {
+				return 0, fmt.Errorf("modulo by zero")
+			}
+			return x % y, nil
+		})
+	}
+
+	ternary := func(trueValue any, falseValue any, condition bool) any {
+		if condition {
+			return trueValue
+		}
+		return falseValue
+	}
+
+	// hashMod deterministically maps the input to a bucket in [0, buckets), for
+	// example to shard devices by name
+	hashMod := func(buckets any, input any) (int64, error) {
+		n, err := templateValueToInt(buckets, lenient)
+		if err != nil {
+			return 0, err
+		}
+		if n <= 0 {
+			return 0, fmt.Errorf("number of buckets must be positive, got %d", n)
+		}
+		h := fnv.New64a()
+		_, _ = h.Write([]byte(stringOrDefault(input)))
+		return int64(h.Sum64() % uint64(n)), nil
+	}
+
 	return template.FuncMap{
 		"upper":        toUpper,
 		"lower":        toLower,
 		"replace":      replace,
 		"getOrDefault": getOrDefault,
+		"default":      defaultValue,
+		"trim":         trim,
+		"split":        split,
+		"join":         join,
+		"hasPrefix":    hasPrefix,
+		"hasSuffix":    hasSuffix,
+		"regexMatch":   regexMatch,
+		"regexReplace": regexReplace,
+		"b64enc":       base64Encode,
+		"b64dec":       base64Decode,
+		"sha256":       sha256Sum,
+		"toJson":       toJson,
+		"fromJson":     fromJson,
+		"add":          add,
+		"sub":          sub,
+		"mul":          mul,
+		"div":          div,
+		"mod":          mod,
+		"ternary":      ternary,
+		"hashMod":      hashMod,
 	}
 }
 
+// isEmptyTemplateValue returns true for values that the "default" template function replaces.
+func isEmptyTemplateValue(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return true
+		}
+		return isEmptyTemplateValue(rv.Elem().Interface())
+	case reflect.String, reflect.Map, reflect.Slice, reflect.Array:
+		return rv.Len() == 0
+	default:
+		return rv.IsZero()
+	}
+}
+
+// templateValueToInt converts numbers and numeric strings (e.g., label values) to int64.
+// If lenient, a missing value or an empty string is treated as 0.
+func templateValueToInt(v any, lenient bool) (int64, error) {
+	switch val := v.(type) {
+	case int:
+		return int64(val), nil
+	case int32:
+		return int64(val), nil
+	case int64:
+		return val, nil
+	case float64:
+		return int64(val), nil
+	case string:
+		if val == "" && lenient {
+			return 0, nil
+		}
+		return strconv.ParseInt(strings.TrimSpace(val), 10, 64)
+	case *string:
+		if val == nil {
+			break
+		}
+		return templateValueToInt(*val, lenient)
+	case nil:
+	default:
+		return 0, fmt.Errorf("cannot convert %v of type %T to an integer", v, v)
+	}
+	if lenient {
+		return 0, nil
+	}
+	return 0, errors.New("cannot convert a missing value to an integer")
+}
+
+func applyIntOperation(a, b any, lenient bool, op func(x, y int64) (int64, error)) (int64, error) {
+	x, err := templateValueToInt(a, lenient)
+	if err != nil {
+		return 0, err
+	}
+	y, err := templateValueToInt(b, lenient)
+	if err != nil {
+		return 0, err
+	}
+	return op(x, y)
+}
+
+// deviceTemplateFields lists the device fields that fleet templates may reference,
+// using the same paths as the map built by ExecuteGoTemplateOnDevice. A trailing
+// ".*" marks a map whose keys are user-defined (e.g., labels).
+var deviceTemplateFields = []string{
+	"metadata.name",
+	"metadata.labels.*",
+	"metadata.annotations.*",
+	"fleet.name",
+	"status.systemInfo.architecture",
+	"status.systemInfo.productSerial",
+	"status.systemInfo.netMacDefault",
+	"status.systemInfo.customInfo.*",
+}
+
+// isDeviceTemplateField returns true if the given field path (e.g., ["metadata", "labels", "key"])
+// references a device field that is exposed to fleet templates, or an ancestor of one.
+func isDeviceTemplateField(path []string) bool {
+	for _, field := range deviceTemplateFields {
+		parts := strings.Split(field, ".")
+		isMap := parts[len(parts)-1] == "*"
+		if isMap {
+			parts = parts[:len(parts)-1]
+		}
+		if len(path) <= len(parts) {
+			if slices.Equal(path, parts[:len(path)]) {
+				return true
+			}
+			continue
+		}
+		if isMap && len(path) == len(parts)+1 && slices.Equal(path[:len(parts)], parts) {
+			return true
+		}
+	}
+	return false
+}
+
 // This function wraps template.Execute.  Instead of passing the device directly,
 // it converts it into a map first.  This has two purposes:
 // 1. The user-provided template uses the yaml/json API format (e.g., lower case)
-// 2. The map contains only the device fields we allow access to
+// 2. The map contains only the device fields we allow access to (see deviceTemplateFields)
+//
+// System info values that the device has not reported are left out of the map, so
+// that referencing them fails when the "missingkey=error" option is used.
 func ExecuteGoTemplateOnDevice(t *template.Template, dev *Device) (string, error) {
 	devMap := map[string]interface{}{
 		"metadata": map[string]interface{}{
-			"name":   dev.Metadata.Name,
-			"labels": dev.Metadata.Labels,
+			"name":        dev.Metadata.Name,
+			"labels":      nonNilStringMap(dev.Metadata.Labels),
+			"annotations": nonNilStringMap(dev.Metadata.Annotations),
+		},
+		"status": map[string]interface{}{
+			"systemInfo": deviceSystemInfoTemplateMap(dev.Status),
 		},
 	}
 
+	if dev.Metadata.Owner != nil {
+		ownerKind, ownerName, err := util.GetResourceOwner(dev.Metadata.Owner)
+		if err == nil && ownerKind == FleetKind {
+			devMap["fleet"] = map[string]interface{}{
+				"name": ownerName,
+			}
+		}
+	}
+
 	buf := new(bytes.Buffer)
 	err := t.Execute(buf, devMap)
 	if err != nil {
@@ -253,6 +539,34 @@ func ExecuteGoTemplateOnDevice(t *template.Template, dev *Device) (string, error
 	return buf.String(), nil
 }
 
+func deviceSystemInfoTemplateMap(status *DeviceStatus) map[string]interface{} {
+	infoMap := map[string]interface{}{}
+	var customInfo *CustomDeviceInfo
+	if status != nil {
+		info := status.SystemInfo
+		if info.Architecture != "" {
+			infoMap["architecture"] = info.Architecture
+		}
+		for _, key := range []string{"productSerial", "netMacDefault"} {
+			if val, ok := info.AdditionalProperties[key]; ok && val != "" {
+				infoMap[key] = val
+			}
+		}
+		customInfo = info.CustomInfo
+	}
+	infoMap["customInfo"] = nonNilStringMap((*map[string]string)(customInfo))
+	return infoMap
+}
+
+// nonNilStringMap returns the given map, or a pointer to an empty map if it is nil.
+// This keeps "missingkey" semantics consistent for maps that the object doesn't set.
+func nonNilStringMap(m *map[string]string) *map[string]string {
+	if m == nil {
+		return &map[string]string{}
+	}
+	return m
+}
+
 // MatchExpressionsToString converts a list of MatchExpressions into a formatted string.
 // Each MatchExpression is represented by its string form, separated by ", ".
 func MatchExpressionsToString(exprs ...MatchExpression) string {
@@ -356,13 +670,19 @@ func (r *Repository) HideSensitiveData() error {
 	}
 	spec := r.Spec
 
-	_, err := spec.GetGenericRepoSpec()
-	if err != nil {
+	genericRepoSpec, err := spec.GetGenericRepoSpec()
+	if err == nil {
+		hideValue(genericRepoSpec.WebhookSecret)
+		if err := spec.FromGenericRepoSpec(genericRepoSpec); err != nil {
+			return err
+		}
+	} else {
 		gitHttpSpec, err := spec.GetHttpRepoSpec()
 		if err == nil {
 			hideValue(gitHttpSpec.HttpConfig.Password)
 			hideValue(gitHttpSpec.HttpConfig.TlsKey)
 			hideValue(gitHttpSpec.HttpConfig.TlsCrt)
+			hideValue(gitHttpSpec.WebhookSecret)
 			if err := spec.FromHttpRepoSpec(gitHttpSpec); err != nil {
 				return err
 			}
@@ -372,6 +692,7 @@ func (r *Repository) HideSensitiveData() error {
 			if err == nil {
 				hideValue(gitSshRepoSpec.SshConfig.SshPrivateKey)
 				hideValue(gitSshRepoSpec.SshConfig.PrivateKeyPassphrase)
+				hideValue(gitSshRepoSpec.WebhookSecret)
 				if err := spec.FromSshRepoSpec(gitSshRepoSpec); err != nil {
 					return err
 				}
@@ -394,6 +715,36 @@ func (r *RepositoryList) HideSensitiveData() error {
 	return nil
 }
 
+func (es *EventSubscription) HideSensitiveData() error {
+	if es == nil {
+		return nil
+	}
+	hideValue(es.Spec.Webhook.Secret)
+	return nil
+}
+
+func (l *EventSubscriptionList) HideSensitiveData() error {
+	if l == nil {
+		return nil
+	}
+	for i := range l.Items {
+		if err := l.Items[i].HideSensitiveData(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsFinished returns true if the bulk operation completed, failed or was canceled
+func (s BulkOperationStatus) IsFinished() bool {
+	switch s.Phase {
+	case BulkOperationPhaseCompleted, BulkOperationPhaseFailed, BulkOperationPhaseCanceled:
+		return true
+	default:
+		return false
+	}
+}
+
 // GetBaseEvent creates a base event with common fields
 func GetBaseEvent(ctx context.Context, resourceKind ResourceKind, resourceName string, reason EventReason, message string, details *EventDetails) *Event {
 	var actorStr string
@@ -438,31 +789,42 @@ func GetBaseEvent(ctx context.Context, resourceKind ResourceKind, resourceName s
 
 // warningReasons contains all event reasons that should result in Warning events
 var warningReasons = map[EventReason]struct{}{
-	EventReasonResourceCreationFailed:          {},
-	EventReasonResourceUpdateFailed:            {},
-	EventReasonResourceDeletionFailed:          {},
-	EventReasonDeviceDecommissionFailed:        {},
-	EventReasonEnrollmentRequestApprovalFailed: {},
-	EventReasonDeviceApplicationDegraded:       {},
-	EventReasonDeviceApplicationError:          {},
-	EventReasonDeviceCPUCritical:               {},
-	EventReasonDeviceCPUWarning:                {},
-	EventReasonDeviceMemoryCritical:            {},
-	EventReasonDeviceMemoryWarning:             {},
-	EventReasonDeviceDiskCritical:              {},
-	EventReasonDeviceDiskWarning:               {},
-	EventReasonDeviceDisconnected:              {},
-	EventReasonDeviceConflictPaused:            {},
-	EventReasonDeviceSpecInvalid:               {},
-	EventReasonFleetInvalid:                    {},
-	EventReasonDeviceMultipleOwnersDetected:    {},
-	EventReasonDeviceUpdateFailed:              {},
-	EventReasonInternalTaskFailed:              {},
-	EventReasonInternalTaskPermanentlyFailed:   {},
-	EventReasonResourceSyncInaccessible:        {},
-	EventReasonResourceSyncParsingFailed:       {},
-	EventReasonResourceSyncSyncFailed:          {},
-	EventReasonFleetRolloutFailed:              {},
+	EventReasonResourceCreationFailed:              {},
+	EventReasonResourceUpdateFailed:                {},
+	EventReasonResourceDeletionFailed:              {},
+	EventReasonDeviceDecommissionFailed:            {},
+	EventReasonEnrollmentRequestApprovalFailed:     {},
+	EventReasonEnrollmentRequestRejected:           {},
+	EventReasonDeviceApplicationDegraded:           {},
+	EventReasonDeviceApplicationError:              {},
+	EventReasonDeviceCPUCritical:                   {},
+	EventReasonDeviceCPUWarning:                    {},
+	EventReasonDeviceMemoryCritical:                {},
+	EventReasonDeviceMemoryWarning:                 {},
+	EventReasonDeviceDiskCritical:                  {},
+	EventReasonDeviceDiskWarning:                   {},
+	EventReasonDeviceTemperatureCritical:           {},
+	EventReasonDeviceTemperatureWarning:            {},
+	EventReasonDeviceNetworkCritical:               {},
+	EventReasonDeviceNetworkWarning:                {},
+	EventReasonDeviceProcessCritical:               {},
+	EventReasonDeviceProcessWarning:                {},
+	EventReasonDeviceDisconnected:                  {},
+	EventReasonDeviceConflictPaused:                {},
+	EventReasonDeviceSpecInvalid:                   {},
+	EventReasonFleetInvalid:                        {},
+	EventReasonDeviceMultipleOwnersDetected:        {},
+	EventReasonDeviceUpdateFailed:                  {},
+	EventReasonInternalTaskFailed:                  {},
+	EventReasonInternalTaskPermanentlyFailed:       {},
+	EventReasonResourceSyncInaccessible:            {},
+	EventReasonResourceSyncParsingFailed:           {},
+	EventReasonResourceSyncSyncFailed:              {},
+	EventReasonResourceSyncDriftDetected:           {},
+	EventReasonFleetRolloutFailed:                  {},
+	EventReasonFleetRolloutRolledBack:              {},
+	EventReasonBulkOperationFailed:                 {},
+	EventReasonDeviceConsoleSessionRecordingFailed: {},
 }
 
 // GetEventType determines the event type based on the event reason
@@ -42,9 +42,49 @@ func TestExecuteGoTemplateOnDevice(t *testing.T) {
 		},
 		{
 			name:        "accessing non-exposed field fails",
-			paramString: "hello {{ .metadata.annotations.key }} world",
+			paramString: "hello {{ .metadata.owner }} world",
 			err:         true,
 		},
+		{
+			name:        "accessing non-exposed system info fails",
+			paramString: "hello {{ .status.systemInfo.bootID }} world",
+			err:         true,
+		},
+		{
+			name:        "annotation",
+			paramString: "Hello {{ .metadata.annotations.site }}",
+			err:         false,
+			expect:      "Hello berlin",
+		},
+		{
+			name:        "fleet name",
+			paramString: "Hello {{ .fleet.name }}",
+			err:         false,
+			expect:      "Hello myfleet",
+		},
+		{
+			name:        "architecture",
+			paramString: "quay.io/org/image:latest-{{ .status.systemInfo.architecture }}",
+			err:         false,
+			expect:      "quay.io/org/image:latest-amd64",
+		},
+		{
+			name:        "product serial",
+			paramString: "CN={{ .status.systemInfo.productSerial }}",
+			err:         false,
+			expect:      "CN=SN1234",
+		},
+		{
+			name:        "unreported system info fails",
+			paramString: "{{ .status.systemInfo.netMacDefault }}",
+			err:         true,
+		},
+		{
+			name:        "custom info",
+			paramString: "Hello {{ getOrDefault .status.systemInfo.customInfo \"rack\" \"none\" }}",
+			err:         false,
+			expect:      "Hello r12",
+		},
 		{
 			name:        "upper name",
 			paramString: "Hello {{ upper .metadata.name }}",
@@ -105,6 +145,142 @@ func TestExecuteGoTemplateOnDevice(t *testing.T) {
 			err:         false,
 			expect:      "Hello value",
 		},
+		{
+			name:        "default with empty value",
+			paramString: "{{ default \"none\" .metadata.annotations.empty }}",
+			err:         false,
+			expect:      "none",
+		},
+		{
+			name:        "default with missing key fails",
+			paramString: "{{ .metadata.annotations.missing | default \"none\" }}",
+			err:         true,
+		},
+		{
+			name:        "default with missing key and getOrDefault",
+			paramString: "{{ getOrDefault .metadata.annotations \"missing\" \"\" | default \"none\" }}",
+			err:         false,
+			expect:      "none",
+		},
+		{
+			name:        "default with value",
+			paramString: "{{ .metadata.name | default \"none\" }}",
+			err:         false,
+			expect:      "Name",
+		},
+		{
+			name:        "trim",
+			paramString: "[{{ trim .metadata.labels.padded }}]",
+			err:         false,
+			expect:      "[padded]",
+		},
+		{
+			name:        "split and index",
+			paramString: "{{ index (split \"-\" .metadata.labels.zone) 1 }}",
+			err:         false,
+			expect:      "west",
+		},
+		{
+			name:        "split and join",
+			paramString: "{{ split \"-\" .metadata.labels.zone | join \".\" }}",
+			err:         false,
+			expect:      "eu.west.1",
+		},
+		{
+			name:        "hasPrefix with ternary",
+			paramString: "{{ ternary \"europe\" \"other\" (hasPrefix \"eu-\" .metadata.labels.zone) }}",
+			err:         false,
+			expect:      "europe",
+		},
+		{
+			name:        "hasSuffix",
+			paramString: "{{ hasSuffix \"-2\" .metadata.labels.zone }}",
+			err:         false,
+			expect:      "false",
+		},
+		{
+			name:        "regexMatch",
+			paramString: "{{ regexMatch \"^SN[0-9]+$\" .status.systemInfo.productSerial }}",
+			err:         false,
+			expect:      "true",
+		},
+		{
+			name:        "regexReplace",
+			paramString: "{{ regexReplace \"^SN([0-9]+)$\" \"serial-$1\" .status.systemInfo.productSerial }}",
+			err:         false,
+			expect:      "serial-1234",
+		},
+		{
+			name:        "invalid regex fails",
+			paramString: "{{ regexMatch \"(\" .metadata.name }}",
+			err:         true,
+		},
+		{
+			name:        "base64 round trip",
+			paramString: "{{ b64enc .metadata.name }} {{ b64enc .metadata.name | b64dec }}",
+			err:         false,
+			expect:      "TmFtZQ== Name",
+		},
+		{
+			name:        "invalid base64 fails",
+			paramString: "{{ b64dec .metadata.labels.zone }}",
+			err:         true,
+		},
+		{
+			name:        "sha256",
+			paramString: "{{ sha256 .metadata.name }}",
+			err:         false,
+			expect:      "dcd1d5223f73b3a965c07e3ff5dbee3eedcfedb806686a05b9b3868a2c3d6d50",
+		},
+		{
+			name:        "toJson",
+			paramString: "{{ toJson .metadata.labels }}",
+			err:         false,
+			expect:      `{"key":"Value","padded":"  padded  ","replicas":"3","zone":"eu-west-1"}`,
+		},
+		{
+			name:        "fromJson",
+			paramString: "{{ index (fromJson .metadata.annotations.config) \"port\" }}",
+			err:         false,
+			expect:      "8080",
+		},
+		{
+			name:        "fromJson of empty value fails",
+			paramString: "{{ fromJson .metadata.annotations.empty }}",
+			err:         true,
+		},
+		{
+			name:        "int arithmetic",
+			paramString: "{{ add .metadata.labels.replicas 2 }} {{ sub 10 .metadata.labels.replicas }} {{ mul .metadata.labels.replicas 3 }} {{ div 10 .metadata.labels.replicas }} {{ mod 10 .metadata.labels.replicas }}",
+			err:         false,
+			expect:      "5 7 9 3 1",
+		},
+		{
+			name:        "division by zero fails",
+			paramString: "{{ div 10 0 }}",
+			err:         true,
+		},
+		{
+			name:        "arithmetic on non-numeric value fails",
+			paramString: "{{ add .metadata.labels.key 1 }}",
+			err:         true,
+		},
+		{
+			name:        "arithmetic on empty value fails",
+			paramString: "{{ add .metadata.annotations.empty 1 }}",
+			err:         true,
+		},
+		{
+			name:        "hashMod",
+			paramString: "shard-{{ hashMod 4 .metadata.name }}",
+			err:         false,
+			expect:      "shard-2",
+		},
+		{
+			name:        "hashMod with invalid bucket count fails",
+			paramString: "{{ hashMod 0 .metadata.name }}",
+			err:         true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -114,8 +290,18 @@ func TestExecuteGoTemplateOnDevice(t *testing.T) {
 
 			dev := &Device{
 				Metadata: ObjectMeta{
-					Name:   lo.ToPtr("Name"),
-					Labels: &map[string]string{"key": "Value"},
+					Name:        lo.ToPtr("Name"),
+					Labels:      &map[string]string{"key": "Value", "zone": "eu-west-1", "padded": "  padded  ", "replicas": "3"},
+					Annotations: &map[string]string{"site": "berlin", "config": `{"port": 8080}`, "empty": ""},
+					Owner:       lo.ToPtr("Fleet/myfleet"),
+				},
+				Status: &DeviceStatus{
+					SystemInfo: DeviceSystemInfo{
+						Architecture:         "amd64",
+						BootID:               "boot",
+						CustomInfo:           &CustomDeviceInfo{"rack": "r12"},
+						AdditionalProperties: map[string]string{"productSerial": "SN1234"},
+					},
 				},
 			}
 			output, err := ExecuteGoTemplateOnDevice(tmpl, dev)
@@ -1,13 +1,18 @@
 package v1alpha1
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"reflect"
 	"regexp"
 	"slices"
+	"strconv"
 	"strings"
 	"text/template"
 	"text/template/parse"
@@ -35,6 +40,7 @@ var (
 	ErrDuplicateMonitorType                  = errors.New("duplicate monitorType in resources")
 	ErrInvalidCPUMonitorField                = errors.New("invalid field for CPU monitor")
 	ErrInvalidMemoryMonitorField             = errors.New("invalid field for Memory monitor")
+	ErrInvalidProcessMonitorField            = errors.New("invalid field for Process monitor")
 )
 
 type Validator interface {
@@ -177,6 +183,20 @@ func (a HookAction) Validate(path string) []error {
 		// TODO: pull the extra validation done by the agent up here
 		allErrs = append(allErrs, validation.ValidateStringMap(runAction.EnvVars, path+".envVars", 1, 256, nil, nil, "")...)
 		allErrs = append(allErrs, validation.ValidateFileOrDirectoryPath(runAction.WorkDir, path+".workDir")...)
+	case HookActionTypeSystemd:
+		systemdAction, err := a.AsHookActionSystemd()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		allErrs = append(allErrs, systemdAction.Systemd.Validate(path+".systemd")...)
+	case HookActionTypeProbe:
+		probeAction, err := a.AsHookActionProbe()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		allErrs = append(allErrs, probeAction.Probe.Validate(path+".probe")...)
 	default:
 		// if we hit this case, it means that the type should be added to the switch statement above
 		allErrs = append(allErrs, fmt.Errorf("%s: unknown hook action type: %s", path, t))
@@ -191,6 +211,60 @@ func (a HookAction) Validate(path string) []error {
 	return allErrs
 }
 
+func (s HookActionSystemdSpec) Validate(path string) []error {
+	allErrs := validation.ValidateSystemdName(&s.Unit, path+".unit")
+	switch s.Operation {
+	case SystemdOperationStart, SystemdOperationStop, SystemdOperationRestart, SystemdOperationReload:
+	default:
+		allErrs = append(allErrs, fmt.Errorf("%s.operation: unsupported systemd operation %q", path, s.Operation))
+	}
+	return allErrs
+}
+
+func (p HookActionProbeSpec) Validate(path string) []error {
+	allErrs := []error{}
+
+	switch {
+	case p.Http != nil && p.Tcp != nil:
+		allErrs = append(allErrs, fmt.Errorf("%s: only one of http or tcp may be specified", path))
+	case p.Http != nil:
+		u, err := url.Parse(p.Http.Url)
+		if err != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.http.url: invalid url: %w", path, err))
+		} else if u.Scheme != "http" && u.Scheme != "https" {
+			allErrs = append(allErrs, fmt.Errorf("%s.http.url: scheme must be http or https: %q", path, p.Http.Url))
+		} else if u.Host == "" {
+			allErrs = append(allErrs, fmt.Errorf("%s.http.url: host must be specified: %q", path, p.Http.Url))
+		}
+		if p.Http.ExpectedStatus != nil && (*p.Http.ExpectedStatus < 100 || *p.Http.ExpectedStatus > 599) {
+			allErrs = append(allErrs, fmt.Errorf("%s.http.expectedStatus: must be a valid HTTP status code: %d", path, *p.Http.ExpectedStatus))
+		}
+	case p.Tcp != nil:
+		host, port, err := net.SplitHostPort(p.Tcp.Address)
+		if err != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.tcp.address: %w", path, err))
+		} else if _, err := strconv.ParseUint(port, 10, 16); err != nil || host == "" {
+			allErrs = append(allErrs, fmt.Errorf("%s.tcp.address: must be in the form 'host:port': %q", path, p.Tcp.Address))
+		}
+	default:
+		allErrs = append(allErrs, fmt.Errorf("%s: one of http or tcp must be specified", path))
+	}
+
+	if p.Retries != nil && *p.Retries < 0 {
+		allErrs = append(allErrs, fmt.Errorf("%s.retries: must not be negative: %d", path, *p.Retries))
+	}
+	if p.Interval != nil {
+		interval, err := time.ParseDuration(*p.Interval)
+		if err != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.interval: invalid duration: %w", path, err))
+		} else if interval <= 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.interval: must be positive: %s", path, *p.Interval))
+		}
+	}
+
+	return allErrs
+}
+
 func (c HookCondition) Validate(path string) []error {
 	allErrs := []error{}
 
@@ -257,8 +331,39 @@ func (r ResourceMonitor) Validate() []error {
 			allErrs = append(allErrs, fmt.Errorf("%w: Memory monitors cannot have a path field", ErrInvalidMemoryMonitorField))
 		}
 		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
+	case "Temperature":
+		spec, err := r.AsTemperatureResourceMonitorSpec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+		}
+		allErrs = append(allErrs, validation.ValidateString(spec.Zone, "spec.resources[].temperature.zone", 0, 256, nil, "")...)
+		if spec.MaxTemperature != nil && *spec.MaxTemperature < 1 {
+			allErrs = append(allErrs, fmt.Errorf("spec.resources[].temperature.maxTemperature must be positive: %d", *spec.MaxTemperature))
+		}
+		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
+	case "Network":
+		spec, err := r.AsNetworkResourceMonitorSpec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+		}
+		allErrs = append(allErrs, validation.ValidateString(spec.Interface, "spec.resources[].network.interface", 0, 15, nil, "")...)
+		if spec.Metric != nil && *spec.Metric != NetworkMonitorMetricThroughput && *spec.Metric != NetworkMonitorMetricErrors {
+			allErrs = append(allErrs, fmt.Errorf("spec.resources[].network.metric must be %s or %s: %s", NetworkMonitorMetricThroughput, NetworkMonitorMetricErrors, *spec.Metric))
+		}
+		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
+	case "Process":
+		spec, err := r.AsProcessResourceMonitorSpec()
+		if err != nil {
+			allErrs = append(allErrs, err)
+		}
+		if (spec.Process == nil) == (spec.Unit == nil) {
+			allErrs = append(allErrs, fmt.Errorf("%w: exactly one of process or unit must be specified", ErrInvalidProcessMonitorField))
+		}
+		allErrs = append(allErrs, validation.ValidateString(spec.Process, "spec.resources[].process.process", 1, 15, nil, "")...)
+		allErrs = append(allErrs, validation.ValidateString(spec.Unit, "spec.resources[].process.unit", 1, 256, nil, "")...)
+		allErrs = append(allErrs, validateAlertRules(spec.AlertRules, spec.SamplingInterval)...)
 	default:
-		allErrs = append(allErrs, fmt.Errorf("unknown monitor type valid types are CPU, Disk and Memory: %s", monitorType))
+		allErrs = append(allErrs, fmt.Errorf("unknown monitor type valid types are CPU, Disk, Memory, Temperature, Network and Process: %s", monitorType))
 	}
 
 	return allErrs
@@ -504,6 +609,29 @@ func (b BatchSequence) Validate() []error {
 	return errs
 }
 
+func (c ProgressiveCanary) Validate() []error {
+	var errs []error
+	if err := validatePercentage(c.InitialPercentage); err != nil {
+		errs = append(errs, fmt.Errorf("canary initial percentage: %w", err))
+	} else if c.InitialPercentage == "0%" {
+		errs = append(errs, errors.New("canary initial percentage must be greater than 0%"))
+	}
+	if c.Multiplier != nil && *c.Multiplier < 2 {
+		errs = append(errs, fmt.Errorf("canary multiplier must be at least 2, got %d", *c.Multiplier))
+	}
+	if c.Interval != nil {
+		if _, err := time.ParseDuration(*c.Interval); err != nil {
+			errs = append(errs, fmt.Errorf("canary interval: %w", err))
+		}
+	}
+	if c.SuccessThreshold != nil {
+		if err := validatePercentage(*c.SuccessThreshold); err != nil {
+			errs = append(errs, fmt.Errorf("canary success threshold: %w", err))
+		}
+	}
+	return errs
+}
+
 func (r *RolloutDeviceSelection) Validate() []error {
 	var errs []error
 	if r == nil {
@@ -516,6 +644,8 @@ func (r *RolloutDeviceSelection) Validate() []error {
 		switch v := i.(type) {
 		case BatchSequence:
 			errs = append(errs, v.Validate()...)
+		case ProgressiveCanary:
+			errs = append(errs, v.Validate()...)
 		}
 	}
 	return errs
@@ -554,6 +684,32 @@ func (r *RolloutPolicy) Validate() []error {
 	return errs
 }
 
+func (p *IntegrityPolicy) Validate() []error {
+	if p == nil {
+		return nil
+	}
+	var errs []error
+	seen := map[int]bool{}
+	for _, ref := range p.ReferenceValues {
+		if ref.Pcr < 0 || ref.Pcr > 23 {
+			errs = append(errs, fmt.Errorf("integrity policy: PCR %d is out of range [0, 23]", ref.Pcr))
+		}
+		if seen[ref.Pcr] {
+			errs = append(errs, fmt.Errorf("integrity policy: PCR %d is listed more than once", ref.Pcr))
+		}
+		seen[ref.Pcr] = true
+		if len(ref.Digests) == 0 {
+			errs = append(errs, fmt.Errorf("integrity policy: PCR %d has no digests", ref.Pcr))
+		}
+		for _, digest := range ref.Digests {
+			if decoded, err := hex.DecodeString(digest); err != nil || len(decoded) != sha256.Size {
+				errs = append(errs, fmt.Errorf("integrity policy: digest %q of PCR %d is not a hex-encoded SHA-256 value", digest, ref.Pcr))
+			}
+		}
+	}
+	return errs
+}
+
 func (r Fleet) Validate() []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateResourceName(r.Metadata.Name)...)
@@ -561,6 +717,7 @@ func (r Fleet) Validate() []error {
 	allErrs = append(allErrs, validation.ValidateAnnotations(r.Metadata.Annotations)...)
 	allErrs = append(allErrs, r.Spec.Selector.Validate()...)
 	allErrs = append(allErrs, r.Spec.RolloutPolicy.Validate()...)
+	allErrs = append(allErrs, r.Spec.IntegrityPolicy.Validate()...)
 
 	// Validate the Device spec settings
 	allErrs = append(allErrs, r.Spec.Template.Spec.Validate(true)...)
@@ -588,6 +745,16 @@ func (u DeviceUpdatePolicySpec) Validate() []error {
 			allErrs = append(allErrs, err...)
 		}
 	}
+	for _, window := range lo.FromPtr(u.MaintenanceWindows) {
+		if err := window.Validate(); err != nil {
+			allErrs = append(allErrs, err...)
+		}
+	}
+	for i, blackout := range lo.FromPtr(u.BlackoutPeriods) {
+		if !blackout.End.After(blackout.Start) {
+			allErrs = append(allErrs, fmt.Errorf("blackoutPeriods[%d]: end must be after start", i))
+		}
+	}
 
 	return allErrs
 }
@@ -679,6 +846,179 @@ func (rs *ResourceSync) ValidateUpdate(newObj *ResourceSync) []error {
 		rs.Status, newObj.Status)
 }
 
+func (es *EventSubscription) Validate() []error {
+	if es == nil {
+		return nil
+	}
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(es.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(es.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(es.Metadata.Annotations)...)
+	for i, reason := range lo.FromPtr(es.Spec.Reasons) {
+		allErrs = append(allErrs, validation.ValidateString(&reason, fmt.Sprintf("spec.reasons[%d]", i), 1, 256, nil, "")...)
+	}
+	for i, kind := range lo.FromPtr(es.Spec.InvolvedObjectKinds) {
+		allErrs = append(allErrs, validation.ValidateString(&kind, fmt.Sprintf("spec.involvedObjectKinds[%d]", i), 1, 256, nil, "")...)
+	}
+	allErrs = append(allErrs, validation.ValidateString(&es.Spec.Webhook.Url, "spec.webhook.url", 1, 2048, nil, "")...)
+	if u, err := url.Parse(es.Spec.Webhook.Url); err != nil {
+		allErrs = append(allErrs, fmt.Errorf("spec.webhook.url: invalid url: %w", err))
+	} else if u.Scheme != "http" && u.Scheme != "https" {
+		allErrs = append(allErrs, fmt.Errorf("spec.webhook.url: scheme must be http or https: %q", es.Spec.Webhook.Url))
+	} else if u.Hostname() == "" {
+		allErrs = append(allErrs, fmt.Errorf("spec.webhook.url: host must be specified: %q", es.Spec.Webhook.Url))
+	} else if host := strings.ToLower(u.Hostname()); host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		allErrs = append(allErrs, fmt.Errorf("spec.webhook.url: host must not be a loopback address: %q", u.Hostname()))
+	} else if ip := net.ParseIP(host); ip != nil && !validation.IsPublicIP(ip) {
+		allErrs = append(allErrs, fmt.Errorf("spec.webhook.url: host must not be a loopback, private or link-local address: %q", u.Hostname()))
+	}
+	if es.Spec.Webhook.Secret != nil {
+		allErrs = append(allErrs, validation.ValidateString(es.Spec.Webhook.Secret, "spec.webhook.secret", 1, 1024, nil, "")...)
+	}
+	return allErrs
+}
+
+// ValidateUpdate ensures immutable fields are unchanged for EventSubscription.
+func (es *EventSubscription) ValidateUpdate(newObj *EventSubscription) []error {
+	return validateImmutableCoreFields(es.Metadata.Name, newObj.Metadata.Name,
+		es.ApiVersion, newObj.ApiVersion,
+		es.Kind, newObj.Kind,
+		es.Status, newObj.Status)
+}
+
+// reservedAnnotationPrefixes are the prefixes of the device annotations that are managed by the service
+var reservedAnnotationPrefixes = []string{"device-controller/", "fleet-controller/", "resourcesync-controller/"}
+
+func (b *BulkOperation) Validate() []error {
+	if b == nil {
+		return nil
+	}
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(b.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(b.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(b.Metadata.Annotations)...)
+
+	if lo.FromPtr(b.Spec.Selector.LabelSelector) == "" && lo.FromPtr(b.Spec.Selector.FieldSelector) == "" {
+		allErrs = append(allErrs, errors.New("spec.selector: at least one of labelSelector and fieldSelector must be specified"))
+	}
+
+	op := b.Spec.Operation
+	switch op.Type {
+	case BulkOperationTypeAddLabels:
+		if len(lo.FromPtr(op.Labels)) == 0 {
+			allErrs = append(allErrs, errors.New("spec.operation.labels: must be specified for AddLabels"))
+		}
+		allErrs = append(allErrs, validation.ValidateLabelsWithPath(op.Labels, "spec.operation.labels")...)
+	case BulkOperationTypeRemoveLabels:
+		if len(lo.FromPtr(op.LabelKeys)) == 0 {
+			allErrs = append(allErrs, errors.New("spec.operation.labelKeys: must be specified for RemoveLabels"))
+		}
+		keys := lo.SliceToMap(lo.FromPtr(op.LabelKeys), func(key string) (string, string) { return key, "" })
+		allErrs = append(allErrs, validation.ValidateLabelsWithPath(&keys, "spec.operation.labelKeys")...)
+	case BulkOperationTypeSetAnnotations:
+		if len(lo.FromPtr(op.Annotations)) == 0 {
+			allErrs = append(allErrs, errors.New("spec.operation.annotations: must be specified for SetAnnotations"))
+		}
+		allErrs = append(allErrs, validation.ValidateAnnotationsWithPath(op.Annotations, "spec.operation.annotations")...)
+		for key := range lo.FromPtr(op.Annotations) {
+			for _, prefix := range reservedAnnotationPrefixes {
+				if strings.HasPrefix(key, prefix) {
+					allErrs = append(allErrs, fmt.Errorf("spec.operation.annotations: %q is managed by the service and cannot be set", key))
+				}
+			}
+		}
+	case BulkOperationTypeMoveToFleet:
+		allErrs = append(allErrs, validation.ValidateResourceNameReference(op.Fleet, "spec.operation.fleet")...)
+	case BulkOperationTypeDecommission:
+		if op.Decommission != nil && !lo.Contains([]DeviceDecommissionTargetType{DeviceDecommissionTargetTypeUnenroll, DeviceDecommissionTargetTypeFactoryReset}, op.Decommission.Target) {
+			allErrs = append(allErrs, fmt.Errorf("spec.operation.decommission.target: unsupported target %q", op.Decommission.Target))
+		}
+	case BulkOperationTypeResume:
+	default:
+		allErrs = append(allErrs, fmt.Errorf("spec.operation.type: unsupported operation %q", op.Type))
+	}
+	return allErrs
+}
+
+func (ep *EnrollmentPolicy) Validate() []error {
+	if ep == nil {
+		return nil
+	}
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateResourceName(ep.Metadata.Name)...)
+	allErrs = append(allErrs, validation.ValidateLabels(ep.Metadata.Labels)...)
+	allErrs = append(allErrs, validation.ValidateAnnotations(ep.Metadata.Annotations)...)
+
+	if len(ep.Spec.Rules) == 0 {
+		allErrs = append(allErrs, errors.New("spec.rules: at least one rule must be specified"))
+	}
+	ruleNames := map[string]struct{}{}
+	for i, rule := range ep.Spec.Rules {
+		path := fmt.Sprintf("spec.rules[%d]", i)
+		allErrs = append(allErrs, validation.ValidateString(&rule.Name, path+".name", 1, 63, nil, "")...)
+		if _, exists := ruleNames[rule.Name]; exists {
+			allErrs = append(allErrs, fmt.Errorf("%s.name: duplicate rule name %q", path, rule.Name))
+		}
+		ruleNames[rule.Name] = struct{}{}
+
+		switch rule.Action {
+		case EnrollmentPolicyActionApprove:
+			allErrs = append(allErrs, validation.ValidateLabelsWithPath(rule.Labels, path+".labels")...)
+			// the system info and the labels of an enrollment request are reported by the device itself
+			if !rule.Match.HasTrustedCondition() {
+				allErrs = append(allErrs, fmt.Errorf("%s.match: rules that approve must require tpmVerified true or ekCertificateIssuers", path))
+			}
+			if rule.Match != nil && rule.Match.Labels != nil {
+				allErrs = append(allErrs, fmt.Errorf("%s.match.labels: can only be specified for rules that reject, as the labels are requested by the device", path))
+			}
+		case EnrollmentPolicyActionReject:
+			if len(lo.FromPtr(rule.Labels)) > 0 {
+				allErrs = append(allErrs, fmt.Errorf("%s.labels: can only be specified for rules that approve", path))
+			}
+		default:
+			allErrs = append(allErrs, fmt.Errorf("%s.action: unsupported action %q", path, rule.Action))
+		}
+
+		if rule.Match == nil {
+			continue
+		}
+		match := rule.Match
+		if match.EkCertificateIssuers != nil && match.TpmVerified != nil && !*match.TpmVerified {
+			allErrs = append(allErrs, fmt.Errorf("%s.match.ekCertificateIssuers: only matches TPM-verified enrollment requests and cannot be combined with tpmVerified false", path))
+		}
+		for j, issuer := range lo.FromPtr(match.EkCertificateIssuers) {
+			issuerPath := fmt.Sprintf("%s.match.ekCertificateIssuers[%d]", path, j)
+			allErrs = append(allErrs, validation.ValidateString(&issuer, issuerPath, 1, 1024, nil, "")...)
+			if !strings.Contains(issuer, "=") {
+				allErrs = append(allErrs, fmt.Errorf("%s: must be a distinguished name, such as \"CN=Vendor EK CA,O=Vendor,C=DE\"", issuerPath))
+			}
+		}
+		for field := range lo.FromPtr(match.SystemInfo) {
+			allErrs = append(allErrs, validation.ValidateString(&field, path+".match.systemInfo", 1, 256, nil, "")...)
+		}
+		keys := lo.MapValues(lo.FromPtr(match.Labels), func(string, string) string { return "" })
+		allErrs = append(allErrs, validation.ValidateLabelsWithPath(&keys, path+".match.labels")...)
+	}
+	return allErrs
+}
+
+// HasTrustedCondition returns whether the match requires a property of an enrollment request that the service
+// verified, rather than one that the device reported.
+func (m *EnrollmentPolicyMatch) HasTrustedCondition() bool {
+	if m == nil {
+		return false
+	}
+	return lo.FromPtr(m.TpmVerified) || len(lo.FromPtr(m.EkCertificateIssuers)) > 0
+}
+
+// ValidateUpdate ensures immutable fields are unchanged for EnrollmentPolicy.
+func (ep *EnrollmentPolicy) ValidateUpdate(newObj *EnrollmentPolicy) []error {
+	return validateImmutableCoreFields(ep.Metadata.Name, newObj.Metadata.Name,
+		ep.ApiVersion, newObj.ApiVersion,
+		ep.Kind, newObj.Kind,
+		nil, nil)
+}
+
 func (tv TemplateVersion) Validate() []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateResourceName(tv.Metadata.Name)...)
@@ -1129,7 +1469,7 @@ func validateParametersInString(s *string, path string, fleetTemplate bool) (boo
 
 	allErrs := []error{}
 
-	t, err := template.New("t").Option("missingkey=zero").Funcs(GetGoTemplateFuncMap()).Parse(*s)
+	t, err := template.New("t").Option("missingkey=zero").Funcs(getValidationGoTemplateFuncMap()).Parse(*s)
 	if err != nil {
 		return false, validation.FormatInvalidError(*s, path, fmt.Sprintf("invalid parameter syntax: %v", err))
 	}
@@ -1145,13 +1485,21 @@ func validateParametersInString(s *string, path string, fleetTemplate bool) (boo
 		}
 	}
 
-	// When the template is executed here, any missing label/annotation keys are evaluated to empty
-	// strings, so an empty map is fine.
+	// Ensure template only references fields that are exposed to templates
+	if err := validateTemplateFieldReferences(t.Root); err != nil {
+		return false, validation.FormatInvalidError(*s, path, err.Error())
+	}
+
+	// When the template is executed here, any missing label/annotation/system info keys are
+	// evaluated to empty strings, so empty maps are fine.
 	dev := &Device{
 		Metadata: ObjectMeta{
-			Name:   lo.ToPtr("name"),
-			Labels: &map[string]string{},
+			Name:        lo.ToPtr("name"),
+			Labels:      &map[string]string{},
+			Annotations: &map[string]string{},
+			Owner:       util.SetResourceOwner(FleetKind, "fleet"),
 		},
+		Status: &DeviceStatus{},
 	}
 
 	output, err := ExecuteGoTemplateOnDevice(t, dev)
@@ -1161,6 +1509,52 @@ func validateParametersInString(s *string, path string, fleetTemplate bool) (boo
 	return output != *s, allErrs
 }
 
+// validateTemplateFieldReferences walks the parsed template and returns an error if it
+// references a device field that is not exposed by ExecuteGoTemplateOnDevice.  Templates only
+// contain text and actions, so "." always refers to the device.
+func validateTemplateFieldReferences(node parse.Node) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			if err := validateTemplateFieldReferences(child); err != nil {
+				return err
+			}
+		}
+	case *parse.ActionNode:
+		return validateTemplateFieldReferences(n.Pipe)
+	case *parse.PipeNode:
+		if n == nil {
+			return nil
+		}
+		for _, cmd := range n.Cmds {
+			if err := validateTemplateFieldReferences(cmd); err != nil {
+				return err
+			}
+		}
+	case *parse.CommandNode:
+		for _, arg := range n.Args {
+			if err := validateTemplateFieldReferences(arg); err != nil {
+				return err
+			}
+		}
+	case *parse.ChainNode:
+		return validateTemplateFieldReferences(n.Node)
+	case *parse.FieldNode:
+		if !isDeviceTemplateField(n.Ident) {
+			return fmt.Errorf("template references unsupported field %q", "."+strings.Join(n.Ident, "."))
+		}
+	case *parse.VariableNode:
+		// Only "$" refers to the device, other variables are declared within the template
+		if len(n.Ident) > 1 && n.Ident[0] == "$" && !isDeviceTemplateField(n.Ident[1:]) {
+			return fmt.Errorf("template references unsupported field %q", strings.Join(n.Ident, "."))
+		}
+	}
+	return nil
+}
+
 func ValidateConditions(conditions []Condition, allowedConditions, trueConditions, exclusiveConditions []ConditionType) []error {
 	allErrs := []error{}
 	seen := make(map[ConditionType]bool)
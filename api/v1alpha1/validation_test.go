@@ -4,6 +4,8 @@ import (
 	"encoding/base64"
 	"strings"
 	"testing"
+	"text/template"
+	"time"
 
 	"github.com/robfig/cron/v3"
 	"github.com/samber/lo"
@@ -316,6 +318,54 @@ func TestValidateScheduleAndGraceDuration(t *testing.T) {
 	}
 }
 
+func TestValidateDeviceUpdatePolicySpec(t *testing.T) {
+	start := time.Date(2026, time.December, 24, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		policy DeviceUpdatePolicySpec
+		errMsg string
+	}{
+		{
+			name: "valid maintenance windows and blackout period",
+			policy: DeviceUpdatePolicySpec{
+				MaintenanceWindows: &[]UpdateSchedule{
+					{At: "0 2 * * 1-5", StartGraceDuration: lo.ToPtr("2h")},
+					{At: "0 * * * 0,6", TimeZone: lo.ToPtr("Europe/Paris")},
+				},
+				BlackoutPeriods: &[]BlackoutPeriod{
+					{Start: start, End: start.Add(14 * 24 * time.Hour), Description: lo.ToPtr("holiday freeze")},
+				},
+			},
+		},
+		{
+			name: "invalid maintenance window",
+			policy: DeviceUpdatePolicySpec{
+				MaintenanceWindows: &[]UpdateSchedule{{At: "0 2 * *"}},
+			},
+			errMsg: "invalid cron schedule",
+		},
+		{
+			name: "blackout period ends before it starts",
+			policy: DeviceUpdatePolicySpec{
+				BlackoutPeriods: &[]BlackoutPeriod{{Start: start, End: start.Add(-time.Hour)}},
+			},
+			errMsg: "blackoutPeriods[0]: end must be after start",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			errs := tt.policy.Validate()
+			if tt.errMsg != "" {
+				require.Len(errs, 1)
+				require.ErrorContains(errs[0], tt.errMsg)
+				return
+			}
+			require.Empty(errs)
+		})
+	}
+}
+
 func TestValidateParametersInString(t *testing.T) {
 	require := require.New(t)
 	tests := []struct {
@@ -350,10 +400,52 @@ func TestValidateParametersInString(t *testing.T) {
 		},
 		{
 			name:           "accessing non-exposed field fails",
-			paramString:    "hello {{ .metadata.annotations.key }} world",
+			paramString:    "hello {{ .metadata.owner }} world",
 			containsParams: true,
 			expectError:    1,
 		},
+		{
+			name:           "accessing non-exposed system info fails",
+			paramString:    "hello {{ .status.systemInfo.bootID }} world",
+			containsParams: true,
+			expectError:    1,
+		},
+		{
+			name:           "accessing non-exposed field via root variable fails",
+			paramString:    "hello {{ $.spec.os.image }} world",
+			containsParams: true,
+			expectError:    1,
+		},
+		{
+			name:           "accessing non-exposed field within function fails",
+			paramString:    "hello {{ upper .status.conditions }} world",
+			containsParams: true,
+			expectError:    1,
+		},
+		{
+			name:           "annotation",
+			paramString:    "hello {{ .metadata.annotations.key }} world",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "fleet name",
+			paramString:    "{{ .fleet.name }}",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "system info",
+			paramString:    "{{ .status.systemInfo.architecture }}-{{ .status.systemInfo.productSerial }}-{{ .status.systemInfo.netMacDefault }}",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "custom info",
+			paramString:    "{{ index .status.systemInfo.customInfo \"key\" }}",
+			containsParams: true,
+			expectError:    0,
+		},
 		{
 			name:           "upper name",
 			paramString:    "{{ upper .metadata.name }}",
@@ -420,6 +512,36 @@ func TestValidateParametersInString(t *testing.T) {
 			containsParams: true,
 			expectError:    0,
 		},
+		{
+			name:           "extended functions",
+			paramString:    "{{ .metadata.labels.key | trim | default \"x\" | b64enc | sha256 }}-{{ hashMod 8 .metadata.name }}-{{ add .metadata.labels.count 1 }}",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "default of getOrDefault on annotations",
+			paramString:    "{{ getOrDefault .metadata.annotations \"key\" \"\" | default \"none\" }}",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "fromJson on missing value",
+			paramString:    "{{ index (fromJson .metadata.annotations.config) \"port\" }}",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "ternary with regexMatch",
+			paramString:    "{{ ternary \"a\" \"b\" (regexMatch \"^x\" .metadata.name) }}",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "invalid regex",
+			paramString:    "{{ regexReplace \"[\" \"\" .metadata.name }}",
+			containsParams: true,
+			expectError:    1,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -432,6 +554,35 @@ func TestValidateParametersInString(t *testing.T) {
 	}
 }
 
+func TestValidateTemplateFieldReferences(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    string
+		expectError bool
+	}{
+		{name: "supported field", template: "{{ .metadata.labels.key }}"},
+		{name: "unsupported field", template: "{{ .spec.os.image }}", expectError: true},
+		{name: "function argument", template: "{{ getOrDefault .metadata.annotations \"key\" \"x\" }}-{{ upper .status.conditions }}", expectError: true},
+		{name: "pipeline", template: "{{ .metadata.name | replace \"a\" .metadata.owner }}", expectError: true},
+		{name: "nested pipeline", template: "{{ lower (printf \"%s\" .status.systemInfo.bootID) }}", expectError: true},
+		{name: "root variable", template: "{{ $.metadata.name }}-{{ $.spec.os.image }}", expectError: true},
+		{name: "declared variable", template: "{{ $name := .metadata.name }}{{ $name }}"},
+		{name: "chain", template: "{{ (.spec).os }}", expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := template.New("t").Funcs(GetGoTemplateFuncMap()).Parse(tt.template)
+			require.NoError(t, err)
+			err = validateTemplateFieldReferences(tmpl.Root)
+			if tt.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidateInlineApplicationProviderSpec(t *testing.T) {
 	plain := EncodingPlain
 	base64Enc := EncodingBase64
@@ -664,6 +815,80 @@ func TestValidateAlertRules(t *testing.T) {
 	}
 }
 
+func TestValidateHookActions(t *testing.T) {
+	require := require.New(t)
+	systemdAction := func(unit string, operation HookActionSystemdOperation) HookAction {
+		var action HookAction
+		require.NoError(action.FromHookActionSystemd(HookActionSystemd{Systemd: HookActionSystemdSpec{Unit: unit, Operation: operation}}))
+		return action
+	}
+	probeAction := func(probe HookActionProbeSpec) HookAction {
+		var action HookAction
+		require.NoError(action.FromHookActionProbe(HookActionProbe{Probe: probe}))
+		return action
+	}
+
+	tests := []struct {
+		name    string
+		action  HookAction
+		wantErr bool
+	}{
+		{name: "systemd restart", action: systemdAction("myapp.service", SystemdOperationRestart)},
+		{name: "systemd template unit", action: systemdAction("getty@tty1.service", SystemdOperationStart)},
+		{name: "systemd empty unit", action: systemdAction("", SystemdOperationStart), wantErr: true},
+		{name: "systemd invalid unit", action: systemdAction("my app.service", SystemdOperationStart), wantErr: true},
+		{name: "systemd unsupported operation", action: systemdAction("myapp.service", "enable"), wantErr: true},
+		{
+			name: "http probe",
+			action: probeAction(HookActionProbeSpec{
+				Http:     &HookActionHttpProbe{Url: "http://localhost:8080/healthz", ExpectedStatus: lo.ToPtr(int32(200))},
+				Retries:  lo.ToPtr(int32(5)),
+				Interval: lo.ToPtr("2s"),
+			}),
+		},
+		{name: "tcp probe", action: probeAction(HookActionProbeSpec{Tcp: &HookActionTcpProbe{Address: "localhost:5432"}})},
+		{name: "tcp probe ipv6", action: probeAction(HookActionProbeSpec{Tcp: &HookActionTcpProbe{Address: "[::1]:5432"}})},
+		{name: "probe without target", action: probeAction(HookActionProbeSpec{}), wantErr: true},
+		{
+			name: "probe with both targets",
+			action: probeAction(HookActionProbeSpec{
+				Http: &HookActionHttpProbe{Url: "http://localhost:8080"},
+				Tcp:  &HookActionTcpProbe{Address: "localhost:8080"},
+			}),
+			wantErr: true,
+		},
+		{name: "http probe unsupported scheme", action: probeAction(HookActionProbeSpec{Http: &HookActionHttpProbe{Url: "ftp://localhost"}}), wantErr: true},
+		{name: "http probe without host", action: probeAction(HookActionProbeSpec{Http: &HookActionHttpProbe{Url: "http:///healthz"}}), wantErr: true},
+		{
+			name:    "http probe invalid status",
+			action:  probeAction(HookActionProbeSpec{Http: &HookActionHttpProbe{Url: "http://localhost", ExpectedStatus: lo.ToPtr(int32(42))}}),
+			wantErr: true,
+		},
+		{name: "tcp probe without port", action: probeAction(HookActionProbeSpec{Tcp: &HookActionTcpProbe{Address: "localhost"}}), wantErr: true},
+		{name: "tcp probe invalid port", action: probeAction(HookActionProbeSpec{Tcp: &HookActionTcpProbe{Address: "localhost:http"}}), wantErr: true},
+		{
+			name:    "probe negative retries",
+			action:  probeAction(HookActionProbeSpec{Tcp: &HookActionTcpProbe{Address: "localhost:80"}, Retries: lo.ToPtr(int32(-1))}),
+			wantErr: true,
+		},
+		{
+			name:    "probe invalid interval",
+			action:  probeAction(HookActionProbeSpec{Tcp: &HookActionTcpProbe{Address: "localhost:80"}, Interval: lo.ToPtr("soon")}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.action.Validate("spec.lifecycleHooks.afterUpdating[0]")
+			if tt.wantErr {
+				require.NotEmpty(errs)
+			} else {
+				require.Empty(errs, "expected no errors but got: %v", errs)
+			}
+		})
+	}
+}
+
 func TestValidateConfigs(t *testing.T) {
 	require := require.New(t)
 	tests := []struct {
@@ -978,6 +1203,106 @@ func TestResourceMonitorValidate_CPUPathField(t *testing.T) {
 	}
 }
 
+func TestResourceMonitorValidate_AdditionalMonitors(t *testing.T) {
+	require := require.New(t)
+	alertRules := []ResourceAlertRule{
+		{
+			Severity:    ResourceAlertSeverityTypeCritical,
+			Percentage:  90,
+			Duration:    "5m",
+			Description: "",
+		},
+	}
+	newMonitor := func(fromFn func(*ResourceMonitor) error) ResourceMonitor {
+		var monitor ResourceMonitor
+		require.NoError(fromFn(&monitor))
+		return monitor
+	}
+
+	tests := []struct {
+		name     string
+		monitor  ResourceMonitor
+		wantErrs int
+		wantErr  error
+	}{
+		{
+			name: "valid Temperature monitor",
+			monitor: newMonitor(func(m *ResourceMonitor) error {
+				return m.FromTemperatureResourceMonitorSpec(TemperatureResourceMonitorSpec{
+					MonitorType: "Temperature", SamplingInterval: "30s", AlertRules: alertRules,
+					Zone: lo.ToPtr("x86_pkg_temp"), MaxTemperature: lo.ToPtr(95),
+				})
+			}),
+		},
+		{
+			name: "invalid Temperature monitor max temperature",
+			monitor: newMonitor(func(m *ResourceMonitor) error {
+				return m.FromTemperatureResourceMonitorSpec(TemperatureResourceMonitorSpec{
+					MonitorType: "Temperature", SamplingInterval: "30s", AlertRules: alertRules, MaxTemperature: lo.ToPtr(0),
+				})
+			}),
+			wantErrs: 1,
+		},
+		{
+			name: "valid Network monitor",
+			monitor: newMonitor(func(m *ResourceMonitor) error {
+				return m.FromNetworkResourceMonitorSpec(NetworkResourceMonitorSpec{
+					MonitorType: "Network", SamplingInterval: "30s", AlertRules: alertRules,
+					Interface: lo.ToPtr("eth0"), Metric: lo.ToPtr(NetworkMonitorMetricErrors),
+				})
+			}),
+		},
+		{
+			name: "invalid Network monitor metric",
+			monitor: newMonitor(func(m *ResourceMonitor) error {
+				return m.FromNetworkResourceMonitorSpec(NetworkResourceMonitorSpec{
+					MonitorType: "Network", SamplingInterval: "30s", AlertRules: alertRules, Metric: lo.ToPtr(NetworkMonitorMetricType("Latency")),
+				})
+			}),
+			wantErrs: 1,
+		},
+		{
+			name: "valid Process monitor for systemd unit",
+			monitor: newMonitor(func(m *ResourceMonitor) error {
+				return m.FromProcessResourceMonitorSpec(ProcessResourceMonitorSpec{
+					MonitorType: "Process", SamplingInterval: "30s", AlertRules: alertRules, Unit: lo.ToPtr("podman.service"),
+				})
+			}),
+		},
+		{
+			name: "invalid Process monitor without process or unit",
+			monitor: newMonitor(func(m *ResourceMonitor) error {
+				return m.FromProcessResourceMonitorSpec(ProcessResourceMonitorSpec{
+					MonitorType: "Process", SamplingInterval: "30s", AlertRules: alertRules,
+				})
+			}),
+			wantErrs: 1,
+			wantErr:  ErrInvalidProcessMonitorField,
+		},
+		{
+			name: "invalid Process monitor with process and unit",
+			monitor: newMonitor(func(m *ResourceMonitor) error {
+				return m.FromProcessResourceMonitorSpec(ProcessResourceMonitorSpec{
+					MonitorType: "Process", SamplingInterval: "30s", AlertRules: alertRules,
+					Process: lo.ToPtr("microshift"), Unit: lo.ToPtr("microshift.service"),
+				})
+			}),
+			wantErrs: 1,
+			wantErr:  ErrInvalidProcessMonitorField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.monitor.Validate()
+			require.Len(errs, tt.wantErrs, "unexpected errors: %v", errs)
+			if tt.wantErr != nil {
+				require.ErrorIs(errs[0], tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestDeviceSpecValidate_ResourceMonitors(t *testing.T) {
 	require := require.New(t)
 	tests := []struct {
@@ -1269,3 +1594,175 @@ ExecStart=/usr/bin/myapp`,
 		})
 	}
 }
+
+func TestValidateProgressiveCanary(t *testing.T) {
+	require := require.New(t)
+	tests := []struct {
+		name    string
+		canary  ProgressiveCanary
+		wantErr bool
+	}{
+		{name: "valid", canary: ProgressiveCanary{InitialPercentage: "5%", Multiplier: lo.ToPtr(3), Interval: lo.ToPtr("1h"), SuccessThreshold: lo.ToPtr("90%")}},
+		{name: "initial percentage only", canary: ProgressiveCanary{InitialPercentage: "10%"}},
+		{name: "zero initial percentage", canary: ProgressiveCanary{InitialPercentage: "0%"}, wantErr: true},
+		{name: "malformed initial percentage", canary: ProgressiveCanary{InitialPercentage: "ten"}, wantErr: true},
+		{name: "multiplier too small", canary: ProgressiveCanary{InitialPercentage: "10%", Multiplier: lo.ToPtr(1)}, wantErr: true},
+		{name: "invalid interval", canary: ProgressiveCanary{InitialPercentage: "10%", Interval: lo.ToPtr("hourly")}, wantErr: true},
+		{name: "invalid success threshold", canary: ProgressiveCanary{InitialPercentage: "10%", SuccessThreshold: lo.ToPtr("101%")}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var selection RolloutDeviceSelection
+			require.NoError(selection.FromProgressiveCanary(tt.canary))
+			errs := selection.Validate()
+			if tt.wantErr {
+				require.NotEmpty(errs)
+			} else {
+				require.Empty(errs, "expected no errors but got: %v", errs)
+			}
+		})
+	}
+}
+
+func TestBulkOperationValidate(t *testing.T) {
+	require := require.New(t)
+	bySite := BulkOperationSelector{LabelSelector: lo.ToPtr("site=paris")}
+	tests := []struct {
+		name     string
+		selector BulkOperationSelector
+		action   BulkOperationAction
+		wantErr  bool
+	}{
+		{name: "add labels", selector: bySite, action: BulkOperationAction{Type: BulkOperationTypeAddLabels, Labels: &map[string]string{"example.com/tier": "edge"}}},
+		{name: "add labels without labels", selector: bySite, action: BulkOperationAction{Type: BulkOperationTypeAddLabels}, wantErr: true},
+		{name: "add invalid label", selector: bySite, action: BulkOperationAction{Type: BulkOperationTypeAddLabels, Labels: &map[string]string{"tier": "not a value"}}, wantErr: true},
+		{name: "remove labels", selector: bySite, action: BulkOperationAction{Type: BulkOperationTypeRemoveLabels, LabelKeys: &[]string{"tier"}}},
+		{name: "remove invalid label key", selector: bySite, action: BulkOperationAction{Type: BulkOperationTypeRemoveLabels, LabelKeys: &[]string{"not a key"}}, wantErr: true},
+		{name: "set annotations", selector: bySite, action: BulkOperationAction{Type: BulkOperationTypeSetAnnotations, Annotations: &map[string]string{"example.com/migrated": "true"}}},
+		{name: "set reserved annotation", selector: bySite, action: BulkOperationAction{Type: BulkOperationTypeSetAnnotations, Annotations: &map[string]string{DeviceAnnotationConsole: "true"}}, wantErr: true},
+		{name: "move to fleet", selector: bySite, action: BulkOperationAction{Type: BulkOperationTypeMoveToFleet, Fleet: lo.ToPtr("paris")}},
+		{name: "move to fleet without fleet", selector: bySite, action: BulkOperationAction{Type: BulkOperationTypeMoveToFleet}, wantErr: true},
+		{name: "decommission", selector: bySite, action: BulkOperationAction{Type: BulkOperationTypeDecommission}},
+		{name: "decommission with invalid target", selector: bySite, action: BulkOperationAction{Type: BulkOperationTypeDecommission, Decommission: &DeviceDecommission{Target: "Shred"}}, wantErr: true},
+		{name: "resume by field selector", selector: BulkOperationSelector{FieldSelector: lo.ToPtr("metadata.owner=Fleet/paris")}, action: BulkOperationAction{Type: BulkOperationTypeResume}},
+		{name: "no selector", selector: BulkOperationSelector{LabelSelector: lo.ToPtr("")}, action: BulkOperationAction{Type: BulkOperationTypeResume}, wantErr: true},
+		{name: "unknown operation", selector: bySite, action: BulkOperationAction{Type: "Reboot"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := BulkOperation{
+				Metadata: ObjectMeta{Name: lo.ToPtr("migrate-paris")},
+				Spec:     BulkOperationSpec{Selector: tt.selector, Operation: tt.action},
+			}
+			errs := operation.Validate()
+			if tt.wantErr {
+				require.NotEmpty(errs)
+			} else {
+				require.Empty(errs, "expected no errors but got: %v", errs)
+			}
+		})
+	}
+}
+
+func TestEnrollmentPolicyValidate(t *testing.T) {
+	require := require.New(t)
+	approve := EnrollmentPolicyRule{Name: "factory", Action: EnrollmentPolicyActionApprove, Match: &EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(true)}, Labels: &map[string]string{"site": "paris"}}
+	tests := []struct {
+		name    string
+		rules   []EnrollmentPolicyRule
+		wantErr bool
+	}{
+		{name: "approve with labels", rules: []EnrollmentPolicyRule{approve}},
+		{name: "reject", rules: []EnrollmentPolicyRule{{Name: "unverified", Action: EnrollmentPolicyActionReject, Match: &EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(false)}}}},
+		{name: "match on EK issuer and system info", rules: []EnrollmentPolicyRule{{Name: "vendor", Action: EnrollmentPolicyActionApprove, Match: &EnrollmentPolicyMatch{EkCertificateIssuers: &[]string{"CN=Vendor EK CA,O=Vendor,C=DE"}, SystemInfo: &map[string]string{"productSerial": "SN-*"}}}}},
+		{name: "reject on requested labels", rules: []EnrollmentPolicyRule{{Name: "region", Action: EnrollmentPolicyActionReject, Match: &EnrollmentPolicyMatch{Labels: &map[string]string{"region": "eu-*"}}}}},
+		{name: "no rules", rules: []EnrollmentPolicyRule{}, wantErr: true},
+		{name: "duplicate rule names", rules: []EnrollmentPolicyRule{approve, approve}, wantErr: true},
+		{name: "missing rule name", rules: []EnrollmentPolicyRule{{Action: EnrollmentPolicyActionReject}}, wantErr: true},
+		{name: "unknown action", rules: []EnrollmentPolicyRule{{Name: "hold", Action: "Hold"}}, wantErr: true},
+		{name: "reject with labels", rules: []EnrollmentPolicyRule{{Name: "reject", Action: EnrollmentPolicyActionReject, Labels: &map[string]string{"site": "paris"}}}, wantErr: true},
+		{name: "invalid label", rules: []EnrollmentPolicyRule{{Name: "factory", Action: EnrollmentPolicyActionApprove, Match: approve.Match, Labels: &map[string]string{"site": "not a value"}}}, wantErr: true},
+		{name: "approve without conditions", rules: []EnrollmentPolicyRule{{Name: "all", Action: EnrollmentPolicyActionApprove}}, wantErr: true},
+		{name: "approve on reported system info alone", rules: []EnrollmentPolicyRule{{Name: "serial", Action: EnrollmentPolicyActionApprove, Match: &EnrollmentPolicyMatch{SystemInfo: &map[string]string{"productSerial": "SN-*"}}}}, wantErr: true},
+		{name: "approve on requested labels", rules: []EnrollmentPolicyRule{{Name: "region", Action: EnrollmentPolicyActionApprove, Match: &EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(true), Labels: &map[string]string{"region": "eu-*"}}}}, wantErr: true},
+		{name: "EK issuer by common name", rules: []EnrollmentPolicyRule{{Name: "vendor", Action: EnrollmentPolicyActionApprove, Match: &EnrollmentPolicyMatch{EkCertificateIssuers: &[]string{"Vendor EK CA"}}}}, wantErr: true},
+		{name: "EK issuer of unverified TPM", rules: []EnrollmentPolicyRule{{Name: "vendor", Action: EnrollmentPolicyActionApprove, Match: &EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(false), EkCertificateIssuers: &[]string{"CN=Vendor EK CA"}}}}, wantErr: true},
+		{name: "invalid requested label key", rules: []EnrollmentPolicyRule{{Name: "region", Action: EnrollmentPolicyActionReject, Match: &EnrollmentPolicyMatch{Labels: &map[string]string{"not a key": "*"}}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := EnrollmentPolicy{
+				Metadata: ObjectMeta{Name: lo.ToPtr("factory-floor")},
+				Spec:     EnrollmentPolicySpec{Rules: tt.rules},
+			}
+			errs := policy.Validate()
+			if tt.wantErr {
+				require.NotEmpty(errs)
+			} else {
+				require.Empty(errs, "expected no errors but got: %v", errs)
+			}
+		})
+	}
+}
+
+func TestIntegrityPolicyValidate(t *testing.T) {
+	require := require.New(t)
+	digest := strings.Repeat("ab", 32)
+	tests := []struct {
+		name            string
+		referenceValues []PCRReferenceValue
+		wantErr         bool
+	}{
+		{name: "single value", referenceValues: []PCRReferenceValue{{Pcr: 7, Digests: []string{digest}}}},
+		{name: "several accepted values", referenceValues: []PCRReferenceValue{{Pcr: 4, Digests: []string{digest, strings.Repeat("CD", 32)}}, {Pcr: 7, Digests: []string{digest}}}},
+		{name: "PCR out of range", referenceValues: []PCRReferenceValue{{Pcr: 24, Digests: []string{digest}}}, wantErr: true},
+		{name: "duplicate PCR", referenceValues: []PCRReferenceValue{{Pcr: 7, Digests: []string{digest}}, {Pcr: 7, Digests: []string{digest}}}, wantErr: true},
+		{name: "no digests", referenceValues: []PCRReferenceValue{{Pcr: 7, Digests: []string{}}}, wantErr: true},
+		{name: "SHA-1 digest", referenceValues: []PCRReferenceValue{{Pcr: 7, Digests: []string{strings.Repeat("ab", 20)}}}, wantErr: true},
+		{name: "not hex", referenceValues: []PCRReferenceValue{{Pcr: 7, Digests: []string{strings.Repeat("zz", 32)}}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &IntegrityPolicy{ReferenceValues: tt.referenceValues}
+			errs := policy.Validate()
+			if tt.wantErr {
+				require.NotEmpty(errs)
+			} else {
+				require.Empty(errs, "expected no errors but got: %v", errs)
+			}
+		})
+	}
+}
+
+func TestEventSubscriptionValidateWebhookURL(t *testing.T) {
+	require := require.New(t)
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{name: "https", url: "https://hooks.example.com/flightctl"},
+		{name: "public address", url: "http://203.0.113.10:8080/events"},
+		{name: "unsupported scheme", url: "ftp://hooks.example.com/", wantErr: true},
+		{name: "no host", url: "https:///events", wantErr: true},
+		{name: "localhost", url: "http://localhost:8080/", wantErr: true},
+		{name: "loopback address", url: "http://127.0.0.1/", wantErr: true},
+		{name: "private address", url: "http://10.0.0.5/", wantErr: true},
+		{name: "link-local address", url: "http://169.254.169.254/latest/meta-data/", wantErr: true},
+		{name: "IPv6 loopback address", url: "http://[::1]:8080/", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subscription := &EventSubscription{
+				Metadata: ObjectMeta{Name: lo.ToPtr("subscription")},
+				Spec:     EventSubscriptionSpec{Webhook: EventSubscriptionWebhook{Url: tt.url}},
+			}
+			errs := subscription.Validate()
+			if tt.wantErr {
+				require.NotEmpty(errs)
+			} else {
+				require.Empty(errs, "expected no errors but got: %v", errs)
+			}
+		})
+	}
+}
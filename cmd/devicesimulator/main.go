@@ -642,7 +642,7 @@ func createSimulatorFleet(ctx context.Context, serviceClient *apiClient.ClientWi
 	}
 
 	// Create the fleet
-	createResponse, err := serviceClient.ReplaceFleetWithBodyWithResponse(ctx, fleetName, "application/json", bytes.NewReader(fleetJSON))
+	createResponse, err := serviceClient.ReplaceFleetWithBodyWithResponse(ctx, fleetName, nil, "application/json", bytes.NewReader(fleetJSON))
 	if err != nil {
 		return fmt.Errorf("creating fleet: %w", err)
 	}
@@ -24,6 +24,7 @@ import (
 	"github.com/flightctl/flightctl/internal/rendered"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/watch"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/flightctl/flightctl/pkg/queues"
 	"github.com/google/uuid"
@@ -160,6 +161,12 @@ func main() {
 	if err = rendered.Bus.Instance().Start(ctx); err != nil {
 		log.Fatalf("starting rendered version manager: %v", err)
 	}
+	if err = watch.Bus.Initialize(ctx, provider, log); err != nil {
+		log.Fatalf("creating resource watch manager: %v", err)
+	}
+	if err = watch.Bus.Instance().Start(ctx); err != nil {
+		log.Fatalf("starting resource watch manager: %v", err)
+	}
 
 	// create the agent service listener as tcp (combined HTTP+gRPC)
 	agentListener, err := net.Listen("tcp", cfg.Service.AgentEndpointAddress)
@@ -26,6 +26,7 @@ func NewFlightCtlCommand() *cobra.Command {
 	cmd.AddCommand(cli.NewCmdGet())
 	cmd.AddCommand(cli.NewCmdApply())
 	cmd.AddCommand(cli.NewCmdEdit())
+	cmd.AddCommand(cli.NewCmdDiff())
 	cmd.AddCommand(cli.NewCmdDelete())
 	cmd.AddCommand(cli.NewCmdApprove())
 	cmd.AddCommand(cli.NewCmdCSRConfig())
@@ -34,6 +35,10 @@ func NewFlightCtlCommand() *cobra.Command {
 	cmd.AddCommand(cli.NewCmdDeny())
 	cmd.AddCommand(cli.NewCmdLogin())
 	cmd.AddCommand(cli.NewCmdResume())
+	cmd.AddCommand(cli.NewCmdBulk())
+	cmd.AddCommand(cli.NewCmdRollout())
+	cmd.AddCommand(cli.NewCmdFleet())
+	cmd.AddCommand(cli.NewCmdSearch())
 	cmd.AddCommand(cli.NewCmdVersion())
 	cmd.AddCommand(cli.NewConsoleCmd())
 	cmd.AddCommand(cli.NewCmdCompletion())
@@ -10,6 +10,7 @@ import (
 	agent_config "github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device"
 	"github.com/flightctl/flightctl/internal/agent/device/applications"
+	"github.com/flightctl/flightctl/internal/agent/device/attestation"
 	"github.com/flightctl/flightctl/internal/agent/device/certmanager"
 	"github.com/flightctl/flightctl/internal/agent/device/config"
 	"github.com/flightctl/flightctl/internal/agent/device/console"
@@ -387,6 +388,19 @@ func (a *Agent) Run(ctx context.Context) error {
 	// must run independently to maintain persistent console connections
 	go consoleManager.Run(ctx)
 
+	// async to attest the boot state with TPM quotes independently of reconciliation
+	if tpmClient != nil {
+		attestationManager := attestation.NewManager(
+			deviceName,
+			bootstrap.ManagementClient(),
+			tpmClient,
+			deviceReadWriter,
+			time.Duration(a.config.TPM.AttestationInterval),
+			a.log,
+		)
+		go attestationManager.Run(ctx)
+	}
+
 	// publisher is async to poll management server for spec updates at regular intervals
 	// fetching is async, but spec management remains serial in the main agent loop
 	go specManager.Publisher().Run(ctx)
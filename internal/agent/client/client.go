@@ -55,6 +55,8 @@ type Management interface {
 	SetRPCMetricsCallback(cb RPCMetricsCallback)
 	CreateCertificateSigningRequest(ctx context.Context, csr v1alpha1.CertificateSigningRequest, rcb ...client.RequestEditorFn) (*v1alpha1.CertificateSigningRequest, int, error)
 	GetCertificateSigningRequest(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1alpha1.CertificateSigningRequest, int, error)
+	CreateDeviceAttestationChallenge(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1alpha1.DeviceAttestationChallenge, error)
+	CreateDeviceAttestation(ctx context.Context, name string, attestation v1alpha1.DeviceAttestation, rcb ...client.RequestEditorFn) (*v1alpha1.DeviceIntegrityStatus, error)
 }
 
 // Enrollment is client the interface for managing device enrollment.
@@ -183,3 +183,52 @@ func (m *management) GetCertificateSigningRequest(ctx context.Context, name stri
 
 	return nil, resp.StatusCode(), nil
 }
+
+// CreateDeviceAttestationChallenge requests a nonce from the management server which the next
+// attestation of the device must be qualified with.
+func (m *management) CreateDeviceAttestationChallenge(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1alpha1.DeviceAttestationChallenge, error) {
+	start := time.Now()
+	resp, err := m.client.CreateDeviceAttestationChallengeWithResponse(ctx, name, rcb...)
+
+	if m.rpcMetricsCallbackFunc != nil {
+		m.rpcMetricsCallbackFunc("create_device_attestation_challenge_duration", time.Since(start).Seconds(), err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	if resp.HTTPResponse != nil {
+		defer func() { _ = resp.HTTPResponse.Body.Close() }()
+	}
+
+	if resp.JSON201 == nil {
+		return nil, fmt.Errorf("create device attestation challenge failed: %s", resp.Status())
+	}
+	return resp.JSON201, nil
+}
+
+// CreateDeviceAttestation submits a PCR quote answering an attestation challenge and returns the
+// integrity status the management server derived from it.
+func (m *management) CreateDeviceAttestation(ctx context.Context, name string, attestation v1alpha1.DeviceAttestation, rcb ...client.RequestEditorFn) (*v1alpha1.DeviceIntegrityStatus, error) {
+	start := time.Now()
+	resp, err := m.client.CreateDeviceAttestationWithResponse(ctx, name, attestation, rcb...)
+
+	if m.rpcMetricsCallbackFunc != nil {
+		m.rpcMetricsCallbackFunc("create_device_attestation_duration", time.Since(start).Seconds(), err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	if resp.HTTPResponse != nil {
+		defer func() { _ = resp.HTTPResponse.Body.Close() }()
+	}
+
+	if resp.JSON400 != nil {
+		return nil, fmt.Errorf("create device attestation failed: %s", resp.JSON400.Message)
+	}
+	if resp.JSON200 == nil {
+		return nil, fmt.Errorf("create device attestation failed: %s", resp.Status())
+	}
+	return resp.JSON200, nil
+}
@@ -63,6 +63,46 @@ func (mr *MockManagementMockRecorder) CreateCertificateSigningRequest(ctx, csr a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCertificateSigningRequest", reflect.TypeOf((*MockManagement)(nil).CreateCertificateSigningRequest), varargs...)
 }
 
+// CreateDeviceAttestation mocks base method.
+func (m *MockManagement) CreateDeviceAttestation(ctx context.Context, name string, attestation v1alpha1.DeviceAttestation, rcb ...client.RequestEditorFn) (*v1alpha1.DeviceIntegrityStatus, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, attestation}
+	for _, a := range rcb {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateDeviceAttestation", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.DeviceIntegrityStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeviceAttestation indicates an expected call of CreateDeviceAttestation.
+func (mr *MockManagementMockRecorder) CreateDeviceAttestation(ctx, name, attestation any, rcb ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, attestation}, rcb...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeviceAttestation", reflect.TypeOf((*MockManagement)(nil).CreateDeviceAttestation), varargs...)
+}
+
+// CreateDeviceAttestationChallenge mocks base method.
+func (m *MockManagement) CreateDeviceAttestationChallenge(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1alpha1.DeviceAttestationChallenge, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range rcb {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateDeviceAttestationChallenge", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.DeviceAttestationChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeviceAttestationChallenge indicates an expected call of CreateDeviceAttestationChallenge.
+func (mr *MockManagementMockRecorder) CreateDeviceAttestationChallenge(ctx, name any, rcb ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, rcb...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeviceAttestationChallenge", reflect.TypeOf((*MockManagement)(nil).CreateDeviceAttestationChallenge), varargs...)
+}
+
 // GetCertificateSigningRequest mocks base method.
 func (m *MockManagement) GetCertificateSigningRequest(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1alpha1.CertificateSigningRequest, int, error) {
 	m.ctrl.T.Helper()
@@ -63,6 +63,8 @@ const (
 	DefaultTPMDevicePath = "/dev/tpm0"
 	// DefaultTPMKeyFile is the default filename for TPM key persistence
 	DefaultTPMKeyFile = "tpm-blob.yaml"
+	// DefaultTPMAttestationInterval is the default interval between two attestations of the boot state
+	DefaultTPMAttestationInterval = util.Duration(10 * time.Minute)
 	// TestRootDirEnvKey is the environment variable key used to set the file system root when testing.
 	TestRootDirEnvKey = "FLIGHTCTL_TEST_ROOT_DIR"
 	// DefaultProfilingEnabled controls whether runtime profiling (pprof) is active by default.
@@ -139,6 +141,8 @@ type TPM struct {
 	AuthEnabled bool `json:"auth-enabled,omitempty"`
 	// StorageFilePath specifies the file path for TPM key storage.
 	StorageFilePath string `json:"storage-file-path,omitempty"`
+	// AttestationInterval is the interval between two PCR quotes sent to the management service.
+	AttestationInterval util.Duration `json:"attestation-interval,omitempty"`
 }
 
 // DefaultSystemInfo defines the list of system information keys that are included
@@ -172,10 +176,11 @@ func NewDefault() *Config {
 		PullRetrySteps:       DefaultPullRetrySteps,
 		ProfilingEnabled:     DefaultProfilingEnabled,
 		TPM: TPM{
-			Enabled:         false,
-			AuthEnabled:     false,
-			DevicePath:      DefaultTPMDevicePath,
-			StorageFilePath: filepath.Join(DefaultDataDir, DefaultTPMKeyFile),
+			Enabled:             false,
+			AuthEnabled:         false,
+			DevicePath:          DefaultTPMDevicePath,
+			StorageFilePath:     filepath.Join(DefaultDataDir, DefaultTPMKeyFile),
+			AttestationInterval: DefaultTPMAttestationInterval,
 		},
 	}
 
@@ -347,6 +352,9 @@ func (cfg *Config) validateSyncIntervals() error {
 	if cfg.StatusUpdateInterval < MinSyncInterval {
 		return fmt.Errorf("minimum status update interval is %s have %s", MinSyncInterval, cfg.StatusUpdateInterval)
 	}
+	if cfg.TPM.Enabled && cfg.TPM.AttestationInterval < MinSyncInterval {
+		return fmt.Errorf("minimum TPM attestation interval is %s have %s", MinSyncInterval, cfg.TPM.AttestationInterval)
+	}
 	return nil
 }
 
@@ -402,6 +410,7 @@ func mergeConfigs(base, override *Config) {
 	overrideIfNotEmpty(&base.TPM.AuthEnabled, override.TPM.AuthEnabled)
 	overrideIfNotEmpty(&base.TPM.DevicePath, override.TPM.DevicePath)
 	overrideIfNotEmpty(&base.TPM.StorageFilePath, override.TPM.StorageFilePath)
+	overrideIfNotEmpty(&base.TPM.AttestationInterval, override.TPM.AttestationInterval)
 
 	// profiling
 	overrideIfNotEmpty(&base.ProfilingEnabled, override.ProfilingEnabled)
@@ -0,0 +1,106 @@
+package attestation
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+)
+
+// Manager periodically proves the boot state of the device to the management service by sending
+// a PCR quote of the TPM, bound to a fresh challenge, together with the measured boot event log.
+type Manager struct {
+	deviceName       string
+	managementClient client.Management
+	tpmClient        tpm.Client
+	readWriter       fileio.ReadWriter
+	interval         time.Duration
+	log              *log.PrefixLogger
+}
+
+func NewManager(
+	deviceName string,
+	managementClient client.Management,
+	tpmClient tpm.Client,
+	readWriter fileio.ReadWriter,
+	interval time.Duration,
+	log *log.PrefixLogger,
+) *Manager {
+	return &Manager{
+		deviceName:       deviceName,
+		managementClient: managementClient,
+		tpmClient:        tpmClient,
+		readWriter:       readWriter,
+		interval:         interval,
+		log:              log,
+	}
+}
+
+func (m *Manager) Run(ctx context.Context) {
+	m.log.Debug("Starting attestation manager")
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+	for {
+		// attest right away so that the boot state is verified soon after every boot
+		if err := m.Attest(ctx); err != nil {
+			m.log.Warnf("Failed to attest boot state: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			m.log.Debug("Attestation manager context done")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Attest answers a new attestation challenge of the management service with a PCR quote.
+func (m *Manager) Attest(ctx context.Context) error {
+	challenge, err := m.managementClient.CreateDeviceAttestationChallenge(ctx, m.deviceName)
+	if err != nil {
+		return fmt.Errorf("getting attestation challenge: %w", err)
+	}
+
+	quote, err := m.tpmClient.Quote(challenge.Nonce)
+	if err != nil {
+		return fmt.Errorf("quoting PCRs: %w", err)
+	}
+
+	attestation := v1alpha1.DeviceAttestation{
+		Nonce:     challenge.Nonce,
+		Quote:     quote.Quoted,
+		Signature: quote.Signature,
+		PcrValues: make([]v1alpha1.PCRValue, 0, len(quote.PCRValues)),
+	}
+	for pcr, value := range quote.PCRValues {
+		attestation.PcrValues = append(attestation.PcrValues, v1alpha1.PCRValue{
+			Pcr:    pcr,
+			Digest: hex.EncodeToString(value),
+		})
+	}
+
+	// the quote alone proves the boot state, so devices whose firmware exposes no event log
+	// still attest
+	eventLog, err := m.readWriter.ReadFile(tpm.DefaultEventLogPath)
+	if err != nil {
+		m.log.Debugf("Not sending the event log: %v", err)
+	} else {
+		attestation.EventLog = &eventLog
+	}
+
+	integrity, err := m.managementClient.CreateDeviceAttestation(ctx, m.deviceName, attestation)
+	if err != nil {
+		return fmt.Errorf("sending attestation: %w", err)
+	}
+	if integrity.Tpm != nil && integrity.Tpm.Status != v1alpha1.DeviceIntegrityCheckStatusVerified {
+		m.log.Warnf("Boot state attestation %s: %s", integrity.Tpm.Status, lo.FromPtr(integrity.Tpm.Info))
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package attestation
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAttest(t *testing.T) {
+	nonce := []byte("nonce")
+	quote := &tpm.Quote{
+		Quoted:    []byte("quoted"),
+		Signature: []byte("signature"),
+		PCRValues: [][]byte{{0x00, 0x01}, {0xab, 0xcd}},
+	}
+
+	testCases := []struct {
+		name          string
+		eventLog      []byte
+		setupMocks    func(mockManagement *client.MockManagement, mockTPM *tpm.MockClient)
+		wantErr       bool
+		wantEventLog  bool
+		wantPCRValues []v1alpha1.PCRValue
+	}{
+		{
+			name:     "quote with event log",
+			eventLog: []byte("event log"),
+			setupMocks: func(mockManagement *client.MockManagement, mockTPM *tpm.MockClient) {
+				mockManagement.EXPECT().CreateDeviceAttestationChallenge(gomock.Any(), "device").
+					Return(&v1alpha1.DeviceAttestationChallenge{Nonce: nonce, ExpiresAt: time.Now().Add(time.Minute)}, nil)
+				mockTPM.EXPECT().Quote(nonce).Return(quote, nil)
+			},
+			wantEventLog:  true,
+			wantPCRValues: []v1alpha1.PCRValue{{Pcr: 0, Digest: "0001"}, {Pcr: 1, Digest: "abcd"}},
+		},
+		{
+			name: "quote without event log",
+			setupMocks: func(mockManagement *client.MockManagement, mockTPM *tpm.MockClient) {
+				mockManagement.EXPECT().CreateDeviceAttestationChallenge(gomock.Any(), "device").
+					Return(&v1alpha1.DeviceAttestationChallenge{Nonce: nonce, ExpiresAt: time.Now().Add(time.Minute)}, nil)
+				mockTPM.EXPECT().Quote(nonce).Return(quote, nil)
+			},
+			wantPCRValues: []v1alpha1.PCRValue{{Pcr: 0, Digest: "0001"}, {Pcr: 1, Digest: "abcd"}},
+		},
+		{
+			name: "challenge fails",
+			setupMocks: func(mockManagement *client.MockManagement, mockTPM *tpm.MockClient) {
+				mockManagement.EXPECT().CreateDeviceAttestationChallenge(gomock.Any(), "device").Return(nil, errors.New("unavailable"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "quote fails",
+			setupMocks: func(mockManagement *client.MockManagement, mockTPM *tpm.MockClient) {
+				mockManagement.EXPECT().CreateDeviceAttestationChallenge(gomock.Any(), "device").
+					Return(&v1alpha1.DeviceAttestationChallenge{Nonce: nonce, ExpiresAt: time.Now().Add(time.Minute)}, nil)
+				mockTPM.EXPECT().Quote(nonce).Return(nil, errors.New("tpm error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockManagement := client.NewMockManagement(ctrl)
+			mockTPM := tpm.NewMockClient(ctrl)
+			tc.setupMocks(mockManagement, mockTPM)
+
+			rw := fileio.NewReadWriter(fileio.WithTestRootDir(t.TempDir()))
+			if tc.eventLog != nil {
+				require.NoError(rw.MkdirAll(filepath.Dir(tpm.DefaultEventLogPath), fileio.DefaultDirectoryPermissions))
+				require.NoError(rw.WriteFile(tpm.DefaultEventLogPath, tc.eventLog, fileio.DefaultFilePermissions))
+			}
+
+			if !tc.wantErr {
+				mockManagement.EXPECT().CreateDeviceAttestation(gomock.Any(), "device", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, attestation v1alpha1.DeviceAttestation, _ ...any) (*v1alpha1.DeviceIntegrityStatus, error) {
+						require.Equal(nonce, attestation.Nonce)
+						require.Equal(quote.Quoted, attestation.Quote)
+						require.Equal(quote.Signature, attestation.Signature)
+						require.Equal(tc.wantPCRValues, attestation.PcrValues)
+						if tc.wantEventLog {
+							require.NotNil(attestation.EventLog)
+							require.Equal(tc.eventLog, *attestation.EventLog)
+						} else {
+							require.Nil(attestation.EventLog)
+						}
+						return &v1alpha1.DeviceIntegrityStatus{Status: v1alpha1.DeviceIntegrityStatusVerified}, nil
+					})
+			}
+
+			manager := NewManager("device", mockManagement, mockTPM, rw, time.Minute, log.NewPrefixLogger("test"))
+			err := manager.Attest(context.Background())
+			if tc.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
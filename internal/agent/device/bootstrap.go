@@ -246,6 +246,15 @@ func (b *Bootstrap) checkRollback(ctx context.Context) error {
 		return nil
 	}
 
+	// read the specs before rolling back to pass them to the lifecycle hooks.  The hooks are skipped if the specs
+	// can't be read, as the rollback must not be blocked by them.
+	current, desired, readErr := b.readRollbackSpecs()
+	if readErr != nil {
+		b.log.Errorf("Skipping update failed and rollback hooks: %v", readErr)
+	} else if err := b.hookManager.OnUpdateFailed(ctx, current, desired); err != nil {
+		b.log.Errorf("running on update failed hook: %v", err)
+	}
+
 	b.log.Warn("Starting spec rollback")
 	// rollback and set the version to failed
 	if err := b.specManager.Rollback(ctx, spec.WithSetFailed()); err != nil {
@@ -253,6 +262,12 @@ func (b *Bootstrap) checkRollback(ctx context.Context) error {
 	}
 	b.log.Info("Spec rollback complete, resuming bootstrap")
 
+	if readErr == nil {
+		if err := b.hookManager.OnRollback(ctx, desired, current); err != nil {
+			b.log.Errorf("running on rollback hook: %v", err)
+		}
+	}
+
 	updateErr = b.statusManager.UpdateCondition(ctx, v1alpha1.Condition{
 		Type:    v1alpha1.ConditionTypeDeviceUpdating,
 		Status:  v1alpha1.ConditionStatusTrue,
@@ -266,6 +281,19 @@ func (b *Bootstrap) checkRollback(ctx context.Context) error {
 	return nil
 }
 
+// readRollbackSpecs reads the current and desired specs that the lifecycle hooks of a rollback are called with
+func (b *Bootstrap) readRollbackSpecs() (*v1alpha1.Device, *v1alpha1.Device, error) {
+	current, err := b.specManager.Read(spec.Current)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading current spec: %w", err)
+	}
+	desired, err := b.specManager.Read(spec.Desired)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading desired spec: %w", err)
+	}
+	return current, desired, nil
+}
+
 func (b *Bootstrap) setManagementClient() error {
 	var err error
 	b.managementClient, err = b.identityProvider.CreateManagementClient(b.managementServiceConfig, b.managementMetricsCallback)
@@ -200,21 +200,23 @@ func TestBootstrapCheckRollback(t *testing.T) {
 	mockErr := errors.New("mock error")
 	bootedOS := "1.0.0"
 	desiredOS := "2.0.0"
+	current := newVersionedDevice("1")
+	desired := newVersionedDevice("2")
 
 	testCases := []struct {
 		name          string
-		setupMocks    func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager)
+		setupMocks    func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager, mockHookManager *hook.MockManager)
 		expectedError error
 	}{
 		{
 			name: "happy path",
-			setupMocks: func(_ *status.MockManager, mockSpecManager *spec.MockManager) {
+			setupMocks: func(_ *status.MockManager, mockSpecManager *spec.MockManager, _ *hook.MockManager) {
 				mockSpecManager.EXPECT().CheckOsReconciliation(gomock.Any()).Return(bootedOS, true, nil)
 			},
 		},
 		{
 			name: "successfully handles no rollback",
-			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager) {
+			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager, mockHookManager *hook.MockManager) {
 				gomock.InOrder(
 					mockSpecManager.EXPECT().CheckOsReconciliation(gomock.Any()).Return(bootedOS, false, nil),
 					mockSpecManager.EXPECT().OSVersion(spec.Desired).Return(desiredOS),
@@ -225,12 +227,31 @@ func TestBootstrapCheckRollback(t *testing.T) {
 		},
 		{
 			name: "successfully handles rollback",
-			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager) {
+			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager, mockHookManager *hook.MockManager) {
 				gomock.InOrder(
 					mockSpecManager.EXPECT().CheckOsReconciliation(gomock.Any()).Return(bootedOS, false, nil),
 					mockSpecManager.EXPECT().OSVersion(spec.Desired).Return(desiredOS),
 					mockStatusManager.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, nil),
 					mockSpecManager.EXPECT().IsRollingBack(gomock.Any()).Return(true, nil),
+					mockSpecManager.EXPECT().Read(spec.Current).Return(current, nil),
+					mockSpecManager.EXPECT().Read(spec.Desired).Return(desired, nil),
+					mockHookManager.EXPECT().OnUpdateFailed(context.TODO(), current, desired).Return(nil),
+					mockSpecManager.EXPECT().Rollback(context.TODO(), gomock.Any()).Return(nil),
+					mockHookManager.EXPECT().OnRollback(context.TODO(), desired, current).Return(nil),
+					mockSpecManager.EXPECT().RenderedVersion(spec.Desired).Return("2"),
+					mockStatusManager.EXPECT().UpdateCondition(gomock.Any(), gomock.Any()).Return(nil),
+				)
+			},
+		},
+		{
+			name: "rolls back without hooks when the specs can't be read",
+			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager, mockHookManager *hook.MockManager) {
+				gomock.InOrder(
+					mockSpecManager.EXPECT().CheckOsReconciliation(gomock.Any()).Return(bootedOS, false, nil),
+					mockSpecManager.EXPECT().OSVersion(spec.Desired).Return(desiredOS),
+					mockStatusManager.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, nil),
+					mockSpecManager.EXPECT().IsRollingBack(gomock.Any()).Return(true, nil),
+					mockSpecManager.EXPECT().Read(spec.Current).Return(nil, mockErr),
 					mockSpecManager.EXPECT().Rollback(context.TODO(), gomock.Any()).Return(nil),
 					mockSpecManager.EXPECT().RenderedVersion(spec.Desired).Return("2"),
 					mockStatusManager.EXPECT().UpdateCondition(gomock.Any(), gomock.Any()).Return(nil),
@@ -239,7 +260,7 @@ func TestBootstrapCheckRollback(t *testing.T) {
 		},
 		{
 			name: "error checking rollback status",
-			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager) {
+			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager, mockHookManager *hook.MockManager) {
 				gomock.InOrder(
 					mockSpecManager.EXPECT().CheckOsReconciliation(gomock.Any()).Return(bootedOS, false, nil),
 					mockSpecManager.EXPECT().OSVersion(spec.Desired).Return(desiredOS),
@@ -251,12 +272,15 @@ func TestBootstrapCheckRollback(t *testing.T) {
 		},
 		{
 			name: "error during rollback",
-			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager) {
+			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager, mockHookManager *hook.MockManager) {
 				gomock.InOrder(
 					mockSpecManager.EXPECT().CheckOsReconciliation(gomock.Any()).Return(bootedOS, false, nil),
 					mockSpecManager.EXPECT().OSVersion(spec.Desired).Return(desiredOS),
 					mockStatusManager.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, nil),
 					mockSpecManager.EXPECT().IsRollingBack(gomock.Any()).Return(true, nil),
+					mockSpecManager.EXPECT().Read(spec.Current).Return(current, nil),
+					mockSpecManager.EXPECT().Read(spec.Desired).Return(desired, nil),
+					mockHookManager.EXPECT().OnUpdateFailed(context.TODO(), current, desired).Return(nil),
 					mockSpecManager.EXPECT().Rollback(context.TODO(), gomock.Any()).Return(mockErr),
 				)
 			},
@@ -264,7 +288,7 @@ func TestBootstrapCheckRollback(t *testing.T) {
 		},
 		{
 			name: "error updating status",
-			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager) {
+			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager, mockHookManager *hook.MockManager) {
 				gomock.InOrder(
 					mockSpecManager.EXPECT().CheckOsReconciliation(gomock.Any()).Return(bootedOS, false, nil),
 					mockSpecManager.EXPECT().OSVersion(spec.Desired).Return(desiredOS),
@@ -281,15 +305,17 @@ func TestBootstrapCheckRollback(t *testing.T) {
 
 			mockStatusManager := status.NewMockManager(ctrl)
 			mockSpecManager := spec.NewMockManager(ctrl)
+			mockHookManager := hook.NewMockManager(ctrl)
 
 			b := &Bootstrap{
 				statusManager: mockStatusManager,
 				specManager:   mockSpecManager,
+				hookManager:   mockHookManager,
 				log:           log.NewPrefixLogger("test"),
 			}
 
 			ctx := context.TODO()
-			tt.setupMocks(mockStatusManager, mockSpecManager)
+			tt.setupMocks(mockStatusManager, mockSpecManager, mockHookManager)
 
 			err := b.checkRollback(ctx)
 			if tt.expectedError != nil {
@@ -307,19 +333,19 @@ func TestEnsureBootedOS(t *testing.T) {
 
 	testCases := []struct {
 		name          string
-		setupMocks    func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager)
+		setupMocks    func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager, mockHookManager *hook.MockManager)
 		expectedError error
 	}{
 		{
 			name: "happy path - no OS update in progress",
-			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager) {
+			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager, mockHookManager *hook.MockManager) {
 				mockSpecManager.EXPECT().IsOSUpdate().Return(false)
 			},
 			expectedError: nil,
 		},
 		{
 			name: "OS image reconciliation failure",
-			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager) {
+			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager, mockHookManager *hook.MockManager) {
 				mockSpecManager.EXPECT().IsOSUpdate().Return(true)
 				mockSpecManager.EXPECT().CheckOsReconciliation(gomock.Any()).Return("", false, specErr)
 			},
@@ -327,12 +353,15 @@ func TestEnsureBootedOS(t *testing.T) {
 		},
 		{
 			name: "OS image not reconciled triggers rollback",
-			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager) {
+			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager, mockHookManager *hook.MockManager) {
 				mockSpecManager.EXPECT().OSVersion(gomock.Any()).Return("desired-image")
 				mockSpecManager.EXPECT().IsOSUpdate().Return(true)
 				mockSpecManager.EXPECT().CheckOsReconciliation(gomock.Any()).Return("unexpected-booted-image", false, nil)
 				mockSpecManager.EXPECT().IsRollingBack(gomock.Any()).Return(true, nil)
+				mockSpecManager.EXPECT().Read(gomock.Any()).Return(newVersionedDevice("1"), nil).Times(2)
 				mockSpecManager.EXPECT().Rollback(gomock.Any(), gomock.Any()).Return(nil)
+				mockHookManager.EXPECT().OnUpdateFailed(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				mockHookManager.EXPECT().OnRollback(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				mockStatusManager.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil, nil)
 				mockSpecManager.EXPECT().RenderedVersion(spec.Desired).Return("2")
 				mockStatusManager.EXPECT().UpdateCondition(gomock.Any(), gomock.Any()).Return(nil)
@@ -341,7 +370,7 @@ func TestEnsureBootedOS(t *testing.T) {
 		},
 		{
 			name: "OS image reconciled",
-			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager) {
+			setupMocks: func(mockStatusManager *status.MockManager, mockSpecManager *spec.MockManager, mockHookManager *hook.MockManager) {
 				mockSpecManager.EXPECT().IsOSUpdate().Return(true)
 				mockSpecManager.EXPECT().CheckOsReconciliation(gomock.Any()).Return("desired-image", true, nil)
 			},
@@ -360,14 +389,16 @@ func TestEnsureBootedOS(t *testing.T) {
 			log := log.NewPrefixLogger("test")
 			mockStatusManager := status.NewMockManager(ctrl)
 			mockSpecManager := spec.NewMockManager(ctrl)
+			mockHookManager := hook.NewMockManager(ctrl)
 
 			b := &Bootstrap{
 				statusManager: mockStatusManager,
 				specManager:   mockSpecManager,
+				hookManager:   mockHookManager,
 				log:           log,
 			}
 
-			tt.setupMocks(mockStatusManager, mockSpecManager)
+			tt.setupMocks(mockStatusManager, mockSpecManager, mockHookManager)
 
 			err := b.ensureBootedOS(ctx)
 			if tt.expectedError != nil {
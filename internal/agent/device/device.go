@@ -128,7 +128,7 @@ func (a *Agent) sync(ctx context.Context, current, desired *v1alpha1.Device) err
 		return fmt.Errorf("sync device: %w", err)
 	}
 
-	if err := a.afterUpdate(ctx, current.Spec, desired.Spec); err != nil {
+	if err := a.afterUpdate(ctx, current, desired); err != nil {
 		return fmt.Errorf("after update: %w", err)
 	}
 
@@ -183,6 +183,9 @@ func (a *Agent) syncDeviceSpec(ctx context.Context) {
 			if err := a.specManager.SetUpgradeFailed(desired.Version()); err != nil {
 				a.log.Errorf("Failed to set upgrade failed: %v", err)
 			}
+			if err := a.hookManager.OnUpdateFailed(ctx, current, desired); err != nil {
+				a.log.Errorf("Error executing OnUpdateFailed hook: %v", err)
+			}
 		}
 
 		// handle prefetch not ready
@@ -192,7 +195,8 @@ func (a *Agent) syncDeviceSpec(ctx context.Context) {
 		}
 
 		// Policy may defer update; in all cases, warn and roll back to the previous renderedVersion.
-		if errors.Is(syncErr, errors.ErrUpdatePolicyNotReady) || errors.Is(syncErr, errors.ErrDownloadPolicyNotReady) {
+		deferred := errors.Is(syncErr, errors.ErrUpdatePolicyNotReady) || errors.Is(syncErr, errors.ErrDownloadPolicyNotReady)
+		if deferred {
 			a.log.Warnf("Requeuing version %s: %s", current.Version(), syncErr.Error())
 		} else {
 			a.log.Warnf("Attempting to rollback to previous renderedVersion: %s", current.Version())
@@ -200,6 +204,13 @@ func (a *Agent) syncDeviceSpec(ctx context.Context) {
 
 		if err := a.rollbackDevice(ctx, current, desired, a.sync); err != nil {
 			a.log.Errorf("Rollback did not complete cleanly: %v", err)
+		} else if !deferred && !errors.IsRetryable(syncErr) {
+			// deferred updates and retryable errors are requeued rather than
+			// rolled back from the user's perspective, so only notify when the
+			// version has failed.
+			if err := a.hookManager.OnRollback(ctx, desired, current); err != nil {
+				a.log.Errorf("Error executing OnRollback hook: %v", err)
+			}
 		}
 
 		a.handleSyncError(ctx, desired, syncErr)
@@ -334,7 +345,7 @@ func (a *Agent) beforeUpdate(ctx context.Context, current, desired *v1alpha1.Dev
 		return fmt.Errorf("applications: %w", err)
 	}
 
-	if err := a.hookManager.OnBeforeUpdating(ctx, current.Spec, desired.Spec); err != nil {
+	if err := a.hookManager.OnBeforeUpdating(ctx, current, desired); err != nil {
 		return fmt.Errorf("hooks: %w", err)
 	}
 
@@ -416,12 +427,12 @@ func (a *Agent) systemdControllerSync(_ context.Context, desired *v1alpha1.Devic
 	return nil
 }
 
-func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1alpha1.DeviceSpec) error {
+func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1alpha1.Device) error {
 	a.log.Debug("Executing after update actions")
 	defer a.log.Debug("Finished executing after update actions")
 
 	// execute after update for lifecycle
-	if err := a.lifecycleManager.AfterUpdate(ctx, current, desired); err != nil {
+	if err := a.lifecycleManager.AfterUpdate(ctx, current.Spec, desired.Spec); err != nil {
 		a.log.Errorf("Error executing lifecycle: %v", err)
 		return err
 	}
@@ -436,7 +447,7 @@ func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1alpha1.Devi
 	// after the os is updated.This happens because the os update requires a
 	// reboot so the lower blocks are not executed until after reboot.
 	if !isOSReconciled && a.specManager.IsOSUpdate() {
-		if err = a.afterUpdateOS(ctx, desired); err != nil {
+		if err = a.afterUpdateOS(ctx, desired.Spec); err != nil {
 			a.log.Errorf("Error executing OS: %v", err)
 			return err
 		}
@@ -451,12 +462,24 @@ func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1alpha1.Devi
 		return err
 	}
 
+	// execute before application start hooks
+	if err := a.hookManager.OnBeforeApplicationStart(ctx, current, desired); err != nil {
+		a.log.Errorf("Error executing BeforeApplicationStart hook: %v", err)
+		return err
+	}
+
 	// execute after update for applications
 	if err := a.appManager.AfterUpdate(ctx); err != nil {
 		a.log.Errorf("Error executing actions: %v", err)
 		return err
 	}
 
+	// execute after application start hooks
+	if err := a.hookManager.OnAfterApplicationStart(ctx, current, desired); err != nil {
+		a.log.Errorf("Error executing AfterApplicationStart hook: %v", err)
+		return err
+	}
+
 	return nil
 }
 
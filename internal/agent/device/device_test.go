@@ -3,6 +3,7 @@ package device
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"path/filepath"
 	"testing"
 	"time"
@@ -96,7 +97,7 @@ func TestSync(t *testing.T) {
 					mockSpecManager.EXPECT().IsOSUpdate().Return(false),
 					mockPrefetchManager.EXPECT().BeforeUpdate(ctx, current.Spec, desired.Spec).Return(nil),
 					mockAppManager.EXPECT().BeforeUpdate(ctx, desired.Spec).Return(nil),
-					mockHookManager.EXPECT().OnBeforeUpdating(ctx, current.Spec, desired.Spec).Return(nil),
+					mockHookManager.EXPECT().OnBeforeUpdating(ctx, current, desired).Return(nil),
 					mockSpecManager.EXPECT().CheckPolicy(ctx, policy.Update, desired.Version()).Return(nil),
 					mockSpecManager.EXPECT().IsUpgrading().Return(true),
 					mockManagementClient.EXPECT().UpdateDeviceStatus(ctx, deviceName, gomock.Any()).Return(nil),
@@ -108,12 +109,13 @@ func TestSync(t *testing.T) {
 					mockLifecycleManager.EXPECT().Sync(ctx, current.Spec, desired.Spec).Return(nil),
 					mockLifecycleManager.EXPECT().AfterUpdate(ctx, current.Spec, desired.Spec).Return(nil),
 					mockSpecManager.EXPECT().CheckOsReconciliation(ctx).Return("", true, nil),
-					mockHookManager.EXPECT().OnAfterUpdating(ctx, current.Spec, desired.Spec, false).Return(nonRetryableHookError),
+					mockHookManager.EXPECT().OnAfterUpdating(ctx, current, desired, false).Return(nonRetryableHookError),
 					//
 					// rollback switch current and desired spec ordering
 					//
 					mockSpecManager.EXPECT().IsUpgrading().Return(true),
 					mockSpecManager.EXPECT().SetUpgradeFailed(desired.Version()).Return(nil),
+					mockHookManager.EXPECT().OnUpdateFailed(ctx, current, desired).Return(nil),
 					mockManagementClient.EXPECT().UpdateDeviceStatus(ctx, deviceName, gomock.Any()).Return(nil),
 					mockSpecManager.EXPECT().Rollback(ctx).Return(nil),
 					mockSpecManager.EXPECT().IsUpgrading().Return(false),
@@ -123,8 +125,11 @@ func TestSync(t *testing.T) {
 					mockLifecycleManager.EXPECT().Sync(ctx, desired.Spec, current.Spec).Return(nil),
 					mockLifecycleManager.EXPECT().AfterUpdate(ctx, desired.Spec, current.Spec).Return(nil),
 					mockSpecManager.EXPECT().CheckOsReconciliation(ctx).Return("", true, nil),
-					mockHookManager.EXPECT().OnAfterUpdating(ctx, desired.Spec, current.Spec, false).Return(nil),
+					mockHookManager.EXPECT().OnAfterUpdating(ctx, desired, current, false).Return(nil),
+					mockHookManager.EXPECT().OnBeforeApplicationStart(ctx, desired, current).Return(nil),
 					mockAppManager.EXPECT().AfterUpdate(ctx).Return(nil),
+					mockHookManager.EXPECT().OnAfterApplicationStart(ctx, desired, current).Return(nil),
+					mockHookManager.EXPECT().OnRollback(ctx, desired, current).Return(nil),
 					mockPrefetchManager.EXPECT().Cleanup(),
 					mockManagementClient.EXPECT().UpdateDeviceStatus(ctx, deviceName, gomock.Any()).Return(nil),
 					//
@@ -138,7 +143,7 @@ func TestSync(t *testing.T) {
 					mockSpecManager.EXPECT().IsOSUpdate().Return(false),
 					mockPrefetchManager.EXPECT().BeforeUpdate(ctx, current.Spec, current.Spec).Return(nil),
 					mockAppManager.EXPECT().BeforeUpdate(ctx, current.Spec).Return(nil),
-					mockHookManager.EXPECT().OnBeforeUpdating(ctx, current.Spec, current.Spec).Return(nil),
+					mockHookManager.EXPECT().OnBeforeUpdating(ctx, current, desired).Return(nil),
 					mockSpecManager.EXPECT().CheckPolicy(ctx, policy.Update, desired.Version()).Return(nil),
 					mockSpecManager.EXPECT().IsUpgrading().Return(false),
 					mockSpecManager.EXPECT().IsUpgrading().Return(false),
@@ -148,8 +153,104 @@ func TestSync(t *testing.T) {
 					mockLifecycleManager.EXPECT().Sync(ctx, current.Spec, current.Spec).Return(nil),
 					mockLifecycleManager.EXPECT().AfterUpdate(ctx, current.Spec, current.Spec).Return(nil),
 					mockSpecManager.EXPECT().CheckOsReconciliation(ctx).Return("", true, nil),
-					mockHookManager.EXPECT().OnAfterUpdating(ctx, current.Spec, current.Spec, false).Return(nil),
+					mockHookManager.EXPECT().OnAfterUpdating(ctx, current, desired, false).Return(nil),
+					mockHookManager.EXPECT().OnBeforeApplicationStart(ctx, current, desired).Return(nil),
 					mockAppManager.EXPECT().AfterUpdate(ctx).Return(nil),
+					mockHookManager.EXPECT().OnAfterApplicationStart(ctx, current, desired).Return(nil),
+					mockSpecManager.EXPECT().IsUpgrading().Return(false),
+					mockPrefetchManager.EXPECT().Cleanup(),
+				)
+			},
+		},
+		{
+			name:    "retryable sync error rolls back without rollback hooks",
+			current: newVersionedDevice("0"),
+			desired: newVersionedDevice("1"),
+			setupMocks: func(
+				current *v1alpha1.Device,
+				desired *v1alpha1.Device,
+				mockOSClient *os.MockClient,
+				mockManagementClient *client.MockManagement,
+				mockSystemInfoManager *systeminfo.MockManager,
+				mockExec *executer.MockExecuter,
+				mockRouterService *console.MockRouterServiceClient,
+				mockResourceManager *resource.MockManager,
+				mockSystemdManager *systemd.MockManager,
+				mockHookManager *hook.MockManager,
+				mockAppManager *applications.MockManager,
+				mockLifecycleManager *lifecycle.MockManager,
+				mockPolicyManager *policy.MockManager,
+				mockSpecManager *spec.MockManager,
+				mockPrefetchManager *dependency.MockPrefetchManager,
+				mockOSManager *os.MockManager,
+			) {
+				retryableHookError := fmt.Errorf("%w: hook error", errors.ErrRetryable)
+				gomock.InOrder(
+					mockSpecManager.EXPECT().GetDesired(ctx).Return(desired, false, nil),
+					mockSpecManager.EXPECT().Read(spec.Current).Return(current, nil),
+					mockSpecManager.EXPECT().CheckPolicy(ctx, policy.Download, desired.Version()).Return(nil),
+					mockSpecManager.EXPECT().IsUpgrading().Return(true),
+					mockManagementClient.EXPECT().UpdateDeviceStatus(ctx, deviceName, gomock.Any()).Return(nil),
+					mockPrefetchManager.EXPECT().RegisterOCICollector(gomock.Any()),
+					mockSpecManager.EXPECT().IsOSUpdate().Return(false),
+					mockPrefetchManager.EXPECT().BeforeUpdate(ctx, current.Spec, desired.Spec).Return(nil),
+					mockAppManager.EXPECT().BeforeUpdate(ctx, desired.Spec).Return(nil),
+					mockHookManager.EXPECT().OnBeforeUpdating(ctx, current, desired).Return(nil),
+					mockSpecManager.EXPECT().CheckPolicy(ctx, policy.Update, desired.Version()).Return(nil),
+					mockSpecManager.EXPECT().IsUpgrading().Return(true),
+					mockManagementClient.EXPECT().UpdateDeviceStatus(ctx, deviceName, gomock.Any()).Return(nil),
+					mockSpecManager.EXPECT().IsUpgrading().Return(true),
+					mockManagementClient.EXPECT().UpdateDeviceStatus(ctx, deviceName, gomock.Any()).Return(nil),
+					mockHookManager.EXPECT().Sync(current.Spec, desired.Spec).Return(nil),
+					mockResourceManager.EXPECT().ResetAlertDefaults().Return(nil),
+					mockSystemdManager.EXPECT().EnsurePatterns(gomock.Any()).Return(nil),
+					mockLifecycleManager.EXPECT().Sync(ctx, current.Spec, desired.Spec).Return(nil),
+					mockLifecycleManager.EXPECT().AfterUpdate(ctx, current.Spec, desired.Spec).Return(nil),
+					mockSpecManager.EXPECT().CheckOsReconciliation(ctx).Return("", true, nil),
+					mockHookManager.EXPECT().OnAfterUpdating(ctx, current, desired, false).Return(retryableHookError),
+					//
+					// rollback switch current and desired spec ordering
+					//
+					mockSpecManager.EXPECT().IsUpgrading().Return(true),
+					mockManagementClient.EXPECT().UpdateDeviceStatus(ctx, deviceName, gomock.Any()).Return(nil),
+					mockSpecManager.EXPECT().Rollback(ctx).Return(nil),
+					mockSpecManager.EXPECT().IsUpgrading().Return(false),
+					mockHookManager.EXPECT().Sync(desired.Spec, current.Spec).Return(nil),
+					mockResourceManager.EXPECT().ResetAlertDefaults().Return(nil),
+					mockSystemdManager.EXPECT().EnsurePatterns(gomock.Any()).Return(nil),
+					mockLifecycleManager.EXPECT().Sync(ctx, desired.Spec, current.Spec).Return(nil),
+					mockLifecycleManager.EXPECT().AfterUpdate(ctx, desired.Spec, current.Spec).Return(nil),
+					mockSpecManager.EXPECT().CheckOsReconciliation(ctx).Return("", true, nil),
+					mockHookManager.EXPECT().OnAfterUpdating(ctx, desired, current, false).Return(nil),
+					mockHookManager.EXPECT().OnBeforeApplicationStart(ctx, desired, current).Return(nil),
+					mockAppManager.EXPECT().AfterUpdate(ctx).Return(nil),
+					mockHookManager.EXPECT().OnAfterApplicationStart(ctx, desired, current).Return(nil),
+					mockManagementClient.EXPECT().UpdateDeviceStatus(ctx, deviceName, gomock.Any()).Return(nil),
+					//
+					// resync steady state current 0 desired 0
+					//
+					mockSpecManager.EXPECT().GetDesired(ctx).Return(desired, false, nil),
+					mockSpecManager.EXPECT().Read(spec.Current).Return(current, nil),
+					mockSpecManager.EXPECT().CheckPolicy(ctx, policy.Download, desired.Version()).Return(nil),
+					mockSpecManager.EXPECT().IsUpgrading().Return(false),
+					mockPrefetchManager.EXPECT().RegisterOCICollector(gomock.Any()),
+					mockSpecManager.EXPECT().IsOSUpdate().Return(false),
+					mockPrefetchManager.EXPECT().BeforeUpdate(ctx, current.Spec, current.Spec).Return(nil),
+					mockAppManager.EXPECT().BeforeUpdate(ctx, current.Spec).Return(nil),
+					mockHookManager.EXPECT().OnBeforeUpdating(ctx, current, desired).Return(nil),
+					mockSpecManager.EXPECT().CheckPolicy(ctx, policy.Update, desired.Version()).Return(nil),
+					mockSpecManager.EXPECT().IsUpgrading().Return(false),
+					mockSpecManager.EXPECT().IsUpgrading().Return(false),
+					mockHookManager.EXPECT().Sync(current.Spec, current.Spec).Return(nil),
+					mockResourceManager.EXPECT().ResetAlertDefaults().Return(nil),
+					mockSystemdManager.EXPECT().EnsurePatterns(gomock.Any()).Return(nil),
+					mockLifecycleManager.EXPECT().Sync(ctx, current.Spec, current.Spec).Return(nil),
+					mockLifecycleManager.EXPECT().AfterUpdate(ctx, current.Spec, current.Spec).Return(nil),
+					mockSpecManager.EXPECT().CheckOsReconciliation(ctx).Return("", true, nil),
+					mockHookManager.EXPECT().OnAfterUpdating(ctx, current, desired, false).Return(nil),
+					mockHookManager.EXPECT().OnBeforeApplicationStart(ctx, current, desired).Return(nil),
+					mockAppManager.EXPECT().AfterUpdate(ctx).Return(nil),
+					mockHookManager.EXPECT().OnAfterApplicationStart(ctx, current, desired).Return(nil),
 					mockSpecManager.EXPECT().IsUpgrading().Return(false),
 					mockPrefetchManager.EXPECT().Cleanup(),
 				)
@@ -62,6 +62,8 @@ var (
 	ErrTokenNotSupported              = errors.New("invalid token: not supported")
 	ErrActionTypeNotFound             = errors.New("failed to find action type")
 	ErrRunActionInvalid               = errors.New("invalid run action")
+	ErrSystemdActionInvalid           = errors.New("invalid systemd action")
+	ErrProbeFailed                    = errors.New("probe failed")
 	ErrUnsupportedFilesystemOperation = errors.New("unsupported filesystem operation")
 
 	// networking
@@ -101,6 +103,10 @@ var (
 func IsRetryable(err error) bool {
 	var dnsErr *net.DNSError
 	switch {
+	case errors.Is(err, ErrProbeFailed), errors.Is(err, ErrSystemdActionInvalid):
+		// a probe has already exhausted its own retries, possibly with a timeout,
+		// and an invalid action can't succeed, so the update fails
+		return false
 	case errors.As(err, &dnsErr):
 		// see https://pkg.go.dev/net#DNSError
 		return dnsErr.Temporary()
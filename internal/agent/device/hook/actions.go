@@ -3,6 +3,10 @@ package hook
 import (
 	"context"
 	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
 	"os"
 	"os/exec"
 	"reflect"
@@ -10,17 +14,23 @@ import (
 	"time"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/config"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 )
 
 type CommandLineVarKey string
 
 const (
 	DefaultHookActionTimeout = 10 * time.Second
+	// DefaultProbeRetries is the number of retries of a probe action if not specified
+	DefaultProbeRetries int32 = 3
+	// DefaultProbeInterval is the time between probe attempts if not specified
+	DefaultProbeInterval = time.Second
 
 	// PathKey defines the name of the variable that contains the path operated on
 	PathKey CommandLineVarKey = "Path"
@@ -48,27 +58,39 @@ type actionContext struct {
 	createdFiles    map[string]api.FileSpec
 	updatedFiles    map[string]api.FileSpec
 	removedFiles    map[string]api.FileSpec
+	previousOsImage string
+	desiredOsImage  string
+	addedApps       []string
+	removedApps     []string
+	labels          map[string]string
 	commandLineVars map[CommandLineVarKey]string
 }
 
-func newActionContext(hook api.DeviceLifecycleHookType, current *api.DeviceSpec, desired *api.DeviceSpec, systemRebooted bool) *actionContext {
+func newActionContext(hook api.DeviceLifecycleHookType, current *api.Device, desired *api.Device, systemRebooted bool) *actionContext {
 	actionContext := &actionContext{
 		hook:            hook,
 		systemRebooted:  systemRebooted,
 		createdFiles:    make(map[string]api.FileSpec),
 		updatedFiles:    make(map[string]api.FileSpec),
 		removedFiles:    make(map[string]api.FileSpec),
+		labels:          make(map[string]string),
 		commandLineVars: make(map[CommandLineVarKey]string),
 	}
 	resetCommandLineVars(actionContext)
 	if current != nil || desired != nil {
-		defaultIfNil := func(spec *api.DeviceSpec) *api.DeviceSpec {
-			if spec == nil {
+		specOrDefault := func(device *api.Device) *api.DeviceSpec {
+			if device == nil || device.Spec == nil {
 				return &api.DeviceSpec{}
 			}
-			return spec
+			return device.Spec
 		}
-		computeFileDiff(actionContext, defaultIfNil(current), defaultIfNil(desired))
+		currentSpec, desiredSpec := specOrDefault(current), specOrDefault(desired)
+		computeFileDiff(actionContext, currentSpec, desiredSpec)
+		computeOsImages(actionContext, currentSpec, desiredSpec)
+		computeAppDiff(actionContext, currentSpec, desiredSpec)
+	}
+	if desired != nil && desired.Metadata.Labels != nil {
+		maps.Copy(actionContext.labels, *desired.Metadata.Labels)
 	}
 	return actionContext
 }
@@ -107,6 +129,39 @@ func computeFileDiff(actionCtx *actionContext, current *api.DeviceSpec, desired
 	}
 }
 
+func computeOsImages(actionCtx *actionContext, current *api.DeviceSpec, desired *api.DeviceSpec) {
+	if current.Os != nil {
+		actionCtx.previousOsImage = current.Os.Image
+	}
+	if desired.Os != nil {
+		actionCtx.desiredOsImage = desired.Os.Image
+	}
+}
+
+func computeAppDiff(actionCtx *actionContext, current *api.DeviceSpec, desired *api.DeviceSpec) {
+	currentApps := appNames(current)
+	desiredApps := appNames(desired)
+	actionCtx.addedApps, actionCtx.removedApps = lo.Difference(desiredApps, currentApps)
+}
+
+// appNames returns the names of the spec's applications, falling back to the
+// image reference for image applications without a name as the agent does.
+func appNames(spec *api.DeviceSpec) []string {
+	names := []string{}
+	for _, app := range lo.FromPtr(spec.Applications) {
+		name := lo.FromPtr(app.Name)
+		if name == "" {
+			if imageSpec, err := app.AsImageApplicationProviderSpec(); err == nil {
+				name = imageSpec.Image
+			}
+		}
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 func executeAction(ctx context.Context, exec executer.Executer, log *log.PrefixLogger, action api.HookAction, actionCtx *actionContext, actionTimeout time.Duration) error {
 	actionType, err := action.Type()
 	if err != nil {
@@ -123,6 +178,18 @@ func executeAction(ctx context.Context, exec executer.Executer, log *log.PrefixL
 			return err
 		}
 		return executeRunAction(ctx, exec, log, runAction, actionCtx)
+	case api.HookActionTypeSystemd:
+		systemdAction, err := action.AsHookActionSystemd()
+		if err != nil {
+			return err
+		}
+		return executeSystemdAction(ctx, exec, log, systemdAction.Systemd, actionCtx)
+	case api.HookActionTypeProbe:
+		probeAction, err := action.AsHookActionProbe()
+		if err != nil {
+			return err
+		}
+		return executeProbeAction(ctx, log, probeAction.Probe, actionCtx)
 	default:
 		return fmt.Errorf("unknown hook action type %q", actionType)
 	}
@@ -164,6 +231,119 @@ func executeRunAction(ctx context.Context, exec executer.Executer, log *log.Pref
 	return nil
 }
 
+func executeSystemdAction(ctx context.Context, exec executer.Executer, log *log.PrefixLogger,
+	action api.HookActionSystemdSpec, actionCtx *actionContext) error {
+	systemd := client.NewSystemd(exec)
+
+	var err error
+	switch action.Operation {
+	case api.SystemdOperationStart:
+		err = systemd.Start(ctx, action.Unit)
+	case api.SystemdOperationStop:
+		err = systemd.Stop(ctx, action.Unit)
+	case api.SystemdOperationRestart:
+		err = systemd.Restart(ctx, action.Unit)
+	case api.SystemdOperationReload:
+		err = systemd.Reload(ctx, action.Unit)
+	default:
+		return fmt.Errorf("%w: unsupported operation %q", errors.ErrSystemdActionInvalid, action.Operation)
+	}
+	if err != nil {
+		log.Errorf("Running systemd %s on %q failed: %v", action.Operation, action.Unit, err)
+		return err
+	}
+	log.Infof("Hook %s executed systemd %s on %q without error", actionCtx.hook, action.Operation, action.Unit)
+
+	return nil
+}
+
+// executeProbeAction probes the configured endpoint until it succeeds, the
+// retries are exhausted, or the action's timeout expires.
+func executeProbeAction(ctx context.Context, log *log.PrefixLogger, action api.HookActionProbeSpec, actionCtx *actionContext) error {
+	retries := int(lo.FromPtrOr(action.Retries, DefaultProbeRetries))
+	interval := DefaultProbeInterval
+	if action.Interval != nil {
+		var err error
+		if interval, err = time.ParseDuration(*action.Interval); err != nil {
+			return fmt.Errorf("%w: invalid interval: %w", errors.ErrProbeFailed, err)
+		}
+	}
+
+	var probe func(context.Context) error
+	var target string
+	switch {
+	case action.Http != nil:
+		target = action.Http.Url
+		probe = func(ctx context.Context) error { return probeHTTP(ctx, action.Http) }
+	case action.Tcp != nil:
+		target = action.Tcp.Address
+		probe = func(ctx context.Context) error { return probeTCP(ctx, action.Tcp) }
+	default:
+		return fmt.Errorf("%w: one of http or tcp must be specified", errors.ErrProbeFailed)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("%w: %s after %d attempt(s): %w", errors.ErrProbeFailed, target, attempt, lastErr)
+			case <-time.After(interval):
+			}
+		}
+		if lastErr = probe(ctx); lastErr == nil {
+			log.Infof("Hook %s probed %q successfully", actionCtx.hook, target)
+			return nil
+		}
+		log.Debugf("Probing %q failed (attempt %d of %d): %v", target, attempt+1, retries+1, lastErr)
+	}
+
+	return fmt.Errorf("%w: %s after %d attempt(s): %w", errors.ErrProbeFailed, target, retries+1, lastErr)
+}
+
+// probeClient is the client of HTTP probes.  Probes check local endpoints, so the proxy of the
+// environment is not used, and redirects are not followed so that the status of the probed
+// endpoint itself is checked.
+var probeClient = &http.Client{
+	Transport: &http.Transport{Proxy: nil},
+	CheckRedirect: func(req *http.Request, via []*http.Request) error {
+		return http.ErrUseLastResponse
+	},
+}
+
+func probeHTTP(ctx context.Context, probe *api.HookActionHttpProbe) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, probe.Url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := probeClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if probe.ExpectedStatus != nil {
+		if resp.StatusCode != int(*probe.ExpectedStatus) {
+			return fmt.Errorf("unexpected status code %d, expected %d", resp.StatusCode, *probe.ExpectedStatus)
+		}
+		return nil
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func probeTCP(ctx context.Context, probe *api.HookActionTcpProbe) error {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", probe.Address)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
 func dirExists(path string) (bool, error) {
 	info, err := os.Stat(path)
 	if err == nil {
@@ -328,6 +508,8 @@ func checkActionDependency(action api.HookAction) error {
 			return err
 		}
 		return checkRunActionDependency(runAction)
+	case api.HookActionTypeSystemd, api.HookActionTypeProbe:
+		return nil
 	default:
 		return fmt.Errorf("unknown hook action type %q", actionType)
 	}
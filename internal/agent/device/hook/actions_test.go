@@ -1,6 +1,10 @@
 package hook
 
 import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
 	"testing"
@@ -8,7 +12,11 @@ import (
 	"github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 func TestSplitCommandAndArgs(t *testing.T) {
@@ -276,3 +284,132 @@ func BenchmarkReplaceTokensOptimized(b *testing.B) {
 		replaceTokens(testString, testTokens)
 	}
 }
+
+func TestExecuteSystemdAction(t *testing.T) {
+	tests := []struct {
+		name      string
+		operation v1alpha1.HookActionSystemdOperation
+		exitCode  int
+		wantErr   error
+	}{
+		{name: "start", operation: v1alpha1.SystemdOperationStart},
+		{name: "stop", operation: v1alpha1.SystemdOperationStop},
+		{name: "restart", operation: v1alpha1.SystemdOperationRestart},
+		{name: "reload", operation: v1alpha1.SystemdOperationReload},
+		{name: "systemctl failure", operation: v1alpha1.SystemdOperationRestart, exitCode: 1},
+		{name: "unsupported operation", operation: "enable", wantErr: errors.ErrSystemdActionInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			mockExecuter := executer.NewMockExecuter(ctrl)
+			if tt.wantErr == nil {
+				mockExecuter.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/bin/systemctl", string(tt.operation), "myapp.service").
+					Return("", "failed", tt.exitCode)
+			}
+
+			action := v1alpha1.HookActionSystemdSpec{Unit: "myapp.service", Operation: tt.operation}
+			actionCtx := newActionContext(v1alpha1.DeviceLifecycleHookAfterUpdating, nil, nil, false)
+			err := executeSystemdAction(context.Background(), mockExecuter, log.NewPrefixLogger("test"), action, actionCtx)
+			switch {
+			case tt.wantErr != nil:
+				require.ErrorIs(err, tt.wantErr)
+				require.False(errors.IsRetryable(err))
+			case tt.exitCode != 0:
+				require.Error(err)
+			default:
+				require.NoError(err)
+			}
+		})
+	}
+}
+
+func TestExecuteProbeAction(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/unavailable" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.URL.Path == "/redirect" {
+			http.Redirect(w, r, "/healthz", http.StatusFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	closedAddress := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	tests := []struct {
+		name    string
+		probe   v1alpha1.HookActionProbeSpec
+		wantErr bool
+	}{
+		{
+			name:  "http success",
+			probe: v1alpha1.HookActionProbeSpec{Http: &v1alpha1.HookActionHttpProbe{Url: server.URL + "/healthz"}},
+		},
+		{
+			name: "http expected status",
+			probe: v1alpha1.HookActionProbeSpec{
+				Http: &v1alpha1.HookActionHttpProbe{Url: server.URL + "/unavailable", ExpectedStatus: lo.ToPtr(int32(http.StatusServiceUnavailable))},
+			},
+		},
+		{
+			name: "http unexpected status",
+			probe: v1alpha1.HookActionProbeSpec{
+				Http:     &v1alpha1.HookActionHttpProbe{Url: server.URL + "/unavailable"},
+				Retries:  lo.ToPtr(int32(1)),
+				Interval: lo.ToPtr("10ms"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "http redirect is not followed",
+			probe: v1alpha1.HookActionProbeSpec{
+				Http:     &v1alpha1.HookActionHttpProbe{Url: server.URL + "/redirect"},
+				Retries:  lo.ToPtr(int32(0)),
+				Interval: lo.ToPtr("10ms"),
+			},
+			wantErr: true,
+		},
+		{
+			name: "http redirect with expected status",
+			probe: v1alpha1.HookActionProbeSpec{
+				Http: &v1alpha1.HookActionHttpProbe{Url: server.URL + "/redirect", ExpectedStatus: lo.ToPtr(int32(http.StatusFound))},
+			},
+		},
+		{
+			name:  "tcp success",
+			probe: v1alpha1.HookActionProbeSpec{Tcp: &v1alpha1.HookActionTcpProbe{Address: server.Listener.Addr().String()}},
+		},
+		{
+			name: "tcp connection refused",
+			probe: v1alpha1.HookActionProbeSpec{
+				Tcp:      &v1alpha1.HookActionTcpProbe{Address: closedAddress},
+				Retries:  lo.ToPtr(int32(0)),
+				Interval: lo.ToPtr("10ms"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			actionCtx := newActionContext(v1alpha1.DeviceLifecycleHookAfterUpdating, nil, nil, false)
+			err := executeProbeAction(context.Background(), log.NewPrefixLogger("test"), tt.probe, actionCtx)
+			if tt.wantErr {
+				require.ErrorIs(err, errors.ErrProbeFailed)
+				require.False(errors.IsRetryable(err), "a failed probe fails the update")
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
@@ -2,9 +2,7 @@ package hook
 
 import (
 	"fmt"
-	"regexp"
 	"slices"
-	"strconv"
 	"strings"
 
 	"github.com/flightctl/flightctl/api/v1alpha1"
@@ -26,7 +24,7 @@ func checkCondition(cond *v1alpha1.HookCondition, actionContext *actionContext)
 		if err != nil {
 			return false, err
 		}
-		return checkExpressionCondition(expression, actionContext), nil
+		return checkExpressionCondition(expression, actionContext)
 	case v1alpha1.HookConditionTypePathOp:
 		pathOp, err := (*cond).AsHookConditionPathOp()
 		if err != nil {
@@ -38,41 +36,12 @@ func checkCondition(cond *v1alpha1.HookCondition, actionContext *actionContext)
 	}
 }
 
-const (
-	hookExpressionConditionFmt string = `(\w+)\s*(==)\s*(\w+)`
-)
-
-var hookExpressionConditionRegex = regexp.MustCompile(hookExpressionConditionFmt)
-
-func checkExpressionCondition(cond v1alpha1.HookConditionExpression, actionCtx *actionContext) bool {
-	match := hookExpressionConditionRegex.FindStringSubmatch(cond)
-	if match == nil {
-		return false
-	}
-	lhs, op, rhs := match[1], match[2], match[3]
-	switch lhs {
-	case "rebooted":
-		b, err := strconv.ParseBool(rhs)
-		if err != nil {
-			return false
-		}
-		return checkOp(actionCtx.systemRebooted, op, b)
-	default:
-		return false
-	}
-}
-
-func checkOp[T comparable](lhs T, op string, rhs T) bool {
-	switch op {
-	case "==":
-		return checkEquals(lhs, rhs)
-	default:
-		return false
+func checkExpressionCondition(cond v1alpha1.HookConditionExpression, actionCtx *actionContext) (bool, error) {
+	expression, err := ParseExpression(cond)
+	if err != nil {
+		return false, fmt.Errorf("invalid expression %q: %w", cond, err)
 	}
-}
-
-func checkEquals[T comparable](a, b T) bool {
-	return a == b
+	return expression.Evaluate(expressionVariables(actionCtx)), nil
 }
 
 func checkPathOpCondition(cond v1alpha1.HookConditionPathOp, actionCtx *actionContext) bool {
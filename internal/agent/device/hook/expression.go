@@ -0,0 +1,480 @@
+package hook
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Expression conditions are boolean expressions over the variables of an
+// action context, for example:
+//
+//	rebooted == true
+//	previousOsImage != desiredOsImage && !("/etc/app/app.conf" in removedFiles)
+//	labels["site"] == "berlin" || "myapp" in addedApps
+//
+// The grammar is:
+//
+//	expr       := and ( "||" and )*
+//	and        := unary ( "&&" unary )*
+//	unary      := "!" unary | comparison
+//	comparison := operand [ ( "==" | "!=" | "in" ) operand ]
+//	operand    := "(" expr ")" | string | "true" | "false" | variable
+//	variable   := ident ( "." ident | "[" string "]" )*
+//
+// Expressions are type-checked when parsed, so that invalid expressions are
+// rejected when hooks are loaded rather than when they are executed.
+
+type exprType int
+
+const (
+	exprTypeBool exprType = iota
+	exprTypeString
+	exprTypeList
+	exprTypeMap
+)
+
+func (t exprType) String() string {
+	switch t {
+	case exprTypeBool:
+		return "bool"
+	case exprTypeString:
+		return "string"
+	case exprTypeList:
+		return "list"
+	case exprTypeMap:
+		return "map"
+	default:
+		return "unknown"
+	}
+}
+
+// Names of the variables that can be referenced in expression conditions.
+const (
+	// RebootedVar is true if the system rebooted during the update
+	RebootedVar = "rebooted"
+	// PreviousOsImageVar is the OS image of the current spec
+	PreviousOsImageVar = "previousOsImage"
+	// DesiredOsImageVar is the OS image of the desired spec
+	DesiredOsImageVar = "desiredOsImage"
+	// ChangedFilesVar is the list of files created, updated, or removed during the update
+	ChangedFilesVar = "changedFiles"
+	// CreatedFilesVar is the list of files created during the update
+	CreatedFilesVar = "createdFiles"
+	// UpdatedFilesVar is the list of files updated during the update
+	UpdatedFilesVar = "updatedFiles"
+	// RemovedFilesVar is the list of files removed during the update
+	RemovedFilesVar = "removedFiles"
+	// AddedAppsVar is the list of names of applications added during the update
+	AddedAppsVar = "addedApps"
+	// RemovedAppsVar is the list of names of applications removed during the update
+	RemovedAppsVar = "removedApps"
+	// LabelsVar is the map of the device's labels
+	LabelsVar = "labels"
+)
+
+var expressionVariableTypes = map[string]exprType{
+	RebootedVar:        exprTypeBool,
+	PreviousOsImageVar: exprTypeString,
+	DesiredOsImageVar:  exprTypeString,
+	ChangedFilesVar:    exprTypeList,
+	CreatedFilesVar:    exprTypeList,
+	UpdatedFilesVar:    exprTypeList,
+	RemovedFilesVar:    exprTypeList,
+	AddedAppsVar:       exprTypeList,
+	RemovedAppsVar:     exprTypeList,
+	LabelsVar:          exprTypeMap,
+}
+
+// expressionVariables returns the values of the expression variables for the given action context.
+func expressionVariables(actionCtx *actionContext) map[string]any {
+	changedFiles := append(append(append([]string{},
+		sortedKeys(actionCtx.createdFiles)...),
+		sortedKeys(actionCtx.updatedFiles)...),
+		sortedKeys(actionCtx.removedFiles)...)
+	return map[string]any{
+		RebootedVar:        actionCtx.systemRebooted,
+		PreviousOsImageVar: actionCtx.previousOsImage,
+		DesiredOsImageVar:  actionCtx.desiredOsImage,
+		ChangedFilesVar:    changedFiles,
+		CreatedFilesVar:    sortedKeys(actionCtx.createdFiles),
+		UpdatedFilesVar:    sortedKeys(actionCtx.updatedFiles),
+		RemovedFilesVar:    sortedKeys(actionCtx.removedFiles),
+		AddedAppsVar:       actionCtx.addedApps,
+		RemovedAppsVar:     actionCtx.removedApps,
+		LabelsVar:          actionCtx.labels,
+	}
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+type exprNode interface {
+	// typeOf returns the type the node evaluates to
+	typeOf() exprType
+	// eval evaluates the node using the given variables
+	eval(vars map[string]any) any
+}
+
+type literalNode struct {
+	t     exprType
+	value any
+}
+
+func (n *literalNode) typeOf() exprType {
+	return n.t
+}
+
+func (n *literalNode) eval(_ map[string]any) any {
+	return n.value
+}
+
+type variableNode struct {
+	name string
+	t    exprType
+}
+
+func (n *variableNode) typeOf() exprType {
+	return n.t
+}
+
+func (n *variableNode) eval(vars map[string]any) any {
+	v := vars[n.name]
+	// normalize nil values so that evaluation never has to deal with them
+	switch n.t {
+	case exprTypeList:
+		if list, _ := v.([]string); list == nil {
+			return []string{}
+		}
+	case exprTypeMap:
+		if m, _ := v.(map[string]string); m == nil {
+			return map[string]string{}
+		}
+	}
+	return v
+}
+
+type mapIndexNode struct {
+	m   exprNode
+	key string
+}
+
+func (n *mapIndexNode) typeOf() exprType {
+	return exprTypeString
+}
+
+// eval returns the value of the key, or an empty string if the key does not exist
+func (n *mapIndexNode) eval(vars map[string]any) any {
+	return n.m.eval(vars).(map[string]string)[n.key]
+}
+
+type notNode struct {
+	operand exprNode
+}
+
+func (n *notNode) typeOf() exprType {
+	return exprTypeBool
+}
+
+func (n *notNode) eval(vars map[string]any) any {
+	return !n.operand.eval(vars).(bool)
+}
+
+type binaryNode struct {
+	op  string
+	lhs exprNode
+	rhs exprNode
+}
+
+func (n *binaryNode) typeOf() exprType {
+	return exprTypeBool
+}
+
+func (n *binaryNode) eval(vars map[string]any) any {
+	switch n.op {
+	case "&&":
+		return n.lhs.eval(vars).(bool) && n.rhs.eval(vars).(bool)
+	case "||":
+		return n.lhs.eval(vars).(bool) || n.rhs.eval(vars).(bool)
+	case "==":
+		return n.lhs.eval(vars) == n.rhs.eval(vars)
+	case "!=":
+		return n.lhs.eval(vars) != n.rhs.eval(vars)
+	case "in":
+		lhs := n.lhs.eval(vars).(string)
+		switch rhs := n.rhs.eval(vars).(type) {
+		case []string:
+			return slices.Contains(rhs, lhs)
+		case map[string]string:
+			_, ok := rhs[lhs]
+			return ok
+		}
+	}
+	return false
+}
+
+// Expression is a parsed and type-checked expression condition.
+type Expression struct {
+	root exprNode
+}
+
+// ParseExpression parses the given expression condition and checks that it
+// evaluates to a boolean.
+func ParseExpression(s string) (*Expression, error) {
+	tokens, err := tokenizeExpression(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q at position %d", p.tokens[p.pos].text, p.tokens[p.pos].pos)
+	}
+	if root.typeOf() != exprTypeBool {
+		return nil, fmt.Errorf("expression must evaluate to a bool, not a %s", root.typeOf())
+	}
+	return &Expression{root: root}, nil
+}
+
+// Evaluate evaluates the expression using the given variables.
+func (e *Expression) Evaluate(vars map[string]any) bool {
+	return e.root.eval(vars).(bool)
+}
+
+type exprTokenKind int
+
+const (
+	tokenIdent exprTokenKind = iota
+	tokenString
+	tokenOperator
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	text string
+	pos  int
+}
+
+var exprOperators = []string{"==", "!=", "&&", "||", "!", "(", ")", "[", "]", "."}
+
+func tokenizeExpression(s string) ([]exprToken, error) {
+	tokens := []exprToken{}
+	i := 0
+	for i < len(s) {
+		r := rune(s[i])
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"' || r == '\'':
+			start := i
+			var sb strings.Builder
+			i++
+			for i < len(s) && rune(s[i]) != r {
+				if s[i] == '\\' && i+1 < len(s) {
+					i++
+				}
+				sb.WriteByte(s[i])
+				i++
+			}
+			if i >= len(s) {
+				return nil, fmt.Errorf("unterminated string starting at position %d", start)
+			}
+			i++
+			tokens = append(tokens, exprToken{kind: tokenString, text: sb.String(), pos: start})
+		case r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			start := i
+			for i < len(s) && (s[i] == '_' || unicode.IsLetter(rune(s[i])) || unicode.IsDigit(rune(s[i]))) {
+				i++
+			}
+			tokens = append(tokens, exprToken{kind: tokenIdent, text: s[start:i], pos: start})
+		default:
+			matched := false
+			for _, op := range exprOperators {
+				if strings.HasPrefix(s[i:], op) {
+					tokens = append(tokens, exprToken{kind: tokenOperator, text: op, pos: i})
+					i += len(op)
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at position %d", r, i)
+			}
+		}
+	}
+	return tokens, nil
+}
+
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+}
+
+func (p *exprParser) peek() *exprToken {
+	if p.pos < len(p.tokens) {
+		return &p.tokens[p.pos]
+	}
+	return nil
+}
+
+func (p *exprParser) acceptOperator(op string) bool {
+	if t := p.peek(); t != nil && t.kind == tokenOperator && t.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	return p.parseBinaryBool("||", p.parseAnd)
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	return p.parseBinaryBool("&&", p.parseUnary)
+}
+
+func (p *exprParser) parseBinaryBool(op string, parseOperand func() (exprNode, error)) (exprNode, error) {
+	lhs, err := parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	for p.acceptOperator(op) {
+		rhs, err := parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		if lhs.typeOf() != exprTypeBool || rhs.typeOf() != exprTypeBool {
+			return nil, fmt.Errorf("operator %q requires bool operands, got %s and %s", op, lhs.typeOf(), rhs.typeOf())
+		}
+		lhs = &binaryNode{op: op, lhs: lhs, rhs: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.acceptOperator("!") {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		if operand.typeOf() != exprTypeBool {
+			return nil, fmt.Errorf("operator \"!\" requires a bool operand, got %s", operand.typeOf())
+		}
+		return &notNode{operand: operand}, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *exprParser) parseComparison() (exprNode, error) {
+	lhs, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	var op string
+	switch t := p.peek(); {
+	case t == nil:
+		return lhs, nil
+	case t.kind == tokenOperator && (t.text == "==" || t.text == "!="):
+		op = t.text
+	case t.kind == tokenIdent && t.text == "in":
+		op = t.text
+	default:
+		return lhs, nil
+	}
+	p.pos++
+
+	rhs, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	switch op {
+	case "in":
+		if lhs.typeOf() != exprTypeString || (rhs.typeOf() != exprTypeList && rhs.typeOf() != exprTypeMap) {
+			return nil, fmt.Errorf("operator \"in\" requires a string and a list or map, got %s and %s", lhs.typeOf(), rhs.typeOf())
+		}
+	default:
+		if lhs.typeOf() != rhs.typeOf() || (lhs.typeOf() != exprTypeBool && lhs.typeOf() != exprTypeString) {
+			return nil, fmt.Errorf("operator %q requires two bools or two strings, got %s and %s", op, lhs.typeOf(), rhs.typeOf())
+		}
+	}
+	return &binaryNode{op: op, lhs: lhs, rhs: rhs}, nil
+}
+
+func (p *exprParser) parseOperand() (exprNode, error) {
+	t := p.peek()
+	if t == nil {
+		return nil, fmt.Errorf("unexpected end of expression")
+	}
+	p.pos++
+
+	switch t.kind {
+	case tokenString:
+		return &literalNode{t: exprTypeString, value: t.text}, nil
+	case tokenOperator:
+		if t.text != "(" {
+			return nil, fmt.Errorf("unexpected %q at position %d", t.text, t.pos)
+		}
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.acceptOperator(")") {
+			return nil, fmt.Errorf("missing \")\" for \"(\" at position %d", t.pos)
+		}
+		return node, nil
+	}
+
+	switch strings.ToLower(t.text) {
+	case "true":
+		return &literalNode{t: exprTypeBool, value: true}, nil
+	case "false":
+		return &literalNode{t: exprTypeBool, value: false}, nil
+	}
+
+	varType, ok := expressionVariableTypes[t.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown variable %q at position %d", t.text, t.pos)
+	}
+	var node exprNode = &variableNode{name: t.text, t: varType}
+
+	for {
+		var key string
+		switch {
+		case p.acceptOperator("."):
+			next := p.peek()
+			if next == nil || next.kind != tokenIdent {
+				return nil, fmt.Errorf("expected key after \".\" at position %d", t.pos)
+			}
+			key = next.text
+			p.pos++
+		case p.acceptOperator("["):
+			next := p.peek()
+			if next == nil || next.kind != tokenString {
+				return nil, fmt.Errorf("expected string key after \"[\" at position %d", t.pos)
+			}
+			key = next.text
+			p.pos++
+			if !p.acceptOperator("]") {
+				return nil, fmt.Errorf("missing \"]\" for %q at position %d", t.text, t.pos)
+			}
+		default:
+			return node, nil
+		}
+		if node.typeOf() != exprTypeMap {
+			return nil, fmt.Errorf("cannot access key %q of %s %q", key, node.typeOf(), t.text)
+		}
+		node = &mapIndexNode{m: node, key: key}
+	}
+}
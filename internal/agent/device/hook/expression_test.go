@@ -0,0 +1,77 @@
+package hook
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpression(t *testing.T) {
+	vars := map[string]any{
+		RebootedVar:        true,
+		PreviousOsImageVar: "quay.io/org/os:v1",
+		DesiredOsImageVar:  "quay.io/org/os:v2",
+		ChangedFilesVar:    []string{"/etc/app/app.conf", "/etc/other.conf"},
+		CreatedFilesVar:    []string{"/etc/app/app.conf"},
+		UpdatedFilesVar:    []string{"/etc/other.conf"},
+		RemovedFilesVar:    []string{},
+		AddedAppsVar:       []string{"myapp"},
+		RemovedAppsVar:     nil,
+		LabelsVar:          map[string]string{"site": "berlin", "example.com/tier": "edge"},
+	}
+
+	testCases := []struct {
+		name       string
+		expression string
+		expected   bool
+		wantErr    bool
+	}{
+		{name: "bool equals", expression: "rebooted == true", expected: true},
+		{name: "bool equals without spaces", expression: "rebooted==false", expected: false},
+		{name: "bool literal is case insensitive", expression: "rebooted == True", expected: true},
+		{name: "bare bool variable", expression: "rebooted", expected: true},
+		{name: "bool not equals", expression: "rebooted != true", expected: false},
+		{name: "string not equals", expression: "previousOsImage != desiredOsImage", expected: true},
+		{name: "string equals literal", expression: `desiredOsImage == "quay.io/org/os:v2"`, expected: true},
+		{name: "single quoted string", expression: `desiredOsImage == 'quay.io/org/os:v1'`, expected: false},
+		{name: "in list", expression: `"/etc/app/app.conf" in changedFiles`, expected: true},
+		{name: "not in list", expression: `!("/etc/app/app.conf" in removedFiles)`, expected: true},
+		{name: "in nil list", expression: `"myapp" in removedApps`, expected: false},
+		{name: "in map keys", expression: `"site" in labels`, expected: true},
+		{name: "label by dot", expression: `labels.site == "berlin"`, expected: true},
+		{name: "label by index", expression: `labels["example.com/tier"] == "edge"`, expected: true},
+		{name: "missing label is empty", expression: `labels.missing == ""`, expected: true},
+		{name: "and", expression: `rebooted && "myapp" in addedApps`, expected: true},
+		{name: "or", expression: `labels.site == "madrid" || labels.site == "berlin"`, expected: true},
+		{name: "and binds tighter than or", expression: `true || false && false`, expected: true},
+		{name: "parentheses", expression: `(true || false) && false`, expected: false},
+		{name: "double negation", expression: `!!rebooted`, expected: true},
+		{name: "escaped quote", expression: `labels.site != "ber\"lin"`, expected: true},
+		{name: "unknown variable", expression: "unknown == true", wantErr: true},
+		{name: "type mismatch", expression: `rebooted == "true"`, wantErr: true},
+		{name: "comparing lists", expression: `changedFiles == createdFiles`, wantErr: true},
+		{name: "not a bool", expression: `desiredOsImage`, wantErr: true},
+		{name: "and with non bool", expression: `rebooted && desiredOsImage`, wantErr: true},
+		{name: "not with non bool", expression: `!labels`, wantErr: true},
+		{name: "in with non list", expression: `"a" in desiredOsImage`, wantErr: true},
+		{name: "key of non map", expression: `addedApps.foo == ""`, wantErr: true},
+		{name: "unterminated string", expression: `desiredOsImage == "abc`, wantErr: true},
+		{name: "missing paren", expression: `(rebooted`, wantErr: true},
+		{name: "trailing tokens", expression: `rebooted rebooted`, wantErr: true},
+		{name: "unexpected character", expression: `rebooted = true`, wantErr: true},
+		{name: "empty", expression: ``, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			expression, err := ParseExpression(tc.expression)
+			if tc.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tc.expected, expression.Evaluate(vars))
+		})
+	}
+}
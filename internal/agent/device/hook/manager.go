@@ -13,6 +13,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 	"sigs.k8s.io/yaml"
 )
 
@@ -31,10 +32,18 @@ var _ Manager = (*manager)(nil)
 type Manager interface {
 	Sync(current, desired *api.DeviceSpec) error
 
-	OnBeforeUpdating(ctx context.Context, current *api.DeviceSpec, desired *api.DeviceSpec) error
-	OnAfterUpdating(ctx context.Context, current *api.DeviceSpec, desired *api.DeviceSpec, systemRebooted bool) error
+	OnBeforeUpdating(ctx context.Context, current *api.Device, desired *api.Device) error
+	OnAfterUpdating(ctx context.Context, current *api.Device, desired *api.Device, systemRebooted bool) error
 	OnBeforeRebooting(ctx context.Context) error
 	OnAfterRebooting(ctx context.Context) error
+	OnBeforeApplicationStart(ctx context.Context, current *api.Device, desired *api.Device) error
+	OnAfterApplicationStart(ctx context.Context, current *api.Device, desired *api.Device) error
+	// OnUpdateFailed is called when the update from current to desired has
+	// failed and desired is marked as failed.
+	OnUpdateFailed(ctx context.Context, current *api.Device, desired *api.Device) error
+	// OnRollback is called after the device rolled back from the failed
+	// version to the version it was running before the update.
+	OnRollback(ctx context.Context, failed *api.Device, rolledBackTo *api.Device) error
 }
 
 type manager struct {
@@ -55,13 +64,12 @@ func (m *manager) Sync(currentPtr, desiredPtr *api.DeviceSpec) error {
 	return nil
 }
 
-func (m *manager) OnBeforeUpdating(ctx context.Context, current *api.DeviceSpec, desired *api.DeviceSpec) error {
+func (m *manager) OnBeforeUpdating(ctx context.Context, current *api.Device, desired *api.Device) error {
 	actionCtx := newActionContext(api.DeviceLifecycleHookBeforeUpdating, current, desired, false)
 	return m.loadAndExecuteActions(ctx, actionCtx)
 }
 
-func (m *manager) OnAfterUpdating(ctx context.Context, current *api.DeviceSpec, desired *api.DeviceSpec, systemRebooted bool) error {
-
+func (m *manager) OnAfterUpdating(ctx context.Context, current *api.Device, desired *api.Device, systemRebooted bool) error {
 	actionCtx := newActionContext(api.DeviceLifecycleHookAfterUpdating, current, desired, systemRebooted)
 	return m.loadAndExecuteActions(ctx, actionCtx)
 }
@@ -76,6 +84,26 @@ func (m *manager) OnAfterRebooting(ctx context.Context) error {
 	return m.loadAndExecuteActions(ctx, actionCtx)
 }
 
+func (m *manager) OnBeforeApplicationStart(ctx context.Context, current *api.Device, desired *api.Device) error {
+	actionCtx := newActionContext(api.DeviceLifecycleHookBeforeApplicationStart, current, desired, false)
+	return m.loadAndExecuteActions(ctx, actionCtx)
+}
+
+func (m *manager) OnAfterApplicationStart(ctx context.Context, current *api.Device, desired *api.Device) error {
+	actionCtx := newActionContext(api.DeviceLifecycleHookAfterApplicationStart, current, desired, false)
+	return m.loadAndExecuteActions(ctx, actionCtx)
+}
+
+func (m *manager) OnUpdateFailed(ctx context.Context, current *api.Device, desired *api.Device) error {
+	actionCtx := newActionContext(api.DeviceLifecycleHookOnUpdateFailed, current, desired, false)
+	return m.loadAndExecuteActions(ctx, actionCtx)
+}
+
+func (m *manager) OnRollback(ctx context.Context, failed *api.Device, rolledBackTo *api.Device) error {
+	actionCtx := newActionContext(api.DeviceLifecycleHookOnRollback, failed, rolledBackTo, false)
+	return m.loadAndExecuteActions(ctx, actionCtx)
+}
+
 func (m *manager) loadAndExecuteActions(ctx context.Context, actionCtx *actionContext) error {
 	m.log.Debugf("Starting hook manager On%s()", actionCtx.hook)
 	defer m.log.Debugf("Finished hook manager On%s()", actionCtx.hook)
@@ -128,7 +156,9 @@ func (m *manager) loadActions(actionsMap map[string][]api.HookAction, actionFile
 		}
 		allErrs := []error{}
 		for i, action := range actions {
-			allErrs = append(allErrs, action.Validate(fmt.Sprintf("validating %q hook action[%d]", f, i))...)
+			path := fmt.Sprintf("validating %q hook action[%d]", f, i)
+			allErrs = append(allErrs, action.Validate(path)...)
+			allErrs = append(allErrs, validateExpressionConditions(action, path)...)
 		}
 		if len(allErrs) > 0 {
 			return errors.Join(allErrs...)
@@ -172,3 +202,23 @@ func (m *manager) executeActions(ctx context.Context, actions []api.HookAction,
 	}
 	return nil
 }
+
+// validateExpressionConditions ensures expression conditions can be parsed,
+// so that invalid hooks are rejected when loaded rather than when executed.
+func validateExpressionConditions(action api.HookAction, path string) []error {
+	allErrs := []error{}
+	for i, condition := range lo.FromPtr(action.If) {
+		conditionType, err := condition.Type()
+		if err != nil || conditionType != api.HookConditionTypeExpression {
+			continue
+		}
+		expression, err := condition.AsHookConditionExpression()
+		if err != nil {
+			continue
+		}
+		if _, err := ParseExpression(expression); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.if[%d]: invalid expression %q: %w", path, i, expression, err))
+		}
+	}
+	return allErrs
+}
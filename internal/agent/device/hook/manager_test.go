@@ -29,48 +29,48 @@ func TestHookManager(t *testing.T) {
 	testCases := []struct {
 		name             string
 		hooks            map[string]string
-		current          *v1alpha1.DeviceSpec
-		desired          *v1alpha1.DeviceSpec
+		current          *v1alpha1.Device
+		desired          *v1alpha1.Device
 		rebooted         bool
 		expectedCommands []command
 	}{
 		{
 			name:             "creating a file outside the default hooks' paths should trigger no action",
 			hooks:            map[string]string{},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/systemd/user/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/systemd/user/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{},
 		},
 		{
 			name:             "creating a file inside a default hook's path should trigger its default action",
 			hooks:            map[string]string{},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/systemd/system/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/systemd/system/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{{"systemctl", []string{"daemon-reload"}}},
 		},
 		{
 			name:             "creating a file whose path is being watched should trigger the action once",
 			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookPathToFile},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{{"systemctl", []string{"restart", "someservice"}}},
 		},
 		{
 			name:             "creating a file whose parent directory's path is being watched should trigger the action once",
 			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookPathToDir},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{{"systemctl", []string{"restart", "someservice"}}},
 		},
 		{
 			name:    "creating multiple files whose parent directory's path is being watched should trigger the action once",
 			hooks:   map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookPathToDir},
-			current: createDeviceSpec(require, map[string]string{}),
-			desired: createDeviceSpec(require, map[string]string{
+			current: createDevice(require, map[string]string{}),
+			desired: createDevice(require, map[string]string{
 				"/etc/someservice/some.config":      "data:,content",
 				"/etc/someservice/someother.config": "data:,content",
 			}),
@@ -80,19 +80,51 @@ func TestHookManager(t *testing.T) {
 		{
 			name:             "actions with rebooted condition should run if the system rebooted during the update",
 			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookRebootedCondition},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
 			rebooted:         true,
 			expectedCommands: []command{{"echo", []string{"System was rebooted."}}},
 		},
 		{
 			name:             "actions with rebooted condition should run if the system rebooted during the update",
 			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookRebootedCondition},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{{"echo", []string{"System was not rebooted."}}},
 		},
+		{
+			name:             "actions with OS image condition should run if the OS image changes",
+			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookOsImageCondition},
+			current:          withOsImage(createDevice(require, map[string]string{}), "quay.io/org/os:v1"),
+			desired:          withOsImage(createDevice(require, map[string]string{}), "quay.io/org/os:v2"),
+			rebooted:         false,
+			expectedCommands: []command{{"echo", []string{"OS image changed."}}},
+		},
+		{
+			name:             "actions with OS image condition should not run if the OS image is unchanged",
+			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookOsImageCondition},
+			current:          withOsImage(createDevice(require, map[string]string{}), "quay.io/org/os:v1"),
+			desired:          withOsImage(createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}), "quay.io/org/os:v1"),
+			rebooted:         false,
+			expectedCommands: []command{},
+		},
+		{
+			name:             "actions with changed files and labels condition should run if both match",
+			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookFilesAndLabelsCondition},
+			current:          createDevice(require, map[string]string{}),
+			desired:          withLabels(createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}), map[string]string{"site": "berlin"}),
+			rebooted:         false,
+			expectedCommands: []command{{"echo", []string{"Config changed in berlin."}}},
+		},
+		{
+			name:             "actions with changed files and labels condition should not run if labels don't match",
+			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookFilesAndLabelsCondition},
+			current:          createDevice(require, map[string]string{}),
+			desired:          withLabels(createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}), map[string]string{"site": "madrid"}),
+			rebooted:         false,
+			expectedCommands: []command{},
+		},
 	}
 
 	for i := range testCases {
@@ -158,6 +190,95 @@ func createTempHooksDir(t *testing.T, hooks map[string]string) fileio.ReadWriter
 	return readerWriter
 }
 
+const testHookOsImageCondition = `
+- if:
+  - previousOsImage != desiredOsImage
+  run: echo "OS image changed."
+`
+
+const testHookFilesAndLabelsCondition = `
+- if:
+  - '"/etc/someservice/some.config" in changedFiles && labels.site == "berlin"'
+  run: echo "Config changed in berlin."
+`
+
+func TestHookManagerRejectsInvalidExpression(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hooks := map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": `
+- if:
+  - rebooted == "yes"
+  run: echo "never"
+`}
+	readWriter := createTempHooksDir(t, hooks)
+	mockExecuter := executer.NewMockExecuter(ctrl)
+	expectExecCalls(mockExecuter, []command{})
+	hookManager := NewManager(readWriter, mockExecuter, log.NewPrefixLogger("test"))
+
+	current := createDevice(require, map[string]string{})
+	desired := createDevice(require, map[string]string{})
+	err := hookManager.OnAfterUpdating(context.Background(), current, desired, false)
+	require.ErrorContains(err, "invalid expression")
+}
+
+func TestHookManagerApplicationAndRollbackHooks(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	hooks := map[string]string{
+		"/etc/flightctl/hooks.d/beforeapplicationstart/01-test.yaml": `
+- run: echo "Flushing buffers."
+`,
+		"/etc/flightctl/hooks.d/afterapplicationstart/01-test.yaml": `
+- run: echo "Applications started."
+`,
+		"/etc/flightctl/hooks.d/onupdatefailed/01-test.yaml": `
+- run: echo "Update failed."
+`,
+		"/etc/flightctl/hooks.d/onrollback/01-test.yaml": `
+- if:
+  - previousOsImage != desiredOsImage
+  run: echo "Rolled back."
+`,
+	}
+	readWriter := createTempHooksDir(t, hooks)
+	mockExecuter := executer.NewMockExecuter(ctrl)
+	expectExecCalls(mockExecuter, []command{
+		{"echo", []string{"Flushing buffers."}},
+		{"echo", []string{"Applications started."}},
+		{"echo", []string{"Update failed."}},
+		{"echo", []string{"Rolled back."}},
+	})
+	hookManager := NewManager(readWriter, mockExecuter, log.NewPrefixLogger("test"))
+
+	ctx := context.Background()
+	current := withOsImage(createDevice(require, map[string]string{}), "quay.io/org/os:v1")
+	desired := withOsImage(createDevice(require, map[string]string{}), "quay.io/org/os:v2")
+	require.NoError(hookManager.OnBeforeApplicationStart(ctx, current, desired))
+	require.NoError(hookManager.OnAfterApplicationStart(ctx, current, desired))
+	require.NoError(hookManager.OnUpdateFailed(ctx, current, desired))
+	require.NoError(hookManager.OnRollback(ctx, desired, current))
+}
+
+func createDevice(require *require.Assertions, fileMap map[string]string) *v1alpha1.Device {
+	return &v1alpha1.Device{
+		Spec: createDeviceSpec(require, fileMap),
+	}
+}
+
+func withOsImage(device *v1alpha1.Device, image string) *v1alpha1.Device {
+	device.Spec.Os = &v1alpha1.DeviceOsSpec{Image: image}
+	return device
+}
+
+func withLabels(device *v1alpha1.Device, labels map[string]string) *v1alpha1.Device {
+	device.Metadata.Labels = &labels
+	return device
+}
+
 func createDeviceSpec(require *require.Assertions, fileMap map[string]string) *v1alpha1.DeviceSpec {
 	files := []v1alpha1.FileSpec{}
 	for path, data := range fileMap {
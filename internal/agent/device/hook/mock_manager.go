@@ -40,6 +40,20 @@ func (m *MockManager) EXPECT() *MockManagerMockRecorder {
 	return m.recorder
 }
 
+// OnAfterApplicationStart mocks base method.
+func (m *MockManager) OnAfterApplicationStart(ctx context.Context, current, desired *v1alpha1.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnAfterApplicationStart", ctx, current, desired)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnAfterApplicationStart indicates an expected call of OnAfterApplicationStart.
+func (mr *MockManagerMockRecorder) OnAfterApplicationStart(ctx, current, desired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnAfterApplicationStart", reflect.TypeOf((*MockManager)(nil).OnAfterApplicationStart), ctx, current, desired)
+}
+
 // OnAfterRebooting mocks base method.
 func (m *MockManager) OnAfterRebooting(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -55,7 +69,7 @@ func (mr *MockManagerMockRecorder) OnAfterRebooting(ctx any) *gomock.Call {
 }
 
 // OnAfterUpdating mocks base method.
-func (m *MockManager) OnAfterUpdating(ctx context.Context, current, desired *v1alpha1.DeviceSpec, systemRebooted bool) error {
+func (m *MockManager) OnAfterUpdating(ctx context.Context, current, desired *v1alpha1.Device, systemRebooted bool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "OnAfterUpdating", ctx, current, desired, systemRebooted)
 	ret0, _ := ret[0].(error)
@@ -68,6 +82,20 @@ func (mr *MockManagerMockRecorder) OnAfterUpdating(ctx, current, desired, system
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnAfterUpdating", reflect.TypeOf((*MockManager)(nil).OnAfterUpdating), ctx, current, desired, systemRebooted)
 }
 
+// OnBeforeApplicationStart mocks base method.
+func (m *MockManager) OnBeforeApplicationStart(ctx context.Context, current, desired *v1alpha1.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnBeforeApplicationStart", ctx, current, desired)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnBeforeApplicationStart indicates an expected call of OnBeforeApplicationStart.
+func (mr *MockManagerMockRecorder) OnBeforeApplicationStart(ctx, current, desired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnBeforeApplicationStart", reflect.TypeOf((*MockManager)(nil).OnBeforeApplicationStart), ctx, current, desired)
+}
+
 // OnBeforeRebooting mocks base method.
 func (m *MockManager) OnBeforeRebooting(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -83,7 +111,7 @@ func (mr *MockManagerMockRecorder) OnBeforeRebooting(ctx any) *gomock.Call {
 }
 
 // OnBeforeUpdating mocks base method.
-func (m *MockManager) OnBeforeUpdating(ctx context.Context, current, desired *v1alpha1.DeviceSpec) error {
+func (m *MockManager) OnBeforeUpdating(ctx context.Context, current, desired *v1alpha1.Device) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "OnBeforeUpdating", ctx, current, desired)
 	ret0, _ := ret[0].(error)
@@ -96,6 +124,34 @@ func (mr *MockManagerMockRecorder) OnBeforeUpdating(ctx, current, desired any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnBeforeUpdating", reflect.TypeOf((*MockManager)(nil).OnBeforeUpdating), ctx, current, desired)
 }
 
+// OnRollback mocks base method.
+func (m *MockManager) OnRollback(ctx context.Context, failed, rolledBackTo *v1alpha1.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnRollback", ctx, failed, rolledBackTo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnRollback indicates an expected call of OnRollback.
+func (mr *MockManagerMockRecorder) OnRollback(ctx, failed, rolledBackTo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnRollback", reflect.TypeOf((*MockManager)(nil).OnRollback), ctx, failed, rolledBackTo)
+}
+
+// OnUpdateFailed mocks base method.
+func (m *MockManager) OnUpdateFailed(ctx context.Context, current, desired *v1alpha1.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OnUpdateFailed", ctx, current, desired)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// OnUpdateFailed indicates an expected call of OnUpdateFailed.
+func (mr *MockManagerMockRecorder) OnUpdateFailed(ctx, current, desired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUpdateFailed", reflect.TypeOf((*MockManager)(nil).OnUpdateFailed), ctx, current, desired)
+}
+
 // Sync mocks base method.
 func (m *MockManager) Sync(current, desired *v1alpha1.DeviceSpec) error {
 	m.ctrl.T.Helper()
@@ -14,8 +14,14 @@ import (
 type manager struct {
 	download *schedule
 	update   *schedule
+	// maintenanceWindows are the additional schedules in which updates may be applied
+	maintenanceWindows []*schedule
+	blackoutPeriods    []v1alpha1.BlackoutPeriod
 
 	log *log.PrefixLogger
+
+	// this is used for testing to override time.Now
+	nowFn func() time.Time
 }
 
 // NewManager returns a new device policy manager.
@@ -23,7 +29,8 @@ type manager struct {
 // thread-safe.
 func NewManager(log *log.PrefixLogger) Manager {
 	return &manager{
-		log: log,
+		log:   log,
+		nowFn: time.Now,
 	}
 }
 
@@ -38,10 +45,12 @@ func (m *manager) Sync(ctx context.Context, desired *v1alpha1.DeviceSpec) error
 		m.log.Debugf("No update policy defined")
 		m.update = nil
 		m.download = nil
+		m.maintenanceWindows = nil
+		m.blackoutPeriods = nil
 		return nil
 	}
 	if desired.UpdatePolicy.DownloadSchedule != nil {
-		schedule := newSchedule(Download)
+		schedule := m.newSchedule(Download)
 		if err := schedule.Parse(m.log, desired.UpdatePolicy.DownloadSchedule); err != nil {
 			return fmt.Errorf("failed to parse download schedule: %w", err)
 		}
@@ -51,7 +60,7 @@ func (m *manager) Sync(ctx context.Context, desired *v1alpha1.DeviceSpec) error
 	}
 
 	if desired.UpdatePolicy.UpdateSchedule != nil {
-		schedule := newSchedule(Update)
+		schedule := m.newSchedule(Update)
 		if err := schedule.Parse(m.log, desired.UpdatePolicy.UpdateSchedule); err != nil {
 			return fmt.Errorf("failed to parse update schedule: %w", err)
 		}
@@ -60,6 +69,17 @@ func (m *manager) Sync(ctx context.Context, desired *v1alpha1.DeviceSpec) error
 		m.update = nil
 	}
 
+	var maintenanceWindows []*schedule
+	for i := range lo.FromPtr(desired.UpdatePolicy.MaintenanceWindows) {
+		schedule := m.newSchedule(Update)
+		if err := schedule.Parse(m.log, &(*desired.UpdatePolicy.MaintenanceWindows)[i]); err != nil {
+			return fmt.Errorf("failed to parse maintenance window %d: %w", i, err)
+		}
+		maintenanceWindows = append(maintenanceWindows, schedule)
+	}
+	m.maintenanceWindows = maintenanceWindows
+	m.blackoutPeriods = lo.FromPtr(desired.UpdatePolicy.BlackoutPeriods)
+
 	return nil
 }
 
@@ -76,15 +96,44 @@ func (m *manager) IsReady(ctx context.Context, policyType Type) bool {
 	}
 
 	if policyType == Update {
-		if m.update == nil {
+		if m.inBlackoutPeriod() {
+			return false
+		}
+		if m.update == nil && len(m.maintenanceWindows) == 0 {
 			return true
 		}
-		return m.update.IsReady(m.log)
+		if m.update != nil && m.update.IsReady(m.log) {
+			return true
+		}
+		for _, window := range m.maintenanceWindows {
+			if window.IsReady(m.log) {
+				return true
+			}
+		}
+		return false
 	}
 
 	return false
 }
 
+// inBlackoutPeriod returns true if updates are currently frozen by one of the blackout periods
+func (m *manager) inBlackoutPeriod() bool {
+	now := m.nowFn()
+	for _, blackout := range m.blackoutPeriods {
+		if !now.Before(blackout.Start) && now.Before(blackout.End) {
+			m.log.Infof("Policy %s is blocked by blackout period from %s to %s", Update, blackout.Start, blackout.End)
+			return true
+		}
+	}
+	return false
+}
+
+func (m *manager) newSchedule(policyType Type) *schedule {
+	schedule := newSchedule(policyType)
+	schedule.nowFn = m.nowFn
+	return schedule
+}
+
 type schedule struct {
 	policyType         Type
 	location           *time.Location
@@ -1,6 +1,7 @@
 package policy
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -150,3 +151,88 @@ func TestIsReady(t *testing.T) {
 		})
 	}
 }
+
+func TestIsReadyMaintenanceWindows(t *testing.T) {
+	// Wednesday
+	now := time.Date(2026, time.December, 23, 14, 30, 0, 0, time.UTC)
+	freezeStart := time.Date(2026, time.December, 24, 0, 0, 0, 0, time.UTC)
+	freezeEnd := time.Date(2027, time.January, 2, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		updatePolicy  *v1alpha1.DeviceUpdatePolicySpec
+		currentTime   time.Time
+		expectedReady bool
+	}{
+		{
+			name:          "ready: no update policy",
+			currentTime:   now,
+			expectedReady: true,
+		},
+		{
+			name: "ready: in second maintenance window",
+			updatePolicy: &v1alpha1.DeviceUpdatePolicySpec{
+				UpdateSchedule: &v1alpha1.UpdateSchedule{At: "0 2 * * *", TimeZone: lo.ToPtr("UTC"), StartGraceDuration: lo.ToPtr("1h")},
+				MaintenanceWindows: &[]v1alpha1.UpdateSchedule{
+					{At: "0 14 * * 3", TimeZone: lo.ToPtr("UTC"), StartGraceDuration: lo.ToPtr("1h")},
+				},
+			},
+			currentTime:   now,
+			expectedReady: true,
+		},
+		{
+			name: "not ready: outside all maintenance windows",
+			updatePolicy: &v1alpha1.DeviceUpdatePolicySpec{
+				MaintenanceWindows: &[]v1alpha1.UpdateSchedule{
+					{At: "0 2 * * *", TimeZone: lo.ToPtr("UTC"), StartGraceDuration: lo.ToPtr("1h")},
+					{At: "0 14 * * 4", TimeZone: lo.ToPtr("UTC"), StartGraceDuration: lo.ToPtr("1h")},
+				},
+			},
+			currentTime:   now,
+			expectedReady: false,
+		},
+		{
+			name: "not ready: in blackout period without schedule",
+			updatePolicy: &v1alpha1.DeviceUpdatePolicySpec{
+				BlackoutPeriods: &[]v1alpha1.BlackoutPeriod{{Start: freezeStart, End: freezeEnd}},
+			},
+			currentTime:   freezeStart.Add(time.Hour),
+			expectedReady: false,
+		},
+		{
+			name: "not ready: maintenance window in blackout period",
+			updatePolicy: &v1alpha1.DeviceUpdatePolicySpec{
+				MaintenanceWindows: &[]v1alpha1.UpdateSchedule{
+					{At: "0 2 * * *", TimeZone: lo.ToPtr("UTC"), StartGraceDuration: lo.ToPtr("1h")},
+				},
+				BlackoutPeriods: &[]v1alpha1.BlackoutPeriod{{Start: freezeStart, End: freezeEnd}},
+			},
+			currentTime:   freezeStart.Add(2*time.Hour + 30*time.Minute),
+			expectedReady: false,
+		},
+		{
+			name: "ready: blackout period has ended",
+			updatePolicy: &v1alpha1.DeviceUpdatePolicySpec{
+				BlackoutPeriods: &[]v1alpha1.BlackoutPeriod{{Start: freezeStart, End: freezeEnd}},
+			},
+			currentTime:   freezeEnd,
+			expectedReady: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			log := log.NewPrefixLogger("test")
+			m := &manager{
+				log:   log,
+				nowFn: func() time.Time { return tt.currentTime },
+			}
+
+			err := m.Sync(context.Background(), &v1alpha1.DeviceSpec{UpdatePolicy: tt.updatePolicy})
+			require.NoError(err)
+			require.Equal(tt.expectedReady, m.IsReady(context.Background(), Update))
+			require.True(m.IsReady(context.Background(), Download))
+		})
+	}
+}
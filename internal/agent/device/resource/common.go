@@ -7,6 +7,7 @@ import (
 
 	"github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 )
 
 func updateMonitor(
@@ -128,6 +129,45 @@ func getMonitorSpec(monitor *v1alpha1.ResourceMonitor) (*MonitorSpec, error) {
 				AlertRules:       spec.AlertRules,
 			},
 		}, nil
+	case TemperatureMonitorType:
+		spec, err := monitor.AsTemperatureResourceMonitorSpec()
+		if err != nil {
+			return nil, err
+		}
+		return &MonitorSpec{
+			ResourceMonitorSpec: v1alpha1.ResourceMonitorSpec{
+				SamplingInterval: spec.SamplingInterval,
+				AlertRules:       spec.AlertRules,
+			},
+			Zone:           lo.FromPtr(spec.Zone),
+			MaxTemperature: lo.FromPtr(spec.MaxTemperature),
+		}, nil
+	case NetworkMonitorType:
+		spec, err := monitor.AsNetworkResourceMonitorSpec()
+		if err != nil {
+			return nil, err
+		}
+		return &MonitorSpec{
+			ResourceMonitorSpec: v1alpha1.ResourceMonitorSpec{
+				SamplingInterval: spec.SamplingInterval,
+				AlertRules:       spec.AlertRules,
+			},
+			Interface: lo.FromPtr(spec.Interface),
+			Metric:    lo.FromPtr(spec.Metric),
+		}, nil
+	case ProcessMonitorType:
+		spec, err := monitor.AsProcessResourceMonitorSpec()
+		if err != nil {
+			return nil, err
+		}
+		return &MonitorSpec{
+			ResourceMonitorSpec: v1alpha1.ResourceMonitorSpec{
+				SamplingInterval: spec.SamplingInterval,
+				AlertRules:       spec.AlertRules,
+			},
+			Process: lo.FromPtr(spec.Process),
+			Unit:    lo.FromPtr(spec.Unit),
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown monitor type: %s", monitorType)
 	}
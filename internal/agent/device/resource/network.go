@@ -0,0 +1,284 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	DefaultNetworkSyncTimeout = 5 * time.Second
+	DefaultSysClassNetPath    = "/sys/class/net"
+	loopbackInterface         = "lo"
+)
+
+var _ Monitor[NetworkUsage] = (*NetworkMonitor)(nil)
+
+type NetworkMonitor struct {
+	mu      sync.Mutex
+	alerts  map[v1alpha1.ResourceAlertSeverityType]*Alert
+	netPath string
+	// iface is the name of the interface to monitor, all interfaces except loopback are monitored if empty
+	iface  string
+	metric v1alpha1.NetworkMonitorMetricType
+	// previous holds the counters of the last sample, rates are computed from the difference to it
+	previous *NetworkUsage
+
+	updateIntervalCh chan time.Duration
+	samplingInterval time.Duration
+
+	log *log.PrefixLogger
+}
+
+func NewNetworkMonitor(
+	log *log.PrefixLogger,
+) *NetworkMonitor {
+	return &NetworkMonitor{
+		alerts:           make(map[v1alpha1.ResourceAlertSeverityType]*Alert),
+		updateIntervalCh: make(chan time.Duration, 1),
+		samplingInterval: DefaultSamplingInterval,
+		netPath:          DefaultSysClassNetPath,
+		metric:           v1alpha1.NetworkMonitorMetricThroughput,
+		log:              log,
+	}
+}
+
+func (m *NetworkMonitor) Run(ctx context.Context) {
+	samplingInterval := m.getSamplingInterval()
+	ticker := time.NewTicker(samplingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case newInterval := <-m.updateIntervalCh:
+			ticker.Reset(newInterval)
+		case <-ticker.C:
+			m.log.Debug("Checking network usage")
+			usage := NetworkUsage{}
+			m.sync(ctx, &usage)
+		}
+	}
+}
+
+func (m *NetworkMonitor) Update(monitor *v1alpha1.ResourceMonitor) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spec, err := getMonitorSpec(monitor)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := updateMonitor(m.log, monitor, &m.samplingInterval, m.alerts, m.updateIntervalCh)
+	if err != nil {
+		return updated, err
+	}
+
+	metric := spec.Metric
+	if metric == "" {
+		metric = v1alpha1.NetworkMonitorMetricThroughput
+	}
+	if spec.Interface != m.iface || metric != m.metric {
+		m.iface = spec.Interface
+		m.metric = metric
+		m.previous = nil
+		updated = true
+	}
+
+	return updated, nil
+}
+
+func (m *NetworkMonitor) Alerts() []v1alpha1.ResourceAlertRule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var firing []v1alpha1.ResourceAlertRule
+	for _, alert := range m.alerts {
+		if alert.IsFiring() {
+			firing = append(firing, alert.ResourceAlertRule)
+		}
+	}
+	return firing
+}
+
+// CollectUsage reads the interface counters and sets the usage relative to the previous sample.  The first
+// sample after the monitor is started or updated has no usage.
+func (m *NetworkMonitor) CollectUsage(ctx context.Context, usage *NetworkUsage) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	interfaces, err := m.interfaces()
+	if err != nil {
+		return err
+	}
+	usage.Interfaces = make(map[string]NetworkCounters, len(interfaces))
+	for _, iface := range interfaces {
+		counters, err := readNetworkCounters(filepath.Join(m.netPath, iface))
+		if err != nil {
+			return fmt.Errorf("reading counters of interface %s: %w", iface, err)
+		}
+		usage.Interfaces[iface] = counters
+	}
+	usage.lastCollectedAt = time.Now()
+
+	if m.previous != nil {
+		usage.UsedPercent = networkUsedPercent(m.metric, m.previous, usage)
+	}
+	m.previous = usage
+	return nil
+}
+
+func (m *NetworkMonitor) interfaces() ([]string, error) {
+	if m.iface != "" {
+		return []string{m.iface}, nil
+	}
+	entries, err := os.ReadDir(m.netPath)
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	for _, entry := range entries {
+		if entry.Name() != loopbackInterface {
+			ret = append(ret, entry.Name())
+		}
+	}
+	return ret, nil
+}
+
+func (m *NetworkMonitor) sync(ctx context.Context, usage *NetworkUsage) {
+	if !m.hasAlertRules() {
+		m.log.Debug("Skipping network usage sync: no alert rules")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultNetworkSyncTimeout)
+	defer cancel()
+
+	if err := m.CollectUsage(ctx, usage); err != nil {
+		m.log.Errorf("Failed to collect network usage: %v", err)
+	}
+
+	m.ensureAlerts(usage.UsedPercent)
+}
+
+func (m *NetworkMonitor) ensureAlerts(percentageUsed int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.log.Tracef("Network %s: %d%%", m.metric, percentageUsed)
+	for _, alert := range m.alerts {
+		alert.Sync(percentageUsed)
+	}
+}
+
+func (m *NetworkMonitor) hasAlertRules() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.alerts) > 0
+}
+
+func (m *NetworkMonitor) getSamplingInterval() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.samplingInterval
+}
+
+func readNetworkCounters(ifacePath string) (NetworkCounters, error) {
+	var counters NetworkCounters
+	values := map[string]*uint64{
+		"rx_bytes":   &counters.RxBytes,
+		"tx_bytes":   &counters.TxBytes,
+		"rx_packets": &counters.RxPackets,
+		"tx_packets": &counters.TxPackets,
+		"rx_errors":  &counters.RxErrors,
+		"tx_errors":  &counters.TxErrors,
+		"rx_dropped": &counters.RxDropped,
+		"tx_dropped": &counters.TxDropped,
+	}
+	for name, value := range values {
+		v, err := readSysfsInt(filepath.Join(ifacePath, "statistics", name))
+		if err != nil {
+			return counters, err
+		}
+		*value = uint64(max(v, 0))
+	}
+	// virtual interfaces have no link speed
+	if speed, err := readSysfsInt(filepath.Join(ifacePath, "speed")); err == nil && speed > 0 {
+		counters.SpeedMbps = uint64(speed)
+	}
+	return counters, nil
+}
+
+// networkUsedPercent returns the highest throughput utilization of the interfaces, or the share of failed packets
+// across all interfaces, between the previous and the current sample.
+func networkUsedPercent(metric v1alpha1.NetworkMonitorMetricType, previous, current *NetworkUsage) int64 {
+	elapsed := current.lastCollectedAt.Sub(previous.lastCollectedAt).Seconds()
+	var usedPercent float64
+	var packets, failed uint64
+	for iface, c := range current.Interfaces {
+		p, ok := previous.Interfaces[iface]
+		if !ok {
+			continue
+		}
+		switch metric {
+		case v1alpha1.NetworkMonitorMetricErrors:
+			packets += counterDelta(p.RxPackets, c.RxPackets) + counterDelta(p.TxPackets, c.TxPackets)
+			failed += counterDelta(p.RxErrors, c.RxErrors) + counterDelta(p.TxErrors, c.TxErrors) +
+				counterDelta(p.RxDropped, c.RxDropped) + counterDelta(p.TxDropped, c.TxDropped)
+		default:
+			if c.SpeedMbps == 0 || elapsed <= 0 {
+				continue
+			}
+			// links are full duplex, so the busier direction determines the utilization
+			bytes := max(counterDelta(p.RxBytes, c.RxBytes), counterDelta(p.TxBytes, c.TxBytes))
+			utilization := float64(bytes) * 8 / elapsed / (float64(c.SpeedMbps) * 1e6) * 100
+			usedPercent = max(usedPercent, utilization)
+		}
+	}
+	if metric == v1alpha1.NetworkMonitorMetricErrors && packets+failed > 0 {
+		usedPercent = float64(failed) / float64(packets+failed) * 100
+	}
+	return int64(usedPercent)
+}
+
+// counterDelta returns the increase of a counter, treating a counter that was reset as starting from zero
+func counterDelta(previous, current uint64) uint64 {
+	if current < previous {
+		return current
+	}
+	return current - previous
+}
+
+// NetworkCounters are the statistics of a network interface.
+type NetworkCounters struct {
+	RxBytes   uint64
+	TxBytes   uint64
+	RxPackets uint64
+	TxPackets uint64
+	RxErrors  uint64
+	TxErrors  uint64
+	RxDropped uint64
+	TxDropped uint64
+	SpeedMbps uint64
+}
+
+// NetworkUsage represents the tracked network usage of this device.
+type NetworkUsage struct {
+	Interfaces  map[string]NetworkCounters
+	UsedPercent int64
+
+	lastCollectedAt time.Time
+}
@@ -0,0 +1,79 @@
+package resource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func writeNetworkInterface(t *testing.T, netPath, iface string, speed int64, counters map[string]uint64) {
+	t.Helper()
+	statsPath := filepath.Join(netPath, iface, "statistics")
+	require.NoError(t, os.MkdirAll(statsPath, 0755))
+	if speed != 0 {
+		require.NoError(t, os.WriteFile(filepath.Join(netPath, iface, "speed"), []byte(strconv.FormatInt(speed, 10)+"\n"), 0600))
+	}
+	for _, name := range []string{"rx_bytes", "tx_bytes", "rx_packets", "tx_packets", "rx_errors", "tx_errors", "rx_dropped", "tx_dropped"} {
+		require.NoError(t, os.WriteFile(filepath.Join(statsPath, name), []byte(strconv.FormatUint(counters[name], 10)+"\n"), 0600))
+	}
+}
+
+func TestNetworkUsedPercent(t *testing.T) {
+	start := time.Now()
+	previous := &NetworkUsage{
+		Interfaces: map[string]NetworkCounters{
+			"eth0": {RxBytes: 1000, TxBytes: 1000, RxPackets: 100, TxPackets: 100, SpeedMbps: 100},
+			"eth1": {RxBytes: 0, TxBytes: 0, RxPackets: 0, TxPackets: 0, SpeedMbps: 1000},
+		},
+		lastCollectedAt: start,
+	}
+	current := &NetworkUsage{
+		Interfaces: map[string]NetworkCounters{
+			// 50Mb received in 10s on a 100Mb/s link
+			"eth0": {RxBytes: 1000 + 62_500_000, TxBytes: 2000, RxPackets: 900, TxPackets: 200, RxErrors: 50, RxDropped: 50, SpeedMbps: 100},
+			// 100Mb transmitted in 10s on a 1Gb/s link
+			"eth1": {TxBytes: 125_000_000, SpeedMbps: 1000},
+			// interface without previous sample
+			"eth2": {RxBytes: 1_000_000_000, SpeedMbps: 10},
+		},
+		lastCollectedAt: start.Add(10 * time.Second),
+	}
+
+	require.Equal(t, int64(50), networkUsedPercent(v1alpha1.NetworkMonitorMetricThroughput, previous, current))
+	// 100 failed packets out of 900 successful and 100 failed packets
+	require.Equal(t, int64(10), networkUsedPercent(v1alpha1.NetworkMonitorMetricErrors, previous, current))
+}
+
+func TestNetworkCollectUsage(t *testing.T) {
+	require := require.New(t)
+	netPath := t.TempDir()
+	writeNetworkInterface(t, netPath, "lo", 0, map[string]uint64{"rx_bytes": 100})
+	writeNetworkInterface(t, netPath, "eth0", 1000, map[string]uint64{"rx_bytes": 100, "rx_packets": 10})
+	writeNetworkInterface(t, netPath, "veth0", 0, map[string]uint64{"tx_bytes": 100})
+
+	monitor := NewNetworkMonitor(log.NewPrefixLogger("test"))
+	monitor.netPath = netPath
+
+	usage := NetworkUsage{}
+	require.NoError(monitor.CollectUsage(context.Background(), &usage))
+	require.Len(usage.Interfaces, 2)
+	require.Equal(uint64(1000), usage.Interfaces["eth0"].SpeedMbps)
+	require.Equal(uint64(0), usage.Interfaces["veth0"].SpeedMbps)
+	// the first sample has no usage
+	require.Equal(int64(0), usage.UsedPercent)
+
+	monitor.iface = "eth0"
+	usage = NetworkUsage{}
+	require.NoError(monitor.CollectUsage(context.Background(), &usage))
+	require.Len(usage.Interfaces, 1)
+
+	monitor.iface = "eth1"
+	require.Error(monitor.CollectUsage(context.Background(), &NetworkUsage{}))
+}
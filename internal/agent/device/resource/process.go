@@ -0,0 +1,290 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	DefaultProcessSyncTimeout = 5 * time.Second
+	DefaultProcPath           = "/proc"
+	DefaultCgroupPath         = "/sys/fs/cgroup"
+	// maxCgroupSearchDepth bounds the search for the cgroup of a systemd unit in the cgroup hierarchy
+	maxCgroupSearchDepth = 4
+)
+
+var _ Monitor[ProcessUsage] = (*ProcessMonitor)(nil)
+
+type ProcessMonitor struct {
+	mu          sync.Mutex
+	alerts      map[v1alpha1.ResourceAlertSeverityType]*Alert
+	procPath    string
+	cgroupPath  string
+	memInfoPath string
+	// process is the name of the processes to monitor
+	process string
+	// unit is the name of the systemd unit to monitor
+	unit string
+
+	updateIntervalCh chan time.Duration
+	samplingInterval time.Duration
+
+	log *log.PrefixLogger
+}
+
+func NewProcessMonitor(
+	log *log.PrefixLogger,
+) *ProcessMonitor {
+	return &ProcessMonitor{
+		alerts:           make(map[v1alpha1.ResourceAlertSeverityType]*Alert),
+		updateIntervalCh: make(chan time.Duration, 1),
+		samplingInterval: DefaultSamplingInterval,
+		procPath:         DefaultProcPath,
+		cgroupPath:       DefaultCgroupPath,
+		memInfoPath:      DefaultProcMemInfoPath,
+		log:              log,
+	}
+}
+
+func (m *ProcessMonitor) Run(ctx context.Context) {
+	samplingInterval := m.getSamplingInterval()
+	ticker := time.NewTicker(samplingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case newInterval := <-m.updateIntervalCh:
+			ticker.Reset(newInterval)
+		case <-ticker.C:
+			m.log.Debug("Checking process memory usage")
+			usage := ProcessUsage{}
+			m.sync(ctx, &usage)
+		}
+	}
+}
+
+func (m *ProcessMonitor) Update(monitor *v1alpha1.ResourceMonitor) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spec, err := getMonitorSpec(monitor)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := updateMonitor(m.log, monitor, &m.samplingInterval, m.alerts, m.updateIntervalCh)
+	if err != nil {
+		return updated, err
+	}
+
+	if spec.Process != m.process || spec.Unit != m.unit {
+		m.process = spec.Process
+		m.unit = spec.Unit
+		updated = true
+	}
+
+	return updated, nil
+}
+
+func (m *ProcessMonitor) Alerts() []v1alpha1.ResourceAlertRule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var firing []v1alpha1.ResourceAlertRule
+	for _, alert := range m.alerts {
+		if alert.IsFiring() {
+			firing = append(firing, alert.ResourceAlertRule)
+		}
+	}
+	return firing
+}
+
+// CollectUsage sets the usage to the memory used by the monitored processes or systemd unit, relative to the
+// total memory of the device.
+func (m *ProcessMonitor) CollectUsage(ctx context.Context, usage *ProcessUsage) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	process, unit := m.getTarget()
+	var err error
+	switch {
+	case unit != "":
+		usage.Name = unit
+		usage.MemoryBytes, err = m.unitMemory(unit)
+	case process != "":
+		usage.Name = process
+		usage.MemoryBytes, err = m.processMemory(process)
+	default:
+		return fmt.Errorf("no process or unit to monitor")
+	}
+	if err != nil {
+		return err
+	}
+
+	file, err := os.ReadFile(m.memInfoPath)
+	if err != nil {
+		return err
+	}
+	memory := MemoryUsage{}
+	if err := parseMemStats(strings.Split(string(file), "\n"), &memory); err != nil {
+		return err
+	}
+	// meminfo reports kB
+	usage.MemTotal = memory.MemTotal * 1024
+	if usage.MemTotal > 0 {
+		usage.UsedPercent = int64(float64(usage.MemoryBytes) / float64(usage.MemTotal) * 100)
+	}
+	usage.lastCollectedAt = time.Now()
+	return nil
+}
+
+// unitMemory returns the memory charged to the cgroup of the systemd unit
+func (m *ProcessMonitor) unitMemory(unit string) (uint64, error) {
+	cgroup, err := findUnitCgroup(m.cgroupPath, unit)
+	if err != nil {
+		return 0, err
+	}
+	memory, err := readSysfsInt(filepath.Join(cgroup, "memory.current"))
+	if err != nil {
+		return 0, fmt.Errorf("reading memory of unit %s: %w", unit, err)
+	}
+	return uint64(max(memory, 0)), nil
+}
+
+// processMemory returns the sum of the resident memory of all processes with the given name
+func (m *ProcessMonitor) processMemory(name string) (uint64, error) {
+	entries, err := os.ReadDir(m.procPath)
+	if err != nil {
+		return 0, err
+	}
+	var total uint64
+	for _, entry := range entries {
+		if _, err := strconv.Atoi(entry.Name()); err != nil {
+			continue
+		}
+		// processes may exit while they are read
+		comm, err := readSysfsString(filepath.Join(m.procPath, entry.Name(), "comm"))
+		if err != nil || comm != name {
+			continue
+		}
+		status, err := os.ReadFile(filepath.Join(m.procPath, entry.Name(), "status"))
+		if err != nil {
+			continue
+		}
+		total += parseVmRSS(string(status))
+	}
+	return total, nil
+}
+
+func (m *ProcessMonitor) sync(ctx context.Context, usage *ProcessUsage) {
+	if !m.hasAlertRules() {
+		m.log.Debug("Skipping process memory usage sync: no alert rules")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultProcessSyncTimeout)
+	defer cancel()
+
+	if err := m.CollectUsage(ctx, usage); err != nil {
+		m.log.Errorf("Failed to collect process memory usage: %v", err)
+	}
+
+	m.ensureAlerts(usage.UsedPercent)
+}
+
+func (m *ProcessMonitor) ensureAlerts(percentageUsed int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.log.Tracef("Process memory usage: %d%%", percentageUsed)
+	for _, alert := range m.alerts {
+		alert.Sync(percentageUsed)
+	}
+}
+
+func (m *ProcessMonitor) hasAlertRules() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.alerts) > 0
+}
+
+func (m *ProcessMonitor) getSamplingInterval() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.samplingInterval
+}
+
+func (m *ProcessMonitor) getTarget() (string, string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.process, m.unit
+}
+
+// findUnitCgroup returns the path of the cgroup of the systemd unit, which is a directory named after the unit
+// in a slice of the cgroup v2 hierarchy, for example system.slice/podman.service.
+func findUnitCgroup(cgroupPath, unit string) (string, error) {
+	errFound := errors.New("found")
+	var found string
+	err := filepath.WalkDir(cgroupPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return nil
+		}
+		if d.Name() == unit {
+			found = path
+			return errFound
+		}
+		rel, err := filepath.Rel(cgroupPath, path)
+		if err == nil && rel != "." && strings.Count(rel, string(filepath.Separator)) >= maxCgroupSearchDepth-1 {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errFound) {
+		return "", err
+	}
+	if found == "" {
+		return "", fmt.Errorf("cgroup of unit %s not found", unit)
+	}
+	return found, nil
+}
+
+// parseVmRSS returns the resident memory in bytes from the contents of /proc/[pid]/status
+func parseVmRSS(status string) uint64 {
+	for _, line := range strings.Split(status, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "VmRSS:" {
+			continue
+		}
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0
+		}
+		return value * 1024
+	}
+	return 0
+}
+
+// ProcessUsage represents the memory used by a process or systemd unit of this device.
+type ProcessUsage struct {
+	Name        string
+	MemoryBytes uint64
+	MemTotal    uint64
+	UsedPercent int64
+
+	lastCollectedAt time.Time
+}
@@ -0,0 +1,92 @@
+package resource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+func writeProcess(t *testing.T, procPath, pid, comm, vmRSS string) {
+	t.Helper()
+	pidPath := filepath.Join(procPath, pid)
+	require.NoError(t, os.MkdirAll(pidPath, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(pidPath, "comm"), []byte(comm+"\n"), 0600))
+	status := "Name:\t" + comm + "\nVmPeak:\t  999999 kB\nVmRSS:\t" + vmRSS + " kB\nThreads:\t1\n"
+	require.NoError(t, os.WriteFile(filepath.Join(pidPath, "status"), []byte(status), 0600))
+}
+
+func TestProcessCollectUsage(t *testing.T) {
+	tmpDir := t.TempDir()
+	memInfoPath := filepath.Join(tmpDir, "meminfo")
+	// 1 GiB of memory
+	require.NoError(t, os.WriteFile(memInfoPath, []byte("MemTotal:        1048576 kB\nMemFree:          524288 kB\n"), 0600))
+
+	procPath := filepath.Join(tmpDir, "proc")
+	writeProcess(t, procPath, "100", "microshift", "262144")
+	writeProcess(t, procPath, "200", "microshift", "104858")
+	writeProcess(t, procPath, "300", "podman", "1024")
+	require.NoError(t, os.MkdirAll(filepath.Join(procPath, "self"), 0755))
+
+	cgroupPath := filepath.Join(tmpDir, "cgroup")
+	unitPath := filepath.Join(cgroupPath, "system.slice", "podman.service")
+	require.NoError(t, os.MkdirAll(unitPath, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(unitPath, "memory.current"), []byte("536870912\n"), 0600))
+
+	tests := []struct {
+		name         string
+		process      string
+		unit         string
+		expectedUsed int64
+		wantErr      bool
+	}{
+		{
+			name:         "sum of processes with the same name",
+			process:      "microshift",
+			expectedUsed: 35,
+		},
+		{
+			name:         "systemd unit",
+			unit:         "podman.service",
+			expectedUsed: 50,
+		},
+		{
+			name:         "process not running",
+			process:      "greenboot",
+			expectedUsed: 0,
+		},
+		{
+			name:    "unknown systemd unit",
+			unit:    "greenboot.service",
+			wantErr: true,
+		},
+		{
+			name:    "nothing to monitor",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			monitor := NewProcessMonitor(log.NewPrefixLogger("test"))
+			monitor.procPath = procPath
+			monitor.cgroupPath = cgroupPath
+			monitor.memInfoPath = memInfoPath
+			monitor.process = tt.process
+			monitor.unit = tt.unit
+
+			usage := ProcessUsage{}
+			err := monitor.CollectUsage(context.Background(), &usage)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expectedUsed, usage.UsedPercent)
+		})
+	}
+}
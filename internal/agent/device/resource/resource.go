@@ -17,9 +17,12 @@ import (
 type MonitorType string
 
 const (
-	CPUMonitorType    = "CPU"
-	DiskMonitorType   = "Disk"
-	MemoryMonitorType = "Memory"
+	CPUMonitorType         = "CPU"
+	DiskMonitorType        = "Disk"
+	MemoryMonitorType      = "Memory"
+	TemperatureMonitorType = "Temperature"
+	NetworkMonitorType     = "Network"
+	ProcessMonitorType     = "Process"
 
 	DefaultSamplingInterval = 1 * time.Minute
 )
@@ -44,10 +47,13 @@ type Collector[T any] interface {
 }
 
 type ResourceManager struct {
-	cpuMonitor    Monitor[CPUUsage]
-	diskMonitor   Monitor[DiskUsage]
-	memoryMonitor Monitor[MemoryUsage]
-	log           *log.PrefixLogger
+	cpuMonitor         Monitor[CPUUsage]
+	diskMonitor        Monitor[DiskUsage]
+	memoryMonitor      Monitor[MemoryUsage]
+	temperatureMonitor Monitor[TemperatureUsage]
+	networkMonitor     Monitor[NetworkUsage]
+	processMonitor     Monitor[ProcessUsage]
+	log                *log.PrefixLogger
 }
 
 // NewManager creates a new resource Manager.
@@ -55,10 +61,13 @@ func NewManager(
 	log *log.PrefixLogger,
 ) Manager {
 	return &ResourceManager{
-		cpuMonitor:    NewCPUMonitor(log),
-		diskMonitor:   NewDiskMonitor(log),
-		memoryMonitor: NewMemoryMonitor(log),
-		log:           log,
+		cpuMonitor:         NewCPUMonitor(log),
+		diskMonitor:        NewDiskMonitor(log),
+		memoryMonitor:      NewMemoryMonitor(log),
+		temperatureMonitor: NewTemperatureMonitor(log),
+		networkMonitor:     NewNetworkMonitor(log),
+		processMonitor:     NewProcessMonitor(log),
+		log:                log,
 	}
 }
 
@@ -69,6 +78,9 @@ func (m *ResourceManager) Run(ctx context.Context) {
 	go m.diskMonitor.Run(ctx)
 	go m.cpuMonitor.Run(ctx)
 	go m.memoryMonitor.Run(ctx)
+	go m.temperatureMonitor.Run(ctx)
+	go m.networkMonitor.Run(ctx)
+	go m.processMonitor.Run(ctx)
 
 	<-ctx.Done()
 }
@@ -86,6 +98,12 @@ func (m *ResourceManager) Update(monitor *v1alpha1.ResourceMonitor) (bool, error
 		return m.diskMonitor.Update(monitor)
 	case MemoryMonitorType:
 		return m.memoryMonitor.Update(monitor)
+	case TemperatureMonitorType:
+		return m.temperatureMonitor.Update(monitor)
+	case NetworkMonitorType:
+		return m.networkMonitor.Update(monitor)
+	case ProcessMonitorType:
+		return m.processMonitor.Update(monitor)
 	default:
 		return false, fmt.Errorf("unknown monitor type: %s", monitorType)
 	}
@@ -133,6 +151,45 @@ func (m *ResourceManager) ResetAlertDefaults() error {
 		m.log.Debug("Reset memory monitor alerts")
 	}
 
+	// temperature
+	temperatureMonitor, err := defaultTemperatureResourceMonitor()
+	if err != nil {
+		errs = append(errs, err)
+	}
+	updated, err = m.temperatureMonitor.Update(temperatureMonitor)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if updated {
+		m.log.Debug("Reset temperature monitor alerts")
+	}
+
+	// network
+	networkMonitor, err := defaultNetworkResourceMonitor()
+	if err != nil {
+		errs = append(errs, err)
+	}
+	updated, err = m.networkMonitor.Update(networkMonitor)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if updated {
+		m.log.Debug("Reset network monitor alerts")
+	}
+
+	// process
+	processMonitor, err := defaultProcessResourceMonitor()
+	if err != nil {
+		errs = append(errs, err)
+	}
+	updated, err = m.processMonitor.Update(processMonitor)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if updated {
+		m.log.Debug("Reset process monitor alerts")
+	}
+
 	if len(errs) > 0 {
 		return errors.Join(errs...)
 	}
@@ -172,6 +229,24 @@ func (m *ResourceManager) Status(ctx context.Context, status *v1alpha1.DeviceSta
 				status.Resources.Memory = resourceStatus
 			},
 		},
+		TemperatureMonitorType: {
+			alerts: alerts.Temperature,
+			setStatusFn: func(resourceStatus v1alpha1.DeviceResourceStatusType) {
+				status.Resources.Temperature = lo.ToPtr(resourceStatus)
+			},
+		},
+		NetworkMonitorType: {
+			alerts: alerts.NetworkUsage,
+			setStatusFn: func(resourceStatus v1alpha1.DeviceResourceStatusType) {
+				status.Resources.Network = lo.ToPtr(resourceStatus)
+			},
+		},
+		ProcessMonitorType: {
+			alerts: alerts.ProcessUsage,
+			setStatusFn: func(resourceStatus v1alpha1.DeviceResourceStatusType) {
+				status.Resources.Process = lo.ToPtr(resourceStatus)
+			},
+		},
 	}
 
 	// set the status for each monitor type
@@ -210,16 +285,22 @@ func (m *ResourceManager) Status(ctx context.Context, status *v1alpha1.DeviceSta
 
 func (m *ResourceManager) Alerts() *Alerts {
 	return &Alerts{
-		DiskUsage:   m.diskMonitor.Alerts(),
-		CPUUsage:    m.cpuMonitor.Alerts(),
-		MemoryUsage: m.memoryMonitor.Alerts(),
+		DiskUsage:    m.diskMonitor.Alerts(),
+		CPUUsage:     m.cpuMonitor.Alerts(),
+		MemoryUsage:  m.memoryMonitor.Alerts(),
+		Temperature:  m.temperatureMonitor.Alerts(),
+		NetworkUsage: m.networkMonitor.Alerts(),
+		ProcessUsage: m.processMonitor.Alerts(),
 	}
 }
 
 type Alerts struct {
-	DiskUsage   []v1alpha1.ResourceAlertRule
-	CPUUsage    []v1alpha1.ResourceAlertRule
-	MemoryUsage []v1alpha1.ResourceAlertRule
+	DiskUsage    []v1alpha1.ResourceAlertRule
+	CPUUsage     []v1alpha1.ResourceAlertRule
+	MemoryUsage  []v1alpha1.ResourceAlertRule
+	Temperature  []v1alpha1.ResourceAlertRule
+	NetworkUsage []v1alpha1.ResourceAlertRule
+	ProcessUsage []v1alpha1.ResourceAlertRule
 }
 
 type Alert struct {
@@ -309,6 +390,18 @@ type MonitorSpec struct {
 
 	// Path is the absolute path used for the disk monitor.
 	Path string `json:"path,omitempty"`
+	// Zone is the thermal zone type used for the temperature monitor.
+	Zone string `json:"zone,omitempty"`
+	// MaxTemperature is the temperature in degrees Celsius corresponding to 100 percent used for the temperature monitor.
+	MaxTemperature int `json:"maxTemperature,omitempty"`
+	// Interface is the network interface used for the network monitor.
+	Interface string `json:"interface,omitempty"`
+	// Metric is the metric used for the network monitor.
+	Metric v1alpha1.NetworkMonitorMetricType `json:"metric,omitempty"`
+	// Process is the process name used for the process monitor.
+	Process string `json:"process,omitempty"`
+	// Unit is the systemd unit used for the process monitor.
+	Unit string `json:"unit,omitempty"`
 }
 
 // getHighestSeverityResourceStatusFromAlerts returns the highest severity statusDeviceResourceStatusType from a list of alerts along with the alert message.
@@ -410,3 +503,53 @@ func defaultMemoryResourceMonitor() (*v1alpha1.ResourceMonitor, error) {
 	err := rm.FromMemoryResourceMonitorSpec(spec)
 	return rm, err
 }
+
+func defaultTemperatureResourceMonitor() (*v1alpha1.ResourceMonitor, error) {
+	spec := v1alpha1.TemperatureResourceMonitorSpec{
+		SamplingInterval: DefaultSamplingInterval.String(),
+		MonitorType:      TemperatureMonitorType,
+		AlertRules: []v1alpha1.ResourceAlertRule{
+			{
+				Severity:    v1alpha1.ResourceAlertSeverityTypeCritical,
+				Percentage:  90,
+				Duration:    "5m",
+				Description: "", // use generated description
+			},
+			{
+				Severity:    v1alpha1.ResourceAlertSeverityTypeWarning,
+				Percentage:  80,
+				Duration:    "15m",
+				Description: "", // use generated description
+			},
+		},
+	}
+	rm := &v1alpha1.ResourceMonitor{}
+	err := rm.FromTemperatureResourceMonitorSpec(spec)
+	return rm, err
+}
+
+// defaultNetworkResourceMonitor returns a network monitor without alert rules, since a sensible threshold
+// depends on the device's workload.
+func defaultNetworkResourceMonitor() (*v1alpha1.ResourceMonitor, error) {
+	spec := v1alpha1.NetworkResourceMonitorSpec{
+		SamplingInterval: DefaultSamplingInterval.String(),
+		MonitorType:      NetworkMonitorType,
+		AlertRules:       []v1alpha1.ResourceAlertRule{},
+	}
+	rm := &v1alpha1.ResourceMonitor{}
+	err := rm.FromNetworkResourceMonitorSpec(spec)
+	return rm, err
+}
+
+// defaultProcessResourceMonitor returns a process monitor without alert rules, since there is no process to
+// monitor by default.
+func defaultProcessResourceMonitor() (*v1alpha1.ResourceMonitor, error) {
+	spec := v1alpha1.ProcessResourceMonitorSpec{
+		SamplingInterval: DefaultSamplingInterval.String(),
+		MonitorType:      ProcessMonitorType,
+		AlertRules:       []v1alpha1.ResourceAlertRule{},
+	}
+	rm := &v1alpha1.ResourceMonitor{}
+	err := rm.FromProcessResourceMonitorSpec(spec)
+	return rm, err
+}
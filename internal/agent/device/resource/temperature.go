@@ -0,0 +1,248 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	DefaultTemperatureSyncTimeout = 5 * time.Second
+	DefaultThermalPath            = "/sys/class/thermal"
+	// DefaultMaxTemperature is the temperature in degrees Celsius that corresponds to 100 percent usage
+	// if the thermal zone has no critical trip point.
+	DefaultMaxTemperature = 100
+)
+
+var _ Monitor[TemperatureUsage] = (*TemperatureMonitor)(nil)
+
+type TemperatureMonitor struct {
+	mu          sync.Mutex
+	alerts      map[v1alpha1.ResourceAlertSeverityType]*Alert
+	thermalPath string
+	// zone is the type of the thermal zone to monitor, all zones are monitored if empty
+	zone string
+	// maxTemperature overrides the critical trip point of the thermal zones if set
+	maxTemperature int
+
+	updateIntervalCh chan time.Duration
+	samplingInterval time.Duration
+
+	log *log.PrefixLogger
+}
+
+func NewTemperatureMonitor(
+	log *log.PrefixLogger,
+) *TemperatureMonitor {
+	return &TemperatureMonitor{
+		alerts:           make(map[v1alpha1.ResourceAlertSeverityType]*Alert),
+		updateIntervalCh: make(chan time.Duration, 1),
+		samplingInterval: DefaultSamplingInterval,
+		thermalPath:      DefaultThermalPath,
+		log:              log,
+	}
+}
+
+func (m *TemperatureMonitor) Run(ctx context.Context) {
+	samplingInterval := m.getSamplingInterval()
+	ticker := time.NewTicker(samplingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case newInterval := <-m.updateIntervalCh:
+			ticker.Reset(newInterval)
+		case <-ticker.C:
+			m.log.Debug("Checking temperature")
+			usage := TemperatureUsage{}
+			m.sync(ctx, &usage)
+		}
+	}
+}
+
+func (m *TemperatureMonitor) Update(monitor *v1alpha1.ResourceMonitor) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	spec, err := getMonitorSpec(monitor)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := updateMonitor(m.log, monitor, &m.samplingInterval, m.alerts, m.updateIntervalCh)
+	if err != nil {
+		return updated, err
+	}
+
+	if spec.Zone != m.zone {
+		m.zone = spec.Zone
+		updated = true
+	}
+	if spec.MaxTemperature != m.maxTemperature {
+		m.maxTemperature = spec.MaxTemperature
+		updated = true
+	}
+
+	return updated, nil
+}
+
+func (m *TemperatureMonitor) Alerts() []v1alpha1.ResourceAlertRule {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var firing []v1alpha1.ResourceAlertRule
+	for _, alert := range m.alerts {
+		if alert.IsFiring() {
+			firing = append(firing, alert.ResourceAlertRule)
+		}
+	}
+	return firing
+}
+
+// CollectUsage sets the usage to the hottest of the monitored thermal zones, relative to its maximum temperature.
+func (m *TemperatureMonitor) CollectUsage(ctx context.Context, usage *TemperatureUsage) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+	}
+
+	zone, maxTemperature := m.getZone()
+	zonePaths, err := filepath.Glob(filepath.Join(m.thermalPath, "thermal_zone*"))
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, zonePath := range zonePaths {
+		zoneType, err := readSysfsString(filepath.Join(zonePath, "type"))
+		if err != nil || (zone != "" && zoneType != zone) {
+			continue
+		}
+		temperature, err := readSysfsInt(filepath.Join(zonePath, "temp"))
+		if err != nil {
+			m.log.Debugf("Failed to read temperature of thermal zone %s: %v", zoneType, err)
+			continue
+		}
+		maxMilliCelsius := int64(maxTemperature) * 1000
+		if maxMilliCelsius == 0 {
+			maxMilliCelsius = criticalTripPoint(zonePath)
+		}
+
+		percent := temperature * 100 / maxMilliCelsius
+		if !found || percent > usage.UsedPercent {
+			usage.Zone = zoneType
+			usage.Temperature = temperature
+			usage.MaxTemperature = maxMilliCelsius
+			usage.UsedPercent = percent
+		}
+		found = true
+	}
+
+	if !found && zone != "" {
+		return fmt.Errorf("thermal zone %s not found", zone)
+	}
+	usage.lastCollectedAt = time.Now()
+	return nil
+}
+
+func (m *TemperatureMonitor) sync(ctx context.Context, usage *TemperatureUsage) {
+	if !m.hasAlertRules() {
+		m.log.Debug("Skipping temperature sync: no alert rules")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultTemperatureSyncTimeout)
+	defer cancel()
+
+	if err := m.CollectUsage(ctx, usage); err != nil {
+		m.log.Errorf("Failed to collect temperature: %v", err)
+	}
+
+	m.ensureAlerts(usage.UsedPercent)
+}
+
+func (m *TemperatureMonitor) ensureAlerts(percentageUsed int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.log.Tracef("Temperature: %d%%", percentageUsed)
+	for _, alert := range m.alerts {
+		alert.Sync(percentageUsed)
+	}
+}
+
+func (m *TemperatureMonitor) hasAlertRules() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.alerts) > 0
+}
+
+func (m *TemperatureMonitor) getSamplingInterval() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.samplingInterval
+}
+
+func (m *TemperatureMonitor) getZone() (string, int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.zone, m.maxTemperature
+}
+
+// criticalTripPoint returns the critical trip point of the thermal zone in millidegrees Celsius, or the
+// default maximum temperature if the zone has none.
+func criticalTripPoint(zonePath string) int64 {
+	tripTypes, err := filepath.Glob(filepath.Join(zonePath, "trip_point_*_type"))
+	if err != nil {
+		return DefaultMaxTemperature * 1000
+	}
+	for _, tripType := range tripTypes {
+		value, err := readSysfsString(tripType)
+		if err != nil || value != "critical" {
+			continue
+		}
+		temperature, err := readSysfsInt(strings.TrimSuffix(tripType, "_type") + "_temp")
+		if err == nil && temperature > 0 {
+			return temperature
+		}
+	}
+	return DefaultMaxTemperature * 1000
+}
+
+func readSysfsString(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+func readSysfsInt(path string) (int64, error) {
+	value, err := readSysfsString(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
+
+// TemperatureUsage represents the temperature of the hottest monitored thermal zone of this device.
+type TemperatureUsage struct {
+	Zone string
+	// Temperature is the temperature of the zone in millidegrees Celsius
+	Temperature int64
+	// MaxTemperature is the temperature in millidegrees Celsius that corresponds to 100 percent usage
+	MaxTemperature int64
+	UsedPercent    int64
+
+	lastCollectedAt time.Time
+}
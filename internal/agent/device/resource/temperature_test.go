@@ -0,0 +1,137 @@
+package resource
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func writeThermalZone(t *testing.T, thermalPath, name, zoneType, temp string, trips map[string]string) {
+	t.Helper()
+	zonePath := filepath.Join(thermalPath, name)
+	require.NoError(t, os.MkdirAll(zonePath, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(zonePath, "type"), []byte(zoneType+"\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(zonePath, "temp"), []byte(temp+"\n"), 0600))
+	i := 0
+	for tripType, tripTemp := range trips {
+		require.NoError(t, os.WriteFile(filepath.Join(zonePath, "trip_point_"+strconv.Itoa(i)+"_type"), []byte(tripType+"\n"), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(zonePath, "trip_point_"+strconv.Itoa(i)+"_temp"), []byte(tripTemp+"\n"), 0600))
+		i++
+	}
+}
+
+func TestTemperatureCollectUsage(t *testing.T) {
+	thermalPath := t.TempDir()
+	// 45C with critical trip point at 90C
+	writeThermalZone(t, thermalPath, "thermal_zone0", "acpitz", "45000", map[string]string{"critical": "90000"})
+	// 85C with critical trip point at 100C
+	writeThermalZone(t, thermalPath, "thermal_zone1", "x86_pkg_temp", "85000", map[string]string{"passive": "80000", "critical": "100000"})
+	// 60C without critical trip point
+	writeThermalZone(t, thermalPath, "thermal_zone2", "pch_cannonlake", "60000", nil)
+
+	tests := []struct {
+		name           string
+		zone           string
+		maxTemperature int
+		expectedZone   string
+		expectedUsed   int64
+		wantErr        bool
+	}{
+		{
+			name:         "hottest zone relative to its critical trip point",
+			expectedZone: "x86_pkg_temp",
+			expectedUsed: 85,
+		},
+		{
+			name:         "selected zone",
+			zone:         "acpitz",
+			expectedZone: "acpitz",
+			expectedUsed: 50,
+		},
+		{
+			name:         "zone without critical trip point uses the default maximum",
+			zone:         "pch_cannonlake",
+			expectedZone: "pch_cannonlake",
+			expectedUsed: 60,
+		},
+		{
+			name:           "max temperature overrides the trip points",
+			maxTemperature: 75,
+			expectedZone:   "x86_pkg_temp",
+			expectedUsed:   113,
+		},
+		{
+			name:    "unknown zone",
+			zone:    "gpu",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			monitor := NewTemperatureMonitor(log.NewPrefixLogger("test"))
+			monitor.thermalPath = thermalPath
+			monitor.zone = tt.zone
+			monitor.maxTemperature = tt.maxTemperature
+
+			usage := TemperatureUsage{}
+			err := monitor.CollectUsage(context.Background(), &usage)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+			require.Equal(tt.expectedZone, usage.Zone)
+			require.Equal(tt.expectedUsed, usage.UsedPercent)
+		})
+	}
+}
+
+func TestTemperatureMonitor(t *testing.T) {
+	require := require.New(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	thermalPath := t.TempDir()
+	writeThermalZone(t, thermalPath, "thermal_zone0", "x86_pkg_temp", "95000", map[string]string{"critical": "100000"})
+
+	monitor := NewTemperatureMonitor(log.NewPrefixLogger("test"))
+	monitor.thermalPath = thermalPath
+	go monitor.Run(ctx)
+
+	rm := &v1alpha1.ResourceMonitor{}
+	err := rm.FromTemperatureResourceMonitorSpec(v1alpha1.TemperatureResourceMonitorSpec{
+		SamplingInterval: (100 * time.Millisecond).String(),
+		MonitorType:      TemperatureMonitorType,
+		Zone:             lo.ToPtr("x86_pkg_temp"),
+		AlertRules: []v1alpha1.ResourceAlertRule{
+			{
+				Severity:   v1alpha1.ResourceAlertSeverityTypeCritical,
+				Percentage: 90,
+				Duration:   "90ms",
+			},
+		},
+	})
+	require.NoError(err)
+
+	updated, err := monitor.Update(rm)
+	require.NoError(err)
+	require.True(updated)
+
+	require.Eventually(func() bool {
+		return len(monitor.Alerts()) == 1
+	}, retryTimeout, retryInterval, "alert add")
+
+	status, alertMsg := getHighestSeverityResourceStatusFromAlerts(TemperatureMonitorType, monitor.Alerts())
+	require.Equal(v1alpha1.DeviceResourceStatusCritical, status)
+	require.NotEmpty(alertMsg)
+}
@@ -247,6 +247,15 @@ func getListEventsParams(newerThan string) api.ListEventsParams {
 		api.EventReasonDeviceDiskCritical,
 		api.EventReasonDeviceDiskNormal,
 		api.EventReasonDeviceDiskWarning,
+		api.EventReasonDeviceTemperatureCritical,
+		api.EventReasonDeviceTemperatureNormal,
+		api.EventReasonDeviceTemperatureWarning,
+		api.EventReasonDeviceNetworkCritical,
+		api.EventReasonDeviceNetworkNormal,
+		api.EventReasonDeviceNetworkWarning,
+		api.EventReasonDeviceProcessCritical,
+		api.EventReasonDeviceProcessNormal,
+		api.EventReasonDeviceProcessWarning,
 		api.EventReasonResourceDeleted,
 		api.EventReasonDeviceDecommissioned,
 	}
@@ -270,10 +279,13 @@ func getListEventsParams(newerThan string) api.ListEventsParams {
 }
 
 var (
-	appStatusGroup = []string{string(api.EventReasonDeviceApplicationError), string(api.EventReasonDeviceApplicationDegraded)}
-	cpuGroup       = []string{string(api.EventReasonDeviceCPUCritical), string(api.EventReasonDeviceCPUWarning)}
-	memoryGroup    = []string{string(api.EventReasonDeviceMemoryCritical), string(api.EventReasonDeviceMemoryWarning)}
-	diskGroup      = []string{string(api.EventReasonDeviceDiskCritical), string(api.EventReasonDeviceDiskWarning)}
+	appStatusGroup   = []string{string(api.EventReasonDeviceApplicationError), string(api.EventReasonDeviceApplicationDegraded)}
+	cpuGroup         = []string{string(api.EventReasonDeviceCPUCritical), string(api.EventReasonDeviceCPUWarning)}
+	memoryGroup      = []string{string(api.EventReasonDeviceMemoryCritical), string(api.EventReasonDeviceMemoryWarning)}
+	diskGroup        = []string{string(api.EventReasonDeviceDiskCritical), string(api.EventReasonDeviceDiskWarning)}
+	temperatureGroup = []string{string(api.EventReasonDeviceTemperatureCritical), string(api.EventReasonDeviceTemperatureWarning)}
+	networkGroup     = []string{string(api.EventReasonDeviceNetworkCritical), string(api.EventReasonDeviceNetworkWarning)}
+	processGroup     = []string{string(api.EventReasonDeviceProcessCritical), string(api.EventReasonDeviceProcessWarning)}
 )
 
 func (c *CheckpointContext) processEvent(event api.Event, orgID uuid.UUID) {
@@ -308,6 +320,27 @@ func (c *CheckpointContext) processEvent(event api.Event, orgID uuid.UUID) {
 		c.setAlert(event, string(api.EventReasonDeviceDiskWarning), diskGroup, orgID)
 	case api.EventReasonDeviceDiskNormal:
 		c.clearAlertGroup(event, diskGroup, orgID)
+	// Temperature
+	case api.EventReasonDeviceTemperatureCritical:
+		c.setAlert(event, string(api.EventReasonDeviceTemperatureCritical), temperatureGroup, orgID)
+	case api.EventReasonDeviceTemperatureWarning:
+		c.setAlert(event, string(api.EventReasonDeviceTemperatureWarning), temperatureGroup, orgID)
+	case api.EventReasonDeviceTemperatureNormal:
+		c.clearAlertGroup(event, temperatureGroup, orgID)
+	// Network
+	case api.EventReasonDeviceNetworkCritical:
+		c.setAlert(event, string(api.EventReasonDeviceNetworkCritical), networkGroup, orgID)
+	case api.EventReasonDeviceNetworkWarning:
+		c.setAlert(event, string(api.EventReasonDeviceNetworkWarning), networkGroup, orgID)
+	case api.EventReasonDeviceNetworkNormal:
+		c.clearAlertGroup(event, networkGroup, orgID)
+	// Process
+	case api.EventReasonDeviceProcessCritical:
+		c.setAlert(event, string(api.EventReasonDeviceProcessCritical), processGroup, orgID)
+	case api.EventReasonDeviceProcessWarning:
+		c.setAlert(event, string(api.EventReasonDeviceProcessWarning), processGroup, orgID)
+	case api.EventReasonDeviceProcessNormal:
+		c.clearAlertGroup(event, processGroup, orgID)
 	// Device connection status
 	case api.EventReasonDeviceDisconnected:
 		c.setAlert(event, string(api.EventReasonDeviceDisconnected), nil, orgID)
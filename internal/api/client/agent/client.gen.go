@@ -99,6 +99,14 @@ type ClientInterface interface {
 	// GetCertificateSigningRequest request
 	GetCertificateSigningRequest(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CreateDeviceAttestationWithBody request with any body
+	CreateDeviceAttestationWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateDeviceAttestation(ctx context.Context, name string, body CreateDeviceAttestationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateDeviceAttestationChallenge request
+	CreateDeviceAttestationChallenge(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetRenderedDevice request
 	GetRenderedDevice(ctx context.Context, name string, params *GetRenderedDeviceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -157,6 +165,42 @@ func (c *Client) GetCertificateSigningRequest(ctx context.Context, name string,
 	return c.Client.Do(req)
 }
 
+func (c *Client) CreateDeviceAttestationWithBody(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDeviceAttestationRequestWithBody(c.Server, name, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDeviceAttestation(ctx context.Context, name string, body CreateDeviceAttestationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDeviceAttestationRequest(c.Server, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateDeviceAttestationChallenge(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateDeviceAttestationChallengeRequest(c.Server, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetRenderedDevice(ctx context.Context, name string, params *GetRenderedDeviceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetRenderedDeviceRequest(c.Server, name, params)
 	if err != nil {
@@ -327,6 +371,87 @@ func NewGetCertificateSigningRequestRequest(server string, name string) (*http.R
 	return req, nil
 }
 
+// NewCreateDeviceAttestationRequest calls the generic CreateDeviceAttestation builder with application/json body
+func NewCreateDeviceAttestationRequest(server string, name string, body CreateDeviceAttestationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateDeviceAttestationRequestWithBody(server, name, "application/json", bodyReader)
+}
+
+// NewCreateDeviceAttestationRequestWithBody generates requests for CreateDeviceAttestation with any type of body
+func NewCreateDeviceAttestationRequestWithBody(server string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/devices/%s/attestation", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewCreateDeviceAttestationChallengeRequest generates requests for CreateDeviceAttestationChallenge
+func NewCreateDeviceAttestationChallengeRequest(server string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/api/v1/devices/%s/attestationchallenge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetRenderedDeviceRequest generates requests for GetRenderedDevice
 func NewGetRenderedDeviceRequest(server string, name string, params *GetRenderedDeviceParams) (*http.Request, error) {
 	var err error
@@ -602,6 +727,14 @@ type ClientWithResponsesInterface interface {
 	// GetCertificateSigningRequestWithResponse request
 	GetCertificateSigningRequestWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*GetCertificateSigningRequestResponse, error)
 
+	// CreateDeviceAttestationWithBodyWithResponse request with any body
+	CreateDeviceAttestationWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDeviceAttestationResponse, error)
+
+	CreateDeviceAttestationWithResponse(ctx context.Context, name string, body CreateDeviceAttestationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDeviceAttestationResponse, error)
+
+	// CreateDeviceAttestationChallengeWithResponse request
+	CreateDeviceAttestationChallengeWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*CreateDeviceAttestationChallengeResponse, error)
+
 	// GetRenderedDeviceWithResponse request
 	GetRenderedDeviceWithResponse(ctx context.Context, name string, params *GetRenderedDeviceParams, reqEditors ...RequestEditorFn) (*GetRenderedDeviceResponse, error)
 
@@ -679,6 +812,57 @@ func (r GetCertificateSigningRequestResponse) StatusCode() int {
 	return 0
 }
 
+type CreateDeviceAttestationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *externalRef0.DeviceIntegrityStatus
+	JSON400      *externalRef0.Status
+	JSON401      *externalRef0.Status
+	JSON404      *externalRef0.Status
+	JSON429      *externalRef0.Status
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDeviceAttestationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDeviceAttestationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateDeviceAttestationChallengeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *externalRef0.DeviceAttestationChallenge
+	JSON401      *externalRef0.Status
+	JSON404      *externalRef0.Status
+	JSON429      *externalRef0.Status
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateDeviceAttestationChallengeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateDeviceAttestationChallengeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetRenderedDeviceResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
@@ -840,6 +1024,32 @@ func (c *ClientWithResponses) GetCertificateSigningRequestWithResponse(ctx conte
 	return ParseGetCertificateSigningRequestResponse(rsp)
 }
 
+// CreateDeviceAttestationWithBodyWithResponse request with arbitrary body returning *CreateDeviceAttestationResponse
+func (c *ClientWithResponses) CreateDeviceAttestationWithBodyWithResponse(ctx context.Context, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateDeviceAttestationResponse, error) {
+	rsp, err := c.CreateDeviceAttestationWithBody(ctx, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDeviceAttestationResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateDeviceAttestationWithResponse(ctx context.Context, name string, body CreateDeviceAttestationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateDeviceAttestationResponse, error) {
+	rsp, err := c.CreateDeviceAttestation(ctx, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDeviceAttestationResponse(rsp)
+}
+
+// CreateDeviceAttestationChallengeWithResponse request returning *CreateDeviceAttestationChallengeResponse
+func (c *ClientWithResponses) CreateDeviceAttestationChallengeWithResponse(ctx context.Context, name string, reqEditors ...RequestEditorFn) (*CreateDeviceAttestationChallengeResponse, error) {
+	rsp, err := c.CreateDeviceAttestationChallenge(ctx, name, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateDeviceAttestationChallengeResponse(rsp)
+}
+
 // GetRenderedDeviceWithResponse request returning *GetRenderedDeviceResponse
 func (c *ClientWithResponses) GetRenderedDeviceWithResponse(ctx context.Context, name string, params *GetRenderedDeviceParams, reqEditors ...RequestEditorFn) (*GetRenderedDeviceResponse, error) {
 	rsp, err := c.GetRenderedDevice(ctx, name, params, reqEditors...)
@@ -1038,6 +1248,107 @@ func ParseGetCertificateSigningRequestResponse(rsp *http.Response) (*GetCertific
 	return response, nil
 }
 
+// ParseCreateDeviceAttestationResponse parses an HTTP response from a CreateDeviceAttestationWithResponse call
+func ParseCreateDeviceAttestationResponse(rsp *http.Response) (*CreateDeviceAttestationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDeviceAttestationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest externalRef0.DeviceIntegrityStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest externalRef0.Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest externalRef0.Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateDeviceAttestationChallengeResponse parses an HTTP response from a CreateDeviceAttestationChallengeWithResponse call
+func ParseCreateDeviceAttestationChallengeResponse(rsp *http.Response) (*CreateDeviceAttestationChallengeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateDeviceAttestationChallengeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest externalRef0.DeviceAttestationChallenge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest externalRef0.Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest externalRef0.Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest externalRef0.Status
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseGetRenderedDeviceResponse parses an HTTP response from a GetRenderedDeviceWithResponse call
 func ParseGetRenderedDeviceResponse(rsp *http.Response) (*GetRenderedDeviceResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -28,6 +28,31 @@ var APIMetadataMap = map[string]EndpointMetadata{
 		Resource:    "",
 		Action:      "",
 	},
+	"GET:/api/v1/bulkoperations": {
+		OperationID: "listBulkOperations",
+		Resource:    "",
+		Action:      "",
+	},
+	"POST:/api/v1/bulkoperations": {
+		OperationID: "createBulkOperation",
+		Resource:    "",
+		Action:      "",
+	},
+	"DELETE:/api/v1/bulkoperations/{name}": {
+		OperationID: "deleteBulkOperation",
+		Resource:    "",
+		Action:      "",
+	},
+	"GET:/api/v1/bulkoperations/{name}": {
+		OperationID: "getBulkOperation",
+		Resource:    "",
+		Action:      "",
+	},
+	"POST:/api/v1/bulkoperations/{name}/cancel": {
+		OperationID: "cancelBulkOperation",
+		Resource:    "bulkoperations/cancel",
+		Action:      "update",
+	},
 	"GET:/api/v1/certificatesigningrequests": {
 		OperationID: "listCertificateSigningRequests",
 		Resource:    "",
@@ -63,6 +88,21 @@ var APIMetadataMap = map[string]EndpointMetadata{
 		Resource:    "",
 		Action:      "",
 	},
+	"GET:/api/v1/consolesessions": {
+		OperationID: "listConsoleSessions",
+		Resource:    "",
+		Action:      "",
+	},
+	"GET:/api/v1/consolesessions/{name}": {
+		OperationID: "getConsoleSession",
+		Resource:    "",
+		Action:      "",
+	},
+	"GET:/api/v1/consolesessions/{name}/recording": {
+		OperationID: "getConsoleSessionRecording",
+		Resource:    "consolesessions/recording",
+		Action:      "get",
+	},
 	"POST:/api/v1/deviceactions/resume": {
 		OperationID: "resumeDevices",
 		Resource:    "devices/resume",
@@ -128,11 +168,46 @@ var APIMetadataMap = map[string]EndpointMetadata{
 		Resource:    "",
 		Action:      "",
 	},
+	"GET:/api/v1/devicesearch": {
+		OperationID: "searchDevices",
+		Resource:    "devices",
+		Action:      "list",
+	},
 	"GET:/api/v1/enrollmentconfig": {
 		OperationID: "getEnrollmentConfig",
 		Resource:    "",
 		Action:      "",
 	},
+	"GET:/api/v1/enrollmentpolicies": {
+		OperationID: "listEnrollmentPolicies",
+		Resource:    "",
+		Action:      "",
+	},
+	"POST:/api/v1/enrollmentpolicies": {
+		OperationID: "createEnrollmentPolicy",
+		Resource:    "",
+		Action:      "",
+	},
+	"DELETE:/api/v1/enrollmentpolicies/{name}": {
+		OperationID: "deleteEnrollmentPolicy",
+		Resource:    "",
+		Action:      "",
+	},
+	"GET:/api/v1/enrollmentpolicies/{name}": {
+		OperationID: "getEnrollmentPolicy",
+		Resource:    "",
+		Action:      "",
+	},
+	"PATCH:/api/v1/enrollmentpolicies/{name}": {
+		OperationID: "patchEnrollmentPolicy",
+		Resource:    "",
+		Action:      "",
+	},
+	"PUT:/api/v1/enrollmentpolicies/{name}": {
+		OperationID: "replaceEnrollmentPolicy",
+		Resource:    "",
+		Action:      "",
+	},
 	"GET:/api/v1/enrollmentrequests": {
 		OperationID: "listEnrollmentRequests",
 		Resource:    "",
@@ -188,6 +263,36 @@ var APIMetadataMap = map[string]EndpointMetadata{
 		Resource:    "",
 		Action:      "",
 	},
+	"GET:/api/v1/eventsubscriptions": {
+		OperationID: "listEventSubscriptions",
+		Resource:    "",
+		Action:      "",
+	},
+	"POST:/api/v1/eventsubscriptions": {
+		OperationID: "createEventSubscription",
+		Resource:    "",
+		Action:      "",
+	},
+	"DELETE:/api/v1/eventsubscriptions/{name}": {
+		OperationID: "deleteEventSubscription",
+		Resource:    "",
+		Action:      "",
+	},
+	"GET:/api/v1/eventsubscriptions/{name}": {
+		OperationID: "getEventSubscription",
+		Resource:    "",
+		Action:      "",
+	},
+	"PATCH:/api/v1/eventsubscriptions/{name}": {
+		OperationID: "patchEventSubscription",
+		Resource:    "",
+		Action:      "",
+	},
+	"PUT:/api/v1/eventsubscriptions/{name}": {
+		OperationID: "replaceEventSubscription",
+		Resource:    "",
+		Action:      "",
+	},
 	"GET:/api/v1/fleets": {
 		OperationID: "listFleets",
 		Resource:    "",
@@ -233,6 +338,26 @@ var APIMetadataMap = map[string]EndpointMetadata{
 		Resource:    "",
 		Action:      "",
 	},
+	"POST:/api/v1/fleets/{name}/preview": {
+		OperationID: "previewFleet",
+		Resource:    "fleets/preview",
+		Action:      "get",
+	},
+	"POST:/api/v1/fleets/{name}/rollout/abort": {
+		OperationID: "abortFleetRollout",
+		Resource:    "fleets/rollout",
+		Action:      "update",
+	},
+	"POST:/api/v1/fleets/{name}/rollout/pause": {
+		OperationID: "pauseFleetRollout",
+		Resource:    "fleets/rollout",
+		Action:      "update",
+	},
+	"POST:/api/v1/fleets/{name}/rollout/resume": {
+		OperationID: "resumeFleetRollout",
+		Resource:    "fleets/rollout",
+		Action:      "update",
+	},
 	"GET:/api/v1/fleets/{name}/status": {
 		OperationID: "getFleetStatus",
 		Resource:    "",
@@ -21,11 +21,26 @@ type ServerInterface interface {
 	// (GET /api/v1/auth/validate)
 	AuthValidate(w http.ResponseWriter, r *http.Request, params AuthValidateParams)
 
+	// (GET /api/v1/bulkoperations)
+	ListBulkOperations(w http.ResponseWriter, r *http.Request, params ListBulkOperationsParams)
+
+	// (POST /api/v1/bulkoperations)
+	CreateBulkOperation(w http.ResponseWriter, r *http.Request, params CreateBulkOperationParams)
+
+	// (DELETE /api/v1/bulkoperations/{name})
+	DeleteBulkOperation(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /api/v1/bulkoperations/{name})
+	GetBulkOperation(w http.ResponseWriter, r *http.Request, name string)
+
+	// (POST /api/v1/bulkoperations/{name}/cancel)
+	CancelBulkOperation(w http.ResponseWriter, r *http.Request, name string)
+
 	// (GET /api/v1/certificatesigningrequests)
 	ListCertificateSigningRequests(w http.ResponseWriter, r *http.Request, params ListCertificateSigningRequestsParams)
 
 	// (POST /api/v1/certificatesigningrequests)
-	CreateCertificateSigningRequest(w http.ResponseWriter, r *http.Request)
+	CreateCertificateSigningRequest(w http.ResponseWriter, r *http.Request, params CreateCertificateSigningRequestParams)
 
 	// (DELETE /api/v1/certificatesigningrequests/{name})
 	DeleteCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string)
@@ -34,14 +49,23 @@ type ServerInterface interface {
 	GetCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string)
 
 	// (PATCH /api/v1/certificatesigningrequests/{name})
-	PatchCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string)
+	PatchCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string, params PatchCertificateSigningRequestParams)
 
 	// (PUT /api/v1/certificatesigningrequests/{name})
-	ReplaceCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string)
+	ReplaceCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string, params ReplaceCertificateSigningRequestParams)
 
 	// (PUT /api/v1/certificatesigningrequests/{name}/approval)
 	UpdateCertificateSigningRequestApproval(w http.ResponseWriter, r *http.Request, name string)
 
+	// (GET /api/v1/consolesessions)
+	ListConsoleSessions(w http.ResponseWriter, r *http.Request, params ListConsoleSessionsParams)
+
+	// (GET /api/v1/consolesessions/{name})
+	GetConsoleSession(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /api/v1/consolesessions/{name}/recording)
+	GetConsoleSessionRecording(w http.ResponseWriter, r *http.Request, name string)
+
 	// (POST /api/v1/deviceactions/resume)
 	ResumeDevices(w http.ResponseWriter, r *http.Request)
 
@@ -49,7 +73,7 @@ type ServerInterface interface {
 	ListDevices(w http.ResponseWriter, r *http.Request, params ListDevicesParams)
 
 	// (POST /api/v1/devices)
-	CreateDevice(w http.ResponseWriter, r *http.Request)
+	CreateDevice(w http.ResponseWriter, r *http.Request, params CreateDeviceParams)
 
 	// (DELETE /api/v1/devices/{name})
 	DeleteDevice(w http.ResponseWriter, r *http.Request, name string)
@@ -58,10 +82,10 @@ type ServerInterface interface {
 	GetDevice(w http.ResponseWriter, r *http.Request, name string)
 
 	// (PATCH /api/v1/devices/{name})
-	PatchDevice(w http.ResponseWriter, r *http.Request, name string)
+	PatchDevice(w http.ResponseWriter, r *http.Request, name string, params PatchDeviceParams)
 
 	// (PUT /api/v1/devices/{name})
-	ReplaceDevice(w http.ResponseWriter, r *http.Request, name string)
+	ReplaceDevice(w http.ResponseWriter, r *http.Request, name string, params ReplaceDeviceParams)
 
 	// (PUT /api/v1/devices/{name}/decommission)
 	DecommissionDevice(w http.ResponseWriter, r *http.Request, name string)
@@ -81,14 +105,35 @@ type ServerInterface interface {
 	// (PUT /api/v1/devices/{name}/status)
 	ReplaceDeviceStatus(w http.ResponseWriter, r *http.Request, name string)
 
+	// (GET /api/v1/devicesearch)
+	SearchDevices(w http.ResponseWriter, r *http.Request, params SearchDevicesParams)
+
 	// (GET /api/v1/enrollmentconfig)
 	GetEnrollmentConfig(w http.ResponseWriter, r *http.Request, params GetEnrollmentConfigParams)
 
+	// (GET /api/v1/enrollmentpolicies)
+	ListEnrollmentPolicies(w http.ResponseWriter, r *http.Request, params ListEnrollmentPoliciesParams)
+
+	// (POST /api/v1/enrollmentpolicies)
+	CreateEnrollmentPolicy(w http.ResponseWriter, r *http.Request, params CreateEnrollmentPolicyParams)
+
+	// (DELETE /api/v1/enrollmentpolicies/{name})
+	DeleteEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /api/v1/enrollmentpolicies/{name})
+	GetEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PATCH /api/v1/enrollmentpolicies/{name})
+	PatchEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string, params PatchEnrollmentPolicyParams)
+
+	// (PUT /api/v1/enrollmentpolicies/{name})
+	ReplaceEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string, params ReplaceEnrollmentPolicyParams)
+
 	// (GET /api/v1/enrollmentrequests)
 	ListEnrollmentRequests(w http.ResponseWriter, r *http.Request, params ListEnrollmentRequestsParams)
 
 	// (POST /api/v1/enrollmentrequests)
-	CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request)
+	CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request, params CreateEnrollmentRequestParams)
 
 	// (DELETE /api/v1/enrollmentrequests/{name})
 	DeleteEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string)
@@ -97,10 +142,10 @@ type ServerInterface interface {
 	GetEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string)
 
 	// (PATCH /api/v1/enrollmentrequests/{name})
-	PatchEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string)
+	PatchEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string, params PatchEnrollmentRequestParams)
 
 	// (PUT /api/v1/enrollmentrequests/{name})
-	ReplaceEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string)
+	ReplaceEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string, params ReplaceEnrollmentRequestParams)
 
 	// (PUT /api/v1/enrollmentrequests/{name}/approval)
 	ApproveEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string)
@@ -117,11 +162,29 @@ type ServerInterface interface {
 	// (GET /api/v1/events)
 	ListEvents(w http.ResponseWriter, r *http.Request, params ListEventsParams)
 
+	// (GET /api/v1/eventsubscriptions)
+	ListEventSubscriptions(w http.ResponseWriter, r *http.Request, params ListEventSubscriptionsParams)
+
+	// (POST /api/v1/eventsubscriptions)
+	CreateEventSubscription(w http.ResponseWriter, r *http.Request, params CreateEventSubscriptionParams)
+
+	// (DELETE /api/v1/eventsubscriptions/{name})
+	DeleteEventSubscription(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /api/v1/eventsubscriptions/{name})
+	GetEventSubscription(w http.ResponseWriter, r *http.Request, name string)
+
+	// (PATCH /api/v1/eventsubscriptions/{name})
+	PatchEventSubscription(w http.ResponseWriter, r *http.Request, name string, params PatchEventSubscriptionParams)
+
+	// (PUT /api/v1/eventsubscriptions/{name})
+	ReplaceEventSubscription(w http.ResponseWriter, r *http.Request, name string, params ReplaceEventSubscriptionParams)
+
 	// (GET /api/v1/fleets)
 	ListFleets(w http.ResponseWriter, r *http.Request, params ListFleetsParams)
 
 	// (POST /api/v1/fleets)
-	CreateFleet(w http.ResponseWriter, r *http.Request)
+	CreateFleet(w http.ResponseWriter, r *http.Request, params CreateFleetParams)
 
 	// (GET /api/v1/fleets/{fleet}/templateversions)
 	ListTemplateVersions(w http.ResponseWriter, r *http.Request, fleet string, params ListTemplateVersionsParams)
@@ -139,10 +202,22 @@ type ServerInterface interface {
 	GetFleet(w http.ResponseWriter, r *http.Request, name string, params GetFleetParams)
 
 	// (PATCH /api/v1/fleets/{name})
-	PatchFleet(w http.ResponseWriter, r *http.Request, name string)
+	PatchFleet(w http.ResponseWriter, r *http.Request, name string, params PatchFleetParams)
 
 	// (PUT /api/v1/fleets/{name})
-	ReplaceFleet(w http.ResponseWriter, r *http.Request, name string)
+	ReplaceFleet(w http.ResponseWriter, r *http.Request, name string, params ReplaceFleetParams)
+
+	// (POST /api/v1/fleets/{name}/preview)
+	PreviewFleet(w http.ResponseWriter, r *http.Request, name string, params PreviewFleetParams)
+
+	// (POST /api/v1/fleets/{name}/rollout/abort)
+	AbortFleetRollout(w http.ResponseWriter, r *http.Request, name string)
+
+	// (POST /api/v1/fleets/{name}/rollout/pause)
+	PauseFleetRollout(w http.ResponseWriter, r *http.Request, name string)
+
+	// (POST /api/v1/fleets/{name}/rollout/resume)
+	ResumeFleetRollout(w http.ResponseWriter, r *http.Request, name string)
 
 	// (GET /api/v1/fleets/{name}/status)
 	GetFleetStatus(w http.ResponseWriter, r *http.Request, name string)
@@ -190,7 +265,7 @@ type ServerInterface interface {
 	ListRepositories(w http.ResponseWriter, r *http.Request, params ListRepositoriesParams)
 
 	// (POST /api/v1/repositories)
-	CreateRepository(w http.ResponseWriter, r *http.Request)
+	CreateRepository(w http.ResponseWriter, r *http.Request, params CreateRepositoryParams)
 
 	// (DELETE /api/v1/repositories/{name})
 	DeleteRepository(w http.ResponseWriter, r *http.Request, name string)
@@ -199,16 +274,16 @@ type ServerInterface interface {
 	GetRepository(w http.ResponseWriter, r *http.Request, name string)
 
 	// (PATCH /api/v1/repositories/{name})
-	PatchRepository(w http.ResponseWriter, r *http.Request, name string)
+	PatchRepository(w http.ResponseWriter, r *http.Request, name string, params PatchRepositoryParams)
 
 	// (PUT /api/v1/repositories/{name})
-	ReplaceRepository(w http.ResponseWriter, r *http.Request, name string)
+	ReplaceRepository(w http.ResponseWriter, r *http.Request, name string, params ReplaceRepositoryParams)
 
 	// (GET /api/v1/resourcesyncs)
 	ListResourceSyncs(w http.ResponseWriter, r *http.Request, params ListResourceSyncsParams)
 
 	// (POST /api/v1/resourcesyncs)
-	CreateResourceSync(w http.ResponseWriter, r *http.Request)
+	CreateResourceSync(w http.ResponseWriter, r *http.Request, params CreateResourceSyncParams)
 
 	// (DELETE /api/v1/resourcesyncs/{name})
 	DeleteResourceSync(w http.ResponseWriter, r *http.Request, name string)
@@ -217,10 +292,10 @@ type ServerInterface interface {
 	GetResourceSync(w http.ResponseWriter, r *http.Request, name string)
 
 	// (PATCH /api/v1/resourcesyncs/{name})
-	PatchResourceSync(w http.ResponseWriter, r *http.Request, name string)
+	PatchResourceSync(w http.ResponseWriter, r *http.Request, name string, params PatchResourceSyncParams)
 
 	// (PUT /api/v1/resourcesyncs/{name})
-	ReplaceResourceSync(w http.ResponseWriter, r *http.Request, name string)
+	ReplaceResourceSync(w http.ResponseWriter, r *http.Request, name string, params ReplaceResourceSyncParams)
 
 	// (GET /api/version)
 	GetVersion(w http.ResponseWriter, r *http.Request)
@@ -240,13 +315,38 @@ func (_ Unimplemented) AuthValidate(w http.ResponseWriter, r *http.Request, para
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/bulkoperations)
+func (_ Unimplemented) ListBulkOperations(w http.ResponseWriter, r *http.Request, params ListBulkOperationsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/bulkoperations)
+func (_ Unimplemented) CreateBulkOperation(w http.ResponseWriter, r *http.Request, params CreateBulkOperationParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/v1/bulkoperations/{name})
+func (_ Unimplemented) DeleteBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/bulkoperations/{name})
+func (_ Unimplemented) GetBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/bulkoperations/{name}/cancel)
+func (_ Unimplemented) CancelBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/certificatesigningrequests)
 func (_ Unimplemented) ListCertificateSigningRequests(w http.ResponseWriter, r *http.Request, params ListCertificateSigningRequestsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // (POST /api/v1/certificatesigningrequests)
-func (_ Unimplemented) CreateCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) CreateCertificateSigningRequest(w http.ResponseWriter, r *http.Request, params CreateCertificateSigningRequestParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -261,12 +361,12 @@ func (_ Unimplemented) GetCertificateSigningRequest(w http.ResponseWriter, r *ht
 }
 
 // (PATCH /api/v1/certificatesigningrequests/{name})
-func (_ Unimplemented) PatchCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) PatchCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string, params PatchCertificateSigningRequestParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // (PUT /api/v1/certificatesigningrequests/{name})
-func (_ Unimplemented) ReplaceCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) ReplaceCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string, params ReplaceCertificateSigningRequestParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -275,6 +375,21 @@ func (_ Unimplemented) UpdateCertificateSigningRequestApproval(w http.ResponseWr
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/consolesessions)
+func (_ Unimplemented) ListConsoleSessions(w http.ResponseWriter, r *http.Request, params ListConsoleSessionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/consolesessions/{name})
+func (_ Unimplemented) GetConsoleSession(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/consolesessions/{name}/recording)
+func (_ Unimplemented) GetConsoleSessionRecording(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (POST /api/v1/deviceactions/resume)
 func (_ Unimplemented) ResumeDevices(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -286,7 +401,7 @@ func (_ Unimplemented) ListDevices(w http.ResponseWriter, r *http.Request, param
 }
 
 // (POST /api/v1/devices)
-func (_ Unimplemented) CreateDevice(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) CreateDevice(w http.ResponseWriter, r *http.Request, params CreateDeviceParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -301,12 +416,12 @@ func (_ Unimplemented) GetDevice(w http.ResponseWriter, r *http.Request, name st
 }
 
 // (PATCH /api/v1/devices/{name})
-func (_ Unimplemented) PatchDevice(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) PatchDevice(w http.ResponseWriter, r *http.Request, name string, params PatchDeviceParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // (PUT /api/v1/devices/{name})
-func (_ Unimplemented) ReplaceDevice(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) ReplaceDevice(w http.ResponseWriter, r *http.Request, name string, params ReplaceDeviceParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -340,18 +455,53 @@ func (_ Unimplemented) ReplaceDeviceStatus(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/devicesearch)
+func (_ Unimplemented) SearchDevices(w http.ResponseWriter, r *http.Request, params SearchDevicesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/enrollmentconfig)
 func (_ Unimplemented) GetEnrollmentConfig(w http.ResponseWriter, r *http.Request, params GetEnrollmentConfigParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/enrollmentpolicies)
+func (_ Unimplemented) ListEnrollmentPolicies(w http.ResponseWriter, r *http.Request, params ListEnrollmentPoliciesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/enrollmentpolicies)
+func (_ Unimplemented) CreateEnrollmentPolicy(w http.ResponseWriter, r *http.Request, params CreateEnrollmentPolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/v1/enrollmentpolicies/{name})
+func (_ Unimplemented) DeleteEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/enrollmentpolicies/{name})
+func (_ Unimplemented) GetEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PATCH /api/v1/enrollmentpolicies/{name})
+func (_ Unimplemented) PatchEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string, params PatchEnrollmentPolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/v1/enrollmentpolicies/{name})
+func (_ Unimplemented) ReplaceEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string, params ReplaceEnrollmentPolicyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/enrollmentrequests)
 func (_ Unimplemented) ListEnrollmentRequests(w http.ResponseWriter, r *http.Request, params ListEnrollmentRequestsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // (POST /api/v1/enrollmentrequests)
-func (_ Unimplemented) CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request, params CreateEnrollmentRequestParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -366,12 +516,12 @@ func (_ Unimplemented) GetEnrollmentRequest(w http.ResponseWriter, r *http.Reque
 }
 
 // (PATCH /api/v1/enrollmentrequests/{name})
-func (_ Unimplemented) PatchEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) PatchEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string, params PatchEnrollmentRequestParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // (PUT /api/v1/enrollmentrequests/{name})
-func (_ Unimplemented) ReplaceEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) ReplaceEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string, params ReplaceEnrollmentRequestParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -400,13 +550,43 @@ func (_ Unimplemented) ListEvents(w http.ResponseWriter, r *http.Request, params
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (GET /api/v1/eventsubscriptions)
+func (_ Unimplemented) ListEventSubscriptions(w http.ResponseWriter, r *http.Request, params ListEventSubscriptionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/eventsubscriptions)
+func (_ Unimplemented) CreateEventSubscription(w http.ResponseWriter, r *http.Request, params CreateEventSubscriptionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (DELETE /api/v1/eventsubscriptions/{name})
+func (_ Unimplemented) DeleteEventSubscription(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /api/v1/eventsubscriptions/{name})
+func (_ Unimplemented) GetEventSubscription(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PATCH /api/v1/eventsubscriptions/{name})
+func (_ Unimplemented) PatchEventSubscription(w http.ResponseWriter, r *http.Request, name string, params PatchEventSubscriptionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (PUT /api/v1/eventsubscriptions/{name})
+func (_ Unimplemented) ReplaceEventSubscription(w http.ResponseWriter, r *http.Request, name string, params ReplaceEventSubscriptionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /api/v1/fleets)
 func (_ Unimplemented) ListFleets(w http.ResponseWriter, r *http.Request, params ListFleetsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // (POST /api/v1/fleets)
-func (_ Unimplemented) CreateFleet(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) CreateFleet(w http.ResponseWriter, r *http.Request, params CreateFleetParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -436,12 +616,32 @@ func (_ Unimplemented) GetFleet(w http.ResponseWriter, r *http.Request, name str
 }
 
 // (PATCH /api/v1/fleets/{name})
-func (_ Unimplemented) PatchFleet(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) PatchFleet(w http.ResponseWriter, r *http.Request, name string, params PatchFleetParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // (PUT /api/v1/fleets/{name})
-func (_ Unimplemented) ReplaceFleet(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) ReplaceFleet(w http.ResponseWriter, r *http.Request, name string, params ReplaceFleetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/fleets/{name}/preview)
+func (_ Unimplemented) PreviewFleet(w http.ResponseWriter, r *http.Request, name string, params PreviewFleetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/fleets/{name}/rollout/abort)
+func (_ Unimplemented) AbortFleetRollout(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/fleets/{name}/rollout/pause)
+func (_ Unimplemented) PauseFleetRollout(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (POST /api/v1/fleets/{name}/rollout/resume)
+func (_ Unimplemented) ResumeFleetRollout(w http.ResponseWriter, r *http.Request, name string) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -522,7 +722,7 @@ func (_ Unimplemented) ListRepositories(w http.ResponseWriter, r *http.Request,
 }
 
 // (POST /api/v1/repositories)
-func (_ Unimplemented) CreateRepository(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) CreateRepository(w http.ResponseWriter, r *http.Request, params CreateRepositoryParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -537,12 +737,12 @@ func (_ Unimplemented) GetRepository(w http.ResponseWriter, r *http.Request, nam
 }
 
 // (PATCH /api/v1/repositories/{name})
-func (_ Unimplemented) PatchRepository(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) PatchRepository(w http.ResponseWriter, r *http.Request, name string, params PatchRepositoryParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // (PUT /api/v1/repositories/{name})
-func (_ Unimplemented) ReplaceRepository(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) ReplaceRepository(w http.ResponseWriter, r *http.Request, name string, params ReplaceRepositoryParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -552,7 +752,7 @@ func (_ Unimplemented) ListResourceSyncs(w http.ResponseWriter, r *http.Request,
 }
 
 // (POST /api/v1/resourcesyncs)
-func (_ Unimplemented) CreateResourceSync(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) CreateResourceSync(w http.ResponseWriter, r *http.Request, params CreateResourceSyncParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -567,12 +767,12 @@ func (_ Unimplemented) GetResourceSync(w http.ResponseWriter, r *http.Request, n
 }
 
 // (PATCH /api/v1/resourcesyncs/{name})
-func (_ Unimplemented) PatchResourceSync(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) PatchResourceSync(w http.ResponseWriter, r *http.Request, name string, params PatchResourceSyncParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // (PUT /api/v1/resourcesyncs/{name})
-func (_ Unimplemented) ReplaceResourceSync(w http.ResponseWriter, r *http.Request, name string) {
+func (_ Unimplemented) ReplaceResourceSync(w http.ResponseWriter, r *http.Request, name string, params ReplaceResourceSyncParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -646,14 +846,14 @@ func (siw *ServerInterfaceWrapper) AuthValidate(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListCertificateSigningRequests operation middleware
-func (siw *ServerInterfaceWrapper) ListCertificateSigningRequests(w http.ResponseWriter, r *http.Request) {
+// ListBulkOperations operation middleware
+func (siw *ServerInterfaceWrapper) ListBulkOperations(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListCertificateSigningRequestsParams
+	var params ListBulkOperationsParams
 
 	// ------------- Optional query parameter "continue" -------------
 
@@ -688,22 +888,7 @@ func (siw *ServerInterfaceWrapper) ListCertificateSigningRequests(w http.Respons
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListCertificateSigningRequests(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// CreateCertificateSigningRequest operation middleware
-func (siw *ServerInterfaceWrapper) CreateCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCertificateSigningRequest(w, r)
+		siw.Handler.ListBulkOperations(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -713,49 +898,25 @@ func (siw *ServerInterfaceWrapper) CreateCertificateSigningRequest(w http.Respon
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteCertificateSigningRequest operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
+// CreateBulkOperation operation middleware
+func (siw *ServerInterfaceWrapper) CreateBulkOperation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
-	}
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCertificateSigningRequest(w, r, name)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// GetCertificateSigningRequest operation middleware
-func (siw *ServerInterfaceWrapper) GetCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	var err error
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateBulkOperationParams
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// ------------- Optional query parameter "dryRun" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCertificateSigningRequest(w, r, name)
+		siw.Handler.CreateBulkOperation(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -765,8 +926,8 @@ func (siw *ServerInterfaceWrapper) GetCertificateSigningRequest(w http.ResponseW
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchCertificateSigningRequest operation middleware
-func (siw *ServerInterfaceWrapper) PatchCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
+// DeleteBulkOperation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBulkOperation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -781,7 +942,7 @@ func (siw *ServerInterfaceWrapper) PatchCertificateSigningRequest(w http.Respons
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchCertificateSigningRequest(w, r, name)
+		siw.Handler.DeleteBulkOperation(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -791,8 +952,8 @@ func (siw *ServerInterfaceWrapper) PatchCertificateSigningRequest(w http.Respons
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReplaceCertificateSigningRequest operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
+// GetBulkOperation operation middleware
+func (siw *ServerInterfaceWrapper) GetBulkOperation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -807,7 +968,7 @@ func (siw *ServerInterfaceWrapper) ReplaceCertificateSigningRequest(w http.Respo
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceCertificateSigningRequest(w, r, name)
+		siw.Handler.GetBulkOperation(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -817,8 +978,8 @@ func (siw *ServerInterfaceWrapper) ReplaceCertificateSigningRequest(w http.Respo
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpdateCertificateSigningRequestApproval operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCertificateSigningRequestApproval(w http.ResponseWriter, r *http.Request) {
+// CancelBulkOperation operation middleware
+func (siw *ServerInterfaceWrapper) CancelBulkOperation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -833,22 +994,7 @@ func (siw *ServerInterfaceWrapper) UpdateCertificateSigningRequestApproval(w htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCertificateSigningRequestApproval(w, r, name)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// ResumeDevices operation middleware
-func (siw *ServerInterfaceWrapper) ResumeDevices(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ResumeDevices(w, r)
+		siw.Handler.CancelBulkOperation(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -858,14 +1004,14 @@ func (siw *ServerInterfaceWrapper) ResumeDevices(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListDevices operation middleware
-func (siw *ServerInterfaceWrapper) ListDevices(w http.ResponseWriter, r *http.Request) {
+// ListCertificateSigningRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListCertificateSigningRequests(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListDevicesParams
+	var params ListCertificateSigningRequestsParams
 
 	// ------------- Optional query parameter "continue" -------------
 
@@ -899,31 +1045,8 @@ func (siw *ServerInterfaceWrapper) ListDevices(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// ------------- Optional query parameter "summaryOnly" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "summaryOnly", r.URL.Query(), &params.SummaryOnly)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "summaryOnly", Err: err})
-		return
-	}
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListDevices(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// CreateDevice operation middleware
-func (siw *ServerInterfaceWrapper) CreateDevice(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateDevice(w, r)
+		siw.Handler.ListCertificateSigningRequests(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -933,23 +1056,25 @@ func (siw *ServerInterfaceWrapper) CreateDevice(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteDevice operation middleware
-func (siw *ServerInterfaceWrapper) DeleteDevice(w http.ResponseWriter, r *http.Request) {
+// CreateCertificateSigningRequest operation middleware
+func (siw *ServerInterfaceWrapper) CreateCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateCertificateSigningRequestParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteDevice(w, r, name)
+		siw.Handler.CreateCertificateSigningRequest(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -959,8 +1084,8 @@ func (siw *ServerInterfaceWrapper) DeleteDevice(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetDevice operation middleware
-func (siw *ServerInterfaceWrapper) GetDevice(w http.ResponseWriter, r *http.Request) {
+// DeleteCertificateSigningRequest operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -975,7 +1100,7 @@ func (siw *ServerInterfaceWrapper) GetDevice(w http.ResponseWriter, r *http.Requ
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDevice(w, r, name)
+		siw.Handler.DeleteCertificateSigningRequest(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -985,8 +1110,8 @@ func (siw *ServerInterfaceWrapper) GetDevice(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchDevice operation middleware
-func (siw *ServerInterfaceWrapper) PatchDevice(w http.ResponseWriter, r *http.Request) {
+// GetCertificateSigningRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1001,7 +1126,7 @@ func (siw *ServerInterfaceWrapper) PatchDevice(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchDevice(w, r, name)
+		siw.Handler.GetCertificateSigningRequest(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1011,8 +1136,8 @@ func (siw *ServerInterfaceWrapper) PatchDevice(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReplaceDevice operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceDevice(w http.ResponseWriter, r *http.Request) {
+// PatchCertificateSigningRequest operation middleware
+func (siw *ServerInterfaceWrapper) PatchCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1026,8 +1151,19 @@ func (siw *ServerInterfaceWrapper) ReplaceDevice(w http.ResponseWriter, r *http.
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchCertificateSigningRequestParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceDevice(w, r, name)
+		siw.Handler.PatchCertificateSigningRequest(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1037,8 +1173,8 @@ func (siw *ServerInterfaceWrapper) ReplaceDevice(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DecommissionDevice operation middleware
-func (siw *ServerInterfaceWrapper) DecommissionDevice(w http.ResponseWriter, r *http.Request) {
+// ReplaceCertificateSigningRequest operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1052,8 +1188,19 @@ func (siw *ServerInterfaceWrapper) DecommissionDevice(w http.ResponseWriter, r *
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplaceCertificateSigningRequestParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DecommissionDevice(w, r, name)
+		siw.Handler.ReplaceCertificateSigningRequest(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1063,8 +1210,8 @@ func (siw *ServerInterfaceWrapper) DecommissionDevice(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetDeviceLastSeen operation middleware
-func (siw *ServerInterfaceWrapper) GetDeviceLastSeen(w http.ResponseWriter, r *http.Request) {
+// UpdateCertificateSigningRequestApproval operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCertificateSigningRequestApproval(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1079,7 +1226,7 @@ func (siw *ServerInterfaceWrapper) GetDeviceLastSeen(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDeviceLastSeen(w, r, name)
+		siw.Handler.UpdateCertificateSigningRequestApproval(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1089,8 +1236,498 @@ func (siw *ServerInterfaceWrapper) GetDeviceLastSeen(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetRenderedDevice operation middleware
-func (siw *ServerInterfaceWrapper) GetRenderedDevice(w http.ResponseWriter, r *http.Request) {
+// ListConsoleSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListConsoleSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListConsoleSessionsParams
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListConsoleSessions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetConsoleSession operation middleware
+func (siw *ServerInterfaceWrapper) GetConsoleSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConsoleSession(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetConsoleSessionRecording operation middleware
+func (siw *ServerInterfaceWrapper) GetConsoleSessionRecording(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConsoleSessionRecording(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ResumeDevices operation middleware
+func (siw *ServerInterfaceWrapper) ResumeDevices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResumeDevices(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListDevices operation middleware
+func (siw *ServerInterfaceWrapper) ListDevices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDevicesParams
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "summaryOnly" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "summaryOnly", r.URL.Query(), &params.SummaryOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "summaryOnly", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "watch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "watch", r.URL.Query(), &params.Watch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "resourceVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceVersion", r.URL.Query(), &params.ResourceVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceVersion", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDevices(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateDevice operation middleware
+func (siw *ServerInterfaceWrapper) CreateDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateDeviceParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDevice(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteDevice operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteDevice(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetDevice operation middleware
+func (siw *ServerInterfaceWrapper) GetDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDevice(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchDevice operation middleware
+func (siw *ServerInterfaceWrapper) PatchDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchDeviceParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchDevice(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReplaceDevice operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplaceDeviceParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceDevice(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DecommissionDevice operation middleware
+func (siw *ServerInterfaceWrapper) DecommissionDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DecommissionDevice(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetDeviceLastSeen operation middleware
+func (siw *ServerInterfaceWrapper) GetDeviceLastSeen(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDeviceLastSeen(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRenderedDevice operation middleware
+func (siw *ServerInterfaceWrapper) GetRenderedDevice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRenderedDeviceParams
+
+	// ------------- Optional query parameter "knownRenderedVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "knownRenderedVersion", r.URL.Query(), &params.KnownRenderedVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "knownRenderedVersion", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRenderedDevice(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetDeviceStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetDeviceStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDeviceStatus(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchDeviceStatus operation middleware
+func (siw *ServerInterfaceWrapper) PatchDeviceStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchDeviceStatus(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReplaceDeviceStatus operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceDeviceStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1098,25 +1735,189 @@ func (siw *ServerInterfaceWrapper) GetRenderedDevice(w http.ResponseWriter, r *h
 	// ------------- Path parameter "name" -------------
 	var name string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceDeviceStatus(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// SearchDevices operation middleware
+func (siw *ServerInterfaceWrapper) SearchDevices(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchDevicesParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchDevices(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetEnrollmentConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetEnrollmentConfig(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEnrollmentConfigParams
+
+	// ------------- Optional query parameter "csr" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "csr", r.URL.Query(), &params.Csr)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "csr", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEnrollmentConfig(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListEnrollmentPolicies operation middleware
+func (siw *ServerInterfaceWrapper) ListEnrollmentPolicies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEnrollmentPoliciesParams
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEnrollmentPolicies(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateEnrollmentPolicy operation middleware
+func (siw *ServerInterfaceWrapper) CreateEnrollmentPolicy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetRenderedDeviceParams
+	var params CreateEnrollmentPolicyParams
 
-	// ------------- Optional query parameter "knownRenderedVersion" -------------
+	// ------------- Optional query parameter "dryRun" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "knownRenderedVersion", r.URL.Query(), &params.KnownRenderedVersion)
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "knownRenderedVersion", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRenderedDevice(w, r, name, params)
+		siw.Handler.CreateEnrollmentPolicy(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1126,8 +1927,8 @@ func (siw *ServerInterfaceWrapper) GetRenderedDevice(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetDeviceStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetDeviceStatus(w http.ResponseWriter, r *http.Request) {
+// DeleteEnrollmentPolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEnrollmentPolicy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1142,7 +1943,7 @@ func (siw *ServerInterfaceWrapper) GetDeviceStatus(w http.ResponseWriter, r *htt
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetDeviceStatus(w, r, name)
+		siw.Handler.DeleteEnrollmentPolicy(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1152,8 +1953,8 @@ func (siw *ServerInterfaceWrapper) GetDeviceStatus(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchDeviceStatus operation middleware
-func (siw *ServerInterfaceWrapper) PatchDeviceStatus(w http.ResponseWriter, r *http.Request) {
+// GetEnrollmentPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetEnrollmentPolicy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1168,7 +1969,7 @@ func (siw *ServerInterfaceWrapper) PatchDeviceStatus(w http.ResponseWriter, r *h
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchDeviceStatus(w, r, name)
+		siw.Handler.GetEnrollmentPolicy(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1178,8 +1979,8 @@ func (siw *ServerInterfaceWrapper) PatchDeviceStatus(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ReplaceDeviceStatus operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceDeviceStatus(w http.ResponseWriter, r *http.Request) {
+// PatchEnrollmentPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PatchEnrollmentPolicy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1193,8 +1994,19 @@ func (siw *ServerInterfaceWrapper) ReplaceDeviceStatus(w http.ResponseWriter, r
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchEnrollmentPolicyParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceDeviceStatus(w, r, name)
+		siw.Handler.PatchEnrollmentPolicy(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1204,25 +2016,34 @@ func (siw *ServerInterfaceWrapper) ReplaceDeviceStatus(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetEnrollmentConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetEnrollmentConfig(w http.ResponseWriter, r *http.Request) {
+// ReplaceEnrollmentPolicy operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceEnrollmentPolicy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetEnrollmentConfigParams
+	var params ReplaceEnrollmentPolicyParams
 
-	// ------------- Optional query parameter "csr" -------------
+	// ------------- Optional query parameter "dryRun" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "csr", r.URL.Query(), &params.Csr)
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "csr", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEnrollmentConfig(w, r, params)
+		siw.Handler.ReplaceEnrollmentPolicy(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1288,8 +2109,21 @@ func (siw *ServerInterfaceWrapper) ListEnrollmentRequests(w http.ResponseWriter,
 func (siw *ServerInterfaceWrapper) CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateEnrollmentRequestParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateEnrollmentRequest(w, r)
+		siw.Handler.CreateEnrollmentRequest(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1366,8 +2200,19 @@ func (siw *ServerInterfaceWrapper) PatchEnrollmentRequest(w http.ResponseWriter,
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchEnrollmentRequestParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchEnrollmentRequest(w, r, name)
+		siw.Handler.PatchEnrollmentRequest(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1392,8 +2237,19 @@ func (siw *ServerInterfaceWrapper) ReplaceEnrollmentRequest(w http.ResponseWrite
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplaceEnrollmentRequestParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceEnrollmentRequest(w, r, name)
+		siw.Handler.ReplaceEnrollmentRequest(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1528,28 +2384,242 @@ func (siw *ServerInterfaceWrapper) ListEvents(w http.ResponseWriter, r *http.Req
 
 	err = runtime.BindQueryParameter("form", true, false, "order", r.URL.Query(), &params.Order)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "watch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "watch", r.URL.Query(), &params.Watch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watch", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListEventSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) ListEventSubscriptions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListEventSubscriptionsParams
+
+	// ------------- Optional query parameter "continue" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "labelSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "labelSelector", r.URL.Query(), &params.LabelSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "labelSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fieldSelector" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fieldSelector", r.URL.Query(), &params.FieldSelector)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fieldSelector", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEventSubscriptions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CreateEventSubscription operation middleware
+func (siw *ServerInterfaceWrapper) CreateEventSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateEventSubscriptionParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateEventSubscription(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteEventSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEventSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteEventSubscription(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetEventSubscription operation middleware
+func (siw *ServerInterfaceWrapper) GetEventSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEventSubscription(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchEventSubscription operation middleware
+func (siw *ServerInterfaceWrapper) PatchEventSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchEventSubscriptionParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchEventSubscription(w, r, name, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ReplaceEventSubscription operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceEventSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "continue" -------------
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplaceEventSubscriptionParams
 
-	err = runtime.BindQueryParameter("form", true, false, "continue", r.URL.Query(), &params.Continue)
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "continue", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListEvents(w, r, params)
+		siw.Handler.ReplaceEventSubscription(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1608,6 +2678,22 @@ func (siw *ServerInterfaceWrapper) ListFleets(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// ------------- Optional query parameter "watch" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "watch", r.URL.Query(), &params.Watch)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "watch", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "resourceVersion" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourceVersion", r.URL.Query(), &params.ResourceVersion)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourceVersion", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListFleets(w, r, params)
 	}))
@@ -1623,8 +2709,21 @@ func (siw *ServerInterfaceWrapper) ListFleets(w http.ResponseWriter, r *http.Req
 func (siw *ServerInterfaceWrapper) CreateFleet(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateFleetParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateFleet(w, r)
+		siw.Handler.CreateFleet(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1843,8 +2942,19 @@ func (siw *ServerInterfaceWrapper) PatchFleet(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchFleetParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchFleet(w, r, name)
+		siw.Handler.PatchFleet(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1869,8 +2979,134 @@ func (siw *ServerInterfaceWrapper) ReplaceFleet(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplaceFleetParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceFleet(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PreviewFleet operation middleware
+func (siw *ServerInterfaceWrapper) PreviewFleet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PreviewFleetParams
+
+	// ------------- Optional query parameter "sampleSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sampleSize", r.URL.Query(), &params.SampleSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sampleSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewFleet(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AbortFleetRollout operation middleware
+func (siw *ServerInterfaceWrapper) AbortFleetRollout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AbortFleetRollout(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PauseFleetRollout operation middleware
+func (siw *ServerInterfaceWrapper) PauseFleetRollout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PauseFleetRollout(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ResumeFleetRollout operation middleware
+func (siw *ServerInterfaceWrapper) ResumeFleetRollout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceFleet(w, r, name)
+		siw.Handler.ResumeFleetRollout(w, r, name)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2337,8 +3573,21 @@ func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *ht
 func (siw *ServerInterfaceWrapper) CreateRepository(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateRepositoryParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateRepository(w, r)
+		siw.Handler.CreateRepository(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2415,8 +3664,19 @@ func (siw *ServerInterfaceWrapper) PatchRepository(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchRepositoryParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchRepository(w, r, name)
+		siw.Handler.PatchRepository(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2441,8 +3701,19 @@ func (siw *ServerInterfaceWrapper) ReplaceRepository(w http.ResponseWriter, r *h
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplaceRepositoryParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceRepository(w, r, name)
+		siw.Handler.ReplaceRepository(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2508,8 +3779,21 @@ func (siw *ServerInterfaceWrapper) ListResourceSyncs(w http.ResponseWriter, r *h
 func (siw *ServerInterfaceWrapper) CreateResourceSync(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateResourceSyncParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateResourceSync(w, r)
+		siw.Handler.CreateResourceSync(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2586,8 +3870,19 @@ func (siw *ServerInterfaceWrapper) PatchResourceSync(w http.ResponseWriter, r *h
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchResourceSyncParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchResourceSync(w, r, name)
+		siw.Handler.PatchResourceSync(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2612,8 +3907,19 @@ func (siw *ServerInterfaceWrapper) ReplaceResourceSync(w http.ResponseWriter, r
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReplaceResourceSyncParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceResourceSync(w, r, name)
+		siw.Handler.ReplaceResourceSync(w, r, name, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2757,6 +4063,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/auth/validate", wrapper.AuthValidate)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/bulkoperations", wrapper.ListBulkOperations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/bulkoperations", wrapper.CreateBulkOperation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/bulkoperations/{name}", wrapper.DeleteBulkOperation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/bulkoperations/{name}", wrapper.GetBulkOperation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/bulkoperations/{name}/cancel", wrapper.CancelBulkOperation)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/certificatesigningrequests", wrapper.ListCertificateSigningRequests)
 	})
@@ -2778,6 +4099,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/certificatesigningrequests/{name}/approval", wrapper.UpdateCertificateSigningRequestApproval)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/consolesessions", wrapper.ListConsoleSessions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/consolesessions/{name}", wrapper.GetConsoleSession)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/consolesessions/{name}/recording", wrapper.GetConsoleSessionRecording)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/api/v1/deviceactions/resume", wrapper.ResumeDevices)
 	})
@@ -2817,9 +4147,30 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/devices/{name}/status", wrapper.ReplaceDeviceStatus)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/devicesearch", wrapper.SearchDevices)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/enrollmentconfig", wrapper.GetEnrollmentConfig)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/enrollmentpolicies", wrapper.ListEnrollmentPolicies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/enrollmentpolicies", wrapper.CreateEnrollmentPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/enrollmentpolicies/{name}", wrapper.DeleteEnrollmentPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/enrollmentpolicies/{name}", wrapper.GetEnrollmentPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/v1/enrollmentpolicies/{name}", wrapper.PatchEnrollmentPolicy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/enrollmentpolicies/{name}", wrapper.ReplaceEnrollmentPolicy)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/enrollmentrequests", wrapper.ListEnrollmentRequests)
 	})
@@ -2853,6 +4204,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/events", wrapper.ListEvents)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/eventsubscriptions", wrapper.ListEventSubscriptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/eventsubscriptions", wrapper.CreateEventSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/eventsubscriptions/{name}", wrapper.DeleteEventSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/eventsubscriptions/{name}", wrapper.GetEventSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/api/v1/eventsubscriptions/{name}", wrapper.PatchEventSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/api/v1/eventsubscriptions/{name}", wrapper.ReplaceEventSubscription)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/fleets", wrapper.ListFleets)
 	})
@@ -2880,6 +4249,18 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/api/v1/fleets/{name}", wrapper.ReplaceFleet)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/fleets/{name}/preview", wrapper.PreviewFleet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/fleets/{name}/rollout/abort", wrapper.AbortFleetRollout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/fleets/{name}/rollout/pause", wrapper.PauseFleetRollout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/fleets/{name}/rollout/resume", wrapper.ResumeFleetRollout)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/api/v1/fleets/{name}/status", wrapper.GetFleetStatus)
 	})
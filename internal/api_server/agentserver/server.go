@@ -151,7 +151,10 @@ func (s *AgentServer) Run(ctx context.Context) error {
 	}()
 
 	s.log.Printf("Listening on %s...", s.listener.Addr().String())
-	srv.TLSConfig = s.tlsConfig
+	srv.TLSConfig = s.tlsConfig.Clone()
+	revocationCache := tlsmiddleware.NewRevocationCache(s.store.CertificateRevocation(), s.log, tlsmiddleware.RevocationCacheRefreshInterval)
+	go revocationCache.Run(ctx)
+	srv.TLSConfig.VerifyConnection = tlsmiddleware.VerifyClientCertNotRevoked(revocationCache, s.log)
 	if err := srv.ServeTLS(s.listener, "", ""); err != nil && !errors.Is(err, net.ErrClosed) && !errors.Is(err, http.ErrServerClosed) {
 		return err
 	}
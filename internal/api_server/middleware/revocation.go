@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// RevocationCacheRefreshInterval is how often the revoked serial numbers are reloaded, and so how long a newly
+	// revoked certificate may still be accepted.
+	RevocationCacheRefreshInterval = 30 * time.Second
+
+	// revocationLookupTimeout bounds the database lookups made during a TLS handshake
+	revocationLookupTimeout = 2 * time.Second
+)
+
+// RevocationStore is the part of the certificate revocation store used by the RevocationCache.
+type RevocationStore interface {
+	RevocationChecker
+	ListUnexpired(ctx context.Context) ([]model.CertificateRevocation, error)
+}
+
+// RevocationCache answers revocation checks from an in-memory set of the revoked serial numbers, so that TLS
+// handshakes don't query the database. The set is reloaded periodically by Run. While it is stale, because the
+// database could not be reached, checks fall back to a lookup bounded by a short timeout, and then to the last set
+// loaded; only a check made before any set was loaded fails when the lookup fails.
+type RevocationCache struct {
+	store           RevocationStore
+	log             logrus.FieldLogger
+	refreshInterval time.Duration
+
+	mu          sync.RWMutex
+	revoked     map[string]struct{}
+	refreshedAt time.Time
+}
+
+func NewRevocationCache(store RevocationStore, log logrus.FieldLogger, refreshInterval time.Duration) *RevocationCache {
+	return &RevocationCache{
+		store:           store,
+		log:             log,
+		refreshInterval: refreshInterval,
+	}
+}
+
+// Run loads the revoked serial numbers and reloads them every refresh interval until the context is done.
+func (c *RevocationCache) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.refreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := c.Refresh(ctx); err != nil {
+			c.log.Errorf("refreshing revoked certificates: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh reloads the revoked serial numbers.
+func (c *RevocationCache) Refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, revocationLookupTimeout)
+	defer cancel()
+	revocations, err := c.store.ListUnexpired(ctx)
+	if err != nil {
+		return err
+	}
+
+	revoked := make(map[string]struct{}, len(revocations))
+	for _, revocation := range revocations {
+		revoked[revocation.SerialNumber] = struct{}{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.revoked = revoked
+	c.refreshedAt = time.Now()
+	return nil
+}
+
+func (c *RevocationCache) IsRevoked(ctx context.Context, serialNumber string) (bool, error) {
+	c.mu.RLock()
+	_, cached := c.revoked[serialNumber]
+	loaded := c.revoked != nil
+	refreshedAt := c.refreshedAt
+	c.mu.RUnlock()
+	if loaded && time.Since(refreshedAt) < 2*c.refreshInterval {
+		return cached, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, revocationLookupTimeout)
+	defer cancel()
+	revoked, err := c.store.IsRevoked(ctx, serialNumber)
+	if err != nil && loaded {
+		c.log.Warnf("checking revocation of certificate %s, using the revoked certificates loaded at %s: %v", serialNumber, refreshedAt.Format(time.RFC3339), err)
+		return cached, nil
+	}
+	return revoked, err
+}
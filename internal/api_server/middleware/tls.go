@@ -3,12 +3,14 @@ package middleware
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"net"
 	"net/http"
 	"time"
 
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
@@ -54,6 +56,37 @@ func NewHTTPServerWithTLSContext(router http.Handler, log logrus.FieldLogger, ad
 	return server
 }
 
+// RevocationChecker looks up whether a certificate, identified by its
+// serial number, has been revoked.
+type RevocationChecker interface {
+	IsRevoked(ctx context.Context, serialNumber string) (bool, error)
+}
+
+// VerifyClientCertNotRevoked returns a tls.Config VerifyConnection callback
+// that rejects the handshake when the client certificate has been revoked.
+// Errors looking up the revocation status also fail the handshake, so the
+// checker should be a RevocationCache rather than the store itself.
+func VerifyClientCertNotRevoked(checker RevocationChecker, log logrus.FieldLogger) func(tls.ConnectionState) error {
+	return func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return nil
+		}
+		peerCertificate := cs.PeerCertificates[0]
+		ctx, cancel := context.WithTimeout(context.Background(), revocationLookupTimeout)
+		defer cancel()
+		revoked, err := checker.IsRevoked(ctx, crypto.CertificateSerialNumber(peerCertificate))
+		if err != nil {
+			log.Errorf("checking revocation of client certificate %q: %v", peerCertificate.Subject.CommonName, err)
+			return errors.New("unable to check client certificate revocation")
+		}
+		if revoked {
+			log.Warnf("rejecting revoked client certificate %q", peerCertificate.Subject.CommonName)
+			return errors.New("client certificate has been revoked")
+		}
+		return nil
+	}
+}
+
 // NewTLSListener returns a new TLS listener. If the address is empty, it will
 // listen on localhost's next available port.
 func NewTLSListener(address string, tlsConfig *tls.Config) (net.Listener, error) {
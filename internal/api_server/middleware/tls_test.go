@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRevocationChecker struct {
+	revoked map[string]bool
+	err     error
+}
+
+func (f *fakeRevocationChecker) IsRevoked(ctx context.Context, serialNumber string) (bool, error) {
+	return f.revoked[serialNumber], f.err
+}
+
+func TestVerifyClientCertNotRevoked(t *testing.T) {
+	peer := &x509.Certificate{SerialNumber: big.NewInt(0x1f), Subject: pkix.Name{CommonName: "device"}}
+
+	testCases := []struct {
+		name        string
+		checker     *fakeRevocationChecker
+		peers       []*x509.Certificate
+		expectError bool
+	}{
+		{
+			name:    "valid certificate",
+			checker: &fakeRevocationChecker{revoked: map[string]bool{"20": true}},
+			peers:   []*x509.Certificate{peer},
+		},
+		{
+			name:        "revoked certificate",
+			checker:     &fakeRevocationChecker{revoked: map[string]bool{"1f": true}},
+			peers:       []*x509.Certificate{peer},
+			expectError: true,
+		},
+		{
+			name:        "revocation lookup fails",
+			checker:     &fakeRevocationChecker{err: errors.New("database unavailable")},
+			peers:       []*x509.Certificate{peer},
+			expectError: true,
+		},
+		{
+			name:    "no client certificate",
+			checker: &fakeRevocationChecker{err: errors.New("must not be called")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			verify := VerifyClientCertNotRevoked(tc.checker, log.InitLogs())
+			err := verify(tls.ConnectionState{PeerCertificates: tc.peers})
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type fakeRevocationStore struct {
+	fakeRevocationChecker
+	revocations []model.CertificateRevocation
+	lookups     int
+}
+
+func (f *fakeRevocationStore) IsRevoked(ctx context.Context, serialNumber string) (bool, error) {
+	f.lookups++
+	return f.fakeRevocationChecker.IsRevoked(ctx, serialNumber)
+}
+
+func (f *fakeRevocationStore) ListUnexpired(ctx context.Context) ([]model.CertificateRevocation, error) {
+	return f.revocations, f.err
+}
+
+func TestRevocationCache(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("answers from the loaded serial numbers", func(t *testing.T) {
+		store := &fakeRevocationStore{revocations: []model.CertificateRevocation{{SerialNumber: "1f"}}}
+		cache := NewRevocationCache(store, log.InitLogs(), time.Minute)
+		require.NoError(t, cache.Refresh(ctx))
+
+		revoked, err := cache.IsRevoked(ctx, "1f")
+		require.NoError(t, err)
+		require.True(t, revoked)
+		revoked, err = cache.IsRevoked(ctx, "20")
+		require.NoError(t, err)
+		require.False(t, revoked)
+		require.Zero(t, store.lookups)
+	})
+
+	t.Run("looks up the store while stale", func(t *testing.T) {
+		store := &fakeRevocationStore{fakeRevocationChecker: fakeRevocationChecker{revoked: map[string]bool{"20": true}}}
+		cache := NewRevocationCache(store, log.InitLogs(), time.Minute)
+		require.NoError(t, cache.Refresh(ctx))
+		cache.refreshedAt = time.Now().Add(-time.Hour)
+
+		revoked, err := cache.IsRevoked(ctx, "20")
+		require.NoError(t, err)
+		require.True(t, revoked)
+		require.Equal(t, 1, store.lookups)
+	})
+
+	t.Run("falls back to the last loaded serial numbers", func(t *testing.T) {
+		store := &fakeRevocationStore{revocations: []model.CertificateRevocation{{SerialNumber: "1f"}}}
+		cache := NewRevocationCache(store, log.InitLogs(), time.Minute)
+		require.NoError(t, cache.Refresh(ctx))
+		store.err = errors.New("database unavailable")
+		require.Error(t, cache.Refresh(ctx))
+		cache.refreshedAt = time.Now().Add(-time.Hour)
+
+		revoked, err := cache.IsRevoked(ctx, "1f")
+		require.NoError(t, err)
+		require.True(t, revoked)
+	})
+
+	t.Run("fails before anything was loaded", func(t *testing.T) {
+		store := &fakeRevocationStore{fakeRevocationChecker: fakeRevocationChecker{err: errors.New("database unavailable")}}
+		cache := NewRevocationCache(store, log.InitLogs(), time.Minute)
+		require.Error(t, cache.Refresh(ctx))
+
+		_, err := cache.IsRevoked(ctx, "1f")
+		require.Error(t, err)
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/flightctl/flightctl/internal/auth"
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/console"
+	"github.com/flightctl/flightctl/internal/console/recording"
 	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/org/resolvers"
@@ -144,6 +145,27 @@ func oapiMultiErrorHandler(errs openapi3.MultiError) (int, error) {
 	return http.StatusBadRequest, errs
 }
 
+// installRateLimiter adds the general rate limiting to a route group, if it is configured and enabled
+func (s *Server) installRateLimiter(r chi.Router) {
+	if s.cfg.Service.RateLimit == nil || !s.cfg.Service.RateLimit.Enabled {
+		return
+	}
+	requests := 300       // Default requests limit
+	window := time.Minute // Default window
+	if s.cfg.Service.RateLimit.Requests > 0 {
+		requests = s.cfg.Service.RateLimit.Requests
+	}
+	if s.cfg.Service.RateLimit.Window > 0 {
+		window = time.Duration(s.cfg.Service.RateLimit.Window)
+	}
+	fcmiddleware.InstallRateLimiter(r, fcmiddleware.RateLimitOptions{
+		Requests:       requests,
+		Window:         window,
+		Message:        "Rate limit exceeded, please try again later",
+		TrustedProxies: s.cfg.Service.RateLimit.TrustedProxies,
+	})
+}
+
 func (s *Server) Run(ctx context.Context) error {
 	s.log.Println("Initializing async jobs")
 	publisher, err := worker_client.QueuePublisher(ctx, s.queuesProvider)
@@ -174,6 +196,11 @@ func (s *Server) Run(ctx context.Context) error {
 		return fmt.Errorf("failed initializing auth: %w", err)
 	}
 
+	consoleRecordings, err := recording.NewSink(s.cfg, s.log)
+	if err != nil {
+		return fmt.Errorf("failed initializing console recording storage: %w", err)
+	}
+
 	router := chi.NewRouter()
 
 	authMiddewares := []func(http.Handler) http.Handler{
@@ -318,26 +345,9 @@ func (s *Server) Run(ctx context.Context) error {
 		// to avoid issues with websocket connections
 		r.Use(oapimiddleware.OapiRequestValidatorWithOptions(swagger, &oapiOpts))
 		r.Use(authMiddewares...)
-		// Add general rate limiting (only if configured and enabled)
-		if s.cfg.Service.RateLimit != nil && s.cfg.Service.RateLimit.Enabled {
-			trustedProxies := s.cfg.Service.RateLimit.TrustedProxies
-			requests := 300       // Default requests limit
-			window := time.Minute // Default window
-			if s.cfg.Service.RateLimit.Requests > 0 {
-				requests = s.cfg.Service.RateLimit.Requests
-			}
-			if s.cfg.Service.RateLimit.Window > 0 {
-				window = time.Duration(s.cfg.Service.RateLimit.Window)
-			}
-			fcmiddleware.InstallRateLimiter(r, fcmiddleware.RateLimitOptions{
-				Requests:       requests,
-				Window:         window,
-				Message:        "Rate limit exceeded, please try again later",
-				TrustedProxies: trustedProxies,
-			})
-		}
+		s.installRateLimiter(r)
 
-		h := transport.NewTransportHandler(serviceHandler, s.authN)
+		h := transport.NewTransportHandler(serviceHandler, s.authN, consoleRecordings)
 
 		// Register all other endpoints with general rate limiting (already applied at router level)
 		// Create a custom handler that excludes the auth validate endpoint
@@ -355,6 +365,22 @@ func (s *Server) Run(ctx context.Context) error {
 		}
 	})
 
+	// git webhook: bypass OpenAPI + auth, requests are verified with the webhook secret of the repository instead
+	router.Group(func(r chi.Router) {
+		s.installRateLimiter(r)
+
+		webhook := transport.NewGitWebhookHandler(serviceHandler, s.log)
+		webhook.RegisterRoutes(r)
+	})
+
+	// certificate revocation: bypass OpenAPI + auth, relying parties can't authenticate and the responses are signed
+	router.Group(func(r chi.Router) {
+		s.installRateLimiter(r)
+
+		pki := transport.NewPKIHandler(serviceHandler, s.log)
+		pki.RegisterRoutes(r)
+	})
+
 	// Register auth validate endpoint with stricter rate limiting (outside main API group)
 	// This ensures it gets all the necessary middleware with stricter rate limiting
 	router.Group(func(r chi.Router) {
@@ -381,7 +407,7 @@ func (s *Server) Run(ctx context.Context) error {
 			})
 		}
 
-		h := transport.NewTransportHandler(serviceHandler, s.authN)
+		h := transport.NewTransportHandler(serviceHandler, s.authN, consoleRecordings)
 		// Use the wrapper to handle the AuthValidate method signature
 		wrapper := &server.ServerInterfaceWrapper{
 			Handler:            h,
@@ -397,26 +423,9 @@ func (s *Server) Run(ctx context.Context) error {
 	router.Group(func(r chi.Router) {
 		r.Use(fcmiddleware.CreateRouteExistsMiddleware(r))
 		r.Use(authMiddewares...)
-		// Add websocket rate limiting (only if configured and enabled)
-		if s.cfg.Service.RateLimit != nil && s.cfg.Service.RateLimit.Enabled {
-			trustedProxies := s.cfg.Service.RateLimit.TrustedProxies
-			requests := 300       // Default requests limit
-			window := time.Minute // Default window
-			if s.cfg.Service.RateLimit.Requests > 0 {
-				requests = s.cfg.Service.RateLimit.Requests
-			}
-			if s.cfg.Service.RateLimit.Window > 0 {
-				window = time.Duration(s.cfg.Service.RateLimit.Window)
-			}
-			fcmiddleware.InstallRateLimiter(r, fcmiddleware.RateLimitOptions{
-				Requests:       requests,
-				Window:         window,
-				Message:        "Rate limit exceeded, please try again later",
-				TrustedProxies: trustedProxies,
-			})
-		}
+		s.installRateLimiter(r)
 
-		consoleSessionManager := console.NewConsoleSessionManager(serviceHandler, s.log, s.consoleEndpointReg)
+		consoleSessionManager := console.NewConsoleSessionManager(serviceHandler, s.log, s.consoleEndpointReg, consoleRecordings, recording.MaxSize(s.cfg))
 		ws := transport.NewWebsocketHandler(s.ca, s.log, consoleSessionManager)
 		ws.RegisterRoutes(r)
 	})
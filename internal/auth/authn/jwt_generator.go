@@ -4,90 +4,88 @@ import (
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/ed25519"
-	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/json"
 	"encoding/pem"
 	"fmt"
-	"os"
 	"time"
 
 	pamapi "github.com/flightctl/flightctl/api/v1alpha1/pam-issuer"
 	"github.com/flightctl/flightctl/internal/auth/common"
 	fccrypto "github.com/flightctl/flightctl/internal/crypto"
-	pkgcrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/lestrrat-go/jwx/v2/jwa"
 	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jws"
 	"github.com/lestrrat-go/jwx/v2/jwt"
 )
 
 // JWTGenerator handles JWT token generation for Linux authentication
 type JWTGenerator struct {
-	privateKey crypto.PrivateKey
-	keyID      string
+	signer crypto.Signer
+	keyID  string
 }
 
-// NewJWTGenerator creates a new JWT generator using the existing CA key
+// NewJWTGenerator creates a new JWT generator that signs with the CA key through the CA backend, so it
+// also works when the key is kept in a PKCS#11 token
 func NewJWTGenerator(caClient *fccrypto.CAClient) (*JWTGenerator, error) {
-	// Get the CA's private key from the existing CA client
-	caConfig := caClient.Config()
-	if caConfig == nil || caConfig.InternalConfig == nil {
-		return nil, fmt.Errorf("CA configuration not available")
-	}
-
-	// Load the CA private key
-	caKeyFile := fccrypto.CertStorePath(caConfig.InternalConfig.KeyFile, caConfig.InternalConfig.CertStore)
-	privateKey, err := pkgcrypto.LoadKey(caKeyFile)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load CA private key from %s: %w", caKeyFile, err)
-	}
-
-	// Generate a key ID based on the CA certificate
-	caCertFile := fccrypto.CertStorePath(caConfig.InternalConfig.CertFile, caConfig.InternalConfig.CertStore)
-	keyID, err := generateKeyIDFromCert(caCertFile)
+	signer, err := caClient.SigningKey()
 	if err != nil {
-		// Fallback to random key ID if we can't read the cert
-		keyIDBytes := make([]byte, 8)
-		if _, err := rand.Read(keyIDBytes); err != nil {
-			return nil, fmt.Errorf("failed to generate key ID: %w", err)
-		}
-		keyID = fmt.Sprintf("%x", keyIDBytes)
+		return nil, fmt.Errorf("CA key not available for signing tokens: %w", err)
 	}
 
 	return &JWTGenerator{
-		privateKey: privateKey,
-		keyID:      keyID,
+		signer: signer,
+		keyID:  generateKeyIDFromCert(caClient.GetCABundleX509()[0]),
 	}, nil
 }
 
 // generateKeyIDFromCert generates a key ID from the CA certificate
-func generateKeyIDFromCert(certFile string) (string, error) {
-	certPEM, err := os.ReadFile(certFile)
-	if err != nil {
-		return "", fmt.Errorf("failed to read certificate file: %w", err)
+func generateKeyIDFromCert(cert *x509.Certificate) string {
+	// Use the first 8 bytes of the certificate's serial number as key ID
+	serialBytes := cert.SerialNumber.Bytes()
+	if len(serialBytes) >= 8 {
+		return fmt.Sprintf("%x", serialBytes[:8])
 	}
 
-	block, _ := pem.Decode(certPEM)
-	if block == nil {
-		return "", fmt.Errorf("failed to decode PEM block")
+	// If serial is shorter, pad with zeros
+	padded := make([]byte, 8)
+	copy(padded, serialBytes)
+	return fmt.Sprintf("%x", padded)
+}
+
+// signingAlgorithm returns the JWS algorithm for the type of the signing key
+func (g *JWTGenerator) signingAlgorithm() (jwa.SignatureAlgorithm, error) {
+	switch publicKey := g.signer.Public().(type) {
+	case *rsa.PublicKey:
+		return jwa.RS256, nil
+	case *ecdsa.PublicKey:
+		return jwa.ES256, nil
+	case ed25519.PublicKey:
+		return "", fmt.Errorf("unsupported key type: Ed25519 keys are not currently supported")
+	default:
+		return "", fmt.Errorf("unsupported key type: %T", publicKey)
 	}
+}
 
-	cert, err := x509.ParseCertificate(block.Bytes)
+// signToken signs the token with the key ID in its header
+func (g *JWTGenerator) signToken(token jwt.Token) (string, error) {
+	signingAlg, err := g.signingAlgorithm()
 	if err != nil {
-		return "", fmt.Errorf("failed to parse certificate: %w", err)
+		return "", err
 	}
 
-	// Use the first 8 bytes of the certificate's serial number as key ID
-	serialBytes := cert.SerialNumber.Bytes()
-	if len(serialBytes) >= 8 {
-		return fmt.Sprintf("%x", serialBytes[:8]), nil
+	headers := jws.NewHeaders()
+	if err := headers.Set(jws.KeyIDKey, g.keyID); err != nil {
+		return "", fmt.Errorf("failed to set key ID: %w", err)
 	}
 
-	// If serial is shorter, pad with zeros
-	padded := make([]byte, 8)
-	copy(padded, serialBytes)
-	return fmt.Sprintf("%x", padded), nil
+	tokenBytes, err := jwt.Sign(token, jwt.WithKey(signingAlg, g.signer, jws.WithProtectedHeaders(headers)))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token: %w", err)
+	}
+
+	return string(tokenBytes), nil
 }
 
 // GenerateToken creates a JWT token for the given identity
@@ -116,43 +114,7 @@ func (g *JWTGenerator) GenerateToken(identity common.Identity, expiration time.D
 		return "", fmt.Errorf("failed to set preferred_username: %w", err)
 	}
 
-	// Create JWK from private key
-	jwkKey, err := jwk.FromRaw(g.privateKey)
-	if err != nil {
-		return "", fmt.Errorf("failed to create JWK: %w", err)
-	}
-
-	// Set key properties
-	if err := jwkKey.Set(jwk.KeyIDKey, g.keyID); err != nil {
-		return "", fmt.Errorf("failed to set key ID: %w", err)
-	}
-
-	// Determine the signing algorithm based on key type
-	var signingAlg jwa.SignatureAlgorithm
-	switch g.privateKey.(type) {
-	case *rsa.PrivateKey:
-		signingAlg = jwa.RS256
-		if err := jwkKey.Set(jwk.AlgorithmKey, jwa.RS256); err != nil {
-			return "", fmt.Errorf("failed to set algorithm: %w", err)
-		}
-	case *ecdsa.PrivateKey:
-		signingAlg = jwa.ES256
-		if err := jwkKey.Set(jwk.AlgorithmKey, jwa.ES256); err != nil {
-			return "", fmt.Errorf("failed to set algorithm: %w", err)
-		}
-	case ed25519.PrivateKey:
-		return "", fmt.Errorf("unsupported key type: Ed25519 keys are not currently supported")
-	default:
-		return "", fmt.Errorf("unsupported key type: %T", g.privateKey)
-	}
-
-	// Sign the token
-	tokenBytes, err := jwt.Sign(token, jwt.WithKey(signingAlg, jwkKey))
-	if err != nil {
-		return "", fmt.Errorf("failed to sign token: %w", err)
-	}
-
-	return string(tokenBytes), nil
+	return g.signToken(token)
 }
 
 type TokenGenerationRequest struct {
@@ -214,43 +176,7 @@ func (g *JWTGenerator) GenerateTokenWithType(request TokenGenerationRequest, exp
 		return "", fmt.Errorf("failed to set token_type: %w", err)
 	}
 
-	// Create JWK from private key
-	jwkKey, err := jwk.FromRaw(g.privateKey)
-	if err != nil {
-		return "", fmt.Errorf("failed to create JWK: %w", err)
-	}
-
-	// Set key properties
-	if err := jwkKey.Set(jwk.KeyIDKey, g.keyID); err != nil {
-		return "", fmt.Errorf("failed to set key ID: %w", err)
-	}
-
-	// Determine the signing algorithm based on key type
-	var signingAlg jwa.SignatureAlgorithm
-	switch g.privateKey.(type) {
-	case *rsa.PrivateKey:
-		signingAlg = jwa.RS256
-		if err := jwkKey.Set(jwk.AlgorithmKey, jwa.RS256); err != nil {
-			return "", fmt.Errorf("failed to set algorithm: %w", err)
-		}
-	case *ecdsa.PrivateKey:
-		signingAlg = jwa.ES256
-		if err := jwkKey.Set(jwk.AlgorithmKey, jwa.ES256); err != nil {
-			return "", fmt.Errorf("failed to set algorithm: %w", err)
-		}
-	case ed25519.PrivateKey:
-		return "", fmt.Errorf("unsupported key type: Ed25519 keys are not currently supported")
-	default:
-		return "", fmt.Errorf("unsupported key type: %T", g.privateKey)
-	}
-
-	// Sign the token
-	tokenBytes, err := jwt.Sign(token, jwt.WithKey(signingAlg, jwkKey))
-	if err != nil {
-		return "", fmt.Errorf("failed to sign token: %w", err)
-	}
-
-	return string(tokenBytes), nil
+	return g.signToken(token)
 }
 
 // ValidateTokenWithType validates a JWT token and ensures it has the correct token type
@@ -275,13 +201,7 @@ func (g *JWTGenerator) ValidateTokenWithType(tokenString string, expectedTokenTy
 
 // GetPublicKeyPEM returns the public key in PEM format for JWKS endpoint
 func (g *JWTGenerator) GetPublicKeyPEM() (string, error) {
-	// Get the public key from the private key
-	signer, ok := g.privateKey.(crypto.Signer)
-	if !ok {
-		return "", fmt.Errorf("private key does not implement crypto.Signer")
-	}
-
-	publicKey := signer.Public()
+	publicKey := g.signer.Public()
 	publicKeyBytes, err := x509.MarshalPKIXPublicKey(publicKey)
 	if err != nil {
 		return "", fmt.Errorf("failed to marshal public key: %w", err)
@@ -297,13 +217,7 @@ func (g *JWTGenerator) GetPublicKeyPEM() (string, error) {
 
 // GetJWKS returns the JWKS (JSON Web Key Set) for this generator
 func (g *JWTGenerator) GetJWKS() (*pamapi.JWKSResponse, error) {
-	// Get the public key from the private key
-	signer, ok := g.privateKey.(crypto.Signer)
-	if !ok {
-		return nil, fmt.Errorf("private key does not implement crypto.Signer")
-	}
-
-	publicKey := signer.Public()
+	publicKey := g.signer.Public()
 
 	// Create JWK from public key
 	jwkKey, err := jwk.FromRaw(publicKey)
@@ -319,8 +233,8 @@ func (g *JWTGenerator) GetJWKS() (*pamapi.JWKSResponse, error) {
 	// Set algorithm and key type based on the key type
 	var alg string
 	var kty string
-	switch g.privateKey.(type) {
-	case *rsa.PrivateKey:
+	switch publicKey.(type) {
+	case *rsa.PublicKey:
 		alg = "RS256"
 		kty = "RSA"
 		if err := jwkKey.Set(jwk.AlgorithmKey, jwa.RS256); err != nil {
@@ -329,7 +243,7 @@ func (g *JWTGenerator) GetJWKS() (*pamapi.JWKSResponse, error) {
 		if err := jwkKey.Set(jwk.KeyTypeKey, "RSA"); err != nil {
 			return nil, fmt.Errorf("failed to set key type: %w", err)
 		}
-	case *ecdsa.PrivateKey:
+	case *ecdsa.PublicKey:
 		alg = "ES256"
 		kty = "EC"
 		if err := jwkKey.Set(jwk.AlgorithmKey, jwa.ES256); err != nil {
@@ -338,10 +252,10 @@ func (g *JWTGenerator) GetJWKS() (*pamapi.JWKSResponse, error) {
 		if err := jwkKey.Set(jwk.KeyTypeKey, "EC"); err != nil {
 			return nil, fmt.Errorf("failed to set key type: %w", err)
 		}
-	case ed25519.PrivateKey:
+	case ed25519.PublicKey:
 		return nil, fmt.Errorf("unsupported key type: Ed25519 keys are not currently supported")
 	default:
-		return nil, fmt.Errorf("unsupported key type: %T", g.privateKey)
+		return nil, fmt.Errorf("unsupported key type: %T", publicKey)
 	}
 
 	if err := jwkKey.Set(jwk.KeyUsageKey, "sig"); err != nil {
@@ -417,13 +331,7 @@ func (g *JWTGenerator) GetJWKS() (*pamapi.JWKSResponse, error) {
 
 // ValidateToken validates a JWT token using the generator's public key
 func (g *JWTGenerator) ValidateToken(tokenString string) (*JWTIdentity, error) {
-	// Get the public key from the private key
-	signer, ok := g.privateKey.(crypto.Signer)
-	if !ok {
-		return nil, fmt.Errorf("private key does not implement crypto.Signer")
-	}
-
-	publicKey := signer.Public()
+	publicKey := g.signer.Public()
 
 	// Create JWK from public key
 	jwkKey, err := jwk.FromRaw(publicKey)
@@ -438,21 +346,21 @@ func (g *JWTGenerator) ValidateToken(tokenString string) (*JWTIdentity, error) {
 
 	// Determine the signing algorithm based on key type
 	var signingAlg jwa.SignatureAlgorithm
-	switch g.privateKey.(type) {
-	case *rsa.PrivateKey:
+	switch publicKey.(type) {
+	case *rsa.PublicKey:
 		signingAlg = jwa.RS256
 		if err := jwkKey.Set(jwk.AlgorithmKey, jwa.RS256); err != nil {
 			return nil, fmt.Errorf("failed to set algorithm: %w", err)
 		}
-	case *ecdsa.PrivateKey:
+	case *ecdsa.PublicKey:
 		signingAlg = jwa.ES256
 		if err := jwkKey.Set(jwk.AlgorithmKey, jwa.ES256); err != nil {
 			return nil, fmt.Errorf("failed to set algorithm: %w", err)
 		}
-	case ed25519.PrivateKey:
+	case ed25519.PublicKey:
 		return nil, fmt.Errorf("unsupported key type: Ed25519 keys are not currently supported")
 	default:
-		return nil, fmt.Errorf("unsupported key type: %T", g.privateKey)
+		return nil, fmt.Errorf("unsupported key type: %T", publicKey)
 	}
 
 	// Parse and validate token
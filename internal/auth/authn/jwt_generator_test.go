@@ -0,0 +1,33 @@
+package authn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	fccrypto "github.com/flightctl/flightctl/internal/crypto"
+	"github.com/lestrrat-go/jwx/v2/jws"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWTGeneratorSignsWithCAKey(t *testing.T) {
+	caClient, _, err := fccrypto.EnsureCA(ca.NewDefault(t.TempDir()))
+	require.NoError(t, err)
+	generator, err := NewJWTGenerator(caClient)
+	require.NoError(t, err)
+
+	token, err := generator.GenerateTokenWithType(TokenGenerationRequest{Username: "alice", UID: "1000"}, time.Minute, "access_token")
+	require.NoError(t, err)
+
+	msg, err := jws.Parse([]byte(token))
+	require.NoError(t, err)
+	require.Equal(t, generator.keyID, msg.Signatures()[0].ProtectedHeaders().KeyID())
+
+	identity, err := generator.ValidateTokenWithType(token, "access_token")
+	require.NoError(t, err)
+	require.Equal(t, "alice", identity.GetUsername())
+	require.Equal(t, "1000", identity.GetUID())
+
+	_, err = generator.ValidateTokenWithType(token, "refresh_token")
+	require.Error(t, err)
+}
@@ -31,6 +31,7 @@ var defaultGroupRoleMap = map[string]string{
 	"flightctl-operator":  "operator",
 	"flightctl-viewer":    "viewer",
 	"flightctl-installer": "installer",
+	"flightctl-auditor":   "auditor",
 	"wheel":               "admin",    // Traditional Unix admin group
 	"sudo":                "admin",    // Sudo users get admin access
 	"adm":                 "operator", // System administration group
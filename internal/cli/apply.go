@@ -13,7 +13,9 @@ import (
 	"path/filepath"
 	"strings"
 
+	api "github.com/flightctl/flightctl/api/v1alpha1"
 	apiclient "github.com/flightctl/flightctl/internal/api/client"
+	"github.com/samber/lo"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
@@ -24,11 +26,20 @@ var (
 	inputExtensions = append(fileExtensions, "stdin")
 )
 
+const (
+	// DryRunNone sends the resources to the service
+	DryRunNone = "none"
+	// DryRunClient only prints the resources that would be sent
+	DryRunClient = "client"
+	// DryRunServer sends the resources to the service to be validated without persisting them
+	DryRunServer = "server"
+)
+
 type ApplyOptions struct {
 	GlobalOptions
 
 	Filenames []string
-	DryRun    bool
+	DryRun    string
 	Recursive bool
 }
 
@@ -36,7 +47,7 @@ func DefaultApplyOptions() *ApplyOptions {
 	return &ApplyOptions{
 		GlobalOptions: DefaultGlobalOptions(),
 		Filenames:     []string{},
-		DryRun:        false,
+		DryRun:        DryRunNone,
 		Recursive:     false,
 	}
 }
@@ -67,6 +78,14 @@ func (o *ApplyOptions) Bind(fs *pflag.FlagSet) {
 	o.GlobalOptions.Bind(fs)
 
 	fs.StringSliceVarP(&o.Filenames, "filename", "f", o.Filenames, "The files or directory that contain the resources to apply.")
+	bindFilenameCompletion(fs)
+	fs.StringVar(&o.DryRun, "dry-run", o.DryRun, fmt.Sprintf("Must be %q, %q, or %q. If client, only print the object that would be sent, without sending it. If server, the service validates the object without persisting it.", DryRunNone, DryRunClient, DryRunServer))
+	fs.Lookup("dry-run").NoOptDefVal = DryRunClient
+	fs.BoolVarP(&o.Recursive, "recursive", "R", o.Recursive, "Process the directory used in -f, --filename recursively.")
+}
+
+// bindFilenameCompletion completes the filename flag with the extensions of resource files
+func bindFilenameCompletion(fs *pflag.FlagSet) {
 	annotations := make([]string, 0, len(fileExtensions))
 	for _, ext := range fileExtensions {
 		annotations = append(annotations, strings.TrimLeft(ext, "."))
@@ -75,8 +94,6 @@ func (o *ApplyOptions) Bind(fs *pflag.FlagSet) {
 	if err != nil {
 		log.Fatalf("setting filename flag annotation: %v", err)
 	}
-	fs.BoolVarP(&o.DryRun, "dry-run", "", o.DryRun, "Only print the object that would be sent, without sending it.")
-	fs.BoolVarP(&o.Recursive, "recursive", "R", o.Recursive, "Process the directory used in -f, --filename recursively.")
 }
 
 func (o *ApplyOptions) Complete(cmd *cobra.Command, args []string) error {
@@ -98,6 +115,11 @@ func (o *ApplyOptions) Validate(args []string) error {
 	if len(args) > 0 {
 		return fmt.Errorf("unexpected arguments: %v (did you forget to quote wildcards?)", args)
 	}
+	switch o.DryRun {
+	case DryRunNone, DryRunClient, DryRunServer:
+	default:
+		return fmt.Errorf("invalid dry-run value %q: must be %q, %q, or %q", o.DryRun, DryRunNone, DryRunClient, DryRunServer)
+	}
 	return nil
 }
 
@@ -107,11 +129,20 @@ func (o *ApplyOptions) Run(ctx context.Context, args []string) error {
 		return fmt.Errorf("creating client: %w", err)
 	}
 
+	errs := walkResourceFiles(o.Filenames, o.Recursive, func(filename string, r io.Reader) []error {
+		return applyFromReader(ctx, c, filename, r, o.DryRun)
+	})
+	return errors.Join(errs...)
+}
+
+// walkResourceFiles calls fn for stdin, given as "-", and for each of the files matching the filenames.  Directories
+// are walked for files with a resource file extension, and recursively if requested.
+func walkResourceFiles(filenames []string, recursive bool, fn func(filename string, r io.Reader) []error) []error {
 	errs := make([]error, 0)
-	for _, filename := range o.Filenames {
+	for _, filename := range filenames {
 		switch {
 		case filename == "-":
-			errs = append(errs, applyFromReader(ctx, c, "<stdin>", os.Stdin, o.DryRun)...)
+			errs = append(errs, fn("<stdin>", os.Stdin)...)
 		default:
 			expandedFilenames, err := expandIfFilePattern(filename)
 			if err != nil {
@@ -134,7 +165,7 @@ func (o *ApplyOptions) Run(ctx context.Context, args []string) error {
 					}
 
 					if fi.IsDir() {
-						if path != filename && !o.Recursive {
+						if path != filename && !recursive {
 							return filepath.SkipDir
 						}
 						return nil
@@ -149,7 +180,7 @@ func (o *ApplyOptions) Run(ctx context.Context, args []string) error {
 						return nil
 					}
 					defer r.Close()
-					errs = append(errs, applyFromReader(ctx, c, path, r, o.DryRun)...)
+					errs = append(errs, fn(path, r)...)
 					return nil
 				})
 				if err != nil {
@@ -158,12 +189,13 @@ func (o *ApplyOptions) Run(ctx context.Context, args []string) error {
 			}
 		}
 	}
-	return errors.Join(errs...)
+	return errs
 }
 
 type genericResource map[string]interface{}
 
-func applyFromReader(ctx context.Context, client *apiclient.ClientWithResponses, filename string, r io.Reader, dryRun bool) []error {
+// decodeResources decodes all YAML or JSON documents of the reader
+func decodeResources(r io.Reader) ([]genericResource, error) {
 	decoder := yamlutil.NewYAMLOrJSONDecoder(r, 100)
 	resources := []genericResource{}
 
@@ -177,32 +209,56 @@ func applyFromReader(ctx context.Context, client *apiclient.ClientWithResponses,
 		resources = append(resources, resource)
 	}
 	if !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return resources, nil
+}
+
+// resourceKindAndName returns the kind and name of a resource read from the file
+func resourceKindAndName(filename string, resource genericResource) (string, string, error) {
+	kindLike, ok := resource["kind"].(string)
+	if !ok {
+		return "", "", fmt.Errorf("%s: skipping resource of unspecified kind: %v", filename, resource)
+	}
+	metadata, ok := resource["metadata"].(map[string]interface{})
+	if !ok {
+		return "", "", fmt.Errorf("%s: skipping resource of unspecified metadata: %v", filename, resource)
+	}
+	resourceName, ok := metadata["name"].(string)
+	if !ok {
+		return "", "", fmt.Errorf("%s: skipping resource of unspecified resource name: %v", filename, resource)
+	}
+	return kindLike, resourceName, nil
+}
+
+func applyFromReader(ctx context.Context, client *apiclient.ClientWithResponses, filename string, r io.Reader, dryRun string) []error {
+	resources, err := decodeResources(r)
+	if err != nil {
 		return []error{err}
 	}
 
+	var dryRunParam *api.DryRun
+	if dryRun == DryRunServer {
+		dryRunParam = lo.ToPtr(api.DryRunAll)
+	}
+
 	errs := make([]error, 0)
 	for _, resource := range resources {
-		kindLike, ok := resource["kind"].(string)
-		if !ok {
-			errs = append(errs, fmt.Errorf("%s: skipping resource of unspecified kind: %v", filename, resource))
-			continue
-		}
-		metadata, ok := resource["metadata"].(map[string]interface{})
-		if !ok {
-			errs = append(errs, fmt.Errorf("%s: skipping resource of unspecified metadata: %v", filename, resource))
-			continue
-		}
-		resourceName, ok := metadata["name"].(string)
-		if !ok {
-			errs = append(errs, fmt.Errorf("%s: skipping resource of unspecified resource name: %v", filename, resource))
+		kindLike, resourceName, err := resourceKindAndName(filename, resource)
+		if err != nil {
+			errs = append(errs, err)
 			continue
 		}
 
-		if dryRun {
+		switch dryRun {
+		case DryRunClient:
 			fmt.Printf("%s: applying %s/%s (dry run only)\n", filename, strings.ToLower(kindLike), resourceName)
 			continue
+		case DryRunServer:
+			fmt.Printf("%s: applying %s/%s (server dry run): ", filename, strings.ToLower(kindLike), resourceName)
+		default:
+			fmt.Printf("%s: applying %s/%s: ", filename, strings.ToLower(kindLike), resourceName)
 		}
-		fmt.Printf("%s: applying %s/%s: ", filename, strings.ToLower(kindLike), resourceName)
 		buf, err := json.Marshal(resource)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("%s: skipping resource of kind %q: %w", filename, kindLike, err))
@@ -215,42 +271,56 @@ func applyFromReader(ctx context.Context, client *apiclient.ClientWithResponses,
 		switch kind {
 		case DeviceKind:
 			var response *apiclient.ReplaceDeviceResponse
-			response, err = client.ReplaceDeviceWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+			response, err = client.ReplaceDeviceWithBodyWithResponse(ctx, resourceName, &api.ReplaceDeviceParams{DryRun: dryRunParam}, "application/json", bytes.NewReader(buf))
 			if response != nil {
 				httpResponse = response.HTTPResponse
 				message = string(response.Body)
 			}
 		case EnrollmentRequestKind:
 			var response *apiclient.ReplaceEnrollmentRequestResponse
-			response, err = client.ReplaceEnrollmentRequestWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+			response, err = client.ReplaceEnrollmentRequestWithBodyWithResponse(ctx, resourceName, &api.ReplaceEnrollmentRequestParams{DryRun: dryRunParam}, "application/json", bytes.NewReader(buf))
 			if response != nil {
 				httpResponse = response.HTTPResponse
 				message = string(response.Body)
 			}
 		case FleetKind:
 			var response *apiclient.ReplaceFleetResponse
-			response, err = client.ReplaceFleetWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+			response, err = client.ReplaceFleetWithBodyWithResponse(ctx, resourceName, &api.ReplaceFleetParams{DryRun: dryRunParam}, "application/json", bytes.NewReader(buf))
 			if response != nil {
 				httpResponse = response.HTTPResponse
 				message = string(response.Body)
 			}
 		case RepositoryKind:
 			var response *apiclient.ReplaceRepositoryResponse
-			response, err = client.ReplaceRepositoryWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+			response, err = client.ReplaceRepositoryWithBodyWithResponse(ctx, resourceName, &api.ReplaceRepositoryParams{DryRun: dryRunParam}, "application/json", bytes.NewReader(buf))
 			if response != nil {
 				httpResponse = response.HTTPResponse
 				message = string(response.Body)
 			}
 		case ResourceSyncKind:
 			var response *apiclient.ReplaceResourceSyncResponse
-			response, err = client.ReplaceResourceSyncWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+			response, err = client.ReplaceResourceSyncWithBodyWithResponse(ctx, resourceName, &api.ReplaceResourceSyncParams{DryRun: dryRunParam}, "application/json", bytes.NewReader(buf))
 			if response != nil {
 				httpResponse = response.HTTPResponse
 				message = string(response.Body)
 			}
 		case CertificateSigningRequestKind:
 			var response *apiclient.ReplaceCertificateSigningRequestResponse
-			response, err = client.ReplaceCertificateSigningRequestWithBodyWithResponse(ctx, resourceName, "application/json", bytes.NewReader(buf))
+			response, err = client.ReplaceCertificateSigningRequestWithBodyWithResponse(ctx, resourceName, &api.ReplaceCertificateSigningRequestParams{DryRun: dryRunParam}, "application/json", bytes.NewReader(buf))
+			if response != nil {
+				httpResponse = response.HTTPResponse
+				message = string(response.Body)
+			}
+		case EventSubscriptionKind:
+			var response *apiclient.ReplaceEventSubscriptionResponse
+			response, err = client.ReplaceEventSubscriptionWithBodyWithResponse(ctx, resourceName, &api.ReplaceEventSubscriptionParams{DryRun: dryRunParam}, "application/json", bytes.NewReader(buf))
+			if response != nil {
+				httpResponse = response.HTTPResponse
+				message = string(response.Body)
+			}
+		case EnrollmentPolicyKind:
+			var response *apiclient.ReplaceEnrollmentPolicyResponse
+			response, err = client.ReplaceEnrollmentPolicyWithBodyWithResponse(ctx, resourceName, &api.ReplaceEnrollmentPolicyParams{DryRun: dryRunParam}, "application/json", bytes.NewReader(buf))
 			if response != nil {
 				httpResponse = response.HTTPResponse
 				message = string(response.Body)
@@ -0,0 +1,305 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type BulkOptions struct {
+	GlobalOptions
+
+	LabelSelector      string
+	FieldSelector      string
+	Name               string
+	DecommissionTarget string
+	DryRun             bool
+	Output             string
+}
+
+func DefaultBulkOptions() *BulkOptions {
+	return &BulkOptions{
+		GlobalOptions:      DefaultGlobalOptions(),
+		LabelSelector:      "",
+		FieldSelector:      "",
+		Name:               "",
+		DecommissionTarget: string(api.DeviceDecommissionTargetTypeUnenroll),
+		DryRun:             false,
+		Output:             "",
+	}
+}
+
+// bulkAction builds the operation of a bulk subcommand from its arguments
+type bulkAction func(o *BulkOptions, args []string) (api.BulkOperationAction, error)
+
+func NewCmdBulk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk",
+		Short: "Apply an operation to all devices that match a selector.",
+		Long: `Apply an operation to all devices that match a selector.
+
+Each command creates a bulk operation that the service applies to the matching devices in the background. Follow
+its progress with 'flightctl get bulkoperation NAME', and stop it with 'flightctl bulk cancel NAME'.
+
+Examples:
+  flightctl bulk label site=berlin -l site=paris
+  flightctl bulk move berlin-stores -l site=paris --name migrate-paris
+  flightctl bulk decommission --field-selector 'metadata.name in (edge-01,edge-02)'`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(newCmdBulkOperation("label KEY=VALUE...", "Add or replace labels on the matching devices.", cobra.MinimumNArgs(1), bulkLabelAction))
+	cmd.AddCommand(newCmdBulkOperation("unlabel KEY...", "Remove labels from the matching devices.", cobra.MinimumNArgs(1), bulkUnlabelAction))
+	cmd.AddCommand(newCmdBulkOperation("annotate KEY=VALUE...", "Set annotations on the matching devices.", cobra.MinimumNArgs(1), bulkAnnotateAction))
+	cmd.AddCommand(newCmdBulkOperation("decommission", "Decommission the matching devices.", cobra.NoArgs, bulkDecommissionAction))
+	cmd.AddCommand(newCmdBulkOperation("resume", "Resume the matching devices that are paused because of a conflict.", cobra.NoArgs, bulkResumeAction))
+	cmd.AddCommand(newCmdBulkOperation("move FLEET", "Move the matching devices to a fleet by setting the labels of the fleet's selector.", cobra.ExactArgs(1), bulkMoveAction))
+	cmd.AddCommand(newCmdBulkCancel())
+	return cmd
+}
+
+func newCmdBulkOperation(use, short string, args cobra.PositionalArgs, action bulkAction) *cobra.Command {
+	o := DefaultBulkOptions()
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  args,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			operation, err := action(o, args)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, operation)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	if strings.HasPrefix(use, "decommission") {
+		cmd.Flags().StringVarP(&o.DecommissionTarget, "target", "t", o.DecommissionTarget, "Specify the type of decommissioning operation: currently supports only 'unenroll'")
+	}
+	return cmd
+}
+
+func (o *BulkOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVarP(&o.LabelSelector, FlagSelector, "l", o.LabelSelector, "Selector (label query) of the devices to operate on, supporting operators like '=', '!=', and 'in' (e.g., -l='key1=value1,key2!=value2').")
+	fs.StringVar(&o.FieldSelector, FlagFieldSelector, o.FieldSelector, "Selector (field query) of the devices to operate on (e.g., --field-selector='metadata.owner=Fleet/test').")
+	fs.StringVar(&o.Name, "name", o.Name, "The name of the bulk operation. A name is generated if not specified.")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Validate the operation and report the number of matching devices, without applying it.")
+	fs.StringVarP(&o.Output, FlagOutput, "o", o.Output, fmt.Sprintf("Output format. One of: (%s, %s).", display.JSONFormat, display.YAMLFormat))
+}
+
+func (o *BulkOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+	if o.Name == "" {
+		o.Name = fmt.Sprintf("%s-%s", cmd.Name(), time.Now().UTC().Format("20060102-150405"))
+	}
+	return nil
+}
+
+func (o *BulkOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	if o.LabelSelector == "" && o.FieldSelector == "" {
+		return fmt.Errorf("at least one selector is required. Use --selector/-l or --field-selector")
+	}
+	if o.Output != "" && o.Output != string(display.JSONFormat) && o.Output != string(display.YAMLFormat) {
+		return fmt.Errorf("output format must be one of (%s, %s), got: %s", display.JSONFormat, display.YAMLFormat, o.Output)
+	}
+	return nil
+}
+
+func (o *BulkOptions) Run(ctx context.Context, action api.BulkOperationAction) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	operation := api.BulkOperation{
+		ApiVersion: api.BulkOperationAPIVersion,
+		Kind:       api.BulkOperationKind,
+		Metadata:   api.ObjectMeta{Name: lo.ToPtr(o.Name)},
+		Spec: api.BulkOperationSpec{
+			Selector: api.BulkOperationSelector{
+				LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+				FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			},
+			Operation: action,
+		},
+	}
+	params := api.CreateBulkOperationParams{}
+	if o.DryRun {
+		params.DryRun = lo.ToPtr(api.DryRunAll)
+	}
+	response, err := c.CreateBulkOperationWithResponse(ctx, &params, operation)
+	if err != nil {
+		return fmt.Errorf("creating bulk operation %s: %w", o.Name, err)
+	}
+	if response.StatusCode() != http.StatusCreated {
+		if err := validateResponse(response); err != nil {
+			return fmt.Errorf("creating bulk operation %s: %w", o.Name, err)
+		}
+	}
+	if response.JSON201 == nil {
+		return fmt.Errorf("creating bulk operation %s: empty response", o.Name)
+	}
+
+	if o.Output != "" {
+		return display.NewFormatter(display.OutputFormat(o.Output)).Format(response.JSON201, display.FormatOptions{Writer: os.Stdout})
+	}
+	printBulkOperationCreated(os.Stdout, response.JSON201, o.DryRun)
+	return nil
+}
+
+func printBulkOperationCreated(out io.Writer, operation *api.BulkOperation, dryRun bool) {
+	name := lo.FromPtr(operation.Metadata.Name)
+	matched := lo.FromPtr(operation.Status).MatchedDevices
+	if dryRun {
+		fmt.Fprintf(out, "Bulk operation %s would apply %s to %d devices (dry run)\n", name, operation.Spec.Operation.Type, matched)
+		return
+	}
+	fmt.Fprintf(out, "Bulk operation %s created, applying %s to %d devices\n", name, operation.Spec.Operation.Type, matched)
+	fmt.Fprintf(out, "Use 'flightctl get bulkoperation %s' to follow its progress.\n", name)
+}
+
+func bulkLabelAction(_ *BulkOptions, args []string) (api.BulkOperationAction, error) {
+	labels, err := parseKeyValuePairs(args)
+	if err != nil {
+		return api.BulkOperationAction{}, err
+	}
+	return api.BulkOperationAction{Type: api.BulkOperationTypeAddLabels, Labels: &labels}, nil
+}
+
+func bulkUnlabelAction(_ *BulkOptions, args []string) (api.BulkOperationAction, error) {
+	return api.BulkOperationAction{Type: api.BulkOperationTypeRemoveLabels, LabelKeys: lo.ToPtr(args)}, nil
+}
+
+func bulkAnnotateAction(_ *BulkOptions, args []string) (api.BulkOperationAction, error) {
+	annotations, err := parseKeyValuePairs(args)
+	if err != nil {
+		return api.BulkOperationAction{}, err
+	}
+	return api.BulkOperationAction{Type: api.BulkOperationTypeSetAnnotations, Annotations: &annotations}, nil
+}
+
+func bulkDecommissionAction(o *BulkOptions, _ []string) (api.BulkOperationAction, error) {
+	target := o.DecommissionTarget
+	if len(target) > 0 {
+		target = strings.ToUpper(target[:1]) + target[1:]
+	}
+	if !slices.Contains(allowedTargets, target) {
+		return api.BulkOperationAction{}, fmt.Errorf("decommission target must be one of: (%s)", strings.Join(allowedTargets, ", "))
+	}
+	decommission := api.DeviceDecommission{Target: api.DeviceDecommissionTargetType(target)}
+	return api.BulkOperationAction{Type: api.BulkOperationTypeDecommission, Decommission: &decommission}, nil
+}
+
+func bulkResumeAction(_ *BulkOptions, _ []string) (api.BulkOperationAction, error) {
+	return api.BulkOperationAction{Type: api.BulkOperationTypeResume}, nil
+}
+
+func bulkMoveAction(_ *BulkOptions, args []string) (api.BulkOperationAction, error) {
+	return api.BulkOperationAction{Type: api.BulkOperationTypeMoveToFleet, Fleet: lo.ToPtr(args[0])}, nil
+}
+
+// parseKeyValuePairs parses arguments of the form KEY=VALUE into a map
+func parseKeyValuePairs(args []string) (map[string]string, error) {
+	pairs := make(map[string]string, len(args))
+	for _, arg := range args {
+		key, value, found := strings.Cut(arg, "=")
+		if !found || key == "" {
+			return nil, fmt.Errorf("invalid argument %q: must be in the KEY=VALUE format", arg)
+		}
+		pairs[key] = value
+	}
+	return pairs, nil
+}
+
+type BulkCancelOptions struct {
+	GlobalOptions
+}
+
+func DefaultBulkCancelOptions() *BulkCancelOptions {
+	return &BulkCancelOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func newCmdBulkCancel() *cobra.Command {
+	o := DefaultBulkCancelOptions()
+	cmd := &cobra.Command{
+		Use:   "cancel NAME",
+		Short: "Cancel a bulk operation.",
+		Long:  `Cancel a bulk operation. Devices that were already processed keep the changes that were applied to them.`,
+		Args:  cobra.ExactArgs(1),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{BulkOperationKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.GlobalOptions.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *BulkCancelOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	name := args[0]
+	response, err := c.CancelBulkOperationWithResponse(ctx, name)
+	if err != nil {
+		return fmt.Errorf("canceling bulk operation %s: %w", name, err)
+	}
+	if err := validateResponse(response); err != nil {
+		return fmt.Errorf("canceling bulk operation %s: %w", name, err)
+	}
+	if response.JSON200 == nil {
+		return fmt.Errorf("canceling bulk operation %s: empty response", name)
+	}
+
+	status := lo.FromPtr(response.JSON200.Status)
+	fmt.Printf("Bulk operation %s canceled after processing %d of %d devices\n", name, status.ProcessedDevices, status.MatchedDevices)
+	return nil
+}
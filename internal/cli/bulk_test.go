@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseKeyValuePairs(t *testing.T) {
+	pairs, err := parseKeyValuePairs([]string{"site=berlin", "example.com/rack=", "tier=a=b"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"site": "berlin", "example.com/rack": "", "tier": "a=b"}, pairs)
+
+	_, err = parseKeyValuePairs([]string{"site"})
+	require.ErrorContains(t, err, "KEY=VALUE")
+
+	_, err = parseKeyValuePairs([]string{"=berlin"})
+	require.ErrorContains(t, err, "KEY=VALUE")
+}
+
+func TestBulkDecommissionAction(t *testing.T) {
+	o := DefaultBulkOptions()
+	o.DecommissionTarget = "unenroll"
+	action, err := bulkDecommissionAction(o, nil)
+	require.NoError(t, err)
+	require.Equal(t, api.BulkOperationTypeDecommission, action.Type)
+	require.Equal(t, api.DeviceDecommissionTargetTypeUnenroll, action.Decommission.Target)
+
+	o.DecommissionTarget = "explode"
+	_, err = bulkDecommissionAction(o, nil)
+	require.ErrorContains(t, err, "decommission target must be one of")
+}
+
+func TestPrintBulkOperationCreated(t *testing.T) {
+	operation := &api.BulkOperation{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr("migrate-paris")},
+		Spec:     api.BulkOperationSpec{Operation: api.BulkOperationAction{Type: api.BulkOperationTypeMoveToFleet}},
+		Status:   &api.BulkOperationStatus{MatchedDevices: 5000},
+	}
+
+	var out bytes.Buffer
+	printBulkOperationCreated(&out, operation, false)
+	require.Contains(t, out.String(), "Bulk operation migrate-paris created, applying MoveToFleet to 5000 devices")
+	require.Contains(t, out.String(), "flightctl get bulkoperation migrate-paris")
+
+	out.Reset()
+	printBulkOperationCreated(&out, operation, true)
+	require.Equal(t, "Bulk operation migrate-paris would apply MoveToFleet to 5000 devices (dry run)\n", out.String())
+}
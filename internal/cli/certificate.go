@@ -256,7 +256,7 @@ func (o *CertificateOptions) submitCsrWithRetries(ctx context.Context, c *apicli
 		}
 
 		fmt.Fprintf(os.Stderr, "Submitting certificate signing request %q...", csrName)
-		response, err := c.CreateCertificateSigningRequestWithBodyWithResponse(ctx, "application/json", bytes.NewReader(csrResourceJSON))
+		response, err := c.CreateCertificateSigningRequestWithBodyWithResponse(ctx, nil, "application/json", bytes.NewReader(csrResourceJSON))
 		if err != nil {
 			return "", fmt.Errorf("submitting CSR: %w", err)
 		}
@@ -295,6 +295,33 @@ func (kna *KindNameAutocomplete) getAutocompleteNames(cmd *cobra.Command, o Clie
 					}
 				}
 			}
+		case EventSubscriptionKind:
+			resp, err := c.ListEventSubscriptionsWithResponse(context.Background(), &api.ListEventSubscriptionsParams{})
+			if err == nil && resp.JSON200 != nil {
+				for _, er := range resp.JSON200.Items {
+					if er.Metadata.Name != nil {
+						names = append(names, *er.Metadata.Name)
+					}
+				}
+			}
+		case BulkOperationKind:
+			resp, err := c.ListBulkOperationsWithResponse(context.Background(), &api.ListBulkOperationsParams{})
+			if err == nil && resp.JSON200 != nil {
+				for _, er := range resp.JSON200.Items {
+					if er.Metadata.Name != nil {
+						names = append(names, *er.Metadata.Name)
+					}
+				}
+			}
+		case EnrollmentPolicyKind:
+			resp, err := c.ListEnrollmentPoliciesWithResponse(context.Background(), &api.ListEnrollmentPoliciesParams{})
+			if err == nil && resp.JSON200 != nil {
+				for _, er := range resp.JSON200.Items {
+					if er.Metadata.Name != nil {
+						names = append(names, *er.Metadata.Name)
+					}
+				}
+			}
 		case TemplateVersionKind:
 			if kna.FleetName != nil {
 				resp, err := c.ListTemplateVersionsWithResponse(context.Background(), *kna.FleetName, &api.ListTemplateVersionsParams{})
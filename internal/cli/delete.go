@@ -163,6 +163,12 @@ func (o *DeleteOptions) deleteOne(ctx context.Context, c *apiclient.ClientWithRe
 		response, err = c.DeleteResourceSyncWithResponse(ctx, name)
 	case CertificateSigningRequestKind:
 		response, err = c.DeleteCertificateSigningRequestWithResponse(ctx, name)
+	case EventSubscriptionKind:
+		response, err = c.DeleteEventSubscriptionWithResponse(ctx, name)
+	case BulkOperationKind:
+		response, err = c.DeleteBulkOperationWithResponse(ctx, name)
+	case EnrollmentPolicyKind:
+		response, err = c.DeleteEnrollmentPolicyWithResponse(ctx, name)
 	default:
 		return nil, fmt.Errorf("unsupported resource kind: %s", kind)
 	}
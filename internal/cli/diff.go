@@ -0,0 +1,402 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	apiclient "github.com/flightctl/flightctl/internal/api/client"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DiffFormatUnified prints a unified diff of the YAML of the resources
+	DiffFormatUnified = "unified"
+	// DiffFormatStructured prints the fields that are added, removed or changed
+	DiffFormatStructured = "structured"
+)
+
+// serverManagedMetadataFields are the metadata fields that are set by the service, and therefore not compared
+var serverManagedMetadataFields = []string{"resourceVersion", "generation", "owner", "creationTimestamp", "deletionTimestamp"}
+
+// serverManagedAnnotationPrefixes are the prefixes of the annotations that are set by the service
+var serverManagedAnnotationPrefixes = []string{"device-controller/", "fleet-controller/", "resourcesync-controller/"}
+
+// sensitiveFields are the paths of the fields whose values the service masks when returning a resource, by kind
+var sensitiveFields = map[ResourceKind][][]string{
+	RepositoryKind: {
+		{"spec", "httpConfig", "password"},
+		{"spec", "httpConfig", "tlsKey"},
+		{"spec", "httpConfig", "tlsCrt"},
+		{"spec", "sshConfig", "sshPrivateKey"},
+		{"spec", "sshConfig", "privateKeyPassphrase"},
+		{"spec", "webhookSecret"},
+	},
+	EventSubscriptionKind: {
+		{"spec", "webhook", "secret"},
+	},
+}
+
+// maskedValue replaces the values of sensitive fields, as the service does
+const maskedValue = "*****"
+
+type DiffOptions struct {
+	GlobalOptions
+
+	Filenames []string
+	Recursive bool
+	Output    string
+}
+
+func DefaultDiffOptions() *DiffOptions {
+	return &DiffOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Filenames:     []string{},
+		Recursive:     false,
+		Output:        DiffFormatUnified,
+	}
+}
+
+func NewCmdDiff() *cobra.Command {
+	o := DefaultDiffOptions()
+	cmd := &cobra.Command{
+		Use:   "diff -f FILENAME",
+		Short: "Show the differences between resources in files and the resources in the service.",
+		Long: `Show the differences between the resources defined in files or stdin and the resources in the service.
+
+Fields that are managed by the service, such as the status, the resource version and the owner, are not compared.
+Secrets, such as the credentials of repositories, are masked on both sides, so only their addition or removal is
+shown.  Resources that don't exist in the service are shown as added.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *DiffOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringSliceVarP(&o.Filenames, "filename", "f", o.Filenames, "The files or directory that contain the resources to compare.")
+	bindFilenameCompletion(fs)
+	fs.BoolVarP(&o.Recursive, "recursive", "R", o.Recursive, "Process the directory used in -f, --filename recursively.")
+	fs.StringVarP(&o.Output, "output", "o", o.Output, fmt.Sprintf("Output format. One of: (%s, %s).", DiffFormatUnified, DiffFormatStructured))
+}
+
+func (o *DiffOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *DiffOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	if len(o.Filenames) == 0 {
+		return fmt.Errorf("must specify -f FILENAME")
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v (did you forget to quote wildcards?)", args)
+	}
+	if o.Output != DiffFormatUnified && o.Output != DiffFormatStructured {
+		return fmt.Errorf("output format must be one of (%s, %s), got: %s", DiffFormatUnified, DiffFormatStructured, o.Output)
+	}
+	return nil
+}
+
+func (o *DiffOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	errs := walkResourceFiles(o.Filenames, o.Recursive, func(filename string, r io.Reader) []error {
+		return o.diffFromReader(ctx, c, filename, r)
+	})
+	return errors.Join(errs...)
+}
+
+func (o *DiffOptions) diffFromReader(ctx context.Context, c *apiclient.ClientWithResponses, filename string, r io.Reader) []error {
+	resources, err := decodeResources(r)
+	if err != nil {
+		return []error{fmt.Errorf("%s: %w", filename, err)}
+	}
+
+	errs := make([]error, 0)
+	for _, resource := range resources {
+		kindLike, name, err := resourceKindAndName(filename, resource)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		kind, err := ResourceKindFromString(kindLike)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: skipping resource of unknown kind %q", filename, kindLike))
+			continue
+		}
+
+		live, err := fetchLiveResource(ctx, c, kind, name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: fetching %s/%s: %w", filename, kind, name, err))
+			continue
+		}
+
+		local := maskSensitiveFields(kind, stripServerManagedFields(map[string]interface{}(resource)))
+		if live != nil {
+			live = maskSensitiveFields(kind, stripServerManagedFields(live))
+		}
+
+		var out string
+		switch o.Output {
+		case DiffFormatStructured:
+			out = structuredDiff(fmt.Sprintf("%s/%s", kind, name), live, local)
+		default:
+			out, err = unifiedDiff(fmt.Sprintf("%s/%s", kind, name), filename, live, local)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: comparing %s/%s: %w", filename, kind, name, err))
+			continue
+		}
+		fmt.Fprint(os.Stdout, out)
+	}
+	return errs
+}
+
+// fetchLiveResource returns the resource as stored in the service, or nil if it doesn't exist
+func fetchLiveResource(ctx context.Context, c *apiclient.ClientWithResponses, kind ResourceKind, name string) (map[string]interface{}, error) {
+	response, err := GetSingleResource(ctx, c, kind, name)
+	if err != nil {
+		return nil, err
+	}
+	httpResponse, err := responseField[*http.Response](response, "HTTPResponse")
+	if err != nil {
+		return nil, err
+	}
+	if httpResponse.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if err := validateResponse(response); err != nil {
+		return nil, err
+	}
+	body, err := responseField[[]byte](response, "Body")
+	if err != nil {
+		return nil, err
+	}
+	var live map[string]interface{}
+	if err := json.Unmarshal(body, &live); err != nil {
+		return nil, fmt.Errorf("unmarshalling resource: %w", err)
+	}
+	return live, nil
+}
+
+// stripServerManagedFields returns a copy of the resource without the fields that are managed by the service, and
+// without null values and empty maps and lists, so that only the fields set by the user are compared
+func stripServerManagedFields(resource map[string]interface{}) map[string]interface{} {
+	stripped, _ := pruneEmpty(resource).(map[string]interface{})
+	if stripped == nil {
+		return map[string]interface{}{}
+	}
+	delete(stripped, "status")
+
+	metadata, ok := stripped["metadata"].(map[string]interface{})
+	if !ok {
+		return stripped
+	}
+	for _, field := range serverManagedMetadataFields {
+		delete(metadata, field)
+	}
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+		for key := range annotations {
+			for _, prefix := range serverManagedAnnotationPrefixes {
+				if strings.HasPrefix(key, prefix) {
+					delete(annotations, key)
+				}
+			}
+		}
+		if len(annotations) == 0 {
+			delete(metadata, "annotations")
+		}
+	}
+	return stripped
+}
+
+// maskSensitiveFields masks the values of the secrets of the resource in place.  The service returns secrets masked,
+// so their values can't be compared, and the local values must not be printed.
+func maskSensitiveFields(kind ResourceKind, resource map[string]interface{}) map[string]interface{} {
+	for _, path := range sensitiveFields[kind] {
+		parent := resource
+		for _, key := range path[:len(path)-1] {
+			child, ok := parent[key].(map[string]interface{})
+			if !ok {
+				parent = nil
+				break
+			}
+			parent = child
+		}
+		if parent == nil {
+			continue
+		}
+		if _, ok := parent[path[len(path)-1]]; ok {
+			parent[path[len(path)-1]] = maskedValue
+		}
+	}
+	return resource
+}
+
+// pruneEmpty returns a copy of the value without null values and empty maps and lists
+func pruneEmpty(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(typed))
+		for key, v := range typed {
+			pruned := pruneEmpty(v)
+			if pruned == nil {
+				continue
+			}
+			result[key] = pruned
+		}
+		if len(result) == 0 {
+			return nil
+		}
+		return result
+	case []interface{}:
+		if len(typed) == 0 {
+			return nil
+		}
+		result := make([]interface{}, 0, len(typed))
+		for _, v := range typed {
+			result = append(result, pruneEmpty(v))
+		}
+		return result
+	default:
+		return value
+	}
+}
+
+// unifiedDiff returns the unified diff between the YAML of the live and the local resource
+func unifiedDiff(resourceName, filename string, live, local map[string]interface{}) (string, error) {
+	var liveYAML []byte
+	if live != nil {
+		var err error
+		if liveYAML, err = yaml.Marshal(live); err != nil {
+			return "", err
+		}
+	}
+	localYAML, err := yaml.Marshal(local)
+	if err != nil {
+		return "", err
+	}
+
+	fromFile := "live/" + resourceName
+	if live == nil {
+		fromFile = "/dev/null"
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(liveYAML)),
+		B:        difflib.SplitLines(string(localYAML)),
+		FromFile: fromFile,
+		ToFile:   fmt.Sprintf("local/%s (%s)", resourceName, filename),
+		Context:  3,
+	})
+}
+
+// fieldChange is a field that differs between the live and the local resource
+type fieldChange struct {
+	path string
+	live interface{}
+	// local is nil if the field is removed
+	local interface{}
+}
+
+// structuredDiff returns the fields that are added, removed or changed by the local resource, one per line
+func structuredDiff(resourceName string, live, local map[string]interface{}) string {
+	if live == nil {
+		return fmt.Sprintf("%s: created\n", resourceName)
+	}
+	changes := compareFields("", live, local)
+	if len(changes) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s: changed\n", resourceName)
+	for _, change := range changes {
+		switch {
+		case change.live == nil:
+			fmt.Fprintf(&sb, "  + %s: %s\n", change.path, formatDiffValue(change.local))
+		case change.local == nil:
+			fmt.Fprintf(&sb, "  - %s: %s\n", change.path, formatDiffValue(change.live))
+		default:
+			fmt.Fprintf(&sb, "  ~ %s: %s -> %s\n", change.path, formatDiffValue(change.live), formatDiffValue(change.local))
+		}
+	}
+	return sb.String()
+}
+
+// compareFields returns the changes between two values, descending into maps.  Lists are compared as a whole.
+func compareFields(path string, live, local interface{}) []fieldChange {
+	liveMap, liveIsMap := live.(map[string]interface{})
+	localMap, localIsMap := local.(map[string]interface{})
+	if !liveIsMap || !localIsMap {
+		if jsonEqual(live, local) {
+			return nil
+		}
+		return []fieldChange{{path: path, live: live, local: local}}
+	}
+
+	keys := make(map[string]struct{}, len(liveMap)+len(localMap))
+	for key := range liveMap {
+		keys[key] = struct{}{}
+	}
+	for key := range localMap {
+		keys[key] = struct{}{}
+	}
+	sortedKeys := make([]string, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Strings(sortedKeys)
+
+	var changes []fieldChange
+	for _, key := range sortedKeys {
+		childPath := key
+		if path != "" {
+			childPath = path + "." + key
+		}
+		changes = append(changes, compareFields(childPath, liveMap[key], localMap[key])...)
+	}
+	return changes
+}
+
+func jsonEqual(a, b interface{}) bool {
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aJSON) == string(bJSON)
+}
+
+func formatDiffValue(value interface{}) string {
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(buf)
+}
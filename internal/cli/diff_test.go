@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStripServerManagedFields(t *testing.T) {
+	resource := map[string]interface{}{
+		"apiVersion": "v1alpha1",
+		"kind":       "Fleet",
+		"metadata": map[string]interface{}{
+			"name":            "fleet1",
+			"resourceVersion": "12",
+			"generation":      float64(3),
+			"owner":           "ResourceSync/rs1",
+			"labels":          map[string]interface{}{},
+			"annotations": map[string]interface{}{
+				"fleet-controller/templateVersion": "fleet1-1",
+				"team":                             "edge",
+			},
+		},
+		"spec": map[string]interface{}{
+			"selector": nil,
+			"template": map[string]interface{}{"spec": map[string]interface{}{"os": map[string]interface{}{"image": "quay.io/os:1"}}},
+		},
+		"status": map[string]interface{}{"conditions": []interface{}{}},
+	}
+
+	require.Equal(t, map[string]interface{}{
+		"apiVersion": "v1alpha1",
+		"kind":       "Fleet",
+		"metadata": map[string]interface{}{
+			"name":        "fleet1",
+			"annotations": map[string]interface{}{"team": "edge"},
+		},
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{"spec": map[string]interface{}{"os": map[string]interface{}{"image": "quay.io/os:1"}}},
+		},
+	}, stripServerManagedFields(resource))
+}
+
+func TestMaskSensitiveFields(t *testing.T) {
+	live := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "repo1"},
+		"spec": map[string]interface{}{
+			"type":       "http",
+			"url":        "https://example.com/repo.git",
+			"httpConfig": map[string]interface{}{"username": "user", "password": "*****"},
+		},
+	}
+	local := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "repo1"},
+		"spec": map[string]interface{}{
+			"type":          "http",
+			"url":           "https://example.com/repo.git",
+			"httpConfig":    map[string]interface{}{"username": "user", "password": "plaintext"},
+			"webhookSecret": "plaintext",
+		},
+	}
+
+	out := structuredDiff("repository/repo1", maskSensitiveFields(RepositoryKind, live), maskSensitiveFields(RepositoryKind, local))
+	require.Equal(t, "repository/repo1: changed\n"+
+		"  + spec.webhookSecret: \"*****\"\n", out)
+	require.NotContains(t, out, "plaintext")
+
+	subscription := map[string]interface{}{
+		"spec": map[string]interface{}{"webhook": map[string]interface{}{"url": "https://example.com", "secret": "plaintext"}},
+	}
+	require.Equal(t, map[string]interface{}{
+		"spec": map[string]interface{}{"webhook": map[string]interface{}{"url": "https://example.com", "secret": "*****"}},
+	}, maskSensitiveFields(EventSubscriptionKind, subscription))
+}
+
+func TestStructuredDiff(t *testing.T) {
+	live := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "fleet1", "labels": map[string]interface{}{"env": "prod"}},
+		"spec":     map[string]interface{}{"image": "quay.io/os:1"},
+	}
+
+	tests := []struct {
+		name     string
+		live     map[string]interface{}
+		local    map[string]interface{}
+		expected string
+	}{
+		{
+			name:     "created",
+			live:     nil,
+			local:    live,
+			expected: "fleet/fleet1: created\n",
+		},
+		{
+			name:     "unchanged",
+			live:     live,
+			local:    live,
+			expected: "",
+		},
+		{
+			name: "changed",
+			live: live,
+			local: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "fleet1", "labels": map[string]interface{}{"site": "a"}},
+				"spec":     map[string]interface{}{"image": "quay.io/os:2"},
+			},
+			expected: "fleet/fleet1: changed\n" +
+				"  - metadata.labels.env: \"prod\"\n" +
+				"  + metadata.labels.site: \"a\"\n" +
+				"  ~ spec.image: \"quay.io/os:1\" -> \"quay.io/os:2\"\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, structuredDiff("fleet/fleet1", tt.live, tt.local))
+		})
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	live := map[string]interface{}{"spec": map[string]interface{}{"image": "quay.io/os:1"}}
+	local := map[string]interface{}{"spec": map[string]interface{}{"image": "quay.io/os:2"}}
+
+	out, err := unifiedDiff("fleet/fleet1", "fleet.yaml", live, local)
+	require.NoError(t, err)
+	require.Contains(t, out, "--- live/fleet/fleet1")
+	require.Contains(t, out, "+++ local/fleet/fleet1 (fleet.yaml)")
+	require.Contains(t, out, "-  image: quay.io/os:1")
+	require.Contains(t, out, "+  image: quay.io/os:2")
+
+	out, err = unifiedDiff("fleet/fleet1", "fleet.yaml", live, live)
+	require.NoError(t, err)
+	require.Empty(t, out)
+
+	out, err = unifiedDiff("fleet/fleet1", "fleet.yaml", nil, local)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(out, "--- /dev/null"))
+}
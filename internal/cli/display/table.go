@@ -10,6 +10,7 @@ import (
 	api "github.com/flightctl/flightctl/api/v1alpha1"
 	apiclient "github.com/flightctl/flightctl/internal/api/client"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
 )
 
 const NoneString = "<none>"
@@ -74,6 +75,12 @@ func (f *TableFormatter) formatList(w *tabwriter.Writer, data interface{}, optio
 		return f.printCSRTable(w, data.(*apiclient.ListCertificateSigningRequestsResponse).JSON200.Items...)
 	case strings.EqualFold(options.Kind, api.EventKind):
 		return f.printEventsTable(w, data.(*apiclient.ListEventsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.EventSubscriptionKind):
+		return f.printEventSubscriptionsTable(w, data.(*apiclient.ListEventSubscriptionsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.BulkOperationKind):
+		return f.printBulkOperationsTable(w, data.(*apiclient.ListBulkOperationsResponse).JSON200.Items...)
+	case strings.EqualFold(options.Kind, api.EnrollmentPolicyKind):
+		return f.printEnrollmentPoliciesTable(w, data.(*apiclient.ListEnrollmentPoliciesResponse).JSON200.Items...)
 	default:
 		return fmt.Errorf("unknown resource type %s", options.Kind)
 	}
@@ -117,6 +124,12 @@ func (f *TableFormatter) formatSingle(w *tabwriter.Writer, data interface{}, opt
 		return f.printResourceSyncsTable(w, *data.(*apiclient.GetResourceSyncResponse).JSON200)
 	case strings.EqualFold(options.Kind, api.CertificateSigningRequestKind):
 		return f.printCSRTable(w, *data.(*apiclient.GetCertificateSigningRequestResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.EventSubscriptionKind):
+		return f.printEventSubscriptionsTable(w, *data.(*apiclient.GetEventSubscriptionResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.BulkOperationKind):
+		return f.printBulkOperationsTable(w, *data.(*apiclient.GetBulkOperationResponse).JSON200)
+	case strings.EqualFold(options.Kind, api.EnrollmentPolicyKind):
+		return f.printEnrollmentPoliciesTable(w, *data.(*apiclient.GetEnrollmentPolicyResponse).JSON200)
 	default:
 		return fmt.Errorf("unknown resource type %s", options.Kind)
 	}
@@ -315,6 +328,72 @@ func (f *TableFormatter) printRepositoriesTable(w *tabwriter.Writer, repos ...ap
 	return nil
 }
 
+func (f *TableFormatter) printEventSubscriptionsTable(w *tabwriter.Writer, subscriptions ...api.EventSubscription) error {
+	f.printHeaderRowLn(w, "NAME", "WEBHOOK URL", "DELIVERING", "DELIVERED", "DEAD LETTERS", "LAST DELIVERY")
+	for _, s := range subscriptions {
+		delivering, delivered, deadLetters, lastDelivery := "Unknown", "0", "0", "<never>"
+		if s.Status != nil {
+			condition := api.FindStatusCondition(s.Status.Conditions, api.ConditionTypeEventSubscriptionDelivering)
+			if condition != nil {
+				delivering = string(condition.Status)
+			}
+			delivered = fmt.Sprintf("%d", lo.FromPtr(s.Status.DeliveredCount))
+			deadLetters = fmt.Sprintf("%d", len(lo.FromPtr(s.Status.DeadLetters)))
+			if s.Status.LastDeliveryTime != nil {
+				lastDelivery = humanize.Time(*s.Status.LastDeliveryTime)
+			}
+		}
+		f.printTableRowLn(w,
+			*s.Metadata.Name,
+			s.Spec.Webhook.Url,
+			delivering,
+			delivered,
+			deadLetters,
+			lastDelivery,
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printBulkOperationsTable(w *tabwriter.Writer, operations ...api.BulkOperation) error {
+	f.printHeaderRowLn(w, "NAME", "OPERATION", "PHASE", "MATCHED", "PROCESSED", "SUCCEEDED", "FAILED", "AGE")
+	for _, o := range operations {
+		status := lo.FromPtr(o.Status)
+		age := NoneString
+		if o.Metadata.CreationTimestamp != nil {
+			age = humanize.Time(*o.Metadata.CreationTimestamp)
+		}
+		f.printTableRowLn(w,
+			*o.Metadata.Name,
+			string(o.Spec.Operation.Type),
+			string(status.Phase),
+			fmt.Sprintf("%d", status.MatchedDevices),
+			fmt.Sprintf("%d", status.ProcessedDevices),
+			fmt.Sprintf("%d", status.SucceededDevices),
+			fmt.Sprintf("%d", status.FailedDevices),
+			age,
+		)
+	}
+	return nil
+}
+
+func (f *TableFormatter) printEnrollmentPoliciesTable(w *tabwriter.Writer, policies ...api.EnrollmentPolicy) error {
+	f.printHeaderRowLn(w, "NAME", "PRIORITY", "RULES", "AGE")
+	for _, p := range policies {
+		age := NoneString
+		if p.Metadata.CreationTimestamp != nil {
+			age = humanize.Time(*p.Metadata.CreationTimestamp)
+		}
+		f.printTableRowLn(w,
+			*p.Metadata.Name,
+			fmt.Sprintf("%d", lo.FromPtr(p.Spec.Priority)),
+			fmt.Sprintf("%d", len(p.Spec.Rules)),
+			age,
+		)
+	}
+	return nil
+}
+
 func (f *TableFormatter) printResourceSyncsTable(w *tabwriter.Writer, resourcesyncs ...api.ResourceSync) error {
 	f.printHeaderRowLn(w, "NAME", "REPOSITORY", "PATH", "REVISION", "ACCESSIBLE", "SYNCED", "LAST SYNC")
 
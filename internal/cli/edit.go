@@ -475,19 +475,19 @@ func (o *EditOptions) executePatchOperation(ctx context.Context, client *apiclie
 
 	switch kind {
 	case DeviceKind:
-		response, err := client.PatchDeviceWithBodyWithResponse(ctx, name, contentType, reader)
+		response, err := client.PatchDeviceWithBodyWithResponse(ctx, name, nil, contentType, reader)
 		return o.extractResponseData(response, err)
 	case FleetKind:
-		response, err := client.PatchFleetWithBodyWithResponse(ctx, name, contentType, reader)
+		response, err := client.PatchFleetWithBodyWithResponse(ctx, name, nil, contentType, reader)
 		return o.extractResponseData(response, err)
 	case RepositoryKind:
-		response, err := client.PatchRepositoryWithBodyWithResponse(ctx, name, contentType, reader)
+		response, err := client.PatchRepositoryWithBodyWithResponse(ctx, name, nil, contentType, reader)
 		return o.extractResponseData(response, err)
 	case CertificateSigningRequestKind:
-		response, err := client.PatchCertificateSigningRequestWithBodyWithResponse(ctx, name, contentType, reader)
+		response, err := client.PatchCertificateSigningRequestWithBodyWithResponse(ctx, name, nil, contentType, reader)
 		return o.extractResponseData(response, err)
 	case EnrollmentRequestKind:
-		response, err := client.PatchEnrollmentRequestWithBodyWithResponse(ctx, name, contentType, reader)
+		response, err := client.PatchEnrollmentRequestWithBodyWithResponse(ctx, name, nil, contentType, reader)
 		return o.extractResponseData(response, err)
 	default:
 		return nil, nil, fmt.Errorf("unsupported resource kind for editing: %s (PATCH not supported)", kind)
@@ -0,0 +1,250 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	apiclient "github.com/flightctl/flightctl/internal/api/client"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+type FleetPreviewOptions struct {
+	GlobalOptions
+
+	Filenames  []string
+	Recursive  bool
+	SampleSize int32
+	Output     string
+}
+
+func DefaultFleetPreviewOptions() *FleetPreviewOptions {
+	return &FleetPreviewOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Filenames:     []string{},
+		Recursive:     false,
+		SampleSize:    5,
+		Output:        "",
+	}
+}
+
+func NewCmdFleet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fleet",
+		Short: "Inspect changes to fleets.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceUsage: true,
+	}
+	cmd.AddCommand(newCmdFleetPreview())
+	return cmd
+}
+
+func newCmdFleetPreview() *cobra.Command {
+	o := DefaultFleetPreviewOptions()
+	cmd := &cobra.Command{
+		Use:   "preview -f FILENAME",
+		Short: "Preview the devices that applying fleets would affect.",
+		Long: `Preview the devices that applying the fleets defined in files or stdin would affect, without applying them.
+
+For each fleet, lists the devices that the fleet would gain, the devices it would lose, and the devices that would
+match more than one fleet. The template is rendered for a sample of the fleet's devices, and shown as a diff against
+the current spec of each device.
+
+Examples:
+  flightctl fleet preview -f fleet.yaml
+  flightctl fleet preview -f fleets/ -R --sample-size 10`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *FleetPreviewOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringSliceVarP(&o.Filenames, "filename", "f", o.Filenames, "The files or directory that contain the fleets to preview.")
+	bindFilenameCompletion(fs)
+	fs.BoolVarP(&o.Recursive, "recursive", "R", o.Recursive, "Process the directory used in -f, --filename recursively.")
+	fs.Int32Var(&o.SampleSize, "sample-size", o.SampleSize, "The number of devices for which the rendered template is shown.")
+	fs.StringVarP(&o.Output, "output", "o", o.Output, fmt.Sprintf("Output format. One of: (%s, %s).", display.JSONFormat, display.YAMLFormat))
+}
+
+func (o *FleetPreviewOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *FleetPreviewOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	if len(o.Filenames) == 0 {
+		return fmt.Errorf("must specify -f FILENAME")
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments: %v (did you forget to quote wildcards?)", args)
+	}
+	if o.SampleSize < 0 || o.SampleSize > 100 {
+		return fmt.Errorf("sample size must be between 0 and 100, got: %d", o.SampleSize)
+	}
+	if o.Output != "" && o.Output != string(display.JSONFormat) && o.Output != string(display.YAMLFormat) {
+		return fmt.Errorf("output format must be one of (%s, %s), got: %s", display.JSONFormat, display.YAMLFormat, o.Output)
+	}
+	return nil
+}
+
+func (o *FleetPreviewOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	errs := walkResourceFiles(o.Filenames, o.Recursive, func(filename string, r io.Reader) []error {
+		return o.previewFromReader(ctx, c, filename, r)
+	})
+	return errors.Join(errs...)
+}
+
+func (o *FleetPreviewOptions) previewFromReader(ctx context.Context, c *apiclient.ClientWithResponses, filename string, r io.Reader) []error {
+	resources, err := decodeResources(r)
+	if err != nil {
+		return []error{fmt.Errorf("%s: %w", filename, err)}
+	}
+
+	errs := make([]error, 0)
+	for _, resource := range resources {
+		kindLike, name, err := resourceKindAndName(filename, resource)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if kind, err := ResourceKindFromString(kindLike); err != nil || kind != FleetKind {
+			errs = append(errs, fmt.Errorf("%s: skipping resource of kind %q, only fleets can be previewed", filename, kindLike))
+			continue
+		}
+
+		buf, err := json.Marshal(resource)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: marshalling fleet %s: %w", filename, name, err))
+			continue
+		}
+		response, err := c.PreviewFleetWithBodyWithResponse(ctx, name, &api.PreviewFleetParams{SampleSize: &o.SampleSize}, "application/json", bytes.NewReader(buf))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: previewing fleet %s: %w", filename, name, err))
+			continue
+		}
+		if err := validateResponse(response); err != nil {
+			errs = append(errs, fmt.Errorf("%s: previewing fleet %s: %w", filename, name, err))
+			continue
+		}
+		if response.JSON200 == nil {
+			errs = append(errs, fmt.Errorf("%s: previewing fleet %s: empty response", filename, name))
+			continue
+		}
+
+		switch o.Output {
+		case string(display.JSONFormat), string(display.YAMLFormat):
+			err = display.NewFormatter(display.OutputFormat(o.Output)).Format(response.JSON200, display.FormatOptions{Writer: os.Stdout})
+		default:
+			err = printFleetPreview(os.Stdout, name, response.JSON200)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: printing preview of fleet %s: %w", filename, name, err))
+		}
+	}
+	return errs
+}
+
+func printFleetPreview(out io.Writer, name string, preview *api.FleetPreview) error {
+	fmt.Fprintf(out, "Fleet %s matches %d devices\n", name, preview.Summary.MatchingDevices)
+
+	printPreviewDevices(out, "Devices gained", preview.Summary.DevicesGained, preview.DevicesGained, func(device api.FleetPreviewDevice) string {
+		return fmt.Sprintf("from %s", lo.FromPtrOr(device.CurrentOwner, display.NoneString))
+	})
+	printPreviewDevices(out, "Devices lost", preview.Summary.DevicesLost, preview.DevicesLost, func(device api.FleetPreviewDevice) string {
+		if device.NewOwner == nil {
+			return "no longer in any fleet"
+		}
+		return fmt.Sprintf("to %s", *device.NewOwner)
+	})
+	printPreviewDevices(out, "New conflicts", preview.Summary.NewConflicts, preview.NewConflicts, func(device api.FleetPreviewDevice) string {
+		return fmt.Sprintf("matches fleets %s, stays in %s", strings.Join(lo.FromPtr(device.MatchingFleets), ", "), lo.FromPtrOr(device.CurrentOwner, display.NoneString))
+	})
+
+	for _, sample := range preview.Samples {
+		fmt.Fprintf(out, "\nDevice %s:\n", sample.Name)
+		if sample.Errors != nil {
+			for _, e := range *sample.Errors {
+				fmt.Fprintf(out, "  error: %s\n", e)
+			}
+			continue
+		}
+		diff, err := deviceSpecDiff(sample.Name, sample.CurrentSpec, sample.RenderedSpec)
+		if err != nil {
+			return err
+		}
+		if diff == "" {
+			fmt.Fprintln(out, "  no changes")
+			continue
+		}
+		fmt.Fprint(out, diff)
+	}
+	return nil
+}
+
+func printPreviewDevices(out io.Writer, title string, total int64, devices []api.FleetPreviewDevice, describe func(api.FleetPreviewDevice) string) {
+	fmt.Fprintf(out, "%s: %d\n", title, total)
+	for _, device := range devices {
+		fmt.Fprintf(out, "  %s (%s)\n", device.Name, describe(device))
+	}
+	if remaining := total - int64(len(devices)); remaining > 0 {
+		fmt.Fprintf(out, "  ... and %d more\n", remaining)
+	}
+}
+
+// deviceSpecDiff returns the unified diff between the YAML of the current and the rendered spec of a device
+func deviceSpecDiff(name string, current, rendered *api.DeviceSpec) (string, error) {
+	var currentYAML, renderedYAML []byte
+	var err error
+	if current != nil {
+		if currentYAML, err = yaml.Marshal(current); err != nil {
+			return "", err
+		}
+	}
+	if rendered != nil {
+		if renderedYAML, err = yaml.Marshal(rendered); err != nil {
+			return "", err
+		}
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(currentYAML)),
+		B:        difflib.SplitLines(string(renderedYAML)),
+		FromFile: "current/" + name,
+		ToFile:   "rendered/" + name,
+		Context:  3,
+	})
+}
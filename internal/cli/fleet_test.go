@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintFleetPreview(t *testing.T) {
+	preview := &api.FleetPreview{
+		Summary: api.FleetPreviewSummary{MatchingDevices: 2, DevicesGained: 1, DevicesLost: 3, NewConflicts: 1},
+		DevicesGained: []api.FleetPreviewDevice{
+			{Name: "dev-gained", NewOwner: lo.ToPtr("Fleet/site")},
+		},
+		DevicesLost: []api.FleetPreviewDevice{
+			{Name: "dev-orphaned", CurrentOwner: lo.ToPtr("Fleet/site")},
+			{Name: "dev-moved", CurrentOwner: lo.ToPtr("Fleet/site"), NewOwner: lo.ToPtr("Fleet/other")},
+		},
+		NewConflicts: []api.FleetPreviewDevice{
+			{Name: "dev-conflict", CurrentOwner: lo.ToPtr("Fleet/zone"), NewOwner: lo.ToPtr("Fleet/zone"), MatchingFleets: &[]string{"site", "zone"}},
+		},
+		Samples: []api.FleetPreviewSample{
+			{
+				Name:         "dev-gained",
+				CurrentSpec:  &api.DeviceSpec{Os: &api.DeviceOsSpec{Image: "quay.io/flightctl/device:v1"}},
+				RenderedSpec: &api.DeviceSpec{Os: &api.DeviceOsSpec{Image: "quay.io/flightctl/device:b"}},
+			},
+			{
+				Name:   "dev-broken",
+				Errors: &[]string{"map has no entry for key \"site\""},
+			},
+		},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, printFleetPreview(&out, "site", preview))
+	s := out.String()
+	require.Contains(t, s, "Fleet site matches 2 devices")
+	require.Contains(t, s, "dev-gained (from <none>)")
+	require.Contains(t, s, "dev-orphaned (no longer in any fleet)")
+	require.Contains(t, s, "dev-moved (to Fleet/other)")
+	require.Contains(t, s, "... and 1 more")
+	require.Contains(t, s, "dev-conflict (matches fleets site, zone, stays in Fleet/zone)")
+	require.Contains(t, s, "-  image: quay.io/flightctl/device:v1")
+	require.Contains(t, s, "+  image: quay.io/flightctl/device:b")
+	require.Contains(t, s, "error: map has no entry for key \"site\"")
+}
@@ -37,6 +37,7 @@ const (
 	FlagSummary     = "summary"      // for listing devices and fleets
 	FlagSummaryOnly = "summary-only" // for listing devices
 	FlagLastSeen    = "last-seen"    // for a single device
+	FlagWatch       = "watch"        // for listing devices, fleets and events
 )
 
 type FlagContextualRule struct {
@@ -58,6 +59,7 @@ type GetOptions struct {
 	Summary       bool
 	SummaryOnly   bool
 	LastSeen      bool
+	Watch         bool
 }
 
 func DefaultGetOptions() *GetOptions {
@@ -92,6 +94,10 @@ func NewCmdGet() *cobra.Command {
 			if err := o.Validate(args); err != nil {
 				return err
 			}
+			if o.Watch {
+				// a watch runs until it is interrupted, so the request timeout does not apply
+				return o.Run(cmd.Context(), args)
+			}
 			ctx, cancel := o.WithTimeout(cmd.Context())
 			defer cancel()
 			return o.Run(ctx, args)
@@ -122,6 +128,7 @@ func (o *GetOptions) Bind(fs *pflag.FlagSet) {
 	fs.BoolVarP(&o.Summary, FlagSummary, "s", false, "Display summary information.")
 	fs.BoolVar(&o.SummaryOnly, FlagSummaryOnly, false, "Display summary information only.")
 	fs.BoolVar(&o.LastSeen, FlagLastSeen, false, "Display the last seen timestamp of the device.")
+	fs.BoolVarP(&o.Watch, FlagWatch, "w", false, "After listing the resources, watch for changes to them.")
 	o.hideHelpContextualFlags(fs)
 }
 
@@ -131,6 +138,7 @@ var flagContextualRules = []FlagContextualRule{
 	{FlagRendered, []ResourceKind{DeviceKind}, []string{"single"}},
 	{FlagLastSeen, []ResourceKind{DeviceKind}, []string{"single"}},
 	{FlagFleetName, []ResourceKind{TemplateVersionKind}, []string{"any"}},
+	{FlagWatch, []ResourceKind{DeviceKind, FleetKind, EventKind}, []string{"list"}},
 }
 
 func (o *GetOptions) hideHelpContextualFlags(fs *pflag.FlagSet) {
@@ -237,6 +245,7 @@ func (o *GetOptions) Validate(args []string) error {
 		func() error { return o.validateSingleResourceRestrictions(kind, names) },
 		func() error { return o.validateLimit() },
 		func() error { return o.validateLastSeen(kind, names) },
+		func() error { return o.validateWatch(kind, names) },
 	}
 
 	for _, v := range validators {
@@ -355,6 +364,23 @@ func (o *GetOptions) validateLastSeen(kind ResourceKind, names []string) error {
 	return nil
 }
 
+// validateWatch checks the usage of the --watch flag.
+func (o *GetOptions) validateWatch(kind ResourceKind, names []string) error {
+	if !o.Watch {
+		return nil
+	}
+	if (kind != DeviceKind && kind != FleetKind && kind != EventKind) || len(names) > 0 {
+		return fmt.Errorf("'--watch' can only be used when getting a list of devices, fleets or events")
+	}
+	if kind == DeviceKind && (o.Summary || o.SummaryOnly) {
+		return fmt.Errorf("'--watch' cannot be combined with '--summary' or '--summary-only'")
+	}
+	if o.Limit > 0 || len(o.Continue) > 0 {
+		return fmt.Errorf("flags '--limit' and '--continue' are not supported when '--watch' is specified")
+	}
+	return nil
+}
+
 func (o *GetOptions) Run(ctx context.Context, args []string) error {
 	clientWithResponses, err := o.BuildClient()
 	if err != nil {
@@ -368,6 +394,13 @@ func (o *GetOptions) Run(ctx context.Context, args []string) error {
 
 	formatter := display.NewFormatter(display.OutputFormat(o.Output))
 
+	if o.Watch {
+		if err := o.handleWatch(ctx, formatter, clientWithResponses, kind); err != nil {
+			return fmt.Errorf("watching %s: %w", kind.ToPlural(), err)
+		}
+		return nil
+	}
+
 	// Handle list case (no specific names)
 	if len(names) == 0 {
 		if err := o.handleList(ctx, formatter, clientWithResponses, kind); err != nil {
@@ -582,6 +615,30 @@ func (o *GetOptions) getResourceList(ctx context.Context, c *apiclient.ClientWit
 			Continue:      util.ToPtrWithNilDefault(o.Continue),
 		}
 		return c.ListEventsWithResponse(ctx, &params)
+	case EventSubscriptionKind:
+		params := api.ListEventSubscriptionsParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.ListEventSubscriptionsWithResponse(ctx, &params)
+	case BulkOperationKind:
+		params := api.ListBulkOperationsParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.ListBulkOperationsWithResponse(ctx, &params)
+	case EnrollmentPolicyKind:
+		params := api.ListEnrollmentPoliciesParams{
+			LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Limit:         util.ToPtrWithNilDefault(o.Limit),
+			Continue:      util.ToPtrWithNilDefault(o.Continue),
+		}
+		return c.ListEnrollmentPoliciesWithResponse(ctx, &params)
 	default:
 		return nil, fmt.Errorf("unsupported resource kind: %s", kind)
 	}
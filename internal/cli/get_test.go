@@ -21,10 +21,12 @@ import (
 // responses sequentially.
 type fakeHTTPClient struct {
 	responses []*http.Response
+	requests  []*http.Request
 	callCount int
 }
 
-func (f *fakeHTTPClient) Do(_ *http.Request) (*http.Response, error) {
+func (f *fakeHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	f.requests = append(f.requests, req)
 	if f.callCount >= len(f.responses) {
 		return nil, fmt.Errorf("no more responses available, already made %d calls", f.callCount)
 	}
@@ -487,6 +489,47 @@ func TestGetOptionsValidation(t *testing.T) {
 			args:        []string{"events"},
 			expectError: false,
 		},
+
+		// Watch validation tests
+		{
+			name:        "watch_devices_ok",
+			args:        []string{"devices"},
+			options:     &GetOptions{Watch: true, LabelSelector: "app=test"},
+			expectError: false,
+		},
+		{
+			name:        "watch_fleets_with_summary_ok",
+			args:        []string{"fleets"},
+			options:     &GetOptions{Watch: true, Summary: true},
+			expectError: false,
+		},
+		{
+			name:        "watch_events_ok",
+			args:        []string{"events"},
+			options:     &GetOptions{Watch: true},
+			expectError: false,
+		},
+		{
+			name:          "watch_single_device",
+			args:          []string{"device", "test1"},
+			options:       &GetOptions{Watch: true},
+			expectError:   true,
+			errorContains: "'--watch' can only be used when getting a list of devices, fleets or events",
+		},
+		{
+			name:          "watch_repositories",
+			args:          []string{"repositories"},
+			options:       &GetOptions{Watch: true},
+			expectError:   true,
+			errorContains: "'--watch' can only be used when getting a list of devices, fleets or events",
+		},
+		{
+			name:          "watch_devices_with_summary",
+			args:          []string{"devices"},
+			options:       &GetOptions{Watch: true, Summary: true},
+			expectError:   true,
+			errorContains: "'--watch' cannot be combined with '--summary' or '--summary-only'",
+		},
 	}
 
 	for _, tc := range tests {
@@ -514,6 +557,9 @@ func TestGetOptionsValidation(t *testing.T) {
 				if tc.options.LastSeen {
 					opts.LastSeen = tc.options.LastSeen
 				}
+				if tc.options.Watch {
+					opts.Watch = tc.options.Watch
+				}
 			}
 
 			err := opts.Validate(tc.args)
@@ -24,10 +24,13 @@ type ResourceKind string
 
 const (
 	InvalidKind                   ResourceKind = ""
+	BulkOperationKind             ResourceKind = "bulkoperation"
 	CertificateSigningRequestKind ResourceKind = "certificatesigningrequest"
 	DeviceKind                    ResourceKind = "device"
+	EnrollmentPolicyKind          ResourceKind = "enrollmentpolicy"
 	EnrollmentRequestKind         ResourceKind = "enrollmentrequest"
 	EventKind                     ResourceKind = "event"
+	EventSubscriptionKind         ResourceKind = "eventsubscription"
 	FleetKind                     ResourceKind = "fleet"
 	OrganizationKind              ResourceKind = "organization"
 	RepositoryKind                ResourceKind = "repository"
@@ -59,10 +62,13 @@ func ResourceKindFromString(kindLike string) (ResourceKind, error) {
 
 var (
 	resourceKindSet = map[ResourceKind]struct{}{
+		BulkOperationKind:             {},
 		CertificateSigningRequestKind: {},
 		DeviceKind:                    {},
+		EnrollmentPolicyKind:          {},
 		EnrollmentRequestKind:         {},
 		EventKind:                     {},
+		EventSubscriptionKind:         {},
 		FleetKind:                     {},
 		OrganizationKind:              {},
 		RepositoryKind:                {},
@@ -73,10 +79,13 @@ var (
 	validResourceKinds = slices.Collect(maps.Keys(resourceKindSet))
 
 	pluralToKind = map[string]ResourceKind{
+		"bulkoperations":             BulkOperationKind,
 		"certificatesigningrequests": CertificateSigningRequestKind,
 		"devices":                    DeviceKind,
+		"enrollmentpolicies":         EnrollmentPolicyKind,
 		"enrollmentrequests":         EnrollmentRequestKind,
 		"events":                     EventKind,
+		"eventsubscriptions":         EventSubscriptionKind,
 		"fleets":                     FleetKind,
 		"organizations":              OrganizationKind,
 		"repositories":               RepositoryKind,
@@ -85,10 +94,13 @@ var (
 	}
 
 	kindToPlural = map[ResourceKind]string{
+		BulkOperationKind:             "bulkoperations",
 		CertificateSigningRequestKind: "certificatesigningrequests",
 		DeviceKind:                    "devices",
+		EnrollmentPolicyKind:          "enrollmentpolicies",
 		EnrollmentRequestKind:         "enrollmentrequests",
 		EventKind:                     "events",
+		EventSubscriptionKind:         "eventsubscriptions",
 		FleetKind:                     "fleets",
 		OrganizationKind:              "organizations",
 		RepositoryKind:                "repositories",
@@ -97,10 +109,13 @@ var (
 	}
 
 	shortnameToKind = map[string]ResourceKind{
+		"bo":   BulkOperationKind,
 		"csr":  CertificateSigningRequestKind,
 		"dev":  DeviceKind,
+		"ep":   EnrollmentPolicyKind,
 		"er":   EnrollmentRequestKind,
 		"ev":   EventKind,
+		"es":   EventSubscriptionKind,
 		"flt":  FleetKind,
 		"org":  OrganizationKind,
 		"repo": RepositoryKind,
@@ -305,6 +320,12 @@ func GetSingleResource(ctx context.Context, c *apiclient.ClientWithResponses, ki
 		return c.GetResourceSyncWithResponse(ctx, name)
 	case CertificateSigningRequestKind:
 		return c.GetCertificateSigningRequestWithResponse(ctx, name)
+	case EventSubscriptionKind:
+		return c.GetEventSubscriptionWithResponse(ctx, name)
+	case BulkOperationKind:
+		return c.GetBulkOperationWithResponse(ctx, name)
+	case EnrollmentPolicyKind:
+		return c.GetEnrollmentPolicyWithResponse(ctx, name)
 	default:
 		return nil, fmt.Errorf("unsupported resource kind: %s", kind)
 	}
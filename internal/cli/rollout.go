@@ -0,0 +1,221 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"text/tabwriter"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	apiclient "github.com/flightctl/flightctl/internal/api/client"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	rolloutPause  = "pause"
+	rolloutResume = "resume"
+	rolloutAbort  = "abort"
+	rolloutStatus = "status"
+)
+
+type RolloutOptions struct {
+	GlobalOptions
+}
+
+func DefaultRolloutOptions() *RolloutOptions {
+	return &RolloutOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdRollout() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rollout",
+		Short: "Manage the rollout of a fleet.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+		SilenceUsage: true,
+	}
+
+	cmd.AddCommand(newCmdRolloutAction(rolloutPause, "Pause the rollout of a fleet.",
+		"Pause the rollout of a fleet. No further batches are rolled out until the rollout is resumed.\n"+
+			"Devices of a batch that was already rolled out continue to update."))
+	cmd.AddCommand(newCmdRolloutAction(rolloutResume, "Resume the rollout of a fleet.",
+		"Resume a paused rollout of a fleet. If the rollout is suspended because a batch did not reach\n"+
+			"the success threshold, or is waiting for approval, the current batch is approved."))
+	cmd.AddCommand(newCmdRolloutAction(rolloutAbort, "Abort the rollout of a fleet.",
+		"Abort the rollout of a fleet. Devices that were already updated are rolled back to the fleet's\n"+
+			"previous template version."))
+	cmd.AddCommand(newCmdRolloutAction(rolloutStatus, "Show the rollout status of a fleet.",
+		"Show the rollout status of a fleet."))
+
+	return cmd
+}
+
+func newCmdRolloutAction(action, short, long string) *cobra.Command {
+	o := DefaultRolloutOptions()
+	cmd := &cobra.Command{
+		Use:   action + " fleet/NAME",
+		Short: short,
+		Long: long + `
+
+Examples:
+  flightctl rollout ` + action + ` fleet/my-fleet`,
+		Args: cobra.RangeArgs(1, 2),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{FleetKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, action, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *RolloutOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+}
+
+func (o *RolloutOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *RolloutOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	return validateRolloutArgs(args)
+}
+
+func validateRolloutArgs(args []string) error {
+	kind, name, err := parseAndValidateKindNameFromArgsOptionalSingle(args)
+	if err != nil {
+		return err
+	}
+	if kind != FleetKind {
+		return fmt.Errorf("kind must be Fleet")
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("specify a specific fleet")
+	}
+	return nil
+}
+
+func (o *RolloutOptions) Run(ctx context.Context, action string, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	_, name, err := parseAndValidateKindNameFromArgsOptionalSingle(args)
+	if err != nil {
+		return err
+	}
+
+	var (
+		httpResponse *http.Response
+		body         []byte
+		fleet        *api.Fleet
+	)
+	switch action {
+	case rolloutPause:
+		response, err := c.PauseFleetRolloutWithResponse(ctx, name)
+		if err != nil {
+			return fmt.Errorf("pausing rollout of fleet %s: %w", name, err)
+		}
+		httpResponse, body, fleet = response.HTTPResponse, response.Body, response.JSON200
+	case rolloutResume:
+		response, err := c.ResumeFleetRolloutWithResponse(ctx, name)
+		if err != nil {
+			return fmt.Errorf("resuming rollout of fleet %s: %w", name, err)
+		}
+		httpResponse, body, fleet = response.HTTPResponse, response.Body, response.JSON200
+	case rolloutAbort:
+		response, err := c.AbortFleetRolloutWithResponse(ctx, name)
+		if err != nil {
+			return fmt.Errorf("aborting rollout of fleet %s: %w", name, err)
+		}
+		httpResponse, body, fleet = response.HTTPResponse, response.Body, response.JSON200
+	case rolloutStatus:
+		return o.runStatus(ctx, c, name)
+	default:
+		return fmt.Errorf("unknown rollout action %s", action)
+	}
+
+	if httpResponse != nil && httpResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("unsuccessful %s rollout request for fleet %s: %s", action, name, string(body))
+	}
+	if fleet == nil {
+		return fmt.Errorf("unsuccessful %s rollout request for fleet %s: empty response", action, name)
+	}
+
+	fmt.Printf("Rollout %s request for %s \"%s\" completed\n", action, FleetKind, name)
+	return nil
+}
+
+func (o *RolloutOptions) runStatus(ctx context.Context, c *apiclient.ClientWithResponses, name string) error {
+	response, err := c.GetFleetWithResponse(ctx, name, &api.GetFleetParams{})
+	if err != nil {
+		return fmt.Errorf("getting fleet %s: %w", name, err)
+	}
+	if response.HTTPResponse != nil && response.HTTPResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("unsuccessful request for fleet %s: %s", name, string(response.Body))
+	}
+	if response.JSON200 == nil {
+		return fmt.Errorf("unsuccessful request for fleet %s: empty response", name)
+	}
+	return printRolloutStatus(os.Stdout, response.JSON200)
+}
+
+func printRolloutStatus(out io.Writer, fleet *api.Fleet) error {
+	w := tabwriter.NewWriter(out, 0, 8, 1, ' ', 0)
+	annotations := lo.FromPtr(fleet.Metadata.Annotations)
+
+	state := "Inactive"
+	message := ""
+	if fleet.Status != nil {
+		if condition := api.FindStatusCondition(fleet.Status.Conditions, api.ConditionTypeFleetRolloutInProgress); condition != nil {
+			state = condition.Reason
+			message = condition.Message
+		}
+	}
+	if _, paused := annotations[api.FleetAnnotationRolloutPaused]; paused && state != api.RolloutPausedReason {
+		state += " (pause requested)"
+	}
+	if _, aborted := annotations[api.FleetAnnotationRolloutAborted]; aborted && state != api.RolloutAbortedReason {
+		state += " (abort requested)"
+	}
+
+	fmt.Fprintf(w, "Fleet:\t%s\n", lo.FromPtr(fleet.Metadata.Name))
+	fmt.Fprintf(w, "Template version:\t%s\n", util.DefaultString(annotations[api.FleetAnnotationTemplateVersion], display.NoneString))
+	fmt.Fprintf(w, "State:\t%s\n", state)
+	if message != "" {
+		fmt.Fprintf(w, "Message:\t%s\n", message)
+	}
+	if rolledBack, exists := annotations[api.FleetAnnotationRolledBackTemplateVersion]; exists {
+		fmt.Fprintf(w, "Rolled back from:\t%s\n", rolledBack)
+	}
+	if report, exists := annotations[api.FleetAnnotationLastBatchCompletionReport]; exists {
+		fmt.Fprintf(w, "Last batch report:\t%s\n", report)
+	}
+	return w.Flush()
+}
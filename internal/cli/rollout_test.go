@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRolloutArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		errorContains string
+	}{
+		{name: "fleet by type/name", args: []string{"fleet/my-fleet"}},
+		{name: "fleet by type name", args: []string{"fleet", "my-fleet"}},
+		{name: "missing name", args: []string{"fleet"}, errorContains: "specify a specific fleet"},
+		{name: "wrong kind", args: []string{"device/my-device"}, errorContains: "kind must be Fleet"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRolloutArgs(tt.args)
+			if tt.errorContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.errorContains)
+		})
+	}
+}
+
+func TestPrintRolloutStatus(t *testing.T) {
+	fleet := &api.Fleet{
+		Metadata: api.ObjectMeta{
+			Name: lo.ToPtr("my-fleet"),
+			Annotations: &map[string]string{
+				api.FleetAnnotationTemplateVersion: "my-fleet-2",
+				api.FleetAnnotationRolloutAborted:  "true",
+			},
+		},
+		Status: &api.FleetStatus{
+			Conditions: []api.Condition{{
+				Type:    api.ConditionTypeFleetRolloutInProgress,
+				Status:  api.ConditionStatusTrue,
+				Reason:  api.RolloutActiveReason,
+				Message: "Rolling out batch 1",
+			}},
+		},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, printRolloutStatus(&out, fleet))
+	require.Contains(t, out.String(), "my-fleet-2")
+	require.Contains(t, out.String(), "Active (abort requested)")
+	require.Contains(t, out.String(), "Rolling out batch 1")
+}
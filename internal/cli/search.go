@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type SearchOptions struct {
+	GlobalOptions
+
+	LabelSelector string
+	FieldSelector string
+	Limit         int32
+	Continue      string
+	Output        string
+}
+
+func DefaultSearchOptions() *SearchOptions {
+	return &SearchOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		LabelSelector: "",
+		FieldSelector: "",
+		Limit:         0,
+		Continue:      "",
+		Output:        "",
+	}
+}
+
+func NewCmdSearch() *cobra.Command {
+	o := DefaultSearchOptions()
+	cmd := &cobra.Command{
+		Use:   "search TEXT",
+		Short: "Search devices by a fragment of their name, alias, labels or system info.",
+		Long: `Search devices whose name, alias, labels, system info or custom info contain the given text, ignoring case.
+
+Devices whose name or alias equals the text are listed first, followed by the devices that match most closely.
+The MATCHES column shows the fields that contain the text.
+
+Examples:
+  flightctl search SN-4711
+  flightctl search 192.168.1. -l site=paris`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *SearchOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVarP(&o.LabelSelector, FlagSelector, "l", o.LabelSelector, "Selector (label query) to restrict the searched devices, supporting operators like '=', '!=', and 'in' (e.g., -l='key1=value1,key2!=value2').")
+	fs.StringVar(&o.FieldSelector, FlagFieldSelector, o.FieldSelector, "Selector (field query) to restrict the searched devices (e.g., --field-selector='metadata.owner=Fleet/test').")
+	fs.Int32Var(&o.Limit, FlagLimit, o.Limit, "The maximum number of results returned in the response. If the value is 0, the server's maximum is used.")
+	fs.StringVar(&o.Continue, FlagContinue, o.Continue, "Query more results starting from the value of the 'continue' field in the previous response.")
+	fs.StringVarP(&o.Output, FlagOutput, "o", o.Output, fmt.Sprintf("Output format. One of: (%s, %s).", display.JSONFormat, display.YAMLFormat))
+}
+
+func (o *SearchOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *SearchOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	if len(strings.TrimSpace(args[0])) < 3 {
+		return fmt.Errorf("search text must be at least 3 characters long")
+	}
+	if o.Limit < 0 {
+		return fmt.Errorf("limit must be greater than 0")
+	}
+	if o.Output != "" && o.Output != string(display.JSONFormat) && o.Output != string(display.YAMLFormat) {
+		return fmt.Errorf("output format must be one of (%s, %s), got: %s", display.JSONFormat, display.YAMLFormat, o.Output)
+	}
+	return nil
+}
+
+func (o *SearchOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+
+	params := api.SearchDevicesParams{
+		Q:             args[0],
+		LabelSelector: util.ToPtrWithNilDefault(o.LabelSelector),
+		FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+		Limit:         util.ToPtrWithNilDefault(o.Limit),
+		Continue:      util.ToPtrWithNilDefault(o.Continue),
+	}
+	response, err := c.SearchDevicesWithResponse(ctx, &params)
+	if err != nil {
+		return fmt.Errorf("searching devices: %w", err)
+	}
+	if err := validateResponse(response); err != nil {
+		return fmt.Errorf("searching devices: %w", err)
+	}
+	if response.JSON200 == nil {
+		return fmt.Errorf("searching devices: empty response")
+	}
+
+	if o.Output != "" {
+		return display.NewFormatter(display.OutputFormat(o.Output)).Format(response.JSON200, display.FormatOptions{Writer: os.Stdout})
+	}
+	return printSearchResults(os.Stdout, response.JSON200)
+}
+
+func printSearchResults(out io.Writer, results *api.DeviceSearchResultList) error {
+	w := tabwriter.NewWriter(out, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, strings.Join([]string{"NAME", "ALIAS", "OWNER", "SCORE", "MATCHES"}, "\t"))
+	for _, result := range results.Items {
+		labels := lo.FromPtr(result.Device.Metadata.Labels)
+		fmt.Fprintln(w, strings.Join([]string{
+			lo.FromPtr(result.Device.Metadata.Name),
+			lo.CoalesceOrEmpty(labels["alias"], display.NoneString),
+			lo.FromPtrOr(result.Device.Metadata.Owner, display.NoneString),
+			strconv.Itoa(int(result.Score)),
+			lo.CoalesceOrEmpty(strings.Join(result.Matches, ","), display.NoneString),
+		}, "\t"))
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if results.Metadata.Continue != nil {
+		fmt.Fprintf(out, "\nMore results are available, use --continue %s to list them.\n", *results.Metadata.Continue)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintSearchResults(t *testing.T) {
+	results := &api.DeviceSearchResultList{
+		Metadata: api.ListMeta{Continue: lo.ToPtr("token")},
+		Items: []api.DeviceSearchResult{
+			{
+				Device: api.Device{Metadata: api.ObjectMeta{
+					Name:   lo.ToPtr("edge-01"),
+					Labels: &map[string]string{"alias": "store-4711"},
+					Owner:  lo.ToPtr("Fleet/stores"),
+				}},
+				Score:   1850,
+				Matches: []string{"metadata.labels.alias", "status.systemInfo.productSerial"},
+			},
+			{
+				Device:  api.Device{Metadata: api.ObjectMeta{Name: lo.ToPtr("edge-02")}},
+				Score:   300,
+				Matches: []string{},
+			},
+		},
+	}
+
+	var out bytes.Buffer
+	require.NoError(t, printSearchResults(&out, results))
+	lines := bytes.Split(bytes.TrimSpace(out.Bytes()), []byte("\n"))
+	require.Regexp(t, `^NAME\s+ALIAS\s+OWNER\s+SCORE\s+MATCHES$`, string(lines[0]))
+	require.Regexp(t, `^edge-01\s+store-4711\s+Fleet/stores\s+1850\s+metadata.labels.alias,status.systemInfo.productSerial$`, string(lines[1]))
+	require.Regexp(t, `^edge-02\s+<none>\s+<none>\s+300\s+<none>$`, string(lines[2]))
+	require.Contains(t, out.String(), "use --continue token")
+}
@@ -0,0 +1,285 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	apiclient "github.com/flightctl/flightctl/internal/api/client"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+// rewatchDelay is the time to wait before watching again after the server closed a watch
+const rewatchDelay = time.Second
+
+// watchColumnWidth is the width of the TYPE column that table output adds in front of each change.  Tables are
+// padded with tabs, so the column spans two tab stops.
+const watchColumnWidth = 16
+
+// resourceWatch holds the state of a watch across the requests it makes, so that a watch closed by the server is
+// resumed where it stopped
+type resourceWatch struct {
+	formatter display.OutputFormatter
+	client    *apiclient.ClientWithResponses
+	kind      ResourceKind
+	// resourceVersion is the highest resourceVersion of the devices or fleets seen
+	resourceVersion int64
+	// eventsSince is the creation time of the latest event seen, and eventsSeen holds the names of the events
+	// created at that time.  Events have no resourceVersion, so the events emitted while no watch was open are
+	// listed instead.
+	eventsSince time.Time
+	eventsSeen  map[string]bool
+	// headerShown is set once the table header was printed
+	headerShown bool
+}
+
+// handleWatch streams changes to the listed resources into the formatter until the context is done.  The server
+// closes watches from time to time, in which case the watch is resumed after the last resource seen.
+func (o *GetOptions) handleWatch(ctx context.Context, formatter display.OutputFormatter, c *apiclient.ClientWithResponses, kind ResourceKind) error {
+	rw := &resourceWatch{formatter: formatter, client: c, kind: kind, eventsSeen: map[string]bool{}}
+	for {
+		err := o.watchOnce(ctx, rw)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(rewatchDelay):
+		}
+	}
+}
+
+// watchOnce streams a single watch until the server closes it
+func (o *GetOptions) watchOnce(ctx context.Context, rw *resourceWatch) error {
+	response, err := o.openWatch(ctx, rw)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		body, err := io.ReadAll(response.Body)
+		if err != nil {
+			return fmt.Errorf("reading response: %w", err)
+		}
+		return validateResponse(&watchErrorResponse{Body: body, HTTPResponse: response})
+	}
+
+	// the events listed after the watch was opened may be streamed by it as well
+	var listed map[string]bool
+	if rw.kind == EventKind && !rw.eventsSince.IsZero() {
+		if listed, err = o.listMissedEvents(ctx, rw); err != nil {
+			return err
+		}
+	}
+
+	decoder := json.NewDecoder(response.Body)
+	for {
+		var event api.WatchEvent
+		if err := decoder.Decode(&event); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("reading watch: %w", err)
+		}
+		if event.Type == api.WatchEventError {
+			fmt.Fprintf(os.Stderr, "watch closed by the server: %v, watching again\n", event.Object["message"])
+			return nil
+		}
+		metadata, item, list, err := decodeWatchObject(rw.kind, event)
+		if err != nil {
+			return err
+		}
+		if listed[lo.FromPtr(metadata.Name)] {
+			continue
+		}
+		if err := o.displayWatchEvent(rw, event.Type, metadata, item, list); err != nil {
+			return err
+		}
+	}
+}
+
+// openWatch opens a watch that starts after the last resource seen.  Events have no resourceVersion, so a watch
+// of events only streams the events emitted after it was opened.
+func (o *GetOptions) openWatch(ctx context.Context, rw *resourceWatch) (*http.Response, error) {
+	var since *string
+	if rw.resourceVersion > 0 {
+		since = lo.ToPtr(strconv.FormatInt(rw.resourceVersion, 10))
+	}
+	switch rw.kind {
+	case DeviceKind:
+		params := api.ListDevicesParams{
+			LabelSelector:   util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector:   util.ToPtrWithNilDefault(o.FieldSelector),
+			Watch:           lo.ToPtr(true),
+			ResourceVersion: since,
+		}
+		return rw.client.ListDevices(ctx, &params)
+	case FleetKind:
+		params := api.ListFleetsParams{
+			LabelSelector:     util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector:     util.ToPtrWithNilDefault(o.FieldSelector),
+			AddDevicesSummary: util.ToPtrWithNilDefault(o.Summary),
+			Watch:             lo.ToPtr(true),
+			ResourceVersion:   since,
+		}
+		return rw.client.ListFleets(ctx, &params)
+	case EventKind:
+		params := api.ListEventsParams{
+			FieldSelector: util.ToPtrWithNilDefault(o.FieldSelector),
+			Watch:         lo.ToPtr(true),
+		}
+		return rw.client.ListEvents(ctx, &params)
+	default:
+		return nil, fmt.Errorf("unsupported resource kind: %s", rw.kind)
+	}
+}
+
+// listMissedEvents displays the events created since the latest event seen, which were emitted while no watch was
+// open, and returns their names
+func (o *GetOptions) listMissedEvents(ctx context.Context, rw *resourceWatch) (map[string]bool, error) {
+	fieldSelector := fmt.Sprintf("metadata.creationTimestamp>=%s", rw.eventsSince.UTC().Format(time.RFC3339Nano))
+	if o.FieldSelector != "" {
+		fieldSelector = o.FieldSelector + "," + fieldSelector
+	}
+	params := api.ListEventsParams{
+		FieldSelector: &fieldSelector,
+		Order:         lo.ToPtr(api.Asc),
+		Limit:         lo.ToPtr(int32(maxRequestLimit)),
+	}
+
+	listed := map[string]bool{}
+	for {
+		response, err := rw.client.ListEventsWithResponse(ctx, &params)
+		if err != nil {
+			return nil, fmt.Errorf("listing events: %w", err)
+		}
+		if err := validateResponse(response); err != nil {
+			return nil, err
+		}
+		for i := range response.JSON200.Items {
+			e := response.JSON200.Items[i]
+			name := lo.FromPtr(e.Metadata.Name)
+			if rw.eventsSeen[name] || listed[name] {
+				continue
+			}
+			listed[name] = true
+			list := &apiclient.ListEventsResponse{JSON200: &api.EventList{Items: []api.Event{e}}}
+			if err := o.displayWatchEvent(rw, api.WatchEventAdded, e.Metadata, &e, list); err != nil {
+				return nil, err
+			}
+		}
+		if response.JSON200.Metadata.Continue == nil {
+			return listed, nil
+		}
+		params.Continue = response.JSON200.Metadata.Continue
+	}
+}
+
+// decodeWatchObject returns the metadata of the resource of an event, the resource itself, and the resource as a
+// one-item list
+func decodeWatchObject(kind ResourceKind, event api.WatchEvent) (api.ObjectMeta, interface{}, interface{}, error) {
+	b, err := json.Marshal(event.Object)
+	if err != nil {
+		return api.ObjectMeta{}, nil, nil, fmt.Errorf("marshalling %s: %w", kind, err)
+	}
+
+	switch kind {
+	case DeviceKind:
+		var device api.Device
+		if err := json.Unmarshal(b, &device); err != nil {
+			return api.ObjectMeta{}, nil, nil, fmt.Errorf("unmarshalling device: %w", err)
+		}
+		return device.Metadata, &device, &apiclient.ListDevicesResponse{JSON200: &api.DeviceList{Items: []api.Device{device}}}, nil
+	case FleetKind:
+		var fleet api.Fleet
+		if err := json.Unmarshal(b, &fleet); err != nil {
+			return api.ObjectMeta{}, nil, nil, fmt.Errorf("unmarshalling fleet: %w", err)
+		}
+		return fleet.Metadata, &fleet, &apiclient.ListFleetsResponse{JSON200: &api.FleetList{Items: []api.Fleet{fleet}}}, nil
+	case EventKind:
+		var e api.Event
+		if err := json.Unmarshal(b, &e); err != nil {
+			return api.ObjectMeta{}, nil, nil, fmt.Errorf("unmarshalling event: %w", err)
+		}
+		return e.Metadata, &e, &apiclient.ListEventsResponse{JSON200: &api.EventList{Items: []api.Event{e}}}, nil
+	default:
+		return api.ObjectMeta{}, nil, nil, fmt.Errorf("unsupported resource kind: %s", kind)
+	}
+}
+
+// displayWatchEvent displays a changed resource and records it as seen.  Table output shows the resource as a
+// one-item list, preceded by a TYPE column with the type of the change, and prints the header only once.
+// Structured output shows the resource itself.
+func (o *GetOptions) displayWatchEvent(rw *resourceWatch, eventType api.WatchEventType, metadata api.ObjectMeta, item, list interface{}) error {
+	options := display.FormatOptions{
+		Kind:    rw.kind.String(),
+		Summary: o.Summary,
+		Wide:    o.Output == string(display.WideFormat),
+		Writer:  os.Stdout,
+	}
+	if o.Output == string(display.JSONFormat) || o.Output == string(display.YAMLFormat) || o.Output == string(display.NameFormat) {
+		options.Name = lo.FromPtr(metadata.Name)
+		if err := rw.formatter.Format(item, options); err != nil {
+			return err
+		}
+	} else {
+		var buf bytes.Buffer
+		options.Writer = &buf
+		if err := rw.formatter.Format(list, options); err != nil {
+			return err
+		}
+		for _, line := range strings.SplitAfter(buf.String(), "\n") {
+			if line == "" {
+				continue
+			}
+			column := string(eventType)
+			if !rw.headerShown {
+				column, rw.headerShown = "TYPE", true
+			}
+			fmt.Fprintf(os.Stdout, "%s%s%s", column, strings.Repeat("\t", (watchColumnWidth-len(column)+7)/8), line)
+		}
+	}
+	rw.observe(metadata)
+	return nil
+}
+
+// observe records a resource as seen, so that the next watch starts after it
+func (rw *resourceWatch) observe(metadata api.ObjectMeta) {
+	if rw.kind != EventKind {
+		if metadata.ResourceVersion != nil {
+			version, _ := strconv.ParseInt(*metadata.ResourceVersion, 10, 64)
+			rw.resourceVersion = max(rw.resourceVersion, version)
+		}
+		return
+	}
+	created := lo.FromPtr(metadata.CreationTimestamp)
+	switch {
+	case created.After(rw.eventsSince):
+		rw.eventsSince = created
+		rw.eventsSeen = map[string]bool{lo.FromPtr(metadata.Name): true}
+	case created.Equal(rw.eventsSince):
+		rw.eventsSeen[lo.FromPtr(metadata.Name)] = true
+	}
+}
+
+// watchErrorResponse holds a failed watch response for validateResponse
+type watchErrorResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
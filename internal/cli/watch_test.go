@@ -0,0 +1,109 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// makeJSONResponse builds an *http.Response whose body is the JSON encoding of each of the values, one per line
+func makeJSONResponse(t *testing.T, values ...any) *http.Response {
+	t.Helper()
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, value := range values {
+		require.NoError(t, encoder.Encode(value))
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(&body),
+	}
+}
+
+func makeWatchEvent(t *testing.T, eventType api.WatchEventType, object any) api.WatchEvent {
+	t.Helper()
+	b, err := json.Marshal(object)
+	require.NoError(t, err)
+	event := api.WatchEvent{Type: eventType}
+	require.NoError(t, json.Unmarshal(b, &event.Object))
+	return event
+}
+
+func TestWatchDevicesResumesAfterError(t *testing.T) {
+	device := func(name, resourceVersion string) api.Device {
+		return api.Device{Kind: api.DeviceKind, Metadata: api.ObjectMeta{Name: lo.ToPtr(name), ResourceVersion: lo.ToPtr(resourceVersion)}}
+	}
+	c, fake := newTestClient(t,
+		makeJSONResponse(t,
+			makeWatchEvent(t, api.WatchEventAdded, device("device-1", "3")),
+			makeWatchEvent(t, api.WatchEventModified, device("device-2", "5")),
+			makeWatchEvent(t, api.WatchEventError, api.StatusGone("watch closed because the client fell behind, watch again")),
+		),
+		makeJSONResponse(t, makeWatchEvent(t, api.WatchEventDeleted, device("device-1", "3"))),
+	)
+
+	opts := DefaultGetOptions()
+	rw := &resourceWatch{formatter: display.NewFormatter(display.OutputFormat(opts.Output)), client: c, kind: DeviceKind, eventsSeen: map[string]bool{}}
+	output := captureStdout(t, func() {
+		require.NoError(t, opts.watchOnce(context.Background(), rw))
+		require.NoError(t, opts.watchOnce(context.Background(), rw))
+	})
+
+	require.Len(t, fake.requests, 2)
+	assert.Empty(t, fake.requests[0].URL.Query().Get("resourceVersion"))
+	assert.Equal(t, "5", fake.requests[1].URL.Query().Get("resourceVersion"))
+
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	require.Len(t, lines, 4)
+	assert.Regexp(t, "^TYPE\t\tNAME\t+ALIAS", lines[0])
+	assert.Regexp(t, "^ADDED\t\tdevice-1\t", lines[1])
+	assert.Regexp(t, "^MODIFIED\tdevice-2\t", lines[2])
+	assert.Regexp(t, "^DELETED\t\tdevice-1\t", lines[3])
+}
+
+func TestWatchEventsListsMissedEvents(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	event := func(name string, created time.Time) api.Event {
+		return api.Event{Kind: api.EventKind, Metadata: api.ObjectMeta{Name: lo.ToPtr(name), CreationTimestamp: lo.ToPtr(created)}, Message: name}
+	}
+	c, fake := newTestClient(t,
+		makeJSONResponse(t, makeWatchEvent(t, api.WatchEventAdded, event("event-1", start))),
+		// the second watch streams an event that is also listed as missed
+		makeJSONResponse(t,
+			makeWatchEvent(t, api.WatchEventAdded, event("event-2", start.Add(time.Second))),
+			makeWatchEvent(t, api.WatchEventAdded, event("event-3", start.Add(2*time.Second))),
+		),
+		makeJSONResponse(t, api.EventList{Items: []api.Event{event("event-1", start), event("event-2", start.Add(time.Second))}}),
+	)
+
+	opts := DefaultGetOptions()
+	opts.FieldSelector = "involvedObject.kind=Device"
+	rw := &resourceWatch{formatter: display.NewFormatter(display.OutputFormat(opts.Output)), client: c, kind: EventKind, eventsSeen: map[string]bool{}}
+	output := captureStdout(t, func() {
+		require.NoError(t, opts.watchOnce(context.Background(), rw))
+		require.NoError(t, opts.watchOnce(context.Background(), rw))
+	})
+
+	require.Len(t, fake.requests, 3)
+	assert.Equal(t, "true", fake.requests[1].URL.Query().Get("watch"))
+	list := fake.requests[2].URL.Query()
+	assert.Equal(t, "involvedObject.kind=Device,metadata.creationTimestamp>=2025-01-01T12:00:00Z", list.Get("fieldSelector"))
+	assert.Equal(t, "asc", list.Get("order"))
+
+	assert.Equal(t, 1, strings.Count(output, "event-1"))
+	assert.Equal(t, 1, strings.Count(output, "event-2"))
+	assert.Equal(t, 1, strings.Count(output, "event-3"))
+	assert.Equal(t, start.Add(2*time.Second), rw.eventsSince)
+}
@@ -16,6 +16,37 @@ type InternalCfg struct {
 	CertStore        string `json:"certStore,omitempty"`
 }
 
+// PKCS11Cfg configures a CA whose private key is kept in a PKCS#11 token, such as an HSM.  The token is
+// selected by its label or slot number and the key by its label.
+type PKCS11Cfg struct {
+	// ModulePath is the path of the PKCS#11 module, e.g. /usr/lib64/pkcs11/libsofthsm2.so.
+	ModulePath string `json:"modulePath,omitempty"`
+	TokenLabel string `json:"tokenLabel,omitempty"`
+	SlotNumber *int   `json:"slotNumber,omitempty"`
+	// Pin is the user PIN of the token.  It is read from the CA_PKCS11_PIN environment variable only, so
+	// that it never appears in the config file or in logged configuration.
+	Pin      string `json:"-"`
+	KeyLabel string `json:"keyLabel,omitempty"`
+	// CertFile is the PEM file with the CA certificate, followed by any intermediate certificates.  If
+	// empty, the certificate labelled like the key is read from the token.
+	CertFile string `json:"certFile,omitempty"`
+}
+
+// StepCACfg configures a CA that is reached over the sign API of step-ca, authenticating with the
+// key of a JWK provisioner.
+type StepCACfg struct {
+	// URL is the base URL of the CA, e.g. https://ca.example.com:9000.
+	URL string `json:"url,omitempty"`
+	// CABundleFile is the PEM file with the root certificate of the CA and the intermediate certificate
+	// that issues the certificates.  It is used to verify the TLS certificate of the CA and the issued
+	// certificates, and is distributed as the CA bundle.
+	CABundleFile          string `json:"caBundleFile,omitempty"`
+	ProvisionerName       string `json:"provisionerName,omitempty"`
+	ProvisionerKeyID      string `json:"provisionerKeyId,omitempty"`
+	ProvisionerKeyFile    string `json:"provisionerKeyFile,omitempty"`
+	RequestTimeoutSeconds int    `json:"requestTimeoutSeconds,omitempty"`
+}
+
 type Config struct {
 	CAType                          CAIdType     `json:"type,omitempty"`
 	AdminCommonName                 string       `json:"adminCommonName,omitempty"`
@@ -29,6 +60,8 @@ type Config struct {
 	ClientBootstrapValidityDays     int          `json:"clientBootStrapValidityDays,omitempty"`
 	DeviceCommonNamePrefix          string       `json:"deviceCommonNamePrefix,omitempty"`
 	InternalConfig                  *InternalCfg `json:"internalConfig,omitempty"`
+	PKCS11Config                    *PKCS11Cfg   `json:"pkcs11Config,omitempty"`
+	StepCAConfig                    *StepCACfg   `json:"stepCAConfig,omitempty"`
 	ServerCertValidityDays          int          `json:"serverCertValidityDays,omitempty"`
 	ExtraAllowedPrefixes            []string     `json:"extraAllowedPrefixes,omitempty"`
 }
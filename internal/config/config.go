@@ -31,6 +31,7 @@ type Config struct {
 	Organizations    *organizationsConfig    `json:"organizations,omitempty"`
 	TelemetryGateway *telemetryGatewayConfig `json:"telemetrygateway,omitempty"`
 	ImageBuilder     *imageBuilderConfig     `json:"imageBuilder,omitempty"`
+	Console          *consoleConfig          `json:"console,omitempty"`
 }
 
 type RateLimitConfig struct {
@@ -217,6 +218,9 @@ type gitOpsConfig struct {
 	// IgnoreResourceUpdates lists JSON pointer paths that should be ignored
 	// when comparing desired vs. live resources during GitOps sync.
 	IgnoreResourceUpdates []string `json:"ignoreResourceUpdates,omitempty"`
+	// PollingInterval is the interval at which ResourceSyncs are checked for new commits.  Repositories that
+	// notify the service through the git webhook are synced on push, so the interval can then be increased.
+	PollingInterval util.Duration `json:"pollingInterval,omitempty"`
 }
 
 type periodicConfig struct {
@@ -394,6 +398,7 @@ func NewDefault(opts ...ConfigOption) *Config {
 			IgnoreResourceUpdates: []string{
 				"/metadata/resourceVersion",
 			},
+			PollingInterval: util.Duration(2 * time.Minute),
 		},
 	}
 	c.CA = ca.NewDefault(CertificateDir())
@@ -454,6 +459,9 @@ func Load(cfgFile string) (*Config, error) {
 	if dbMigrationPass := os.Getenv("DB_MIGRATION_PASSWORD"); dbMigrationPass != "" {
 		c.Database.MigrationPassword = SecureString(dbMigrationPass)
 	}
+	if pkcs11Pin := os.Getenv("CA_PKCS11_PIN"); pkcs11Pin != "" && c.CA != nil && c.CA.PKCS11Config != nil {
+		c.CA.PKCS11Config.Pin = pkcs11Pin
+	}
 
 	// Set up OIDC issuer and client defaults only when explicitly configured
 	if c.Auth != nil {
@@ -507,6 +515,15 @@ func Validate(cfg *Config) error {
 				return fmt.Errorf("invalid ignoreResourceUpdates value: %s", path)
 			}
 		}
+		if cfg.GitOps.PollingInterval < 0 {
+			return fmt.Errorf("gitOps.pollingInterval must not be negative")
+		}
+	}
+
+	if cfg.Console != nil && cfg.Console.Recording != nil && cfg.Console.Recording.Enabled {
+		if err := validateConsoleRecordingStorage(cfg.Console.Recording.Storage); err != nil {
+			return fmt.Errorf("console.recording.storage: %w", err)
+		}
 	}
 
 	if cfg.Service != nil && cfg.Service.HealthChecks != nil && cfg.Service.HealthChecks.Enabled {
@@ -548,6 +565,20 @@ type storageConfig struct {
 	S3Config  *s3Config `json:"s3,omitempty"`
 }
 
+type consoleConfig struct {
+	// Recording configures the recording of remote console sessions.  Sessions are recorded only when it is enabled.
+	Recording *consoleRecordingConfig `json:"recording,omitempty"`
+}
+
+type consoleRecordingConfig struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Storage is where recordings are kept.  Only the "local" and "s3" storage types are supported.
+	Storage *storageConfig `json:"storage,omitempty"`
+	// MaxSize is the size limit of a recording in bytes, past which the rest of the session is not recorded.
+	// Defaults to 100 MiB.
+	MaxSize int64 `json:"maxSize,omitempty"`
+}
+
 type s3Config struct {
 	Endpoint  string       `json:"endpoint"`
 	Bucket    string       `json:"bucket"`
@@ -556,6 +587,25 @@ type s3Config struct {
 	Region    string       `json:"region,omitempty"`
 }
 
+func validateConsoleRecordingStorage(storage *storageConfig) error {
+	if storage == nil {
+		return fmt.Errorf("must be set when recording is enabled")
+	}
+	switch storage.Type {
+	case "local":
+		if storage.LocalPath == "" {
+			return fmt.Errorf("localPath must be set for local storage")
+		}
+	case "s3":
+		if storage.S3Config == nil || storage.S3Config.Endpoint == "" || storage.S3Config.Bucket == "" {
+			return fmt.Errorf("s3.endpoint and s3.bucket must be set for s3 storage")
+		}
+	default:
+		return fmt.Errorf("unsupported storage type %q", storage.Type)
+	}
+	return nil
+}
+
 func (cfg *Config) String() string {
 	contents, err := json.Marshal(cfg)
 	if err != nil {
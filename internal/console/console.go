@@ -6,12 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	authcommon "github.com/flightctl/flightctl/internal/auth/common"
+	"github.com/flightctl/flightctl/internal/console/recording"
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/rendered"
 	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
@@ -21,6 +26,10 @@ import (
 
 const ChannelSize = 2048
 
+// errorChannel is the channel of the v5.channel.k8s.io streaming protocol on which the device reports the exit
+// status of the session
+const errorChannel byte = 3
+
 type ConsoleSession struct {
 	UUID       string
 	OrgId      uuid.UUID
@@ -28,6 +37,36 @@ type ConsoleSession struct {
 	SendCh     chan []byte
 	RecvCh     chan []byte
 	ProtocolCh chan string
+
+	// audit is the record of the session kept by the service
+	audit    api.ConsoleSession
+	recorder *recording.Recorder
+	exitCode *int32
+}
+
+// exitStatus holds the part of the Kubernetes status sent on the error channel that carries the exit code
+type exitStatus struct {
+	Code int32 `json:"code"`
+}
+
+// RecordInput must be called with every message sent by the user to the device.
+func (s *ConsoleSession) RecordInput(message []byte) {
+	if s.recorder != nil {
+		s.recorder.RecordInput(message)
+	}
+}
+
+// RecordOutput must be called with every message sent by the device to the user.
+func (s *ConsoleSession) RecordOutput(message []byte) {
+	if len(message) > 0 && message[0] == errorChannel {
+		var status exitStatus
+		if err := json.Unmarshal(message[1:], &status); err == nil {
+			s.exitCode = &status.Code
+		}
+	}
+	if s.recorder != nil {
+		s.recorder.RecordOutput(message)
+	}
 }
 
 type InternalSessionRegistration interface {
@@ -44,13 +83,19 @@ type ConsoleSessionManager struct {
 	// This one is the gRPC Handler of the agent for now, in the next iteration
 	// this should be split so we funnel traffic through a queue in redis/valkey
 	sessionRegistration InternalSessionRegistration
+	// recordings stores the recordings of the sessions, nil if sessions are not recorded
+	recordings recording.Sink
+	// recordingMaxSize is the size limit of a recording, zero for the default
+	recordingMaxSize int64
 }
 
-func NewConsoleSessionManager(serviceHandler service.Service, log logrus.FieldLogger, sessionRegistration InternalSessionRegistration) *ConsoleSessionManager {
+func NewConsoleSessionManager(serviceHandler service.Service, log logrus.FieldLogger, sessionRegistration InternalSessionRegistration, recordings recording.Sink, recordingMaxSize int64) *ConsoleSessionManager {
 	return &ConsoleSessionManager{
 		serviceHandler:      serviceHandler,
 		log:                 log,
 		sessionRegistration: sessionRegistration,
+		recordings:          recordings,
+		recordingMaxSize:    recordingMaxSize,
 	}
 }
 
@@ -171,7 +216,13 @@ func (m *ConsoleSessionManager) StartSession(ctx context.Context, deviceName, se
 		return nil, service.ApiStatusToErr(status)
 	}
 
+	// every session must be audited, so a session that can't be recorded is not started
+	if err := m.startAudit(ctx, session, sessionMetadata); err != nil {
+		return nil, err
+	}
+
 	if err := m.modifyAnnotations(ctx, deviceName, addSession(session.UUID, sessionMetadata)); err != nil {
+		m.endAudit(ctx, session)
 		return nil, err
 	}
 	// tell the gRPC service, or the message queue (in the future) that there is a session waiting, and provide
@@ -182,6 +233,7 @@ func (m *ConsoleSessionManager) StartSession(ctx context.Context, deviceName, se
 		if annErr := m.modifyAnnotations(ctx, deviceName, removeSession(session.UUID)); annErr != nil {
 			m.log.Errorf("Failed to remove annotation from device %s: %v", deviceName, annErr)
 		}
+		m.endAudit(ctx, session)
 		return nil, err
 	}
 	return session, nil
@@ -189,6 +241,8 @@ func (m *ConsoleSessionManager) StartSession(ctx context.Context, deviceName, se
 
 func (m *ConsoleSessionManager) CloseSession(ctx context.Context, session *ConsoleSession) error {
 	closeSessionErr := m.sessionRegistration.CloseSession(session)
+	m.endAudit(ctx, session)
+
 	// make sure the device exists
 
 	if err := m.modifyAnnotations(ctx, session.DeviceName, removeSession(session.UUID)); err != nil {
@@ -198,3 +252,80 @@ func (m *ConsoleSessionManager) CloseSession(ctx context.Context, session *Conso
 	// we still want to signal if there was an error closing the session
 	return closeSessionErr
 }
+
+// startAudit records who opened the session and, if sessions are recorded, starts its recording.
+func (m *ConsoleSessionManager) startAudit(ctx context.Context, session *ConsoleSession, sessionMetadata string) error {
+	var metadata api.DeviceConsoleSessionMetadata
+	if err := json.Unmarshal([]byte(sessionMetadata), &metadata); err != nil {
+		return fmt.Errorf("invalid session metadata: %w", err)
+	}
+
+	user := "unknown"
+	if identity, err := authcommon.GetIdentity(ctx); err == nil && identity.GetUsername() != "" {
+		user = identity.GetUsername()
+	}
+	var command string
+	if metadata.Command != nil {
+		command = strings.Join(append([]string{metadata.Command.Command}, metadata.Command.Args...), " ")
+	}
+
+	audit := api.ConsoleSession{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr(session.UUID)},
+		Spec: api.ConsoleSessionSpec{
+			DeviceName: session.DeviceName,
+			User:       user,
+			Command:    lo.EmptyableToPtr(command),
+			Tty:        lo.ToPtr(metadata.TTY),
+		},
+		Status: &api.ConsoleSessionStatus{StartTime: time.Now()},
+	}
+	if m.recordings != nil {
+		// the recording is stored while the session runs, so it is listed from the start
+		audit.Status.Recording = &api.ConsoleSessionRecording{Format: api.ConsoleSessionRecordingFormatAsciicastV2}
+	}
+	created, status := m.serviceHandler.CreateConsoleSession(ctx, audit)
+	if status.Code != http.StatusCreated {
+		return fmt.Errorf("failed to record session %s: %w", session.UUID, service.ApiStatusToErr(status))
+	}
+	session.audit = *created
+
+	if m.recordings != nil {
+		var width, height int
+		if metadata.InitialDimensions != nil {
+			width, height = int(metadata.InitialDimensions.Width), int(metadata.InitialDimensions.Height)
+		}
+		session.recorder = recording.NewRecorder(ctx, m.recordings, session.OrgId, session.UUID, m.recordingMaxSize,
+			width, height, command, lo.FromPtr(metadata.Term))
+	}
+	return nil
+}
+
+// endAudit stores the recording of the session and records how it ended.  The session is closed either way, so
+// failures are only reported.
+func (m *ConsoleSessionManager) endAudit(ctx context.Context, session *ConsoleSession) {
+	audit := session.audit
+	if audit.Status == nil {
+		return
+	}
+	audit.Status.EndTime = lo.ToPtr(time.Now())
+	audit.Status.ExitCode = session.exitCode
+
+	if session.recorder != nil {
+		size, err := session.recorder.Close()
+		if err != nil {
+			m.log.Errorf("Failed to store recording of console session %s for device %s: %v", session.UUID, session.DeviceName, err)
+			m.serviceHandler.CreateEvent(ctx, common.GetDeviceConsoleSessionRecordingFailedEvent(ctx, audit, err))
+			audit.Status.Recording = nil
+		} else {
+			audit.Status.Recording = &api.ConsoleSessionRecording{
+				Format: api.ConsoleSessionRecordingFormatAsciicastV2,
+				Size:   size,
+			}
+		}
+		session.recorder = nil
+	}
+
+	if _, status := m.serviceHandler.ReplaceConsoleSessionStatus(ctx, session.UUID, audit); status.Code != http.StatusOK {
+		m.log.Errorf("Failed to record the end of console session %s for device %s: %v", session.UUID, session.DeviceName, service.ApiStatusToErr(status))
+	}
+}
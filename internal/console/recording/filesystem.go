@@ -0,0 +1,78 @@
+package recording
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/sirupsen/logrus"
+)
+
+// FilesystemSink stores recordings as files below a directory, which may be a mounted volume shared by the
+// API server replicas.
+type FilesystemSink struct {
+	dir string
+	log logrus.FieldLogger
+}
+
+var _ Sink = (*FilesystemSink)(nil)
+
+func NewFilesystemSink(dir string, log logrus.FieldLogger) *FilesystemSink {
+	return &FilesystemSink{dir: dir, log: log}
+}
+
+func (s *FilesystemSink) path(key string) (string, error) {
+	if !filepath.IsLocal(key) {
+		return "", fmt.Errorf("invalid recording key %q", key)
+	}
+	return filepath.Join(s.dir, key), nil
+}
+
+func (s *FilesystemSink) Store(ctx context.Context, key string, recording io.ReadSeeker) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("creating recording directory: %w", err)
+	}
+
+	// write to a temporary file first so that a partially written recording is never served
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".recording-*")
+	if err != nil {
+		return fmt.Errorf("creating recording file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, recording); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing recording file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("writing recording file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("storing recording file: %w", err)
+	}
+	s.log.Debugf("Stored console recording %s", path)
+	return nil
+}
+
+func (s *FilesystemSink) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, flterrors.ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("opening recording file: %w", err)
+	}
+	return file, nil
+}
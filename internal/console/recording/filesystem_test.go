@@ -0,0 +1,35 @@
+package recording
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilesystemSink(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	sink := NewFilesystemSink(t.TempDir(), log.InitLogs())
+	key := ChunkKey(uuid.New(), "session", 0)
+
+	_, err := sink.Open(ctx, key)
+	require.ErrorIs(err, flterrors.ErrResourceNotFound)
+
+	require.NoError(sink.Store(ctx, key, strings.NewReader("recording")))
+	reader, err := sink.Open(ctx, key)
+	require.NoError(err)
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	require.NoError(err)
+	require.Equal("recording", string(content))
+
+	require.Error(sink.Store(ctx, "../session.cast", strings.NewReader("recording")))
+	_, err = sink.Open(ctx, "/etc/passwd")
+	require.Error(err)
+}
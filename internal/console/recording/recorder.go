@@ -0,0 +1,297 @@
+package recording
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// The channels of the v5.channel.k8s.io streaming protocol spoken over the console websocket.  The first byte of
+// every message is the channel it belongs to.
+const (
+	stdinChannel  byte = 0
+	stdoutChannel byte = 1
+	stderrChannel byte = 2
+	resizeChannel byte = 4
+)
+
+// asciicast v2 event codes
+const (
+	outputEvent = "o"
+	inputEvent  = "i"
+	resizeEvent = "r"
+	markerEvent = "m"
+)
+
+const (
+	defaultWidth  = 80
+	defaultHeight = 24
+)
+
+// DefaultMaxSize is the size limit of a recording, past which the rest of the session is not recorded
+const DefaultMaxSize = 100 << 20
+
+// truncatedMarker labels the marker that ends a recording that reached its size limit
+const truncatedMarker = "recording truncated: size limit reached"
+
+const (
+	// chunkSize is the size at which the buffered recording is stored as a chunk
+	chunkSize = 1 << 20
+	// flushInterval is the longest time the recording is buffered before it is stored, even if its chunk isn't full
+	flushInterval = 30 * time.Second
+	// maxPendingChunks is the number of chunks that may wait to be stored before recording blocks the session
+	maxPendingChunks = 4
+)
+
+// Header is the first line of an asciicast v2 recording.
+type Header struct {
+	Version   int               `json:"version"`
+	Width     int               `json:"width"`
+	Height    int               `json:"height"`
+	Timestamp int64             `json:"timestamp"`
+	Command   string            `json:"command,omitempty"`
+	Env       map[string]string `json:"env,omitempty"`
+}
+
+// terminalSize is the message sent on the resize channel
+type terminalSize struct {
+	Width  uint16
+	Height uint16
+}
+
+// Recorder writes the byte stream of a console session in the asciicast v2 format
+// (https://docs.asciinema.org/manual/asciicast/v2/) to a sink while the session runs.  Both directions of the
+// stream are recorded, so that the recording shows what the user typed even when the terminal does not echo it.
+//
+// The recording is buffered in memory and stored in chunks, once a chunk is full and at least every flushInterval,
+// so that a server that goes away only loses the end of the session.  Recordings are cut at their size limit.
+type Recorder struct {
+	mu        sync.Mutex
+	sink      Sink
+	orgId     uuid.UUID
+	session   string
+	chunk     bytes.Buffer
+	chunks    int
+	start     time.Time
+	size      int64
+	maxSize   int64
+	truncated bool
+	// partial holds the trailing bytes of an incomplete UTF-8 sequence for each event code, as a character may be
+	// split across messages
+	partial map[string][]byte
+	err     error
+
+	// uploads are the chunks waiting to be stored.  Its capacity bounds the memory of a session whose sink is slow.
+	uploads   chan pendingChunk
+	uploaded  chan struct{}
+	uploadMu  sync.Mutex
+	uploadErr error
+
+	stopFlush chan struct{}
+	flushDone chan struct{}
+}
+
+type pendingChunk struct {
+	key  string
+	data []byte
+}
+
+// NewRecorder starts the recording of a session that starts now, which is stored in the sink under the chunk keys
+// of the session.  A zero width or height is replaced by the standard terminal size, as asciinema requires both, and
+// a non-positive maxSize by DefaultMaxSize.  Close must be called once the session ends.
+func NewRecorder(ctx context.Context, sink Sink, orgId uuid.UUID, session string, maxSize int64, width, height int, command string, term string) *Recorder {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	r := &Recorder{
+		sink:      sink,
+		orgId:     orgId,
+		session:   session,
+		start:     time.Now(),
+		maxSize:   maxSize,
+		partial:   map[string][]byte{},
+		uploads:   make(chan pendingChunk, maxPendingChunks),
+		uploaded:  make(chan struct{}),
+		stopFlush: make(chan struct{}),
+		flushDone: make(chan struct{}),
+	}
+	header := Header{
+		Version:   2,
+		Width:     width,
+		Height:    height,
+		Timestamp: r.start.Unix(),
+		Command:   command,
+	}
+	if header.Width == 0 || header.Height == 0 {
+		header.Width, header.Height = defaultWidth, defaultHeight
+	}
+	if term != "" {
+		header.Env = map[string]string{"TERM": term}
+	}
+	r.writeLine(header)
+
+	// the chunks are stored after the request of the session is done, so they must not be canceled with it
+	go r.upload(context.WithoutCancel(ctx))
+	go r.flushPeriodically()
+	return r
+}
+
+// RecordInput records a message sent by the user to the device.
+func (r *Recorder) RecordInput(message []byte) {
+	if len(message) == 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch message[0] {
+	case stdinChannel:
+		r.writeEvent(inputEvent, message[1:])
+	case resizeChannel:
+		var size terminalSize
+		if err := json.Unmarshal(message[1:], &size); err == nil {
+			r.writeEvent(resizeEvent, []byte(fmt.Sprintf("%dx%d", size.Width, size.Height)))
+		}
+	}
+}
+
+// RecordOutput records a message sent by the device to the user.
+func (r *Recorder) RecordOutput(message []byte) {
+	if len(message) == 0 {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	switch message[0] {
+	case stdoutChannel, stderrChannel:
+		r.writeEvent(outputEvent, message[1:])
+	}
+}
+
+// Close completes the recording, waits until all of it is stored and returns its size.
+func (r *Recorder) Close() (int64, error) {
+	close(r.stopFlush)
+	<-r.flushDone
+
+	r.mu.Lock()
+	for code, data := range r.partial {
+		if len(data) > 0 {
+			r.writeLine([]any{r.elapsed(), code, string(data)})
+		}
+	}
+	r.partial = map[string][]byte{}
+	r.flushChunk()
+	err := r.err
+	r.mu.Unlock()
+
+	close(r.uploads)
+	<-r.uploaded
+
+	if err != nil {
+		return 0, err
+	}
+	r.uploadMu.Lock()
+	defer r.uploadMu.Unlock()
+	if r.uploadErr != nil {
+		return 0, r.uploadErr
+	}
+	return r.size, nil
+}
+
+func (r *Recorder) elapsed() float64 {
+	return time.Since(r.start).Seconds()
+}
+
+// writeEvent writes the complete UTF-8 characters of data, keeping a trailing incomplete character until the next
+// message of the same event code completes it.
+func (r *Recorder) writeEvent(code string, data []byte) {
+	data = append(r.partial[code], data...)
+	end := len(data)
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				end = i
+			}
+			break
+		}
+	}
+	r.partial[code] = append([]byte(nil), data[end:]...)
+	if end == 0 {
+		return
+	}
+	r.writeLine([]any{r.elapsed(), code, string(data[:end])})
+}
+
+// writeLine appends a line to the recording.  The line that would exceed the size limit is replaced by a marker,
+// and the rest of the session is not recorded.
+func (r *Recorder) writeLine(value any) {
+	if r.err != nil || r.truncated {
+		return
+	}
+	line, err := json.Marshal(value)
+	if err != nil {
+		r.err = fmt.Errorf("encoding recording event: %w", err)
+		return
+	}
+	if r.size+int64(len(line))+1 > r.maxSize {
+		r.truncated = true
+		line, _ = json.Marshal([]any{r.elapsed(), markerEvent, truncatedMarker})
+	}
+	line = append(line, '\n')
+	r.chunk.Write(line)
+	r.size += int64(len(line))
+	if r.chunk.Len() >= chunkSize {
+		r.flushChunk()
+	}
+}
+
+// flushChunk hands the buffered part of the recording over to be stored as the next chunk.  It blocks while
+// maxPendingChunks chunks are waiting to be stored.
+func (r *Recorder) flushChunk() {
+	if r.chunk.Len() == 0 {
+		return
+	}
+	r.uploads <- pendingChunk{key: ChunkKey(r.orgId, r.session, r.chunks), data: bytes.Clone(r.chunk.Bytes())}
+	r.chunk.Reset()
+	r.chunks++
+}
+
+func (r *Recorder) flushPeriodically() {
+	defer close(r.flushDone)
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.stopFlush:
+			return
+		case <-ticker.C:
+			r.mu.Lock()
+			r.flushChunk()
+			r.mu.Unlock()
+		}
+	}
+}
+
+// upload stores the chunks in order.  Once a chunk fails, the following ones are dropped, as a recording is read
+// up to its first missing chunk.
+func (r *Recorder) upload(ctx context.Context) {
+	defer close(r.uploaded)
+	for chunk := range r.uploads {
+		r.uploadMu.Lock()
+		failed := r.uploadErr != nil
+		r.uploadMu.Unlock()
+		if failed {
+			continue
+		}
+		if err := r.sink.Store(ctx, chunk.key, bytes.NewReader(chunk.data)); err != nil {
+			r.uploadMu.Lock()
+			r.uploadErr = err
+			r.uploadMu.Unlock()
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package recording
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func readRecording(t *testing.T, recording io.Reader) (Header, [][]any) {
+	t.Helper()
+	scanner := bufio.NewScanner(recording)
+	scanner.Buffer(nil, 2*chunkSize)
+	require.True(t, scanner.Scan())
+	var header Header
+	require.NoError(t, json.Unmarshal(scanner.Bytes(), &header))
+	var events [][]any
+	for scanner.Scan() {
+		var event []any
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &event))
+		events = append(events, event)
+	}
+	require.NoError(t, scanner.Err())
+	return header, events
+}
+
+func newTestRecorder(t *testing.T, maxSize int64, width, height int, command, term string) (*Recorder, Sink, uuid.UUID) {
+	t.Helper()
+	sink := NewFilesystemSink(t.TempDir(), log.InitLogs())
+	orgId := uuid.New()
+	return NewRecorder(context.Background(), sink, orgId, "session", maxSize, width, height, command, term), sink, orgId
+}
+
+func openRecording(t *testing.T, sink Sink, orgId uuid.UUID) []byte {
+	t.Helper()
+	reader, err := Open(context.Background(), sink, orgId, "session")
+	require.NoError(t, err)
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	return content
+}
+
+func TestRecorder(t *testing.T) {
+	require := require.New(t)
+
+	r, sink, orgId := newTestRecorder(t, 0, 0, 0, "/bin/sh", "xterm")
+
+	r.RecordInput(append([]byte{stdinChannel}, "ls\n"...))
+	r.RecordInput(append([]byte{resizeChannel}, `{"Width":120,"Height":40}`...))
+	// "é" split across two messages is written once it is complete
+	r.RecordOutput(append([]byte{stdoutChannel}, "caf\xc3"...))
+	r.RecordOutput([]byte{stdoutChannel, 0xa9})
+	r.RecordOutput(append([]byte{stderrChannel}, "error"...))
+	// messages on other channels are not part of the recording
+	r.RecordOutput(append([]byte{3}, `{"status":"Success"}`...))
+	r.RecordInput(nil)
+
+	size, err := r.Close()
+	require.NoError(err)
+	content := openRecording(t, sink, orgId)
+	require.Equal(int64(len(content)), size)
+
+	header, events := readRecording(t, bytes.NewReader(content))
+	require.Equal(2, header.Version)
+	require.Equal(defaultWidth, header.Width)
+	require.Equal(defaultHeight, header.Height)
+	require.Equal("/bin/sh", header.Command)
+	require.Equal(map[string]string{"TERM": "xterm"}, header.Env)
+
+	require.Len(events, 5)
+	require.Equal([]any{inputEvent, "ls\n"}, events[0][1:])
+	require.Equal([]any{resizeEvent, "120x40"}, events[1][1:])
+	require.Equal([]any{outputEvent, "caf"}, events[2][1:])
+	require.Equal([]any{outputEvent, "é"}, events[3][1:])
+	require.Equal([]any{outputEvent, "error"}, events[4][1:])
+	for _, event := range events {
+		require.IsType(float64(0), event[0])
+	}
+}
+
+func TestRecorderChunks(t *testing.T) {
+	require := require.New(t)
+
+	r, sink, orgId := newTestRecorder(t, 0, 100, 30, "", "")
+	output := append([]byte{stdoutChannel}, strings.Repeat("x", chunkSize/2)...)
+	for i := 0; i < 3; i++ {
+		r.RecordOutput(output)
+	}
+	// the full chunk is stored while the session runs
+	require.Eventually(func() bool {
+		_, err := os.Stat(filepath.Join(sink.(*FilesystemSink).dir, ChunkKey(orgId, "session", 0)))
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	size, err := r.Close()
+	require.NoError(err)
+	_, err = os.Stat(filepath.Join(sink.(*FilesystemSink).dir, ChunkKey(orgId, "session", 1)))
+	require.NoError(err)
+
+	content := openRecording(t, sink, orgId)
+	require.Equal(int64(len(content)), size)
+	_, events := readRecording(t, bytes.NewReader(content))
+	require.Len(events, 3)
+}
+
+func TestRecorderMaxSize(t *testing.T) {
+	require := require.New(t)
+
+	r, sink, orgId := newTestRecorder(t, 200, 100, 30, "", "")
+	for i := 0; i < 10; i++ {
+		r.RecordOutput(append([]byte{stdoutChannel}, "0123456789"...))
+	}
+	size, err := r.Close()
+	require.NoError(err)
+
+	content := openRecording(t, sink, orgId)
+	require.Equal(int64(len(content)), size)
+	_, events := readRecording(t, bytes.NewReader(content))
+	require.Less(len(events), 10)
+	require.Equal([]any{markerEvent, truncatedMarker}, events[len(events)-1][1:])
+}
+
+func TestOpenMissingRecording(t *testing.T) {
+	sink := NewFilesystemSink(t.TempDir(), log.InitLogs())
+	_, err := Open(context.Background(), sink, uuid.New(), "session")
+	require.ErrorIs(t, err, flterrors.ErrResourceNotFound)
+}
@@ -0,0 +1,70 @@
+package recording
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/sirupsen/logrus"
+)
+
+// S3Sink stores recordings as objects in a bucket of an S3-compatible object store.
+type S3Sink struct {
+	client *s3.S3
+	bucket string
+	log    logrus.FieldLogger
+}
+
+var _ Sink = (*S3Sink)(nil)
+
+func NewS3Sink(endpoint, region, bucket, accessKey, secretKey string, log logrus.FieldLogger) (*S3Sink, error) {
+	if region == "" {
+		region = "us-east-1"
+	}
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:         aws.String(endpoint),
+		Region:           aws.String(region),
+		Credentials:      credentials.NewStaticCredentials(accessKey, secretKey, ""),
+		S3ForcePathStyle: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating S3 session: %w", err)
+	}
+	return &S3Sink{client: s3.New(sess), bucket: bucket, log: log}, nil
+}
+
+func (s *S3Sink) Store(ctx context.Context, key string, recording io.ReadSeeker) error {
+	_, err := s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        recording,
+		ContentType: aws.String(ContentType),
+	})
+	if err != nil {
+		return fmt.Errorf("uploading recording to S3: %w", err)
+	}
+	s.log.Debugf("Stored console recording s3://%s/%s", s.bucket, key)
+	return nil
+}
+
+func (s *S3Sink) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	output, err := s.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, flterrors.ErrResourceNotFound
+		}
+		return nil, fmt.Errorf("downloading recording from S3: %w", err)
+	}
+	return output.Body, nil
+}
@@ -0,0 +1,114 @@
+package recording
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// ContentType is the media type of asciicast v2 recordings
+const ContentType = "application/x-asciicast"
+
+// Sink stores console session recordings.
+type Sink interface {
+	// Store saves the recording under the given key, replacing any recording with the same key.
+	Store(ctx context.Context, key string, recording io.ReadSeeker) error
+	// Open returns the recording stored under the given key.  It returns flterrors.ErrResourceNotFound if there is
+	// no such recording.
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+}
+
+// NewSink returns the sink configured for console recordings, or nil if console sessions are not recorded.
+func NewSink(cfg *config.Config, log logrus.FieldLogger) (Sink, error) {
+	if cfg.Console == nil || cfg.Console.Recording == nil || !cfg.Console.Recording.Enabled {
+		return nil, nil
+	}
+	storage := cfg.Console.Recording.Storage
+	if storage == nil {
+		return nil, fmt.Errorf("console recording storage is not configured")
+	}
+	switch storage.Type {
+	case "local":
+		return NewFilesystemSink(storage.LocalPath, log), nil
+	case "s3":
+		sink, err := NewS3Sink(storage.S3Config.Endpoint, storage.S3Config.Region, storage.S3Config.Bucket,
+			storage.S3Config.AccessKey, string(storage.S3Config.SecretKey), log)
+		if err != nil {
+			return nil, err
+		}
+		return sink, nil
+	default:
+		return nil, fmt.Errorf("unsupported console recording storage type: %s", storage.Type)
+	}
+}
+
+// MaxSize returns the configured size limit of recordings, or zero for the default.
+func MaxSize(cfg *config.Config) int64 {
+	if cfg.Console == nil || cfg.Console.Recording == nil {
+		return 0
+	}
+	return cfg.Console.Recording.MaxSize
+}
+
+// ChunkKey returns the key under which a chunk of the recording of a console session is stored.  The recording is
+// the concatenation of its chunks, in the order of their index.
+func ChunkKey(orgId uuid.UUID, sessionName string, index int) string {
+	return fmt.Sprintf("%s/%s/%06d.cast", orgId, sessionName, index)
+}
+
+// Open returns the recording of a console session as stored so far.  It returns flterrors.ErrResourceNotFound if
+// no chunk of the recording is stored.
+func Open(ctx context.Context, sink Sink, orgId uuid.UUID, sessionName string) (io.ReadCloser, error) {
+	first, err := sink.Open(ctx, ChunkKey(orgId, sessionName, 0))
+	if err != nil {
+		return nil, err
+	}
+	return &chunkReader{ctx: ctx, sink: sink, orgId: orgId, session: sessionName, current: first}, nil
+}
+
+// chunkReader reads the chunks of a recording one after the other, up to the first missing one
+type chunkReader struct {
+	ctx     context.Context
+	sink    Sink
+	orgId   uuid.UUID
+	session string
+	index   int
+	current io.ReadCloser
+}
+
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for c.current != nil {
+		n, err := c.current.Read(p)
+		if !errors.Is(err, io.EOF) {
+			return n, err
+		}
+		_ = c.current.Close()
+		c.current = nil
+		c.index++
+		next, err := c.sink.Open(c.ctx, ChunkKey(c.orgId, c.session, c.index))
+		switch {
+		case errors.Is(err, flterrors.ErrResourceNotFound):
+		case err != nil:
+			return n, err
+		default:
+			c.current = next
+		}
+		if n > 0 {
+			return n, nil
+		}
+	}
+	return 0, io.EOF
+}
+
+func (c *chunkReader) Close() error {
+	if c.current == nil {
+		return nil
+	}
+	return c.current.Close()
+}
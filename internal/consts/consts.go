@@ -26,4 +26,5 @@ const (
 	AgentCtxKey                ctxKey = "agent"
 	TokenCtxKey                ctxKey = "token"
 	IdentityCtxKey             ctxKey = "identity"
+	DryRunCtxKey               ctxKey = "dry-run"
 )
@@ -6,15 +6,19 @@ import (
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/config/ca"
 	"github.com/flightctl/flightctl/internal/crypto/signer"
 	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"golang.org/x/crypto/ocsp"
 	"k8s.io/apimachinery/pkg/util/sets"
 )
 
@@ -23,6 +27,45 @@ type CertOption = func(*x509.Certificate) error
 type CABackend interface {
 	IssueRequestedCertificateAsX509(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, usage []x509.ExtKeyUsage, opts ...CertOption) (*x509.Certificate, error)
 	GetCABundleX509() []*x509.Certificate
+	CreateRevocationList(ctx context.Context, template *x509.RevocationList) ([]byte, error)
+	CreateOCSPResponse(ctx context.Context, template ocsp.Response) ([]byte, error)
+	// SigningKey returns the CA key for signing other than issuing certificates, such as the tokens of
+	// the PAM issuer.  Backends that don't hold the CA key return an error.
+	SigningKey() (crypto.Signer, error)
+}
+
+// requestedCertificateTemplate returns the template of the certificate to issue for a CSR, with the
+// options applied.
+func requestedCertificateTemplate(csr *x509.CertificateRequest, issuer *x509.Certificate, expirySeconds int, usage []x509.ExtKeyUsage, opts ...CertOption) (*x509.Certificate, error) {
+	now := time.Now()
+	expire := time.Duration(expirySeconds) * time.Second
+	// Note Subject (and other fields where applicable) validation is performed by the callers.
+	// This routine will sign what it is given, length checks and other validation should happen
+	// further up the call chain.
+	template := &x509.Certificate{
+		Subject:               csr.Subject,
+		Signature:             csr.Signature,
+		SignatureAlgorithm:    csr.SignatureAlgorithm,
+		PublicKey:             csr.PublicKey,
+		PublicKeyAlgorithm:    csr.PublicKeyAlgorithm,
+		IPAddresses:           csr.IPAddresses,
+		DNSNames:              csr.DNSNames,
+		Issuer:                issuer.Subject,
+		NotBefore:             now.Add(-time.Second),
+		NotAfter:              now.Add(expire),
+		SerialNumber:          big.NewInt(1),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           usage,
+		BasicConstraintsValid: true,
+		AuthorityKeyId:        issuer.SubjectKeyId,
+	}
+
+	for _, opt := range opts {
+		if err := opt(template); err != nil {
+			return nil, fmt.Errorf("applying cert option: %w", err)
+		}
+	}
+	return template, nil
 }
 
 type CAClient struct {
@@ -39,8 +82,23 @@ func (caClient *CAClient) Config() *ca.Config {
 // If the CA is successfully loaded or generated it returns a valid CA instance, a flag signifying
 // was it loaded or generated and a nil error.
 // In case of errors a non-nil error is returned.
+// The backend is the internal CA unless a PKCS#11 token or a step-ca instance is configured.
 func EnsureCA(cfg *ca.Config) (*CAClient, bool, error) {
-	caBackend, fresh, err := ensureInternalCA(cfg)
+	var (
+		caBackend CABackend
+		fresh     bool
+		err       error
+	)
+	switch {
+	case cfg.PKCS11Config != nil && cfg.StepCAConfig != nil:
+		return nil, false, errors.New("only one of pkcs11Config and stepCAConfig can be set")
+	case cfg.PKCS11Config != nil:
+		caBackend, fresh, err = ensurePKCS11CA(cfg.PKCS11Config)
+	case cfg.StepCAConfig != nil:
+		caBackend, fresh, err = ensureStepCA(cfg.StepCAConfig)
+	default:
+		caBackend, fresh, err = ensureInternalCA(cfg)
+	}
 	if err != nil {
 		return nil, fresh, err
 	}
@@ -255,6 +313,12 @@ func (caClient *CAClient) GetCABundleX509() []*x509.Certificate {
 	return caClient.caBackend.GetCABundleX509()
 }
 
+// SigningKey returns the CA key for signing tokens.  It is kept in the backend, e.g. in a PKCS#11
+// token, so it can only be used through crypto.Signer.
+func (caClient *CAClient) SigningKey() (crypto.Signer, error) {
+	return caClient.caBackend.SigningKey()
+}
+
 func (caClient *CAClient) GetCABundle() ([]byte, error) {
 	certs := caClient.GetCABundleX509()
 	return oscrypto.EncodeCertificates(certs...)
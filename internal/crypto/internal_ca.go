@@ -7,7 +7,6 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"errors"
-	"fmt"
 	"math/big"
 	"os"
 	"time"
@@ -15,6 +14,7 @@ import (
 	"github.com/flightctl/flightctl/internal/config/ca"
 	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"golang.org/x/crypto/ocsp"
 )
 
 type internalCA struct {
@@ -112,7 +112,7 @@ func makeSelfSignedCAConfig(subject pkix.Name, caLifetime time.Duration, serial
 
 		SerialNumber: big.NewInt(serial),
 
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
 		BasicConstraintsValid: true,
 		IsCA:                  true,
 
@@ -160,33 +160,9 @@ func signCertificate(template *x509.Certificate, requestKey crypto.PublicKey, is
 // This currently processes both enrollment cert and management cert signing requests, which both are signed
 // by the FC service's internal CA instance named 'ca'.
 func (caBackend *internalCA) IssueRequestedCertificateAsX509(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, usage []x509.ExtKeyUsage, opts ...CertOption) (*x509.Certificate, error) {
-	now := time.Now()
-	expire := time.Duration(expirySeconds) * time.Second
-	// Note Subject (and other fields where applicable) validation is performed by the callers.
-	// This routine will sign what it is given, length checks and other validation should happen
-	// further up the call chain.
-	template := &x509.Certificate{
-		Subject:               csr.Subject,
-		Signature:             csr.Signature,
-		SignatureAlgorithm:    csr.SignatureAlgorithm,
-		PublicKey:             csr.PublicKey,
-		PublicKeyAlgorithm:    csr.PublicKeyAlgorithm,
-		IPAddresses:           csr.IPAddresses,
-		DNSNames:              csr.DNSNames,
-		Issuer:                caBackend.Config.Certs[0].Subject,
-		NotBefore:             now.Add(-time.Second),
-		NotAfter:              now.Add(expire),
-		SerialNumber:          big.NewInt(1),
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
-		ExtKeyUsage:           usage,
-		BasicConstraintsValid: true,
-		AuthorityKeyId:        caBackend.Config.Certs[0].SubjectKeyId,
-	}
-
-	for _, opt := range opts {
-		if err := opt(template); err != nil {
-			return nil, fmt.Errorf("applying cert option: %w", err)
-		}
+	template, err := requestedCertificateTemplate(csr, caBackend.Config.Certs[0], expirySeconds, usage, opts...)
+	if err != nil {
+		return nil, err
 	}
 	return caBackend.signCertificate(template, csr.PublicKey)
 }
@@ -194,3 +170,27 @@ func (caBackend *internalCA) IssueRequestedCertificateAsX509(ctx context.Context
 func (caBackend *internalCA) GetCABundleX509() []*x509.Certificate {
 	return caBackend.Config.Certs
 }
+
+func (caBackend *internalCA) CreateRevocationList(ctx context.Context, template *x509.RevocationList) ([]byte, error) {
+	signer, ok := caBackend.Config.Key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("CA key cannot be used for signing")
+	}
+	return createRevocationList(template, caBackend.Config.Certs[0], signer)
+}
+
+func (caBackend *internalCA) SigningKey() (crypto.Signer, error) {
+	signer, ok := caBackend.Config.Key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("CA key cannot be used for signing")
+	}
+	return signer, nil
+}
+
+func (caBackend *internalCA) CreateOCSPResponse(ctx context.Context, template ocsp.Response) ([]byte, error) {
+	signer, ok := caBackend.Config.Key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("CA key cannot be used for signing")
+	}
+	return createOCSPResponse(template, caBackend.Config.Certs[0], signer)
+}
@@ -0,0 +1,113 @@
+//go:build cgo
+
+package crypto
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ThalesIgnite/crypto11"
+	"github.com/flightctl/flightctl/internal/config/ca"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"golang.org/x/crypto/ocsp"
+)
+
+// pkcs11CA signs with a CA key that is kept in a PKCS#11 token, so the key never leaves the token.  The
+// key and certificate are provisioned by the operator; unlike the internal CA, nothing is generated.
+type pkcs11CA struct {
+	certs           []*x509.Certificate
+	signer          crypto.Signer
+	serialGenerator oscrypto.SerialGenerator
+}
+
+func ensurePKCS11CA(cfg *ca.PKCS11Cfg) (CABackend, bool, error) {
+	if cfg.ModulePath == "" || cfg.KeyLabel == "" {
+		return nil, false, errors.New("PKCS#11 backend requires modulePath and keyLabel")
+	}
+	if cfg.TokenLabel == "" && cfg.SlotNumber == nil {
+		return nil, false, errors.New("PKCS#11 backend requires tokenLabel or slotNumber")
+	}
+
+	p11, err := crypto11.Configure(&crypto11.Config{
+		Path:       cfg.ModulePath,
+		TokenLabel: cfg.TokenLabel,
+		SlotNumber: cfg.SlotNumber,
+		Pin:        cfg.Pin,
+	})
+	if err != nil {
+		return nil, false, fmt.Errorf("opening PKCS#11 token: %w", err)
+	}
+
+	signer, err := p11.FindKeyPair(nil, []byte(cfg.KeyLabel))
+	if err != nil {
+		return nil, false, fmt.Errorf("finding CA key %q in PKCS#11 token: %w", cfg.KeyLabel, err)
+	}
+	if signer == nil {
+		return nil, false, fmt.Errorf("CA key %q not found in PKCS#11 token", cfg.KeyLabel)
+	}
+
+	var certs []*x509.Certificate
+	if cfg.CertFile != "" {
+		certPEM, err := os.ReadFile(cfg.CertFile)
+		if err != nil {
+			return nil, false, fmt.Errorf("reading CA certificate: %w", err)
+		}
+		if certs, err = oscrypto.CertsFromPEM(certPEM); err != nil {
+			return nil, false, fmt.Errorf("parsing CA certificate: %w", err)
+		}
+	} else {
+		cert, err := p11.FindCertificate(nil, []byte(cfg.KeyLabel), nil)
+		if err != nil {
+			return nil, false, fmt.Errorf("finding CA certificate %q in PKCS#11 token: %w", cfg.KeyLabel, err)
+		}
+		if cert == nil {
+			return nil, false, fmt.Errorf("CA certificate %q not found in PKCS#11 token", cfg.KeyLabel)
+		}
+		certs = []*x509.Certificate{cert}
+	}
+
+	caKey, ok := certs[0].PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !caKey.Equal(signer.Public()) {
+		return nil, false, fmt.Errorf("CA certificate does not match the key %q in the PKCS#11 token", cfg.KeyLabel)
+	}
+
+	return &pkcs11CA{
+		certs:           certs,
+		signer:          signer,
+		serialGenerator: &oscrypto.RandomSerialGenerator{},
+	}, false, nil
+}
+
+func (caBackend *pkcs11CA) IssueRequestedCertificateAsX509(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, usage []x509.ExtKeyUsage, opts ...CertOption) (*x509.Certificate, error) {
+	template, err := requestedCertificateTemplate(csr, caBackend.certs[0], expirySeconds, usage, opts...)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := caBackend.serialGenerator.Next(template)
+	if err != nil {
+		return nil, err
+	}
+	template.SerialNumber = big.NewInt(serial)
+	return signCertificate(template, csr.PublicKey, caBackend.certs[0], caBackend.signer)
+}
+
+func (caBackend *pkcs11CA) GetCABundleX509() []*x509.Certificate {
+	return caBackend.certs
+}
+
+func (caBackend *pkcs11CA) CreateRevocationList(ctx context.Context, template *x509.RevocationList) ([]byte, error) {
+	return createRevocationList(template, caBackend.certs[0], caBackend.signer)
+}
+
+func (caBackend *pkcs11CA) SigningKey() (crypto.Signer, error) {
+	return caBackend.signer, nil
+}
+
+func (caBackend *pkcs11CA) CreateOCSPResponse(ctx context.Context, template ocsp.Response) ([]byte, error) {
+	return createOCSPResponse(template, caBackend.certs[0], caBackend.signer)
+}
@@ -0,0 +1,13 @@
+//go:build !cgo
+
+package crypto
+
+import (
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+)
+
+func ensurePKCS11CA(cfg *ca.PKCS11Cfg) (CABackend, bool, error) {
+	return nil, false, errors.New("PKCS#11 backend is not available in builds without cgo")
+}
@@ -0,0 +1,180 @@
+//go:build cgo
+
+package crypto
+
+import (
+	"context"
+	"crypto"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ThalesIgnite/crypto11"
+	"github.com/flightctl/flightctl/internal/config/ca"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	softHSMTokenLabel = "flightctl-test"
+	softHSMPin        = "1234"
+	softHSMKeyLabel   = "ca"
+)
+
+// softHSMModulePaths are the locations of the SoftHSM module on the common distributions
+var softHSMModulePaths = []string{
+	"/usr/lib64/pkcs11/libsofthsm2.so",
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/lib/aarch64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+}
+
+// newSoftHSMToken initializes a SoftHSM token in a temporary directory and returns the config of a
+// PKCS#11 CA using it.  The test is skipped if SoftHSM is not installed.
+func newSoftHSMToken(t *testing.T) *ca.PKCS11Cfg {
+	modulePath := os.Getenv("SOFTHSM2_MODULE")
+	if modulePath == "" {
+		for _, path := range softHSMModulePaths {
+			if _, err := os.Stat(path); err == nil {
+				modulePath = path
+				break
+			}
+		}
+	}
+	if modulePath == "" {
+		t.Skip("SoftHSM module not found, set SOFTHSM2_MODULE to run the PKCS#11 tests")
+	}
+	if _, err := exec.LookPath("softhsm2-util"); err != nil {
+		t.Skip("softhsm2-util not found")
+	}
+
+	dir := t.TempDir()
+	tokenDir := filepath.Join(dir, "tokens")
+	require.NoError(t, os.Mkdir(tokenDir, 0700))
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, os.WriteFile(conf, []byte("directories.tokendir = "+tokenDir+"\nobjectstore.backend = file\n"), 0600))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	out, err := exec.Command("softhsm2-util", "--init-token", "--free", "--label", softHSMTokenLabel,
+		"--pin", softHSMPin, "--so-pin", "5678").CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	return &ca.PKCS11Cfg{
+		ModulePath: modulePath,
+		TokenLabel: softHSMTokenLabel,
+		Pin:        softHSMPin,
+		KeyLabel:   softHSMKeyLabel,
+	}
+}
+
+func openSoftHSMToken(t *testing.T, cfg *ca.PKCS11Cfg) *crypto11.Context {
+	p11, err := crypto11.Configure(&crypto11.Config{Path: cfg.ModulePath, TokenLabel: cfg.TokenLabel, Pin: cfg.Pin})
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = p11.Close() })
+	return p11
+}
+
+func newSelfSignedCACert(t *testing.T, signer crypto.Signer) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "pkcs11-test-ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func writeCertFile(t *testing.T, cert *x509.Certificate) string {
+	certPEM, err := oscrypto.EncodeCertificates(cert)
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "ca.crt")
+	require.NoError(t, os.WriteFile(path, certPEM, 0600))
+	return path
+}
+
+func issueTestClientCertificate(t *testing.T, backend CABackend) *x509.Certificate {
+	_, privateKey, err := fccrypto.NewKeyPair()
+	require.NoError(t, err)
+	csrPEM, err := fccrypto.MakeCSR(privateKey.(crypto.Signer), "device")
+	require.NoError(t, err)
+	csr, err := fccrypto.ParseCSR(csrPEM)
+	require.NoError(t, err)
+	cert, err := backend.IssueRequestedCertificateAsX509(context.Background(), csr, 3600, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+	require.NoError(t, err)
+	return cert
+}
+
+func TestPKCS11CA(t *testing.T) {
+	cfg := newSoftHSMToken(t)
+	p11 := openSoftHSMToken(t, cfg)
+	signer, err := p11.GenerateECDSAKeyPairWithLabel([]byte{1}, []byte(softHSMKeyLabel), elliptic.P256())
+	require.NoError(t, err)
+	caCert := newSelfSignedCACert(t, signer)
+
+	verifyIssued := func(t *testing.T, backend CABackend) {
+		cert := issueTestClientCertificate(t, backend)
+		roots := x509.NewCertPool()
+		roots.AddCert(caCert)
+		_, err := cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+		require.NoError(t, err)
+	}
+
+	t.Run("issues certificates with the certificate file", func(t *testing.T) {
+		fileCfg := *cfg
+		fileCfg.CertFile = writeCertFile(t, caCert)
+		backend, fresh, err := ensurePKCS11CA(&fileCfg)
+		require.NoError(t, err)
+		require.False(t, fresh)
+		require.True(t, caCert.Equal(backend.GetCABundleX509()[0]))
+		verifyIssued(t, backend)
+
+		crl, err := backend.CreateRevocationList(context.Background(), &x509.RevocationList{
+			Number:     big.NewInt(1),
+			ThisUpdate: time.Now(),
+			NextUpdate: time.Now().Add(time.Hour),
+		})
+		require.NoError(t, err)
+		parsed, err := x509.ParseRevocationList(crl)
+		require.NoError(t, err)
+		require.NoError(t, parsed.CheckSignatureFrom(caCert))
+	})
+
+	t.Run("issues certificates with the certificate in the token", func(t *testing.T) {
+		require.NoError(t, p11.ImportCertificateWithLabel([]byte{1}, []byte(softHSMKeyLabel), caCert))
+		backend, _, err := ensurePKCS11CA(cfg)
+		require.NoError(t, err)
+		verifyIssued(t, backend)
+	})
+
+	t.Run("certificate does not match the key", func(t *testing.T) {
+		_, otherKey, err := fccrypto.NewKeyPair()
+		require.NoError(t, err)
+		mismatchCfg := *cfg
+		mismatchCfg.CertFile = writeCertFile(t, newSelfSignedCACert(t, otherKey.(crypto.Signer)))
+		_, _, err = ensurePKCS11CA(&mismatchCfg)
+		require.ErrorContains(t, err, "does not match the key")
+	})
+
+	t.Run("key not found", func(t *testing.T) {
+		missingCfg := *cfg
+		missingCfg.KeyLabel = "missing"
+		_, _, err := ensurePKCS11CA(&missingCfg)
+		require.ErrorContains(t, err, "not found in PKCS#11 token")
+	})
+}
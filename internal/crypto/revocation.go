@@ -0,0 +1,99 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+)
+
+// ErrCRLSignNotPermitted is returned when creating a revocation list with a CA
+// certificate that lacks the cRLSign key usage, as CAs generated by earlier
+// versions do. Relying parties reject CRLs signed by such a CA, so the CA
+// certificate has to be re-issued with the key usage first.
+var ErrCRLSignNotPermitted = errors.New("the CA certificate does not permit signing revocation lists, re-issue it with the cRLSign key usage")
+
+// CertificateSerialNumber returns the serial number of a certificate in the
+// form used to record its revocation.
+func CertificateSerialNumber(cert *x509.Certificate) string {
+	return SerialNumberString(cert.SerialNumber)
+}
+
+func SerialNumberString(serial *big.Int) string {
+	return serial.Text(16)
+}
+
+// ParseSerialNumber is the inverse of SerialNumberString.
+func ParseSerialNumber(serialNumber string) (*big.Int, bool) {
+	return new(big.Int).SetString(serialNumber, 16)
+}
+
+// CreateRevocationList returns a DER-encoded CRL listing the given entries,
+// signed by the CA. The CRL number is derived from thisUpdate so that lists
+// generated later always carry a higher number.
+func (caClient *CAClient) CreateRevocationList(ctx context.Context, entries []x509.RevocationListEntry, thisUpdate, nextUpdate time.Time) ([]byte, error) {
+	template := &x509.RevocationList{
+		RevokedCertificateEntries: entries,
+		Number:                    big.NewInt(thisUpdate.Unix()),
+		ThisUpdate:                thisUpdate,
+		NextUpdate:                nextUpdate,
+	}
+	crl, err := caClient.caBackend.CreateRevocationList(ctx, template)
+	if err != nil {
+		return nil, fmt.Errorf("creating revocation list: %w", err)
+	}
+	return crl, nil
+}
+
+// CreateOCSPResponse returns a DER-encoded OCSP response signed by the CA.
+func (caClient *CAClient) CreateOCSPResponse(ctx context.Context, template ocsp.Response) ([]byte, error) {
+	resp, err := caClient.caBackend.CreateOCSPResponse(ctx, template)
+	if err != nil {
+		return nil, fmt.Errorf("creating OCSP response: %w", err)
+	}
+	return resp, nil
+}
+
+func createRevocationList(template *x509.RevocationList, issuer *x509.Certificate, signer crypto.Signer) ([]byte, error) {
+	if issuer.KeyUsage != 0 && issuer.KeyUsage&x509.KeyUsageCRLSign == 0 {
+		return nil, ErrCRLSignNotPermitted
+	}
+	return x509.CreateRevocationList(rand.Reader, template, issuer, signer)
+}
+
+func createOCSPResponse(template ocsp.Response, issuer *x509.Certificate, signer crypto.Signer) ([]byte, error) {
+	return ocsp.CreateResponse(issuer, issuer, template, signer)
+}
+
+// IsOCSPRequestIssuer reports whether the OCSP request asks about a
+// certificate issued by the CA, by comparing the issuer name and key hashes.
+func (caClient *CAClient) IsOCSPRequestIssuer(req *ocsp.Request) bool {
+	if !req.HashAlgorithm.Available() {
+		return false
+	}
+	issuer := caClient.GetCABundleX509()[0]
+
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(issuer.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return false
+	}
+
+	nameHash := req.HashAlgorithm.New()
+	nameHash.Write(issuer.RawSubject)
+	keyHash := req.HashAlgorithm.New()
+	keyHash.Write(spki.PublicKey.RightAlign())
+
+	return bytes.Equal(nameHash.Sum(nil), req.IssuerNameHash) && bytes.Equal(keyHash.Sum(nil), req.IssuerKeyHash)
+}
@@ -0,0 +1,57 @@
+package crypto
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestIssuer(t *testing.T, keyUsage x509.KeyUsage) (*x509.Certificate, crypto.Signer) {
+	_, privateKey, err := fccrypto.NewKeyPair()
+	require.NoError(t, err)
+	signer := privateKey.(crypto.Signer)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              keyUsage,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, signer.Public(), signer)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, signer
+}
+
+func TestCreateRevocationList(t *testing.T) {
+	template := &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now(),
+		NextUpdate: time.Now().Add(time.Hour),
+	}
+
+	t.Run("CA with cRLSign", func(t *testing.T) {
+		issuer, signer := newTestIssuer(t, x509.KeyUsageCertSign|x509.KeyUsageCRLSign)
+		der, err := createRevocationList(template, issuer, signer)
+		require.NoError(t, err)
+		crl, err := x509.ParseRevocationList(der)
+		require.NoError(t, err)
+		require.NoError(t, crl.CheckSignatureFrom(issuer))
+	})
+
+	t.Run("CA without cRLSign", func(t *testing.T) {
+		issuer, signer := newTestIssuer(t, x509.KeyUsageCertSign)
+		_, err := createRevocationList(template, issuer, signer)
+		require.ErrorIs(t, err, ErrCRLSignNotPermitted)
+	})
+}
@@ -0,0 +1,363 @@
+package crypto
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/google/uuid"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwk"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	stepCASignPath              = "/1.0/sign"
+	stepCATokenLifetime         = 5 * time.Minute
+	defaultStepCARequestTimeout = 30 * time.Second
+	maxStepCAResponseSize       = 1024 * 1024
+)
+
+// ErrStepCARevocationUnsupported is returned when creating a revocation list or
+// an OCSP response with the step-ca backend, which does not hold the CA key.
+var ErrStepCARevocationUnsupported = errors.New("the step-ca backend does not sign revocation information, use the CRL and OCSP responder of step-ca instead")
+
+var errStepCASigningKeyUnavailable = errors.New("the step-ca backend does not hold the CA key")
+
+// stepCA issues certificates through the sign API of step-ca.  Requests are authorized with a one-time
+// token signed by the key of a JWK provisioner, so the CA key never leaves the CA.
+//
+// step-ca builds certificates from the template of the provisioner, so the key usages and the extensions
+// that the signers add are passed as template data; the provisioner template must copy them:
+//
+//	{
+//		"subject": {{ toJson .Subject }},
+//		"sans": {{ toJson .SANs }},
+//		"keyUsage": {{ toJson .Insecure.User.keyUsage }},
+//		"extKeyUsage": {{ toJson .Insecure.User.extKeyUsage }},
+//		"extensions": {{ toJson .Insecure.User.extensions }}
+//	}
+type stepCA struct {
+	cfg            *ca.StepCACfg
+	bundle         []*x509.Certificate
+	roots          *x509.CertPool
+	provisionerKey jwk.Key
+	signingAlg     jwa.SignatureAlgorithm
+	client         *http.Client
+}
+
+type stepCASignRequest struct {
+	CSR          string             `json:"csr"`
+	OTT          string             `json:"ott"`
+	NotBefore    time.Time          `json:"notBefore"`
+	NotAfter     time.Time          `json:"notAfter"`
+	TemplateData stepCATemplateData `json:"templateData"`
+}
+
+type stepCATemplateData struct {
+	KeyUsage    []string          `json:"keyUsage"`
+	ExtKeyUsage []string          `json:"extKeyUsage"`
+	Extensions  []stepCAExtension `json:"extensions"`
+}
+
+// stepCAExtension has the format of extensions in step-ca certificate templates
+type stepCAExtension struct {
+	ID       string `json:"id"`
+	Critical bool   `json:"critical"`
+	Value    []byte `json:"value"`
+}
+
+type stepCASignResponse struct {
+	Certificate string `json:"crt"`
+}
+
+type stepCAError struct {
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+}
+
+func ensureStepCA(cfg *ca.StepCACfg) (CABackend, bool, error) {
+	if cfg.URL == "" || cfg.CABundleFile == "" || cfg.ProvisionerName == "" || cfg.ProvisionerKeyFile == "" {
+		return nil, false, errors.New("step-ca backend requires url, caBundleFile, provisionerName and provisionerKeyFile")
+	}
+
+	bundlePEM, err := os.ReadFile(cfg.CABundleFile)
+	if err != nil {
+		return nil, false, fmt.Errorf("reading step-ca CA bundle: %w", err)
+	}
+	bundle, err := oscrypto.CertsFromPEM(bundlePEM)
+	if err != nil {
+		return nil, false, fmt.Errorf("parsing step-ca CA bundle: %w", err)
+	}
+	roots := x509.NewCertPool()
+	for _, cert := range bundle {
+		roots.AddCert(cert)
+	}
+
+	key, err := fccrypto.LoadKey(cfg.ProvisionerKeyFile)
+	if err != nil {
+		return nil, false, fmt.Errorf("loading step-ca provisioner key: %w", err)
+	}
+	provisionerKey, signingAlg, err := stepCAProvisionerKey(key, cfg.ProvisionerKeyID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	timeout := defaultStepCARequestTimeout
+	if cfg.RequestTimeoutSeconds > 0 {
+		timeout = time.Duration(cfg.RequestTimeoutSeconds) * time.Second
+	}
+
+	return &stepCA{
+		cfg:            cfg,
+		bundle:         bundle,
+		roots:          roots,
+		provisionerKey: provisionerKey,
+		signingAlg:     signingAlg,
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{RootCAs: roots, MinVersion: tls.VersionTLS12},
+			},
+		},
+	}, false, nil
+}
+
+func stepCAProvisionerKey(key crypto.PrivateKey, keyID string) (jwk.Key, jwa.SignatureAlgorithm, error) {
+	var alg jwa.SignatureAlgorithm
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		switch k.Curve.Params().BitSize {
+		case 256:
+			alg = jwa.ES256
+		case 384:
+			alg = jwa.ES384
+		case 521:
+			alg = jwa.ES512
+		default:
+			return nil, "", fmt.Errorf("unsupported step-ca provisioner key curve %s", k.Curve.Params().Name)
+		}
+	case *rsa.PrivateKey:
+		alg = jwa.RS256
+	case ed25519.PrivateKey:
+		alg = jwa.EdDSA
+	default:
+		return nil, "", fmt.Errorf("unsupported step-ca provisioner key type %T", key)
+	}
+
+	jwkKey, err := jwk.FromRaw(key)
+	if err != nil {
+		return nil, "", fmt.Errorf("converting step-ca provisioner key: %w", err)
+	}
+	if keyID == "" {
+		// step-ca uses the JWK thumbprint as the key ID by default
+		thumbprint, err := jwkKey.Thumbprint(crypto.SHA256)
+		if err != nil {
+			return nil, "", fmt.Errorf("computing step-ca provisioner key ID: %w", err)
+		}
+		keyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+	}
+	if err := jwkKey.Set(jwk.KeyIDKey, keyID); err != nil {
+		return nil, "", fmt.Errorf("setting step-ca provisioner key ID: %w", err)
+	}
+	return jwkKey, alg, nil
+}
+
+func (s *stepCA) IssueRequestedCertificateAsX509(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, usage []x509.ExtKeyUsage, opts ...CertOption) (*x509.Certificate, error) {
+	template, err := requestedCertificateTemplate(csr, s.bundle[0], expirySeconds, usage, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	ott, err := s.signToken(template)
+	if err != nil {
+		return nil, err
+	}
+	data, err := stepCATemplateDataFor(template)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(stepCASignRequest{
+		CSR:          string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr.Raw})),
+		OTT:          ott,
+		NotBefore:    template.NotBefore,
+		NotAfter:     template.NotAfter,
+		TemplateData: data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encoding step-ca sign request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(s.cfg.URL, "/")+stepCASignPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("creating step-ca sign request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending step-ca sign request: %w", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxStepCAResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("reading step-ca sign response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		var stepErr stepCAError
+		if err := json.Unmarshal(respBody, &stepErr); err == nil && stepErr.Message != "" {
+			return nil, fmt.Errorf("step-ca rejected the sign request (%d): %s", resp.StatusCode, stepErr.Message)
+		}
+		return nil, fmt.Errorf("step-ca rejected the sign request (%d)", resp.StatusCode)
+	}
+
+	var signResp stepCASignResponse
+	if err := json.Unmarshal(respBody, &signResp); err != nil {
+		return nil, fmt.Errorf("decoding step-ca sign response: %w", err)
+	}
+	cert, err := fccrypto.ParsePEMCertificate([]byte(signResp.Certificate))
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate issued by step-ca: %w", err)
+	}
+	if err := s.verifyIssuedCertificate(cert, template); err != nil {
+		return nil, err
+	}
+	return cert, nil
+}
+
+// signToken returns the one-time token that authorizes the sign request
+func (s *stepCA) signToken(template *x509.Certificate) (string, error) {
+	sans := make([]string, 0, len(template.DNSNames)+len(template.IPAddresses))
+	sans = append(sans, template.DNSNames...)
+	for _, ip := range template.IPAddresses {
+		sans = append(sans, ip.String())
+	}
+
+	now := time.Now()
+	token, err := jwt.NewBuilder().
+		Issuer(s.cfg.ProvisionerName).
+		Audience([]string{strings.TrimSuffix(s.cfg.URL, "/") + stepCASignPath}).
+		Subject(template.Subject.CommonName).
+		IssuedAt(now).
+		NotBefore(now).
+		Expiration(now.Add(stepCATokenLifetime)).
+		JwtID(uuid.NewString()).
+		Claim("sans", sans).
+		Build()
+	if err != nil {
+		return "", fmt.Errorf("building step-ca token: %w", err)
+	}
+	signed, err := jwt.Sign(token, jwt.WithKey(s.signingAlg, s.provisionerKey))
+	if err != nil {
+		return "", fmt.Errorf("signing step-ca token: %w", err)
+	}
+	return string(signed), nil
+}
+
+// verifyIssuedCertificate makes sure that step-ca issued the certificate that was requested, since a
+// provisioner template that doesn't copy the template data silently drops the extensions that the
+// signers rely on.
+func (s *stepCA) verifyIssuedCertificate(cert *x509.Certificate, template *x509.Certificate) error {
+	if _, err := cert.Verify(x509.VerifyOptions{Roots: s.roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}}); err != nil {
+		return fmt.Errorf("certificate issued by step-ca does not chain to the CA bundle: %w", err)
+	}
+	issuedKey, ok := cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !issuedKey.Equal(template.PublicKey) {
+		return errors.New("certificate issued by step-ca does not match the requested public key")
+	}
+	if cert.Subject.CommonName != template.Subject.CommonName {
+		return fmt.Errorf("certificate issued by step-ca has common name %q instead of %q", cert.Subject.CommonName, template.Subject.CommonName)
+	}
+	for _, requested := range template.ExtraExtensions {
+		found := false
+		for _, issued := range cert.Extensions {
+			if issued.Id.Equal(requested.Id) && bytes.Equal(issued.Value, requested.Value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("certificate issued by step-ca lacks extension %s, the provisioner template must copy the extensions of the template data", requested.Id)
+		}
+	}
+	for _, requested := range template.ExtKeyUsage {
+		found := false
+		for _, issued := range cert.ExtKeyUsage {
+			if issued == requested {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.New("certificate issued by step-ca lacks a requested extended key usage")
+		}
+	}
+	return nil
+}
+
+func (s *stepCA) GetCABundleX509() []*x509.Certificate {
+	return s.bundle
+}
+
+func (s *stepCA) CreateRevocationList(ctx context.Context, template *x509.RevocationList) ([]byte, error) {
+	return nil, ErrStepCARevocationUnsupported
+}
+
+func (s *stepCA) SigningKey() (crypto.Signer, error) {
+	return nil, errStepCASigningKeyUnavailable
+}
+
+func (s *stepCA) CreateOCSPResponse(ctx context.Context, template ocsp.Response) ([]byte, error) {
+	return nil, ErrStepCARevocationUnsupported
+}
+
+func stepCATemplateDataFor(template *x509.Certificate) (stepCATemplateData, error) {
+	data := stepCATemplateData{
+		KeyUsage:    []string{},
+		ExtKeyUsage: []string{},
+		Extensions:  []stepCAExtension{},
+	}
+	if template.KeyUsage&x509.KeyUsageDigitalSignature != 0 {
+		data.KeyUsage = append(data.KeyUsage, "digitalSignature")
+	}
+	if template.KeyUsage&x509.KeyUsageKeyEncipherment != 0 {
+		data.KeyUsage = append(data.KeyUsage, "keyEncipherment")
+	}
+	for _, usage := range template.ExtKeyUsage {
+		switch usage {
+		case x509.ExtKeyUsageClientAuth:
+			data.ExtKeyUsage = append(data.ExtKeyUsage, "clientAuth")
+		case x509.ExtKeyUsageServerAuth:
+			data.ExtKeyUsage = append(data.ExtKeyUsage, "serverAuth")
+		default:
+			return data, fmt.Errorf("unsupported extended key usage %d", usage)
+		}
+	}
+	for _, ext := range template.ExtraExtensions {
+		data.Extensions = append(data.Extensions, stepCAExtension{
+			ID:       ext.Id.String(),
+			Critical: ext.Critical,
+			Value:    ext.Value,
+		})
+	}
+	return data, nil
+}
@@ -0,0 +1,236 @@
+package crypto
+
+import (
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/json"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/crypto/signer"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/lestrrat-go/jwx/v2/jwa"
+	"github.com/lestrrat-go/jwx/v2/jwt"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// stepCAStub implements the sign API of step-ca with a provisioner template that copies the
+// template data, unless dropExtensions is set.
+type stepCAStub struct {
+	t              *testing.T
+	ca             *oscrypto.TLSCertificateConfig
+	provisionerKey crypto.PublicKey
+	audience       string
+	dropExtensions bool
+	requests       int
+}
+
+func (s *stepCAStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests++
+	var req stepCASignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		s.fail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	token, err := jwt.Parse([]byte(req.OTT),
+		jwt.WithKey(jwa.ES256, s.provisionerKey),
+		jwt.WithIssuer("flightctl"),
+		jwt.WithAudience(s.audience))
+	if err != nil {
+		s.fail(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+	csr, err := fccrypto.ParseCSR([]byte(req.CSR))
+	if err != nil {
+		s.fail(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if token.Subject() != csr.Subject.CommonName {
+		s.fail(w, http.StatusUnauthorized, "token subject does not match the CSR")
+		return
+	}
+
+	template := &x509.Certificate{
+		Subject:      csr.Subject,
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		NotBefore:    req.NotBefore,
+		NotAfter:     req.NotAfter,
+		DNSNames:     csr.DNSNames,
+		IPAddresses:  csr.IPAddresses,
+	}
+	for _, usage := range req.TemplateData.KeyUsage {
+		switch usage {
+		case "digitalSignature":
+			template.KeyUsage |= x509.KeyUsageDigitalSignature
+		case "keyEncipherment":
+			template.KeyUsage |= x509.KeyUsageKeyEncipherment
+		}
+	}
+	for _, usage := range req.TemplateData.ExtKeyUsage {
+		switch usage {
+		case "clientAuth":
+			template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageClientAuth)
+		case "serverAuth":
+			template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageServerAuth)
+		}
+	}
+	if !s.dropExtensions {
+		for _, ext := range req.TemplateData.Extensions {
+			template.ExtraExtensions = append(template.ExtraExtensions, pkix.Extension{Id: parseOID(s.t, ext.ID), Critical: ext.Critical, Value: ext.Value})
+		}
+	}
+
+	cert, err := signCertificate(template, csr.PublicKey, s.ca.Certs[0], s.ca.Key)
+	if err != nil {
+		s.fail(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	certPEM, err := fccrypto.EncodeCertificatePEM(cert)
+	require.NoError(s.t, err)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	require.NoError(s.t, json.NewEncoder(w).Encode(stepCASignResponse{Certificate: string(certPEM)}))
+}
+
+func (s *stepCAStub) fail(w http.ResponseWriter, status int, message string) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(stepCAError{Status: status, Message: message})
+}
+
+func parseOID(t *testing.T, id string) asn1.ObjectIdentifier {
+	var oid asn1.ObjectIdentifier
+	var arc int
+	for _, c := range id + "." {
+		if c == '.' {
+			oid = append(oid, arc)
+			arc = 0
+			continue
+		}
+		require.True(t, c >= '0' && c <= '9', "invalid OID %q", id)
+		arc = arc*10 + int(c-'0')
+	}
+	return oid
+}
+
+// newStepCAStub starts a stub step-ca with a TLS certificate issued by its own CA and returns the
+// config to reach it.
+func newStepCAStub(t *testing.T) (*stepCAStub, *ca.StepCACfg) {
+	dir := t.TempDir()
+	caConfig, err := makeSelfSignedCAConfig(pkix.Name{CommonName: "step-ca-stub"}, time.Hour, 1)
+	require.NoError(t, err)
+	bundlePEM, err := oscrypto.EncodeCertificates(caConfig.Certs...)
+	require.NoError(t, err)
+	bundleFile := filepath.Join(dir, "ca.crt")
+	require.NoError(t, os.WriteFile(bundleFile, bundlePEM, 0600))
+
+	provisionerPublicKey, provisionerKey, err := fccrypto.NewKeyPair()
+	require.NoError(t, err)
+	provisionerKeyFile := filepath.Join(dir, "provisioner.key")
+	require.NoError(t, fccrypto.WriteKey(provisionerKeyFile, provisionerKey))
+
+	serverPublicKey, serverKey, err := fccrypto.NewKeyPair()
+	require.NoError(t, err)
+	serverCert, err := signCertificate(&x509.Certificate{
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		SerialNumber: big.NewInt(2),
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, serverPublicKey, caConfig.Certs[0], caConfig.Key)
+	require.NoError(t, err)
+
+	stub := &stepCAStub{t: t, ca: caConfig, provisionerKey: provisionerPublicKey}
+	server := httptest.NewUnstartedServer(stub)
+	server.TLS = &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{serverCert.Raw}, PrivateKey: serverKey}}}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	stub.audience = server.URL + stepCASignPath
+
+	return stub, &ca.StepCACfg{
+		URL:                server.URL,
+		CABundleFile:       bundleFile,
+		ProvisionerName:    "flightctl",
+		ProvisionerKeyFile: provisionerKeyFile,
+	}
+}
+
+func newTestCSR(t *testing.T, commonName string) *x509.CertificateRequest {
+	_, privateKey, err := fccrypto.NewKeyPair()
+	require.NoError(t, err)
+	csrPEM, err := fccrypto.MakeCSR(privateKey.(crypto.Signer), commonName)
+	require.NoError(t, err)
+	csr, err := fccrypto.ParseCSR(csrPEM)
+	require.NoError(t, err)
+	return csr
+}
+
+func TestStepCAIssueCertificate(t *testing.T) {
+	require := require.New(t)
+	stub, stepCfg := newStepCAStub(t)
+	cfg := ca.NewDefault(t.TempDir())
+	cfg.StepCAConfig = stepCfg
+	caClient, fresh, err := EnsureCA(cfg)
+	require.NoError(err)
+	require.False(fresh)
+
+	cert, err := caClient.IssueRequestedClientCertificate(context.Background(), newTestCSR(t, "device-1"), 3600,
+		signer.WithExtension(signer.OIDSignerName, cfg.DeviceEnrollmentSignerName))
+	require.NoError(err)
+	require.Equal(1, stub.requests)
+	require.Equal("device-1", cert.Subject.CommonName)
+	require.Equal([]x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, cert.ExtKeyUsage)
+	signerName, err := signer.GetSignerNameExtension(cert)
+	require.NoError(err)
+	require.Equal(cfg.DeviceEnrollmentSignerName, signerName)
+	require.NoError(cert.CheckSignatureFrom(caClient.GetCABundleX509()[0]))
+
+	_, err = caClient.CreateRevocationList(context.Background(), nil, time.Now(), time.Now().Add(time.Hour))
+	require.ErrorIs(err, ErrStepCARevocationUnsupported)
+}
+
+func TestStepCAIssueCertificateErrors(t *testing.T) {
+	t.Run("extensions dropped by the provisioner template", func(t *testing.T) {
+		stub, stepCfg := newStepCAStub(t)
+		stub.dropExtensions = true
+		backend, _, err := ensureStepCA(stepCfg)
+		require.NoError(t, err)
+
+		_, err = backend.IssueRequestedCertificateAsX509(context.Background(), newTestCSR(t, "device-1"), 3600,
+			[]x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, signer.WithExtension(signer.OIDSignerName, "enrollment"))
+		require.ErrorContains(t, err, "lacks extension")
+	})
+
+	t.Run("unknown provisioner", func(t *testing.T) {
+		_, stepCfg := newStepCAStub(t)
+		stepCfg.ProvisionerName = "other"
+		backend, _, err := ensureStepCA(stepCfg)
+		require.NoError(t, err)
+
+		_, err = backend.IssueRequestedCertificateAsX509(context.Background(), newTestCSR(t, "device-1"), 3600,
+			[]x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+		require.ErrorContains(t, err, "(401)")
+	})
+
+	t.Run("both external backends configured", func(t *testing.T) {
+		_, stepCfg := newStepCAStub(t)
+		cfg := ca.NewDefault(t.TempDir())
+		cfg.StepCAConfig = stepCfg
+		cfg.PKCS11Config = &ca.PKCS11Cfg{}
+		_, _, err := EnsureCA(cfg)
+		require.Error(t, err)
+	})
+}
@@ -21,6 +21,7 @@ var (
 	ErrLabelSelectorParseFailed            = errors.New("failed to parse label selector")
 	ErrAnnotationSelectorSyntax            = errors.New("invalid annotation selector syntax")
 	ErrAnnotationSelectorParseFailed       = errors.New("failed to parse annotation selector")
+	ErrInvalidContinue                     = errors.New("continue parameter does not match the request")
 
 	// devices
 	ErrTemplateVersionIsNil   = errors.New("spec.templateVersion not set")
@@ -58,7 +58,7 @@ func (cm *CertificateManager) RequestEnrollmentCertificate(ctx context.Context,
 	}
 
 	// Submit the CSR
-	resp, err := cm.client.CreateCertificateSigningRequestWithResponse(ctx, api.CreateCertificateSigningRequestJSONRequestBody(*csr))
+	resp, err := cm.client.CreateCertificateSigningRequestWithResponse(ctx, nil, api.CreateCertificateSigningRequestJSONRequestBody(*csr))
 	if err != nil {
 		return "", "", fmt.Errorf("failed to create certificate signing request: %w", err)
 	}
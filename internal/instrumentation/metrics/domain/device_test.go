@@ -12,6 +12,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 )
 
 // MockStore implements store.Store for testing
@@ -53,6 +54,26 @@ func (m *MockStore) Event() store.Event {
 	return nil
 }
 
+func (m *MockStore) EventSubscription() store.EventSubscription {
+	return nil
+}
+
+func (m *MockStore) BulkOperation() store.BulkOperation {
+	return nil
+}
+
+func (m *MockStore) EnrollmentPolicy() store.EnrollmentPolicy {
+	return nil
+}
+
+func (m *MockStore) CertificateRevocation() store.CertificateRevocation {
+	return nil
+}
+
+func (m *MockStore) ConsoleSession() store.ConsoleSession {
+	return nil
+}
+
 func (m *MockStore) Checkpoint() store.Checkpoint {
 	return nil
 }
@@ -94,6 +115,10 @@ func (m *MockDevice) GetLastSeen(ctx context.Context, orgId uuid.UUID, name stri
 	return nil, nil
 }
 
+func (m *MockDevice) Search(ctx context.Context, orgId uuid.UUID, text string, listParams store.ListParams) (*api.DeviceSearchResultList, error) {
+	return nil, nil
+}
+
 func (m *MockDevice) Healthcheck(ctx context.Context, orgId uuid.UUID, names []string) error {
 	return nil
 }
@@ -140,6 +165,9 @@ func (m *MockDevice) GetRendered(ctx context.Context, orgId uuid.UUID, name stri
 func (m *MockDevice) UpdateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) error {
 	return nil
 }
+func (m *MockDevice) UpdateIntegrity(ctx context.Context, orgId uuid.UUID, name string, integrity api.DeviceIntegrityStatus) error {
+	return nil
+}
 func (m *MockDevice) UpdateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, specHash string) (string, error) {
 	return "", nil
 }
@@ -178,6 +206,10 @@ func (m *MockDevice) RemoveConflictPausedAnnotation(ctx context.Context, orgId u
 	return 0, nil, nil
 }
 
+func (m *MockDevice) UnsetResourceSync(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, resourceSyncName string) error {
+	return nil
+}
+
 func (m *MockDevice) SetOutOfDate(ctx context.Context, orgId uuid.UUID, owner string) error {
 	return nil
 }
@@ -150,6 +150,26 @@ func (m *MockFleetStoreWrapper) Event() store.Event {
 	return nil
 }
 
+func (m *MockFleetStoreWrapper) EventSubscription() store.EventSubscription {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) BulkOperation() store.BulkOperation {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) EnrollmentPolicy() store.EnrollmentPolicy {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) CertificateRevocation() store.CertificateRevocation {
+	return nil
+}
+
+func (m *MockFleetStoreWrapper) ConsoleSession() store.ConsoleSession {
+	return nil
+}
+
 func (m *MockFleetStoreWrapper) TemplateVersion() store.TemplateVersion {
 	return nil
 }
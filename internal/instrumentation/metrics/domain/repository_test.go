@@ -12,6 +12,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 )
 
 // MockStore implements store.Store for testing
@@ -33,6 +34,11 @@ func (m *MockRepositoryStore) Fleet() store.Fleet
 func (m *MockRepositoryStore) TemplateVersion() store.TemplateVersion                     { return nil }
 func (m *MockRepositoryStore) ResourceSync() store.ResourceSync                           { return nil }
 func (m *MockRepositoryStore) Event() store.Event                                         { return nil }
+func (m *MockRepositoryStore) EventSubscription() store.EventSubscription                 { return nil }
+func (m *MockRepositoryStore) BulkOperation() store.BulkOperation                         { return nil }
+func (m *MockRepositoryStore) EnrollmentPolicy() store.EnrollmentPolicy                   { return nil }
+func (m *MockRepositoryStore) CertificateRevocation() store.CertificateRevocation         { return nil }
+func (m *MockRepositoryStore) ConsoleSession() store.ConsoleSession                       { return nil }
 func (m *MockRepositoryStore) Checkpoint() store.Checkpoint                               { return nil }
 func (m *MockRepositoryStore) Organization() store.Organization                           { return nil }
 func (m *MockRepositoryStore) RunMigrations(context.Context) error                        { return nil }
@@ -62,7 +68,7 @@ func (m *MockRepository) Create(context.Context, uuid.UUID, *api.Repository, sto
 func (m *MockRepository) Update(context.Context, uuid.UUID, *api.Repository, store.EventCallback) (*api.Repository, error) {
 	return nil, nil
 }
-func (m *MockRepository) CreateOrUpdate(context.Context, uuid.UUID, *api.Repository, store.EventCallback) (*api.Repository, bool, error) {
+func (m *MockRepository) CreateOrUpdate(context.Context, uuid.UUID, *api.Repository, bool, store.EventCallback) (*api.Repository, bool, error) {
 	return nil, false, nil
 }
 func (m *MockRepository) Get(context.Context, uuid.UUID, string) (*api.Repository, error) {
@@ -77,6 +83,9 @@ func (m *MockRepository) Delete(context.Context, uuid.UUID, string, store.EventC
 func (m *MockRepository) UpdateStatus(context.Context, uuid.UUID, *api.Repository, store.EventCallback) (*api.Repository, error) {
 	return nil, nil
 }
+func (m *MockRepository) UnsetOwner(context.Context, *gorm.DB, uuid.UUID, string) error {
+	return nil
+}
 func (m *MockRepository) GetFleetRefs(context.Context, uuid.UUID, string) (*api.FleetList, error) {
 	return nil, nil
 }
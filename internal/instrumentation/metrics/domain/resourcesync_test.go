@@ -31,13 +31,18 @@ func (m *MockResourceSyncStore) Repository() store.Repository           { return
 func (m *MockResourceSyncStore) ResourceSync() store.ResourceSync {
 	return &MockResourceSync{results: m.results}
 }
-func (m *MockResourceSyncStore) Event() store.Event                  { return nil }
-func (m *MockResourceSyncStore) Checkpoint() store.Checkpoint        { return nil }
-func (m *MockResourceSyncStore) Organization() store.Organization    { return nil }
-func (m *MockResourceSyncStore) RunMigrations(context.Context) error { return nil }
-func (m *MockResourceSyncStore) Close() error                        { return nil }
-func (m *MockResourceSyncStore) CheckHealth(context.Context) error   { return nil }
-func (m *MockResourceSyncStore) ImageBuild() store.ImageBuild        { return nil }
+func (m *MockResourceSyncStore) Event() store.Event                                 { return nil }
+func (m *MockResourceSyncStore) EventSubscription() store.EventSubscription         { return nil }
+func (m *MockResourceSyncStore) BulkOperation() store.BulkOperation                 { return nil }
+func (m *MockResourceSyncStore) EnrollmentPolicy() store.EnrollmentPolicy           { return nil }
+func (m *MockResourceSyncStore) CertificateRevocation() store.CertificateRevocation { return nil }
+func (m *MockResourceSyncStore) ConsoleSession() store.ConsoleSession               { return nil }
+func (m *MockResourceSyncStore) Checkpoint() store.Checkpoint                       { return nil }
+func (m *MockResourceSyncStore) Organization() store.Organization                   { return nil }
+func (m *MockResourceSyncStore) RunMigrations(context.Context) error                { return nil }
+func (m *MockResourceSyncStore) Close() error                                       { return nil }
+func (m *MockResourceSyncStore) CheckHealth(context.Context) error                  { return nil }
+func (m *MockResourceSyncStore) ImageBuild() store.ImageBuild                       { return nil }
 
 type MockResourceSync struct {
 	results []store.CountByResourceSyncOrgAndStatusResult
@@ -71,6 +76,9 @@ func (m *MockResourceSync) Count(ctx context.Context, orgId uuid.UUID, listParam
 func (m *MockResourceSync) CountByOrgAndStatus(ctx context.Context, orgId *uuid.UUID, status *string) ([]store.CountByResourceSyncOrgAndStatusResult, error) {
 	return m.results, nil
 }
+func (m *MockResourceSync) WithSyncLock(ctx context.Context, orgId uuid.UUID, name string, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
 
 func TestResourceSyncCollectorGroupByOrgAndStatus(t *testing.T) {
 	mockResults := []store.CountByResourceSyncOrgAndStatusResult{
@@ -19,6 +19,7 @@ import (
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/watch"
 	"github.com/flightctl/flightctl/internal/worker_client"
 	"github.com/flightctl/flightctl/pkg/poll"
 	"github.com/flightctl/flightctl/pkg/queues"
@@ -79,6 +80,9 @@ func (s *Server) Run(ctx context.Context) error {
 	if err = rendered.Bus.Initialize(ctx, kvStore, queuesProvider, time.Duration(s.cfg.Service.RenderedWaitTimeout), s.log); err != nil {
 		return err
 	}
+	if err = watch.Bus.Initialize(ctx, queuesProvider, s.log); err != nil {
+		return err
+	}
 
 	orgCache := cache.NewOrganizationTTL(cache.DefaultTTL)
 	go orgCache.Start()
@@ -138,7 +142,7 @@ func (s *Server) Run(ctx context.Context) error {
 	publisherConfig := PeriodicTaskPublisherConfig{
 		Log:            s.log,
 		OrgService:     serviceHandler,
-		TasksMetadata:  periodicTasks,
+		TasksMetadata:  periodicTasksFromConfig(s.cfg),
 		ChannelManager: channelManager,
 		TaskBackoff: &poll.Config{
 			BaseDelay:    100 * time.Millisecond,
@@ -2,6 +2,7 @@ package periodic
 
 import (
 	"context"
+	"maps"
 	"time"
 
 	"github.com/flightctl/flightctl/internal/config"
@@ -26,13 +27,15 @@ const QueueMaintenanceInterval = 2 * tasks.EventProcessingTimeout
 type PeriodicTaskType string
 
 const (
-	PeriodicTaskTypeRepositoryTester       PeriodicTaskType = "repository-tester"
-	PeriodicTaskTypeResourceSync           PeriodicTaskType = "resource-sync"
-	PeriodicTaskTypeDeviceDisconnected     PeriodicTaskType = "device-disconnected"
-	PeriodicTaskTypeRolloutDeviceSelection PeriodicTaskType = "rollout-device-selection"
-	PeriodicTaskTypeDisruptionBudget       PeriodicTaskType = "disruption-budget"
-	PeriodicTaskTypeEventCleanup           PeriodicTaskType = "event-cleanup"
-	PeriodicTaskTypeQueueMaintenance       PeriodicTaskType = "queue-maintenance"
+	PeriodicTaskTypeRepositoryTester          PeriodicTaskType = "repository-tester"
+	PeriodicTaskTypeResourceSync              PeriodicTaskType = "resource-sync"
+	PeriodicTaskTypeDeviceDisconnected        PeriodicTaskType = "device-disconnected"
+	PeriodicTaskTypeRolloutDeviceSelection    PeriodicTaskType = "rollout-device-selection"
+	PeriodicTaskTypeDisruptionBudget          PeriodicTaskType = "disruption-budget"
+	PeriodicTaskTypeEventCleanup              PeriodicTaskType = "event-cleanup"
+	PeriodicTaskTypeQueueMaintenance          PeriodicTaskType = "queue-maintenance"
+	PeriodicTaskTypeEventSubscriptionDelivery PeriodicTaskType = "event-subscription-delivery"
+	PeriodicTaskTypeRevocationCleanup         PeriodicTaskType = "certificate-revocation-cleanup"
 )
 
 type PeriodicTaskMetadata struct {
@@ -41,13 +44,24 @@ type PeriodicTaskMetadata struct {
 }
 
 var periodicTasks = map[PeriodicTaskType]PeriodicTaskMetadata{
-	PeriodicTaskTypeRepositoryTester:       {Interval: 2 * time.Minute, SystemWide: false},
-	PeriodicTaskTypeResourceSync:           {Interval: 2 * time.Minute, SystemWide: false},
-	PeriodicTaskTypeDeviceDisconnected:     {Interval: tasks.DeviceDisconnectedPollingInterval, SystemWide: false},
-	PeriodicTaskTypeRolloutDeviceSelection: {Interval: device_selection.RolloutDeviceSelectionInterval, SystemWide: false},
-	PeriodicTaskTypeDisruptionBudget:       {Interval: disruption_budget.DisruptionBudgetReconcilationInterval, SystemWide: false},
-	PeriodicTaskTypeEventCleanup:           {Interval: tasks.EventCleanupPollingInterval, SystemWide: false},
-	PeriodicTaskTypeQueueMaintenance:       {Interval: QueueMaintenanceInterval, SystemWide: true},
+	PeriodicTaskTypeRepositoryTester:          {Interval: 2 * time.Minute, SystemWide: false},
+	PeriodicTaskTypeResourceSync:              {Interval: 2 * time.Minute, SystemWide: false},
+	PeriodicTaskTypeDeviceDisconnected:        {Interval: tasks.DeviceDisconnectedPollingInterval, SystemWide: false},
+	PeriodicTaskTypeRolloutDeviceSelection:    {Interval: device_selection.RolloutDeviceSelectionInterval, SystemWide: false},
+	PeriodicTaskTypeDisruptionBudget:          {Interval: disruption_budget.DisruptionBudgetReconcilationInterval, SystemWide: false},
+	PeriodicTaskTypeEventCleanup:              {Interval: tasks.EventCleanupPollingInterval, SystemWide: false},
+	PeriodicTaskTypeQueueMaintenance:          {Interval: QueueMaintenanceInterval, SystemWide: true},
+	PeriodicTaskTypeEventSubscriptionDelivery: {Interval: tasks.EventSubscriptionDeliveryPollingInterval, SystemWide: false},
+	PeriodicTaskTypeRevocationCleanup:         {Interval: tasks.CertificateRevocationCleanupPollingInterval, SystemWide: true},
+}
+
+// periodicTasksFromConfig returns the periodic tasks with the intervals that are configurable applied
+func periodicTasksFromConfig(cfg *config.Config) map[PeriodicTaskType]PeriodicTaskMetadata {
+	tasksMetadata := maps.Clone(periodicTasks)
+	if cfg != nil && cfg.GitOps != nil && cfg.GitOps.PollingInterval > 0 {
+		tasksMetadata[PeriodicTaskTypeResourceSync] = PeriodicTaskMetadata{Interval: time.Duration(cfg.GitOps.PollingInterval), SystemWide: false}
+	}
+	return tasksMetadata
 }
 
 type PeriodicTaskReference struct {
@@ -139,6 +153,28 @@ func (e *EventCleanupExecutor) Execute(ctx context.Context, log logrus.FieldLogg
 	eventCleanup.Poll(taskCtx)
 }
 
+type EventSubscriptionDeliveryExecutor struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+}
+
+func (e *EventSubscriptionDeliveryExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgID uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeEventSubscriptionDelivery, orgID)
+	eventSubscriptionDelivery := tasks.NewEventSubscriptionDelivery(e.log, e.serviceHandler)
+	eventSubscriptionDelivery.Poll(taskCtx)
+}
+
+type RevocationCleanupExecutor struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+}
+
+func (e *RevocationCleanupExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgID uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeRevocationCleanup, orgID)
+	revocationCleanup := tasks.NewCertificateRevocationCleanup(e.log, e.serviceHandler)
+	revocationCleanup.Poll(taskCtx)
+}
+
 type QueueMaintenanceExecutor struct {
 	log            logrus.FieldLogger
 	serviceHandler service.Service
@@ -193,5 +229,13 @@ func InitializeTaskExecutors(log logrus.FieldLogger, serviceHandler service.Serv
 			queuesProvider: queuesProvider,
 			workerMetrics:  workerMetrics,
 		},
+		PeriodicTaskTypeEventSubscriptionDelivery: &EventSubscriptionDeliveryExecutor{
+			log:            log.WithField("pkg", "event-subscription-delivery"),
+			serviceHandler: serviceHandler,
+		},
+		PeriodicTaskTypeRevocationCleanup: &RevocationCleanupExecutor{
+			log:            log.WithField("pkg", "certificate-revocation-cleanup"),
+			serviceHandler: serviceHandler,
+		},
 	}
 }
@@ -94,6 +94,7 @@ func (q *querySelectorParts) withoutDisconnected() *querySelectorParts {
 func newBatchSequenceSelector(sequence api.BatchSequence, updateTimeout time.Duration, serviceHandler service.Service, orgId uuid.UUID, fleet *api.Fleet, templateVersionName string, log logrus.FieldLogger) RolloutDeviceSelector {
 	return &batchSequenceSelector{
 		BatchSequence:       sequence,
+		definition:          sequence,
 		serviceHandler:      serviceHandler,
 		orgId:               orgId,
 		fleetName:           lo.FromPtr(fleet.Metadata.Name),
@@ -106,6 +107,10 @@ func newBatchSequenceSelector(sequence api.BatchSequence, updateTimeout time.Dur
 
 type batchSequenceSelector struct {
 	api.BatchSequence
+	// definition is the user-facing device selection definition the batch sequence was derived from
+	definition any
+	// batchInterval is the minimum duration of each batch in the sequence
+	batchInterval       time.Duration
 	serviceHandler      service.Service
 	orgId               uuid.UUID
 	fleet               *api.Fleet
@@ -131,7 +136,7 @@ func (b *batchSequenceSelector) getPreviousBatchSequenceDigest() (string, bool)
 }
 
 func (b *batchSequenceSelector) batchSequenceDigest() (string, error) {
-	marshalled, err := json.Marshal(&b.BatchSequence)
+	marshalled, err := json.Marshal(b.definition)
 	if err != nil {
 		return "", err
 	}
@@ -160,7 +165,8 @@ func (b *batchSequenceSelector) OnNewRollout(ctx context.Context) error {
 		api.FleetAnnotationDeployingTemplateVersion:    b.templateVersionName,
 		api.FleetAnnotationDeviceSelectionConfigDigest: batchSequenceDigest,
 	}
-	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.fleetName, annotations, nil))
+	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.fleetName, annotations, []string{
+		api.FleetAnnotationRolledBackTemplateVersion, api.FleetAnnotationRolloutAborted}))
 }
 
 func (b *batchSequenceSelector) getCurrentBatch(ctx context.Context) (int, error) {
@@ -186,7 +192,8 @@ func (b *batchSequenceSelector) getCurrentBatch(ctx context.Context) (int, error
 func (b *batchSequenceSelector) setCurrentBatch(ctx context.Context, currentBatch int) error {
 	b.log.Infof("%v/%s: setCurrentBatch. Batch number %d", b.orgId, b.fleetName, currentBatch)
 	annotations := map[string]string{
-		api.FleetAnnotationBatchNumber: strconv.FormatInt(int64(currentBatch), 10),
+		api.FleetAnnotationBatchNumber:    strconv.FormatInt(int64(currentBatch), 10),
+		api.FleetAnnotationBatchStartTime: time.Now().UTC().Format(time.RFC3339),
 	}
 	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.fleetName, annotations, nil))
 }
@@ -300,6 +307,7 @@ func (b *batchSequenceSelector) currentSelection(ctx context.Context, currentBat
 		templateVersionName: b.templateVersionName,
 		fleet:               fleet,
 		updateTimeout:       b.updateTimeout,
+		batchInterval:       b.batchInterval,
 		log:                 b.log,
 		conditionEmitter:    newConditionEmitter(b.orgId, b.fleetName, batchName, b.serviceHandler),
 	}, nil
@@ -338,6 +346,7 @@ type batchSelection struct {
 	templateVersionName string
 	fleet               *api.Fleet
 	updateTimeout       time.Duration
+	batchInterval       time.Duration
 	conditionEmitter    *conditionEmitter
 	log                 logrus.FieldLogger
 }
@@ -458,9 +467,47 @@ func (b *batchSelection) isTimedOut(c api.DeviceCompletionCount) bool {
 	return c.SameTemplateVersion && c.UpdateTimedOut
 }
 
+// isIntervalElapsed checks if the batch has lasted for at least the batch interval
+func (b *batchSelection) isIntervalElapsed() (bool, error) {
+	if b.batch == nil || b.batchInterval <= 0 {
+		return true, nil
+	}
+	startTimeStr, exists := b.fleet.GetAnnotation(api.FleetAnnotationBatchStartTime)
+	if !exists {
+		return true, nil
+	}
+	startTime, err := time.Parse(time.RFC3339, startTimeStr)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse batch start time %q: %w", startTimeStr, err)
+	}
+	return time.Since(startTime) >= b.batchInterval, nil
+}
+
+// effectiveUpdateTimeout extends the update timeout by the time during which the fleet's devices were not allowed to
+// apply updates, so devices waiting for their maintenance window are not counted as timed out
+func (b *batchSelection) effectiveUpdateTimeout() time.Duration {
+	if b.fleet == nil {
+		return b.updateTimeout
+	}
+	policy, err := newMaintenancePolicy(b.fleet.Spec.Template.Spec.UpdatePolicy)
+	if err != nil {
+		b.log.WithError(err).Warnf("%v/%s: failed to parse update policy, using the default update timeout", b.orgId, b.fleetName)
+		return b.updateTimeout
+	}
+	if policy == nil {
+		return b.updateTimeout
+	}
+	return policy.effectiveUpdateTimeout(time.Now(), b.updateTimeout)
+}
+
 // IsComplete checks is the total number of devices in a batch is the same as the number of completed
 func (b *batchSelection) IsComplete(ctx context.Context) (bool, error) {
-	counts, status := b.serviceHandler.GetDeviceCompletionCounts(ctx, util.ResourceOwner(api.FleetKind, b.fleetName), b.templateVersionName, &b.updateTimeout)
+	intervalElapsed, err := b.isIntervalElapsed()
+	if err != nil || !intervalElapsed {
+		return false, err
+	}
+
+	counts, status := b.serviceHandler.GetDeviceCompletionCounts(ctx, util.ResourceOwner(api.FleetKind, b.fleetName), b.templateVersionName, lo.ToPtr(b.effectiveUpdateTimeout()))
 	if status.Code != http.StatusOK {
 		return false, service.ApiStatusToErr(status)
 	}
@@ -500,7 +547,7 @@ func (b *batchSelection) completionReport(counts []api.DeviceCompletionCount) ap
 }
 
 func (b *batchSelection) SetCompletionReport(ctx context.Context) error {
-	counts, status := b.serviceHandler.GetDeviceCompletionCounts(ctx, util.ResourceOwner(api.FleetKind, b.fleetName), b.templateVersionName, &b.updateTimeout)
+	counts, status := b.serviceHandler.GetDeviceCompletionCounts(ctx, util.ResourceOwner(api.FleetKind, b.fleetName), b.templateVersionName, lo.ToPtr(b.effectiveUpdateTimeout()))
 	if status.Code != http.StatusOK {
 		return service.ApiStatusToErr(status)
 	}
@@ -626,6 +673,15 @@ func (b *batchSelection) OnSuspended(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("failed to get succuess threshold: %w", err)
 		}
+		if isRollbackOnFailure(b.fleet) {
+			previousTemplateVersion, err := b.rollback(ctx)
+			if err != nil {
+				return err
+			}
+			if previousTemplateVersion != "" {
+				return b.conditionEmitter.rolledBack(ctx, successThreshold, report, b.templateVersionName, previousTemplateVersion)
+			}
+		}
 		return b.conditionEmitter.suspended(ctx, successThreshold, report)
 	} else {
 		return b.conditionEmitter.waiting(ctx)
@@ -635,3 +691,28 @@ func (b *batchSelection) OnSuspended(ctx context.Context) error {
 func (b *batchSelection) OnFinish(ctx context.Context) error {
 	return b.conditionEmitter.inactive(ctx)
 }
+
+func (b *batchSelection) OnPaused(ctx context.Context) error {
+	return b.conditionEmitter.paused(ctx)
+}
+
+// OnAbort rolls back the devices that were already updated to the previous template version.  If there is no
+// previous template version, the rollout is only stopped.
+func (b *batchSelection) OnAbort(ctx context.Context) error {
+	if condition := api.FindStatusCondition(lo.FromPtr(b.fleet.Status).Conditions, api.ConditionTypeFleetRolloutInProgress); condition != nil && condition.Reason == api.RolloutAbortedReason {
+		return nil
+	}
+	previousTemplateVersion, err := b.rollback(ctx)
+	if err != nil {
+		return err
+	}
+	if previousTemplateVersion == "" {
+		if err = b.unmark(ctx); err != nil {
+			return err
+		}
+		if err = b.stop(ctx, nil); err != nil {
+			return err
+		}
+	}
+	return b.conditionEmitter.aborted(ctx, b.templateVersionName, previousTemplateVersion)
+}
@@ -54,12 +54,24 @@ func (c *conditionEmitter) active(ctx context.Context) error {
 	))
 }
 
+func failureMessage(threshold int, completionReport api.RolloutBatchCompletionReport) string {
+	return fmt.Sprintf("%s failed: %d%% of batch devices were updated successfully, while success threshold was set to %d%%; Breakdown: total=%d successful=%d failed=%d timed out=%d",
+		completionReport.BatchName, completionReport.SuccessPercentage, threshold, completionReport.Total, completionReport.Successful, completionReport.Failed, completionReport.TimedOut)
+}
+
 func (c *conditionEmitter) suspended(ctx context.Context, threshold int, completionReport api.RolloutBatchCompletionReport) error {
 	return c.save(ctx, c.create(
 		api.ConditionStatusFalse,
 		api.RolloutSuspendedReason,
-		fmt.Sprintf("%s failed: %d%% of batch devices were updated successfully, while success threshold was set to %d%%; Breakdown: total=%d successful=%d failed=%d timed out=%d",
-			completionReport.BatchName, completionReport.SuccessPercentage, threshold, completionReport.Total, completionReport.Successful, completionReport.Failed, completionReport.TimedOut),
+		failureMessage(threshold, completionReport),
+	))
+}
+
+func (c *conditionEmitter) rolledBack(ctx context.Context, threshold int, completionReport api.RolloutBatchCompletionReport, failedTemplateVersion, previousTemplateVersion string) error {
+	return c.save(ctx, c.create(
+		api.ConditionStatusFalse,
+		api.RolloutRolledBackReason,
+		fmt.Sprintf("Rolled back template version %s to %s: %s", failedTemplateVersion, previousTemplateVersion, failureMessage(threshold, completionReport)),
 	))
 }
 
@@ -70,3 +82,23 @@ func (c *conditionEmitter) waiting(ctx context.Context) error {
 		fmt.Sprintf("Waiting for %s to be approved", c.batchName),
 	))
 }
+
+func (c *conditionEmitter) paused(ctx context.Context) error {
+	return c.save(ctx, c.create(
+		api.ConditionStatusFalse,
+		api.RolloutPausedReason,
+		fmt.Sprintf("Rollout is paused before %s", c.batchName),
+	))
+}
+
+func (c *conditionEmitter) aborted(ctx context.Context, abortedTemplateVersion, previousTemplateVersion string) error {
+	message := fmt.Sprintf("Rollout of template version %s was aborted", abortedTemplateVersion)
+	if previousTemplateVersion != "" {
+		message += fmt.Sprintf("; rolled back to %s", previousTemplateVersion)
+	}
+	return c.save(ctx, c.create(
+		api.ConditionStatusFalse,
+		api.RolloutAbortedReason,
+		message,
+	))
+}
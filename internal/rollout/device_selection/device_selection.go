@@ -35,6 +35,8 @@ type Selection interface {
 	OnRollout(ctx context.Context) error
 	OnSuspended(ctx context.Context) error
 	OnFinish(ctx context.Context) error
+	OnPaused(ctx context.Context) error
+	OnAbort(ctx context.Context) error
 }
 
 func getUpdateTimeout(defaultUpdateTimeoutStr *api.Duration) (time.Duration, error) {
@@ -65,6 +67,8 @@ func NewRolloutDeviceSelector(deviceSelection *api.RolloutDeviceSelection, defau
 	switch v := selectorInterface.(type) {
 	case api.BatchSequence:
 		return newBatchSequenceSelector(v, updateTimeout, serviceHandler, orgId, fleet, templateVersionName, log), nil
+	case api.ProgressiveCanary:
+		return newProgressiveCanarySelector(v, updateTimeout, serviceHandler, orgId, fleet, templateVersionName, log)
 	default:
 		return nil, fmt.Errorf("unexpected selector %T", selectorInterface)
 	}
@@ -79,6 +83,10 @@ func cleanupRollout(ctx context.Context, fleet *api.Fleet, serviceHandler servic
 		api.FleetAnnotationRolloutApprovalMethod,
 		api.FleetAnnotationDeployingTemplateVersion,
 		api.FleetAnnotationDeviceSelectionConfigDigest,
+		api.FleetAnnotationBatchStartTime,
+		api.FleetAnnotationRolledBackTemplateVersion,
+		api.FleetAnnotationRolloutPaused,
+		api.FleetAnnotationRolloutAborted,
 	}
 	if lo.NoneBy(annotationsToDelete, func(ann string) bool {
 		return lo.HasKey(lo.CoalesceMapOrEmpty(lo.FromPtr(fleet.Metadata.Annotations)), ann)
@@ -0,0 +1,168 @@
+package device_selection
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/robfig/cron/v3"
+	"github.com/samber/lo"
+)
+
+const (
+	// maxMaintenanceLookback bounds how far back the time during which updates may be applied is accumulated
+	maxMaintenanceLookback = 366 * 24 * time.Hour
+	// maintenanceLookbackStep is the length of the time range evaluated at once when accumulating open time
+	maintenanceLookbackStep = 24 * time.Hour
+)
+
+type timeRange struct {
+	start time.Time
+	end   time.Time
+}
+
+type maintenanceWindow struct {
+	cron     cron.Schedule
+	location *time.Location
+	// duration is the time after each cron occurrence during which an update may start
+	duration time.Duration
+}
+
+// maintenancePolicy is the server side view of a device update policy.  It is used to determine how much time
+// a device had to apply an update, since devices only apply updates inside their maintenance windows and outside
+// their blackout periods.
+type maintenancePolicy struct {
+	windows         []maintenanceWindow
+	blackoutPeriods []api.BlackoutPeriod
+}
+
+// newMaintenancePolicy returns nil if the update policy does not restrict when updates are applied
+func newMaintenancePolicy(updatePolicy *api.DeviceUpdatePolicySpec) (*maintenancePolicy, error) {
+	if updatePolicy == nil {
+		return nil, nil
+	}
+	var schedules []api.UpdateSchedule
+	if updatePolicy.UpdateSchedule != nil {
+		schedules = append(schedules, *updatePolicy.UpdateSchedule)
+	}
+	schedules = append(schedules, lo.FromPtr(updatePolicy.MaintenanceWindows)...)
+	blackoutPeriods := lo.FromPtr(updatePolicy.BlackoutPeriods)
+	if len(schedules) == 0 && len(blackoutPeriods) == 0 {
+		return nil, nil
+	}
+
+	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	ret := &maintenancePolicy{blackoutPeriods: blackoutPeriods}
+	for _, schedule := range schedules {
+		// The device evaluates schedules without a time zone in its local time, which is not known here
+		location := time.Local
+		if schedule.TimeZone != nil {
+			var err error
+			if location, err = time.LoadLocation(*schedule.TimeZone); err != nil {
+				return nil, fmt.Errorf("invalid time zone %s: %w", *schedule.TimeZone, err)
+			}
+		}
+		cronSchedule, err := parser.Parse(schedule.At)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %s: %w", schedule.At, err)
+		}
+		// Cron expressions have a resolution of one minute, so each occurrence is open for at least a minute
+		duration := time.Minute
+		if schedule.StartGraceDuration != nil {
+			grace, err := time.ParseDuration(*schedule.StartGraceDuration)
+			if err != nil {
+				return nil, fmt.Errorf("invalid start grace duration %s: %w", *schedule.StartGraceDuration, err)
+			}
+			duration = max(duration, grace)
+		}
+		ret.windows = append(ret.windows, maintenanceWindow{
+			cron:     cronSchedule,
+			location: location,
+			duration: duration,
+		})
+	}
+	return ret, nil
+}
+
+// openRanges returns the sorted, non-overlapping time ranges within [from, to) during which updates may be applied
+func (p *maintenancePolicy) openRanges(from, to time.Time) []timeRange {
+	var ranges []timeRange
+	if len(p.windows) == 0 {
+		ranges = append(ranges, timeRange{start: from, end: to})
+	}
+	for _, window := range p.windows {
+		// Start early enough to include an occurrence that is still open at the beginning of the range
+		for occurrence := window.cron.Next(from.Add(-window.duration - time.Second).In(window.location)); occurrence.Before(to); occurrence = window.cron.Next(occurrence) {
+			end := occurrence.Add(window.duration)
+			if !end.After(from) {
+				continue
+			}
+			ranges = append(ranges, timeRange{start: maxTime(occurrence, from), end: minTime(end, to)})
+		}
+	}
+	ranges = mergeTimeRanges(ranges)
+	for _, blackout := range p.blackoutPeriods {
+		ranges = subtractTimeRange(ranges, timeRange{start: blackout.Start, end: blackout.End})
+	}
+	return ranges
+}
+
+// effectiveUpdateTimeout returns the duration before now that contains updateTimeout of time during which updates
+// may be applied.  A device rendered earlier than that had the full update timeout to apply its update.
+func (p *maintenancePolicy) effectiveUpdateTimeout(now time.Time, updateTimeout time.Duration) time.Duration {
+	remaining := updateTimeout
+	for end := now; now.Sub(end) < maxMaintenanceLookback; end = end.Add(-maintenanceLookbackStep) {
+		ranges := p.openRanges(end.Add(-maintenanceLookbackStep), end)
+		for i := len(ranges) - 1; i >= 0; i-- {
+			length := ranges[i].end.Sub(ranges[i].start)
+			if length >= remaining {
+				return now.Sub(ranges[i].end.Add(-remaining))
+			}
+			remaining -= length
+		}
+	}
+	return maxMaintenanceLookback
+}
+
+func mergeTimeRanges(ranges []timeRange) []timeRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].start.Before(ranges[j].start) })
+	ret := []timeRange{ranges[0]}
+	for _, r := range ranges[1:] {
+		last := &ret[len(ret)-1]
+		if r.start.After(last.end) {
+			ret = append(ret, r)
+			continue
+		}
+		last.end = maxTime(last.end, r.end)
+	}
+	return ret
+}
+
+func subtractTimeRange(ranges []timeRange, excluded timeRange) []timeRange {
+	var ret []timeRange
+	for _, r := range ranges {
+		if !excluded.start.Before(r.end) || !excluded.end.After(r.start) {
+			ret = append(ret, r)
+			continue
+		}
+		if r.start.Before(excluded.start) {
+			ret = append(ret, timeRange{start: r.start, end: excluded.start})
+		}
+		if excluded.end.Before(r.end) {
+			ret = append(ret, timeRange{start: excluded.end, end: r.end})
+		}
+	}
+	return ret
+}
+
+func maxTime(a, b time.Time) time.Time {
+	return lo.Ternary(a.After(b), a, b)
+}
+
+func minTime(a, b time.Time) time.Time {
+	return lo.Ternary(a.Before(b), a, b)
+}
@@ -0,0 +1,100 @@
+package device_selection
+
+import (
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEffectiveUpdateTimeout(t *testing.T) {
+	now := time.Date(2026, time.December, 23, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		updatePolicy    *api.DeviceUpdatePolicySpec
+		updateTimeout   time.Duration
+		expectedTimeout time.Duration
+		expectedNil     bool
+	}{
+		{
+			name:          "no update policy",
+			updateTimeout: time.Hour,
+			expectedNil:   true,
+		},
+		{
+			name:          "update policy with download schedule only",
+			updatePolicy:  &api.DeviceUpdatePolicySpec{DownloadSchedule: &api.UpdateSchedule{At: "0 2 * * *"}},
+			updateTimeout: time.Hour,
+			expectedNil:   true,
+		},
+		{
+			name: "inside maintenance window",
+			updatePolicy: &api.DeviceUpdatePolicySpec{
+				UpdateSchedule: &api.UpdateSchedule{At: "0 10 * * *", TimeZone: lo.ToPtr("UTC"), StartGraceDuration: lo.ToPtr("4h")},
+			},
+			updateTimeout:   time.Hour,
+			expectedTimeout: time.Hour,
+		},
+		{
+			name: "timeout spans the previous day's window",
+			updatePolicy: &api.DeviceUpdatePolicySpec{
+				UpdateSchedule: &api.UpdateSchedule{At: "0 10 * * *", TimeZone: lo.ToPtr("UTC"), StartGraceDuration: lo.ToPtr("4h")},
+			},
+			updateTimeout: 3 * time.Hour,
+			// 2 hours of today's window and the last hour of yesterday's window
+			expectedTimeout: 23 * time.Hour,
+		},
+		{
+			name: "multiple maintenance windows",
+			updatePolicy: &api.DeviceUpdatePolicySpec{
+				UpdateSchedule: &api.UpdateSchedule{At: "0 2 * * *", TimeZone: lo.ToPtr("UTC"), StartGraceDuration: lo.ToPtr("1h")},
+				MaintenanceWindows: &[]api.UpdateSchedule{
+					{At: "0 8 * * *", TimeZone: lo.ToPtr("UTC"), StartGraceDuration: lo.ToPtr("1h")},
+				},
+			},
+			updateTimeout: 90 * time.Minute,
+			// 08:00-09:00 today and the last half hour of 02:00-03:00
+			expectedTimeout: 9*time.Hour + 30*time.Minute,
+		},
+		{
+			name: "blackout period without schedule",
+			updatePolicy: &api.DeviceUpdatePolicySpec{
+				BlackoutPeriods: &[]api.BlackoutPeriod{{Start: now.Add(-5 * time.Hour), End: now.Add(-time.Hour)}},
+			},
+			updateTimeout:   2 * time.Hour,
+			expectedTimeout: 6 * time.Hour,
+		},
+		{
+			name: "blackout period covering now",
+			updatePolicy: &api.DeviceUpdatePolicySpec{
+				BlackoutPeriods: &[]api.BlackoutPeriod{{Start: now.Add(-3 * time.Hour), End: now.Add(24 * time.Hour)}},
+			},
+			updateTimeout:   time.Hour,
+			expectedTimeout: 4 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			policy, err := newMaintenancePolicy(tt.updatePolicy)
+			require.NoError(err)
+			if tt.expectedNil {
+				require.Nil(policy)
+				return
+			}
+			require.NotNil(policy)
+			require.Equal(tt.expectedTimeout, policy.effectiveUpdateTimeout(now, tt.updateTimeout))
+		})
+	}
+}
+
+func TestNewMaintenancePolicyInvalid(t *testing.T) {
+	_, err := newMaintenancePolicy(&api.DeviceUpdatePolicySpec{
+		MaintenanceWindows: &[]api.UpdateSchedule{{At: "not a cron"}},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,79 @@
+package device_selection
+
+import (
+	"fmt"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const DefaultCanaryMultiplier = 2
+
+// canaryPercentages returns the cumulative percentages of the fleet's devices that are
+// updated by each canary step.  The step updating all the devices is not included since
+// it is covered by the final implicit batch.
+func canaryPercentages(initialPercentage, multiplier int) []int {
+	var ret []int
+	for percentage := initialPercentage; percentage > 0 && percentage < 100; percentage *= multiplier {
+		ret = append(ret, percentage)
+	}
+	return ret
+}
+
+// canaryBatchSequence translates a progressive canary definition into the equivalent batch
+// sequence, where each batch limits the rollout to a growing percentage of the fleet.
+func canaryBatchSequence(canary api.ProgressiveCanary) (api.BatchSequence, error) {
+	initialPercentage, err := api.PercentageAsInt(canary.InitialPercentage)
+	if err != nil {
+		return api.BatchSequence{}, fmt.Errorf("invalid canary initial percentage: %w", err)
+	}
+	multiplier := lo.FromPtrOr(canary.Multiplier, DefaultCanaryMultiplier)
+	if multiplier < 2 {
+		return api.BatchSequence{}, fmt.Errorf("canary multiplier must be at least 2, got %d", multiplier)
+	}
+
+	var batches []api.Batch
+	for _, percentage := range canaryPercentages(initialPercentage, multiplier) {
+		var limit api.Batch_Limit
+		if err := limit.FromPercentage(fmt.Sprintf("%d%%", percentage)); err != nil {
+			return api.BatchSequence{}, err
+		}
+		batches = append(batches, api.Batch{
+			Limit:            &limit,
+			SuccessThreshold: canary.SuccessThreshold,
+		})
+	}
+	return api.BatchSequence{
+		Strategy: api.RolloutStrategyBatchSequence,
+		Sequence: &batches,
+	}, nil
+}
+
+func newProgressiveCanarySelector(canary api.ProgressiveCanary, updateTimeout time.Duration, serviceHandler service.Service, orgId uuid.UUID, fleet *api.Fleet, templateVersionName string, log logrus.FieldLogger) (RolloutDeviceSelector, error) {
+	sequence, err := canaryBatchSequence(canary)
+	if err != nil {
+		return nil, err
+	}
+	var interval time.Duration
+	if canary.Interval != nil {
+		if interval, err = time.ParseDuration(*canary.Interval); err != nil {
+			return nil, fmt.Errorf("failed to parse canary interval %s: %w", *canary.Interval, err)
+		}
+	}
+	return &batchSequenceSelector{
+		BatchSequence:       sequence,
+		definition:          canary,
+		batchInterval:       interval,
+		serviceHandler:      serviceHandler,
+		orgId:               orgId,
+		fleetName:           lo.FromPtr(fleet.Metadata.Name),
+		fleet:               fleet,
+		templateVersionName: templateVersionName,
+		updateTimeout:       updateTimeout,
+		log:                 log,
+	}, nil
+}
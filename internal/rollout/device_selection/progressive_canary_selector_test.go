@@ -0,0 +1,72 @@
+package device_selection
+
+import (
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanaryBatchSequence(t *testing.T) {
+	tests := []struct {
+		name           string
+		canary         api.ProgressiveCanary
+		expectedLimits []string
+		wantErr        bool
+	}{
+		{
+			name:           "default multiplier",
+			canary:         api.ProgressiveCanary{InitialPercentage: "5%"},
+			expectedLimits: []string{"5%", "10%", "20%", "40%", "80%"},
+		},
+		{
+			name:           "custom multiplier",
+			canary:         api.ProgressiveCanary{InitialPercentage: "1%", Multiplier: lo.ToPtr(5)},
+			expectedLimits: []string{"1%", "5%", "25%"},
+		},
+		{
+			name:           "single step",
+			canary:         api.ProgressiveCanary{InitialPercentage: "50%"},
+			expectedLimits: []string{"50%"},
+		},
+		{
+			name:           "all devices at once",
+			canary:         api.ProgressiveCanary{InitialPercentage: "100%"},
+			expectedLimits: nil,
+		},
+		{
+			name:    "invalid percentage",
+			canary:  api.ProgressiveCanary{InitialPercentage: "5"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid multiplier",
+			canary:  api.ProgressiveCanary{InitialPercentage: "5%", Multiplier: lo.ToPtr(1)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			tt.canary.SuccessThreshold = lo.ToPtr("95%")
+			sequence, err := canaryBatchSequence(tt.canary)
+			if tt.wantErr {
+				require.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			var limits []string
+			for _, batch := range lo.FromPtr(sequence.Sequence) {
+				limit, err := batch.Limit.AsPercentage()
+				require.NoError(err)
+				limits = append(limits, limit)
+				require.Equal(tt.canary.SuccessThreshold, batch.SuccessThreshold)
+				require.Nil(batch.Selector)
+			}
+			require.Equal(tt.expectedLimits, limits)
+		})
+	}
+}
@@ -52,6 +52,18 @@ func (r *reconciler) emitFleetRolloutBatchDispatchedEvent(ctx context.Context, f
 	}
 }
 
+// onRolloutStopped handles a rollout that was paused or aborted by a user
+func (r *reconciler) onRolloutStopped(ctx context.Context, orgId uuid.UUID, fleetName string, selector RolloutDeviceSelector, handler func(Selection, context.Context) error) {
+	selection, err := selector.CurrentSelection(ctx)
+	if err != nil {
+		r.log.WithError(err).Errorf("%v/%s: CurrentSelection", orgId, fleetName)
+		return
+	}
+	if err = handler(selection, ctx); err != nil {
+		r.log.WithError(err).Errorf("%v/%s: failed handling stopped rollout", orgId, fleetName)
+	}
+}
+
 func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet api.Fleet) {
 	fleetName := lo.FromPtr(fleet.Metadata.Name)
 
@@ -101,6 +113,16 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 			r.log.WithError(err).Errorf("%v/%s: Reset", orgId, fleetName)
 			return
 		}
+	} else if isRolledBack(&fleet) {
+		r.log.Debugf("Rollout of fleet %v/%s was rolled back", orgId, fleetName)
+		return
+	} else if isRolloutAborted(&fleet) {
+		r.onRolloutStopped(ctx, orgId, fleetName, selector, Selection.OnAbort)
+		return
+	}
+	if isRolloutPaused(&fleet) {
+		r.onRolloutStopped(ctx, orgId, fleetName, selector, Selection.OnPaused)
+		return
 	}
 
 	for {
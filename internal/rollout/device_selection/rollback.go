@@ -0,0 +1,148 @@
+package device_selection
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+// isRolledBack returns true if the rollout of the fleet's current template version is the result
+// of an automatic rollback.  Such a rollout is not processed by the device selection until the
+// fleet gets a new template version or its device selection definition is updated.
+func isRolledBack(fleet *api.Fleet) bool {
+	_, exists := fleet.GetAnnotation(api.FleetAnnotationRolledBackTemplateVersion)
+	return exists
+}
+
+func isRolloutPaused(fleet *api.Fleet) bool {
+	_, exists := fleet.GetAnnotation(api.FleetAnnotationRolloutPaused)
+	return exists
+}
+
+func isRolloutAborted(fleet *api.Fleet) bool {
+	_, exists := fleet.GetAnnotation(api.FleetAnnotationRolloutAborted)
+	return exists
+}
+
+func isRollbackOnFailure(fleet *api.Fleet) bool {
+	return fleet.Spec.RolloutPolicy != nil && lo.FromPtr(fleet.Spec.RolloutPolicy.RollbackOnFailure)
+}
+
+func rollbackTemplateVersionName(failedTemplateVersionName string) string {
+	return failedTemplateVersionName + "-rollback"
+}
+
+// previousTemplateVersion returns the newest template version of the fleet that was created before the
+// template version being rolled out
+func (b *batchSelection) previousTemplateVersion(ctx context.Context) (*api.TemplateVersion, error) {
+	// Template versions are listed newest first
+	params := api.ListTemplateVersionsParams{}
+	found := false
+	for {
+		templateVersions, status := b.serviceHandler.ListTemplateVersions(ctx, b.fleetName, params)
+		if status.Code != http.StatusOK {
+			return nil, service.ApiStatusToErr(status)
+		}
+		for i := range templateVersions.Items {
+			if lo.FromPtr(templateVersions.Items[i].Metadata.Name) == b.templateVersionName {
+				found = true
+				continue
+			}
+			if found {
+				return &templateVersions.Items[i], nil
+			}
+		}
+		if templateVersions.Metadata.Continue == nil {
+			return nil, nil
+		}
+		params.Continue = templateVersions.Metadata.Continue
+	}
+}
+
+// createRollbackTemplateVersion creates a new template version holding the spec of the previous template version.
+// Since the rollout always renders the latest template version of the fleet, rolling back is done by creating
+// a new template version rather than by pointing the fleet at the old one.  This also keeps the failed template
+// version, which prevents it from being re-created when the fleet is validated again.
+func (b *batchSelection) createRollbackTemplateVersion(ctx context.Context, previous *api.TemplateVersion) (string, error) {
+	name := rollbackTemplateVersionName(b.templateVersionName)
+	templateVersion := api.TemplateVersion{
+		Metadata: api.ObjectMeta{
+			Name:  &name,
+			Owner: util.SetResourceOwner(api.FleetKind, b.fleetName),
+		},
+		Spec:   api.TemplateVersionSpec{Fleet: b.fleetName},
+		Status: previous.Status,
+	}
+
+	// The rollback is rolled out immediately to the devices that are marked for rollout
+	_, status := b.serviceHandler.CreateTemplateVersion(ctx, templateVersion, true)
+	switch status.Code {
+	case http.StatusCreated:
+	case http.StatusConflict:
+		b.log.Warnf("%v/%s: rollback templateVersion %s already exists", b.orgId, b.fleetName, name)
+	default:
+		return "", service.ApiStatusToErr(status)
+	}
+	return name, nil
+}
+
+// markFailedDevices marks the devices that were rendered with the failed template version, so they are the only
+// devices that are re-rendered by the rollback
+func (b *batchSelection) markFailedDevices(ctx context.Context) error {
+	if err := b.unmark(ctx); err != nil {
+		return err
+	}
+	listParams, annotationSelector := newQuerySelectorParts().
+		withOwner(b.fleetName).
+		withRolledOut(b.templateVersionName).
+		listParams()
+	return service.ApiStatusToErr(b.serviceHandler.MarkDevicesRolloutSelection(ctx, listParams, annotationSelector, nil))
+}
+
+// rollback points the fleet back to its previous template version and returns the name of the previous template
+// version.  It returns an empty name if there is no previous template version to roll back to.
+func (b *batchSelection) rollback(ctx context.Context) (string, error) {
+	previous, err := b.previousTemplateVersion(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get previous template version: %w", err)
+	}
+	if previous == nil {
+		b.log.Warnf("%v/%s: no template version to roll back to from %s", b.orgId, b.fleetName, b.templateVersionName)
+		return "", nil
+	}
+	previousName := lo.FromPtr(previous.Metadata.Name)
+	b.log.Infof("%v/%s: rolling back template version %s to %s", b.orgId, b.fleetName, b.templateVersionName, previousName)
+
+	if err = b.markFailedDevices(ctx); err != nil {
+		return "", fmt.Errorf("failed to mark devices for rollback: %w", err)
+	}
+	rollbackName, err := b.createRollbackTemplateVersion(ctx, previous)
+	if err != nil {
+		return "", fmt.Errorf("failed to create rollback template version: %w", err)
+	}
+	annotations := map[string]string{
+		api.FleetAnnotationTemplateVersion:           rollbackName,
+		api.FleetAnnotationDeployingTemplateVersion:  rollbackName,
+		api.FleetAnnotationRolledBackTemplateVersion: b.templateVersionName,
+	}
+	if err = b.stop(ctx, annotations); err != nil {
+		return "", err
+	}
+	return previousName, nil
+}
+
+// stop clears the batch progress of the rollout, so no further batches are processed
+func (b *batchSelection) stop(ctx context.Context, annotations map[string]string) error {
+	annotationsToDelete := []string{
+		api.FleetAnnotationBatchNumber,
+		api.FleetAnnotationRolloutApproved,
+		api.FleetAnnotationLastBatchCompletionReport,
+		api.FleetAnnotationBatchStartTime,
+	}
+	return service.ApiStatusToErr(b.serviceHandler.UpdateFleetAnnotations(ctx, b.fleetName, annotations, annotationsToDelete))
+}
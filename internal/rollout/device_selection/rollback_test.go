@@ -0,0 +1,180 @@
+package device_selection
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func newTestTemplateVersion(name string) api.TemplateVersion {
+	return api.TemplateVersion{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec:     api.TemplateVersionSpec{Fleet: "fleet"},
+		Status: &api.TemplateVersionStatus{
+			Os: &api.DeviceOsSpec{Image: name},
+		},
+	}
+}
+
+func newTestBatchSelection(serviceHandler service.Service) *batchSelection {
+	orgId := uuid.New()
+	return &batchSelection{
+		batchNum:            1,
+		batchName:           "batch 2",
+		serviceHandler:      serviceHandler,
+		orgId:               orgId,
+		fleetName:           "fleet",
+		templateVersionName: "fleet-3",
+		fleet: &api.Fleet{
+			Metadata: api.ObjectMeta{Name: lo.ToPtr("fleet")},
+			Spec: api.FleetSpec{
+				RolloutPolicy: &api.RolloutPolicy{RollbackOnFailure: lo.ToPtr(true)},
+			},
+		},
+		log:              logrus.New(),
+		conditionEmitter: newConditionEmitter(orgId, "fleet", "batch 2", serviceHandler),
+	}
+}
+
+func TestRollback(t *testing.T) {
+	t.Run("rolls back to the previous template version", func(t *testing.T) {
+		require := require.New(t)
+		ctrl := gomock.NewController(t)
+		mockService := service.NewMockService(ctrl)
+		b := newTestBatchSelection(mockService)
+
+		mockService.EXPECT().ListTemplateVersions(gomock.Any(), "fleet", gomock.Any()).Return(&api.TemplateVersionList{
+			Items: []api.TemplateVersion{newTestTemplateVersion("fleet-3"), newTestTemplateVersion("fleet-2"), newTestTemplateVersion("fleet-1")},
+		}, api.StatusOK())
+		mockService.EXPECT().UnmarkDevicesRolloutSelection(gomock.Any(), "fleet").Return(api.StatusOK())
+		mockService.EXPECT().MarkDevicesRolloutSelection(gomock.Any(), gomock.Any(), gomock.Any(), nil).DoAndReturn(
+			func(_ context.Context, params api.ListDevicesParams, _ any, _ *int) api.Status {
+				require.Equal("metadata.owner=Fleet/fleet", lo.FromPtr(params.FieldSelector))
+				return api.StatusOK()
+			})
+		mockService.EXPECT().CreateTemplateVersion(gomock.Any(), gomock.Any(), true).DoAndReturn(
+			func(_ context.Context, tv api.TemplateVersion, _ bool) (*api.TemplateVersion, api.Status) {
+				require.Equal("fleet-3-rollback", lo.FromPtr(tv.Metadata.Name))
+				require.Equal("fleet-2", tv.Status.Os.Image)
+				return &tv, api.StatusCreated()
+			})
+		mockService.EXPECT().UpdateFleetAnnotations(gomock.Any(), "fleet", gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, annotations map[string]string, deleteKeys []string) api.Status {
+				require.Equal("fleet-3-rollback", annotations[api.FleetAnnotationTemplateVersion])
+				require.Equal("fleet-3-rollback", annotations[api.FleetAnnotationDeployingTemplateVersion])
+				require.Equal("fleet-3", annotations[api.FleetAnnotationRolledBackTemplateVersion])
+				require.Contains(deleteKeys, api.FleetAnnotationBatchNumber)
+				return api.StatusOK()
+			})
+
+		previous, err := b.rollback(context.Background())
+		require.NoError(err)
+		require.Equal("fleet-2", previous)
+	})
+
+	t.Run("no previous template version", func(t *testing.T) {
+		require := require.New(t)
+		ctrl := gomock.NewController(t)
+		mockService := service.NewMockService(ctrl)
+		b := newTestBatchSelection(mockService)
+
+		mockService.EXPECT().ListTemplateVersions(gomock.Any(), "fleet", gomock.Any()).Return(&api.TemplateVersionList{
+			Items: []api.TemplateVersion{newTestTemplateVersion("fleet-3")},
+		}, api.StatusOK())
+
+		previous, err := b.rollback(context.Background())
+		require.NoError(err)
+		require.Empty(previous)
+	})
+
+	t.Run("previous template version on next page", func(t *testing.T) {
+		require := require.New(t)
+		ctrl := gomock.NewController(t)
+		mockService := service.NewMockService(ctrl)
+		b := newTestBatchSelection(mockService)
+
+		gomock.InOrder(
+			mockService.EXPECT().ListTemplateVersions(gomock.Any(), "fleet", gomock.Any()).Return(&api.TemplateVersionList{
+				Metadata: api.ListMeta{Continue: lo.ToPtr("next")},
+				Items:    []api.TemplateVersion{newTestTemplateVersion("fleet-3-rollback"), newTestTemplateVersion("fleet-3")},
+			}, api.StatusOK()),
+			mockService.EXPECT().ListTemplateVersions(gomock.Any(), "fleet", api.ListTemplateVersionsParams{Continue: lo.ToPtr("next")}).Return(&api.TemplateVersionList{
+				Items: []api.TemplateVersion{newTestTemplateVersion("fleet-2")},
+			}, api.StatusOK()),
+		)
+
+		previous, err := b.previousTemplateVersion(context.Background())
+		require.NoError(err)
+		require.Equal("fleet-2", lo.FromPtr(previous.Metadata.Name))
+	})
+}
+
+func TestOnSuspendedRollsBack(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+	b := newTestBatchSelection(mockService)
+	b.fleet.Metadata.Annotations = &map[string]string{
+		api.FleetAnnotationRolloutApprovalMethod:     "automatic",
+		api.FleetAnnotationLastBatchCompletionReport: `{"batchName":"batch 1","successPercentage":50,"total":2,"successful":1,"failed":1,"timedOut":0}`,
+	}
+
+	mockService.EXPECT().ListTemplateVersions(gomock.Any(), "fleet", gomock.Any()).Return(&api.TemplateVersionList{
+		Items: []api.TemplateVersion{newTestTemplateVersion("fleet-3"), newTestTemplateVersion("fleet-2")},
+	}, api.StatusOK())
+	mockService.EXPECT().UnmarkDevicesRolloutSelection(gomock.Any(), "fleet").Return(api.StatusOK())
+	mockService.EXPECT().MarkDevicesRolloutSelection(gomock.Any(), gomock.Any(), gomock.Any(), nil).Return(api.StatusOK())
+	mockService.EXPECT().CreateTemplateVersion(gomock.Any(), gomock.Any(), true).Return(nil, api.StatusCreated())
+	mockService.EXPECT().UpdateFleetAnnotations(gomock.Any(), "fleet", gomock.Any(), gomock.Any()).Return(api.StatusOK())
+	mockService.EXPECT().UpdateFleetConditions(gomock.Any(), "fleet", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, conditions []api.Condition) api.Status {
+			require.Len(conditions, 1)
+			require.Equal(api.RolloutRolledBackReason, conditions[0].Reason)
+			require.Contains(conditions[0].Message, "Rolled back template version fleet-3 to fleet-2: batch 1 failed")
+			return api.StatusOK()
+		})
+
+	require.NoError(b.OnSuspended(context.Background()))
+}
+
+func TestOnAbort(t *testing.T) {
+	t.Run("without previous template version", func(t *testing.T) {
+		require := require.New(t)
+		ctrl := gomock.NewController(t)
+		mockService := service.NewMockService(ctrl)
+		b := newTestBatchSelection(mockService)
+
+		mockService.EXPECT().ListTemplateVersions(gomock.Any(), "fleet", gomock.Any()).Return(&api.TemplateVersionList{
+			Items: []api.TemplateVersion{newTestTemplateVersion("fleet-3")},
+		}, api.StatusOK())
+		mockService.EXPECT().UnmarkDevicesRolloutSelection(gomock.Any(), "fleet").Return(api.StatusOK())
+		mockService.EXPECT().UpdateFleetAnnotations(gomock.Any(), "fleet", nil, gomock.Any()).Return(api.StatusOK())
+		mockService.EXPECT().UpdateFleetConditions(gomock.Any(), "fleet", gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, conditions []api.Condition) api.Status {
+				require.Equal(api.RolloutAbortedReason, conditions[0].Reason)
+				require.Equal("Rollout of template version fleet-3 was aborted", conditions[0].Message)
+				return api.StatusOK()
+			})
+
+		require.NoError(b.OnAbort(context.Background()))
+	})
+
+	t.Run("already aborted", func(t *testing.T) {
+		require := require.New(t)
+		ctrl := gomock.NewController(t)
+		mockService := service.NewMockService(ctrl)
+		b := newTestBatchSelection(mockService)
+		b.fleet.Status = &api.FleetStatus{
+			Conditions: []api.Condition{{Type: api.ConditionTypeFleetRolloutInProgress, Reason: api.RolloutAbortedReason}},
+		}
+
+		require.NoError(b.OnAbort(context.Background()))
+	})
+}
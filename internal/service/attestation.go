@@ -0,0 +1,253 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	// attestationCheckpointConsumer is the checkpoint consumer under which outstanding attestation
+	// challenges are stored, so that any replica can verify the answer to a challenge
+	attestationCheckpointConsumer = "attestation"
+	attestationNonceLength        = 32
+	attestationChallengeValidity  = 5 * time.Minute
+)
+
+// attestationChallenge is the stored form of an outstanding attestation challenge
+type attestationChallenge struct {
+	Nonce     []byte    `json:"nonce,omitempty"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func attestationChallengeKey(orgId uuid.UUID, name string) string {
+	return orgId.String() + "/" + name
+}
+
+// (POST /api/v1/devices/{name}/attestationchallenge)
+func (h *ServiceHandler) CreateDeviceAttestationChallenge(ctx context.Context, name string) (*api.DeviceAttestationChallenge, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	if _, err := h.store.Device().GetWithoutServiceConditions(ctx, orgId, name); err != nil {
+		return nil, StoreErrorToApiStatus(err, false, api.DeviceKind, &name)
+	}
+
+	challenge := attestationChallenge{
+		Nonce:     make([]byte, attestationNonceLength),
+		ExpiresAt: time.Now().Add(attestationChallengeValidity),
+	}
+	if _, err := rand.Read(challenge.Nonce); err != nil {
+		return nil, api.StatusInternalServerError(fmt.Sprintf("generating nonce: %v", err))
+	}
+	value, err := json.Marshal(challenge)
+	if err != nil {
+		return nil, api.StatusInternalServerError(err.Error())
+	}
+	// a new challenge replaces any outstanding one of the device
+	if err := h.store.Checkpoint().Set(ctx, attestationCheckpointConsumer, attestationChallengeKey(orgId, name), value); err != nil {
+		return nil, api.StatusInternalServerError(err.Error())
+	}
+
+	return &api.DeviceAttestationChallenge{
+		Nonce:     challenge.Nonce,
+		ExpiresAt: challenge.ExpiresAt,
+	}, api.StatusCreated()
+}
+
+// (POST /api/v1/devices/{name}/attestation)
+func (h *ServiceHandler) CreateDeviceAttestation(ctx context.Context, name string, attestation api.DeviceAttestation) (*api.DeviceIntegrityStatus, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	device, err := h.store.Device().GetWithoutServiceConditions(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, api.DeviceKind, &name)
+	}
+	if err := h.consumeAttestationChallenge(ctx, orgId, name, attestation.Nonce); err != nil {
+		return nil, api.StatusBadRequest(err.Error())
+	}
+	pcrValues, err := decodePCRValues(attestation.PcrValues)
+	if err != nil {
+		return nil, api.StatusBadRequest(err.Error())
+	}
+
+	now := time.Now()
+	integrity := device.Status.Integrity
+	integrity.LastVerified = &now
+	integrity.Tpm = h.verifyDeviceAttestation(ctx, orgId, device, &attestation, pcrValues)
+	switch {
+	case integrity.Tpm.Status == api.DeviceIntegrityCheckStatusFailed:
+		integrity.Status = api.DeviceIntegrityStatusFailed
+		integrity.Info = lo.ToPtr("Boot integrity verification failed")
+	case integrity.DeviceIdentity != nil && integrity.DeviceIdentity.Status == api.DeviceIntegrityCheckStatusFailed:
+		integrity.Status = api.DeviceIntegrityStatusFailed
+		integrity.Info = lo.ToPtr("Integrity verification failed")
+	case integrity.Tpm.Status == api.DeviceIntegrityCheckStatusUnknown:
+		integrity.Status = api.DeviceIntegrityStatusUnknown
+		integrity.Info = lo.ToPtr("Boot integrity cannot be verified without reference values")
+	default:
+		integrity.Status = api.DeviceIntegrityStatusVerified
+		integrity.Info = lo.ToPtr("All integrity checks completed successfully")
+	}
+
+	// only the integrity status is written, the rest of the status may have been updated by the agent meanwhile
+	if err := h.store.Device().UpdateIntegrity(ctx, orgId, name, integrity); err != nil {
+		return nil, StoreErrorToApiStatus(err, false, api.DeviceKind, &name)
+	}
+	notifyWatchers(ctx, api.DeviceKind, orgId, name, api.WatchEventModified, nil)
+	return &integrity, api.StatusOK()
+}
+
+// consumeAttestationChallenge checks that nonce is the outstanding challenge of the device and
+// invalidates it, so that every quote is bound to a fresh challenge.
+func (h *ServiceHandler) consumeAttestationChallenge(ctx context.Context, orgId uuid.UUID, name string, nonce []byte) error {
+	key := attestationChallengeKey(orgId, name)
+	value, err := h.store.Checkpoint().Get(ctx, attestationCheckpointConsumer, key)
+	if err != nil {
+		if errors.Is(err, flterrors.ErrResourceNotFound) {
+			return fmt.Errorf("no outstanding attestation challenge")
+		}
+		return err
+	}
+	var challenge attestationChallenge
+	if err := json.Unmarshal(value, &challenge); err != nil {
+		return fmt.Errorf("decoding attestation challenge: %w", err)
+	}
+	if len(challenge.Nonce) == 0 {
+		return fmt.Errorf("no outstanding attestation challenge")
+	}
+	if !bytes.Equal(challenge.Nonce, nonce) {
+		return fmt.Errorf("nonce does not match the outstanding attestation challenge")
+	}
+	if time.Now().After(challenge.ExpiresAt) {
+		return fmt.Errorf("attestation challenge expired")
+	}
+
+	// the checkpoint store cannot delete keys, so the challenge is cleared instead
+	value, err = json.Marshal(attestationChallenge{})
+	if err != nil {
+		return err
+	}
+	return h.store.Checkpoint().Set(ctx, attestationCheckpointConsumer, key, value)
+}
+
+// verifyDeviceAttestation checks the quote with the attestation key of the device, replays the event
+// log against the quoted PCR values and compares them with the reference values of the device's fleet.
+func (h *ServiceHandler) verifyDeviceAttestation(ctx context.Context, orgId uuid.UUID, device *api.Device, attestation *api.DeviceAttestation, pcrValues map[int][]byte) *api.DeviceIntegrityCheckStatus {
+	failed := func(messageTemplate string, args ...any) *api.DeviceIntegrityCheckStatus {
+		return &api.DeviceIntegrityCheckStatus{
+			Status: api.DeviceIntegrityCheckStatusFailed,
+			Info:   lo.ToPtr(fmt.Sprintf(messageTemplate, args...)),
+		}
+	}
+
+	// the attestation key was certified by the EK when the device enrolled
+	name := lo.FromPtr(device.Metadata.Name)
+	er, err := h.store.EnrollmentRequest().Get(ctx, orgId, name)
+	if err != nil {
+		return failed("Unable to find the enrollment request of the device")
+	}
+	if er.Status == nil || !api.IsStatusConditionTrue(er.Status.Conditions, api.ConditionTypeEnrollmentRequestTPMVerified) {
+		return failed("The device did not enroll with a verified TPM identity")
+	}
+	erBytes, isTPM := tpm.ParseTCGCSRBytes(er.Spec.Csr)
+	if !isTPM {
+		return failed("The device did not enroll with a verified TPM identity")
+	}
+	attestPub, err := tpm.AttestationKeyFromTCGCSR(erBytes)
+	if err != nil {
+		return failed("Invalid attestation key: %v", err)
+	}
+
+	if err := tpm.VerifyQuote(attestPub, attestation.Quote, attestation.Signature, attestation.Nonce, pcrValues); err != nil {
+		return failed("PCR quote verification failed: %v", err)
+	}
+
+	if attestation.EventLog != nil {
+		replayed, err := tpm.ReplayEventLog(*attestation.EventLog)
+		if err != nil {
+			return failed("Invalid event log: %v", err)
+		}
+		for pcr, value := range replayed {
+			if quoted, ok := pcrValues[pcr]; ok && !bytes.Equal(quoted, value) {
+				return failed("Event log does not match the quoted value of PCR %d", pcr)
+			}
+		}
+	}
+
+	policy, err := h.deviceIntegrityPolicy(ctx, orgId, device)
+	if err != nil {
+		return failed("Unable to get the integrity policy of the device: %v", err)
+	}
+	if policy == nil {
+		// the quote is genuine, but there is nothing to compare the measured boot state with
+		return &api.DeviceIntegrityCheckStatus{
+			Status: api.DeviceIntegrityCheckStatusUnknown,
+			Info:   lo.ToPtr("PCR quote verified, no reference values configured"),
+		}
+	}
+	var mismatched []string
+	for _, reference := range policy.ReferenceValues {
+		value, ok := pcrValues[reference.Pcr]
+		if !ok || !slices.ContainsFunc(reference.Digests, func(digest string) bool {
+			return strings.EqualFold(digest, hex.EncodeToString(value))
+		}) {
+			mismatched = append(mismatched, strconv.Itoa(reference.Pcr))
+		}
+	}
+	if len(mismatched) > 0 {
+		return failed("PCRs %s do not match the reference values", strings.Join(mismatched, ", "))
+	}
+	return &api.DeviceIntegrityCheckStatus{
+		Status: api.DeviceIntegrityCheckStatusVerified,
+		Info:   lo.ToPtr("PCR quote matches the reference values"),
+	}
+}
+
+// deviceIntegrityPolicy returns the integrity policy of the fleet that owns the device, if any
+func (h *ServiceHandler) deviceIntegrityPolicy(ctx context.Context, orgId uuid.UUID, device *api.Device) (*api.IntegrityPolicy, error) {
+	if device.Metadata.Owner == nil {
+		return nil, nil
+	}
+	kind, owner, err := util.GetResourceOwner(device.Metadata.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if kind != api.FleetKind {
+		return nil, nil
+	}
+	fleet, err := h.store.Fleet().Get(ctx, orgId, owner)
+	if err != nil {
+		return nil, err
+	}
+	return fleet.Spec.IntegrityPolicy, nil
+}
+
+func decodePCRValues(values []api.PCRValue) (map[int][]byte, error) {
+	decoded := make(map[int][]byte, len(values))
+	for _, value := range values {
+		if _, exists := decoded[value.Pcr]; exists {
+			return nil, fmt.Errorf("duplicate value for PCR %d", value.Pcr)
+		}
+		digest, err := hex.DecodeString(value.Digest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for PCR %d: %w", value.Pcr, err)
+		}
+		decoded[value.Pcr] = digest
+	}
+	return decoded, nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func newAttestationTestHandler(t *testing.T) (*ServiceHandler, *TestStore) {
+	ts := &TestStore{}
+	_, err := ts.Device().Create(context.Background(), store.NullOrgId, &api.Device{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr("mydevice")},
+		Status:   lo.ToPtr(api.NewDeviceStatus()),
+	}, nil)
+	require.NoError(t, err)
+	return &ServiceHandler{
+		eventHandler: NewEventHandler(ts, &DummyWorkerClient{}, log.InitLogs()),
+		store:        ts,
+		log:          log.InitLogs(),
+	}, ts
+}
+
+func TestCreateDeviceAttestationChallenge(t *testing.T) {
+	require := require.New(t)
+	handler, _ := newAttestationTestHandler(t)
+	ctx := context.Background()
+
+	challenge, status := handler.CreateDeviceAttestationChallenge(ctx, "mydevice")
+	require.Equal(statusCreatedCode, status.Code)
+	require.Len(challenge.Nonce, attestationNonceLength)
+
+	next, status := handler.CreateDeviceAttestationChallenge(ctx, "mydevice")
+	require.Equal(statusCreatedCode, status.Code)
+	require.NotEqual(challenge.Nonce, next.Nonce)
+
+	_, status = handler.CreateDeviceAttestationChallenge(ctx, "otherdevice")
+	require.Equal(statusNotFoundCode, status.Code)
+}
+
+func TestCreateDeviceAttestation(t *testing.T) {
+	require := require.New(t)
+	handler, ts := newAttestationTestHandler(t)
+	ctx := context.Background()
+
+	attestation := api.DeviceAttestation{
+		Quote:     []byte("quote"),
+		Signature: []byte("signature"),
+		PcrValues: []api.PCRValue{{Pcr: 0, Digest: "00"}},
+	}
+
+	// a quote must answer an outstanding challenge
+	attestation.Nonce = []byte("nonce")
+	_, status := handler.CreateDeviceAttestation(ctx, "mydevice", attestation)
+	require.Equal(statusBadRequestCode, status.Code)
+
+	challenge, status := handler.CreateDeviceAttestationChallenge(ctx, "mydevice")
+	require.Equal(statusCreatedCode, status.Code)
+	_, status = handler.CreateDeviceAttestation(ctx, "mydevice", attestation)
+	require.Equal(statusBadRequestCode, status.Code)
+
+	// the device did not enroll with a verified TPM identity, so its quote cannot be trusted
+	_, err := ts.EnrollmentRequest().Create(ctx, store.NullOrgId, &api.EnrollmentRequest{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr("mydevice")},
+		Spec:     api.EnrollmentRequestSpec{Csr: "csr"},
+	}, nil)
+	require.NoError(err)
+	attestation.Nonce = challenge.Nonce
+	integrity, status := handler.CreateDeviceAttestation(ctx, "mydevice", attestation)
+	require.Equal(statusSuccessCode, status.Code)
+	require.Equal(api.DeviceIntegrityStatusFailed, integrity.Status)
+	require.Equal(api.DeviceIntegrityCheckStatusFailed, integrity.Tpm.Status)
+	require.NotNil(integrity.LastVerified)
+
+	device, err := ts.Device().Get(ctx, store.NullOrgId, "mydevice")
+	require.NoError(err)
+	require.Equal(api.DeviceIntegrityStatusFailed, device.Status.Integrity.Status)
+
+	// a challenge can only be answered once
+	_, status = handler.CreateDeviceAttestation(ctx, "mydevice", attestation)
+	require.Equal(statusBadRequestCode, status.Code)
+
+	// PCR values must be hex encoded
+	challenge, _ = handler.CreateDeviceAttestationChallenge(ctx, "mydevice")
+	attestation.Nonce = challenge.Nonce
+	attestation.PcrValues = []api.PCRValue{{Pcr: 0, Digest: "not hex"}}
+	_, status = handler.CreateDeviceAttestation(ctx, "mydevice", attestation)
+	require.Equal(statusBadRequestCode, status.Code)
+}
+
+func TestDeviceIntegrityPolicy(t *testing.T) {
+	require := require.New(t)
+	handler, ts := newAttestationTestHandler(t)
+	ctx := context.Background()
+
+	policy := &api.IntegrityPolicy{
+		ReferenceValues: []api.PCRReferenceValue{{Pcr: 7, Digests: []string{"00"}}},
+	}
+	_, err := ts.Fleet().Create(ctx, store.NullOrgId, &api.Fleet{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr("myfleet")},
+		Spec:     api.FleetSpec{IntegrityPolicy: policy},
+	}, nil)
+	require.NoError(err)
+
+	device := &api.Device{Metadata: api.ObjectMeta{Name: lo.ToPtr("mydevice")}}
+	result, err := handler.deviceIntegrityPolicy(ctx, store.NullOrgId, device)
+	require.NoError(err)
+	require.Nil(result)
+
+	device.Metadata.Owner = lo.ToPtr("Fleet/myfleet")
+	result, err = handler.deviceIntegrityPolicy(ctx, store.NullOrgId, device)
+	require.NoError(err)
+	require.Equal(policy, result)
+}
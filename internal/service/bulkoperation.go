@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// bulkOperationCancelAttempts is the number of times a cancellation is retried when it races with the worker
+const bulkOperationCancelAttempts = 3
+
+func (h *ServiceHandler) CreateBulkOperation(ctx context.Context, operation api.BulkOperation) (*api.BulkOperation, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	// don't set fields that are managed by the service
+	operation.Status = nil
+	NilOutManagedObjectMetaProperties(&operation.Metadata)
+
+	if errs := operation.Validate(); len(errs) > 0 {
+		return nil, api.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if operation.Spec.Operation.Type == api.BulkOperationTypeMoveToFleet {
+		if _, status := h.bulkOperationFleetLabels(ctx, orgId, *operation.Spec.Operation.Fleet); status != api.StatusOK() {
+			return nil, status
+		}
+	}
+
+	listParams, status := prepareListParams(nil, operation.Spec.Selector.LabelSelector, operation.Spec.Selector.FieldSelector, nil)
+	if status != api.StatusOK() {
+		return nil, status
+	}
+	matched, err := h.store.Device().Count(ctx, orgId, *listParams)
+	if err != nil {
+		var se *selector.SelectorError
+		if selector.AsSelectorError(err, &se) {
+			return nil, api.StatusBadRequest(fmt.Sprintf("spec.selector: %s", se.Error()))
+		}
+		return nil, api.StatusInternalServerError(err.Error())
+	}
+	operation.Status = &api.BulkOperationStatus{
+		Phase:          api.BulkOperationPhasePending,
+		MatchedDevices: matched,
+	}
+
+	result, err := h.store.BulkOperation().Create(ctx, orgId, &operation, h.callbackBulkOperationCreated)
+	return result, StoreErrorToApiStatus(err, true, api.BulkOperationKind, operation.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListBulkOperations(ctx context.Context, params api.ListBulkOperationsParams) (*api.BulkOperationList, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != api.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.BulkOperation().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, api.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, api.StatusBadRequest(se.Error())
+	default:
+		return nil, api.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetBulkOperation(ctx context.Context, name string) (*api.BulkOperation, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	result, err := h.store.BulkOperation().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, api.BulkOperationKind, &name)
+}
+
+// DeleteBulkOperation deletes a bulk operation.  A running operation stops before its next batch of devices.
+func (h *ServiceHandler) DeleteBulkOperation(ctx context.Context, name string) api.Status {
+	orgId := getOrgIdFromContext(ctx)
+
+	err := h.store.BulkOperation().Delete(ctx, orgId, name, h.callbackBulkOperationDeleted)
+	return StoreErrorToApiStatus(err, false, api.BulkOperationKind, &name)
+}
+
+// CancelBulkOperation stops a pending or running bulk operation before its next batch of devices.  The devices that
+// were already processed are left as they are.
+func (h *ServiceHandler) CancelBulkOperation(ctx context.Context, name string) (*api.BulkOperation, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	for attempt := 1; ; attempt++ {
+		operation, err := h.store.BulkOperation().Get(ctx, orgId, name)
+		if err != nil {
+			return nil, StoreErrorToApiStatus(err, false, api.BulkOperationKind, &name)
+		}
+		if operation.Status.IsFinished() {
+			return nil, api.StatusConflict(fmt.Sprintf("bulk operation is already %s", operation.Status.Phase))
+		}
+
+		operation.Status.Phase = api.BulkOperationPhaseCanceled
+		operation.Status.CompletedAt = lo.ToPtr(time.Now().UTC())
+		operation.Status.Message = lo.ToPtr(fmt.Sprintf("Canceled after processing %d of %d devices.", operation.Status.ProcessedDevices, operation.Status.MatchedDevices))
+		operation.Status.Continue = nil
+
+		// the update fails if the worker recorded progress since the operation was read
+		result, err := h.store.BulkOperation().UpdateStatus(ctx, orgId, operation)
+		if errors.Is(err, flterrors.ErrNoRowsUpdated) && attempt < bulkOperationCancelAttempts {
+			continue
+		}
+		if err != nil {
+			return nil, StoreErrorToApiStatus(err, false, api.BulkOperationKind, &name)
+		}
+		h.CreateEvent(ctx, common.GetBulkOperationCanceledEvent(ctx, name, *result.Status))
+		return result, api.StatusOK()
+	}
+}
+
+// ReplaceBulkOperationStatus records the progress of a bulk operation.  It is only used internally by the worker, and
+// fails with a conflict if the operation was changed since it was read.
+func (h *ServiceHandler) ReplaceBulkOperationStatus(ctx context.Context, name string, operation api.BulkOperation) (*api.BulkOperation, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	if name != lo.FromPtr(operation.Metadata.Name) {
+		return nil, api.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, err := h.store.BulkOperation().UpdateStatus(ctx, orgId, &operation)
+	return result, StoreErrorToApiStatus(err, false, api.BulkOperationKind, &name)
+}
+
+// bulkOperationFleetLabels returns the labels that make the fleet with the given name select a device
+func (h *ServiceHandler) bulkOperationFleetLabels(ctx context.Context, orgId uuid.UUID, fleetName string) (map[string]string, api.Status) {
+	fleet, err := h.store.Fleet().Get(ctx, orgId, fleetName)
+	if errors.Is(err, flterrors.ErrResourceNotFound) {
+		return nil, api.StatusBadRequest(fmt.Sprintf("spec.operation.fleet: fleet %q not found", fleetName))
+	}
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, api.FleetKind, &fleetName)
+	}
+	matchLabels := fleetMatchLabels(fleet)
+	if len(matchLabels) == 0 {
+		return nil, api.StatusBadRequest(fmt.Sprintf("spec.operation.fleet: fleet %q has no selector labels", fleetName))
+	}
+	return matchLabels, api.StatusOK()
+}
+
+// callbackBulkOperationCreated is the bulk operation-specific callback that handles bulk operation creation events
+func (h *ServiceHandler) callbackBulkOperationCreated(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleBulkOperationCreatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackBulkOperationDeleted is the bulk operation-specific callback that handles bulk operation deletion events
+func (h *ServiceHandler) callbackBulkOperationDeleted(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
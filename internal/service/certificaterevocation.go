@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"golang.org/x/crypto/ocsp"
+)
+
+// revocationInfoValidity is how long relying parties may cache a CRL or OCSP
+// response before fetching a fresh one.
+const revocationInfoValidity = time.Hour
+
+// (GET /pki/v1/crl)
+func (h *ServiceHandler) GetCertificateRevocationList(ctx context.Context) ([]byte, api.Status) {
+	revocations, err := h.store.CertificateRevocation().ListUnexpired(ctx)
+	if err != nil {
+		return nil, api.StatusInternalServerError(err.Error())
+	}
+
+	entries := make([]x509.RevocationListEntry, 0, len(revocations))
+	for _, revocation := range revocations {
+		serialNumber, ok := crypto.ParseSerialNumber(revocation.SerialNumber)
+		if !ok {
+			h.log.Warnf("skipping revocation with malformed serial number %q", revocation.SerialNumber)
+			continue
+		}
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   serialNumber,
+			RevocationTime: revocation.RevokedAt,
+			ReasonCode:     revocation.Reason,
+		})
+	}
+
+	now := time.Now()
+	crl, err := h.ca.CreateRevocationList(ctx, entries, now, now.Add(revocationInfoValidity))
+	if errors.Is(err, crypto.ErrCRLSignNotPermitted) || errors.Is(err, crypto.ErrStepCARevocationUnsupported) {
+		return nil, api.StatusNotImplemented(err.Error())
+	}
+	if err != nil {
+		return nil, api.StatusInternalServerError(err.Error())
+	}
+	return crl, api.StatusOK()
+}
+
+// (POST /pki/v1/ocsp)
+func (h *ServiceHandler) GetOCSPResponse(ctx context.Context, request []byte) ([]byte, api.Status) {
+	req, err := ocsp.ParseRequest(request)
+	if err != nil {
+		// malformed requests are answered with an OCSP error response rather
+		// than an HTTP error, as clients expect
+		return ocsp.MalformedRequestErrorResponse, api.StatusOK()
+	}
+
+	now := time.Now()
+	template := ocsp.Response{
+		SerialNumber: req.SerialNumber,
+		Status:       ocsp.Unknown,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(revocationInfoValidity),
+	}
+	if h.ca.IsOCSPRequestIssuer(req) {
+		revocation, err := h.store.CertificateRevocation().Get(ctx, crypto.SerialNumberString(req.SerialNumber))
+		switch {
+		case err == nil:
+			template.Status = ocsp.Revoked
+			template.RevokedAt = revocation.RevokedAt
+			template.RevocationReason = revocation.Reason
+		case errors.Is(err, flterrors.ErrResourceNotFound):
+			template.Status = ocsp.Good
+		default:
+			return nil, api.StatusInternalServerError(err.Error())
+		}
+	}
+
+	resp, err := h.ca.CreateOCSPResponse(ctx, template)
+	if errors.Is(err, crypto.ErrStepCARevocationUnsupported) {
+		return nil, api.StatusNotImplemented(err.Error())
+	}
+	if err != nil {
+		return nil, api.StatusInternalServerError(err.Error())
+	}
+	return resp, api.StatusOK()
+}
+
+func (h *ServiceHandler) DeleteExpiredCertificateRevocations(ctx context.Context) (int64, api.Status) {
+	numDeleted, err := h.store.CertificateRevocation().DeleteExpired(ctx)
+	if err != nil {
+		return 0, api.StatusInternalServerError(err.Error())
+	}
+	return numDeleted, api.StatusOK()
+}
+
+// revokeDeviceCertificates revokes the certificates issued to a device: the
+// management certificate from its enrollment request and any certificates
+// issued through certificate signing requests the device owns. Failures are
+// logged, as the device operation that triggered the revocation has already
+// been persisted.
+func (h *ServiceHandler) revokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, name string, reason int) {
+	var certificates []*x509.Certificate
+
+	er, err := h.store.EnrollmentRequest().Get(ctx, orgId, name)
+	switch {
+	case err == nil:
+		if er.Status != nil && er.Status.Certificate != nil {
+			cert, err := fccrypto.ParsePEMCertificate([]byte(*er.Status.Certificate))
+			if err != nil {
+				h.log.Errorf("parsing certificate of enrollment request %s/%s: %v", orgId, name, err)
+			} else {
+				certificates = append(certificates, cert)
+			}
+		}
+	case !errors.Is(err, flterrors.ErrResourceNotFound):
+		h.log.Errorf("getting enrollment request %s/%s to revoke its certificate: %v", orgId, name, err)
+	}
+
+	ownerSelector, err := selector.NewFieldSelectorFromMap(map[string]string{"metadata.owner": lo.FromPtr(util.SetResourceOwner(api.DeviceKind, name))})
+	if err != nil {
+		h.log.Errorf("building owner selector for device %s/%s: %v", orgId, name, err)
+		return
+	}
+	csrs, err := h.store.CertificateSigningRequest().List(ctx, orgId, store.ListParams{FieldSelector: ownerSelector})
+	if err != nil {
+		h.log.Errorf("listing certificate signing requests of device %s/%s to revoke their certificates: %v", orgId, name, err)
+	} else {
+		for _, csr := range csrs.Items {
+			if csr.Status == nil || csr.Status.Certificate == nil {
+				continue
+			}
+			cert, err := fccrypto.ParsePEMCertificate(*csr.Status.Certificate)
+			if err != nil {
+				h.log.Errorf("parsing certificate of certificate signing request %s/%s: %v", orgId, lo.FromPtr(csr.Metadata.Name), err)
+				continue
+			}
+			certificates = append(certificates, cert)
+		}
+	}
+
+	now := time.Now()
+	for _, cert := range certificates {
+		if err := h.revokeCertificate(ctx, orgId, cert, reason, now); err != nil {
+			h.log.Errorf("revoking certificate %q of device %s/%s: %v", cert.Subject.CommonName, orgId, name, err)
+			continue
+		}
+		h.log.Infof("revoked certificate %q of device %s/%s", cert.Subject.CommonName, orgId, name)
+	}
+}
+
+func (h *ServiceHandler) revokeCertificate(ctx context.Context, orgId uuid.UUID, cert *x509.Certificate, reason int, revokedAt time.Time) error {
+	if !cert.NotAfter.After(revokedAt) {
+		// expired certificates are rejected anyway
+		return nil
+	}
+	return h.store.CertificateRevocation().Revoke(ctx, &model.CertificateRevocation{
+		SerialNumber: crypto.CertificateSerialNumber(cert),
+		OrgID:        orgId,
+		CommonName:   cert.Subject.CommonName,
+		Reason:       reason,
+		RevokedAt:    revokedAt,
+		NotAfter:     cert.NotAfter,
+	})
+}
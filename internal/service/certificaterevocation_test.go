@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/config/ca"
+	fcrypto "github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+)
+
+func newRevocationTestHandler(t *testing.T) (*ServiceHandler, *TestStore) {
+	caClient, _, err := fcrypto.EnsureCA(ca.NewDefault(t.TempDir()))
+	require.NoError(t, err)
+	ts := &TestStore{}
+	return &ServiceHandler{
+		eventHandler: NewEventHandler(ts, &DummyWorkerClient{}, log.InitLogs()),
+		store:        ts,
+		ca:           caClient,
+		log:          log.InitLogs(),
+	}, ts
+}
+
+func issueTestCertificate(t *testing.T, caClient *fcrypto.CAClient, commonName string) *x509.Certificate {
+	_, privateKey, err := fccrypto.NewKeyPair()
+	require.NoError(t, err)
+	csrPEM, err := fccrypto.MakeCSR(privateKey.(crypto.Signer), commonName)
+	require.NoError(t, err)
+	csr, err := fccrypto.ParseCSR(csrPEM)
+	require.NoError(t, err)
+	cert, err := caClient.IssueRequestedClientCertificate(context.Background(), csr, 3600)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestRevokeDeviceCertificates(t *testing.T) {
+	require := require.New(t)
+	handler, ts := newRevocationTestHandler(t)
+	ctx := context.Background()
+
+	managementCert := issueTestCertificate(t, handler.ca, "device-management")
+	managementPEM, err := fccrypto.EncodeCertificatePEM(managementCert)
+	require.NoError(err)
+	_, err = ts.EnrollmentRequest().Create(ctx, store.NullOrgId, &api.EnrollmentRequest{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr("mydevice")},
+		Status:   &api.EnrollmentRequestStatus{Certificate: lo.ToPtr(string(managementPEM))},
+	}, nil)
+	require.NoError(err)
+
+	svcClientCert := issueTestCertificate(t, handler.ca, "svc-client-mydevice")
+	svcClientPEM, err := fccrypto.EncodeCertificatePEM(svcClientCert)
+	require.NoError(err)
+	_, err = ts.CertificateSigningRequest().Create(ctx, store.NullOrgId, &api.CertificateSigningRequest{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr("svc-client"), Owner: util.SetResourceOwner(api.DeviceKind, "mydevice")},
+		Status:   &api.CertificateSigningRequestStatus{Certificate: &svcClientPEM},
+	}, nil)
+	require.NoError(err)
+	_, err = ts.CertificateSigningRequest().Create(ctx, store.NullOrgId, &api.CertificateSigningRequest{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr("pending"), Owner: util.SetResourceOwner(api.DeviceKind, "mydevice")},
+	}, nil)
+	require.NoError(err)
+
+	handler.revokeDeviceCertificates(ctx, store.NullOrgId, "mydevice", ocsp.CessationOfOperation)
+
+	revocations, err := ts.CertificateRevocation().ListUnexpired(ctx)
+	require.NoError(err)
+	require.Len(revocations, 2)
+	for _, cert := range []*x509.Certificate{managementCert, svcClientCert} {
+		revocation, err := ts.CertificateRevocation().Get(ctx, fcrypto.CertificateSerialNumber(cert))
+		require.NoError(err)
+		require.Equal(cert.Subject.CommonName, revocation.CommonName)
+		require.Equal(ocsp.CessationOfOperation, revocation.Reason)
+		require.True(cert.NotAfter.Equal(revocation.NotAfter))
+	}
+
+	// revoking again keeps the original revocations
+	handler.revokeDeviceCertificates(ctx, store.NullOrgId, "mydevice", ocsp.KeyCompromise)
+	revocations, err = ts.CertificateRevocation().ListUnexpired(ctx)
+	require.NoError(err)
+	require.Len(revocations, 2)
+	require.Equal(ocsp.CessationOfOperation, revocations[0].Reason)
+}
+
+func TestDecommissionDeviceRevokesCertificates(t *testing.T) {
+	require := require.New(t)
+	handler, ts := newRevocationTestHandler(t)
+	ctx := context.Background()
+
+	_, err := ts.Device().Create(ctx, store.NullOrgId, &api.Device{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr("mydevice")},
+		Spec:     &api.DeviceSpec{},
+		Status:   lo.ToPtr(api.NewDeviceStatus()),
+	}, nil)
+	require.NoError(err)
+	managementCert := issueTestCertificate(t, handler.ca, "device-management")
+	managementPEM, err := fccrypto.EncodeCertificatePEM(managementCert)
+	require.NoError(err)
+	_, err = ts.EnrollmentRequest().Create(ctx, store.NullOrgId, &api.EnrollmentRequest{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr("mydevice")},
+		Status:   &api.EnrollmentRequestStatus{Certificate: lo.ToPtr(string(managementPEM))},
+	}, nil)
+	require.NoError(err)
+
+	_, status := handler.DecommissionDevice(ctx, "mydevice", api.DeviceDecommission{Target: api.DeviceDecommissionTargetTypeUnenroll})
+	require.Equal(statusSuccessCode, status.Code)
+
+	revocation, err := ts.CertificateRevocation().Get(ctx, fcrypto.CertificateSerialNumber(managementCert))
+	require.NoError(err)
+	require.Equal(ocsp.CessationOfOperation, revocation.Reason)
+}
+
+func TestGetCertificateRevocationList(t *testing.T) {
+	require := require.New(t)
+	handler, _ := newRevocationTestHandler(t)
+	ctx := context.Background()
+
+	revoked := issueTestCertificate(t, handler.ca, "revoked")
+	require.NoError(handler.revokeCertificate(ctx, store.NullOrgId, revoked, ocsp.CessationOfOperation, revoked.NotBefore))
+
+	der, status := handler.GetCertificateRevocationList(ctx)
+	require.Equal(statusSuccessCode, status.Code)
+
+	crl, err := x509.ParseRevocationList(der)
+	require.NoError(err)
+	require.NoError(crl.CheckSignatureFrom(handler.ca.GetCABundleX509()[0]))
+	require.True(crl.NextUpdate.After(crl.ThisUpdate))
+	require.Len(crl.RevokedCertificateEntries, 1)
+	require.Equal(0, revoked.SerialNumber.Cmp(crl.RevokedCertificateEntries[0].SerialNumber))
+	require.Equal(ocsp.CessationOfOperation, crl.RevokedCertificateEntries[0].ReasonCode)
+}
+
+func TestGetOCSPResponse(t *testing.T) {
+	handler, _ := newRevocationTestHandler(t)
+	ctx := context.Background()
+	issuer := handler.ca.GetCABundleX509()[0]
+
+	revoked := issueTestCertificate(t, handler.ca, "revoked")
+	require.NoError(t, handler.revokeCertificate(ctx, store.NullOrgId, revoked, ocsp.CessationOfOperation, revoked.NotBefore))
+	good := issueTestCertificate(t, handler.ca, "good")
+
+	otherCA, _, err := fcrypto.EnsureCA(ca.NewDefault(t.TempDir()))
+	require.NoError(t, err)
+	foreign := issueTestCertificate(t, otherCA, "foreign")
+
+	testCases := []struct {
+		name     string
+		cert     *x509.Certificate
+		issuer   *x509.Certificate
+		expected int
+	}{
+		{name: "revoked certificate", cert: revoked, issuer: issuer, expected: ocsp.Revoked},
+		{name: "valid certificate", cert: good, issuer: issuer, expected: ocsp.Good},
+		{name: "certificate of another CA", cert: foreign, issuer: otherCA.GetCABundleX509()[0], expected: ocsp.Unknown},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			request, err := ocsp.CreateRequest(tc.cert, tc.issuer, nil)
+			require.NoError(err)
+
+			der, status := handler.GetOCSPResponse(ctx, request)
+			require.Equal(statusSuccessCode, status.Code)
+
+			resp, err := ocsp.ParseResponse(der, issuer)
+			require.NoError(err)
+			require.Equal(tc.expected, resp.Status)
+			require.Equal(0, tc.cert.SerialNumber.Cmp(resp.SerialNumber))
+			if tc.expected == ocsp.Revoked {
+				require.Equal(ocsp.CessationOfOperation, resp.RevocationReason)
+			}
+		})
+	}
+
+	t.Run("malformed request", func(t *testing.T) {
+		der, status := handler.GetOCSPResponse(ctx, []byte("not an OCSP request"))
+		require.Equal(t, statusSuccessCode, status.Code)
+		require.Equal(t, ocsp.MalformedRequestErrorResponse, der)
+	})
+}
+
+func TestDeleteExpiredCertificateRevocations(t *testing.T) {
+	require := require.New(t)
+	handler, ts := newRevocationTestHandler(t)
+	ctx := context.Background()
+
+	valid := issueTestCertificate(t, handler.ca, "valid")
+	require.NoError(handler.revokeCertificate(ctx, store.NullOrgId, valid, ocsp.CessationOfOperation, valid.NotBefore))
+	require.NoError(ts.CertificateRevocation().Revoke(ctx, &model.CertificateRevocation{
+		SerialNumber: "1f",
+		OrgID:        store.NullOrgId,
+		RevokedAt:    time.Now().Add(-2 * time.Hour),
+		NotAfter:     time.Now().Add(-time.Hour),
+	}))
+
+	numDeleted, status := handler.DeleteExpiredCertificateRevocations(ctx)
+	require.Equal(statusSuccessCode, status.Code)
+	require.Equal(int64(1), numDeleted)
+
+	revocations, err := ts.CertificateRevocation().ListUnexpired(ctx)
+	require.NoError(err)
+	require.Len(revocations, 1)
+	require.Equal(fcrypto.CertificateSerialNumber(valid), revocations[0].SerialNumber)
+}
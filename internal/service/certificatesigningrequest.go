@@ -192,6 +192,11 @@ func (h *ServiceHandler) CreateCertificateSigningRequest(ctx context.Context, cs
 		return nil, StoreErrorToApiStatus(err, true, api.CertificateSigningRequestKind, csr.Metadata.Name)
 	}
 
+	// a dry run neither approves nor signs the request
+	if IsDryRun(ctx) {
+		return result, api.StatusCreated()
+	}
+
 	if result.Spec.SignerName == h.ca.Cfg.ClientBootstrapSignerName {
 		h.autoApprove(ctx, orgId, result)
 	}
@@ -270,6 +275,10 @@ func (h *ServiceHandler) PatchCertificateSigningRequest(ctx context.Context, nam
 		return nil, StoreErrorToApiStatus(err, false, api.CertificateSigningRequestKind, &name)
 	}
 
+	if IsDryRun(ctx) {
+		return result, api.StatusOK()
+	}
+
 	if result.Spec.SignerName == h.ca.Cfg.ClientBootstrapSignerName {
 		h.autoApprove(ctx, orgId, result)
 	}
@@ -326,6 +335,10 @@ func (h *ServiceHandler) ReplaceCertificateSigningRequest(ctx context.Context, n
 		return nil, StoreErrorToApiStatus(err, created, api.CertificateSigningRequestKind, &name)
 	}
 
+	if IsDryRun(ctx) {
+		return result, StoreErrorToApiStatus(nil, created, api.CertificateSigningRequestKind, &name)
+	}
+
 	if result.Spec.SignerName == h.ca.Cfg.ClientBootstrapSignerName {
 		h.autoApprove(ctx, orgId, result)
 	}
@@ -32,6 +32,19 @@ func IsInternalRequest(ctx context.Context) bool {
 	return false
 }
 
+// WithDryRun returns a context for a request whose changes are checked but rolled back when dryRun is All
+func WithDryRun(ctx context.Context, dryRun *api.DryRun) context.Context {
+	if dryRun == nil || *dryRun != api.DryRunAll {
+		return ctx
+	}
+	return store.WithDryRun(ctx)
+}
+
+// IsDryRun returns true if the request must be checked without persisting its changes
+func IsDryRun(ctx context.Context) bool {
+	return store.IsDryRun(ctx)
+}
+
 func NilOutManagedObjectMetaProperties(om *api.ObjectMeta) {
 	if om == nil {
 		return
@@ -31,6 +31,15 @@ const (
 	DiskIsCritical                    = "Disk utilization has reached a critical level."
 	DiskIsWarning                     = "Disk utilization has reached a warning level."
 	DiskIsNormal                      = "Disk utilization has returned to normal."
+	TemperatureIsCritical             = "Temperature has reached a critical level."
+	TemperatureIsWarning              = "Temperature has reached a warning level."
+	TemperatureIsNormal               = "Temperature has returned to normal."
+	NetworkIsCritical                 = "Network utilization has reached a critical level."
+	NetworkIsWarning                  = "Network utilization has reached a warning level."
+	NetworkIsNormal                   = "Network utilization has returned to normal."
+	ProcessIsCritical                 = "Process memory utilization has reached a critical level."
+	ProcessIsWarning                  = "Process memory utilization has reached a warning level."
+	ProcessIsNormal                   = "Process memory utilization has returned to normal."
 )
 
 type DeviceSuccessEvent func(ctx context.Context, created bool, resourceKind api.ResourceKind, resourceName string, updateDetails *api.ResourceUpdatedDetailsUpdatedFields, log logrus.FieldLogger) *api.Event
@@ -64,6 +73,24 @@ var (
 		api.DeviceResourceStatusWarning:  ResourceUpdate{Reason: api.EventReasonDeviceDiskWarning, Details: DiskIsWarning},
 		api.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: api.EventReasonDeviceDiskNormal, Details: DiskIsNormal},
 	}
+
+	temperatureStatus = statusType{
+		api.DeviceResourceStatusCritical: ResourceUpdate{Reason: api.EventReasonDeviceTemperatureCritical, Details: TemperatureIsCritical},
+		api.DeviceResourceStatusWarning:  ResourceUpdate{Reason: api.EventReasonDeviceTemperatureWarning, Details: TemperatureIsWarning},
+		api.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: api.EventReasonDeviceTemperatureNormal, Details: TemperatureIsNormal},
+	}
+
+	networkStatus = statusType{
+		api.DeviceResourceStatusCritical: ResourceUpdate{Reason: api.EventReasonDeviceNetworkCritical, Details: NetworkIsCritical},
+		api.DeviceResourceStatusWarning:  ResourceUpdate{Reason: api.EventReasonDeviceNetworkWarning, Details: NetworkIsWarning},
+		api.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: api.EventReasonDeviceNetworkNormal, Details: NetworkIsNormal},
+	}
+
+	processStatus = statusType{
+		api.DeviceResourceStatusCritical: ResourceUpdate{Reason: api.EventReasonDeviceProcessCritical, Details: ProcessIsCritical},
+		api.DeviceResourceStatusWarning:  ResourceUpdate{Reason: api.EventReasonDeviceProcessWarning, Details: ProcessIsWarning},
+		api.DeviceResourceStatusHealthy:  ResourceUpdate{Reason: api.EventReasonDeviceProcessNormal, Details: ProcessIsNormal},
+	}
 )
 
 func UpdateServiceSideStatus(ctx context.Context, orgId uuid.UUID, device *api.Device, st store.Store, log logrus.FieldLogger) bool {
@@ -109,6 +136,16 @@ func resourcesDisk(disk api.DeviceResourceStatusType, resourceErrors *[]string,
 	}
 }
 
+// resourcesOptional adds the status of a resource that is only reported by agents supporting its monitor
+func resourcesOptional(resource *api.DeviceResourceStatusType, statusMap statusType, resourceErrors *[]string, resourceDegradations *[]string) {
+	switch lo.FromPtr(resource) {
+	case api.DeviceResourceStatusCritical:
+		*resourceErrors = append(*resourceErrors, statusMap[api.DeviceResourceStatusCritical].Details)
+	case api.DeviceResourceStatusWarning:
+		*resourceDegradations = append(*resourceDegradations, statusMap[api.DeviceResourceStatusWarning].Details)
+	}
+}
+
 func updateServerSideDeviceStatus(device *api.Device) bool {
 	lastDeviceStatus := device.Status.Summary.Status
 
@@ -146,6 +183,9 @@ func updateServerSideDeviceStatus(device *api.Device) bool {
 	resourcesCpu(device.Status.Resources.Cpu, &resourceErrors, &resourceDegradations)
 	resourcesMemory(device.Status.Resources.Memory, &resourceErrors, &resourceDegradations)
 	resourcesDisk(device.Status.Resources.Disk, &resourceErrors, &resourceDegradations)
+	resourcesOptional(device.Status.Resources.Temperature, temperatureStatus, &resourceErrors, &resourceDegradations)
+	resourcesOptional(device.Status.Resources.Network, networkStatus, &resourceErrors, &resourceDegradations)
+	resourcesOptional(device.Status.Resources.Process, processStatus, &resourceErrors, &resourceDegradations)
 
 	switch {
 	case len(resourceErrors) > 0:
@@ -414,6 +454,15 @@ func ComputeDeviceStatusChanges(ctx context.Context, oldDevice, newDevice *api.D
 		{cpuStatus, func(d *api.Device) api.DeviceResourceStatusType { return d.Status.Resources.Cpu }},
 		{memoryStatus, func(d *api.Device) api.DeviceResourceStatusType { return d.Status.Resources.Memory }},
 		{diskStatus, func(d *api.Device) api.DeviceResourceStatusType { return d.Status.Resources.Disk }},
+		{temperatureStatus, func(d *api.Device) api.DeviceResourceStatusType {
+			return lo.FromPtrOr(d.Status.Resources.Temperature, api.DeviceResourceStatusUnknown)
+		}},
+		{networkStatus, func(d *api.Device) api.DeviceResourceStatusType {
+			return lo.FromPtrOr(d.Status.Resources.Network, api.DeviceResourceStatusUnknown)
+		}},
+		{processStatus, func(d *api.Device) api.DeviceResourceStatusType {
+			return lo.FromPtrOr(d.Status.Resources.Process, api.DeviceResourceStatusUnknown)
+		}},
 	}
 	for _, check := range resourceChecks {
 		checkResourceStatus(oldDevice, newDevice, check.statusMap, check.getter, &resourceUpdates)
@@ -254,3 +254,50 @@ func TestUpdateServerSideDeviceStatus_PostRestoreState(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateServerSideDeviceStatus_OptionalResources(t *testing.T) {
+	device := &api.Device{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr("test-device")},
+		Status: &api.DeviceStatus{
+			LastSeen: lo.ToPtr(time.Now()),
+			Resources: api.DeviceResourceStatus{
+				Cpu:         api.DeviceResourceStatusHealthy,
+				Memory:      api.DeviceResourceStatusHealthy,
+				Disk:        api.DeviceResourceStatusHealthy,
+				Temperature: lo.ToPtr(api.DeviceResourceStatusCritical),
+				Network:     lo.ToPtr(api.DeviceResourceStatusWarning),
+			},
+		},
+	}
+
+	assert.True(t, updateServerSideDeviceStatus(device))
+	assert.Equal(t, api.DeviceSummaryStatusError, device.Status.Summary.Status)
+	assert.Equal(t, TemperatureIsCritical, lo.FromPtr(device.Status.Summary.Info))
+
+	device.Status.Resources.Temperature = lo.ToPtr(api.DeviceResourceStatusHealthy)
+	assert.True(t, updateServerSideDeviceStatus(device))
+	assert.Equal(t, api.DeviceSummaryStatusDegraded, device.Status.Summary.Status)
+	assert.Equal(t, NetworkIsWarning, lo.FromPtr(device.Status.Summary.Info))
+}
+
+func TestComputeDeviceStatusChanges_OptionalResources(t *testing.T) {
+	newDevice := func(temperature, process *api.DeviceResourceStatusType) *api.Device {
+		return &api.Device{
+			Metadata: api.ObjectMeta{Name: lo.ToPtr("test-device")},
+			Status: &api.DeviceStatus{
+				Resources: api.DeviceResourceStatus{
+					Cpu:         api.DeviceResourceStatusHealthy,
+					Memory:      api.DeviceResourceStatusHealthy,
+					Disk:        api.DeviceResourceStatusHealthy,
+					Temperature: temperature,
+					Process:     process,
+				},
+			},
+		}
+	}
+	oldDevice := newDevice(nil, nil)
+	updatedDevice := newDevice(lo.ToPtr(api.DeviceResourceStatusWarning), lo.ToPtr(api.DeviceResourceStatusHealthy))
+
+	updates := ComputeDeviceStatusChanges(context.Background(), oldDevice, updatedDevice, uuid.New(), nil)
+	assert.Equal(t, ResourceUpdates{{Reason: api.EventReasonDeviceTemperatureWarning, Details: TemperatureIsWarning}}, updates)
+}
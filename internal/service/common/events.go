@@ -190,6 +190,35 @@ func GetEnrollmentRequestApprovalFailedEvent(ctx context.Context, resourceName s
 	})
 }
 
+// GetEnrollmentPolicyDecisionEvent creates an event for an enrollment request that was approved or rejected by a rule of an enrollment policy
+func GetEnrollmentPolicyDecisionEvent(ctx context.Context, resourceName string, policyName string, rule api.EnrollmentPolicyRule) *api.Event {
+	details := api.EnrollmentPolicyDecisionDetails{
+		DetailType: api.EnrollmentPolicyDecision,
+		PolicyName: policyName,
+		RuleName:   rule.Name,
+		Action:     rule.Action,
+	}
+	eventDetails := api.EventDetails{}
+	if err := eventDetails.FromEnrollmentPolicyDecisionDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+
+	reason := api.EventReasonEnrollmentRequestAutoApproved
+	action := "approved"
+	if rule.Action == api.EnrollmentPolicyActionReject {
+		reason = api.EventReasonEnrollmentRequestRejected
+		action = "rejected"
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: api.EnrollmentRequestKind,
+		resourceName: resourceName,
+		reason:       reason,
+		message:      fmt.Sprintf("EnrollmentRequest was %s by rule %s of EnrollmentPolicy %s.", action, rule.Name, policyName),
+		details:      &eventDetails,
+	})
+}
+
 // GetDeviceDecommissionedSuccessEvent creates an event for successful device decommission
 func GetDeviceDecommissionedSuccessEvent(ctx context.Context, _ bool, _ api.ResourceKind, resourceName string, update *api.ResourceUpdatedDetails, log logrus.FieldLogger) *api.Event {
 	return getBaseEvent(ctx, resourceEvent{
@@ -401,6 +430,111 @@ func GetResourceSyncSyncFailedEvent(ctx context.Context, resourceName string, er
 	})
 }
 
+func GetResourceSyncDriftDetectedEvent(ctx context.Context, resourceName string, message string) *api.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: api.ResourceSyncKind,
+		resourceName: resourceName,
+		reason:       api.EventReasonResourceSyncDriftDetected,
+		message:      fmt.Sprintf("Drift detected: %s.", message),
+		details:      nil,
+	})
+}
+
+func GetResourceSyncDriftResolvedEvent(ctx context.Context, resourceName string) *api.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: api.ResourceSyncKind,
+		resourceName: resourceName,
+		reason:       api.EventReasonResourceSyncDriftResolved,
+		message:      "Resources match the repository.",
+		details:      nil,
+	})
+}
+
+// GetResourceSyncSyncRequestedEvent creates an event requesting an immediate sync of a ResourceSync
+func GetResourceSyncSyncRequestedEvent(ctx context.Context, resourceName string, repositoryName string) *api.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: api.ResourceSyncKind,
+		resourceName: resourceName,
+		reason:       api.EventReasonResourceSyncSyncRequested,
+		message:      fmt.Sprintf("Sync requested by a push to repository %s.", repositoryName),
+		details:      nil,
+	})
+}
+
+// GetBulkOperationProgressedEvent creates an event for a bulk operation that has more devices to process
+func GetBulkOperationProgressedEvent(ctx context.Context, name string, status api.BulkOperationStatus) *api.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: api.BulkOperationKind,
+		resourceName: name,
+		reason:       api.EventReasonBulkOperationProgressed,
+		message:      fmt.Sprintf("Processed %d of %d devices.", status.ProcessedDevices, status.MatchedDevices),
+		details:      nil,
+	})
+}
+
+// GetBulkOperationFinishedEvent creates an event for a bulk operation that completed or failed
+func GetBulkOperationFinishedEvent(ctx context.Context, name string, status api.BulkOperationStatus) *api.Event {
+	reason := api.EventReasonBulkOperationCompleted
+	if status.Phase == api.BulkOperationPhaseFailed {
+		reason = api.EventReasonBulkOperationFailed
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: api.BulkOperationKind,
+		resourceName: name,
+		reason:       reason,
+		message:      lo.FromPtr(status.Message),
+		details:      nil,
+	})
+}
+
+// GetBulkOperationCanceledEvent creates an event for a bulk operation that was canceled
+func GetBulkOperationCanceledEvent(ctx context.Context, name string, status api.BulkOperationStatus) *api.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: api.BulkOperationKind,
+		resourceName: name,
+		reason:       api.EventReasonBulkOperationCanceled,
+		message:      fmt.Sprintf("Canceled after processing %d of %d devices.", status.ProcessedDevices, status.MatchedDevices),
+		details:      nil,
+	})
+}
+
+// GetDeviceConsoleSessionStartedEvent creates an event for a console session opened to a device
+func GetDeviceConsoleSessionStartedEvent(ctx context.Context, session api.ConsoleSession) *api.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: api.DeviceKind,
+		resourceName: session.Spec.DeviceName,
+		reason:       api.EventReasonDeviceConsoleSessionStarted,
+		message:      fmt.Sprintf("Console session %s was opened by %s.", lo.FromPtr(session.Metadata.Name), session.Spec.User),
+		details:      nil,
+	})
+}
+
+// GetDeviceConsoleSessionEndedEvent creates an event for a console session to a device that was closed
+func GetDeviceConsoleSessionEndedEvent(ctx context.Context, session api.ConsoleSession) *api.Event {
+	message := fmt.Sprintf("Console session %s opened by %s was closed", lo.FromPtr(session.Metadata.Name), session.Spec.User)
+	if session.Status != nil && session.Status.ExitCode != nil {
+		message += fmt.Sprintf(" with exit code %d", *session.Status.ExitCode)
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: api.DeviceKind,
+		resourceName: session.Spec.DeviceName,
+		reason:       api.EventReasonDeviceConsoleSessionEnded,
+		message:      message + ".",
+		details:      nil,
+	})
+}
+
+// GetDeviceConsoleSessionRecordingFailedEvent creates an event for a console session whose recording could not be stored
+func GetDeviceConsoleSessionRecordingFailedEvent(ctx context.Context, session api.ConsoleSession, err error) *api.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: api.DeviceKind,
+		resourceName: session.Spec.DeviceName,
+		reason:       api.EventReasonDeviceConsoleSessionRecordingFailed,
+		message:      fmt.Sprintf("Recording of console session %s failed: %v.", lo.FromPtr(session.Metadata.Name), err),
+		details:      nil,
+	})
+}
+
 // GetFleetRolloutNewEvent creates an event for fleet rollout creation
 func GetFleetRolloutNewEvent(ctx context.Context, name string) *api.Event {
 	return getBaseEvent(ctx, resourceEvent{
@@ -551,6 +685,27 @@ func GetFleetRolloutFailedEvent(ctx context.Context, name string, deployingTempl
 	})
 }
 
+// GetFleetRolloutRolledBackEvent creates an event for an automatic fleet rollout rollback
+func GetFleetRolloutRolledBackEvent(ctx context.Context, name string, failedTemplateVersion string, rollbackTemplateVersion string, message string) *api.Event {
+	details := api.FleetRolloutRolledBackDetails{
+		DetailType:              api.FleetRolloutRolledBack,
+		TemplateVersion:         failedTemplateVersion,
+		RollbackTemplateVersion: rollbackTemplateVersion,
+	}
+	eventDetails := api.EventDetails{}
+	if err := eventDetails.FromFleetRolloutRolledBackDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: api.FleetKind,
+		resourceName: name,
+		reason:       api.EventReasonFleetRolloutRolledBack,
+		message:      message,
+		details:      &eventDetails,
+	})
+}
+
 // GetRepositoryAccessibleEvent creates an event for repository accessibility
 func GetRepositoryAccessibleEvent(ctx context.Context, name string) *api.Event {
 	return getBaseEvent(ctx, resourceEvent{
@@ -573,6 +728,21 @@ func GetRepositoryInaccessibleEvent(ctx context.Context, name string, errorMessa
 	})
 }
 
+// GetRepositoryPushReceivedEvent creates an event for a push notification received for a repository
+func GetRepositoryPushReceivedEvent(ctx context.Context, name string, ref string) *api.Event {
+	message := "Push received."
+	if ref != "" {
+		message = fmt.Sprintf("Push to %s received.", ref)
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: api.RepositoryKind,
+		resourceName: name,
+		reason:       api.EventReasonRepositoryPushReceived,
+		message:      message,
+		details:      nil,
+	})
+}
+
 // GetReferencedRepositoryUpdatedEvent creates an event for a referenced repository being updated
 func GetReferencedRepositoryUpdatedEvent(ctx context.Context, kind api.ResourceKind, name, repositoryName string) *api.Event {
 	details := api.ReferencedRepositoryUpdatedDetails{
@@ -0,0 +1,384 @@
+package common
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"text/template"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/samber/lo"
+)
+
+var ErrUnknownConfigName = errors.New("failed to find configuration item name")
+
+// RenderDeviceSpec returns the spec of a device that belongs to a fleet, by replacing the parameters in the fleet's
+// template with the values of the device.  All the errors found while replacing the parameters are returned.
+func RenderDeviceSpec(device *api.Device, templateSpec *api.DeviceSpec) (*api.DeviceSpec, []error) {
+	errs := []error{}
+
+	var osSpec *api.DeviceOsSpec
+	if templateSpec.Os != nil {
+		img, err := ReplaceParametersInString(templateSpec.Os.Image, device)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed replacing parameters in OS image: %w", err))
+		} else {
+			osSpec = &api.DeviceOsSpec{Image: img}
+		}
+	}
+
+	deviceConfig, configErrs := renderConfig(device, templateSpec.Config)
+	errs = append(errs, configErrs...)
+
+	deviceApps, appErrs := renderApplications(device, templateSpec.Applications)
+	errs = append(errs, appErrs...)
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	return &api.DeviceSpec{
+		Config:       deviceConfig,
+		Os:           osSpec,
+		Systemd:      templateSpec.Systemd,
+		Resources:    templateSpec.Resources,
+		Applications: deviceApps,
+		UpdatePolicy: templateSpec.UpdatePolicy,
+	}, nil
+}
+
+func renderApplications(device *api.Device, applications *[]api.ApplicationProviderSpec) (*[]api.ApplicationProviderSpec, []error) {
+	if applications == nil {
+		return nil, nil
+	}
+
+	deviceApps := []api.ApplicationProviderSpec{}
+	appErrs := []error{}
+	for appIndex, appItem := range *applications {
+		var newAppItem *api.ApplicationProviderSpec
+		errs := []error{}
+		appType, err := appItem.Type()
+		if err != nil {
+			appErrs = append(errs, fmt.Errorf("failed getting type for app %d: %w", appIndex, err))
+			continue
+		}
+		switch appType {
+		case api.ImageApplicationProviderType:
+			newAppItem, errs = replaceEnvVarValueParameters(device, appItem)
+		case api.InlineApplicationProviderType:
+			newAppItem, errs = replaceInlineApplicationParameters(device, appItem)
+		default:
+			errs = append(errs, fmt.Errorf("unsupported type for app %d: %s", appIndex, appType))
+		}
+
+		appErrs = append(appErrs, errs...)
+		if newAppItem != nil {
+			deviceApps = append(deviceApps, *newAppItem)
+		}
+	}
+
+	if len(appErrs) > 0 {
+		return nil, appErrs
+	}
+
+	return &deviceApps, nil
+}
+
+func replaceEnvVarValueParameters(device *api.Device, app api.ApplicationProviderSpec) (*api.ApplicationProviderSpec, []error) {
+	if app.EnvVars == nil {
+		return &app, nil
+	}
+
+	origEnvVars := *app.EnvVars
+	var errs []error
+	newEnvVars := make(map[string]string, len(origEnvVars))
+	for k, v := range origEnvVars {
+		newValue, err := ReplaceParametersInString(v, device)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed replacing parameters in env var %s: %w", k, err))
+			continue
+		}
+		newEnvVars[k] = newValue
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	app.EnvVars = &newEnvVars
+
+	return &app, nil
+}
+
+func renderConfig(device *api.Device, config *[]api.ConfigProviderSpec) (*[]api.ConfigProviderSpec, []error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	deviceConfig := []api.ConfigProviderSpec{}
+	configErrs := []error{}
+	for _, configItem := range *config {
+		var newConfigItem *api.ConfigProviderSpec
+		errs := []error{}
+
+		configType, err := configItem.Type()
+		if err != nil {
+			configErrs = append(configErrs, fmt.Errorf("%w: failed getting config type: %w", ErrUnknownConfigName, err))
+			continue
+		}
+
+		switch configType {
+		case api.GitConfigProviderType:
+			newConfigItem, errs = replaceGitConfigParameters(device, configItem)
+		case api.KubernetesSecretProviderType:
+			newConfigItem, errs = replaceKubeSecretConfigParameters(device, configItem)
+		case api.InlineConfigProviderType:
+			newConfigItem, errs = replaceInlineConfigParameters(device, configItem)
+		case api.HttpConfigProviderType:
+			newConfigItem, errs = replaceHTTPConfigParameters(device, configItem)
+		default:
+			errs = append(errs, fmt.Errorf("%w: unsupported config type %q", ErrUnknownConfigName, configType))
+		}
+
+		configErrs = append(configErrs, errs...)
+		if newConfigItem != nil {
+			deviceConfig = append(deviceConfig, *newConfigItem)
+		}
+	}
+
+	if len(configErrs) > 0 {
+		return nil, configErrs
+	}
+
+	return &deviceConfig, nil
+}
+
+func replaceGitConfigParameters(device *api.Device, configItem api.ConfigProviderSpec) (*api.ConfigProviderSpec, []error) {
+	gitSpec, err := configItem.AsGitConfigProviderSpec()
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed to convert config to git config: %w", err)}
+	}
+
+	errs := []error{}
+
+	gitSpec.GitRef.TargetRevision, err = ReplaceParametersInString(gitSpec.GitRef.TargetRevision, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in targetRevision in git config %s: %w", gitSpec.Name, err))
+	}
+
+	gitSpec.GitRef.Path, err = ReplaceParametersInString(gitSpec.GitRef.Path, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in path in git config %s: %w", gitSpec.Name, err))
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	newConfigItem := api.ConfigProviderSpec{}
+	err = newConfigItem.FromGitConfigProviderSpec(gitSpec)
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed converting git config: %w", err)}
+	}
+
+	return &newConfigItem, nil
+}
+
+func replaceKubeSecretConfigParameters(device *api.Device, configItem api.ConfigProviderSpec) (*api.ConfigProviderSpec, []error) {
+	secretSpec, err := configItem.AsKubernetesSecretProviderSpec()
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed to convert config to kubernetes secret config: %w", err)}
+	}
+
+	errs := []error{}
+
+	secretSpec.SecretRef.Name, err = ReplaceParametersInString(secretSpec.SecretRef.Name, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in name in k8s secret config %s: %w", secretSpec.Name, err))
+	}
+
+	secretSpec.SecretRef.Namespace, err = ReplaceParametersInString(secretSpec.SecretRef.Namespace, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in namespace in k8s secret config %s: %w", secretSpec.Name, err))
+	}
+
+	secretSpec.SecretRef.MountPath, err = ReplaceParametersInString(secretSpec.SecretRef.MountPath, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in mountPath in k8s secret config %s: %w", secretSpec.Name, err))
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	newConfigItem := api.ConfigProviderSpec{}
+	err = newConfigItem.FromKubernetesSecretProviderSpec(secretSpec)
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed converting git config: %w", err)}
+	}
+
+	return &newConfigItem, nil
+}
+
+func replaceInlineConfigParameters(device *api.Device, configItem api.ConfigProviderSpec) (*api.ConfigProviderSpec, []error) {
+	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed to convert config to inline config: %w", err)}
+	}
+
+	errs := []error{}
+
+	for fileIndex, file := range inlineSpec.Inline {
+		var decodedBytes []byte
+		var err error
+
+		inlineSpec.Inline[fileIndex].Path, err = ReplaceParametersInString(file.Path, device)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed replacing parameters in path for file %d in inline config %s: %w", fileIndex, inlineSpec.Name, err))
+		}
+
+		encoding := lo.FromPtr(file.ContentEncoding)
+		if encoding == api.EncodingBase64 {
+			decodedBytes, err = base64.StdEncoding.DecodeString(file.Content)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed base64 decoding contents for file %d in inline config %s: %w", fileIndex, inlineSpec.Name, err))
+				continue
+			}
+		} else {
+			decodedBytes = []byte(file.Content)
+		}
+
+		contentsReplaced, err := ReplaceParametersInString(string(decodedBytes), device)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed replacing parameters in contents for file %d in inline config %s: %w", fileIndex, inlineSpec.Name, err))
+			continue
+		}
+
+		if encoding == api.EncodingBase64 {
+			inlineSpec.Inline[fileIndex].Content = base64.StdEncoding.EncodeToString([]byte(contentsReplaced))
+		} else {
+			inlineSpec.Inline[fileIndex].Content = contentsReplaced
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	newConfigItem := api.ConfigProviderSpec{}
+	err = newConfigItem.FromInlineConfigProviderSpec(inlineSpec)
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed converting inline config: %w", err)}
+	}
+
+	return &newConfigItem, nil
+}
+
+func replaceInlineApplicationParameters(device *api.Device, item api.ApplicationProviderSpec) (*api.ApplicationProviderSpec, []error) {
+	appName := lo.FromPtr(item.Name)
+	inlineSpec, err := item.AsInlineApplicationProviderSpec()
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed to convert to inline application provider: %w", err)}
+	}
+
+	errs := []error{}
+	for fileIndex, file := range inlineSpec.Inline {
+		var decodedBytes []byte
+		var err error
+
+		inlineSpec.Inline[fileIndex].Path, err = ReplaceParametersInString(file.Path, device)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed replacing parameters in path for file %d in inline app %s: %w", fileIndex, appName, err))
+		}
+
+		content := lo.FromPtr(file.Content)
+		encoding := lo.FromPtr(file.ContentEncoding)
+		if encoding == api.EncodingBase64 {
+			decodedBytes, err = base64.StdEncoding.DecodeString(content)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed base64 decoding contents for file %d in inline app %s: %w", fileIndex, appName, err))
+				continue
+			}
+		} else {
+			decodedBytes = []byte(content)
+		}
+
+		contentsReplaced, err := ReplaceParametersInString(string(decodedBytes), device)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed replacing parameters in contents for file %d in inline app %s: %w", fileIndex, appName, err))
+			continue
+		}
+
+		if encoding == api.EncodingBase64 {
+			contentsReplaced = base64.StdEncoding.EncodeToString([]byte(contentsReplaced))
+			inlineSpec.Inline[fileIndex].Content = &contentsReplaced
+		} else {
+			inlineSpec.Inline[fileIndex].Content = &contentsReplaced
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	newItem := api.ApplicationProviderSpec{
+		Name:    &appName,
+		EnvVars: item.EnvVars,
+		AppType: item.AppType,
+	}
+	err = newItem.FromInlineApplicationProviderSpec(inlineSpec)
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed converting inline application: %w", err)}
+	}
+
+	return &newItem, nil
+}
+
+func replaceHTTPConfigParameters(device *api.Device, configItem api.ConfigProviderSpec) (*api.ConfigProviderSpec, []error) {
+	httpSpec, err := configItem.AsHttpConfigProviderSpec()
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed to convert config to http config: %w", err)}
+	}
+
+	errs := []error{}
+
+	if httpSpec.HttpRef.Suffix != nil {
+		suffix, err := ReplaceParametersInString(*httpSpec.HttpRef.Suffix, device)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed replacing parameters in suffix in http config %s: %w", httpSpec.Name, err))
+		}
+		httpSpec.HttpRef.Suffix = &suffix
+	}
+
+	httpSpec.HttpRef.FilePath, err = ReplaceParametersInString(httpSpec.HttpRef.FilePath, device)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed replacing parameters in file path in http config %s: %w", httpSpec.Name, err))
+	}
+
+	if len(errs) > 0 {
+		return nil, errs
+	}
+
+	newConfigItem := api.ConfigProviderSpec{}
+	err = newConfigItem.FromHttpConfigProviderSpec(httpSpec)
+	if err != nil {
+		return nil, []error{fmt.Errorf("failed converting http config: %w", err)}
+	}
+
+	return &newConfigItem, nil
+}
+
+// ReplaceParametersInString renders the parameters in a string of a fleet template for the device
+func ReplaceParametersInString(s string, device *api.Device) (string, error) {
+	t, err := template.New("t").Option("missingkey=error").Funcs(api.GetGoTemplateFuncMap()).Parse(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid parameter syntax: %v", err)
+	}
+
+	output, err := api.ExecuteGoTemplateOnDevice(t, device)
+	if err != nil {
+		return "", fmt.Errorf("cannot apply parameters, possibly because they access invalid fields: %w", err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/samber/lo"
+)
+
+// CreateConsoleSession records a console session that was opened to a device.  Console sessions are created by the
+// console session manager, never through the API.
+func (h *ServiceHandler) CreateConsoleSession(ctx context.Context, session api.ConsoleSession) (*api.ConsoleSession, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	result, err := h.store.ConsoleSession().Create(ctx, orgId, &session)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, true, api.ConsoleSessionKind, session.Metadata.Name)
+	}
+	h.CreateEvent(ctx, common.GetDeviceConsoleSessionStartedEvent(ctx, *result))
+	return result, api.StatusCreated()
+}
+
+func (h *ServiceHandler) ListConsoleSessions(ctx context.Context, params api.ListConsoleSessionsParams) (*api.ConsoleSessionList, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != api.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.ConsoleSession().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, api.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, api.StatusBadRequest(se.Error())
+	default:
+		return nil, api.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetConsoleSession(ctx context.Context, name string) (*api.ConsoleSession, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	result, err := h.store.ConsoleSession().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, api.ConsoleSessionKind, &name)
+}
+
+// ReplaceConsoleSessionStatus records the outcome of a console session.  Setting the end time marks the session as
+// closed.
+func (h *ServiceHandler) ReplaceConsoleSessionStatus(ctx context.Context, name string, session api.ConsoleSession) (*api.ConsoleSession, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	if name != lo.FromPtr(session.Metadata.Name) {
+		return nil, api.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, err := h.store.ConsoleSession().UpdateStatus(ctx, orgId, &session)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, api.ConsoleSessionKind, &name)
+	}
+	if session.Status != nil && session.Status.EndTime != nil {
+		h.CreateEvent(ctx, common.GetDeviceConsoleSessionEndedEvent(ctx, session))
+	}
+	return result, api.StatusOK()
+}
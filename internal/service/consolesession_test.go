@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsoleSession(t *testing.T) {
+	require := require.New(t)
+	ts := &TestStore{}
+	handler := &ServiceHandler{
+		eventHandler: NewEventHandler(ts, &DummyWorkerClient{}, log.InitLogs()),
+		store:        ts,
+		log:          log.InitLogs(),
+	}
+	ctx := context.Background()
+
+	session := api.ConsoleSession{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr("mysession")},
+		Spec:     api.ConsoleSessionSpec{DeviceName: "mydevice", User: "alice"},
+		Status:   &api.ConsoleSessionStatus{StartTime: time.Now()},
+	}
+	_, status := handler.CreateConsoleSession(ctx, session)
+	require.Equal(statusCreatedCode, status.Code)
+
+	result, status := handler.GetConsoleSession(ctx, "mysession")
+	require.Equal(statusSuccessCode, status.Code)
+	require.Equal("alice", result.Spec.User)
+	_, status = handler.GetConsoleSession(ctx, "othersession")
+	require.Equal(statusNotFoundCode, status.Code)
+
+	_, status = handler.ReplaceConsoleSessionStatus(ctx, "othersession", session)
+	require.Equal(statusBadRequestCode, status.Code)
+
+	session.Status.EndTime = lo.ToPtr(time.Now())
+	session.Status.ExitCode = lo.ToPtr(int32(130))
+	_, status = handler.ReplaceConsoleSessionStatus(ctx, "mysession", session)
+	require.Equal(statusSuccessCode, status.Code)
+	result, _ = handler.GetConsoleSession(ctx, "mysession")
+	require.Equal(lo.ToPtr(int32(130)), result.Status.ExitCode)
+
+	events, err := ts.Event().List(ctx, store.NullOrgId, store.ListParams{})
+	require.NoError(err)
+	require.Len(events.Items, 2)
+	require.Equal(api.EventReasonDeviceConsoleSessionStarted, events.Items[0].Reason)
+	require.Equal(api.EventReasonDeviceConsoleSessionEnded, events.Items[1].Reason)
+	require.Equal("mydevice", events.Items[1].InvolvedObject.Name)
+	require.Contains(events.Items[1].Message, "exit code 130")
+}
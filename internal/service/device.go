@@ -21,6 +21,7 @@ import (
 	"github.com/flightctl/flightctl/internal/util/validation"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
+	"golang.org/x/crypto/ocsp"
 )
 
 // PrepareDevicesAfterRestore performs post-restoration preparation tasks for devices
@@ -308,7 +309,10 @@ func (h *ServiceHandler) UpdateDevice(ctx context.Context, name string, device a
 func (h *ServiceHandler) DeleteDevice(ctx context.Context, name string) api.Status {
 	orgId := getOrgIdFromContext(ctx)
 
-	_, err := h.store.Device().Delete(ctx, orgId, name, h.callbackDeviceDeleted)
+	deleted, err := h.store.Device().Delete(ctx, orgId, name, h.callbackDeviceDeleted)
+	if err == nil && deleted {
+		h.revokeDeviceCertificates(ctx, orgId, name, ocsp.CessationOfOperation)
+	}
 	return StoreErrorToApiStatus(err, false, api.DeviceKind, &name)
 }
 
@@ -559,12 +563,16 @@ func (h *ServiceHandler) DecommissionDevice(ctx context.Context, name string, de
 
 	// set the fromAPI bool to 'false', otherwise updating the spec.decommissionRequested of a device is blocked
 	result, err := h.store.Device().Update(ctx, orgId, deviceObj, []string{"status", "owner"}, false, DeviceVerificationCallback, h.callbackDeviceDecommission)
+	if err == nil {
+		h.revokeDeviceCertificates(ctx, orgId, name, ocsp.CessationOfOperation)
+	}
 	return result, StoreErrorToApiStatus(err, false, api.DeviceKind, &name)
 }
 
 func (h *ServiceHandler) UpdateDeviceAnnotations(ctx context.Context, name string, annotations map[string]string, deleteKeys []string) api.Status {
 	orgId := getOrgIdFromContext(ctx)
 	err := h.store.Device().UpdateAnnotations(ctx, orgId, name, annotations, deleteKeys)
+	notifyWatchers(ctx, api.DeviceKind, orgId, name, api.WatchEventModified, err)
 	return StoreErrorToApiStatus(err, false, api.DeviceKind, &name)
 }
 
@@ -601,6 +609,7 @@ func (h *ServiceHandler) SetDeviceServiceConditions(ctx context.Context, name st
 	}
 
 	err := h.store.Device().SetServiceConditions(ctx, orgId, name, conditions, callback)
+	notifyWatchers(ctx, api.DeviceKind, orgId, name, api.WatchEventModified, err)
 	return StoreErrorToApiStatus(err, false, api.DeviceKind, &name)
 }
 
@@ -763,16 +772,19 @@ func (h *ServiceHandler) ResumeDevices(ctx context.Context, request api.DeviceRe
 // callbackDeviceUpdated is the device-specific callback that handles device events
 func (h *ServiceHandler) callbackDeviceUpdated(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleDeviceUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+	notifyWatchers(ctx, resourceKind, orgId, name, lo.Ternary(created, api.WatchEventAdded, api.WatchEventModified), err)
 }
 
 // callbackDeviceDecommission is the device-specific callback that handles device decommission events
 func (h *ServiceHandler) callbackDeviceDecommission(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleDeviceDecommissionEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+	notifyWatchers(ctx, resourceKind, orgId, name, api.WatchEventModified, err)
 }
 
 // callbackDeviceDeleted is the device-specific callback that handles device deletion events
 func (h *ServiceHandler) callbackDeviceDeleted(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+	notifyWatchers(ctx, resourceKind, orgId, name, api.WatchEventDeleted, err)
 }
 
 // processAwaitingReconnectIfNeeded processes the awaiting reconnect annotation only if the KV store contains the awaiting reconnection key
@@ -390,3 +390,45 @@ func TestDeviceDisconnected(t *testing.T) {
 	require.Equal(true, changed)
 	require.Equal(device.Status.Summary.Status, api.DeviceSummaryStatusUnknown)
 }
+
+func TestDeviceSearchMatches(t *testing.T) {
+	require := require.New(t)
+	status := api.NewDeviceStatus()
+	status.SystemInfo = api.DeviceSystemInfo{
+		Architecture:         "amd64",
+		OperatingSystem:      "linux",
+		AdditionalProperties: map[string]string{"productSerial": "SN-4711-X", "netIpDefault": "10.0.47.11"},
+		CustomInfo:           &api.CustomDeviceInfo{"rack": "r4711"},
+	}
+	device := api.Device{
+		Metadata: api.ObjectMeta{
+			Name:   lo.ToPtr("edge-01"),
+			Labels: &map[string]string{"alias": "store-4711", "site": "paris"},
+		},
+		Status: &status,
+	}
+
+	require.Equal([]string{
+		"metadata.alias",
+		"metadata.labels.alias",
+		"status.systemInfo.productSerial",
+		"status.systemInfo.customInfo.rack",
+	}, deviceSearchMatches(&device, "4711"))
+	require.Equal([]string{"metadata.labels.site"}, deviceSearchMatches(&device, "SITE=Par"))
+	require.Equal([]string{"metadata.name"}, deviceSearchMatches(&device, "EDGE"))
+	require.Equal([]string{"metadata.alias", "metadata.labels.alias"}, deviceSearchMatches(&device, "Store-"))
+	require.Empty(deviceSearchMatches(&device, "nothing"))
+}
+
+func TestSearchDevicesTextTooShort(t *testing.T) {
+	require := require.New(t)
+	ts := &TestStore{}
+	wc := &DummyWorkerClient{}
+	serviceHandler := ServiceHandler{
+		eventHandler: NewEventHandler(ts, wc, log.InitLogs()),
+		store:        ts,
+		workerClient: wc,
+	}
+	_, status := serviceHandler.SearchDevices(context.Background(), api.SearchDevicesParams{Q: " ab "})
+	require.Equal(statusBadRequestCode, status.Code)
+}
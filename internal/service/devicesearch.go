@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/samber/lo"
+)
+
+// minDeviceSearchLength is the shortest text that can be searched, since shorter texts can't use the trigram index
+const minDeviceSearchLength = 3
+
+// SearchDevices returns the devices whose name, alias, labels or system info contain the given text, ordered by
+// relevance.  Each result lists the fields that contain the text.
+func (h *ServiceHandler) SearchDevices(ctx context.Context, params api.SearchDevicesParams) (*api.DeviceSearchResultList, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	text := strings.TrimSpace(params.Q)
+	if utf8.RuneCountInString(text) < minDeviceSearchLength {
+		return nil, api.StatusBadRequest(fmt.Sprintf("search text must be at least %d characters long", minDeviceSearchLength))
+	}
+
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != api.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.Device().Search(ctx, orgId, text, *listParams)
+	var se *selector.SelectorError
+	switch {
+	case err == nil:
+	case errors.Is(err, flterrors.ErrInvalidContinue):
+		return nil, api.StatusBadRequest(fmt.Sprintf("failed to parse continue parameter: %v", err))
+	case selector.AsSelectorError(err, &se):
+		return nil, api.StatusBadRequest(se.Error())
+	default:
+		return nil, api.StatusInternalServerError(err.Error())
+	}
+
+	for i := range result.Items {
+		result.Items[i].Matches = deviceSearchMatches(&result.Items[i].Device, text)
+	}
+	return result, api.StatusOK()
+}
+
+// deviceSearchMatches returns the paths of the searchable fields of the device that contain the text, ignoring case
+func deviceSearchMatches(device *api.Device, text string) []string {
+	text = strings.ToLower(text)
+	contains := func(value string) bool {
+		return strings.Contains(strings.ToLower(value), text)
+	}
+
+	matches := []string{}
+	if contains(lo.FromPtr(device.Metadata.Name)) {
+		matches = append(matches, "metadata.name")
+	}
+	if alias, ok := lo.FromPtr(device.Metadata.Labels)["alias"]; ok && contains(alias) {
+		matches = append(matches, "metadata.alias")
+	}
+	for _, key := range sortedKeys(lo.FromPtr(device.Metadata.Labels)) {
+		value := (*device.Metadata.Labels)[key]
+		if contains(key + "=" + value) {
+			matches = append(matches, "metadata.labels."+key)
+		}
+	}
+	if device.Status == nil {
+		return matches
+	}
+
+	systemInfo := device.Status.SystemInfo
+	fields := map[string]string{
+		"agentVersion":    systemInfo.AgentVersion,
+		"architecture":    systemInfo.Architecture,
+		"bootID":          systemInfo.BootID,
+		"operatingSystem": systemInfo.OperatingSystem,
+	}
+	for key, value := range systemInfo.AdditionalProperties {
+		fields[key] = value
+	}
+	for _, key := range sortedKeys(fields) {
+		if contains(fields[key]) {
+			matches = append(matches, "status.systemInfo."+key)
+		}
+	}
+	customInfo := map[string]string(lo.FromPtr(systemInfo.CustomInfo))
+	for _, key := range sortedKeys(customInfo) {
+		if contains(customInfo[key]) {
+			matches = append(matches, "status.systemInfo.customInfo."+key)
+		}
+	}
+	return matches
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := lo.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
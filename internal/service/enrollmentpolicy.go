@@ -0,0 +1,364 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func (h *ServiceHandler) CreateEnrollmentPolicy(ctx context.Context, policy api.EnrollmentPolicy) (*api.EnrollmentPolicy, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	// don't set fields that are managed by the service
+	NilOutManagedObjectMetaProperties(&policy.Metadata)
+
+	if errs := policy.Validate(); len(errs) > 0 {
+		return nil, api.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	result, err := h.store.EnrollmentPolicy().Create(ctx, orgId, &policy, h.callbackEnrollmentPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, true, api.EnrollmentPolicyKind, policy.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListEnrollmentPolicies(ctx context.Context, params api.ListEnrollmentPoliciesParams) (*api.EnrollmentPolicyList, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != api.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.EnrollmentPolicy().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, api.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, api.StatusBadRequest(se.Error())
+	default:
+		return nil, api.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetEnrollmentPolicy(ctx context.Context, name string) (*api.EnrollmentPolicy, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	result, err := h.store.EnrollmentPolicy().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, api.EnrollmentPolicyKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceEnrollmentPolicy(ctx context.Context, name string, policy api.EnrollmentPolicy) (*api.EnrollmentPolicy, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	// don't overwrite fields that are managed by the service for external requests
+	if !IsInternalRequest(ctx) {
+		NilOutManagedObjectMetaProperties(&policy.Metadata)
+	}
+
+	if errs := policy.Validate(); len(errs) > 0 {
+		return nil, api.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *policy.Metadata.Name {
+		return nil, api.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, created, err := h.store.EnrollmentPolicy().CreateOrUpdate(ctx, orgId, &policy, h.callbackEnrollmentPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, created, api.EnrollmentPolicyKind, &name)
+}
+
+func (h *ServiceHandler) DeleteEnrollmentPolicy(ctx context.Context, name string) api.Status {
+	orgId := getOrgIdFromContext(ctx)
+
+	err := h.store.EnrollmentPolicy().Delete(ctx, orgId, name, h.callbackEnrollmentPolicyDeleted)
+	return StoreErrorToApiStatus(err, false, api.EnrollmentPolicyKind, &name)
+}
+
+func (h *ServiceHandler) PatchEnrollmentPolicy(ctx context.Context, name string, patch api.PatchRequest) (*api.EnrollmentPolicy, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	currentObj, err := h.store.EnrollmentPolicy().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, api.EnrollmentPolicyKind, &name)
+	}
+
+	newObj := &api.EnrollmentPolicy{}
+	err = ApplyJSONPatch(ctx, currentObj, newObj, patch, "/api/v1/enrollmentpolicies/"+name)
+	if err != nil {
+		return nil, api.StatusBadRequest(err.Error())
+	}
+
+	if errs := newObj.Validate(); len(errs) > 0 {
+		return nil, api.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if errs := currentObj.ValidateUpdate(newObj); len(errs) > 0 {
+		return nil, api.StatusBadRequest(errors.Join(errs...).Error())
+	}
+
+	NilOutManagedObjectMetaProperties(&newObj.Metadata)
+	newObj.Metadata.ResourceVersion = nil
+
+	result, err := h.store.EnrollmentPolicy().Update(ctx, orgId, newObj, h.callbackEnrollmentPolicyUpdated)
+	return result, StoreErrorToApiStatus(err, false, api.EnrollmentPolicyKind, &name)
+}
+
+// applyEnrollmentPolicies approves or rejects an enrollment request that awaits a decision, according to the first
+// rule of the organization's enrollment policies that matches it.  It returns the enrollment request as it was
+// stored.  Failures are recorded as events and leave the request pending, since the request itself was accepted.
+// A dry run neither approves nor rejects the request.
+func (h *ServiceHandler) applyEnrollmentPolicies(ctx context.Context, orgId uuid.UUID, er *api.EnrollmentRequest) *api.EnrollmentRequest {
+	if IsDryRun(ctx) || !enrollmentRequestAwaitsDecision(er) {
+		return er
+	}
+	name := lo.FromPtr(er.Metadata.Name)
+
+	policies, err := h.listAllEnrollmentPolicies(ctx, orgId)
+	if err != nil {
+		h.log.Errorf("failed to list enrollment policies for enrollment request %s: %v", name, err)
+		return er
+	}
+	if len(policies) == 0 {
+		return er
+	}
+
+	facts := h.enrollmentRequestFacts(er)
+	policyName, rule := matchEnrollmentPolicies(policies, facts)
+	if rule == nil {
+		return er
+	}
+
+	// decide on a copy of the status, so that a failed decision leaves the stored request unchanged
+	decided := *er
+	status := *er.Status
+	status.Conditions = slices.Clone(er.Status.Conditions)
+	decided.Status = &status
+	if result := h.decideEnrollmentRequest(ctx, orgId, &decided, policyName, *rule); result != nil {
+		return result
+	}
+	return er
+}
+
+// decideEnrollmentRequest applies the action of a rule to an enrollment request and stores the outcome
+func (h *ServiceHandler) decideEnrollmentRequest(ctx context.Context, orgId uuid.UUID, er *api.EnrollmentRequest, policyName string, rule api.EnrollmentPolicyRule) *api.EnrollmentRequest {
+	name := lo.FromPtr(er.Metadata.Name)
+	decidedBy := fmt.Sprintf("%s/%s", api.EnrollmentPolicyKind, policyName)
+
+	switch rule.Action {
+	case api.EnrollmentPolicyActionApprove:
+		approvalStatus := api.EnrollmentRequestApprovalStatus{
+			Approved:   true,
+			Labels:     lo.ToPtr(lo.Assign(lo.FromPtr(rule.Labels))),
+			ApprovedAt: time.Now(),
+			ApprovedBy: decidedBy,
+		}
+		if err := approveAndSignEnrollmentRequest(ctx, h.ca, er, &approvalStatus, api.EnrollmentRequestAutoApprovedReason); err != nil {
+			status := api.StatusBadRequest(fmt.Sprintf("Error approving and signing enrollment request: %v", err.Error()))
+			h.CreateEvent(ctx, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
+			return nil
+		}
+		if err := h.createDeviceFromEnrollmentRequest(ctx, orgId, er); err != nil {
+			status := api.StatusInternalServerError(fmt.Sprintf("error creating device from enrollment request: %v", err))
+			h.CreateEvent(ctx, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
+			return nil
+		}
+	case api.EnrollmentPolicyActionReject:
+		api.SetStatusCondition(&er.Status.Conditions, api.Condition{
+			Type:    api.ConditionTypeEnrollmentRequestApproved,
+			Status:  api.ConditionStatusFalse,
+			Reason:  api.EnrollmentRequestRejectedReason,
+			Message: fmt.Sprintf("Rejected by %s rule %s", decidedBy, rule.Name),
+		})
+	default:
+		h.log.Errorf("enrollment policy %s has rule %s with unsupported action %q", policyName, rule.Name, rule.Action)
+		return nil
+	}
+
+	callback := func(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+		if err != nil {
+			status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+			h.CreateEvent(ctx, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
+			return
+		}
+		h.CreateEvent(ctx, common.GetEnrollmentPolicyDecisionEvent(ctx, name, policyName, rule))
+	}
+	result, err := h.store.EnrollmentRequest().UpdateStatus(ctx, orgId, er, callback)
+	if err != nil {
+		h.log.Errorf("failed to update status of enrollment request %s decided by %s: %v", name, decidedBy, err)
+		return nil
+	}
+	return result
+}
+
+// listAllEnrollmentPolicies returns the enrollment policies of an organization in the order in which they are evaluated
+func (h *ServiceHandler) listAllEnrollmentPolicies(ctx context.Context, orgId uuid.UUID) ([]api.EnrollmentPolicy, error) {
+	policies := []api.EnrollmentPolicy{}
+	listParams := store.ListParams{Limit: MaxRecordsPerListRequest}
+	for {
+		result, err := h.store.EnrollmentPolicy().List(ctx, orgId, listParams)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, result.Items...)
+		if result.Metadata.Continue == nil {
+			break
+		}
+		cont, err := store.ParseContinueString(result.Metadata.Continue)
+		if err != nil {
+			return nil, err
+		}
+		listParams.Continue = cont
+	}
+
+	sort.SliceStable(policies, func(i, j int) bool {
+		pi, pj := lo.FromPtr(policies[i].Spec.Priority), lo.FromPtr(policies[j].Spec.Priority)
+		if pi != pj {
+			return pi < pj
+		}
+		return lo.FromPtr(policies[i].Metadata.Name) < lo.FromPtr(policies[j].Metadata.Name)
+	})
+	return policies, nil
+}
+
+// enrollmentRequestAwaitsDecision returns whether an enrollment request was neither approved nor rejected, and is not
+// waiting for the TPM credential challenge that decides whether its TPM identity is verified
+func enrollmentRequestAwaitsDecision(er *api.EnrollmentRequest) bool {
+	if er == nil || er.Status == nil {
+		return false
+	}
+	if api.FindStatusCondition(er.Status.Conditions, api.ConditionTypeEnrollmentRequestApproved) != nil {
+		return false
+	}
+	tpmCondition := api.FindStatusCondition(er.Status.Conditions, api.ConditionTypeEnrollmentRequestTPMVerified)
+	return tpmCondition == nil || tpmCondition.Reason != api.TPMChallengeRequiredReason
+}
+
+// enrollmentRequestFacts holds the properties of an enrollment request that enrollment policies match on
+type enrollmentRequestFacts struct {
+	tpmVerified bool
+	ekIssuer    string
+	// systemInfo and labels are reported by the device, so they can't be trusted to approve it
+	systemInfo map[string]string
+	labels     map[string]string
+}
+
+func (h *ServiceHandler) enrollmentRequestFacts(er *api.EnrollmentRequest) enrollmentRequestFacts {
+	facts := enrollmentRequestFacts{
+		tpmVerified: api.IsStatusConditionTrue(er.Status.Conditions, api.ConditionTypeEnrollmentRequestTPMVerified),
+		systemInfo:  map[string]string{},
+		labels:      lo.FromPtr(er.Spec.Labels),
+	}
+
+	// the issuer of the EK certificate is only trusted once the chain of trust of the TCG CSR was validated
+	if facts.tpmVerified {
+		if csrBytes, isTPM := tpm.ParseTCGCSRBytes(er.Spec.Csr); isTPM {
+			ekCert, err := tpm.EKCertificateFromTCGCSR(csrBytes)
+			if err != nil {
+				h.log.Warnf("failed to read EK certificate of enrollment request %s: %v", lo.FromPtr(er.Metadata.Name), err)
+			} else {
+				facts.ekIssuer = ekCert.Issuer.String()
+			}
+		}
+	}
+
+	if er.Spec.DeviceStatus != nil {
+		systemInfo := er.Spec.DeviceStatus.SystemInfo
+		facts.systemInfo["agentVersion"] = systemInfo.AgentVersion
+		facts.systemInfo["architecture"] = systemInfo.Architecture
+		facts.systemInfo["bootID"] = systemInfo.BootID
+		facts.systemInfo["operatingSystem"] = systemInfo.OperatingSystem
+		for key, value := range systemInfo.AdditionalProperties {
+			facts.systemInfo[key] = value
+		}
+		for key, value := range lo.FromPtr(systemInfo.CustomInfo) {
+			facts.systemInfo["customInfo."+key] = value
+		}
+	}
+	return facts
+}
+
+// matchEnrollmentPolicies returns the first rule of the policies that matches the facts, and the name of its policy
+func matchEnrollmentPolicies(policies []api.EnrollmentPolicy, facts enrollmentRequestFacts) (string, *api.EnrollmentPolicyRule) {
+	for i := range policies {
+		for j := range policies[i].Spec.Rules {
+			rule := &policies[i].Spec.Rules[j]
+			if enrollmentPolicyRuleMatches(rule, facts) {
+				return lo.FromPtr(policies[i].Metadata.Name), rule
+			}
+		}
+	}
+	return "", nil
+}
+
+func enrollmentPolicyRuleMatches(rule *api.EnrollmentPolicyRule, facts enrollmentRequestFacts) bool {
+	// policies are validated when stored, but a rule that approves on facts reported by the device alone must never
+	// match, whatever is stored
+	if rule.Action == api.EnrollmentPolicyActionApprove && (!rule.Match.HasTrustedCondition() || rule.Match.Labels != nil) {
+		return false
+	}
+	if rule.Match == nil {
+		return true
+	}
+	match := rule.Match
+
+	if match.TpmVerified != nil && *match.TpmVerified != facts.tpmVerified {
+		return false
+	}
+	if match.EkCertificateIssuers != nil {
+		if facts.ekIssuer == "" {
+			return false
+		}
+		if !lo.Contains(*match.EkCertificateIssuers, facts.ekIssuer) {
+			return false
+		}
+	}
+	for key, pattern := range lo.FromPtr(match.SystemInfo) {
+		value, ok := facts.systemInfo[key]
+		if !ok || !globMatch(pattern, value) {
+			return false
+		}
+	}
+	for key, pattern := range lo.FromPtr(match.Labels) {
+		value, ok := facts.labels[key]
+		if !ok || !globMatch(pattern, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// globMatch returns whether the value matches the pattern, in which '*' matches any sequence of characters
+func globMatch(pattern, value string) bool {
+	if !strings.Contains(pattern, "*") {
+		return pattern == value
+	}
+	parts := strings.Split(pattern, "*")
+	for i := range parts {
+		parts[i] = regexp.QuoteMeta(parts[i])
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$").MatchString(value)
+}
+
+// callbackEnrollmentPolicyUpdated is the enrollment policy-specific callback that handles enrollment policy update events
+func (h *ServiceHandler) callbackEnrollmentPolicyUpdated(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleEnrollmentPolicyUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackEnrollmentPolicyDeleted is the enrollment policy-specific callback that handles enrollment policy deletion events
+func (h *ServiceHandler) callbackEnrollmentPolicyDeleted(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
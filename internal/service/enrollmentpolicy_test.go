@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnrollmentPolicyRuleMatches(t *testing.T) {
+	facts := enrollmentRequestFacts{
+		tpmVerified: true,
+		ekIssuer:    "CN=Vendor EK CA 003,O=Vendor,C=DE",
+		systemInfo: map[string]string{
+			"architecture":      "amd64",
+			"productSerial":     "SN-4711",
+			"customInfo.siteId": "paris-1",
+		},
+		labels: map[string]string{"region": "eu-west"},
+	}
+
+	testCases := []struct {
+		name     string
+		action   api.EnrollmentPolicyAction
+		match    *api.EnrollmentPolicyMatch
+		facts    enrollmentRequestFacts
+		expected bool
+	}{
+		{
+			name:     "rejecting rule without conditions",
+			action:   api.EnrollmentPolicyActionReject,
+			match:    nil,
+			facts:    facts,
+			expected: true,
+		},
+		{
+			name:     "approving rule without conditions",
+			match:    nil,
+			facts:    facts,
+			expected: false,
+		},
+		{
+			name:     "approving rule on reported system info alone",
+			match:    &api.EnrollmentPolicyMatch{SystemInfo: &map[string]string{"architecture": "amd64"}},
+			facts:    facts,
+			expected: false,
+		},
+		{
+			name:     "approving rule on requested labels",
+			match:    &api.EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(true), Labels: &map[string]string{"region": "eu-*"}},
+			facts:    facts,
+			expected: false,
+		},
+		{
+			name:     "TPM verified",
+			match:    &api.EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(true)},
+			facts:    facts,
+			expected: true,
+		},
+		{
+			name:     "TPM not verified",
+			match:    &api.EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(false)},
+			facts:    facts,
+			expected: false,
+		},
+		{
+			name:     "EK issuer by distinguished name",
+			match:    &api.EnrollmentPolicyMatch{EkCertificateIssuers: &[]string{"CN=Other CA", "CN=Vendor EK CA 003,O=Vendor,C=DE"}},
+			facts:    facts,
+			expected: true,
+		},
+		{
+			name:     "EK issuer by common name only",
+			match:    &api.EnrollmentPolicyMatch{EkCertificateIssuers: &[]string{"Vendor EK CA 003"}},
+			facts:    facts,
+			expected: false,
+		},
+		{
+			name:     "EK issuer with a different organization",
+			match:    &api.EnrollmentPolicyMatch{EkCertificateIssuers: &[]string{"CN=Vendor EK CA 003,O=Impostor,C=DE"}},
+			facts:    facts,
+			expected: false,
+		},
+		{
+			name:     "EK issuer of unverified TPM",
+			match:    &api.EnrollmentPolicyMatch{EkCertificateIssuers: &[]string{"CN=Vendor EK CA 003,O=Vendor,C=DE"}},
+			facts:    enrollmentRequestFacts{systemInfo: facts.systemInfo, labels: facts.labels},
+			expected: false,
+		},
+		{
+			name:     "system info with wildcard",
+			match:    &api.EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(true), SystemInfo: &map[string]string{"productSerial": "SN-*", "customInfo.siteId": "paris-*"}},
+			facts:    facts,
+			expected: true,
+		},
+		{
+			name:     "system info mismatch",
+			match:    &api.EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(true), SystemInfo: &map[string]string{"architecture": "arm64"}},
+			facts:    facts,
+			expected: false,
+		},
+		{
+			name:     "system info not reported",
+			match:    &api.EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(true), SystemInfo: &map[string]string{"productModel": "*"}},
+			facts:    facts,
+			expected: false,
+		},
+		{
+			name:     "requested labels",
+			action:   api.EnrollmentPolicyActionReject,
+			match:    &api.EnrollmentPolicyMatch{Labels: &map[string]string{"region": "eu-*"}},
+			facts:    facts,
+			expected: true,
+		},
+		{
+			name:     "requested label missing",
+			action:   api.EnrollmentPolicyActionReject,
+			match:    &api.EnrollmentPolicyMatch{Labels: &map[string]string{"zone": "*"}},
+			facts:    facts,
+			expected: false,
+		},
+		{
+			name:     "wildcard does not match regular expression",
+			match:    &api.EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(true), SystemInfo: &map[string]string{"productSerial": "SN.4711"}},
+			facts:    facts,
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			action := lo.Ternary(tc.action != "", tc.action, api.EnrollmentPolicyActionApprove)
+			rule := &api.EnrollmentPolicyRule{Name: "rule", Action: action, Match: tc.match}
+			require.Equal(t, tc.expected, enrollmentPolicyRuleMatches(rule, tc.facts))
+		})
+	}
+}
+
+func TestApplyEnrollmentPolicies(t *testing.T) {
+	reject := api.EnrollmentPolicyRule{
+		Name:   "reject-arm",
+		Action: api.EnrollmentPolicyActionReject,
+		Match:  &api.EnrollmentPolicyMatch{SystemInfo: &map[string]string{"architecture": "arm64"}},
+	}
+	policies := []api.EnrollmentPolicy{
+		newTestEnrollmentPolicy("later", 10, api.EnrollmentPolicyRule{Name: "reject-all", Action: api.EnrollmentPolicyActionReject}),
+		newTestEnrollmentPolicy("first", 0, reject),
+	}
+
+	testCases := []struct {
+		name         string
+		architecture string
+		tpmReason    string
+		policyName   string
+		ruleName     string
+	}{
+		{
+			name:         "first matching rule of the policies in order of priority",
+			architecture: "arm64",
+			policyName:   "first",
+			ruleName:     "reject-arm",
+		},
+		{
+			name:         "rule of a later policy",
+			architecture: "amd64",
+			policyName:   "later",
+			ruleName:     "reject-all",
+		},
+		{
+			name:         "TPM credential challenge pending",
+			architecture: "arm64",
+			tpmReason:    api.TPMChallengeRequiredReason,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			ts := &TestStore{}
+			serviceHandler := &ServiceHandler{
+				eventHandler: NewEventHandler(ts, &DummyWorkerClient{}, log.InitLogs()),
+				store:        ts,
+				log:          log.InitLogs(),
+			}
+			ctx := context.Background()
+			for i := range policies {
+				_, err := ts.EnrollmentPolicy().Create(ctx, store.NullOrgId, &policies[i], nil)
+				require.NoError(err)
+			}
+
+			deviceStatus := api.NewDeviceStatus()
+			deviceStatus.SystemInfo.Architecture = tc.architecture
+			er := &api.EnrollmentRequest{
+				Metadata: api.ObjectMeta{Name: lo.ToPtr("er")},
+				Spec:     api.EnrollmentRequestSpec{Csr: "TestCSR", DeviceStatus: &deviceStatus},
+				Status:   &api.EnrollmentRequestStatus{Conditions: []api.Condition{}},
+			}
+			if tc.tpmReason != "" {
+				api.SetStatusCondition(&er.Status.Conditions, api.Condition{
+					Type:   api.ConditionTypeEnrollmentRequestTPMVerified,
+					Status: api.ConditionStatusFalse,
+					Reason: tc.tpmReason,
+				})
+			}
+			_, err := ts.EnrollmentRequest().Create(ctx, store.NullOrgId, er, nil)
+			require.NoError(err)
+
+			result := serviceHandler.applyEnrollmentPolicies(ctx, store.NullOrgId, er)
+			events, err := ts.Event().List(ctx, store.NullOrgId, store.ListParams{})
+			require.NoError(err)
+
+			condition := api.FindStatusCondition(result.Status.Conditions, api.ConditionTypeEnrollmentRequestApproved)
+			if tc.policyName == "" {
+				require.Nil(condition)
+				require.Empty(events.Items)
+				return
+			}
+			require.NotNil(condition)
+			require.Equal(api.ConditionStatusFalse, condition.Status)
+			require.Equal(api.EnrollmentRequestRejectedReason, condition.Reason)
+
+			require.Len(events.Items, 1)
+			require.Equal(api.EventReasonEnrollmentRequestRejected, events.Items[0].Reason)
+			require.Equal(api.Warning, events.Items[0].Type)
+			details, err := events.Items[0].Details.AsEnrollmentPolicyDecisionDetails()
+			require.NoError(err)
+			require.Equal(tc.policyName, details.PolicyName)
+			require.Equal(tc.ruleName, details.RuleName)
+			require.Equal(api.EnrollmentPolicyActionReject, details.Action)
+		})
+	}
+}
+
+func newTestEnrollmentPolicy(name string, priority int32, rules ...api.EnrollmentPolicyRule) api.EnrollmentPolicy {
+	return api.EnrollmentPolicy{
+		ApiVersion: api.EnrollmentPolicyAPIVersion,
+		Kind:       api.EnrollmentPolicyKind,
+		Metadata:   api.ObjectMeta{Name: lo.ToPtr(name)},
+		Spec:       api.EnrollmentPolicySpec{Priority: lo.ToPtr(priority), Rules: rules},
+	}
+}
@@ -64,7 +64,7 @@ func (h *ServiceHandler) verifyTPMEnrollmentRequest(er *api.EnrollmentRequest, n
 	return nil
 }
 
-func approveAndSignEnrollmentRequest(ctx context.Context, ca *crypto.CAClient, enrollmentRequest *api.EnrollmentRequest, approval *api.EnrollmentRequestApprovalStatus) error {
+func approveAndSignEnrollmentRequest(ctx context.Context, ca *crypto.CAClient, enrollmentRequest *api.EnrollmentRequest, approval *api.EnrollmentRequestApprovalStatus, reason string) error {
 	if enrollmentRequest == nil {
 		return errors.New("approveAndSignEnrollmentRequest: enrollmentRequest is nil")
 	}
@@ -104,7 +104,7 @@ func approveAndSignEnrollmentRequest(ctx context.Context, ca *crypto.CAClient, e
 	condition := api.Condition{
 		Type:    api.ConditionTypeEnrollmentRequestApproved,
 		Status:  api.ConditionStatusTrue,
-		Reason:  "ManuallyApproved",
+		Reason:  reason,
 		Message: "Approved by " + approval.ApprovedBy,
 	}
 	api.SetStatusCondition(&enrollmentRequest.Status.Conditions, condition)
@@ -291,6 +291,9 @@ func (h *ServiceHandler) CreateEnrollmentRequest(ctx context.Context, er api.Enr
 
 	// Use fromAPI=false for internal requests to preserve annotations
 	result, err := h.store.EnrollmentRequest().CreateWithFromAPI(ctx, orgId, &er, false, h.callbackEnrollmentRequestUpdated)
+	if err == nil {
+		result = h.applyEnrollmentPolicies(ctx, orgId, result)
+	}
 	return result, StoreErrorToApiStatus(err, true, api.EnrollmentRequestKind, er.Metadata.Name)
 }
 
@@ -362,6 +365,9 @@ func (h *ServiceHandler) ReplaceEnrollmentRequest(ctx context.Context, name stri
 	}
 
 	result, created, err := h.store.EnrollmentRequest().CreateOrUpdate(ctx, orgId, &er, h.callbackEnrollmentRequestUpdated)
+	if err == nil {
+		result = h.applyEnrollmentPolicies(ctx, orgId, result)
+	}
 	return result, StoreErrorToApiStatus(err, created, api.EnrollmentRequestKind, &name)
 }
 
@@ -469,7 +475,7 @@ func (h *ServiceHandler) ApproveEnrollmentRequest(ctx context.Context, name stri
 		}
 		approvalStatusToReturn = &approvalStatus
 
-		err = approveAndSignEnrollmentRequest(ctx, h.ca, enrollmentReq, &approvalStatus)
+		err = approveAndSignEnrollmentRequest(ctx, h.ca, enrollmentReq, &approvalStatus, api.EnrollmentRequestManuallyApprovedReason)
 		if err != nil {
 			status := api.StatusBadRequest(fmt.Sprintf("Error approving and signing enrollment request: %v", err.Error()))
 			h.CreateEvent(ctx, common.GetEnrollmentRequestApprovalFailedEvent(ctx, name, status, h.log))
@@ -495,6 +501,10 @@ func (h *ServiceHandler) ReplaceEnrollmentRequestStatus(ctx context.Context, nam
 	addStatusIfNeeded(&er)
 
 	result, err := h.store.EnrollmentRequest().UpdateStatus(ctx, orgId, &er, h.callbackEnrollmentRequestUpdated)
+	if err == nil {
+		// the status is updated when the TPM credential challenge completes, which may verify the TPM identity
+		result = h.applyEnrollmentPolicies(ctx, orgId, result)
+	}
 	return result, StoreErrorToApiStatus(err, false, api.EnrollmentRequestKind, &name)
 }
 
@@ -46,6 +46,8 @@ func (h *EventHandler) CreateEvent(ctx context.Context, event *api.Event) {
 		return
 	}
 
+	notifyWatchers(ctx, api.EventKind, orgId, lo.FromPtr(event.Metadata.Name), api.WatchEventAdded, nil)
+
 	if h.workerClient != nil {
 		h.workerClient.EmitEvent(ctx, orgId, event)
 	}
@@ -198,6 +200,7 @@ func (h *EventHandler) HandleFleetUpdatedEvents(ctx context.Context, resourceKin
 	h.emitFleetRolloutBatchCompletedEvent(ctx, name, deployingTemplateVersion, oldFleet, newFleet)
 	h.emitFleetRolloutCompletedEvent(ctx, name, deployingTemplateVersion, oldFleet, newFleet)
 	h.emitFleetRolloutFailedEvent(ctx, name, deployingTemplateVersion, oldFleet, newFleet)
+	h.emitFleetRolloutRolledBackEvent(ctx, name, deployingTemplateVersion, oldFleet, newFleet)
 }
 
 func (h *EventHandler) emitFleetRolloutNewEvent(ctx context.Context, name string, deployingTemplateVersion string, oldFleet, newFleet *api.Fleet) {
@@ -296,6 +299,30 @@ func (h *EventHandler) emitFleetRolloutFailedEvent(ctx context.Context, name str
 	h.CreateEvent(ctx, common.GetFleetRolloutFailedEvent(ctx, name, deployingTemplateVersion, newCondition.Message))
 }
 
+func (h *EventHandler) emitFleetRolloutRolledBackEvent(ctx context.Context, name string, deployingTemplateVersion string, oldFleet, newFleet *api.Fleet) {
+	if newFleet.Status == nil {
+		return
+	}
+	newCondition := api.FindStatusCondition(newFleet.Status.Conditions, api.ConditionTypeFleetRolloutInProgress)
+	if newCondition == nil || newCondition.Reason != api.RolloutRolledBackReason {
+		return
+	}
+	var oldConditions []api.Condition
+	if oldFleet != nil && oldFleet.Status != nil {
+		oldConditions = oldFleet.Status.Conditions
+	}
+	oldCondition := api.FindStatusCondition(oldConditions, api.ConditionTypeFleetRolloutInProgress)
+	if oldCondition != nil && oldCondition.Reason == api.RolloutRolledBackReason {
+		return
+	}
+	failedTemplateVersion, exists := newFleet.GetAnnotation(api.FleetAnnotationRolledBackTemplateVersion)
+	if !exists {
+		failedTemplateVersion = "unknown"
+	}
+
+	h.CreateEvent(ctx, common.GetFleetRolloutRolledBackEvent(ctx, name, failedTemplateVersion, deployingTemplateVersion, newCondition.Message))
+}
+
 //////////////////////////////////////////////////////
 //                    Repository Events             //
 //////////////////////////////////////////////////////
@@ -445,6 +472,63 @@ func (h *EventHandler) HandleCertificateSigningRequestUpdatedEvents(ctx context.
 	}
 }
 
+//////////////////////////////////////////////////////
+//               EventSubscription Events           //
+//////////////////////////////////////////////////////
+
+// HandleEventSubscriptionUpdatedEvents handles event subscription update event emission logic
+func (h *EventHandler) HandleEventSubscriptionUpdatedEvents(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+		return
+	}
+
+	var updateDetails *api.ResourceUpdatedDetails
+	if !created {
+		if oldSubscription, newSubscription, ok := castResources[api.EventSubscription](oldResource, newResource); ok && oldSubscription != nil && newSubscription != nil {
+			updateDetails = h.computeResourceUpdatedDetails(oldSubscription.Metadata, newSubscription.Metadata)
+		}
+	}
+	h.CreateEvent(ctx, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+}
+
+//////////////////////////////////////////////////////
+//              EnrollmentPolicy Events             //
+//////////////////////////////////////////////////////
+
+// HandleEnrollmentPolicyUpdatedEvents handles enrollment policy update event emission logic
+func (h *EventHandler) HandleEnrollmentPolicyUpdatedEvents(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+		return
+	}
+
+	var updateDetails *api.ResourceUpdatedDetails
+	if !created {
+		if oldPolicy, newPolicy, ok := castResources[api.EnrollmentPolicy](oldResource, newResource); ok && oldPolicy != nil && newPolicy != nil {
+			updateDetails = h.computeResourceUpdatedDetails(oldPolicy.Metadata, newPolicy.Metadata)
+		}
+	}
+	h.CreateEvent(ctx, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, updateDetails, h.log, nil))
+}
+
+//////////////////////////////////////////////////////
+//                BulkOperation Events              //
+//////////////////////////////////////////////////////
+
+// HandleBulkOperationCreatedEvents handles bulk operation creation event emission logic.  Bulk operations are never
+// updated through the API, so only their creation is reported.
+func (h *EventHandler) HandleBulkOperationCreatedEvents(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	if err != nil {
+		status := StoreErrorToApiStatus(err, created, string(resourceKind), &name)
+		h.CreateEvent(ctx, common.GetResourceCreatedOrUpdatedFailureEvent(ctx, created, resourceKind, name, status, nil))
+		return
+	}
+	h.CreateEvent(ctx, common.GetResourceCreatedOrUpdatedSuccessEvent(ctx, created, resourceKind, name, nil, h.log, nil))
+}
+
 //////////////////////////////////////////////////////
 //                TemplateVersion Events            //
 //////////////////////////////////////////////////////
@@ -530,9 +614,12 @@ func (h *EventHandler) emitResourceSyncConditionEvents(ctx context.Context, name
 	oldSynced := api.FindStatusCondition(oldConditions, api.ConditionTypeResourceSyncSynced)
 	newSynced := api.FindStatusCondition(newConditions, api.ConditionTypeResourceSyncSynced)
 	if hasConditionChanged(oldSynced, newSynced) {
-		if api.IsStatusConditionTrue(newConditions, api.ConditionTypeResourceSyncSynced) {
+		switch {
+		case api.IsStatusConditionTrue(newConditions, api.ConditionTypeResourceSyncSynced):
 			h.CreateEvent(ctx, common.GetResourceSyncSyncedEvent(ctx, name))
-		} else {
+		case newSynced != nil && newSynced.Reason == api.ResourceSyncDryRunReason:
+			// A dry run is not a failure, its changes are reported in the status
+		default:
 			message := "Resource sync failed"
 			if newSynced != nil && newSynced.Message != "" {
 				message = newSynced.Message
@@ -540,6 +627,17 @@ func (h *EventHandler) emitResourceSyncConditionEvents(ctx context.Context, name
 			h.CreateEvent(ctx, common.GetResourceSyncSyncFailedEvent(ctx, name, message))
 		}
 	}
+
+	// Drifted condition
+	oldDrifted := api.FindStatusCondition(oldConditions, api.ConditionTypeResourceSyncDrifted)
+	newDrifted := api.FindStatusCondition(newConditions, api.ConditionTypeResourceSyncDrifted)
+	if hasConditionChanged(oldDrifted, newDrifted) {
+		if api.IsStatusConditionTrue(newConditions, api.ConditionTypeResourceSyncDrifted) {
+			h.CreateEvent(ctx, common.GetResourceSyncDriftDetectedEvent(ctx, name, newDrifted.Message))
+		} else if api.IsStatusConditionTrue(oldConditions, api.ConditionTypeResourceSyncDrifted) {
+			h.CreateEvent(ctx, common.GetResourceSyncDriftResolvedEvent(ctx, name))
+		}
+	}
 }
 
 //////////////////////////////////////////////////////
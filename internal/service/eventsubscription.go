@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+func (h *ServiceHandler) CreateEventSubscription(ctx context.Context, subscription api.EventSubscription) (*api.EventSubscription, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	// don't set fields that are managed by the service
+	subscription.Status = nil
+	NilOutManagedObjectMetaProperties(&subscription.Metadata)
+
+	if errs := subscription.Validate(); len(errs) > 0 {
+		return nil, api.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if status := h.validateEventSubscriptionSelector(ctx, orgId, subscription.Spec); status != api.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.EventSubscription().Create(ctx, orgId, &subscription, h.callbackEventSubscriptionUpdated)
+	return result, StoreErrorToApiStatus(err, true, api.EventSubscriptionKind, subscription.Metadata.Name)
+}
+
+func (h *ServiceHandler) ListEventSubscriptions(ctx context.Context, params api.ListEventSubscriptionsParams) (*api.EventSubscriptionList, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	listParams, status := prepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
+	if status != api.StatusOK() {
+		return nil, status
+	}
+
+	result, err := h.store.EventSubscription().List(ctx, orgId, *listParams)
+	if err == nil {
+		return result, api.StatusOK()
+	}
+
+	var se *selector.SelectorError
+
+	switch {
+	case selector.AsSelectorError(err, &se):
+		return nil, api.StatusBadRequest(se.Error())
+	default:
+		return nil, api.StatusInternalServerError(err.Error())
+	}
+}
+
+func (h *ServiceHandler) GetEventSubscription(ctx context.Context, name string) (*api.EventSubscription, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	result, err := h.store.EventSubscription().Get(ctx, orgId, name)
+	return result, StoreErrorToApiStatus(err, false, api.EventSubscriptionKind, &name)
+}
+
+func (h *ServiceHandler) ReplaceEventSubscription(ctx context.Context, name string, subscription api.EventSubscription) (*api.EventSubscription, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	// don't overwrite fields that are managed by the service for external requests
+	if !IsInternalRequest(ctx) {
+		subscription.Status = nil
+		NilOutManagedObjectMetaProperties(&subscription.Metadata)
+	}
+
+	if errs := subscription.Validate(); len(errs) > 0 {
+		return nil, api.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *subscription.Metadata.Name {
+		return nil, api.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+	if status := h.validateEventSubscriptionSelector(ctx, orgId, subscription.Spec); status != api.StatusOK() {
+		return nil, status
+	}
+
+	result, created, err := h.store.EventSubscription().CreateOrUpdate(ctx, orgId, &subscription, h.callbackEventSubscriptionUpdated)
+	return result, StoreErrorToApiStatus(err, created, api.EventSubscriptionKind, &name)
+}
+
+func (h *ServiceHandler) DeleteEventSubscription(ctx context.Context, name string) api.Status {
+	orgId := getOrgIdFromContext(ctx)
+
+	err := h.store.EventSubscription().Delete(ctx, orgId, name, h.callbackEventSubscriptionDeleted)
+	return StoreErrorToApiStatus(err, false, api.EventSubscriptionKind, &name)
+}
+
+func (h *ServiceHandler) PatchEventSubscription(ctx context.Context, name string, patch api.PatchRequest) (*api.EventSubscription, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	currentObj, err := h.store.EventSubscription().Get(ctx, orgId, name)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, api.EventSubscriptionKind, &name)
+	}
+
+	newObj := &api.EventSubscription{}
+	err = ApplyJSONPatch(ctx, currentObj, newObj, patch, "/api/v1/eventsubscriptions/"+name)
+	if err != nil {
+		return nil, api.StatusBadRequest(err.Error())
+	}
+
+	if errs := newObj.Validate(); len(errs) > 0 {
+		return nil, api.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if errs := currentObj.ValidateUpdate(newObj); len(errs) > 0 {
+		return nil, api.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if status := h.validateEventSubscriptionSelector(ctx, orgId, newObj.Spec); status != api.StatusOK() {
+		return nil, status
+	}
+
+	NilOutManagedObjectMetaProperties(&newObj.Metadata)
+	newObj.Metadata.ResourceVersion = nil
+
+	result, err := h.store.EventSubscription().Update(ctx, orgId, newObj, h.callbackEventSubscriptionUpdated)
+	return result, StoreErrorToApiStatus(err, false, api.EventSubscriptionKind, &name)
+}
+
+// ReplaceEventSubscriptionStatus records the outcome of deliveries.  It is only used internally by the delivery task.
+func (h *ServiceHandler) ReplaceEventSubscriptionStatus(ctx context.Context, name string, subscription api.EventSubscription) (*api.EventSubscription, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	if name != lo.FromPtr(subscription.Metadata.Name) {
+		return nil, api.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	result, err := h.store.EventSubscription().UpdateStatus(ctx, orgId, &subscription)
+	return result, StoreErrorToApiStatus(err, false, api.EventSubscriptionKind, &name)
+}
+
+// validateEventSubscriptionSelector verifies that the field selector of the subscription can be used to list events
+func (h *ServiceHandler) validateEventSubscriptionSelector(ctx context.Context, orgId uuid.UUID, spec api.EventSubscriptionSpec) api.Status {
+	if spec.FieldSelector == nil {
+		return api.StatusOK()
+	}
+	fieldSelector, err := selector.NewFieldSelector(*spec.FieldSelector)
+	if err != nil {
+		return api.StatusBadRequest(fmt.Sprintf("spec.fieldSelector: failed to parse field selector: %v", err))
+	}
+	_, err = h.store.Event().List(ctx, orgId, store.ListParams{Limit: 1, FieldSelector: fieldSelector})
+	if err == nil {
+		return api.StatusOK()
+	}
+
+	var se *selector.SelectorError
+	if selector.AsSelectorError(err, &se) {
+		return api.StatusBadRequest(fmt.Sprintf("spec.fieldSelector: %s", se.Error()))
+	}
+	return api.StatusInternalServerError(err.Error())
+}
+
+// callbackEventSubscriptionUpdated is the event subscription-specific callback that handles event subscription update events
+func (h *ServiceHandler) callbackEventSubscriptionUpdated(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleEventSubscriptionUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
+
+// callbackEventSubscriptionDeleted is the event subscription-specific callback that handles event subscription deletion events
+func (h *ServiceHandler) callbackEventSubscriptionDeleted(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+}
@@ -3,13 +3,17 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 )
 
 func (h *ServiceHandler) CreateFleet(ctx context.Context, fleet api.Fleet) (*api.Fleet, api.Status) {
@@ -26,6 +30,12 @@ func (h *ServiceHandler) CreateFleet(ctx context.Context, fleet api.Fleet) (*api
 		return nil, api.StatusBadRequest(errors.Join(errs...).Error())
 	}
 
+	if IsDryRun(ctx) {
+		if status := h.checkFleetTemplate(ctx, orgId, &fleet); status.Code != http.StatusOK {
+			return nil, status
+		}
+	}
+
 	result, err := h.store.Fleet().Create(ctx, orgId, &fleet, h.callbackFleetUpdated)
 	return result, StoreErrorToApiStatus(err, true, api.FleetKind, fleet.Metadata.Name)
 }
@@ -80,6 +90,12 @@ func (h *ServiceHandler) ReplaceFleet(ctx context.Context, name string, fleet ap
 		return nil, api.StatusBadRequest("resource name specified in metadata does not match name in path")
 	}
 
+	if IsDryRun(ctx) {
+		if status := h.checkFleetTemplate(ctx, orgId, &fleet); status.Code != http.StatusOK {
+			return nil, status
+		}
+	}
+
 	result, created, err := h.store.Fleet().CreateOrUpdate(ctx, orgId, &fleet, nil, !isInternal, h.callbackFleetUpdated)
 	return result, StoreErrorToApiStatus(err, created, api.FleetKind, &name)
 }
@@ -114,6 +130,7 @@ func (h *ServiceHandler) ReplaceFleetStatus(ctx context.Context, name string, fl
 	orgId := getOrgIdFromContext(ctx)
 
 	result, err := h.store.Fleet().UpdateStatus(ctx, orgId, &fleet)
+	notifyWatchers(ctx, api.FleetKind, orgId, name, api.WatchEventModified, err)
 	return result, StoreErrorToApiStatus(err, false, api.FleetKind, &name)
 }
 
@@ -143,6 +160,12 @@ func (h *ServiceHandler) PatchFleet(ctx context.Context, name string, patch api.
 	NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
 
+	if IsDryRun(ctx) {
+		if status := h.checkFleetTemplate(ctx, orgId, newObj); status.Code != http.StatusOK {
+			return nil, status
+		}
+	}
+
 	result, err := h.store.Fleet().Update(ctx, orgId, newObj, nil, true, h.callbackFleetUpdated)
 	return result, StoreErrorToApiStatus(err, false, api.FleetKind, &name)
 }
@@ -188,12 +211,165 @@ func (h *ServiceHandler) GetFleetRepositoryRefs(ctx context.Context, name string
 	return result, StoreErrorToApiStatus(err, false, api.FleetKind, &name)
 }
 
+// getFleetWithDeviceSelection returns the fleet if its rollouts are processed by the device selection,
+// which is the only kind of rollout that can be paused, resumed or aborted.
+func (h *ServiceHandler) getFleetWithDeviceSelection(ctx context.Context, name string) (*api.Fleet, api.Status) {
+	fleet, status := h.GetFleet(ctx, name, api.GetFleetParams{})
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+	if fleet.Spec.RolloutPolicy == nil || fleet.Spec.RolloutPolicy.DeviceSelection == nil {
+		return nil, api.StatusBadRequest(fmt.Sprintf("fleet %s has no device selection in its rollout policy; its rollouts are applied immediately", name))
+	}
+	return fleet, api.StatusOK()
+}
+
+func rolloutConditionReason(fleet *api.Fleet) string {
+	if fleet.Status == nil {
+		return ""
+	}
+	condition := api.FindStatusCondition(fleet.Status.Conditions, api.ConditionTypeFleetRolloutInProgress)
+	if condition == nil {
+		return ""
+	}
+	return condition.Reason
+}
+
+// PauseFleetRollout stops the device selection from dispatching further batches of the fleet's rollout.  A fleet may
+// be paused before a rollout starts, in which case the next rollout starts paused.
+func (h *ServiceHandler) PauseFleetRollout(ctx context.Context, name string) (*api.Fleet, api.Status) {
+	fleet, status := h.getFleetWithDeviceSelection(ctx, name)
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+	if _, paused := fleet.GetAnnotation(api.FleetAnnotationRolloutPaused); paused {
+		return fleet, api.StatusOK()
+	}
+	status = h.UpdateFleetAnnotations(ctx, name, map[string]string{api.FleetAnnotationRolloutPaused: "true"}, nil)
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+	return h.GetFleet(ctx, name, api.GetFleetParams{})
+}
+
+// ResumeFleetRollout resumes a paused rollout.  If the rollout is suspended because a batch missed its success
+// threshold, or is waiting for approval, the current batch is approved as well.
+func (h *ServiceHandler) ResumeFleetRollout(ctx context.Context, name string) (*api.Fleet, api.Status) {
+	fleet, status := h.getFleetWithDeviceSelection(ctx, name)
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+	_, paused := fleet.GetAnnotation(api.FleetAnnotationRolloutPaused)
+	reason := rolloutConditionReason(fleet)
+	approve := reason == api.RolloutSuspendedReason || reason == api.RolloutWaitingReason
+	if !paused && !approve {
+		return nil, api.StatusConflict(fmt.Sprintf("rollout of fleet %s is not paused, suspended or waiting for approval", name))
+	}
+
+	annotations := map[string]string{}
+	if approve {
+		annotations[api.FleetAnnotationRolloutApproved] = "true"
+	}
+	status = h.UpdateFleetAnnotations(ctx, name, annotations, []string{api.FleetAnnotationRolloutPaused})
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+	return h.GetFleet(ctx, name, api.GetFleetParams{})
+}
+
+// AbortFleetRollout marks the rollout of the fleet's current template version as aborted.  The device selection then
+// rolls back the devices that were already updated to the fleet's previous template version.
+func (h *ServiceHandler) AbortFleetRollout(ctx context.Context, name string) (*api.Fleet, api.Status) {
+	fleet, status := h.getFleetWithDeviceSelection(ctx, name)
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+	if _, aborted := fleet.GetAnnotation(api.FleetAnnotationRolloutAborted); aborted {
+		return fleet, api.StatusOK()
+	}
+	if _, exists := fleet.GetAnnotation(api.FleetAnnotationDeployingTemplateVersion); !exists {
+		return nil, api.StatusConflict(fmt.Sprintf("fleet %s has no rollout to abort", name))
+	}
+	switch rolloutConditionReason(fleet) {
+	case api.RolloutInactiveReason, api.RolloutRolledBackReason, api.RolloutAbortedReason:
+		return nil, api.StatusConflict(fmt.Sprintf("rollout of fleet %s is not in progress", name))
+	}
+	status = h.UpdateFleetAnnotations(ctx, name, map[string]string{api.FleetAnnotationRolloutAborted: "true"}, nil)
+	if status.Code != http.StatusOK {
+		return nil, status
+	}
+	return h.GetFleet(ctx, name, api.GetFleetParams{})
+}
+
+// checkFleetTemplate checks that the template of a fleet can be rendered for each device that the fleet selects, and
+// that the repositories that its configuration references exist.  Otherwise these errors are only reported after the
+// fleet is persisted, in its conditions and in the annotations of the devices, so dry runs check them upfront.
+func (h *ServiceHandler) checkFleetTemplate(ctx context.Context, orgId uuid.UUID, fleet *api.Fleet) api.Status {
+	var errs []error
+	for _, configItem := range lo.FromPtr(fleet.Spec.Template.Spec.Config) {
+		repository := configRepository(configItem)
+		if repository == "" {
+			continue
+		}
+		if _, err := h.store.Repository().Get(ctx, orgId, repository); err != nil {
+			if !errors.Is(err, flterrors.ErrResourceNotFound) {
+				return StoreErrorToApiStatus(err, false, api.RepositoryKind, &repository)
+			}
+			errs = append(errs, fmt.Errorf("repository %s referenced by the template does not exist", repository))
+		}
+	}
+
+	// like the fleet selector, which assigns devices to fleets, only the match labels select devices
+	if matchLabels := fleetMatchLabels(fleet); len(matchLabels) > 0 {
+		labelSelector, err := selector.NewLabelSelectorFromMap(matchLabels)
+		if err != nil {
+			return api.StatusBadRequest(err.Error())
+		}
+		owner := util.SetResourceOwner(api.FleetKind, lo.FromPtr(fleet.Metadata.Name))
+		err = h.forEachDevice(ctx, orgId, store.ListParams{LabelSelector: labelSelector}, func(device *api.Device) {
+			device.Metadata.Owner = owner
+			if _, renderErrs := common.RenderDeviceSpec(device, &fleet.Spec.Template.Spec); len(renderErrs) > 0 {
+				errs = append(errs, fmt.Errorf("device %s: %w", lo.FromPtr(device.Metadata.Name), errors.Join(renderErrs...)))
+			}
+		})
+		if err != nil {
+			return StoreErrorToApiStatus(err, false, api.DeviceKind, nil)
+		}
+	}
+
+	if len(errs) > 0 {
+		return api.StatusBadRequest(fmt.Sprintf("invalid fleet template: %v", errors.Join(errs...)))
+	}
+	return api.StatusOK()
+}
+
+// configRepository returns the name of the repository that a config provider references, if any
+func configRepository(configItem api.ConfigProviderSpec) string {
+	configType, err := configItem.Type()
+	if err != nil {
+		return ""
+	}
+	switch configType {
+	case api.GitConfigProviderType:
+		if gitSpec, err := configItem.AsGitConfigProviderSpec(); err == nil {
+			return gitSpec.GitRef.Repository
+		}
+	case api.HttpConfigProviderType:
+		if httpSpec, err := configItem.AsHttpConfigProviderSpec(); err == nil {
+			return httpSpec.HttpRef.Repository
+		}
+	}
+	return ""
+}
+
 // callbackFleetUpdated is the fleet-specific callback that handles fleet events
 func (h *ServiceHandler) callbackFleetUpdated(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleFleetUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+	notifyWatchers(ctx, resourceKind, orgId, name, lo.Ternary(created, api.WatchEventAdded, api.WatchEventModified), err)
 }
 
 // callbackFleetDeleted is the fleet-specific callback that handles fleet deletion events
 func (h *ServiceHandler) callbackFleetDeleted(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+	notifyWatchers(ctx, resourceKind, orgId, name, api.WatchEventDeleted, err)
 }
@@ -219,3 +219,105 @@ func TestFleetNonExistingResource(t *testing.T) {
 	require.Equal(statusNotFoundCode, status.Code)
 	require.Nil(resp)
 }
+
+func TestFleetDryRunTemplateCheck(t *testing.T) {
+	require := require.New(t)
+	testStore := &TestStore{}
+	testStore.init()
+	*testStore.devices.devices = []api.Device{
+		{
+			Metadata: api.ObjectMeta{
+				Name:   lo.ToPtr("dev1"),
+				Labels: &map[string]string{"devKey": "devValue", "site": "factory-1"},
+			},
+		},
+		{
+			Metadata: api.ObjectMeta{
+				Name:   lo.ToPtr("dev2"),
+				Labels: &map[string]string{"devKey": "devValue"},
+			},
+		},
+	}
+	wc := &DummyWorkerClient{}
+	serviceHandler := &ServiceHandler{
+		eventHandler: NewEventHandler(testStore, wc, log.InitLogs()),
+		store:        testStore,
+		workerClient: wc,
+	}
+	ctx := WithDryRun(context.Background(), lo.ToPtr(api.DryRunAll))
+
+	newFleet := func(image string, config *[]api.ConfigProviderSpec) api.Fleet {
+		fleet := api.Fleet{
+			ApiVersion: "v1",
+			Kind:       "Fleet",
+			Metadata:   api.ObjectMeta{Name: lo.ToPtr("foo")},
+			Spec: api.FleetSpec{
+				Selector: &api.LabelSelector{
+					MatchLabels: &map[string]string{"devKey": "devValue"},
+				},
+			},
+		}
+		fleet.Spec.Template.Spec = api.DeviceSpec{
+			Os:     &api.DeviceOsSpec{Image: image},
+			Config: config,
+		}
+		return fleet
+	}
+
+	// dev2 doesn't have the site label used by the template
+	_, status := serviceHandler.CreateFleet(ctx, newFleet("quay.io/flightctl/device:{{ .metadata.labels.site }}", nil))
+	require.Equal(statusBadRequestCode, status.Code)
+	require.Contains(status.Message, "dev2")
+	require.NotContains(status.Message, "dev1")
+
+	gitConfig := api.ConfigProviderSpec{}
+	err := gitConfig.FromGitConfigProviderSpec(api.GitConfigProviderSpec{
+		Name: "git-config",
+		GitRef: struct {
+			Path           string "json:\"path\""
+			Repository     string "json:\"repository\""
+			TargetRevision string "json:\"targetRevision\""
+		}{Path: "/config", Repository: "missing", TargetRevision: "main"},
+	})
+	require.NoError(err)
+	_, status = serviceHandler.CreateFleet(ctx, newFleet("img", &[]api.ConfigProviderSpec{gitConfig}))
+	require.Equal(statusBadRequestCode, status.Code)
+	require.Contains(status.Message, "repository missing")
+
+	_, status = serviceHandler.CreateFleet(ctx, newFleet("img", nil))
+	require.Equal(statusCreatedCode, status.Code)
+
+	// like fleet ownership, only the match labels select the devices that are checked
+	fleet := newFleet("quay.io/flightctl/device:{{ .metadata.labels.site }}", nil)
+	fleet.Spec.Selector = &api.LabelSelector{
+		MatchExpressions: &api.MatchExpressions{{Key: "devKey", Operator: api.Exists}},
+	}
+	_, status = serviceHandler.CreateFleet(ctx, fleet)
+	require.Equal(statusCreatedCode, status.Code)
+}
+
+func TestPreviewDeviceOwner(t *testing.T) {
+	tests := []struct {
+		name           string
+		owner          *string
+		decommissioned bool
+		matchingFleets []string
+		expected       string
+	}{
+		{name: "single match", matchingFleets: []string{"a"}, expected: "Fleet/a"},
+		{name: "single match replaces owner", owner: lo.ToPtr("Fleet/b"), matchingFleets: []string{"a"}, expected: "Fleet/a"},
+		{name: "no match removes fleet owner", owner: lo.ToPtr("Fleet/a"), expected: ""},
+		{name: "no match keeps other owner", owner: lo.ToPtr("ResourceSync/rs"), expected: "ResourceSync/rs"},
+		{name: "multiple matches keep owner", owner: lo.ToPtr("Fleet/a"), matchingFleets: []string{"a", "b"}, expected: "Fleet/a"},
+		{name: "decommissioning keeps owner", owner: lo.ToPtr("Fleet/b"), decommissioned: true, matchingFleets: []string{"a"}, expected: "Fleet/b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			device := &api.Device{Metadata: api.ObjectMeta{Name: lo.ToPtr("dev"), Owner: tt.owner}}
+			if tt.decommissioned {
+				device.Spec = &api.DeviceSpec{Decommissioning: &api.DeviceDecommission{}}
+			}
+			require.Equal(t, tt.expected, previewDeviceOwner(device, tt.matchingFleets))
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+const (
+	defaultFleetPreviewSampleSize = 5
+	maxFleetPreviewSampleSize     = 100
+	// maxFleetPreviewDevices is the maximum number of devices in each list of a fleet preview
+	maxFleetPreviewDevices = 1000
+)
+
+// PreviewFleet reports the devices that replacing a fleet with the given one would affect, without replacing it.
+// Device ownership is computed as the fleet selector matching task does: a device belongs to the single fleet whose
+// selector matches its labels, keeps its owner while several fleets match, and loses it when none does.  The
+// template is rendered from the fleet's spec, so git revisions are not resolved as they are for a template version.
+func (h *ServiceHandler) PreviewFleet(ctx context.Context, name string, fleet api.Fleet, params api.PreviewFleetParams) (*api.FleetPreview, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	fleet.Status = nil
+	NilOutManagedObjectMetaProperties(&fleet.Metadata)
+	if fleet.Spec.Template.Metadata != nil {
+		NilOutManagedObjectMetaProperties(fleet.Spec.Template.Metadata)
+	}
+	if errs := fleet.Validate(); len(errs) > 0 {
+		return nil, api.StatusBadRequest(errors.Join(errs...).Error())
+	}
+	if name != *fleet.Metadata.Name {
+		return nil, api.StatusBadRequest("resource name specified in metadata does not match name in path")
+	}
+
+	sampleSize := defaultFleetPreviewSampleSize
+	if params.SampleSize != nil {
+		if *params.SampleSize < 0 || *params.SampleSize > maxFleetPreviewSampleSize {
+			return nil, api.StatusBadRequest(fmt.Sprintf("sampleSize must be between 0 and %d", maxFleetPreviewSampleSize))
+		}
+		sampleSize = int(*params.SampleSize)
+	}
+
+	currentFleets, err := h.listAllFleets(ctx, orgId)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, api.FleetKind, nil)
+	}
+	proposedFleets := lo.Reject(currentFleets, func(f api.Fleet, _ int) bool { return lo.FromPtr(f.Metadata.Name) == name })
+	proposedFleets = append(proposedFleets, fleet)
+
+	p := &fleetPreview{
+		owner:          *util.SetResourceOwner(api.FleetKind, name),
+		template:       &fleet.Spec.Template.Spec,
+		currentFleets:  currentFleets,
+		proposedFleets: proposedFleets,
+		sampleSize:     sampleSize,
+		preview: api.FleetPreview{
+			DevicesGained: []api.FleetPreviewDevice{},
+			DevicesLost:   []api.FleetPreviewDevice{},
+			NewConflicts:  []api.FleetPreviewDevice{},
+			Samples:       []api.FleetPreviewSample{},
+		},
+	}
+
+	// the devices that the fleet's selector matches after the change
+	matchLabels := fleetMatchLabels(&fleet)
+	if len(matchLabels) > 0 {
+		labelSelector, err := selector.NewLabelSelectorFromMap(matchLabels)
+		if err != nil {
+			return nil, api.StatusBadRequest(err.Error())
+		}
+		err = h.forEachDevice(ctx, orgId, store.ListParams{LabelSelector: labelSelector}, func(device *api.Device) {
+			p.preview.Summary.MatchingDevices++
+			p.addDevice(device)
+		})
+		if err != nil {
+			return nil, StoreErrorToApiStatus(err, false, api.DeviceKind, nil)
+		}
+	}
+
+	// the devices that the fleet owns before the change and that its selector no longer matches
+	ownerSelector, err := selector.NewFieldSelectorFromMap(map[string]string{"metadata.owner": p.owner})
+	if err != nil {
+		return nil, api.StatusInternalServerError(err.Error())
+	}
+	err = h.forEachDevice(ctx, orgId, store.ListParams{FieldSelector: ownerSelector}, func(device *api.Device) {
+		if !util.LabelsMatchLabelSelector(lo.FromPtr(device.Metadata.Labels), matchLabels) {
+			p.addDevice(device)
+		}
+	})
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, api.DeviceKind, nil)
+	}
+
+	return &p.preview, api.StatusOK()
+}
+
+// fleetPreview accumulates the devices affected by a change to a fleet
+type fleetPreview struct {
+	owner          string
+	template       *api.DeviceSpec
+	currentFleets  []api.Fleet
+	proposedFleets []api.Fleet
+	sampleSize     int
+	preview        api.FleetPreview
+}
+
+func (p *fleetPreview) addDevice(device *api.Device) {
+	labels := lo.FromPtr(device.Metadata.Labels)
+	currentOwner := lo.FromPtr(device.Metadata.Owner)
+	matchingFleets := matchingFleetNames(labels, p.proposedFleets)
+	newOwner := previewDeviceOwner(device, matchingFleets)
+
+	previewDevice := api.FleetPreviewDevice{
+		Name:           lo.FromPtr(device.Metadata.Name),
+		CurrentOwner:   lo.EmptyableToPtr(currentOwner),
+		NewOwner:       lo.EmptyableToPtr(newOwner),
+		MatchingFleets: lo.EmptyableToPtr(matchingFleets),
+	}
+
+	if len(matchingFleets) > 1 && len(matchingFleetNames(labels, p.currentFleets)) <= 1 {
+		p.preview.Summary.NewConflicts++
+		if len(p.preview.NewConflicts) < maxFleetPreviewDevices {
+			p.preview.NewConflicts = append(p.preview.NewConflicts, previewDevice)
+		}
+	}
+	switch {
+	case newOwner == p.owner && currentOwner != p.owner:
+		p.preview.Summary.DevicesGained++
+		if len(p.preview.DevicesGained) < maxFleetPreviewDevices {
+			p.preview.DevicesGained = append(p.preview.DevicesGained, previewDevice)
+		}
+	case newOwner != p.owner && currentOwner == p.owner:
+		p.preview.Summary.DevicesLost++
+		if len(p.preview.DevicesLost) < maxFleetPreviewDevices {
+			p.preview.DevicesLost = append(p.preview.DevicesLost, previewDevice)
+		}
+	}
+
+	if newOwner == p.owner && len(p.preview.Samples) < p.sampleSize {
+		p.preview.Samples = append(p.preview.Samples, p.renderSample(device))
+	}
+}
+
+func (p *fleetPreview) renderSample(device *api.Device) api.FleetPreviewSample {
+	sample := api.FleetPreviewSample{
+		Name:        lo.FromPtr(device.Metadata.Name),
+		CurrentSpec: device.Spec,
+	}
+	owned := *device
+	owned.Metadata.Owner = lo.ToPtr(p.owner)
+	renderedSpec, errs := common.RenderDeviceSpec(&owned, p.template)
+	if len(errs) > 0 {
+		sample.Errors = lo.ToPtr(lo.Map(errs, func(err error, _ int) string { return err.Error() }))
+		return sample
+	}
+	sample.RenderedSpec = renderedSpec
+	return sample
+}
+
+// previewDeviceOwner returns the owner of a device once the fleet selector matching task has processed it
+func previewDeviceOwner(device *api.Device, matchingFleets []string) string {
+	currentOwner := lo.FromPtr(device.Metadata.Owner)
+	// the owner of a decommissioning device is not updated
+	if device.Spec != nil && device.Spec.Decommissioning != nil {
+		return currentOwner
+	}
+	switch len(matchingFleets) {
+	case 0:
+		if ownerKind, _, err := util.GetResourceOwner(device.Metadata.Owner); err == nil && ownerKind == api.FleetKind {
+			return ""
+		}
+		return currentOwner
+	case 1:
+		return *util.SetResourceOwner(api.FleetKind, matchingFleets[0])
+	default:
+		return currentOwner
+	}
+}
+
+func matchingFleetNames(labels map[string]string, fleets []api.Fleet) []string {
+	var names []string
+	for i := range fleets {
+		if util.LabelsMatchLabelSelector(labels, fleetMatchLabels(&fleets[i])) {
+			names = append(names, lo.FromPtr(fleets[i].Metadata.Name))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func fleetMatchLabels(fleet *api.Fleet) map[string]string {
+	return lo.FromPtr(lo.FromPtr(fleet.Spec.Selector).MatchLabels)
+}
+
+func (h *ServiceHandler) listAllFleets(ctx context.Context, orgId uuid.UUID) ([]api.Fleet, error) {
+	var fleets []api.Fleet
+	listParams := store.ListParams{Limit: MaxRecordsPerListRequest}
+	for {
+		result, err := h.store.Fleet().List(ctx, orgId, listParams)
+		if err != nil {
+			return nil, err
+		}
+		fleets = append(fleets, result.Items...)
+		if result.Metadata.Continue == nil {
+			return fleets, nil
+		}
+		if listParams.Continue, err = store.ParseContinueString(result.Metadata.Continue); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (h *ServiceHandler) forEachDevice(ctx context.Context, orgId uuid.UUID, listParams store.ListParams, fn func(device *api.Device)) error {
+	listParams.Limit = MaxRecordsPerListRequest
+	for {
+		result, err := h.store.Device().List(ctx, orgId, listParams)
+		if err != nil {
+			return err
+		}
+		for i := range result.Items {
+			fn(&result.Items[i])
+		}
+		if result.Metadata.Continue == nil {
+			return nil
+		}
+		if listParams.Continue, err = store.ParseContinueString(result.Metadata.Continue); err != nil {
+			return err
+		}
+	}
+}
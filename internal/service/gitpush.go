@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/samber/lo"
+)
+
+// GitPush is a push to a git repository, as notified by the webhook of a git server
+type GitPush struct {
+	// RepositoryURLs are the URLs the pushed repository can be cloned from, e.g. its HTTPS and SSH URLs
+	RepositoryURLs []string
+	// Ref is the pushed ref, e.g. refs/heads/main
+	Ref string
+	// DefaultBranch is the name of the default branch of the repository, if known
+	DefaultBranch string
+}
+
+// HandleGitPush requests an immediate sync of the ResourceSyncs of the organization that track the pushed ref of the
+// repository, and notifies the referers of the Repository.  It returns the objects that were notified.  The webhook
+// of a Repository is verified with its own secret, so only the ResourceSyncs of its organization are synced.
+func (h *ServiceHandler) HandleGitPush(ctx context.Context, repository string, push GitPush) ([]api.ObjectReference, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+
+	pushedURLs := lo.FilterMap(push.RepositoryURLs, func(u string, _ int) (string, bool) {
+		normalized := NormalizeGitURL(u)
+		return normalized, normalized != ""
+	})
+	if len(pushedURLs) == 0 {
+		return nil, api.StatusBadRequest("push does not specify the URL of the repository")
+	}
+
+	repo, err := h.store.Repository().Get(ctx, orgId, repository)
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, api.RepositoryKind, &repository)
+	}
+	spec, err := repo.Spec.AsGenericRepoSpec()
+	if err != nil || spec.Type != api.Git {
+		return nil, api.StatusBadRequest(fmt.Sprintf("repository %q is not a git repository", repository))
+	}
+	if !lo.Contains(pushedURLs, NormalizeGitURL(spec.Url)) {
+		return nil, api.StatusBadRequest(fmt.Sprintf("pushed repository does not match the URL of repository %q", repository))
+	}
+
+	h.CreateEvent(ctx, common.GetRepositoryPushReceivedEvent(ctx, repository, push.Ref))
+	triggered := []api.ObjectReference{{Kind: api.RepositoryKind, Name: repository}}
+
+	resourceSyncs, err := h.store.ResourceSync().List(ctx, orgId, store.ListParams{})
+	if err != nil {
+		return nil, StoreErrorToApiStatus(err, false, api.ResourceSyncKind, nil)
+	}
+	for _, rs := range resourceSyncs.Items {
+		if rs.Spec.Repository != repository {
+			continue
+		}
+		if !gitRefMatchesRevision(push.Ref, push.DefaultBranch, rs.Spec.TargetRevision) {
+			continue
+		}
+		name := lo.FromPtr(rs.Metadata.Name)
+		h.CreateEvent(ctx, common.GetResourceSyncSyncRequestedEvent(ctx, name, rs.Spec.Repository))
+		triggered = append(triggered, api.ObjectReference{Kind: api.ResourceSyncKind, Name: name})
+	}
+	return triggered, api.StatusOK()
+}
+
+// NormalizeGitURL returns the host and path of a git URL, so that the HTTPS and SSH URLs of the same repository
+// can be compared.  For example, both https://user@GitHub.com/org/repo.git and git@github.com:org/repo are
+// normalized to github.com/org/repo.
+func NormalizeGitURL(rawURL string) string {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return ""
+	}
+
+	var host, path string
+	if parsed, err := url.Parse(rawURL); err == nil && parsed.Scheme != "" && parsed.Host != "" {
+		host, path = parsed.Hostname(), parsed.Path
+		if port := parsed.Port(); port != "" && parsed.Scheme != "ssh" {
+			host += ":" + port
+		}
+	} else if at := strings.Index(rawURL, "@"); at >= 0 && strings.Contains(rawURL[at:], ":") {
+		// scp-like syntax: [user@]host:path
+		hostAndPath := rawURL[at+1:]
+		colon := strings.Index(hostAndPath, ":")
+		host, path = hostAndPath[:colon], hostAndPath[colon+1:]
+	} else {
+		return ""
+	}
+
+	path = strings.Trim(path, "/")
+	path = strings.TrimSuffix(path, ".git")
+	return strings.ToLower(host) + "/" + path
+}
+
+// gitRefMatchesRevision returns true if the pushed ref is the target revision of a ResourceSync.  An empty or HEAD
+// target revision tracks the default branch.
+func gitRefMatchesRevision(ref, defaultBranch, targetRevision string) bool {
+	if targetRevision == "" || targetRevision == "HEAD" {
+		return defaultBranch != "" && ref == "refs/heads/"+defaultBranch
+	}
+	return ref == targetRevision || ref == "refs/heads/"+targetRevision || ref == "refs/tags/"+targetRevision
+}
@@ -43,6 +43,21 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// AbortFleetRollout mocks base method.
+func (m *MockService) AbortFleetRollout(ctx context.Context, name string) (*v1alpha1.Fleet, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortFleetRollout", ctx, name)
+	ret0, _ := ret[0].(*v1alpha1.Fleet)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// AbortFleetRollout indicates an expected call of AbortFleetRollout.
+func (mr *MockServiceMockRecorder) AbortFleetRollout(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortFleetRollout", reflect.TypeOf((*MockService)(nil).AbortFleetRollout), ctx, name)
+}
+
 // ApproveEnrollmentRequest mocks base method.
 func (m *MockService) ApproveEnrollmentRequest(ctx context.Context, name string, approval v1alpha1.EnrollmentRequestApproval) (*v1alpha1.EnrollmentRequestApprovalStatus, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -58,6 +73,21 @@ func (mr *MockServiceMockRecorder) ApproveEnrollmentRequest(ctx, name, approval
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveEnrollmentRequest", reflect.TypeOf((*MockService)(nil).ApproveEnrollmentRequest), ctx, name, approval)
 }
 
+// CancelBulkOperation mocks base method.
+func (m *MockService) CancelBulkOperation(ctx context.Context, name string) (*v1alpha1.BulkOperation, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelBulkOperation", ctx, name)
+	ret0, _ := ret[0].(*v1alpha1.BulkOperation)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// CancelBulkOperation indicates an expected call of CancelBulkOperation.
+func (mr *MockServiceMockRecorder) CancelBulkOperation(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelBulkOperation", reflect.TypeOf((*MockService)(nil).CancelBulkOperation), ctx, name)
+}
+
 // CountDevices mocks base method.
 func (m *MockService) CountDevices(ctx context.Context, params v1alpha1.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (int64, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -88,6 +118,21 @@ func (mr *MockServiceMockRecorder) CountDevicesByLabels(ctx, params, annotationS
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDevicesByLabels", reflect.TypeOf((*MockService)(nil).CountDevicesByLabels), ctx, params, annotationSelector, groupBy)
 }
 
+// CreateBulkOperation mocks base method.
+func (m *MockService) CreateBulkOperation(ctx context.Context, operation v1alpha1.BulkOperation) (*v1alpha1.BulkOperation, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBulkOperation", ctx, operation)
+	ret0, _ := ret[0].(*v1alpha1.BulkOperation)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// CreateBulkOperation indicates an expected call of CreateBulkOperation.
+func (mr *MockServiceMockRecorder) CreateBulkOperation(ctx, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBulkOperation", reflect.TypeOf((*MockService)(nil).CreateBulkOperation), ctx, operation)
+}
+
 // CreateCertificateSigningRequest mocks base method.
 func (m *MockService) CreateCertificateSigningRequest(ctx context.Context, csr v1alpha1.CertificateSigningRequest) (*v1alpha1.CertificateSigningRequest, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -103,6 +148,21 @@ func (mr *MockServiceMockRecorder) CreateCertificateSigningRequest(ctx, csr any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCertificateSigningRequest", reflect.TypeOf((*MockService)(nil).CreateCertificateSigningRequest), ctx, csr)
 }
 
+// CreateConsoleSession mocks base method.
+func (m *MockService) CreateConsoleSession(ctx context.Context, session v1alpha1.ConsoleSession) (*v1alpha1.ConsoleSession, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConsoleSession", ctx, session)
+	ret0, _ := ret[0].(*v1alpha1.ConsoleSession)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// CreateConsoleSession indicates an expected call of CreateConsoleSession.
+func (mr *MockServiceMockRecorder) CreateConsoleSession(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConsoleSession", reflect.TypeOf((*MockService)(nil).CreateConsoleSession), ctx, session)
+}
+
 // CreateDevice mocks base method.
 func (m *MockService) CreateDevice(ctx context.Context, device v1alpha1.Device) (*v1alpha1.Device, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -118,6 +178,51 @@ func (mr *MockServiceMockRecorder) CreateDevice(ctx, device any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDevice", reflect.TypeOf((*MockService)(nil).CreateDevice), ctx, device)
 }
 
+// CreateDeviceAttestation mocks base method.
+func (m *MockService) CreateDeviceAttestation(ctx context.Context, name string, attestation v1alpha1.DeviceAttestation) (*v1alpha1.DeviceIntegrityStatus, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeviceAttestation", ctx, name, attestation)
+	ret0, _ := ret[0].(*v1alpha1.DeviceIntegrityStatus)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// CreateDeviceAttestation indicates an expected call of CreateDeviceAttestation.
+func (mr *MockServiceMockRecorder) CreateDeviceAttestation(ctx, name, attestation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeviceAttestation", reflect.TypeOf((*MockService)(nil).CreateDeviceAttestation), ctx, name, attestation)
+}
+
+// CreateDeviceAttestationChallenge mocks base method.
+func (m *MockService) CreateDeviceAttestationChallenge(ctx context.Context, name string) (*v1alpha1.DeviceAttestationChallenge, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeviceAttestationChallenge", ctx, name)
+	ret0, _ := ret[0].(*v1alpha1.DeviceAttestationChallenge)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// CreateDeviceAttestationChallenge indicates an expected call of CreateDeviceAttestationChallenge.
+func (mr *MockServiceMockRecorder) CreateDeviceAttestationChallenge(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeviceAttestationChallenge", reflect.TypeOf((*MockService)(nil).CreateDeviceAttestationChallenge), ctx, name)
+}
+
+// CreateEnrollmentPolicy mocks base method.
+func (m *MockService) CreateEnrollmentPolicy(ctx context.Context, policy v1alpha1.EnrollmentPolicy) (*v1alpha1.EnrollmentPolicy, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEnrollmentPolicy", ctx, policy)
+	ret0, _ := ret[0].(*v1alpha1.EnrollmentPolicy)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// CreateEnrollmentPolicy indicates an expected call of CreateEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) CreateEnrollmentPolicy(ctx, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).CreateEnrollmentPolicy), ctx, policy)
+}
+
 // CreateEnrollmentRequest mocks base method.
 func (m *MockService) CreateEnrollmentRequest(ctx context.Context, er v1alpha1.EnrollmentRequest) (*v1alpha1.EnrollmentRequest, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -145,6 +250,21 @@ func (mr *MockServiceMockRecorder) CreateEvent(ctx, event any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockService)(nil).CreateEvent), ctx, event)
 }
 
+// CreateEventSubscription mocks base method.
+func (m *MockService) CreateEventSubscription(ctx context.Context, subscription v1alpha1.EventSubscription) (*v1alpha1.EventSubscription, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEventSubscription", ctx, subscription)
+	ret0, _ := ret[0].(*v1alpha1.EventSubscription)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// CreateEventSubscription indicates an expected call of CreateEventSubscription.
+func (mr *MockServiceMockRecorder) CreateEventSubscription(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEventSubscription", reflect.TypeOf((*MockService)(nil).CreateEventSubscription), ctx, subscription)
+}
+
 // CreateFleet mocks base method.
 func (m *MockService) CreateFleet(ctx context.Context, fleet v1alpha1.Fleet) (*v1alpha1.Fleet, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -235,6 +355,20 @@ func (mr *MockServiceMockRecorder) DecommissionDevice(ctx, name, decom any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecommissionDevice", reflect.TypeOf((*MockService)(nil).DecommissionDevice), ctx, name, decom)
 }
 
+// DeleteBulkOperation mocks base method.
+func (m *MockService) DeleteBulkOperation(ctx context.Context, name string) v1alpha1.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBulkOperation", ctx, name)
+	ret0, _ := ret[0].(v1alpha1.Status)
+	return ret0
+}
+
+// DeleteBulkOperation indicates an expected call of DeleteBulkOperation.
+func (mr *MockServiceMockRecorder) DeleteBulkOperation(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBulkOperation", reflect.TypeOf((*MockService)(nil).DeleteBulkOperation), ctx, name)
+}
+
 // DeleteCertificateSigningRequest mocks base method.
 func (m *MockService) DeleteCertificateSigningRequest(ctx context.Context, name string) v1alpha1.Status {
 	m.ctrl.T.Helper()
@@ -263,6 +397,20 @@ func (mr *MockServiceMockRecorder) DeleteDevice(ctx, name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevice", reflect.TypeOf((*MockService)(nil).DeleteDevice), ctx, name)
 }
 
+// DeleteEnrollmentPolicy mocks base method.
+func (m *MockService) DeleteEnrollmentPolicy(ctx context.Context, name string) v1alpha1.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEnrollmentPolicy", ctx, name)
+	ret0, _ := ret[0].(v1alpha1.Status)
+	return ret0
+}
+
+// DeleteEnrollmentPolicy indicates an expected call of DeleteEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) DeleteEnrollmentPolicy(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).DeleteEnrollmentPolicy), ctx, name)
+}
+
 // DeleteEnrollmentRequest mocks base method.
 func (m *MockService) DeleteEnrollmentRequest(ctx context.Context, name string) v1alpha1.Status {
 	m.ctrl.T.Helper()
@@ -277,6 +425,20 @@ func (mr *MockServiceMockRecorder) DeleteEnrollmentRequest(ctx, name any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEnrollmentRequest", reflect.TypeOf((*MockService)(nil).DeleteEnrollmentRequest), ctx, name)
 }
 
+// DeleteEventSubscription mocks base method.
+func (m *MockService) DeleteEventSubscription(ctx context.Context, name string) v1alpha1.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventSubscription", ctx, name)
+	ret0, _ := ret[0].(v1alpha1.Status)
+	return ret0
+}
+
+// DeleteEventSubscription indicates an expected call of DeleteEventSubscription.
+func (mr *MockServiceMockRecorder) DeleteEventSubscription(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventSubscription", reflect.TypeOf((*MockService)(nil).DeleteEventSubscription), ctx, name)
+}
+
 // DeleteEventsOlderThan mocks base method.
 func (m *MockService) DeleteEventsOlderThan(ctx context.Context, cutoffTime time.Time) (int64, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -292,6 +454,21 @@ func (mr *MockServiceMockRecorder) DeleteEventsOlderThan(ctx, cutoffTime any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventsOlderThan", reflect.TypeOf((*MockService)(nil).DeleteEventsOlderThan), ctx, cutoffTime)
 }
 
+// DeleteExpiredCertificateRevocations mocks base method.
+func (m *MockService) DeleteExpiredCertificateRevocations(ctx context.Context) (int64, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredCertificateRevocations", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// DeleteExpiredCertificateRevocations indicates an expected call of DeleteExpiredCertificateRevocations.
+func (mr *MockServiceMockRecorder) DeleteExpiredCertificateRevocations(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredCertificateRevocations", reflect.TypeOf((*MockService)(nil).DeleteExpiredCertificateRevocations), ctx)
+}
+
 // DeleteFleet mocks base method.
 func (m *MockService) DeleteFleet(ctx context.Context, name string) v1alpha1.Status {
 	m.ctrl.T.Helper()
@@ -377,6 +554,36 @@ func (mr *MockServiceMockRecorder) GenerateContainerfile(ctx, imageBuildSpec, en
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateContainerfile", reflect.TypeOf((*MockService)(nil).GenerateContainerfile), ctx, imageBuildSpec, enrollmentCert)
 }
 
+// GetBulkOperation mocks base method.
+func (m *MockService) GetBulkOperation(ctx context.Context, name string) (*v1alpha1.BulkOperation, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBulkOperation", ctx, name)
+	ret0, _ := ret[0].(*v1alpha1.BulkOperation)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// GetBulkOperation indicates an expected call of GetBulkOperation.
+func (mr *MockServiceMockRecorder) GetBulkOperation(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBulkOperation", reflect.TypeOf((*MockService)(nil).GetBulkOperation), ctx, name)
+}
+
+// GetCertificateRevocationList mocks base method.
+func (m *MockService) GetCertificateRevocationList(ctx context.Context) ([]byte, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificateRevocationList", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// GetCertificateRevocationList indicates an expected call of GetCertificateRevocationList.
+func (mr *MockServiceMockRecorder) GetCertificateRevocationList(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateRevocationList", reflect.TypeOf((*MockService)(nil).GetCertificateRevocationList), ctx)
+}
+
 // GetCertificateSigningRequest mocks base method.
 func (m *MockService) GetCertificateSigningRequest(ctx context.Context, name string) (*v1alpha1.CertificateSigningRequest, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -407,6 +614,21 @@ func (mr *MockServiceMockRecorder) GetCheckpoint(ctx, consumer, key any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckpoint", reflect.TypeOf((*MockService)(nil).GetCheckpoint), ctx, consumer, key)
 }
 
+// GetConsoleSession mocks base method.
+func (m *MockService) GetConsoleSession(ctx context.Context, name string) (*v1alpha1.ConsoleSession, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConsoleSession", ctx, name)
+	ret0, _ := ret[0].(*v1alpha1.ConsoleSession)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// GetConsoleSession indicates an expected call of GetConsoleSession.
+func (mr *MockServiceMockRecorder) GetConsoleSession(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConsoleSession", reflect.TypeOf((*MockService)(nil).GetConsoleSession), ctx, name)
+}
+
 // GetDatabaseTime mocks base method.
 func (m *MockService) GetDatabaseTime(ctx context.Context) (time.Time, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -527,6 +749,21 @@ func (mr *MockServiceMockRecorder) GetEnrollmentConfig(ctx, params any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentConfig", reflect.TypeOf((*MockService)(nil).GetEnrollmentConfig), ctx, params)
 }
 
+// GetEnrollmentPolicy mocks base method.
+func (m *MockService) GetEnrollmentPolicy(ctx context.Context, name string) (*v1alpha1.EnrollmentPolicy, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEnrollmentPolicy", ctx, name)
+	ret0, _ := ret[0].(*v1alpha1.EnrollmentPolicy)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// GetEnrollmentPolicy indicates an expected call of GetEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) GetEnrollmentPolicy(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).GetEnrollmentPolicy), ctx, name)
+}
+
 // GetEnrollmentRequest mocks base method.
 func (m *MockService) GetEnrollmentRequest(ctx context.Context, name string) (*v1alpha1.EnrollmentRequest, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -557,6 +794,21 @@ func (mr *MockServiceMockRecorder) GetEnrollmentRequestStatus(ctx, name any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEnrollmentRequestStatus", reflect.TypeOf((*MockService)(nil).GetEnrollmentRequestStatus), ctx, name)
 }
 
+// GetEventSubscription mocks base method.
+func (m *MockService) GetEventSubscription(ctx context.Context, name string) (*v1alpha1.EventSubscription, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventSubscription", ctx, name)
+	ret0, _ := ret[0].(*v1alpha1.EventSubscription)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// GetEventSubscription indicates an expected call of GetEventSubscription.
+func (mr *MockServiceMockRecorder) GetEventSubscription(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSubscription", reflect.TypeOf((*MockService)(nil).GetEventSubscription), ctx, name)
+}
+
 // GetFleet mocks base method.
 func (m *MockService) GetFleet(ctx context.Context, name string, params v1alpha1.GetFleetParams) (*v1alpha1.Fleet, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -662,6 +914,21 @@ func (mr *MockServiceMockRecorder) GetLatestTemplateVersion(ctx, fleet any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestTemplateVersion", reflect.TypeOf((*MockService)(nil).GetLatestTemplateVersion), ctx, fleet)
 }
 
+// GetOCSPResponse mocks base method.
+func (m *MockService) GetOCSPResponse(ctx context.Context, request []byte) ([]byte, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOCSPResponse", ctx, request)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// GetOCSPResponse indicates an expected call of GetOCSPResponse.
+func (mr *MockServiceMockRecorder) GetOCSPResponse(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOCSPResponse", reflect.TypeOf((*MockService)(nil).GetOCSPResponse), ctx, request)
+}
+
 // GetRenderedDevice mocks base method.
 func (m *MockService) GetRenderedDevice(ctx context.Context, name string, params v1alpha1.GetRenderedDeviceParams) (*v1alpha1.Device, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -752,6 +1019,36 @@ func (mr *MockServiceMockRecorder) GetTemplateVersion(ctx, fleet, name any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateVersion", reflect.TypeOf((*MockService)(nil).GetTemplateVersion), ctx, fleet, name)
 }
 
+// HandleGitPush mocks base method.
+func (m *MockService) HandleGitPush(ctx context.Context, repository string, push GitPush) ([]v1alpha1.ObjectReference, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleGitPush", ctx, repository, push)
+	ret0, _ := ret[0].([]v1alpha1.ObjectReference)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// HandleGitPush indicates an expected call of HandleGitPush.
+func (mr *MockServiceMockRecorder) HandleGitPush(ctx, repository, push any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleGitPush", reflect.TypeOf((*MockService)(nil).HandleGitPush), ctx, repository, push)
+}
+
+// ListBulkOperations mocks base method.
+func (m *MockService) ListBulkOperations(ctx context.Context, params v1alpha1.ListBulkOperationsParams) (*v1alpha1.BulkOperationList, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBulkOperations", ctx, params)
+	ret0, _ := ret[0].(*v1alpha1.BulkOperationList)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// ListBulkOperations indicates an expected call of ListBulkOperations.
+func (mr *MockServiceMockRecorder) ListBulkOperations(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBulkOperations", reflect.TypeOf((*MockService)(nil).ListBulkOperations), ctx, params)
+}
+
 // ListCertificateSigningRequests mocks base method.
 func (m *MockService) ListCertificateSigningRequests(ctx context.Context, params v1alpha1.ListCertificateSigningRequestsParams) (*v1alpha1.CertificateSigningRequestList, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -767,6 +1064,21 @@ func (mr *MockServiceMockRecorder) ListCertificateSigningRequests(ctx, params an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCertificateSigningRequests", reflect.TypeOf((*MockService)(nil).ListCertificateSigningRequests), ctx, params)
 }
 
+// ListConsoleSessions mocks base method.
+func (m *MockService) ListConsoleSessions(ctx context.Context, params v1alpha1.ListConsoleSessionsParams) (*v1alpha1.ConsoleSessionList, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConsoleSessions", ctx, params)
+	ret0, _ := ret[0].(*v1alpha1.ConsoleSessionList)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// ListConsoleSessions indicates an expected call of ListConsoleSessions.
+func (mr *MockServiceMockRecorder) ListConsoleSessions(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConsoleSessions", reflect.TypeOf((*MockService)(nil).ListConsoleSessions), ctx, params)
+}
+
 // ListDevices mocks base method.
 func (m *MockService) ListDevices(ctx context.Context, params v1alpha1.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (*v1alpha1.DeviceList, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -827,6 +1139,21 @@ func (mr *MockServiceMockRecorder) ListDisruptionBudgetFleets(ctx any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDisruptionBudgetFleets", reflect.TypeOf((*MockService)(nil).ListDisruptionBudgetFleets), ctx)
 }
 
+// ListEnrollmentPolicies mocks base method.
+func (m *MockService) ListEnrollmentPolicies(ctx context.Context, params v1alpha1.ListEnrollmentPoliciesParams) (*v1alpha1.EnrollmentPolicyList, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnrollmentPolicies", ctx, params)
+	ret0, _ := ret[0].(*v1alpha1.EnrollmentPolicyList)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// ListEnrollmentPolicies indicates an expected call of ListEnrollmentPolicies.
+func (mr *MockServiceMockRecorder) ListEnrollmentPolicies(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnrollmentPolicies", reflect.TypeOf((*MockService)(nil).ListEnrollmentPolicies), ctx, params)
+}
+
 // ListEnrollmentRequests mocks base method.
 func (m *MockService) ListEnrollmentRequests(ctx context.Context, params v1alpha1.ListEnrollmentRequestsParams) (*v1alpha1.EnrollmentRequestList, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -842,6 +1169,21 @@ func (mr *MockServiceMockRecorder) ListEnrollmentRequests(ctx, params any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnrollmentRequests", reflect.TypeOf((*MockService)(nil).ListEnrollmentRequests), ctx, params)
 }
 
+// ListEventSubscriptions mocks base method.
+func (m *MockService) ListEventSubscriptions(ctx context.Context, params v1alpha1.ListEventSubscriptionsParams) (*v1alpha1.EventSubscriptionList, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventSubscriptions", ctx, params)
+	ret0, _ := ret[0].(*v1alpha1.EventSubscriptionList)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// ListEventSubscriptions indicates an expected call of ListEventSubscriptions.
+func (mr *MockServiceMockRecorder) ListEventSubscriptions(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventSubscriptions", reflect.TypeOf((*MockService)(nil).ListEventSubscriptions), ctx, params)
+}
+
 // ListEvents mocks base method.
 func (m *MockService) ListEvents(ctx context.Context, params v1alpha1.ListEventsParams) (*v1alpha1.EventList, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -1074,6 +1416,21 @@ func (mr *MockServiceMockRecorder) PatchDeviceStatus(ctx, name, patch any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchDeviceStatus", reflect.TypeOf((*MockService)(nil).PatchDeviceStatus), ctx, name, patch)
 }
 
+// PatchEnrollmentPolicy mocks base method.
+func (m *MockService) PatchEnrollmentPolicy(ctx context.Context, name string, patch v1alpha1.PatchRequest) (*v1alpha1.EnrollmentPolicy, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchEnrollmentPolicy", ctx, name, patch)
+	ret0, _ := ret[0].(*v1alpha1.EnrollmentPolicy)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// PatchEnrollmentPolicy indicates an expected call of PatchEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) PatchEnrollmentPolicy(ctx, name, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).PatchEnrollmentPolicy), ctx, name, patch)
+}
+
 // PatchEnrollmentRequest mocks base method.
 func (m *MockService) PatchEnrollmentRequest(ctx context.Context, name string, patch v1alpha1.PatchRequest) (*v1alpha1.EnrollmentRequest, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -1089,6 +1446,21 @@ func (mr *MockServiceMockRecorder) PatchEnrollmentRequest(ctx, name, patch any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchEnrollmentRequest", reflect.TypeOf((*MockService)(nil).PatchEnrollmentRequest), ctx, name, patch)
 }
 
+// PatchEventSubscription mocks base method.
+func (m *MockService) PatchEventSubscription(ctx context.Context, name string, patch v1alpha1.PatchRequest) (*v1alpha1.EventSubscription, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchEventSubscription", ctx, name, patch)
+	ret0, _ := ret[0].(*v1alpha1.EventSubscription)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// PatchEventSubscription indicates an expected call of PatchEventSubscription.
+func (mr *MockServiceMockRecorder) PatchEventSubscription(ctx, name, patch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchEventSubscription", reflect.TypeOf((*MockService)(nil).PatchEventSubscription), ctx, name, patch)
+}
+
 // PatchFleet mocks base method.
 func (m *MockService) PatchFleet(ctx context.Context, name string, patch v1alpha1.PatchRequest) (*v1alpha1.Fleet, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -1164,6 +1536,51 @@ func (mr *MockServiceMockRecorder) PatchResourceSync(ctx, name, patch any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchResourceSync", reflect.TypeOf((*MockService)(nil).PatchResourceSync), ctx, name, patch)
 }
 
+// PauseFleetRollout mocks base method.
+func (m *MockService) PauseFleetRollout(ctx context.Context, name string) (*v1alpha1.Fleet, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseFleetRollout", ctx, name)
+	ret0, _ := ret[0].(*v1alpha1.Fleet)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// PauseFleetRollout indicates an expected call of PauseFleetRollout.
+func (mr *MockServiceMockRecorder) PauseFleetRollout(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseFleetRollout", reflect.TypeOf((*MockService)(nil).PauseFleetRollout), ctx, name)
+}
+
+// PreviewFleet mocks base method.
+func (m *MockService) PreviewFleet(ctx context.Context, name string, fleet v1alpha1.Fleet, params v1alpha1.PreviewFleetParams) (*v1alpha1.FleetPreview, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewFleet", ctx, name, fleet, params)
+	ret0, _ := ret[0].(*v1alpha1.FleetPreview)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// PreviewFleet indicates an expected call of PreviewFleet.
+func (mr *MockServiceMockRecorder) PreviewFleet(ctx, name, fleet, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewFleet", reflect.TypeOf((*MockService)(nil).PreviewFleet), ctx, name, fleet, params)
+}
+
+// ReplaceBulkOperationStatus mocks base method.
+func (m *MockService) ReplaceBulkOperationStatus(ctx context.Context, name string, operation v1alpha1.BulkOperation) (*v1alpha1.BulkOperation, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceBulkOperationStatus", ctx, name, operation)
+	ret0, _ := ret[0].(*v1alpha1.BulkOperation)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// ReplaceBulkOperationStatus indicates an expected call of ReplaceBulkOperationStatus.
+func (mr *MockServiceMockRecorder) ReplaceBulkOperationStatus(ctx, name, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceBulkOperationStatus", reflect.TypeOf((*MockService)(nil).ReplaceBulkOperationStatus), ctx, name, operation)
+}
+
 // ReplaceCertificateSigningRequest mocks base method.
 func (m *MockService) ReplaceCertificateSigningRequest(ctx context.Context, name string, csr v1alpha1.CertificateSigningRequest) (*v1alpha1.CertificateSigningRequest, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -1179,6 +1596,21 @@ func (mr *MockServiceMockRecorder) ReplaceCertificateSigningRequest(ctx, name, c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceCertificateSigningRequest", reflect.TypeOf((*MockService)(nil).ReplaceCertificateSigningRequest), ctx, name, csr)
 }
 
+// ReplaceConsoleSessionStatus mocks base method.
+func (m *MockService) ReplaceConsoleSessionStatus(ctx context.Context, name string, session v1alpha1.ConsoleSession) (*v1alpha1.ConsoleSession, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceConsoleSessionStatus", ctx, name, session)
+	ret0, _ := ret[0].(*v1alpha1.ConsoleSession)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// ReplaceConsoleSessionStatus indicates an expected call of ReplaceConsoleSessionStatus.
+func (mr *MockServiceMockRecorder) ReplaceConsoleSessionStatus(ctx, name, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceConsoleSessionStatus", reflect.TypeOf((*MockService)(nil).ReplaceConsoleSessionStatus), ctx, name, session)
+}
+
 // ReplaceDevice mocks base method.
 func (m *MockService) ReplaceDevice(ctx context.Context, name string, device v1alpha1.Device, fieldsToUnset []string) (*v1alpha1.Device, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -1209,6 +1641,21 @@ func (mr *MockServiceMockRecorder) ReplaceDeviceStatus(ctx, name, device any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceDeviceStatus", reflect.TypeOf((*MockService)(nil).ReplaceDeviceStatus), ctx, name, device)
 }
 
+// ReplaceEnrollmentPolicy mocks base method.
+func (m *MockService) ReplaceEnrollmentPolicy(ctx context.Context, name string, policy v1alpha1.EnrollmentPolicy) (*v1alpha1.EnrollmentPolicy, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceEnrollmentPolicy", ctx, name, policy)
+	ret0, _ := ret[0].(*v1alpha1.EnrollmentPolicy)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// ReplaceEnrollmentPolicy indicates an expected call of ReplaceEnrollmentPolicy.
+func (mr *MockServiceMockRecorder) ReplaceEnrollmentPolicy(ctx, name, policy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceEnrollmentPolicy", reflect.TypeOf((*MockService)(nil).ReplaceEnrollmentPolicy), ctx, name, policy)
+}
+
 // ReplaceEnrollmentRequest mocks base method.
 func (m *MockService) ReplaceEnrollmentRequest(ctx context.Context, name string, er v1alpha1.EnrollmentRequest) (*v1alpha1.EnrollmentRequest, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -1239,6 +1686,36 @@ func (mr *MockServiceMockRecorder) ReplaceEnrollmentRequestStatus(ctx, name, er
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceEnrollmentRequestStatus", reflect.TypeOf((*MockService)(nil).ReplaceEnrollmentRequestStatus), ctx, name, er)
 }
 
+// ReplaceEventSubscription mocks base method.
+func (m *MockService) ReplaceEventSubscription(ctx context.Context, name string, subscription v1alpha1.EventSubscription) (*v1alpha1.EventSubscription, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceEventSubscription", ctx, name, subscription)
+	ret0, _ := ret[0].(*v1alpha1.EventSubscription)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// ReplaceEventSubscription indicates an expected call of ReplaceEventSubscription.
+func (mr *MockServiceMockRecorder) ReplaceEventSubscription(ctx, name, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceEventSubscription", reflect.TypeOf((*MockService)(nil).ReplaceEventSubscription), ctx, name, subscription)
+}
+
+// ReplaceEventSubscriptionStatus mocks base method.
+func (m *MockService) ReplaceEventSubscriptionStatus(ctx context.Context, name string, subscription v1alpha1.EventSubscription) (*v1alpha1.EventSubscription, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceEventSubscriptionStatus", ctx, name, subscription)
+	ret0, _ := ret[0].(*v1alpha1.EventSubscription)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// ReplaceEventSubscriptionStatus indicates an expected call of ReplaceEventSubscriptionStatus.
+func (mr *MockServiceMockRecorder) ReplaceEventSubscriptionStatus(ctx, name, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceEventSubscriptionStatus", reflect.TypeOf((*MockService)(nil).ReplaceEventSubscriptionStatus), ctx, name, subscription)
+}
+
 // ReplaceFleet mocks base method.
 func (m *MockService) ReplaceFleet(ctx context.Context, name string, fleet v1alpha1.Fleet) (*v1alpha1.Fleet, v1alpha1.Status) {
 	m.ctrl.T.Helper()
@@ -1374,6 +1851,36 @@ func (mr *MockServiceMockRecorder) ResumeDevices(ctx, request any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeDevices", reflect.TypeOf((*MockService)(nil).ResumeDevices), ctx, request)
 }
 
+// ResumeFleetRollout mocks base method.
+func (m *MockService) ResumeFleetRollout(ctx context.Context, name string) (*v1alpha1.Fleet, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeFleetRollout", ctx, name)
+	ret0, _ := ret[0].(*v1alpha1.Fleet)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// ResumeFleetRollout indicates an expected call of ResumeFleetRollout.
+func (mr *MockServiceMockRecorder) ResumeFleetRollout(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeFleetRollout", reflect.TypeOf((*MockService)(nil).ResumeFleetRollout), ctx, name)
+}
+
+// SearchDevices mocks base method.
+func (m *MockService) SearchDevices(ctx context.Context, params v1alpha1.SearchDevicesParams) (*v1alpha1.DeviceSearchResultList, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchDevices", ctx, params)
+	ret0, _ := ret[0].(*v1alpha1.DeviceSearchResultList)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// SearchDevices indicates an expected call of SearchDevices.
+func (mr *MockServiceMockRecorder) SearchDevices(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchDevices", reflect.TypeOf((*MockService)(nil).SearchDevices), ctx, params)
+}
+
 // SetCheckpoint mocks base method.
 func (m *MockService) SetCheckpoint(ctx context.Context, consumer, key string, value []byte) v1alpha1.Status {
 	m.ctrl.T.Helper()
@@ -1543,3 +2050,62 @@ func (mr *MockServiceMockRecorder) UpdateServiceSideDeviceStatus(ctx, device any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceSideDeviceStatus", reflect.TypeOf((*MockService)(nil).UpdateServiceSideDeviceStatus), ctx, device)
 }
+
+// WatchDevices mocks base method.
+func (m *MockService) WatchDevices(ctx context.Context, params v1alpha1.ListDevicesParams) (<-chan v1alpha1.WatchEvent, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchDevices", ctx, params)
+	ret0, _ := ret[0].(<-chan v1alpha1.WatchEvent)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// WatchDevices indicates an expected call of WatchDevices.
+func (mr *MockServiceMockRecorder) WatchDevices(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchDevices", reflect.TypeOf((*MockService)(nil).WatchDevices), ctx, params)
+}
+
+// WatchEvents mocks base method.
+func (m *MockService) WatchEvents(ctx context.Context, params v1alpha1.ListEventsParams) (<-chan v1alpha1.WatchEvent, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchEvents", ctx, params)
+	ret0, _ := ret[0].(<-chan v1alpha1.WatchEvent)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// WatchEvents indicates an expected call of WatchEvents.
+func (mr *MockServiceMockRecorder) WatchEvents(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchEvents", reflect.TypeOf((*MockService)(nil).WatchEvents), ctx, params)
+}
+
+// WatchFleets mocks base method.
+func (m *MockService) WatchFleets(ctx context.Context, params v1alpha1.ListFleetsParams) (<-chan v1alpha1.WatchEvent, v1alpha1.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchFleets", ctx, params)
+	ret0, _ := ret[0].(<-chan v1alpha1.WatchEvent)
+	ret1, _ := ret[1].(v1alpha1.Status)
+	return ret0, ret1
+}
+
+// WatchFleets indicates an expected call of WatchFleets.
+func (mr *MockServiceMockRecorder) WatchFleets(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchFleets", reflect.TypeOf((*MockService)(nil).WatchFleets), ctx, params)
+}
+
+// WithResourceSyncLock mocks base method.
+func (m *MockService) WithResourceSyncLock(ctx context.Context, name string, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithResourceSyncLock", ctx, name, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithResourceSyncLock indicates an expected call of WithResourceSyncLock.
+func (mr *MockServiceMockRecorder) WithResourceSyncLock(ctx, name, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithResourceSyncLock", reflect.TypeOf((*MockService)(nil).WithResourceSyncLock), ctx, name, fn)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -59,7 +60,8 @@ func (h *ServiceHandler) ReplaceRepository(ctx context.Context, name string, rep
 	orgId := getOrgIdFromContext(ctx)
 
 	// don't overwrite fields that are managed by the service for external requests
-	if !IsInternalRequest(ctx) {
+	isInternal := IsInternalRequest(ctx)
+	if !isInternal {
 		repository.Status = nil
 		NilOutManagedObjectMetaProperties(&repository.Metadata)
 	}
@@ -71,13 +73,27 @@ func (h *ServiceHandler) ReplaceRepository(ctx context.Context, name string, rep
 		return nil, api.StatusBadRequest("resource name specified in metadata does not match name in path")
 	}
 
-	result, created, err := h.store.Repository().CreateOrUpdate(ctx, orgId, &repository, h.callbackRepositoryUpdated)
+	result, created, err := h.store.Repository().CreateOrUpdate(ctx, orgId, &repository, !isInternal, h.callbackRepositoryUpdated)
 	return result, StoreErrorToApiStatus(err, created, api.RepositoryKind, &name)
 }
 
 func (h *ServiceHandler) DeleteRepository(ctx context.Context, name string) api.Status {
 	orgId := getOrgIdFromContext(ctx)
 
+	if !IsInternalRequest(ctx) {
+		r, err := h.store.Repository().Get(ctx, orgId, name)
+		if err != nil {
+			if errors.Is(err, flterrors.ErrResourceNotFound) {
+				return api.StatusOK() // idempotent delete
+			}
+			return StoreErrorToApiStatus(err, false, api.RepositoryKind, &name)
+		}
+		if r.Metadata.Owner != nil {
+			// Can't delete via api
+			return api.StatusConflict("unauthorized to delete repository because it is owned by another resource")
+		}
+	}
+
 	err := h.store.Repository().Delete(ctx, orgId, name, h.callbackRepositoryDeleted)
 	return StoreErrorToApiStatus(err, false, api.RepositoryKind, &name)
 }
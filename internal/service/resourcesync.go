@@ -76,7 +76,13 @@ func (h *ServiceHandler) DeleteResourceSync(ctx context.Context, name string) ap
 	orgId := getOrgIdFromContext(ctx)
 
 	callback := func(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error {
-		return h.store.Fleet().UnsetOwner(ctx, tx, orgId, owner)
+		if err := h.store.Fleet().UnsetOwner(ctx, tx, orgId, owner); err != nil {
+			return err
+		}
+		if err := h.store.Repository().UnsetOwner(ctx, tx, orgId, owner); err != nil {
+			return err
+		}
+		return h.store.Device().UnsetResourceSync(ctx, tx, orgId, name)
 	}
 
 	err := h.store.ResourceSync().Delete(ctx, orgId, name, callback, h.callbackResourceSyncDeleted)
@@ -126,6 +132,14 @@ func (h *ServiceHandler) ReplaceResourceSyncStatus(ctx context.Context, name str
 	return result, StoreErrorToApiStatus(err, false, api.ResourceSyncKind, &name)
 }
 
+// WithResourceSyncLock runs fn while holding the sync lock of the resource sync, so that syncs of the same resource
+// sync are serialized across the processes that run them
+func (h *ServiceHandler) WithResourceSyncLock(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	orgId := getOrgIdFromContext(ctx)
+
+	return h.store.ResourceSync().WithSyncLock(ctx, orgId, name, fn)
+}
+
 // callbackResourceSyncUpdated is the resource sync-specific callback that handles resource sync events
 func (h *ServiceHandler) callbackResourceSyncUpdated(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.eventHandler.HandleResourceSyncUpdatedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
@@ -22,7 +22,9 @@ type Service interface {
 	// Device
 	CreateDevice(ctx context.Context, device api.Device) (*api.Device, api.Status)
 	ListDevices(ctx context.Context, params api.ListDevicesParams, annotationSelector *selector.AnnotationSelector) (*api.DeviceList, api.Status)
+	WatchDevices(ctx context.Context, params api.ListDevicesParams) (<-chan api.WatchEvent, api.Status)
 	ListDevicesByServiceCondition(ctx context.Context, conditionType string, conditionStatus string, listParams store.ListParams) (*api.DeviceList, api.Status)
+	SearchDevices(ctx context.Context, params api.SearchDevicesParams) (*api.DeviceSearchResultList, api.Status)
 	UpdateDevice(ctx context.Context, name string, device api.Device, fieldsToUnset []string) (*api.Device, error)
 	GetDevice(ctx context.Context, name string) (*api.Device, api.Status)
 	ReplaceDevice(ctx context.Context, name string, device api.Device, fieldsToUnset []string) (*api.Device, api.Status)
@@ -52,9 +54,18 @@ type Service interface {
 	UpdateServerSideDeviceStatus(ctx context.Context, name string) error
 	ListDisconnectedDevices(ctx context.Context, params api.ListDevicesParams, cutoffTime time.Time) (*api.DeviceList, api.Status)
 
+	// DeviceAttestation
+	CreateDeviceAttestationChallenge(ctx context.Context, name string) (*api.DeviceAttestationChallenge, api.Status)
+	CreateDeviceAttestation(ctx context.Context, name string, attestation api.DeviceAttestation) (*api.DeviceIntegrityStatus, api.Status)
+
 	// EnrollmentConfig
 	GetEnrollmentConfig(ctx context.Context, params api.GetEnrollmentConfigParams) (*api.EnrollmentConfig, api.Status)
 
+	// CertificateRevocation
+	GetCertificateRevocationList(ctx context.Context) ([]byte, api.Status)
+	GetOCSPResponse(ctx context.Context, request []byte) ([]byte, api.Status)
+	DeleteExpiredCertificateRevocations(ctx context.Context) (int64, api.Status)
+
 	//EnrollmentRequest
 	CreateEnrollmentRequest(ctx context.Context, er api.EnrollmentRequest) (*api.EnrollmentRequest, api.Status)
 	ListEnrollmentRequests(ctx context.Context, params api.ListEnrollmentRequestsParams) (*api.EnrollmentRequestList, api.Status)
@@ -69,12 +80,17 @@ type Service interface {
 	// Fleet
 	CreateFleet(ctx context.Context, fleet api.Fleet) (*api.Fleet, api.Status)
 	ListFleets(ctx context.Context, params api.ListFleetsParams) (*api.FleetList, api.Status)
+	WatchFleets(ctx context.Context, params api.ListFleetsParams) (<-chan api.WatchEvent, api.Status)
 	GetFleet(ctx context.Context, name string, params api.GetFleetParams) (*api.Fleet, api.Status)
 	ReplaceFleet(ctx context.Context, name string, fleet api.Fleet) (*api.Fleet, api.Status)
 	DeleteFleet(ctx context.Context, name string) api.Status
 	GetFleetStatus(ctx context.Context, name string) (*api.Fleet, api.Status)
 	ReplaceFleetStatus(ctx context.Context, name string, fleet api.Fleet) (*api.Fleet, api.Status)
 	PatchFleet(ctx context.Context, name string, patch api.PatchRequest) (*api.Fleet, api.Status)
+	PauseFleetRollout(ctx context.Context, name string) (*api.Fleet, api.Status)
+	ResumeFleetRollout(ctx context.Context, name string) (*api.Fleet, api.Status)
+	AbortFleetRollout(ctx context.Context, name string) (*api.Fleet, api.Status)
+	PreviewFleet(ctx context.Context, name string, fleet api.Fleet, params api.PreviewFleetParams) (*api.FleetPreview, api.Status)
 	ListFleetRolloutDeviceSelection(ctx context.Context) (*api.FleetList, api.Status)
 	ListDisruptionBudgetFleets(ctx context.Context) (*api.FleetList, api.Status)
 	UpdateFleetConditions(ctx context.Context, name string, conditions []api.Condition) api.Status
@@ -117,6 +133,8 @@ type Service interface {
 	DeleteResourceSync(ctx context.Context, name string) api.Status
 	PatchResourceSync(ctx context.Context, name string, patch api.PatchRequest) (*api.ResourceSync, api.Status)
 	ReplaceResourceSyncStatus(ctx context.Context, name string, resourceSync api.ResourceSync) (*api.ResourceSync, api.Status)
+	WithResourceSyncLock(ctx context.Context, name string, fn func(ctx context.Context) error) error
+	HandleGitPush(ctx context.Context, repository string, push GitPush) ([]api.ObjectReference, api.Status)
 
 	// TemplateVersion
 	CreateTemplateVersion(ctx context.Context, tv api.TemplateVersion, immediateRollout bool) (*api.TemplateVersion, api.Status)
@@ -128,8 +146,40 @@ type Service interface {
 	// Event
 	CreateEvent(ctx context.Context, event *api.Event)
 	ListEvents(ctx context.Context, params api.ListEventsParams) (*api.EventList, api.Status)
+	WatchEvents(ctx context.Context, params api.ListEventsParams) (<-chan api.WatchEvent, api.Status)
 	DeleteEventsOlderThan(ctx context.Context, cutoffTime time.Time) (int64, api.Status)
 
+	// EventSubscription
+	CreateEventSubscription(ctx context.Context, subscription api.EventSubscription) (*api.EventSubscription, api.Status)
+	ListEventSubscriptions(ctx context.Context, params api.ListEventSubscriptionsParams) (*api.EventSubscriptionList, api.Status)
+	GetEventSubscription(ctx context.Context, name string) (*api.EventSubscription, api.Status)
+	ReplaceEventSubscription(ctx context.Context, name string, subscription api.EventSubscription) (*api.EventSubscription, api.Status)
+	DeleteEventSubscription(ctx context.Context, name string) api.Status
+	PatchEventSubscription(ctx context.Context, name string, patch api.PatchRequest) (*api.EventSubscription, api.Status)
+	ReplaceEventSubscriptionStatus(ctx context.Context, name string, subscription api.EventSubscription) (*api.EventSubscription, api.Status)
+
+	// EnrollmentPolicy
+	CreateEnrollmentPolicy(ctx context.Context, policy api.EnrollmentPolicy) (*api.EnrollmentPolicy, api.Status)
+	ListEnrollmentPolicies(ctx context.Context, params api.ListEnrollmentPoliciesParams) (*api.EnrollmentPolicyList, api.Status)
+	GetEnrollmentPolicy(ctx context.Context, name string) (*api.EnrollmentPolicy, api.Status)
+	ReplaceEnrollmentPolicy(ctx context.Context, name string, policy api.EnrollmentPolicy) (*api.EnrollmentPolicy, api.Status)
+	DeleteEnrollmentPolicy(ctx context.Context, name string) api.Status
+	PatchEnrollmentPolicy(ctx context.Context, name string, patch api.PatchRequest) (*api.EnrollmentPolicy, api.Status)
+
+	// BulkOperation
+	CreateBulkOperation(ctx context.Context, operation api.BulkOperation) (*api.BulkOperation, api.Status)
+	ListBulkOperations(ctx context.Context, params api.ListBulkOperationsParams) (*api.BulkOperationList, api.Status)
+	GetBulkOperation(ctx context.Context, name string) (*api.BulkOperation, api.Status)
+	DeleteBulkOperation(ctx context.Context, name string) api.Status
+	CancelBulkOperation(ctx context.Context, name string) (*api.BulkOperation, api.Status)
+	ReplaceBulkOperationStatus(ctx context.Context, name string, operation api.BulkOperation) (*api.BulkOperation, api.Status)
+
+	// ConsoleSession
+	CreateConsoleSession(ctx context.Context, session api.ConsoleSession) (*api.ConsoleSession, api.Status)
+	ListConsoleSessions(ctx context.Context, params api.ListConsoleSessionsParams) (*api.ConsoleSessionList, api.Status)
+	GetConsoleSession(ctx context.Context, name string) (*api.ConsoleSession, api.Status)
+	ReplaceConsoleSessionStatus(ctx context.Context, name string, session api.ConsoleSession) (*api.ConsoleSession, api.Status)
+
 	// Checkpoint
 	GetCheckpoint(ctx context.Context, consumer string, key string) ([]byte, api.Status)
 	SetCheckpoint(ctx context.Context, consumer string, key string, value []byte) api.Status
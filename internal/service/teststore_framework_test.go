@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/flterrors"
@@ -28,6 +29,11 @@ type TestStore struct {
 	repositories       *DummyRepository
 	resourceSyncVals   *DummyResourceSync
 	enrollmentRequests *DummyEnrollmentRequest
+	enrollmentPolicies *DummyEnrollmentPolicy
+	csrs               *DummyCertificateSigningRequest
+	revocations        *DummyCertificateRevocation
+	consoleSessions    *DummyConsoleSession
+	checkpoints        *DummyCheckpoint
 	organizations      *DummyOrganization
 }
 
@@ -61,6 +67,31 @@ type DummyEnrollmentRequest struct {
 	enrollmentRequests *[]api.EnrollmentRequest
 }
 
+type DummyEnrollmentPolicy struct {
+	store.EnrollmentPolicy
+	enrollmentPolicies *[]api.EnrollmentPolicy
+}
+
+type DummyCertificateSigningRequest struct {
+	store.CertificateSigningRequest
+	csrs *[]api.CertificateSigningRequest
+}
+
+type DummyCertificateRevocation struct {
+	store.CertificateRevocation
+	revocations *[]model.CertificateRevocation
+}
+
+type DummyConsoleSession struct {
+	store.ConsoleSession
+	consoleSessions *[]api.ConsoleSession
+}
+
+type DummyCheckpoint struct {
+	store.Checkpoint
+	checkpoints map[string][]byte
+}
+
 type DummyOrganization struct {
 	store.Organization
 	organizations *[]*model.Organization
@@ -86,6 +117,21 @@ func (s *TestStore) init() {
 	if s.enrollmentRequests == nil {
 		s.enrollmentRequests = &DummyEnrollmentRequest{enrollmentRequests: &[]api.EnrollmentRequest{}}
 	}
+	if s.enrollmentPolicies == nil {
+		s.enrollmentPolicies = &DummyEnrollmentPolicy{enrollmentPolicies: &[]api.EnrollmentPolicy{}}
+	}
+	if s.csrs == nil {
+		s.csrs = &DummyCertificateSigningRequest{csrs: &[]api.CertificateSigningRequest{}}
+	}
+	if s.revocations == nil {
+		s.revocations = &DummyCertificateRevocation{revocations: &[]model.CertificateRevocation{}}
+	}
+	if s.consoleSessions == nil {
+		s.consoleSessions = &DummyConsoleSession{consoleSessions: &[]api.ConsoleSession{}}
+	}
+	if s.checkpoints == nil {
+		s.checkpoints = &DummyCheckpoint{checkpoints: map[string][]byte{}}
+	}
 	if s.organizations == nil {
 		s.organizations = &DummyOrganization{organizations: &[]*model.Organization{}}
 	}
@@ -121,6 +167,31 @@ func (s *TestStore) EnrollmentRequest() store.EnrollmentRequest {
 	return s.enrollmentRequests
 }
 
+func (s *TestStore) EnrollmentPolicy() store.EnrollmentPolicy {
+	s.init()
+	return s.enrollmentPolicies
+}
+
+func (s *TestStore) CertificateSigningRequest() store.CertificateSigningRequest {
+	s.init()
+	return s.csrs
+}
+
+func (s *TestStore) CertificateRevocation() store.CertificateRevocation {
+	s.init()
+	return s.revocations
+}
+
+func (s *TestStore) ConsoleSession() store.ConsoleSession {
+	s.init()
+	return s.consoleSessions
+}
+
+func (s *TestStore) Checkpoint() store.Checkpoint {
+	s.init()
+	return s.checkpoints
+}
+
 func (s *TestStore) Organization() store.Organization {
 	s.init()
 	return s.organizations
@@ -224,6 +295,15 @@ func (s *DummyDevice) Create(ctx context.Context, orgId uuid.UUID, device *api.D
 	return device, nil
 }
 
+func (s *DummyDevice) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*api.DeviceList, error) {
+	return &api.DeviceList{
+		ApiVersion: "",
+		Kind:       "",
+		Metadata:   api.ListMeta{},
+		Items:      *s.devices,
+	}, nil
+}
+
 func (s *DummyDevice) UpdateStatus(ctx context.Context, orgId uuid.UUID, device *api.Device, callbackEvent store.EventCallback) (*api.Device, error) {
 	for i, dev := range *s.devices {
 		if *device.Metadata.Name == *dev.Metadata.Name {
@@ -242,6 +322,19 @@ func (s *DummyDevice) UpdateStatus(ctx context.Context, orgId uuid.UUID, device
 	return nil, flterrors.ErrResourceNotFound
 }
 
+func (s *DummyDevice) UpdateIntegrity(ctx context.Context, orgId uuid.UUID, name string, integrity api.DeviceIntegrityStatus) error {
+	for i, dev := range *s.devices {
+		if name == *dev.Metadata.Name {
+			if dev.Status == nil {
+				(*s.devices)[i].Status = lo.ToPtr(api.NewDeviceStatus())
+			}
+			deepCopy(integrity, &(*s.devices)[i].Status.Integrity)
+			return nil
+		}
+	}
+	return flterrors.ErrResourceNotFound
+}
+
 // --------------------------------------> Fleet
 
 func (s *DummyFleet) Get(ctx context.Context, orgId uuid.UUID, name string, options ...store.GetOption) (*api.Fleet, error) {
@@ -320,7 +413,7 @@ func (s *DummyRepository) Update(ctx context.Context, orgId uuid.UUID, repositor
 	return nil, flterrors.ErrResourceNotFound
 }
 
-func (s *DummyRepository) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, repository *api.Repository, callbackEvent store.EventCallback) (*api.Repository, bool, error) {
+func (s *DummyRepository) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, repository *api.Repository, fromAPI bool, callbackEvent store.EventCallback) (*api.Repository, bool, error) {
 	created := true
 	var repo api.Repository
 	deepCopy(repository, &repo)
@@ -504,6 +597,117 @@ func (s *DummyEnrollmentRequest) UpdateStatus(ctx context.Context, orgId uuid.UU
 	return nil, flterrors.ErrResourceNotFound
 }
 
+// --------------------------------------> EnrollmentPolicy
+
+func (s *DummyEnrollmentPolicy) Create(ctx context.Context, orgId uuid.UUID, policy *api.EnrollmentPolicy, callbackEvent store.EventCallback) (*api.EnrollmentPolicy, error) {
+	var p api.EnrollmentPolicy
+	deepCopy(policy, &p)
+	*s.enrollmentPolicies = append(*s.enrollmentPolicies, p)
+	return policy, nil
+}
+
+func (s *DummyEnrollmentPolicy) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*api.EnrollmentPolicyList, error) {
+	return &api.EnrollmentPolicyList{
+		ApiVersion: "",
+		Kind:       "",
+		Metadata:   api.ListMeta{},
+		Items:      *s.enrollmentPolicies,
+	}, nil
+}
+
+// --------------------------------------> CertificateSigningRequest
+
+func (s *DummyCertificateSigningRequest) Create(ctx context.Context, orgId uuid.UUID, csr *api.CertificateSigningRequest, callbackEvent store.EventCallback) (*api.CertificateSigningRequest, error) {
+	var c api.CertificateSigningRequest
+	deepCopy(csr, &c)
+	*s.csrs = append(*s.csrs, c)
+	return csr, nil
+}
+
+// List ignores the field selector and returns all CSRs
+func (s *DummyCertificateSigningRequest) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*api.CertificateSigningRequestList, error) {
+	return &api.CertificateSigningRequestList{
+		Items: *s.csrs,
+	}, nil
+}
+
+// --------------------------------------> CertificateRevocation
+
+func (s *DummyCertificateRevocation) Revoke(ctx context.Context, revocation *model.CertificateRevocation) error {
+	if _, err := s.Get(ctx, revocation.SerialNumber); err == nil {
+		return nil
+	}
+	*s.revocations = append(*s.revocations, *revocation)
+	return nil
+}
+
+func (s *DummyCertificateRevocation) Get(ctx context.Context, serialNumber string) (*model.CertificateRevocation, error) {
+	for _, revocation := range *s.revocations {
+		if revocation.SerialNumber == serialNumber {
+			r := revocation
+			return &r, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyCertificateRevocation) ListUnexpired(ctx context.Context) ([]model.CertificateRevocation, error) {
+	return *s.revocations, nil
+}
+
+func (s *DummyCertificateRevocation) DeleteExpired(ctx context.Context) (int64, error) {
+	now := time.Now()
+	unexpired := lo.Filter(*s.revocations, func(r model.CertificateRevocation, _ int) bool { return r.NotAfter.After(now) })
+	numDeleted := int64(len(*s.revocations) - len(unexpired))
+	*s.revocations = unexpired
+	return numDeleted, nil
+}
+
+// --------------------------------------> ConsoleSession
+
+func (s *DummyConsoleSession) Create(ctx context.Context, orgId uuid.UUID, session *api.ConsoleSession) (*api.ConsoleSession, error) {
+	var cs api.ConsoleSession
+	deepCopy(session, &cs)
+	*s.consoleSessions = append(*s.consoleSessions, cs)
+	return session, nil
+}
+
+func (s *DummyConsoleSession) Get(ctx context.Context, orgId uuid.UUID, name string) (*api.ConsoleSession, error) {
+	for _, session := range *s.consoleSessions {
+		if name == *session.Metadata.Name {
+			var cs api.ConsoleSession
+			deepCopy(session, &cs)
+			return &cs, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *DummyConsoleSession) UpdateStatus(ctx context.Context, orgId uuid.UUID, session *api.ConsoleSession) (*api.ConsoleSession, error) {
+	for i, cs := range *s.consoleSessions {
+		if *session.Metadata.Name == *cs.Metadata.Name {
+			deepCopy(session.Status, &(*s.consoleSessions)[i].Status)
+			return session, nil
+		}
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+// --------------------------------------> Checkpoint
+
+func (s *DummyCheckpoint) Set(ctx context.Context, consumer string, key string, value []byte) error {
+	s.checkpoints[consumer+"/"+key] = value
+	return nil
+}
+
+func (s *DummyCheckpoint) Get(ctx context.Context, consumer string, key string) ([]byte, error) {
+	value, ok := s.checkpoints[consumer+"/"+key]
+	if !ok {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	return value, nil
+}
+
 // --------------------------------------> Organization
 
 func (s *DummyOrganization) InitialMigration(ctx context.Context) error {
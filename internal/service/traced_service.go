@@ -191,6 +191,12 @@ func (t *TracedService) ListDevices(ctx context.Context, params api.ListDevicesP
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) WatchDevices(ctx context.Context, params api.ListDevicesParams) (<-chan api.WatchEvent, api.Status) {
+	ctx, span := startSpan(ctx, "WatchDevices")
+	resp, st := t.inner.WatchDevices(ctx, params)
+	endSpan(span, st)
+	return resp, st
+}
 
 func (t *TracedService) ListDisconnectedDevices(ctx context.Context, params api.ListDevicesParams, cutoffTime time.Time) (*api.DeviceList, api.Status) {
 	ctx, span := startSpan(ctx, "ListDisconnectedDevices")
@@ -205,6 +211,12 @@ func (t *TracedService) ListDevicesByServiceCondition(ctx context.Context, condi
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) SearchDevices(ctx context.Context, params api.SearchDevicesParams) (*api.DeviceSearchResultList, api.Status) {
+	ctx, span := startSpan(ctx, "SearchDevices")
+	resp, st := t.inner.SearchDevices(ctx, params)
+	endSpan(span, st)
+	return resp, st
+}
 func (t *TracedService) UpdateDevice(ctx context.Context, name string, device api.Device, fieldsToUnset []string) (*api.Device, error) {
 	ctx, span := startSpan(ctx, "UpdateDevice")
 	resp, err := t.inner.UpdateDevice(ctx, name, device, fieldsToUnset)
@@ -351,6 +363,21 @@ func (t *TracedService) UpdateServiceSideDeviceStatus(ctx context.Context, devic
 	return resp
 }
 
+// --- DeviceAttestation ---
+func (t *TracedService) CreateDeviceAttestationChallenge(ctx context.Context, name string) (*api.DeviceAttestationChallenge, api.Status) {
+	ctx, span := startSpan(ctx, "CreateDeviceAttestationChallenge")
+	resp, st := t.inner.CreateDeviceAttestationChallenge(ctx, name)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) CreateDeviceAttestation(ctx context.Context, name string, attestation api.DeviceAttestation) (*api.DeviceIntegrityStatus, api.Status) {
+	ctx, span := startSpan(ctx, "CreateDeviceAttestation")
+	resp, st := t.inner.CreateDeviceAttestation(ctx, name, attestation)
+	endSpan(span, st)
+	return resp, st
+}
+
 // --- EnrollmentConfig ---
 func (t *TracedService) GetEnrollmentConfig(ctx context.Context, params api.GetEnrollmentConfigParams) (*api.EnrollmentConfig, api.Status) {
 	ctx, span := startSpan(ctx, "GetEnrollmentConfig")
@@ -359,6 +386,28 @@ func (t *TracedService) GetEnrollmentConfig(ctx context.Context, params api.GetE
 	return resp, st
 }
 
+// --- CertificateRevocation ---
+func (t *TracedService) GetCertificateRevocationList(ctx context.Context) ([]byte, api.Status) {
+	ctx, span := startSpan(ctx, "GetCertificateRevocationList")
+	resp, st := t.inner.GetCertificateRevocationList(ctx)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) GetOCSPResponse(ctx context.Context, request []byte) ([]byte, api.Status) {
+	ctx, span := startSpan(ctx, "GetOCSPResponse")
+	resp, st := t.inner.GetOCSPResponse(ctx, request)
+	endSpan(span, st)
+	return resp, st
+}
+
+func (t *TracedService) DeleteExpiredCertificateRevocations(ctx context.Context) (int64, api.Status) {
+	ctx, span := startSpan(ctx, "DeleteExpiredCertificateRevocations")
+	resp, st := t.inner.DeleteExpiredCertificateRevocations(ctx)
+	endSpan(span, st)
+	return resp, st
+}
+
 // --- EnrollmentRequest ---
 func (t *TracedService) CreateEnrollmentRequest(ctx context.Context, er api.EnrollmentRequest) (*api.EnrollmentRequest, api.Status) {
 	ctx, span := startSpan(ctx, "CreateEnrollmentRequest")
@@ -428,6 +477,12 @@ func (t *TracedService) ListFleets(ctx context.Context, params api.ListFleetsPar
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) WatchFleets(ctx context.Context, params api.ListFleetsParams) (<-chan api.WatchEvent, api.Status) {
+	ctx, span := startSpan(ctx, "WatchFleets")
+	resp, st := t.inner.WatchFleets(ctx, params)
+	endSpan(span, st)
+	return resp, st
+}
 func (t *TracedService) GetFleet(ctx context.Context, name string, params api.GetFleetParams) (*api.Fleet, api.Status) {
 	ctx, span := startSpan(ctx, "GetFleet")
 	resp, st := t.inner.GetFleet(ctx, name, params)
@@ -464,6 +519,30 @@ func (t *TracedService) PatchFleet(ctx context.Context, name string, patch api.P
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) PauseFleetRollout(ctx context.Context, name string) (*api.Fleet, api.Status) {
+	ctx, span := startSpan(ctx, "PauseFleetRollout")
+	resp, st := t.inner.PauseFleetRollout(ctx, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ResumeFleetRollout(ctx context.Context, name string) (*api.Fleet, api.Status) {
+	ctx, span := startSpan(ctx, "ResumeFleetRollout")
+	resp, st := t.inner.ResumeFleetRollout(ctx, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) AbortFleetRollout(ctx context.Context, name string) (*api.Fleet, api.Status) {
+	ctx, span := startSpan(ctx, "AbortFleetRollout")
+	resp, st := t.inner.AbortFleetRollout(ctx, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) PreviewFleet(ctx context.Context, name string, fleet api.Fleet, params api.PreviewFleetParams) (*api.FleetPreview, api.Status) {
+	ctx, span := startSpan(ctx, "PreviewFleet")
+	resp, st := t.inner.PreviewFleet(ctx, name, fleet, params)
+	endSpan(span, st)
+	return resp, st
+}
 func (t *TracedService) ListFleetRolloutDeviceSelection(ctx context.Context) (*api.FleetList, api.Status) {
 	ctx, span := startSpan(ctx, "ListFleetRolloutDeviceSelection")
 	resp, st := t.inner.ListFleetRolloutDeviceSelection(ctx)
@@ -610,6 +689,17 @@ func (t *TracedService) ReplaceResourceSyncStatus(ctx context.Context, name stri
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) WithResourceSyncLock(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	ctx, span := startSpan(ctx, "WithResourceSyncLock")
+	defer span.End()
+	return t.inner.WithResourceSyncLock(ctx, name, fn)
+}
+func (t *TracedService) HandleGitPush(ctx context.Context, repository string, push GitPush) ([]api.ObjectReference, api.Status) {
+	ctx, span := startSpan(ctx, "HandleGitPush")
+	resp, st := t.inner.HandleGitPush(ctx, repository, push)
+	endSpan(span, st)
+	return resp, st
+}
 
 // --- TemplateVersion ---
 func (t *TracedService) CreateTemplateVersion(ctx context.Context, tv api.TemplateVersion, immediateRollout bool) (*api.TemplateVersion, api.Status) {
@@ -655,6 +745,12 @@ func (t *TracedService) ListEvents(ctx context.Context, params api.ListEventsPar
 	endSpan(span, st)
 	return resp, st
 }
+func (t *TracedService) WatchEvents(ctx context.Context, params api.ListEventsParams) (<-chan api.WatchEvent, api.Status) {
+	ctx, span := startSpan(ctx, "WatchEvents")
+	resp, st := t.inner.WatchEvents(ctx, params)
+	endSpan(span, st)
+	return resp, st
+}
 func (t *TracedService) DeleteEventsOlderThan(ctx context.Context, cutoffTime time.Time) (int64, api.Status) {
 	ctx, span := startSpan(ctx, "DeleteEventsOlderThan")
 	resp, st := t.inner.DeleteEventsOlderThan(ctx, cutoffTime)
@@ -662,6 +758,152 @@ func (t *TracedService) DeleteEventsOlderThan(ctx context.Context, cutoffTime ti
 	return resp, st
 }
 
+// --- EventSubscription ---
+func (t *TracedService) CreateEventSubscription(ctx context.Context, subscription api.EventSubscription) (*api.EventSubscription, api.Status) {
+	ctx, span := startSpan(ctx, "CreateEventSubscription")
+	resp, st := t.inner.CreateEventSubscription(ctx, subscription)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListEventSubscriptions(ctx context.Context, params api.ListEventSubscriptionsParams) (*api.EventSubscriptionList, api.Status) {
+	ctx, span := startSpan(ctx, "ListEventSubscriptions")
+	resp, st := t.inner.ListEventSubscriptions(ctx, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetEventSubscription(ctx context.Context, name string) (*api.EventSubscription, api.Status) {
+	ctx, span := startSpan(ctx, "GetEventSubscription")
+	resp, st := t.inner.GetEventSubscription(ctx, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceEventSubscription(ctx context.Context, name string, subscription api.EventSubscription) (*api.EventSubscription, api.Status) {
+	ctx, span := startSpan(ctx, "ReplaceEventSubscription")
+	resp, st := t.inner.ReplaceEventSubscription(ctx, name, subscription)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteEventSubscription(ctx context.Context, name string) api.Status {
+	ctx, span := startSpan(ctx, "DeleteEventSubscription")
+	st := t.inner.DeleteEventSubscription(ctx, name)
+	endSpan(span, st)
+	return st
+}
+func (t *TracedService) PatchEventSubscription(ctx context.Context, name string, patch api.PatchRequest) (*api.EventSubscription, api.Status) {
+	ctx, span := startSpan(ctx, "PatchEventSubscription")
+	resp, st := t.inner.PatchEventSubscription(ctx, name, patch)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceEventSubscriptionStatus(ctx context.Context, name string, subscription api.EventSubscription) (*api.EventSubscription, api.Status) {
+	ctx, span := startSpan(ctx, "ReplaceEventSubscriptionStatus")
+	resp, st := t.inner.ReplaceEventSubscriptionStatus(ctx, name, subscription)
+	endSpan(span, st)
+	return resp, st
+}
+
+// --- EnrollmentPolicy ---
+func (t *TracedService) CreateEnrollmentPolicy(ctx context.Context, policy api.EnrollmentPolicy) (*api.EnrollmentPolicy, api.Status) {
+	ctx, span := startSpan(ctx, "CreateEnrollmentPolicy")
+	resp, st := t.inner.CreateEnrollmentPolicy(ctx, policy)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListEnrollmentPolicies(ctx context.Context, params api.ListEnrollmentPoliciesParams) (*api.EnrollmentPolicyList, api.Status) {
+	ctx, span := startSpan(ctx, "ListEnrollmentPolicies")
+	resp, st := t.inner.ListEnrollmentPolicies(ctx, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetEnrollmentPolicy(ctx context.Context, name string) (*api.EnrollmentPolicy, api.Status) {
+	ctx, span := startSpan(ctx, "GetEnrollmentPolicy")
+	resp, st := t.inner.GetEnrollmentPolicy(ctx, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceEnrollmentPolicy(ctx context.Context, name string, policy api.EnrollmentPolicy) (*api.EnrollmentPolicy, api.Status) {
+	ctx, span := startSpan(ctx, "ReplaceEnrollmentPolicy")
+	resp, st := t.inner.ReplaceEnrollmentPolicy(ctx, name, policy)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteEnrollmentPolicy(ctx context.Context, name string) api.Status {
+	ctx, span := startSpan(ctx, "DeleteEnrollmentPolicy")
+	st := t.inner.DeleteEnrollmentPolicy(ctx, name)
+	endSpan(span, st)
+	return st
+}
+func (t *TracedService) PatchEnrollmentPolicy(ctx context.Context, name string, patch api.PatchRequest) (*api.EnrollmentPolicy, api.Status) {
+	ctx, span := startSpan(ctx, "PatchEnrollmentPolicy")
+	resp, st := t.inner.PatchEnrollmentPolicy(ctx, name, patch)
+	endSpan(span, st)
+	return resp, st
+}
+
+// --- BulkOperation ---
+func (t *TracedService) CreateBulkOperation(ctx context.Context, operation api.BulkOperation) (*api.BulkOperation, api.Status) {
+	ctx, span := startSpan(ctx, "CreateBulkOperation")
+	resp, st := t.inner.CreateBulkOperation(ctx, operation)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListBulkOperations(ctx context.Context, params api.ListBulkOperationsParams) (*api.BulkOperationList, api.Status) {
+	ctx, span := startSpan(ctx, "ListBulkOperations")
+	resp, st := t.inner.ListBulkOperations(ctx, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetBulkOperation(ctx context.Context, name string) (*api.BulkOperation, api.Status) {
+	ctx, span := startSpan(ctx, "GetBulkOperation")
+	resp, st := t.inner.GetBulkOperation(ctx, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) DeleteBulkOperation(ctx context.Context, name string) api.Status {
+	ctx, span := startSpan(ctx, "DeleteBulkOperation")
+	st := t.inner.DeleteBulkOperation(ctx, name)
+	endSpan(span, st)
+	return st
+}
+func (t *TracedService) CancelBulkOperation(ctx context.Context, name string) (*api.BulkOperation, api.Status) {
+	ctx, span := startSpan(ctx, "CancelBulkOperation")
+	resp, st := t.inner.CancelBulkOperation(ctx, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceBulkOperationStatus(ctx context.Context, name string, operation api.BulkOperation) (*api.BulkOperation, api.Status) {
+	ctx, span := startSpan(ctx, "ReplaceBulkOperationStatus")
+	resp, st := t.inner.ReplaceBulkOperationStatus(ctx, name, operation)
+	endSpan(span, st)
+	return resp, st
+}
+
+// --- ConsoleSession ---
+func (t *TracedService) CreateConsoleSession(ctx context.Context, session api.ConsoleSession) (*api.ConsoleSession, api.Status) {
+	ctx, span := startSpan(ctx, "CreateConsoleSession")
+	resp, st := t.inner.CreateConsoleSession(ctx, session)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ListConsoleSessions(ctx context.Context, params api.ListConsoleSessionsParams) (*api.ConsoleSessionList, api.Status) {
+	ctx, span := startSpan(ctx, "ListConsoleSessions")
+	resp, st := t.inner.ListConsoleSessions(ctx, params)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) GetConsoleSession(ctx context.Context, name string) (*api.ConsoleSession, api.Status) {
+	ctx, span := startSpan(ctx, "GetConsoleSession")
+	resp, st := t.inner.GetConsoleSession(ctx, name)
+	endSpan(span, st)
+	return resp, st
+}
+func (t *TracedService) ReplaceConsoleSessionStatus(ctx context.Context, name string, session api.ConsoleSession) (*api.ConsoleSession, api.Status) {
+	ctx, span := startSpan(ctx, "ReplaceConsoleSessionStatus")
+	resp, st := t.inner.ReplaceConsoleSessionStatus(ctx, name, session)
+	endSpan(span, st)
+	return resp, st
+}
+
 // --- Checkpoint ---
 func (t *TracedService) GetCheckpoint(ctx context.Context, consumer string, key string) ([]byte, api.Status) {
 	ctx, span := startSpan(ctx, "GetCheckpoint")
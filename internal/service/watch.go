@@ -0,0 +1,422 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/watch"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// watchedResource is a resource as seen by a watch
+type watchedResource struct {
+	name            string
+	resourceVersion int64
+	object          any
+}
+
+// watchLister lists the resources that match the selectors of a watch and returns the continue token of the
+// next page, if any
+type watchLister func(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) ([]watchedResource, *string, error)
+
+// resourceWatch streams the changes to the resources of one kind that match the selectors of a watch.  Changes
+// are announced on the watch bus, and the current state of each changed resource is read with the selectors of
+// the watch applied, so a resource that stops matching the selectors is reported as deleted.
+type resourceWatch struct {
+	kind       api.ResourceKind
+	listParams store.ListParams
+	list       watchLister
+	// sinceVersion skips resources whose resourceVersion is not greater than it
+	sinceVersion *int64
+	// tracked is set for kinds whose resources change and are deleted, as opposed to events which are only added
+	tracked bool
+	// known holds the last resourceVersion sent for each resource that currently matches the selectors
+	known map[string]int64
+}
+
+func (h *ServiceHandler) WatchDevices(ctx context.Context, params api.ListDevicesParams) (<-chan api.WatchEvent, api.Status) {
+	if lo.FromPtr(params.SummaryOnly) {
+		return nil, api.StatusBadRequest("parameter 'summaryOnly' is not supported when watching")
+	}
+	listParams, status := prepareListParams(nil, params.LabelSelector, params.FieldSelector, nil)
+	if status != api.StatusOK() {
+		return nil, status
+	}
+	sinceVersion, status := parseWatchResourceVersion(params.ResourceVersion)
+	if status != api.StatusOK() {
+		return nil, status
+	}
+
+	list := func(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) ([]watchedResource, *string, error) {
+		result, err := h.store.Device().List(ctx, orgId, listParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		ret := make([]watchedResource, 0, len(result.Items))
+		for i := range result.Items {
+			ret = append(ret, newWatchedResource(result.Items[i].Metadata, &result.Items[i]))
+		}
+		return ret, result.Metadata.Continue, nil
+	}
+	return h.watch(ctx, &resourceWatch{
+		kind:         api.DeviceKind,
+		listParams:   *listParams,
+		list:         list,
+		sinceVersion: sinceVersion,
+		tracked:      true,
+	})
+}
+
+func (h *ServiceHandler) WatchFleets(ctx context.Context, params api.ListFleetsParams) (<-chan api.WatchEvent, api.Status) {
+	listParams, status := prepareListParams(nil, params.LabelSelector, params.FieldSelector, nil)
+	if status != api.StatusOK() {
+		return nil, status
+	}
+	sinceVersion, status := parseWatchResourceVersion(params.ResourceVersion)
+	if status != api.StatusOK() {
+		return nil, status
+	}
+
+	list := func(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) ([]watchedResource, *string, error) {
+		result, err := h.store.Fleet().List(ctx, orgId, listParams, store.ListWithDevicesSummary(util.DefaultBoolIfNil(params.AddDevicesSummary, false)))
+		if err != nil {
+			return nil, nil, err
+		}
+		ret := make([]watchedResource, 0, len(result.Items))
+		for i := range result.Items {
+			ret = append(ret, newWatchedResource(result.Items[i].Metadata, &result.Items[i]))
+		}
+		return ret, result.Metadata.Continue, nil
+	}
+	return h.watch(ctx, &resourceWatch{
+		kind:         api.FleetKind,
+		listParams:   *listParams,
+		list:         list,
+		sinceVersion: sinceVersion,
+		tracked:      true,
+	})
+}
+
+func (h *ServiceHandler) WatchEvents(ctx context.Context, params api.ListEventsParams) (<-chan api.WatchEvent, api.Status) {
+	listParams, status := prepareListParams(nil, nil, params.FieldSelector, nil)
+	if status != api.StatusOK() {
+		return nil, status
+	}
+
+	list := func(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) ([]watchedResource, *string, error) {
+		result, err := h.store.Event().List(ctx, orgId, listParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		ret := make([]watchedResource, 0, len(result.Items))
+		for i := range result.Items {
+			ret = append(ret, newWatchedResource(result.Items[i].Metadata, &result.Items[i]))
+		}
+		return ret, result.Metadata.Continue, nil
+	}
+	return h.watch(ctx, &resourceWatch{
+		kind:       api.EventKind,
+		listParams: *listParams,
+		list:       list,
+	})
+}
+
+// notifyWatchers announces a successful change of a resource to the watches of all service instances
+func notifyWatchers(ctx context.Context, kind api.ResourceKind, orgId uuid.UUID, name string, eventType api.WatchEventType, err error) {
+	if err != nil {
+		return
+	}
+	watch.Bus.Instance().Notify(ctx, orgId, kind, name, eventType)
+}
+
+// watch registers the watch on the bus before listing the existing resources, so that no change is missed.  A
+// change that is both listed and announced is sent once, since its resourceVersion is already known.
+func (h *ServiceHandler) watch(ctx context.Context, rw *resourceWatch) (<-chan api.WatchEvent, api.Status) {
+	orgId := getOrgIdFromContext(ctx)
+	bus := watch.Bus.Instance()
+	watcher := bus.Watch(orgId, rw.kind)
+
+	initial, err := rw.listInitial(ctx, orgId)
+	if err != nil {
+		bus.Stop(watcher)
+		return nil, watchListErrorToApiStatus(err)
+	}
+
+	out := make(chan api.WatchEvent)
+	go func() {
+		defer close(out)
+		defer bus.Stop(watcher)
+
+		for _, event := range initial {
+			if !sendWatchEvent(ctx, out, event) {
+				return
+			}
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case n, ok := <-watcher.ResultChan():
+				if !ok {
+					sendWatchEvent(ctx, out, newWatchErrorEvent(api.StatusGone("watch closed because the client fell behind, watch again")))
+					return
+				}
+				batch, open := receivePendingNotifications(watcher.ResultChan(), n)
+				events, err := rw.handle(ctx, orgId, batch)
+				if err != nil {
+					h.log.WithError(err).Warnf("failed to read %d %s resources of %s for watch", len(batch), rw.kind, orgId)
+				}
+				for _, event := range events {
+					if !sendWatchEvent(ctx, out, event) {
+						return
+					}
+				}
+				if !open {
+					sendWatchEvent(ctx, out, newWatchErrorEvent(api.StatusGone("watch closed because the client fell behind, watch again")))
+					return
+				}
+			}
+		}
+	}()
+	return out, api.StatusOK()
+}
+
+// listInitial returns an ADDED event for every existing resource newer than sinceVersion.  Events are not listed,
+// only the selector of the watch is verified.
+func (rw *resourceWatch) listInitial(ctx context.Context, orgId uuid.UUID) ([]api.WatchEvent, error) {
+	rw.known = make(map[string]int64)
+	listParams := rw.listParams
+	listParams.Limit = MaxRecordsPerListRequest
+	if !rw.tracked {
+		listParams.Limit = 1
+		_, _, err := rw.list(ctx, orgId, listParams)
+		return nil, err
+	}
+
+	var ret []api.WatchEvent
+	for {
+		resources, next, err := rw.list(ctx, orgId, listParams)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range resources {
+			rw.known[r.name] = r.resourceVersion
+			if rw.sinceVersion != nil && r.resourceVersion <= *rw.sinceVersion {
+				continue
+			}
+			event, err := newWatchEvent(api.WatchEventAdded, r.object)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, event)
+		}
+		if next == nil {
+			return ret, nil
+		}
+		if listParams.Continue, err = store.ParseContinueString(next); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// receivePendingNotifications returns n followed by the notifications already waiting on ch, so that they are
+// handled together, and reports whether ch is still open.  At most a buffer's worth of notifications is waiting.
+func receivePendingNotifications(ch <-chan watch.Notification, n watch.Notification) ([]watch.Notification, bool) {
+	batch := []watch.Notification{n}
+	for {
+		select {
+		case next, ok := <-ch:
+			if !ok {
+				return batch, false
+			}
+			batch = append(batch, next)
+		default:
+			return batch, true
+		}
+	}
+}
+
+// handle returns the events to send for a batch of notifications.  Several notifications of the same resource
+// are coalesced, and the resources that were not deleted are read with a single list, so a busy organization
+// costs each watch one query per batch rather than one per change.  Changes that are not visible to the watch
+// produce no event.
+func (rw *resourceWatch) handle(ctx context.Context, orgId uuid.UUID, notifications []watch.Notification) ([]api.WatchEvent, error) {
+	var (
+		ret     []api.WatchEvent
+		names   []string
+		deleted = make(map[string]bool)
+		last    = make(map[string]api.WatchEventType)
+	)
+	for _, n := range notifications {
+		if _, ok := last[n.Name]; !ok {
+			names = append(names, n.Name)
+		}
+		last[n.Name] = n.Type
+		if n.Type == api.WatchEventDeleted {
+			deleted[n.Name] = true
+		}
+	}
+
+	// a deletion is sent before a later recreation of the resource, whose resourceVersion starts over
+	var toRead []string
+	for _, name := range names {
+		if deleted[name] {
+			event, err := rw.deleted(name)
+			if err != nil {
+				return ret, err
+			}
+			if event != nil {
+				ret = append(ret, *event)
+			}
+		}
+		if last[name] != api.WatchEventDeleted {
+			toRead = append(toRead, name)
+		}
+	}
+	if len(toRead) == 0 {
+		return ret, nil
+	}
+
+	nameSelector, err := selector.NewFieldSelector(fmt.Sprintf("metadata.name in (%s)", strings.Join(toRead, ",")))
+	if err != nil {
+		return ret, err
+	}
+	listParams := rw.listParams
+	listParams.Limit = len(toRead)
+	listParams.FieldSelector = nameSelector.Add(rw.listParams.FieldSelector)
+	resources, _, err := rw.list(ctx, orgId, listParams)
+	if err != nil {
+		return ret, err
+	}
+	read := make(map[string]watchedResource, len(resources))
+	for _, r := range resources {
+		read[r.name] = r
+	}
+
+	for _, name := range toRead {
+		r, ok := read[name]
+		if !ok {
+			// the resource does not match the selectors (anymore)
+			event, err := rw.deleted(name)
+			if err != nil {
+				return ret, err
+			}
+			if event != nil {
+				ret = append(ret, *event)
+			}
+			continue
+		}
+		event, err := rw.changed(r)
+		if err != nil {
+			return ret, err
+		}
+		if event != nil {
+			ret = append(ret, *event)
+		}
+	}
+	return ret, nil
+}
+
+// changed returns the event to send for a resource read after a change, or nil if the change was already sent
+func (rw *resourceWatch) changed(r watchedResource) (*api.WatchEvent, error) {
+	if !rw.tracked {
+		event, err := newWatchEvent(api.WatchEventAdded, r.object)
+		return &event, err
+	}
+	previous, ok := rw.known[r.name]
+	if ok && r.resourceVersion <= previous {
+		return nil, nil
+	}
+	if rw.sinceVersion != nil && r.resourceVersion <= *rw.sinceVersion {
+		return nil, nil
+	}
+	rw.known[r.name] = r.resourceVersion
+	event, err := newWatchEvent(lo.Ternary(ok, api.WatchEventModified, api.WatchEventAdded), r.object)
+	return &event, err
+}
+
+// deleted returns a DELETED event if the resource was visible to the watch.  The deleted resource can no longer
+// be read, so the event only contains its kind and metadata.
+func (rw *resourceWatch) deleted(name string) (*api.WatchEvent, error) {
+	resourceVersion, ok := rw.known[name]
+	if !ok {
+		return nil, nil
+	}
+	delete(rw.known, name)
+	event, err := newWatchEvent(api.WatchEventDeleted, map[string]any{
+		"kind": rw.kind,
+		"metadata": api.ObjectMeta{
+			Name:            lo.ToPtr(name),
+			ResourceVersion: lo.ToPtr(strconv.FormatInt(resourceVersion, 10)),
+		},
+	})
+	return &event, err
+}
+
+func newWatchedResource(metadata api.ObjectMeta, object any) watchedResource {
+	var resourceVersion int64
+	if metadata.ResourceVersion != nil {
+		resourceVersion, _ = strconv.ParseInt(*metadata.ResourceVersion, 10, 64)
+	}
+	return watchedResource{
+		name:            lo.FromPtr(metadata.Name),
+		resourceVersion: resourceVersion,
+		object:          object,
+	}
+}
+
+func newWatchEvent(eventType api.WatchEventType, object any) (api.WatchEvent, error) {
+	b, err := json.Marshal(object)
+	if err != nil {
+		return api.WatchEvent{}, err
+	}
+	event := api.WatchEvent{Type: eventType}
+	if err := json.Unmarshal(b, &event.Object); err != nil {
+		return api.WatchEvent{}, err
+	}
+	return event, nil
+}
+
+func newWatchErrorEvent(status api.Status) api.WatchEvent {
+	event, err := newWatchEvent(api.WatchEventError, status)
+	if err != nil {
+		return api.WatchEvent{Type: api.WatchEventError, Object: map[string]any{"message": status.Message}}
+	}
+	return event
+}
+
+func sendWatchEvent(ctx context.Context, out chan<- api.WatchEvent, event api.WatchEvent) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case out <- event:
+		return true
+	}
+}
+
+func parseWatchResourceVersion(resourceVersion *string) (*int64, api.Status) {
+	if resourceVersion == nil || *resourceVersion == "" {
+		return nil, api.StatusOK()
+	}
+	version, err := strconv.ParseInt(*resourceVersion, 10, 64)
+	if err != nil {
+		return nil, api.StatusBadRequest(fmt.Sprintf("invalid resourceVersion %q: must be a number", *resourceVersion))
+	}
+	return &version, api.StatusOK()
+}
+
+func watchListErrorToApiStatus(err error) api.Status {
+	var se *selector.SelectorError
+	if selector.AsSelectorError(err, &se) {
+		return api.StatusBadRequest(se.Error())
+	}
+	return api.StatusInternalServerError(err.Error())
+}
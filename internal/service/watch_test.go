@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/watch"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestResourceWatch returns a watch whose lister returns the resources in matching, which stand for the
+// resources that match the selectors of the watch
+func newTestResourceWatch(matching *[]watchedResource, sinceVersion *int64) *resourceWatch {
+	return &resourceWatch{
+		kind: api.DeviceKind,
+		list: func(_ context.Context, _ uuid.UUID, _ store.ListParams) ([]watchedResource, *string, error) {
+			return *matching, nil, nil
+		},
+		sinceVersion: sinceVersion,
+		tracked:      true,
+	}
+}
+
+func testWatchedDevice(name string, resourceVersion int64) watchedResource {
+	device := &api.Device{Metadata: api.ObjectMeta{Name: lo.ToPtr(name)}}
+	return watchedResource{name: name, resourceVersion: resourceVersion, object: device}
+}
+
+func TestResourceWatchInitialList(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	matching := []watchedResource{testWatchedDevice("dev1", 5), testWatchedDevice("dev2", 10)}
+
+	events, err := newTestResourceWatch(&matching, nil).listInitial(ctx, uuid.New())
+	require.NoError(err)
+	require.Len(events, 2)
+	for _, e := range events {
+		require.Equal(api.WatchEventAdded, e.Type)
+	}
+
+	// only resources newer than the requested resourceVersion are sent
+	events, err = newTestResourceWatch(&matching, lo.ToPtr(int64(5))).listInitial(ctx, uuid.New())
+	require.NoError(err)
+	require.Len(events, 1)
+	require.Equal("dev2", events[0].Object["metadata"].(map[string]any)["name"])
+}
+
+func TestResourceWatchHandle(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	orgId := uuid.New()
+	matching := []watchedResource{testWatchedDevice("dev1", 5)}
+	rw := newTestResourceWatch(&matching, nil)
+	_, err := rw.listInitial(ctx, orgId)
+	require.NoError(err)
+
+	notification := func(name string, eventType api.WatchEventType) watch.Notification {
+		return watch.Notification{OrgId: orgId, Kind: api.DeviceKind, Name: name, Type: eventType}
+	}
+	handle := func(notifications ...watch.Notification) []api.WatchEvent {
+		events, err := rw.handle(ctx, orgId, notifications)
+		require.NoError(err)
+		return events
+	}
+
+	// a change that was already listed is not sent again
+	require.Empty(handle(notification("dev1", api.WatchEventModified)))
+
+	matching = []watchedResource{testWatchedDevice("dev1", 6)}
+	events := handle(notification("dev1", api.WatchEventModified))
+	require.Len(events, 1)
+	require.Equal(api.WatchEventModified, events[0].Type)
+
+	matching = []watchedResource{testWatchedDevice("dev2", 7)}
+	events = handle(notification("dev2", api.WatchEventAdded))
+	require.Len(events, 1)
+	require.Equal(api.WatchEventAdded, events[0].Type)
+
+	// a resource that no longer matches the selectors is deleted from the watch
+	matching = nil
+	events = handle(notification("dev1", api.WatchEventModified))
+	require.Len(events, 1)
+	require.Equal(api.WatchEventDeleted, events[0].Type)
+	require.Equal("dev1", events[0].Object["metadata"].(map[string]any)["name"])
+
+	// a change to a resource that never matched is not sent
+	require.Empty(handle(notification("dev3", api.WatchEventModified)))
+
+	events = handle(notification("dev2", api.WatchEventDeleted))
+	require.Len(events, 1)
+	require.Equal(api.WatchEventDeleted, events[0].Type)
+	require.Equal(api.DeviceKind, events[0].Object["kind"])
+
+	require.Empty(handle(notification("dev2", api.WatchEventDeleted)))
+}
+
+func TestResourceWatchHandleBatch(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	orgId := uuid.New()
+	matching := []watchedResource{testWatchedDevice("dev1", 5), testWatchedDevice("dev2", 5)}
+	rw := newTestResourceWatch(&matching, nil)
+	_, err := rw.listInitial(ctx, orgId)
+	require.NoError(err)
+
+	var lists []store.ListParams
+	list := rw.list
+	rw.list = func(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) ([]watchedResource, *string, error) {
+		lists = append(lists, listParams)
+		return list(ctx, orgId, listParams)
+	}
+	notification := func(name string, eventType api.WatchEventType) watch.Notification {
+		return watch.Notification{OrgId: orgId, Kind: api.DeviceKind, Name: name, Type: eventType}
+	}
+
+	// dev1 changed twice, dev2 was deleted and created again and dev3 was created then deleted
+	matching = []watchedResource{testWatchedDevice("dev1", 7), testWatchedDevice("dev2", 1)}
+	events, err := rw.handle(ctx, orgId, []watch.Notification{
+		notification("dev1", api.WatchEventModified),
+		notification("dev2", api.WatchEventDeleted),
+		notification("dev3", api.WatchEventAdded),
+		notification("dev1", api.WatchEventModified),
+		notification("dev2", api.WatchEventAdded),
+		notification("dev3", api.WatchEventDeleted),
+	})
+	require.NoError(err)
+
+	// the resources are read with a single list
+	require.Len(lists, 1)
+	require.Equal(2, lists[0].Limit)
+
+	require.Len(events, 3)
+	require.Equal(api.WatchEventDeleted, events[0].Type)
+	require.Equal("dev2", events[0].Object["metadata"].(map[string]any)["name"])
+	require.Equal(api.WatchEventModified, events[1].Type)
+	require.Equal("dev1", events[1].Object["metadata"].(map[string]any)["name"])
+	require.Equal(api.WatchEventAdded, events[2].Type)
+	require.Equal("dev2", events[2].Object["metadata"].(map[string]any)["name"])
+}
+
+func TestReceivePendingNotifications(t *testing.T) {
+	require := require.New(t)
+	ch := make(chan watch.Notification, 3)
+	ch <- watch.Notification{Name: "dev2"}
+	ch <- watch.Notification{Name: "dev3"}
+
+	batch, open := receivePendingNotifications(ch, watch.Notification{Name: "dev1"})
+	require.True(open)
+	require.Equal([]string{"dev1", "dev2", "dev3"}, lo.Map(batch, func(n watch.Notification, _ int) string { return n.Name }))
+
+	ch <- watch.Notification{Name: "dev4"}
+	close(ch)
+	batch, open = receivePendingNotifications(ch, watch.Notification{Name: "dev1"})
+	require.False(open)
+	require.Len(batch, 2)
+}
+
+func TestParseWatchResourceVersion(t *testing.T) {
+	require := require.New(t)
+
+	version, status := parseWatchResourceVersion(nil)
+	require.Equal(api.StatusOK(), status)
+	require.Nil(version)
+
+	version, status = parseWatchResourceVersion(lo.ToPtr("42"))
+	require.Equal(api.StatusOK(), status)
+	require.Equal(int64(42), *version)
+
+	_, status = parseWatchResourceVersion(lo.ToPtr("latest"))
+	require.Equal(statusBadRequestCode, status.Code)
+}
@@ -0,0 +1,125 @@
+package store
+
+import (
+	"context"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BulkOperation interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, operation *api.BulkOperation, eventCallback EventCallback) (*api.BulkOperation, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*api.BulkOperation, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.BulkOperationList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+	UpdateStatus(ctx context.Context, orgId uuid.UUID, operation *api.BulkOperation) (*api.BulkOperation, error)
+}
+
+type BulkOperationStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.BulkOperation, model.BulkOperation, api.BulkOperation, api.BulkOperationList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to BulkOperation interface
+var _ BulkOperation = (*BulkOperationStore)(nil)
+
+func NewBulkOperation(db *gorm.DB, log logrus.FieldLogger) BulkOperation {
+	genericStore := NewGenericStore[*model.BulkOperation, model.BulkOperation, api.BulkOperation, api.BulkOperationList](
+		db,
+		log,
+		model.NewBulkOperationFromApiResource,
+		(*model.BulkOperation).ToApiResource,
+		model.BulkOperationsToApiResource,
+	)
+	return &BulkOperationStore{dbHandler: db, log: log, genericStore: genericStore, eventCallbackCaller: CallEventCallback(api.BulkOperationKind, log)}
+}
+
+func (s *BulkOperationStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *BulkOperationStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.BulkOperation{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for BulkOperation labels
+	if !db.Migrator().HasIndex(&model.BulkOperation{}, "idx_bulk_operations_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_bulk_operations_labels ON bulk_operations USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.BulkOperation{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *BulkOperationStore) Create(ctx context.Context, orgId uuid.UUID, resource *api.BulkOperation, eventCallback EventCallback) (*api.BulkOperation, error) {
+	operation, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, operation, true, err)
+	return operation, err
+}
+
+func (s *BulkOperationStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*api.BulkOperation, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *BulkOperationStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.BulkOperationList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *BulkOperationStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.BulkOperation{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, nil)
+	}
+	return err
+}
+
+// UpdateStatus replaces the status of a bulk operation.  If the resource version of the operation is set, the status
+// is only replaced if the stored operation has the same version, and ErrNoRowsUpdated is returned otherwise.  This
+// keeps the worker from overwriting a cancellation that happened while it was processing devices.
+func (s *BulkOperationStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *api.BulkOperation) (*api.BulkOperation, error) {
+	if resource == nil {
+		return nil, flterrors.ErrResourceIsNil
+	}
+	if resource.Metadata.ResourceVersion == nil {
+		return s.genericStore.UpdateStatus(ctx, orgId, resource)
+	}
+
+	operation, err := model.NewBulkOperationFromApiResource(resource)
+	if err != nil {
+		return nil, err
+	}
+	result := s.getDB(ctx).Model(operation).
+		Where("org_id = ? AND name = ? AND resource_version = ?", orgId, operation.Name, lo.FromPtr(operation.ResourceVersion)).
+		Clauses(clause.Returning{}).
+		Updates(map[string]interface{}{
+			"status":           operation.Status,
+			"resource_version": gorm.Expr("resource_version + 1"),
+		})
+	if err := ErrorFromGormError(result.Error); err != nil {
+		return nil, err
+	}
+	if result.RowsAffected == 0 {
+		return nil, flterrors.ErrNoRowsUpdated
+	}
+	return operation.ToApiResource()
+}
@@ -0,0 +1,86 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CertificateRevocation interface {
+	InitialMigration(ctx context.Context) error
+	Revoke(ctx context.Context, revocation *model.CertificateRevocation) error
+	IsRevoked(ctx context.Context, serialNumber string) (bool, error)
+	Get(ctx context.Context, serialNumber string) (*model.CertificateRevocation, error)
+	ListUnexpired(ctx context.Context) ([]model.CertificateRevocation, error)
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+type CertificateRevocationStore struct {
+	dbHandler *gorm.DB
+	log       logrus.FieldLogger
+}
+
+// Make sure we conform to CertificateRevocation interface
+var _ CertificateRevocation = (*CertificateRevocationStore)(nil)
+
+func NewCertificateRevocation(db *gorm.DB, log logrus.FieldLogger) CertificateRevocation {
+	return &CertificateRevocationStore{dbHandler: db, log: log}
+}
+
+func (s *CertificateRevocationStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *CertificateRevocationStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+	return db.AutoMigrate(&model.CertificateRevocation{})
+}
+
+// Revoke records the revocation of a certificate. Revoking an already revoked
+// certificate keeps the original revocation time and reason.
+func (s *CertificateRevocationStore) Revoke(ctx context.Context, revocation *model.CertificateRevocation) error {
+	return s.getDB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(revocation).Error
+}
+
+func (s *CertificateRevocationStore) IsRevoked(ctx context.Context, serialNumber string) (bool, error) {
+	var count int64
+	err := s.getDB(ctx).Model(&model.CertificateRevocation{}).Where("serial_number = ?", serialNumber).Count(&count).Error
+	if err != nil {
+		return false, ErrorFromGormError(err)
+	}
+	return count > 0, nil
+}
+
+func (s *CertificateRevocationStore) Get(ctx context.Context, serialNumber string) (*model.CertificateRevocation, error) {
+	var revocation model.CertificateRevocation
+	if err := s.getDB(ctx).Take(&revocation, "serial_number = ?", serialNumber).Error; err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+	return &revocation, nil
+}
+
+// ListUnexpired returns the revocations of certificates that have not expired
+// yet, ordered by revocation time.
+func (s *CertificateRevocationStore) ListUnexpired(ctx context.Context) ([]model.CertificateRevocation, error) {
+	var revocations []model.CertificateRevocation
+	err := s.getDB(ctx).Where("not_after > ?", time.Now()).Order("revoked_at, serial_number").Find(&revocations).Error
+	if err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+	return revocations, nil
+}
+
+// DeleteExpired deletes the revocations of certificates that have expired,
+// which are rejected regardless of their revocation.
+func (s *CertificateRevocationStore) DeleteExpired(ctx context.Context) (int64, error) {
+	result := s.getDB(ctx).Where("not_after <= ?", time.Now()).Delete(&model.CertificateRevocation{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to delete expired certificate revocations: %w", result.Error)
+	}
+	return result.RowsAffected, nil
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/store/selector"
@@ -25,6 +26,46 @@ type EventCallbackCaller func(ctx context.Context, callbackEvent EventCallback,
 
 type EventCallback func(ctx context.Context, resourceKind api.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error)
 
+// errDryRunRollback is returned from the transaction of a dry run so that its changes are rolled back
+var errDryRunRollback = errors.New("dry run")
+
+type dryRunTransactionKey struct{}
+
+// WithDryRun returns a context for a request whose changes are rolled back instead of committed
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, consts.DryRunCtxKey, true)
+}
+
+// IsDryRun returns true if the changes of the request must be rolled back instead of committed
+func IsDryRun(ctx context.Context) bool {
+	dryRun, ok := ctx.Value(consts.DryRunCtxKey).(bool)
+	return ok && dryRun
+}
+
+// dryRunTransaction returns the transaction of the dry run that the context belongs to, if any
+func dryRunTransaction(ctx context.Context) *gorm.DB {
+	tx, _ := ctx.Value(dryRunTransactionKey{}).(*gorm.DB)
+	return tx
+}
+
+// runDryRun runs fn in a transaction that is always rolled back, so that the writes are checked against the
+// database, including its constraints, without being persisted.  The queries of fn must use the database returned
+// by dryRunTransaction for the context that is passed to it.
+func runDryRun(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	var fnErr error
+	err := db.Transaction(func(tx *gorm.DB) error {
+		fnErr = fn(context.WithValue(ctx, dryRunTransactionKey{}, tx))
+		return errDryRunRollback
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+	if err != nil && !errors.Is(err, errDryRunRollback) {
+		return ErrorFromGormError(err)
+	}
+	return nil
+}
+
 func ErrorFromGormError(err error) error {
 	switch {
 	case err == nil:
@@ -340,7 +381,7 @@ func SafeEventCallback(log logrus.FieldLogger, callback func()) {
 
 func CallEventCallback(resourceKind api.ResourceKind, log logrus.FieldLogger) func(ctx context.Context, callbackEvent EventCallback, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	return func(ctx context.Context, callbackEvent EventCallback, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
-		if callbackEvent == nil {
+		if callbackEvent == nil || IsDryRun(ctx) {
 			return
 		}
 
@@ -0,0 +1,83 @@
+package store
+
+import (
+	"context"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type ConsoleSession interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, session *api.ConsoleSession) (*api.ConsoleSession, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*api.ConsoleSession, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.ConsoleSessionList, error)
+	UpdateStatus(ctx context.Context, orgId uuid.UUID, session *api.ConsoleSession) (*api.ConsoleSession, error)
+}
+
+type ConsoleSessionStore struct {
+	dbHandler    *gorm.DB
+	log          logrus.FieldLogger
+	genericStore *GenericStore[*model.ConsoleSession, model.ConsoleSession, api.ConsoleSession, api.ConsoleSessionList]
+}
+
+// Make sure we conform to ConsoleSession interface
+var _ ConsoleSession = (*ConsoleSessionStore)(nil)
+
+func NewConsoleSession(db *gorm.DB, log logrus.FieldLogger) ConsoleSession {
+	genericStore := NewGenericStore[*model.ConsoleSession, model.ConsoleSession, api.ConsoleSession, api.ConsoleSessionList](
+		db,
+		log,
+		model.NewConsoleSessionFromApiResource,
+		(*model.ConsoleSession).ToApiResource,
+		model.ConsoleSessionsToApiResource,
+	)
+	return &ConsoleSessionStore{dbHandler: db, log: log, genericStore: genericStore}
+}
+
+func (s *ConsoleSessionStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *ConsoleSessionStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.ConsoleSession{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for ConsoleSession labels
+	if !db.Migrator().HasIndex(&model.ConsoleSession{}, "idx_console_sessions_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_console_sessions_labels ON console_sessions USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.ConsoleSession{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *ConsoleSessionStore) Create(ctx context.Context, orgId uuid.UUID, resource *api.ConsoleSession) (*api.ConsoleSession, error) {
+	return s.genericStore.Create(ctx, orgId, resource)
+}
+
+func (s *ConsoleSessionStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*api.ConsoleSession, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *ConsoleSessionStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.ConsoleSessionList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *ConsoleSessionStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *api.ConsoleSession) (*api.ConsoleSession, error) {
+	return s.genericStore.UpdateStatus(ctx, orgId, resource)
+}
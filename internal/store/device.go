@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -59,15 +60,18 @@ type Device interface {
 	Healthcheck(ctx context.Context, orgId uuid.UUID, names []string) error
 	ProcessAwaitingReconnectAnnotation(ctx context.Context, orgId uuid.UUID, deviceName string, deviceReportedVersion *string) (bool, error)
 	GetLastSeen(ctx context.Context, orgId uuid.UUID, name string) (*time.Time, error)
+	Search(ctx context.Context, orgId uuid.UUID, text string, listParams ListParams) (*api.DeviceSearchResultList, error)
 
 	// Used internally
 	UpdateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) error
+	UpdateIntegrity(ctx context.Context, orgId uuid.UUID, name string, integrity api.DeviceIntegrityStatus) error
 	UpdateRendered(ctx context.Context, orgId uuid.UUID, name, renderedConfig, renderedApplications, specHash string) (string, error)
 	SetServiceConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []api.Condition, callback ServiceConditionsCallback) error
 	OverwriteRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string, repositoryNames ...string) error
 	GetRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string) (*api.RepositoryList, error)
 	PrepareDevicesAfterRestore(ctx context.Context) (int64, error)
 	RemoveConflictPausedAnnotation(ctx context.Context, orgId uuid.UUID, listParams ListParams) (int64, []string, error)
+	UnsetResourceSync(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, resourceSyncName string) error
 	SetOutOfDate(ctx context.Context, orgId uuid.UUID, owner string) error
 	ListDisconnected(ctx context.Context, orgId uuid.UUID, listParams ListParams, cutoffTime time.Time) (*api.DeviceList, error)
 	GetWithoutServiceConditions(ctx context.Context, orgId uuid.UUID, name string) (*api.Device, error)
@@ -114,7 +118,7 @@ func NewDevice(db *gorm.DB, log logrus.FieldLogger) Device {
 }
 
 func (s *DeviceStore) callEventCallback(ctx context.Context, eventCallback EventCallback, orgId uuid.UUID, name string, oldDevice, newDevice *api.Device, created bool, err error) {
-	if eventCallback == nil {
+	if eventCallback == nil || IsDryRun(ctx) {
 		return
 	}
 
@@ -124,6 +128,9 @@ func (s *DeviceStore) callEventCallback(ctx context.Context, eventCallback Event
 }
 
 func (s *DeviceStore) getDB(ctx context.Context) *gorm.DB {
+	if tx := dryRunTransaction(ctx); tx != nil {
+		return tx.WithContext(ctx)
+	}
 	return s.dbHandler.WithContext(ctx)
 }
 
@@ -134,7 +141,7 @@ func (s *DeviceStore) SetIntegrationTestCreateOrUpdateCallback(c IntegrationTest
 func (s *DeviceStore) InitialMigration(ctx context.Context) error {
 	db := s.getDB(ctx)
 
-	if err := db.AutoMigrate(&model.Device{}, &model.DeviceLabel{}, &model.DeviceTimestamp{}); err != nil {
+	if err := db.AutoMigrate(&model.Device{}, &model.DeviceLabel{}, &model.DeviceTimestamp{}, &model.DeviceSystemInfoText{}); err != nil {
 		return err
 	}
 
@@ -166,6 +173,10 @@ func (s *DeviceStore) InitialMigration(ctx context.Context) error {
 		return err
 	}
 
+	if err := s.createDeviceSearchIndex(db); err != nil {
+		return err
+	}
+
 	if err := s.createDeviceLabelsTrigger(db); err != nil {
 		return err
 	}
@@ -276,6 +287,80 @@ func (s *DeviceStore) createServiceConditionsIndex(db *gorm.DB) error {
 	return nil
 }
 
+// createDeviceSearchIndex creates the functions that build the text that device searches match, and trigram indexes
+// on it so that searching for a substring doesn't scan the devices table.  The text of the name, alias and labels is
+// indexed on the devices table, while the text of the system info is copied to device_system_info_texts by a trigger,
+// so that status updates that don't change the system info don't update a trigram index.
+func (s *DeviceStore) createDeviceSearchIndex(db *gorm.DB) error {
+	if db.Dialector.Name() != "postgres" {
+		return nil
+	}
+	if err := db.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error; err != nil {
+		return err
+	}
+	// The values of labels, of the system info and of the custom info are included, but not the nested objects.
+	// The text used to include the system info, so the former function is dropped with the index on it.
+	if err := db.Exec(`
+		DROP FUNCTION IF EXISTS device_search_text(TEXT, TEXT, JSONB, JSONB) CASCADE;
+
+		CREATE OR REPLACE FUNCTION device_search_text(name TEXT, alias TEXT, labels JSONB)
+		RETURNS TEXT
+		LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+			SELECT lower(concat_ws(' ', name, alias,
+				(SELECT string_agg(key || '=' || value, ' ')
+					FROM jsonb_each_text(CASE WHEN jsonb_typeof(labels) = 'object' THEN labels ELSE '{}'::jsonb END))
+			))
+		$$;
+
+		CREATE OR REPLACE FUNCTION device_system_info_search_text(status JSONB)
+		RETURNS TEXT
+		LANGUAGE sql IMMUTABLE PARALLEL SAFE AS $$
+			SELECT lower(concat_ws(' ',
+				(SELECT string_agg(value, ' ')
+					FROM jsonb_each_text(CASE WHEN jsonb_typeof(status->'systemInfo') = 'object' THEN status->'systemInfo' ELSE '{}'::jsonb END)
+					WHERE jsonb_typeof(status->'systemInfo'->key) = 'string'),
+				(SELECT string_agg(value, ' ')
+					FROM jsonb_each_text(CASE WHEN jsonb_typeof(status#>'{systemInfo,customInfo}') = 'object' THEN status#>'{systemInfo,customInfo}' ELSE '{}'::jsonb END))
+			))
+		$$;
+
+		CREATE OR REPLACE FUNCTION sync_device_system_info_text()
+		RETURNS TRIGGER AS $$
+		BEGIN
+			INSERT INTO device_system_info_texts (org_id, device_name, text)
+			VALUES (NEW.org_id, NEW.name, device_system_info_search_text(NEW.status))
+			ON CONFLICT (org_id, device_name) DO UPDATE
+			SET text = EXCLUDED.text;
+			RETURN NEW;
+		END;
+		$$ LANGUAGE plpgsql;
+
+		DROP TRIGGER IF EXISTS device_system_info_text_insert ON devices;
+		DROP TRIGGER IF EXISTS device_system_info_text_update ON devices;
+
+		CREATE TRIGGER device_system_info_text_insert
+		AFTER INSERT ON devices
+		FOR EACH ROW
+		EXECUTE FUNCTION sync_device_system_info_text();
+
+		CREATE TRIGGER device_system_info_text_update
+		AFTER UPDATE OF status ON devices
+		FOR EACH ROW
+		WHEN (OLD.status->'systemInfo' IS DISTINCT FROM NEW.status->'systemInfo')
+		EXECUTE FUNCTION sync_device_system_info_text();
+
+		INSERT INTO device_system_info_texts (org_id, device_name, text)
+		SELECT org_id, name, device_system_info_search_text(status) FROM devices
+		ON CONFLICT (org_id, device_name) DO NOTHING;
+	`).Error; err != nil {
+		return err
+	}
+	if err := db.Exec("CREATE INDEX IF NOT EXISTS idx_device_system_info_text ON device_system_info_texts USING GIN (text gin_trgm_ops)").Error; err != nil {
+		return err
+	}
+	return db.Exec(fmt.Sprintf("CREATE INDEX IF NOT EXISTS idx_device_search ON devices USING GIN (%s gin_trgm_ops)", deviceSearchTextExpr)).Error
+}
+
 func (s *DeviceStore) createDeviceLabelsTrigger(db *gorm.DB) error {
 	if db.Dialector.Name() == "postgres" {
 		triggerSQL := `
@@ -408,6 +493,118 @@ func (s *DeviceStore) List(ctx context.Context, orgId uuid.UUID, listParams List
 	return s.genericStore.List(ctx, orgId, listParams)
 }
 
+// deviceSearchTextExpr is the lowercase text of the name, alias and labels that device searches match, as indexed
+// by idx_device_search
+const deviceSearchTextExpr = "device_search_text(name, alias, labels)"
+
+// deviceSystemInfoTextExpr is the lowercase text of the system info that device searches match, as indexed by
+// idx_device_system_info_text
+const deviceSystemInfoTextExpr = `COALESCE((SELECT text FROM device_system_info_texts
+	WHERE device_system_info_texts.org_id = devices.org_id AND device_system_info_texts.device_name = devices.name), '')`
+
+// deviceSearchMatchExpr selects the devices whose text contains a pattern.  Each text is matched on its own, so
+// that both trigram indexes are used.
+const deviceSearchMatchExpr = `name IN (
+	SELECT name FROM devices WHERE org_id = ? AND ` + deviceSearchTextExpr + ` LIKE ?
+	UNION SELECT device_name FROM device_system_info_texts WHERE org_id = ? AND text LIKE ?)`
+
+// deviceSearchScoreExpr ranks the devices that match a search: exact matches of the name or alias first, then
+// prefixes of the name or alias, and then by how closely the text matches a word of the searchable fields
+const deviceSearchScoreExpr = `(CASE
+		WHEN lower(name) = ? OR lower(alias) = ? THEN 2000
+		WHEN lower(name) LIKE ? OR lower(alias) LIKE ? THEN 1000
+		ELSE 0
+	END + round(word_similarity(?, concat_ws(' ', ` + deviceSearchTextExpr + `, ` + deviceSystemInfoTextExpr + `)) * 1000))::int`
+
+type deviceSearchResult struct {
+	model.Device
+	SearchScore int32
+}
+
+// Search returns the devices whose name, alias, labels or system info contain the text, ignoring case.  The results are
+// ordered by decreasing score and then by name, and the continue token holds the score and name of the next result.
+func (s *DeviceStore) Search(ctx context.Context, orgId uuid.UUID, text string, listParams ListParams) (*api.DeviceSearchResultList, error) {
+	text = strings.ToLower(text)
+	escaped := escapeLikePattern(text)
+
+	query, err := ListQuery(&model.Device{}).BuildNoOrder(ctx, s.getDB(ctx), orgId, listParams)
+	if err != nil {
+		return nil, err
+	}
+	query = query.
+		Select("*, "+deviceSearchScoreExpr+" AS search_score", text, text, escaped+"%", escaped+"%", text).
+		Where("spec IS NOT NULL").
+		Where(deviceSearchMatchExpr, orgId, "%"+escaped+"%", orgId, "%"+escaped+"%")
+
+	results := s.getDB(ctx).Table("(?) AS results", query)
+	if listParams.Continue != nil {
+		score, name, err := parseDeviceSearchContinue(listParams.Continue)
+		if err != nil {
+			return nil, err
+		}
+		results = results.Where("search_score < ? OR (search_score = ? AND name >= ?)", score, score, name)
+	}
+	results = results.Order("search_score DESC, name ASC")
+	if listParams.Limit > 0 {
+		// Request 1 more than the user asked for to see if we need to return "continue"
+		results = results.Limit(listParams.Limit + 1)
+	}
+
+	var devices []deviceSearchResult
+	if err := results.Scan(&devices).Error; err != nil {
+		return nil, ErrorFromGormError(err)
+	}
+
+	ret := &api.DeviceSearchResultList{Items: []api.DeviceSearchResult{}}
+	if listParams.Limit > 0 && len(devices) > listParams.Limit {
+		next := devices[len(devices)-1]
+		devices = devices[:len(devices)-1]
+
+		var numRemaining int64
+		if listParams.Continue != nil {
+			numRemaining = max(listParams.Continue.Count-int64(listParams.Limit), 1)
+		} else {
+			err := s.getDB(ctx).Table("(?) AS results", query).
+				Where("search_score < ? OR (search_score = ? AND name >= ?)", next.SearchScore, next.SearchScore, next.Name).
+				Count(&numRemaining).Error
+			if err != nil {
+				return nil, ErrorFromGormError(err)
+			}
+		}
+		ret.Metadata.Continue = BuildContinueString([]string{strconv.Itoa(int(next.SearchScore)), next.Name}, numRemaining)
+		ret.Metadata.RemainingItemCount = &numRemaining
+	}
+
+	for i := range devices {
+		device, err := devices[i].Device.ToApiResource()
+		if err != nil {
+			return nil, err
+		}
+		ret.Items = append(ret.Items, api.DeviceSearchResult{
+			Device:  *device,
+			Score:   devices[i].SearchScore,
+			Matches: []string{},
+		})
+	}
+	return ret, nil
+}
+
+func parseDeviceSearchContinue(cont *Continue) (int32, string, error) {
+	if len(cont.Names) != 2 {
+		return 0, "", flterrors.ErrInvalidContinue
+	}
+	score, err := strconv.ParseInt(cont.Names[0], 10, 32)
+	if err != nil {
+		return 0, "", flterrors.ErrInvalidContinue
+	}
+	return int32(score), cont.Names[1], nil
+}
+
+// escapeLikePattern escapes the characters that have a special meaning in a LIKE pattern
+func escapeLikePattern(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 func (s *DeviceStore) ListDisconnected(ctx context.Context, orgId uuid.UUID, listParams ListParams, cutoffTime time.Time) (*api.DeviceList, error) {
 	var nextContinue *string
 	var numRemaining *int64
@@ -758,6 +955,28 @@ func (s *DeviceStore) UpdateAnnotations(ctx context.Context, orgId uuid.UUID, na
 	})
 }
 
+// UpdateIntegrity replaces only the integrity status of the device, so that it doesn't overwrite the rest of
+// the status reported concurrently by the agent.
+func (s *DeviceStore) UpdateIntegrity(ctx context.Context, orgId uuid.UUID, name string, integrity api.DeviceIntegrityStatus) error {
+	integrityJSON, err := json.Marshal(integrity)
+	if err != nil {
+		return err
+	}
+	return retryUpdate(func() (bool, error) {
+		result := s.getDB(ctx).Model(&model.Device{}).Where("org_id = ? AND name = ?", orgId, name).Updates(map[string]any{
+			"status":           gorm.Expr(`jsonb_set(COALESCE(status, '{}'::jsonb), '{integrity}', ?::jsonb, true)`, string(integrityJSON)),
+			"resource_version": gorm.Expr("resource_version + 1"),
+		})
+		if err := ErrorFromGormError(result.Error); err != nil {
+			return strings.Contains(err.Error(), "deadlock"), err
+		}
+		if result.RowsAffected == 0 {
+			return false, flterrors.ErrResourceNotFound
+		}
+		return false, nil
+	})
+}
+
 func (s *DeviceStore) healthcheck(ctx context.Context, orgId uuid.UUID, names []string) (bool, error) {
 	// Handle empty device list gracefully
 	if len(names) == 0 {
@@ -1378,6 +1597,22 @@ func (s *DeviceStore) RemoveConflictPausedAnnotation(ctx context.Context, orgId
 	return affectedRows, deviceIDs, err
 }
 
+// UnsetResourceSync removes the annotations that mark the devices as synced by the ResourceSync.  The labels and
+// annotations that were synced are kept, but are no longer managed by it.
+func (s *DeviceStore) UnsetResourceSync(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, resourceSyncName string) error {
+	db := s.getDB(ctx)
+	if tx != nil {
+		db = tx
+	}
+	result := db.Model(&model.Device{}).
+		Where("org_id = ? AND annotations ->> ? = ?", orgId, api.DeviceAnnotationResourceSync, resourceSyncName).
+		Updates(map[string]any{
+			"annotations":      gorm.Expr("annotations - ? - ?", api.DeviceAnnotationResourceSync, api.DeviceAnnotationResourceSyncManagedKeys),
+			"resource_version": gorm.Expr("resource_version + 1"),
+		})
+	return ErrorFromGormError(result.Error)
+}
+
 // GetAllDeviceNames returns all device names for a given organization
 // This is used for restoration to add awaiting reconnection keys
 func (s *DeviceStore) GetAllDeviceNames(ctx context.Context, orgId uuid.UUID) ([]string, error) {
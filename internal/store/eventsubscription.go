@@ -0,0 +1,123 @@
+package store
+
+import (
+	"context"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+type EventSubscription interface {
+	InitialMigration(ctx context.Context) error
+
+	Create(ctx context.Context, orgId uuid.UUID, subscription *api.EventSubscription, eventCallback EventCallback) (*api.EventSubscription, error)
+	Update(ctx context.Context, orgId uuid.UUID, subscription *api.EventSubscription, eventCallback EventCallback) (*api.EventSubscription, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, subscription *api.EventSubscription, eventCallback EventCallback) (*api.EventSubscription, bool, error)
+	Get(ctx context.Context, orgId uuid.UUID, name string) (*api.EventSubscription, error)
+	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.EventSubscriptionList, error)
+	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
+	UpdateStatus(ctx context.Context, orgId uuid.UUID, subscription *api.EventSubscription) (*api.EventSubscription, error)
+}
+
+type EventSubscriptionStore struct {
+	dbHandler           *gorm.DB
+	log                 logrus.FieldLogger
+	genericStore        *GenericStore[*model.EventSubscription, model.EventSubscription, api.EventSubscription, api.EventSubscriptionList]
+	eventCallbackCaller EventCallbackCaller
+}
+
+// Make sure we conform to EventSubscription interface
+var _ EventSubscription = (*EventSubscriptionStore)(nil)
+
+func NewEventSubscription(db *gorm.DB, log logrus.FieldLogger) EventSubscription {
+	genericStore := NewGenericStore[*model.EventSubscription, model.EventSubscription, api.EventSubscription, api.EventSubscriptionList](
+		db,
+		log,
+		model.NewEventSubscriptionFromApiResource,
+		(*model.EventSubscription).ToApiResource,
+		model.EventSubscriptionsToApiResource,
+	)
+	return &EventSubscriptionStore{dbHandler: db, log: log, genericStore: genericStore, eventCallbackCaller: CallEventCallback(api.EventSubscriptionKind, log)}
+}
+
+func (s *EventSubscriptionStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *EventSubscriptionStore) InitialMigration(ctx context.Context) error {
+	db := s.getDB(ctx)
+
+	if err := db.AutoMigrate(&model.EventSubscription{}); err != nil {
+		return err
+	}
+
+	// Create GIN index for EventSubscription labels
+	if !db.Migrator().HasIndex(&model.EventSubscription{}, "idx_event_subscriptions_labels") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_event_subscriptions_labels ON event_subscriptions USING GIN (labels)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.EventSubscription{}, "Labels"); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Create GIN index for EventSubscription annotations
+	if !db.Migrator().HasIndex(&model.EventSubscription{}, "idx_event_subscriptions_annotations") {
+		if db.Dialector.Name() == "postgres" {
+			if err := db.Exec("CREATE INDEX idx_event_subscriptions_annotations ON event_subscriptions USING GIN (annotations)").Error; err != nil {
+				return err
+			}
+		} else {
+			if err := db.Migrator().CreateIndex(&model.EventSubscription{}, "Annotations"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *EventSubscriptionStore) Create(ctx context.Context, orgId uuid.UUID, resource *api.EventSubscription, eventCallback EventCallback) (*api.EventSubscription, error) {
+	subscription, err := s.genericStore.Create(ctx, orgId, resource)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), nil, subscription, true, err)
+	return subscription, err
+}
+
+func (s *EventSubscriptionStore) Update(ctx context.Context, orgId uuid.UUID, resource *api.EventSubscription, eventCallback EventCallback) (*api.EventSubscription, error) {
+	newSubscription, oldSubscription, err := s.genericStore.Update(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldSubscription, newSubscription, false, err)
+	return newSubscription, err
+}
+
+func (s *EventSubscriptionStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.EventSubscription, eventCallback EventCallback) (*api.EventSubscription, bool, error) {
+	newSubscription, oldSubscription, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldSubscription, newSubscription, created, err)
+	return newSubscription, created, err
+}
+
+func (s *EventSubscriptionStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*api.EventSubscription, error) {
+	return s.genericStore.Get(ctx, orgId, name)
+}
+
+func (s *EventSubscriptionStore) List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.EventSubscriptionList, error) {
+	return s.genericStore.List(ctx, orgId, listParams)
+}
+
+func (s *EventSubscriptionStore) Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error {
+	deleted, err := s.genericStore.Delete(ctx, model.EventSubscription{Resource: model.Resource{OrgID: orgId, Name: name}})
+	if deleted && eventCallback != nil {
+		s.eventCallbackCaller(ctx, eventCallback, orgId, name, nil, nil, false, nil)
+	}
+	return err
+}
+
+func (s *EventSubscriptionStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *api.EventSubscription) (*api.EventSubscription, error) {
+	return s.genericStore.UpdateStatus(ctx, orgId, resource)
+}
@@ -63,7 +63,7 @@ func NewFleet(db *gorm.DB, log logrus.FieldLogger) Fleet {
 }
 
 func (s *FleetStore) callEventCallback(ctx context.Context, eventCallback EventCallback, orgId uuid.UUID, name string, oldFleet, newFleet *api.Fleet, created bool, err error) {
-	if eventCallback == nil {
+	if eventCallback == nil || IsDryRun(ctx) {
 		return
 	}
 
@@ -72,6 +72,9 @@ func (s *FleetStore) callEventCallback(ctx context.Context, eventCallback EventC
 	})
 }
 func (s *FleetStore) getDB(ctx context.Context) *gorm.DB {
+	if tx := dryRunTransaction(ctx); tx != nil {
+		return tx.WithContext(ctx)
+	}
 	return s.dbHandler.WithContext(ctx)
 }
 
@@ -24,7 +24,7 @@ type IntegrationTestCallback func()
 // A is the API resource, for example: api.Device
 // AL is the API list, for example: api.DeviceList
 type Model interface {
-	model.CertificateSigningRequest | model.Device | model.EnrollmentRequest | model.Fleet | model.Repository | model.ResourceSync | model.TemplateVersion | model.Event | model.ImageBuild
+	model.CertificateSigningRequest | model.Device | model.EnrollmentRequest | model.Fleet | model.Repository | model.ResourceSync | model.TemplateVersion | model.Event | model.ImageBuild | model.EventSubscription | model.BulkOperation | model.EnrollmentPolicy | model.ConsoleSession
 }
 type extInt[M any] interface {
 	model.ResourceInterface
@@ -67,6 +67,9 @@ func NewGenericStore[P extInt[M], M Model, A any, AL any](
 }
 
 func (s *GenericStore[P, M, A, AL]) getDB(ctx context.Context) *gorm.DB {
+	if tx := dryRunTransaction(ctx); tx != nil {
+		return tx.WithContext(ctx)
+	}
 	return s.dbHandler.WithContext(ctx)
 }
 
@@ -89,6 +92,23 @@ func (s *GenericStore[P, M, A, AL]) CreateOrUpdate(ctx context.Context, orgId uu
 }
 
 func (s *GenericStore[P, M, A, AL]) createOrUpdate(ctx context.Context, orgId uuid.UUID, resource *A, fieldsToUnset []string, fromAPI bool, mode CreateOrUpdateMode, validationCallback func(ctx context.Context, before, after *A) error) (*A, *A, bool, bool, error) {
+	if !IsDryRun(ctx) || dryRunTransaction(ctx) != nil {
+		return s.createOrUpdateResource(ctx, orgId, resource, fieldsToUnset, fromAPI, mode, validationCallback)
+	}
+
+	var (
+		updated, before *A
+		created, retry  bool
+	)
+	err := runDryRun(ctx, s.getDB(ctx), func(ctx context.Context) error {
+		var err error
+		updated, before, created, retry, err = s.createOrUpdateResource(ctx, orgId, resource, fieldsToUnset, fromAPI, mode, validationCallback)
+		return err
+	})
+	return updated, before, created, retry, err
+}
+
+func (s *GenericStore[P, M, A, AL]) createOrUpdateResource(ctx context.Context, orgId uuid.UUID, resource *A, fieldsToUnset []string, fromAPI bool, mode CreateOrUpdateMode, validationCallback func(ctx context.Context, before, after *A) error) (*A, *A, bool, bool, error) {
 	if resource == nil {
 		return nil, nil, false, false, flterrors.ErrResourceIsNil
 	}
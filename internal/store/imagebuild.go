@@ -44,7 +44,7 @@ func NewImageBuild(db *gorm.DB, log logrus.FieldLogger) ImageBuild {
 }
 
 func (s *ImageBuildStore) callEventCallback(ctx context.Context, eventCallback EventCallback, orgId uuid.UUID, name string, oldImageBuild, newImageBuild *api.ImageBuild, created bool, err error) {
-	if eventCallback == nil {
+	if eventCallback == nil || IsDryRun(ctx) {
 		return
 	}
 
@@ -0,0 +1,146 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type BulkOperation struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[api.BulkOperationSpec] `gorm:"type:jsonb"`
+
+	// The last reported state, stored as opaque JSON object.
+	Status *JSONField[api.BulkOperationStatus] `gorm:"type:jsonb"`
+}
+
+func (b BulkOperation) String() string {
+	val, _ := json.Marshal(b)
+	return string(val)
+}
+
+func NewBulkOperationFromApiResource(resource *api.BulkOperation) (*BulkOperation, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &BulkOperation{}, nil
+	}
+
+	status := api.BulkOperationStatus{Phase: api.BulkOperationPhasePending}
+	if resource.Status != nil {
+		status = *resource.Status
+	}
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &BulkOperation{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			ResourceVersion: resourceVersion,
+		},
+		Spec:   MakeJSONField(resource.Spec),
+		Status: MakeJSONField(status),
+	}, nil
+}
+
+func BulkOperationAPIVersion() string {
+	return fmt.Sprintf("%s/%s", api.APIGroup, api.BulkOperationAPIVersion)
+}
+
+func (b *BulkOperation) ToApiResource(opts ...APIResourceOption) (*api.BulkOperation, error) {
+	if b == nil {
+		return &api.BulkOperation{}, nil
+	}
+
+	var spec api.BulkOperationSpec
+	if b.Spec != nil {
+		spec = b.Spec.Data
+	}
+
+	status := api.BulkOperationStatus{Phase: api.BulkOperationPhasePending}
+	if b.Status != nil {
+		status = b.Status.Data
+	}
+
+	return &api.BulkOperation{
+		ApiVersion: BulkOperationAPIVersion(),
+		Kind:       api.BulkOperationKind,
+		Metadata: api.ObjectMeta{
+			Name:              lo.ToPtr(b.Name),
+			CreationTimestamp: lo.ToPtr(b.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(b.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(b.Resource.Annotations)),
+			ResourceVersion:   lo.Ternary(b.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(b.ResourceVersion), 10)), nil),
+		},
+		Spec:   spec,
+		Status: &status,
+	}, nil
+}
+
+func BulkOperationsToApiResource(operations []BulkOperation, cont *string, numRemaining *int64) (api.BulkOperationList, error) {
+	operationList := make([]api.BulkOperation, len(operations))
+	for i, operation := range operations {
+		apiResource, err := operation.ToApiResource()
+		if err != nil {
+			return api.BulkOperationList{
+				ApiVersion: BulkOperationAPIVersion(),
+				Kind:       api.BulkOperationListKind,
+				Items:      []api.BulkOperation{},
+			}, err
+		}
+		operationList[i] = *apiResource
+	}
+	ret := api.BulkOperationList{
+		ApiVersion: BulkOperationAPIVersion(),
+		Kind:       api.BulkOperationListKind,
+		Items:      operationList,
+		Metadata:   api.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (b *BulkOperation) GetKind() string {
+	return api.BulkOperationKind
+}
+
+func (b *BulkOperation) HasNilSpec() bool {
+	return b.Spec == nil
+}
+
+func (b *BulkOperation) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*BulkOperation)
+	if !ok {
+		return false
+	}
+	if other == nil {
+		return false
+	}
+	if b.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (b.Spec == nil && other.Spec != nil) || (b.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(b.Spec.Data, other.Spec.Data)
+}
+
+func (b *BulkOperation) GetStatusAsJson() ([]byte, error) {
+	return b.Status.MarshalJSON()
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CertificateRevocation records a certificate issued by the internal CA that
+// must no longer be accepted. Entries are kept until the certificate expires.
+type CertificateRevocation struct {
+	// SerialNumber is the lower-case hexadecimal serial number of the certificate.
+	SerialNumber string    `gorm:"primaryKey"`
+	OrgID        uuid.UUID `gorm:"type:uuid;index"`
+	CommonName   string
+	Reason       int
+	RevokedAt    time.Time
+	NotAfter     time.Time `gorm:"index"`
+}
+
+func (r CertificateRevocation) String() string {
+	val, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Sprintf("CertificateRevocation<marshal-error:%v>", err)
+	}
+	return string(val)
+}
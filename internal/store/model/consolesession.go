@@ -0,0 +1,149 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type ConsoleSession struct {
+	Resource
+
+	// The requested session, stored as opaque JSON object.
+	Spec *JSONField[api.ConsoleSessionSpec] `gorm:"type:jsonb"`
+
+	// The lifetime and outcome of the session, stored as opaque JSON object.
+	Status *JSONField[api.ConsoleSessionStatus] `gorm:"type:jsonb"`
+}
+
+func (c ConsoleSession) String() string {
+	val, _ := json.Marshal(c)
+	return string(val)
+}
+
+func NewConsoleSessionFromApiResource(resource *api.ConsoleSession) (*ConsoleSession, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &ConsoleSession{}, nil
+	}
+
+	var status api.ConsoleSessionStatus
+	if resource.Status != nil {
+		status = *resource.Status
+	}
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &ConsoleSession{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			ResourceVersion: resourceVersion,
+			// the owner lets sessions be listed by device with the metadata.owner field selector
+			Owner: util.SetResourceOwner(api.DeviceKind, resource.Spec.DeviceName),
+		},
+		Spec:   MakeJSONField(resource.Spec),
+		Status: MakeJSONField(status),
+	}, nil
+}
+
+func ConsoleSessionAPIVersion() string {
+	return fmt.Sprintf("%s/%s", api.APIGroup, api.ConsoleSessionAPIVersion)
+}
+
+func (c *ConsoleSession) ToApiResource(opts ...APIResourceOption) (*api.ConsoleSession, error) {
+	if c == nil {
+		return &api.ConsoleSession{}, nil
+	}
+
+	var spec api.ConsoleSessionSpec
+	if c.Spec != nil {
+		spec = c.Spec.Data
+	}
+
+	var status api.ConsoleSessionStatus
+	if c.Status != nil {
+		status = c.Status.Data
+	}
+
+	return &api.ConsoleSession{
+		ApiVersion: ConsoleSessionAPIVersion(),
+		Kind:       api.ConsoleSessionKind,
+		Metadata: api.ObjectMeta{
+			Name:              lo.ToPtr(c.Name),
+			CreationTimestamp: lo.ToPtr(c.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(c.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(c.Resource.Annotations)),
+			Owner:             c.Owner,
+			ResourceVersion:   lo.Ternary(c.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(c.ResourceVersion), 10)), nil),
+		},
+		Spec:   spec,
+		Status: &status,
+	}, nil
+}
+
+func ConsoleSessionsToApiResource(sessions []ConsoleSession, cont *string, numRemaining *int64) (api.ConsoleSessionList, error) {
+	sessionList := make([]api.ConsoleSession, len(sessions))
+	for i, session := range sessions {
+		apiResource, err := session.ToApiResource()
+		if err != nil {
+			return api.ConsoleSessionList{
+				ApiVersion: ConsoleSessionAPIVersion(),
+				Kind:       api.ConsoleSessionListKind,
+				Items:      []api.ConsoleSession{},
+			}, err
+		}
+		sessionList[i] = *apiResource
+	}
+	ret := api.ConsoleSessionList{
+		ApiVersion: ConsoleSessionAPIVersion(),
+		Kind:       api.ConsoleSessionListKind,
+		Items:      sessionList,
+		Metadata:   api.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (c *ConsoleSession) GetKind() string {
+	return api.ConsoleSessionKind
+}
+
+func (c *ConsoleSession) HasNilSpec() bool {
+	return c.Spec == nil
+}
+
+func (c *ConsoleSession) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*ConsoleSession)
+	if !ok {
+		return false
+	}
+	if other == nil {
+		return false
+	}
+	if c.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (c.Spec == nil && other.Spec != nil) || (c.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(c.Spec.Data, other.Spec.Data)
+}
+
+func (c *ConsoleSession) GetStatusAsJson() ([]byte, error) {
+	return c.Status.MarshalJSON()
+}
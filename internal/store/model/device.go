@@ -79,6 +79,18 @@ type DeviceLabel struct {
 	Device Device `gorm:"foreignKey:OrgID,DeviceName;references:OrgID,Name;constraint:OnDelete:CASCADE"`
 }
 
+// DeviceSystemInfoText holds the lowercase values of the system info and custom info of a device, which device
+// searches match.  The status of a device changes far more often than its system info, so the text is kept in its
+// own table, whose trigram index is only updated when the system info changes.
+type DeviceSystemInfoText struct {
+	OrgID      uuid.UUID `gorm:"primaryKey;type:uuid"`
+	DeviceName string    `gorm:"primaryKey"`
+	Text       string    `gorm:"type:text"`
+
+	// Foreign Key Constraint with CASCADE DELETE
+	Device Device `gorm:"foreignKey:OrgID,DeviceName;references:OrgID,Name;constraint:OnDelete:CASCADE"`
+}
+
 type ServiceConditions struct {
 	Conditions *[]api.Condition `json:"conditions,omitempty"`
 }
@@ -0,0 +1,132 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type EnrollmentPolicy struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[api.EnrollmentPolicySpec] `gorm:"type:jsonb"`
+}
+
+func (ep EnrollmentPolicy) String() string {
+	val, _ := json.Marshal(ep)
+	return string(val)
+}
+
+func NewEnrollmentPolicyFromApiResource(resource *api.EnrollmentPolicy) (*EnrollmentPolicy, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &EnrollmentPolicy{}, nil
+	}
+
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &EnrollmentPolicy{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			ResourceVersion: resourceVersion,
+		},
+		Spec: MakeJSONField(resource.Spec),
+	}, nil
+}
+
+func EnrollmentPolicyAPIVersion() string {
+	return fmt.Sprintf("%s/%s", api.APIGroup, api.EnrollmentPolicyAPIVersion)
+}
+
+func (ep *EnrollmentPolicy) ToApiResource(opts ...APIResourceOption) (*api.EnrollmentPolicy, error) {
+	if ep == nil {
+		return &api.EnrollmentPolicy{}, nil
+	}
+
+	var spec api.EnrollmentPolicySpec
+	if ep.Spec != nil {
+		spec = ep.Spec.Data
+	}
+
+	return &api.EnrollmentPolicy{
+		ApiVersion: EnrollmentPolicyAPIVersion(),
+		Kind:       api.EnrollmentPolicyKind,
+		Metadata: api.ObjectMeta{
+			Name:              lo.ToPtr(ep.Name),
+			CreationTimestamp: lo.ToPtr(ep.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(ep.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(ep.Resource.Annotations)),
+			ResourceVersion:   lo.Ternary(ep.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(ep.ResourceVersion), 10)), nil),
+		},
+		Spec: spec,
+	}, nil
+}
+
+func EnrollmentPoliciesToApiResource(policies []EnrollmentPolicy, cont *string, numRemaining *int64) (api.EnrollmentPolicyList, error) {
+	policyList := make([]api.EnrollmentPolicy, len(policies))
+	for i, policy := range policies {
+		apiResource, err := policy.ToApiResource()
+		if err != nil {
+			return api.EnrollmentPolicyList{
+				ApiVersion: EnrollmentPolicyAPIVersion(),
+				Kind:       api.EnrollmentPolicyListKind,
+				Items:      []api.EnrollmentPolicy{},
+			}, err
+		}
+		policyList[i] = *apiResource
+	}
+	ret := api.EnrollmentPolicyList{
+		ApiVersion: EnrollmentPolicyAPIVersion(),
+		Kind:       api.EnrollmentPolicyListKind,
+		Items:      policyList,
+		Metadata:   api.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (ep *EnrollmentPolicy) GetKind() string {
+	return api.EnrollmentPolicyKind
+}
+
+func (ep *EnrollmentPolicy) HasNilSpec() bool {
+	return ep.Spec == nil
+}
+
+func (ep *EnrollmentPolicy) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*EnrollmentPolicy)
+	if !ok {
+		return false
+	}
+	if other == nil {
+		return false
+	}
+	if ep.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (ep.Spec == nil && other.Spec != nil) || (ep.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(ep.Spec.Data, other.Spec.Data)
+}
+
+func (ep *EnrollmentPolicy) GetStatusAsJson() ([]byte, error) {
+	return nil, nil
+}
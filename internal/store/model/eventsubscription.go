@@ -0,0 +1,146 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+type EventSubscription struct {
+	Resource
+
+	// The desired state, stored as opaque JSON object.
+	Spec *JSONField[api.EventSubscriptionSpec] `gorm:"type:jsonb"`
+
+	// The last reported state, stored as opaque JSON object.
+	Status *JSONField[api.EventSubscriptionStatus] `gorm:"type:jsonb"`
+}
+
+func (es EventSubscription) String() string {
+	val, _ := json.Marshal(es)
+	return string(val)
+}
+
+func NewEventSubscriptionFromApiResource(resource *api.EventSubscription) (*EventSubscription, error) {
+	if resource == nil || resource.Metadata.Name == nil {
+		return &EventSubscription{}, nil
+	}
+
+	status := api.EventSubscriptionStatus{Conditions: []api.Condition{}}
+	if resource.Status != nil {
+		status = *resource.Status
+	}
+	var resourceVersion *int64
+	if resource.Metadata.ResourceVersion != nil {
+		i, err := strconv.ParseInt(lo.FromPtr(resource.Metadata.ResourceVersion), 10, 64)
+		if err != nil {
+			return nil, flterrors.ErrIllegalResourceVersionFormat
+		}
+		resourceVersion = &i
+	}
+	return &EventSubscription{
+		Resource: Resource{
+			Name:            *resource.Metadata.Name,
+			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
+			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			ResourceVersion: resourceVersion,
+		},
+		Spec:   MakeJSONField(resource.Spec),
+		Status: MakeJSONField(status),
+	}, nil
+}
+
+func EventSubscriptionAPIVersion() string {
+	return fmt.Sprintf("%s/%s", api.APIGroup, api.EventSubscriptionAPIVersion)
+}
+
+func (es *EventSubscription) ToApiResource(opts ...APIResourceOption) (*api.EventSubscription, error) {
+	if es == nil {
+		return &api.EventSubscription{}, nil
+	}
+
+	var spec api.EventSubscriptionSpec
+	if es.Spec != nil {
+		spec = es.Spec.Data
+	}
+
+	status := api.EventSubscriptionStatus{Conditions: []api.Condition{}}
+	if es.Status != nil {
+		status = es.Status.Data
+	}
+
+	return &api.EventSubscription{
+		ApiVersion: EventSubscriptionAPIVersion(),
+		Kind:       api.EventSubscriptionKind,
+		Metadata: api.ObjectMeta{
+			Name:              lo.ToPtr(es.Name),
+			CreationTimestamp: lo.ToPtr(es.CreatedAt.UTC()),
+			Labels:            lo.ToPtr(util.EnsureMap(es.Resource.Labels)),
+			Annotations:       lo.ToPtr(util.EnsureMap(es.Resource.Annotations)),
+			ResourceVersion:   lo.Ternary(es.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(es.ResourceVersion), 10)), nil),
+		},
+		Spec:   spec,
+		Status: &status,
+	}, nil
+}
+
+func EventSubscriptionsToApiResource(subscriptions []EventSubscription, cont *string, numRemaining *int64) (api.EventSubscriptionList, error) {
+	subscriptionList := make([]api.EventSubscription, len(subscriptions))
+	for i, subscription := range subscriptions {
+		apiResource, err := subscription.ToApiResource()
+		if err != nil {
+			return api.EventSubscriptionList{
+				ApiVersion: EventSubscriptionAPIVersion(),
+				Kind:       api.EventSubscriptionListKind,
+				Items:      []api.EventSubscription{},
+			}, err
+		}
+		subscriptionList[i] = *apiResource
+	}
+	ret := api.EventSubscriptionList{
+		ApiVersion: EventSubscriptionAPIVersion(),
+		Kind:       api.EventSubscriptionListKind,
+		Items:      subscriptionList,
+		Metadata:   api.ListMeta{},
+	}
+	if cont != nil {
+		ret.Metadata.Continue = cont
+		ret.Metadata.RemainingItemCount = numRemaining
+	}
+	return ret, nil
+}
+
+func (es *EventSubscription) GetKind() string {
+	return api.EventSubscriptionKind
+}
+
+func (es *EventSubscription) HasNilSpec() bool {
+	return es.Spec == nil
+}
+
+func (es *EventSubscription) HasSameSpecAs(otherResource any) bool {
+	other, ok := otherResource.(*EventSubscription)
+	if !ok {
+		return false
+	}
+	if other == nil {
+		return false
+	}
+	if es.Spec == nil && other.Spec == nil {
+		return true
+	}
+	if (es.Spec == nil && other.Spec != nil) || (es.Spec != nil && other.Spec == nil) {
+		return false
+	}
+	return reflect.DeepEqual(es.Spec.Data, other.Spec.Data)
+}
+
+func (es *EventSubscription) GetStatusAsJson() ([]byte, error) {
+	return es.Status.MarshalJSON()
+}
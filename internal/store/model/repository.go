@@ -55,6 +55,7 @@ func NewRepositoryFromApiResource(resource *api.Repository) (*Repository, error)
 			Name:            *resource.Metadata.Name,
 			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
 			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
+			Owner:           resource.Metadata.Owner,
 			ResourceVersion: resourceVersion,
 		},
 		Spec:   MakeJSONField(resource.Spec),
@@ -89,6 +90,7 @@ func (r *Repository) ToApiResource(opts ...APIResourceOption) (*api.Repository,
 			CreationTimestamp: lo.ToPtr(r.CreatedAt.UTC()),
 			Labels:            lo.ToPtr(util.EnsureMap(r.Resource.Labels)),
 			Annotations:       lo.ToPtr(util.EnsureMap(r.Resource.Annotations)),
+			Owner:             r.Owner,
 			ResourceVersion:   lo.Ternary(r.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(r.ResourceVersion), 10)), nil),
 		},
 		Spec:   spec,
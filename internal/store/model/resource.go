@@ -185,3 +185,7 @@ var _ ResourceInterface = (*Repository)(nil)
 var _ ResourceInterface = (*ResourceSync)(nil)
 var _ ResourceInterface = (*TemplateVersion)(nil)
 var _ ResourceInterface = (*Event)(nil)
+var _ ResourceInterface = (*EventSubscription)(nil)
+var _ ResourceInterface = (*BulkOperation)(nil)
+var _ ResourceInterface = (*EnrollmentPolicy)(nil)
+var _ ResourceInterface = (*ConsoleSession)(nil)
@@ -16,11 +16,12 @@ type Repository interface {
 
 	Create(ctx context.Context, orgId uuid.UUID, repository *api.Repository, eventCallback EventCallback) (*api.Repository, error)
 	Update(ctx context.Context, orgId uuid.UUID, repository *api.Repository, eventCallback EventCallback) (*api.Repository, error)
-	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, repository *api.Repository, eventCallback EventCallback) (*api.Repository, bool, error)
+	CreateOrUpdate(ctx context.Context, orgId uuid.UUID, repository *api.Repository, fromAPI bool, eventCallback EventCallback) (*api.Repository, bool, error)
 	Get(ctx context.Context, orgId uuid.UUID, name string) (*api.Repository, error)
 	List(ctx context.Context, orgId uuid.UUID, listParams ListParams) (*api.RepositoryList, error)
 	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback EventCallback) error
 	UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *api.Repository, eventCallback EventCallback) (*api.Repository, error)
+	UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error
 
 	GetFleetRefs(ctx context.Context, orgId uuid.UUID, name string) (*api.FleetList, error)
 	GetDeviceRefs(ctx context.Context, orgId uuid.UUID, name string) (*api.DeviceList, error)
@@ -103,8 +104,8 @@ func (s *RepositoryStore) Update(ctx context.Context, orgId uuid.UUID, resource
 	return newRepo, err
 }
 
-func (s *RepositoryStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.Repository, eventCallback EventCallback) (*api.Repository, bool, error) {
-	newRepo, oldRepo, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, true, nil)
+func (s *RepositoryStore) CreateOrUpdate(ctx context.Context, orgId uuid.UUID, resource *api.Repository, fromAPI bool, eventCallback EventCallback) (*api.Repository, bool, error) {
+	newRepo, oldRepo, created, err := s.genericStore.CreateOrUpdate(ctx, orgId, resource, nil, fromAPI, nil)
 	s.eventCallbackCaller(ctx, eventCallback, orgId, lo.FromPtr(resource.Metadata.Name), oldRepo, newRepo, created, err)
 
 	return newRepo, created, err
@@ -155,6 +156,21 @@ func (s *RepositoryStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, res
 	return newRepo, err
 }
 
+func (s *RepositoryStore) UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error {
+	db := s.getDB(ctx)
+	if tx != nil {
+		db = tx
+	}
+	repositoryCondition := model.Repository{
+		Resource: model.Resource{OrgID: orgId, Owner: &owner},
+	}
+	result := db.Model(repositoryCondition).Where("org_id = ? and owner = ?", orgId, owner).Updates(map[string]interface{}{
+		"owner":            nil,
+		"resource_version": gorm.Expr("resource_version + 1"),
+	})
+	return ErrorFromGormError(result.Error)
+}
+
 func (s *RepositoryStore) GetFleetRefs(ctx context.Context, orgId uuid.UUID, name string) (*api.FleetList, error) {
 	repository := model.Repository{Resource: model.Resource{OrgID: orgId, Name: name}}
 	var fleets []model.Fleet
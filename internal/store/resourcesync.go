@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/flterrors"
@@ -26,6 +27,7 @@ type ResourceSync interface {
 	UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *api.ResourceSync, eventCallback EventCallback) (*api.ResourceSync, error)
 	Count(ctx context.Context, orgId uuid.UUID, listParams ListParams) (int64, error)
 	CountByOrgAndStatus(ctx context.Context, orgId *uuid.UUID, status *string) ([]CountByResourceSyncOrgAndStatusResult, error)
+	WithSyncLock(ctx context.Context, orgId uuid.UUID, name string, fn func(ctx context.Context) error) error
 }
 
 // CountByResourceSyncOrgAndStatusResult holds the result of the group by query for organization and status.
@@ -201,3 +203,27 @@ func (s *ResourceSyncStore) CountByOrgAndStatus(ctx context.Context, orgId *uuid
 	}
 	return results, nil
 }
+
+// WithSyncLock runs fn while holding the sync lock of the resource sync, so that a resource sync is not synced by
+// several processes at once, e.g. by an immediate sync after a push and by the periodic poll.  The lock is a session
+// advisory lock, which is held by the connection fn is run alongside and released on return.
+func (s *ResourceSyncStore) WithSyncLock(ctx context.Context, orgId uuid.UUID, name string, fn func(ctx context.Context) error) error {
+	db := s.getDB(ctx)
+	if db.Dialector.Name() != "postgres" {
+		return fn(ctx)
+	}
+
+	key := fmt.Sprintf("resourcesync/%s/%s", orgId, name)
+	return db.Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(hashtext(?))", key).Error; err != nil {
+			return ErrorFromGormError(err)
+		}
+		defer func() {
+			// unlock even if ctx was canceled, as the connection returns to the pool still holding the lock otherwise
+			if err := conn.WithContext(context.WithoutCancel(ctx)).Exec("SELECT pg_advisory_unlock(hashtext(?))", key).Error; err != nil {
+				s.log.Errorf("failed to release the sync lock of resourcesync/%s: %v", name, err)
+			}
+		}()
+		return fn(ctx)
+	})
+}
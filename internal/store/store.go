@@ -29,6 +29,11 @@ type Store interface {
 	Repository() Repository
 	ResourceSync() ResourceSync
 	Event() Event
+	EventSubscription() EventSubscription
+	BulkOperation() BulkOperation
+	EnrollmentPolicy() EnrollmentPolicy
+	CertificateRevocation() CertificateRevocation
+	ConsoleSession() ConsoleSession
 	Checkpoint() Checkpoint
 	Organization() Organization
 	RunMigrations(context.Context) error
@@ -46,6 +51,11 @@ type DataStore struct {
 	repository                Repository
 	resourceSync              ResourceSync
 	event                     Event
+	eventSubscription         EventSubscription
+	bulkOperation             BulkOperation
+	enrollmentPolicy          EnrollmentPolicy
+	certificateRevocation     CertificateRevocation
+	consoleSession            ConsoleSession
 	checkpoint                Checkpoint
 	organization              Organization
 
@@ -63,6 +73,11 @@ func NewStore(db *gorm.DB, log logrus.FieldLogger) Store {
 		repository:                NewRepository(db, log),
 		resourceSync:              NewResourceSync(db, log),
 		event:                     NewEvent(db, log),
+		eventSubscription:         NewEventSubscription(db, log),
+		bulkOperation:             NewBulkOperation(db, log),
+		enrollmentPolicy:          NewEnrollmentPolicy(db, log),
+		certificateRevocation:     NewCertificateRevocation(db, log),
+		consoleSession:            NewConsoleSession(db, log),
 		checkpoint:                NewCheckpoint(db, log),
 		organization:              NewOrganization(db),
 		db:                        db,
@@ -105,6 +120,26 @@ func (s *DataStore) Event() Event {
 	return s.event
 }
 
+func (s *DataStore) EventSubscription() EventSubscription {
+	return s.eventSubscription
+}
+
+func (s *DataStore) BulkOperation() BulkOperation {
+	return s.bulkOperation
+}
+
+func (s *DataStore) EnrollmentPolicy() EnrollmentPolicy {
+	return s.enrollmentPolicy
+}
+
+func (s *DataStore) CertificateRevocation() CertificateRevocation {
+	return s.certificateRevocation
+}
+
+func (s *DataStore) ConsoleSession() ConsoleSession {
+	return s.consoleSession
+}
+
 func (s *DataStore) Checkpoint() Checkpoint {
 	return s.checkpoint
 }
@@ -185,6 +220,21 @@ func (s *DataStore) RunMigrations(ctx context.Context) error {
 	if err := s.Event().InitialMigration(ctx); err != nil {
 		return err
 	}
+	if err := s.EventSubscription().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.BulkOperation().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.EnrollmentPolicy().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.CertificateRevocation().InitialMigration(ctx); err != nil {
+		return err
+	}
+	if err := s.ConsoleSession().InitialMigration(ctx); err != nil {
+		return err
+	}
 	if err := s.Checkpoint().InitialMigration(ctx); err != nil {
 		return err
 	}
@@ -0,0 +1,325 @@
+package tasks
+
+// Bulk Operation Task
+//
+// This task applies the operation of a BulkOperation to the devices that match its selector.
+//
+// Devices are listed in batches, in the order of their names, and the continue token of the device list is recorded
+// in the status of the operation after each batch.  Devices that stop matching the selector because of the operation,
+// for example when their labels are removed, are therefore neither skipped nor processed twice.
+//
+// A single run processes batches for up to bulkOperationTimeBudget, and then emits a BulkOperationProgressed event
+// that triggers the next run.  The status is written with the resource version that was read, so that a cancellation
+// or deletion that happens during a batch stops the operation instead of being overwritten.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// BulkOperationBatchSize is the number of devices listed and processed at a time
+	BulkOperationBatchSize = 50
+
+	// MaxBulkOperationFailures is the number of failed devices listed in the status of a bulk operation
+	MaxBulkOperationFailures = 100
+
+	// bulkOperationTimeBudget is the time after which a run hands over to the next run, leaving enough of the
+	// EventProcessingTimeout to finish the batch in progress
+	bulkOperationTimeBudget = EventProcessingTimeout / 2
+)
+
+func bulkOperationRun(ctx context.Context, event api.Event, serviceHandler service.Service, log logrus.FieldLogger) error {
+	logic := BulkOperationLogic{
+		log:            log,
+		serviceHandler: serviceHandler,
+		name:           event.InvolvedObject.Name,
+		batchSize:      BulkOperationBatchSize,
+		timeBudget:     bulkOperationTimeBudget,
+	}
+	return logic.Run(ctx)
+}
+
+type BulkOperationLogic struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+	name           string
+	batchSize      int32
+	timeBudget     time.Duration
+}
+
+// Run processes batches of devices until the operation is finished or the time budget is exhausted
+func (b BulkOperationLogic) Run(ctx context.Context) error {
+	start := time.Now()
+	var labels map[string]string
+	var fleets []api.Fleet
+
+	for first := true; ; first = false {
+		operation, status := b.serviceHandler.GetBulkOperation(ctx, b.name)
+		if status.Code == http.StatusNotFound {
+			b.log.Infof("bulk operation %s was deleted", b.name)
+			return nil
+		}
+		if status.Code != http.StatusOK {
+			return service.ApiStatusToErr(status)
+		}
+		if operation.Status.IsFinished() {
+			return nil
+		}
+
+		if !first && time.Since(start) >= b.timeBudget {
+			b.serviceHandler.CreateEvent(ctx, common.GetBulkOperationProgressedEvent(ctx, b.name, *operation.Status))
+			return nil
+		}
+
+		if operation.Status.Phase == api.BulkOperationPhasePending {
+			operation.Status.Phase = api.BulkOperationPhaseRunning
+			operation.Status.StartedAt = lo.ToPtr(time.Now().UTC())
+		}
+
+		var failure string
+		if labels == nil {
+			labels, failure = b.labelsToAdd(ctx, operation.Spec.Operation)
+		}
+		if failure == "" && fleets == nil && operation.Spec.Operation.Type == api.BulkOperationTypeMoveToFleet {
+			fleets, failure = b.listFleets(ctx)
+		}
+		if failure == "" {
+			var err error
+			if failure, err = b.processBatch(ctx, operation, labels, fleets); err != nil {
+				return err
+			}
+		}
+
+		finished := failure != "" || operation.Status.Continue == nil
+		if finished {
+			finishBulkOperation(operation.Status, failure)
+		}
+
+		_, status = b.serviceHandler.ReplaceBulkOperationStatus(ctx, b.name, *operation)
+		if status.Code == http.StatusConflict || status.Code == http.StatusNotFound {
+			b.log.Infof("bulk operation %s was canceled or deleted while processing devices", b.name)
+			return nil
+		}
+		if status.Code != http.StatusOK {
+			return service.ApiStatusToErr(status)
+		}
+		if finished {
+			b.serviceHandler.CreateEvent(ctx, common.GetBulkOperationFinishedEvent(ctx, b.name, *operation.Status))
+			return nil
+		}
+	}
+}
+
+// processBatch applies the operation to the next batch of devices and records the outcome in the status of the
+// operation.  It returns a failure message if the devices cannot be listed, and an error if the batch was interrupted,
+// in which case the batch is processed again by the next attempt.
+func (b BulkOperationLogic) processBatch(ctx context.Context, operation *api.BulkOperation, labels map[string]string, fleets []api.Fleet) (string, error) {
+	params := api.ListDevicesParams{
+		LabelSelector: operation.Spec.Selector.LabelSelector,
+		FieldSelector: operation.Spec.Selector.FieldSelector,
+		Limit:         lo.ToPtr(b.batchSize),
+		Continue:      operation.Status.Continue,
+	}
+	devices, status := b.serviceHandler.ListDevices(ctx, params, nil)
+	if status.Code == http.StatusBadRequest {
+		return fmt.Sprintf("Failed to list devices: %s", status.Message), nil
+	}
+	if status.Code != http.StatusOK {
+		return "", service.ApiStatusToErr(status)
+	}
+
+	for i := range devices.Items {
+		if err := ctx.Err(); err != nil {
+			return "", err
+		}
+		device := &devices.Items[i]
+		name := lo.FromPtr(device.Metadata.Name)
+		operation.Status.ProcessedDevices++
+		if err := b.apply(ctx, operation.Spec.Operation, device, labels, fleets); err != nil {
+			operation.Status.FailedDevices++
+			if len(lo.FromPtr(operation.Status.Failures)) < MaxBulkOperationFailures {
+				operation.Status.Failures = lo.ToPtr(append(lo.FromPtr(operation.Status.Failures), api.BulkOperationFailure{Device: name, Message: err.Error()}))
+			}
+			continue
+		}
+		operation.Status.SucceededDevices++
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	operation.Status.Continue = devices.Metadata.Continue
+	return "", nil
+}
+
+// apply applies the operation to a single device.  Devices that already have the requested state are left unchanged.
+func (b BulkOperationLogic) apply(ctx context.Context, action api.BulkOperationAction, device *api.Device, labels map[string]string, fleets []api.Fleet) error {
+	name := lo.FromPtr(device.Metadata.Name)
+	var status api.Status
+
+	switch action.Type {
+	case api.BulkOperationTypeAddLabels:
+		patch := addLabelsPatch(lo.FromPtr(device.Metadata.Labels), labels)
+		if len(patch) == 0 {
+			return nil
+		}
+		_, status = b.serviceHandler.PatchDevice(ctx, name, patch)
+	case api.BulkOperationTypeMoveToFleet:
+		patch, err := moveToFleetPatch(lo.FromPtr(device.Metadata.Labels), lo.FromPtr(action.Fleet), labels, fleets)
+		if err != nil {
+			return err
+		}
+		if len(patch) == 0 {
+			return nil
+		}
+		_, status = b.serviceHandler.PatchDevice(ctx, name, patch)
+	case api.BulkOperationTypeRemoveLabels:
+		patch := removeLabelsPatch(lo.FromPtr(device.Metadata.Labels), lo.FromPtr(action.LabelKeys))
+		if len(patch) == 0 {
+			return nil
+		}
+		_, status = b.serviceHandler.PatchDevice(ctx, name, patch)
+	case api.BulkOperationTypeSetAnnotations:
+		status = b.serviceHandler.UpdateDeviceAnnotations(ctx, name, lo.FromPtr(action.Annotations), nil)
+	case api.BulkOperationTypeDecommission:
+		if device.Spec != nil && device.Spec.Decommissioning != nil {
+			return nil
+		}
+		decommission := lo.FromPtrOr(action.Decommission, api.DeviceDecommission{Target: api.DeviceDecommissionTargetTypeUnenroll})
+		_, status = b.serviceHandler.DecommissionDevice(ctx, name, decommission)
+	case api.BulkOperationTypeResume:
+		_, status = b.serviceHandler.ResumeDevices(ctx, api.DeviceResumeRequest{FieldSelector: lo.ToPtr("metadata.name=" + name)})
+	default:
+		return fmt.Errorf("unsupported operation %q", action.Type)
+	}
+
+	if status.Code != http.StatusOK {
+		return service.ApiStatusToErr(status)
+	}
+	return nil
+}
+
+// labelsToAdd returns the labels added by AddLabels and MoveToFleet.  For MoveToFleet, these are the labels of the
+// fleet's selector, read when the operation runs so that they follow changes to the fleet.
+func (b BulkOperationLogic) labelsToAdd(ctx context.Context, action api.BulkOperationAction) (map[string]string, string) {
+	switch action.Type {
+	case api.BulkOperationTypeAddLabels:
+		return lo.FromPtr(action.Labels), ""
+	case api.BulkOperationTypeMoveToFleet:
+		fleetName := lo.FromPtr(action.Fleet)
+		fleet, status := b.serviceHandler.GetFleet(ctx, fleetName, api.GetFleetParams{})
+		if status.Code != http.StatusOK {
+			return nil, fmt.Sprintf("Failed to get fleet %s: %s", fleetName, status.Message)
+		}
+		matchLabels := lo.FromPtr(lo.FromPtr(fleet.Spec.Selector).MatchLabels)
+		if len(matchLabels) == 0 {
+			return nil, fmt.Sprintf("Fleet %s has no selector labels.", fleetName)
+		}
+		return matchLabels, ""
+	default:
+		return map[string]string{}, ""
+	}
+}
+
+// listFleets returns all fleets, which MoveToFleet uses to find the fleets that select a device
+func (b BulkOperationLogic) listFleets(ctx context.Context) ([]api.Fleet, string) {
+	fleets := []api.Fleet{}
+	params := api.ListFleetsParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	for {
+		list, status := b.serviceHandler.ListFleets(ctx, params)
+		if status.Code != http.StatusOK {
+			return nil, fmt.Sprintf("Failed to list fleets: %s", status.Message)
+		}
+		fleets = append(fleets, list.Items...)
+		if list.Metadata.Continue == nil {
+			return fleets, ""
+		}
+		params.Continue = list.Metadata.Continue
+	}
+}
+
+// finishBulkOperation sets the final phase and message of an operation that ran out of devices, or that failed to run
+func finishBulkOperation(status *api.BulkOperationStatus, failure string) {
+	status.CompletedAt = lo.ToPtr(time.Now().UTC())
+	status.Continue = nil
+	switch {
+	case failure != "":
+		status.Phase = api.BulkOperationPhaseFailed
+		status.Message = lo.ToPtr(failure)
+	case status.FailedDevices > 0:
+		status.Phase = api.BulkOperationPhaseFailed
+		status.Message = lo.ToPtr(fmt.Sprintf("Operation failed for %d of %d devices.", status.FailedDevices, status.ProcessedDevices))
+	default:
+		status.Phase = api.BulkOperationPhaseCompleted
+		status.Message = lo.ToPtr(fmt.Sprintf("Operation succeeded for %d devices.", status.SucceededDevices))
+	}
+}
+
+// addLabelsPatch returns the JSON patch that adds the labels to, or replaces them on, a device with the current labels
+func addLabelsPatch(current, labels map[string]string) api.PatchRequest {
+	keys := lo.Keys(labels)
+	sort.Strings(keys)
+	patch := api.PatchRequest{}
+	for _, key := range keys {
+		if value, ok := current[key]; ok && value == labels[key] {
+			continue
+		}
+		var value interface{} = labels[key]
+		patch = append(patch, api.PatchRequest{{Op: api.Add, Path: labelPatchPath(key), Value: &value}}...)
+	}
+	return patch
+}
+
+// removeLabelsPatch returns the JSON patch that removes the labels with the given keys from a device with the current labels
+func removeLabelsPatch(current map[string]string, keys []string) api.PatchRequest {
+	patch := api.PatchRequest{}
+	for _, key := range lo.Uniq(keys) {
+		if _, ok := current[key]; !ok {
+			continue
+		}
+		patch = append(patch, api.PatchRequest{{Op: api.Remove, Path: labelPatchPath(key)}}...)
+	}
+	return patch
+}
+
+// moveToFleetPatch returns the JSON patch that moves a device with the current labels to the fleet with the given
+// selector labels.  The selector labels of the other fleets that select the device are removed, and the move fails if
+// the device would still be selected by another fleet, since the device would then not be owned by the fleet.
+func moveToFleetPatch(current map[string]string, fleetName string, labels map[string]string, fleets []api.Fleet) (api.PatchRequest, error) {
+	removed := []string{}
+	for i := range fleets {
+		matchLabels := getMatchLabelsSafe(&fleets[i])
+		if lo.FromPtr(fleets[i].Metadata.Name) == fleetName || !util.LabelsMatchLabelSelector(current, matchLabels) {
+			continue
+		}
+		for key := range matchLabels {
+			if _, ok := labels[key]; !ok {
+				removed = append(removed, key)
+			}
+		}
+	}
+	sort.Strings(removed)
+
+	moved := lo.Assign(lo.OmitByKeys(current, removed), labels)
+	if others := lo.Without(findMatchingFleets(moved, fleets), fleetName); len(others) > 0 {
+		sort.Strings(others)
+		return nil, fmt.Errorf("device would also be selected by fleet(s) %s", strings.Join(others, ", "))
+	}
+	return append(removeLabelsPatch(current, removed), addLabelsPatch(current, labels)...), nil
+}
+
+// labelPatchPath returns the JSON pointer to a label, escaping the characters that JSON pointers reserve
+func labelPatchPath(key string) string {
+	return "/metadata/labels/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+}
@@ -0,0 +1,255 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func newTestBulkOperation(action api.BulkOperationAction) api.BulkOperation {
+	return api.BulkOperation{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr("test-operation"), ResourceVersion: lo.ToPtr("1")},
+		Spec: api.BulkOperationSpec{
+			Selector:  api.BulkOperationSelector{LabelSelector: lo.ToPtr("site=paris")},
+			Operation: action,
+		},
+		Status: &api.BulkOperationStatus{Phase: api.BulkOperationPhasePending, MatchedDevices: 3},
+	}
+}
+
+func newTestBulkDevice(name string, labels map[string]string) api.Device {
+	return api.Device{Metadata: api.ObjectMeta{Name: lo.ToPtr(name), Labels: &labels}}
+}
+
+// setupBulkOperation returns a logic whose service stores the status of the operation, and lists the given batches of devices
+func setupBulkOperation(t *testing.T, operation api.BulkOperation, batches [][]api.Device) (*BulkOperationLogic, *service.MockService, *api.BulkOperation, context.Context) {
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+	ctx := util.WithOrganizationID(context.Background(), uuid.New())
+	stored := &operation
+
+	mockService.EXPECT().GetBulkOperation(gomock.Any(), "test-operation").DoAndReturn(
+		func(ctx context.Context, name string) (*api.BulkOperation, api.Status) {
+			copied := *stored
+			status := *stored.Status
+			copied.Status = &status
+			return &copied, api.StatusOK()
+		}).AnyTimes()
+	mockService.EXPECT().ReplaceBulkOperationStatus(gomock.Any(), "test-operation", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, name string, operation api.BulkOperation) (*api.BulkOperation, api.Status) {
+			*stored = operation
+			return stored, api.StatusOK()
+		}).AnyTimes()
+
+	for i, batch := range batches {
+		var cont, next *string
+		if i > 0 {
+			cont = lo.ToPtr(lo.FromPtr(batch[0].Metadata.Name))
+		}
+		if i < len(batches)-1 {
+			next = lo.ToPtr(lo.FromPtr(batches[i+1][0].Metadata.Name))
+		}
+		mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), nil).DoAndReturn(
+			func(ctx context.Context, params api.ListDevicesParams, _ any) (*api.DeviceList, api.Status) {
+				assert.Equal(t, "site=paris", lo.FromPtr(params.LabelSelector))
+				assert.Equal(t, cont, params.Continue)
+				return &api.DeviceList{Items: batch, Metadata: api.ListMeta{Continue: next}}, api.StatusOK()
+			})
+	}
+
+	logic := &BulkOperationLogic{
+		log:            logrus.New(),
+		serviceHandler: mockService,
+		name:           "test-operation",
+		batchSize:      2,
+		timeBudget:     time.Minute,
+	}
+	return logic, mockService, stored, ctx
+}
+
+func TestBulkOperationAddLabels(t *testing.T) {
+	operation := newTestBulkOperation(api.BulkOperationAction{
+		Type:   api.BulkOperationTypeAddLabels,
+		Labels: &map[string]string{"example.com/tier": "edge"},
+	})
+	batches := [][]api.Device{
+		{newTestBulkDevice("device-1", map[string]string{"site": "paris"}), newTestBulkDevice("device-2", map[string]string{"site": "paris", "example.com/tier": "edge"})},
+		{newTestBulkDevice("device-3", map[string]string{"site": "paris"})},
+	}
+	logic, mockService, stored, ctx := setupBulkOperation(t, operation, batches)
+
+	patched := []string{}
+	mockService.EXPECT().PatchDevice(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, name string, patch api.PatchRequest) (*api.Device, api.Status) {
+			require.Len(t, patch, 1)
+			assert.Equal(t, api.Add, patch[0].Op)
+			assert.Equal(t, "/metadata/labels/example.com~1tier", patch[0].Path)
+			patched = append(patched, name)
+			return &api.Device{}, api.StatusOK()
+		}).Times(2)
+	mockService.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, event *api.Event) {
+		assert.Equal(t, api.EventReasonBulkOperationCompleted, event.Reason)
+	})
+
+	require.NoError(t, logic.Run(ctx))
+	assert.Equal(t, []string{"device-1", "device-3"}, patched)
+	assert.Equal(t, api.BulkOperationPhaseCompleted, stored.Status.Phase)
+	assert.Equal(t, int64(3), stored.Status.ProcessedDevices)
+	assert.Equal(t, int64(3), stored.Status.SucceededDevices)
+	assert.Equal(t, int64(0), stored.Status.FailedDevices)
+	assert.NotNil(t, stored.Status.StartedAt)
+	assert.NotNil(t, stored.Status.CompletedAt)
+	assert.Nil(t, stored.Status.Continue)
+}
+
+func TestBulkOperationReportsFailures(t *testing.T) {
+	operation := newTestBulkOperation(api.BulkOperationAction{
+		Type:      api.BulkOperationTypeRemoveLabels,
+		LabelKeys: &[]string{"site"},
+	})
+	batches := [][]api.Device{
+		{newTestBulkDevice("device-1", map[string]string{"site": "paris"}), newTestBulkDevice("device-2", map[string]string{"site": "paris"})},
+	}
+	logic, mockService, stored, ctx := setupBulkOperation(t, operation, batches)
+
+	mockService.EXPECT().PatchDevice(gomock.Any(), "device-1", gomock.Any()).Return(&api.Device{}, api.StatusOK())
+	mockService.EXPECT().PatchDevice(gomock.Any(), "device-2", gomock.Any()).Return(nil, api.StatusConflict("the object has been modified"))
+	mockService.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, event *api.Event) {
+		assert.Equal(t, api.EventReasonBulkOperationFailed, event.Reason)
+		assert.Equal(t, api.Warning, event.Type)
+	})
+
+	require.NoError(t, logic.Run(ctx))
+	assert.Equal(t, api.BulkOperationPhaseFailed, stored.Status.Phase)
+	assert.Equal(t, int64(1), stored.Status.SucceededDevices)
+	assert.Equal(t, int64(1), stored.Status.FailedDevices)
+	require.Len(t, lo.FromPtr(stored.Status.Failures), 1)
+	assert.Equal(t, "device-2", (*stored.Status.Failures)[0].Device)
+}
+
+func TestBulkOperationHandsOverAfterTimeBudget(t *testing.T) {
+	operation := newTestBulkOperation(api.BulkOperationAction{Type: api.BulkOperationTypeResume})
+	logic, mockService, stored, ctx := setupBulkOperation(t, operation, nil)
+	logic.timeBudget = 0
+
+	// the next batch is left to the run triggered by the progressed event
+	mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), nil).Return(&api.DeviceList{
+		Items:    []api.Device{newTestBulkDevice("device-1", nil), newTestBulkDevice("device-2", nil)},
+		Metadata: api.ListMeta{Continue: lo.ToPtr("next")},
+	}, api.StatusOK())
+	mockService.EXPECT().ResumeDevices(gomock.Any(), api.DeviceResumeRequest{FieldSelector: lo.ToPtr("metadata.name=device-1")}).Return(api.DeviceResumeResponse{}, api.StatusOK())
+	mockService.EXPECT().ResumeDevices(gomock.Any(), api.DeviceResumeRequest{FieldSelector: lo.ToPtr("metadata.name=device-2")}).Return(api.DeviceResumeResponse{}, api.StatusOK())
+	mockService.EXPECT().CreateEvent(gomock.Any(), gomock.Any()).Do(func(ctx context.Context, event *api.Event) {
+		assert.Equal(t, api.EventReasonBulkOperationProgressed, event.Reason)
+	})
+
+	require.NoError(t, logic.Run(ctx))
+	assert.Equal(t, api.BulkOperationPhaseRunning, stored.Status.Phase)
+	assert.Equal(t, int64(2), stored.Status.ProcessedDevices)
+	assert.Equal(t, "next", lo.FromPtr(stored.Status.Continue))
+}
+
+func TestBulkOperationStopsWhenCanceled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+	ctx := util.WithOrganizationID(context.Background(), uuid.New())
+	operation := newTestBulkOperation(api.BulkOperationAction{
+		Type:        api.BulkOperationTypeSetAnnotations,
+		Annotations: &map[string]string{"example.com/migrated": "true"},
+	})
+
+	mockService.EXPECT().GetBulkOperation(gomock.Any(), "test-operation").Return(&operation, api.StatusOK())
+	mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), nil).Return(&api.DeviceList{Items: []api.Device{newTestBulkDevice("device-1", nil)}}, api.StatusOK())
+	mockService.EXPECT().UpdateDeviceAnnotations(gomock.Any(), "device-1", map[string]string{"example.com/migrated": "true"}, nil).Return(api.StatusOK())
+	// the operation was canceled while the batch was processed
+	mockService.EXPECT().ReplaceBulkOperationStatus(gomock.Any(), "test-operation", gomock.Any()).Return(nil, api.StatusConflict("the object has been modified"))
+
+	logic := &BulkOperationLogic{log: logrus.New(), serviceHandler: mockService, name: "test-operation", batchSize: 2, timeBudget: time.Minute}
+	require.NoError(t, logic.Run(ctx))
+}
+
+func newTestBulkFleet(name string, matchLabels map[string]string) api.Fleet {
+	return api.Fleet{Metadata: api.ObjectMeta{Name: lo.ToPtr(name)}, Spec: api.FleetSpec{Selector: &api.LabelSelector{MatchLabels: &matchLabels}}}
+}
+
+func TestBulkOperationMoveToFleet(t *testing.T) {
+	operation := newTestBulkOperation(api.BulkOperationAction{Type: api.BulkOperationTypeMoveToFleet, Fleet: lo.ToPtr("berlin")})
+	batches := [][]api.Device{
+		{newTestBulkDevice("device-1", map[string]string{"site": "paris", "tier": "edge"}), newTestBulkDevice("device-2", map[string]string{"site": "paris", "gpu": "true"})},
+		{newTestBulkDevice("device-3", map[string]string{"site": "paris"})},
+	}
+	logic, mockService, stored, ctx := setupBulkOperation(t, operation, batches)
+	berlin := newTestBulkFleet("berlin", map[string]string{"site": "berlin"})
+
+	mockService.EXPECT().GetFleet(gomock.Any(), "berlin", gomock.Any()).Return(&berlin, api.StatusOK())
+	mockService.EXPECT().ListFleets(gomock.Any(), gomock.Any()).Return(&api.FleetList{Items: []api.Fleet{
+		berlin,
+		newTestBulkFleet("paris", map[string]string{"site": "paris"}),
+		newTestBulkFleet("edge", map[string]string{"tier": "edge"}),
+		newTestBulkFleet("berlin-gpu", map[string]string{"site": "berlin", "gpu": "true"}),
+	}}, api.StatusOK())
+	// the selector label of the edge fleet is removed, so that the device is owned by the berlin fleet only
+	mockService.EXPECT().PatchDevice(gomock.Any(), "device-1", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, name string, patch api.PatchRequest) (*api.Device, api.Status) {
+			require.Len(t, patch, 2)
+			assert.Equal(t, api.Remove, patch[0].Op)
+			assert.Equal(t, "/metadata/labels/tier", patch[0].Path)
+			assert.Equal(t, api.Add, patch[1].Op)
+			assert.Equal(t, "/metadata/labels/site", patch[1].Path)
+			return &api.Device{}, api.StatusOK()
+		})
+	mockService.EXPECT().PatchDevice(gomock.Any(), "device-3", gomock.Any()).Return(&api.Device{}, api.StatusOK())
+	mockService.EXPECT().CreateEvent(gomock.Any(), gomock.Any())
+
+	require.NoError(t, logic.Run(ctx))
+	assert.Equal(t, api.BulkOperationPhaseFailed, stored.Status.Phase)
+	assert.Equal(t, int64(2), stored.Status.SucceededDevices)
+	assert.Equal(t, int64(1), stored.Status.FailedDevices)
+	require.Len(t, lo.FromPtr(stored.Status.Failures), 1)
+	assert.Equal(t, api.BulkOperationFailure{Device: "device-2", Message: "device would also be selected by fleet(s) berlin-gpu"}, (*stored.Status.Failures)[0])
+}
+
+func TestBulkOperationMoveToFleetWithoutSelector(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+	ctx := util.WithOrganizationID(context.Background(), uuid.New())
+	operation := newTestBulkOperation(api.BulkOperationAction{Type: api.BulkOperationTypeMoveToFleet, Fleet: lo.ToPtr("berlin")})
+
+	mockService.EXPECT().GetBulkOperation(gomock.Any(), "test-operation").Return(&operation, api.StatusOK())
+	mockService.EXPECT().GetFleet(gomock.Any(), "berlin", gomock.Any()).Return(&api.Fleet{}, api.StatusOK())
+	mockService.EXPECT().ReplaceBulkOperationStatus(gomock.Any(), "test-operation", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, name string, operation api.BulkOperation) (*api.BulkOperation, api.Status) {
+			assert.Equal(t, api.BulkOperationPhaseFailed, operation.Status.Phase)
+			assert.Equal(t, "Fleet berlin has no selector labels.", lo.FromPtr(operation.Status.Message))
+			return &operation, api.StatusOK()
+		})
+	mockService.EXPECT().CreateEvent(gomock.Any(), gomock.Any())
+
+	logic := &BulkOperationLogic{log: logrus.New(), serviceHandler: mockService, name: "test-operation", batchSize: 2, timeBudget: time.Minute}
+	require.NoError(t, logic.Run(ctx))
+}
+
+func TestLabelsPatch(t *testing.T) {
+	current := map[string]string{"site": "paris", "a~b": "1"}
+
+	patch := addLabelsPatch(current, map[string]string{"site": "berlin", "tier": "edge"})
+	require.Len(t, patch, 2)
+	assert.Equal(t, "/metadata/labels/site", patch[0].Path)
+	assert.Equal(t, "berlin", *patch[0].Value)
+	assert.Equal(t, "/metadata/labels/tier", patch[1].Path)
+
+	patch = removeLabelsPatch(current, []string{"a~b", "missing", "a~b"})
+	require.Len(t, patch, 1)
+	assert.Equal(t, api.Remove, patch[0].Op)
+	assert.Equal(t, "/metadata/labels/a~0b", patch[0].Path)
+}
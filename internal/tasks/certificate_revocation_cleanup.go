@@ -0,0 +1,39 @@
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// CertificateRevocationCleanupPollingInterval is the interval at which the certificate revocation cleanup task runs.
+	CertificateRevocationCleanupPollingInterval = time.Hour
+	CertificateRevocationCleanupTaskName        = "certificate-revocation-cleanup"
+)
+
+type CertificateRevocationCleanup struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+}
+
+func NewCertificateRevocationCleanup(log logrus.FieldLogger, serviceHandler service.Service) *CertificateRevocationCleanup {
+	return &CertificateRevocationCleanup{
+		log:            log,
+		serviceHandler: serviceHandler,
+	}
+}
+
+// Poll deletes the revocations of expired certificates, which no longer need to be listed in the CRL
+func (t *CertificateRevocationCleanup) Poll(ctx context.Context) {
+	t.log.Info("Running CertificateRevocationCleanup Polling")
+	numDeleted, status := t.serviceHandler.DeleteExpiredCertificateRevocations(ctx)
+	if status.Code != http.StatusOK {
+		t.log.Errorf("failed to clean up certificate revocations: %s", status.Message)
+		return
+	}
+	t.log.Infof("cleaned up %d certificate revocations", numDeleted)
+}
@@ -8,6 +8,7 @@ import (
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/util"
 )
 
@@ -18,7 +19,7 @@ const (
 )
 
 var (
-	ErrUnknownConfigName      = errors.New("failed to find configuration item name")
+	ErrUnknownConfigName      = common.ErrUnknownConfigName
 	ErrUnknownApplicationType = errors.New("unknown application type")
 )
 
@@ -23,7 +23,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 )
 
-func dispatchTasks(serviceHandler service.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, workerMetrics *worker.WorkerCollector) queues.ConsumeHandler {
+func dispatchTasks(serviceHandler service.Service, k8sClient k8sclient.K8SClient, kvStore kvstore.KVStore, ignoreResourceUpdates []string, workerMetrics *worker.WorkerCollector) queues.ConsumeHandler {
 	return func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
 		startTime := time.Now()
 
@@ -106,6 +106,21 @@ func dispatchTasks(serviceHandler service.Service, k8sClient k8sclient.K8SClient
 			})
 			errorMessages = appendErrorMessage(errorMessages, taskName, err)
 		}
+		if shouldSyncResourceSync(ctx, eventWithOrgId.Event, log) {
+			taskName = "resourceSync"
+			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
+				return NewResourceSync(serviceHandler, log, ignoreResourceUpdates).SyncResourceSync(ctx, eventWithOrgId.Event.InvolvedObject.Name)
+			})
+			errorMessages = appendErrorMessage(errorMessages, taskName, err)
+		}
+
+		if shouldRunBulkOperation(ctx, eventWithOrgId.Event, log) {
+			taskName = "bulkOperation"
+			err = runTaskWithMetrics(taskName, workerMetrics, func() error {
+				return bulkOperationRun(ctx, eventWithOrgId.Event, serviceHandler, log)
+			})
+			errorMessages = appendErrorMessage(errorMessages, taskName, err)
+		}
 
 		// Emit InternalTaskFailedEvent for any unhandled task failures
 		// This serves as a safety net while preserving specific error handling within tasks
@@ -282,9 +297,28 @@ func shouldUpdateRepositoryReferers(ctx context.Context, event api.Event, log lo
 		return true
 	}
 
+	// If a push to a repository was received, return true
+	if event.Reason == api.EventReasonRepositoryPushReceived && event.InvolvedObject.Kind == api.RepositoryKind {
+		return true
+	}
+
 	return false
 }
 
+func shouldSyncResourceSync(ctx context.Context, event api.Event, log logrus.FieldLogger) bool {
+	// If a sync of a resourcesync was requested, return true
+	return event.Reason == api.EventReasonResourceSyncSyncRequested && event.InvolvedObject.Kind == api.ResourceSyncKind
+}
+
+func shouldRunBulkOperation(ctx context.Context, event api.Event, log logrus.FieldLogger) bool {
+	if event.InvolvedObject.Kind != api.BulkOperationKind {
+		return false
+	}
+
+	// If a bulk operation was created, or has more devices to process, return true
+	return event.Reason == api.EventReasonResourceCreated || event.Reason == api.EventReasonBulkOperationProgressed
+}
+
 func hasUpdatedFields(details *api.EventDetails, log logrus.FieldLogger, fields ...api.ResourceUpdatedDetailsUpdatedFields) bool {
 	if details == nil {
 		return false
@@ -310,6 +344,7 @@ func LaunchConsumers(ctx context.Context,
 	serviceHandler service.Service,
 	k8sClient k8sclient.K8SClient,
 	kvStore kvstore.KVStore,
+	ignoreResourceUpdates []string,
 	numConsumers, threadsPerConsumer int,
 	workerMetrics *worker.WorkerCollector) error {
 	totalConsumers := numConsumers * threadsPerConsumer
@@ -329,7 +364,7 @@ func LaunchConsumers(ctx context.Context,
 			return err
 		}
 		for j := 0; j != threadsPerConsumer; j++ {
-			if err = consumer.Consume(ctx, dispatchTasks(serviceHandler, k8sClient, kvStore, workerMetrics)); err != nil {
+			if err = consumer.Consume(ctx, dispatchTasks(serviceHandler, k8sClient, kvStore, ignoreResourceUpdates, workerMetrics)); err != nil {
 				return err
 			}
 		}
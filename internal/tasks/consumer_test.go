@@ -340,6 +340,11 @@ func TestShouldUpdateRepositoryReferers(t *testing.T) {
 			event:    createTestEvent(api.RepositoryKind, api.EventReasonResourceCreated, "repo1"),
 			expected: true,
 		},
+		{
+			name:     "RepositoryPushReceived",
+			event:    createTestEvent(api.RepositoryKind, api.EventReasonRepositoryPushReceived, "repo1"),
+			expected: true,
+		},
 		{
 			name:     "FleetUpdated",
 			event:    createTestEvent(api.FleetKind, api.EventReasonResourceUpdated, "fleet1"),
@@ -361,6 +366,75 @@ func TestShouldUpdateRepositoryReferers(t *testing.T) {
 	}
 }
 
+func TestShouldSyncResourceSync(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    api.Event
+		expected bool
+	}{
+		{
+			name:     "ResourceSyncSyncRequested",
+			event:    createTestEvent(api.ResourceSyncKind, api.EventReasonResourceSyncSyncRequested, "rs1"),
+			expected: true,
+		},
+		{
+			name:     "ResourceSyncUpdated",
+			event:    createTestEvent(api.ResourceSyncKind, api.EventReasonResourceUpdated, "rs1"),
+			expected: false,
+		},
+		{
+			name:     "RepositoryPushReceived",
+			event:    createTestEvent(api.RepositoryKind, api.EventReasonRepositoryPushReceived, "repo1"),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := logrus.New()
+			result := shouldSyncResourceSync(context.Background(), tt.event, log)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestShouldRunBulkOperation(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    api.Event
+		expected bool
+	}{
+		{
+			name:     "BulkOperationCreated",
+			event:    createTestEvent(api.BulkOperationKind, api.EventReasonResourceCreated, "op1"),
+			expected: true,
+		},
+		{
+			name:     "BulkOperationProgressed",
+			event:    createTestEvent(api.BulkOperationKind, api.EventReasonBulkOperationProgressed, "op1"),
+			expected: true,
+		},
+		{
+			name:     "BulkOperationDeleted",
+			event:    createTestEvent(api.BulkOperationKind, api.EventReasonResourceDeleted, "op1"),
+			expected: false,
+		},
+		{
+			name:     "DeviceCreated",
+			event:    createTestEvent(api.DeviceKind, api.EventReasonResourceCreated, "device1"),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := logrus.New()
+			result := shouldRunBulkOperation(context.Background(), tt.event, log)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestHasUpdatedFields(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -469,7 +543,7 @@ func TestDispatchTasks_WithNilMetrics(t *testing.T) {
 	mockConsumer.On("Complete", mock.Anything, "entry-123", payload, mock.MatchedBy(func(e error) bool { return e == nil })).Return(nil)
 
 	// Create dispatcher with nil metrics
-	handler := dispatchTasks(nil, nil, nil, nil)
+	handler := dispatchTasks(nil, nil, nil, nil, nil)
 
 	// Execute handler
 	err = handler(ctx, payload, "entry-123", mockConsumer, log)
@@ -504,7 +578,7 @@ func TestDispatchTasks_WithNilMetrics_SuccessfulProcessing(t *testing.T) {
 	mockConsumer.On("Complete", mock.Anything, "entry-123", payload, mock.MatchedBy(func(e error) bool { return e == nil })).Return(nil)
 
 	// Create dispatcher with nil metrics
-	handler := dispatchTasks(nil, nil, nil, nil)
+	handler := dispatchTasks(nil, nil, nil, nil, nil)
 
 	// Execute handler
 	err = handler(ctx, payload, "entry-123", mockConsumer, log)
@@ -527,7 +601,7 @@ func TestDispatchTasks_WithNilMetrics_InvalidPayload(t *testing.T) {
 	mockConsumer.On("Complete", mock.Anything, "entry-123", payload, nil).Return(nil)
 
 	// Create dispatcher with nil metrics
-	handler := dispatchTasks(nil, nil, nil, nil)
+	handler := dispatchTasks(nil, nil, nil, nil, nil)
 
 	// Execute handler
 	err := handler(ctx, payload, "entry-123", mockConsumer, log)
@@ -0,0 +1,408 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/flightctl/flightctl/pkg/poll"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// EventSubscriptionDeliveryPollingInterval is the interval at which new events are delivered to event subscriptions.
+	EventSubscriptionDeliveryPollingInterval = 30 * time.Second
+	EventSubscriptionDeliveryTaskName        = "event-subscription-delivery"
+
+	// EventSubscriptionCheckpointConsumer is the checkpoint consumer of the delivery task.  Each subscription has its
+	// own checkpoint, keyed by the organization ID and the name of the subscription.
+	EventSubscriptionCheckpointConsumer       = "event-subscription-delivery"
+	CurrentEventSubscriptionCheckpointVersion = 1
+
+	// Headers of a delivery.  The signature is only sent if the subscription has a secret.
+	EventSubscriptionHeader          = "X-Flightctl-Subscription"
+	EventSubscriptionDeliveryHeader  = "X-Flightctl-Delivery"
+	EventSubscriptionSignatureHeader = "X-Flightctl-Signature"
+
+	// MaxEventSubscriptionDeadLetters is the number of undeliverable events kept in the status of a subscription.
+	MaxEventSubscriptionDeadLetters = 20
+
+	eventDeliveryAttempts  = 5
+	eventDeliveryBaseDelay = time.Second
+	eventDeliveryMaxDelay  = 10 * time.Second
+	eventDeliveryTimeout   = 10 * time.Second
+)
+
+// EventSubscriptionCheckpoint is the last point in time up to which the events of a subscription were delivered
+type EventSubscriptionCheckpoint struct {
+	Version   int    `json:"version"`
+	Timestamp string `json:"timestamp"`
+}
+
+// deliveryStatusError is returned when a webhook responds with a non-2xx status code
+type deliveryStatusError struct {
+	statusCode int
+}
+
+func (e *deliveryStatusError) Error() string {
+	return fmt.Sprintf("unexpected status code %d", e.statusCode)
+}
+
+// retryable returns false for redirects, which are not followed, and for client errors, which are not expected to
+// succeed when retried
+func (e *deliveryStatusError) retryable() bool {
+	switch e.statusCode {
+	case http.StatusRequestTimeout, http.StatusTooManyRequests:
+		return true
+	default:
+		return e.statusCode >= 500
+	}
+}
+
+// newEventDeliveryClient returns the HTTP client used to call webhooks.  The webhook URL is supplied by users, so the
+// client refuses to connect to the addresses rejected by checkIP and does not follow redirects.  The check is made
+// on the resolved address when dialing, so it also applies to host names that resolve to internal addresses, and
+// proxies from the environment are not used since the check would then apply to the proxy instead.
+func newEventDeliveryClient(checkIP func(net.IP) error) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: eventDeliveryTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return fmt.Errorf("invalid address %q", address)
+			}
+			return checkIP(ip)
+		},
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   eventDeliveryTimeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// checkWebhookIP rejects the loopback, private and link-local addresses of the cluster network
+func checkWebhookIP(ip net.IP) error {
+	if !validation.IsPublicIP(ip) {
+		return fmt.Errorf("webhook address %s is not allowed", ip)
+	}
+	return nil
+}
+
+type EventSubscriptionDelivery struct {
+	log            logrus.FieldLogger
+	serviceHandler service.Service
+	client         *http.Client
+	backoff        poll.Config
+}
+
+func NewEventSubscriptionDelivery(log logrus.FieldLogger, serviceHandler service.Service) *EventSubscriptionDelivery {
+	backoff := poll.NewConfig(eventDeliveryBaseDelay, 2)
+	backoff.MaxDelay = eventDeliveryMaxDelay
+	backoff.MaxSteps = eventDeliveryAttempts
+	return &EventSubscriptionDelivery{
+		log:            log,
+		serviceHandler: serviceHandler,
+		client:         newEventDeliveryClient(checkWebhookIP),
+		backoff:        *backoff,
+	}
+}
+
+// Poll delivers the events created since the last checkpoint of each event subscription of the organization.  An
+// event that cannot be delivered after all retries is recorded as a dead letter in the status of the subscription.
+// The checkpoint of a subscription is only advanced once its deliveries are done, so events are delivered at least
+// once, and a cancelled poll does not deliver again to the subscriptions that were already served.
+func (t *EventSubscriptionDelivery) Poll(ctx context.Context) {
+	t.log.Debug("Running EventSubscriptionDelivery Polling")
+	orgId, ok := util.GetOrgIdFromContext(ctx)
+	if !ok {
+		t.log.Error("failed to deliver events: no organization in context")
+		return
+	}
+
+	subscriptions, err := t.listSubscriptions(ctx)
+	if err != nil {
+		t.log.Errorf("failed to list event subscriptions: %v", err)
+		return
+	}
+	if len(subscriptions) == 0 {
+		return
+	}
+
+	until, status := t.serviceHandler.GetDatabaseTime(ctx)
+	if status.Code != http.StatusOK {
+		t.log.Errorf("failed to get database time: %s", status.Message)
+		return
+	}
+
+	for i := range subscriptions {
+		subscription := &subscriptions[i]
+		key := eventSubscriptionCheckpointKey(orgId, lo.FromPtr(subscription.Metadata.Name))
+		checkpoint := t.loadCheckpoint(ctx, key)
+		since, err := time.Parse(time.RFC3339Nano, checkpoint.Timestamp)
+		if err != nil {
+			t.log.Errorf("failed to parse event subscription checkpoint timestamp %q: %v", checkpoint.Timestamp, err)
+			continue
+		}
+
+		t.deliverToSubscription(ctx, subscription, since, until)
+		if ctx.Err() != nil {
+			t.log.Warn("Context cancelled during event delivery, the events will be delivered again")
+			return
+		}
+
+		t.storeCheckpoint(ctx, key, &EventSubscriptionCheckpoint{
+			Version:   CurrentEventSubscriptionCheckpointVersion,
+			Timestamp: until.Format(time.RFC3339Nano),
+		})
+	}
+}
+
+func eventSubscriptionCheckpointKey(orgId uuid.UUID, name string) string {
+	return orgId.String() + "/" + name
+}
+
+func (t *EventSubscriptionDelivery) listSubscriptions(ctx context.Context) ([]api.EventSubscription, error) {
+	var subscriptions []api.EventSubscription
+	params := api.ListEventSubscriptionsParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	for {
+		list, status := t.serviceHandler.ListEventSubscriptions(ctx, params)
+		if status.Code != http.StatusOK {
+			return nil, errors.New(status.Message)
+		}
+		subscriptions = append(subscriptions, list.Items...)
+		if list.Metadata.Continue == nil {
+			return subscriptions, nil
+		}
+		params.Continue = list.Metadata.Continue
+	}
+}
+
+// loadCheckpoint returns the checkpoint of a subscription.  Like the alert exporter, it starts from an hour ago
+// if there is no usable checkpoint; events older than a subscription are never delivered to it regardless.
+func (t *EventSubscriptionDelivery) loadCheckpoint(ctx context.Context, key string) *EventSubscriptionCheckpoint {
+	fresh := &EventSubscriptionCheckpoint{
+		Version:   CurrentEventSubscriptionCheckpointVersion,
+		Timestamp: time.Now().Add(-time.Hour).Format(time.RFC3339Nano),
+	}
+
+	data, status := t.serviceHandler.GetCheckpoint(ctx, EventSubscriptionCheckpointConsumer, key)
+	if status.Code == http.StatusNotFound {
+		t.log.Info("No existing event subscription checkpoint found, starting with fresh state")
+		return fresh
+	}
+	if status.Code != http.StatusOK {
+		t.log.Errorf("failed to get event subscription checkpoint: %s", status.Message)
+		return fresh
+	}
+
+	var checkpoint EventSubscriptionCheckpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		t.log.Errorf("failed to unmarshal event subscription checkpoint: %v", err)
+		return fresh
+	}
+	return &checkpoint
+}
+
+func (t *EventSubscriptionDelivery) storeCheckpoint(ctx context.Context, key string, checkpoint *EventSubscriptionCheckpoint) {
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		t.log.Errorf("failed to marshal event subscription checkpoint: %v", err)
+		return
+	}
+	if status := t.serviceHandler.SetCheckpoint(ctx, EventSubscriptionCheckpointConsumer, key, data); status.Code != http.StatusOK {
+		t.log.Errorf("failed to store event subscription checkpoint: %s", status.Message)
+	}
+}
+
+// deliverToSubscription delivers the matching events created in [since, until) and records the outcome in the status
+// of the subscription.  Once an event has exhausted its retries, the remaining events of the poll are attempted only
+// once until a delivery succeeds again, so that an unavailable webhook does not hold up the other subscriptions.
+func (t *EventSubscriptionDelivery) deliverToSubscription(ctx context.Context, subscription *api.EventSubscription, since, until time.Time) {
+	name := lo.FromPtr(subscription.Metadata.Name)
+	log := t.log.WithField("eventSubscription", name)
+
+	if created := subscription.Metadata.CreationTimestamp; created != nil && created.After(since) {
+		since = *created
+	}
+	params := api.ListEventsParams{
+		Order:         lo.ToPtr(api.Asc),
+		FieldSelector: lo.ToPtr(eventSubscriptionFieldSelector(subscription.Spec, since, until)),
+		Limit:         lo.ToPtr(int32(ItemsPerPage)),
+	}
+
+	status := lo.FromPtrOr(subscription.Status, api.EventSubscriptionStatus{})
+	if status.Conditions == nil {
+		status.Conditions = []api.Condition{}
+	}
+	attempts := eventDeliveryAttempts
+	delivered := 0
+	processed := 0
+	var deliveryErr error
+	for {
+		events, listStatus := t.serviceHandler.ListEvents(ctx, params)
+		if listStatus.Code != http.StatusOK {
+			deliveryErr = fmt.Errorf("failed to list events: %s", listStatus.Message)
+			log.Error(deliveryErr)
+			break
+		}
+		for i := range events.Items {
+			event := &events.Items[i]
+			tries, err := t.deliver(ctx, subscription, event, attempts)
+			if ctx.Err() != nil {
+				return
+			}
+			processed++
+			if err != nil {
+				log.Warnf("failed to deliver event %s (%s) after %d attempts: %v", lo.FromPtr(event.Metadata.Name), event.Reason, tries, err)
+				status.DeadLetters = lo.ToPtr(appendDeadLetter(lo.FromPtr(status.DeadLetters), api.EventSubscriptionDeadLetter{
+					EventName:      lo.FromPtr(event.Metadata.Name),
+					Reason:         string(event.Reason),
+					InvolvedObject: event.InvolvedObject,
+					Attempts:       int32(tries), //nolint:gosec // tries is bounded by eventDeliveryAttempts
+					LastError:      err.Error(),
+					Time:           time.Now().UTC(),
+				}))
+				deliveryErr = err
+				attempts = 1
+				continue
+			}
+			delivered++
+			deliveryErr = nil
+			attempts = eventDeliveryAttempts
+		}
+		if events.Metadata.Continue == nil {
+			break
+		}
+		params.Continue = events.Metadata.Continue
+	}
+
+	if processed == 0 && deliveryErr == nil {
+		return
+	}
+	if delivered > 0 {
+		status.DeliveredCount = lo.ToPtr(lo.FromPtr(status.DeliveredCount) + int64(delivered))
+		status.LastDeliveryTime = lo.ToPtr(time.Now().UTC())
+	}
+	api.SetStatusConditionByError(&status.Conditions, api.ConditionTypeEventSubscriptionDelivering, "Delivered", "DeliveryFailed", deliveryErr)
+	subscription.Status = &status
+	if _, st := t.serviceHandler.ReplaceEventSubscriptionStatus(ctx, name, *subscription); st.Code != http.StatusOK {
+		log.Errorf("failed to update event subscription status: %s", st.Message)
+	}
+}
+
+// deliver sends the event to the webhook of the subscription with exponential backoff, and returns the number of
+// attempts made
+func (t *EventSubscriptionDelivery) deliver(ctx context.Context, subscription *api.EventSubscription, event *api.Event, attempts int) (int, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return 0, fmt.Errorf("marshalling event: %w", err)
+	}
+
+	backoff := t.backoff
+	backoff.MaxSteps = attempts
+	tries := 0
+	var lastErr error
+	err = poll.BackoffWithContext(ctx, backoff, func(ctx context.Context) (bool, error) {
+		tries++
+		lastErr = t.send(ctx, subscription, event, body)
+		if lastErr == nil {
+			return true, nil
+		}
+		var statusErr *deliveryStatusError
+		if errors.As(lastErr, &statusErr) && !statusErr.retryable() {
+			return false, lastErr
+		}
+		return false, nil
+	})
+	if err != nil && lastErr != nil {
+		return tries, lastErr
+	}
+	return tries, err
+}
+
+func (t *EventSubscriptionDelivery) send(ctx context.Context, subscription *api.EventSubscription, event *api.Event, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.Spec.Webhook.Url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventSubscriptionHeader, lo.FromPtr(subscription.Metadata.Name))
+	req.Header.Set(EventSubscriptionDeliveryHeader, lo.FromPtr(event.Metadata.Name))
+	if secret := lo.FromPtr(subscription.Spec.Webhook.Secret); secret != "" {
+		req.Header.Set(EventSubscriptionSignatureHeader, "sha256="+SignEventDelivery(secret, body))
+	}
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return &deliveryStatusError{statusCode: resp.StatusCode}
+	}
+	return nil
+}
+
+// SignEventDelivery returns the hex-encoded HMAC-SHA256 of the body of a delivery, keyed with the secret of the
+// subscription
+func SignEventDelivery(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// eventSubscriptionFieldSelector returns the field selector of the events created in [since, until) that match the
+// filters of the subscription
+func eventSubscriptionFieldSelector(spec api.EventSubscriptionSpec, since, until time.Time) string {
+	fieldSelectors := []string{
+		fmt.Sprintf("metadata.creationTimestamp>=%s", since.UTC().Format(time.RFC3339Nano)),
+		fmt.Sprintf("metadata.creationTimestamp<%s", until.UTC().Format(time.RFC3339Nano)),
+	}
+	if reasons := lo.FromPtr(spec.Reasons); len(reasons) > 0 {
+		fieldSelectors = append(fieldSelectors, fmt.Sprintf("reason in (%s)", strings.Join(reasons, ",")))
+	}
+	if kinds := lo.FromPtr(spec.InvolvedObjectKinds); len(kinds) > 0 {
+		fieldSelectors = append(fieldSelectors, fmt.Sprintf("involvedObject.kind in (%s)", strings.Join(kinds, ",")))
+	}
+	if spec.FieldSelector != nil && *spec.FieldSelector != "" {
+		fieldSelectors = append(fieldSelectors, *spec.FieldSelector)
+	}
+	return strings.Join(fieldSelectors, ",")
+}
+
+// appendDeadLetter appends the dead letter, keeping only the newest MaxEventSubscriptionDeadLetters
+func appendDeadLetter(deadLetters []api.EventSubscriptionDeadLetter, deadLetter api.EventSubscriptionDeadLetter) []api.EventSubscriptionDeadLetter {
+	deadLetters = append(deadLetters, deadLetter)
+	if len(deadLetters) > MaxEventSubscriptionDeadLetters {
+		deadLetters = deadLetters[len(deadLetters)-MaxEventSubscriptionDeadLetters:]
+	}
+	return deadLetters
+}
@@ -0,0 +1,203 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func newTestEventSubscription(url string, secret *string) api.EventSubscription {
+	return api.EventSubscription{
+		Metadata: api.ObjectMeta{
+			Name:              lo.ToPtr("test-subscription"),
+			CreationTimestamp: lo.ToPtr(time.Now().Add(-24 * time.Hour)),
+		},
+		Spec: api.EventSubscriptionSpec{
+			Reasons: &[]string{string(api.EventReasonDeviceDisconnected)},
+			Webhook: api.EventSubscriptionWebhook{Url: url, Secret: secret},
+		},
+	}
+}
+
+func newTestSubscriptionEvent(name string) api.Event {
+	return api.Event{
+		Metadata:       api.ObjectMeta{Name: lo.ToPtr(name)},
+		Reason:         api.EventReasonDeviceDisconnected,
+		InvolvedObject: api.ObjectReference{Kind: api.DeviceKind, Name: "device-1"},
+		Type:           api.Warning,
+	}
+}
+
+func setupEventSubscriptionDelivery(t *testing.T, subscription api.EventSubscription, events []api.Event) (*EventSubscriptionDelivery, *service.MockService, context.Context) {
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+	orgId := uuid.New()
+	ctx := util.WithOrganizationID(context.Background(), orgId)
+
+	mockService.EXPECT().ListEventSubscriptions(gomock.Any(), gomock.Any()).Return(&api.EventSubscriptionList{Items: []api.EventSubscription{subscription}}, api.StatusOK())
+	mockService.EXPECT().GetCheckpoint(gomock.Any(), EventSubscriptionCheckpointConsumer, orgId.String()+"/test-subscription").Return(nil, api.StatusResourceNotFound("Checkpoint", EventSubscriptionCheckpointConsumer))
+	mockService.EXPECT().GetDatabaseTime(gomock.Any()).Return(time.Now(), api.StatusOK())
+	mockService.EXPECT().ListEvents(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, params api.ListEventsParams) (*api.EventList, api.Status) {
+			assert.Contains(t, lo.FromPtr(params.FieldSelector), "reason in (DeviceDisconnected)")
+			return &api.EventList{Items: events}, api.StatusOK()
+		})
+
+	delivery := NewEventSubscriptionDelivery(logrus.New(), mockService)
+	// the test webhooks listen on the loopback address
+	delivery.client = newEventDeliveryClient(func(net.IP) error { return nil })
+	delivery.backoff.BaseDelay = time.Millisecond
+	delivery.backoff.MaxDelay = time.Millisecond
+	return delivery, mockService, ctx
+}
+
+func TestEventSubscriptionDelivery_DeliversSignedEvents(t *testing.T) {
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "test-subscription", r.Header.Get(EventSubscriptionHeader))
+		assert.Equal(t, "sha256="+SignEventDelivery("s3cret", body), r.Header.Get(EventSubscriptionSignatureHeader))
+
+		var event api.Event
+		require.NoError(t, json.Unmarshal(body, &event))
+		assert.Equal(t, lo.FromPtr(event.Metadata.Name), r.Header.Get(EventSubscriptionDeliveryHeader))
+		received.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	subscription := newTestEventSubscription(server.URL, lo.ToPtr("s3cret"))
+	delivery, mockService, ctx := setupEventSubscriptionDelivery(t, subscription, []api.Event{newTestSubscriptionEvent("event-1"), newTestSubscriptionEvent("event-2")})
+
+	mockService.EXPECT().ReplaceEventSubscriptionStatus(gomock.Any(), "test-subscription", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, name string, sub api.EventSubscription) (*api.EventSubscription, api.Status) {
+			require.NotNil(t, sub.Status)
+			assert.Equal(t, int64(2), lo.FromPtr(sub.Status.DeliveredCount))
+			assert.Empty(t, lo.FromPtr(sub.Status.DeadLetters))
+			assert.True(t, api.IsStatusConditionTrue(sub.Status.Conditions, api.ConditionTypeEventSubscriptionDelivering))
+			return &sub, api.StatusOK()
+		})
+	mockService.EXPECT().SetCheckpoint(gomock.Any(), EventSubscriptionCheckpointConsumer, gomock.Any(), gomock.Any()).Return(api.StatusOK())
+
+	delivery.Poll(ctx)
+	assert.Equal(t, int32(2), received.Load())
+}
+
+func TestEventSubscriptionDelivery_RefusesInternalAddresses(t *testing.T) {
+	var received atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	subscription := newTestEventSubscription(server.URL, nil)
+	delivery, mockService, ctx := setupEventSubscriptionDelivery(t, subscription, []api.Event{newTestSubscriptionEvent("event-1")})
+	delivery.client = newEventDeliveryClient(checkWebhookIP)
+
+	mockService.EXPECT().ReplaceEventSubscriptionStatus(gomock.Any(), "test-subscription", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, name string, sub api.EventSubscription) (*api.EventSubscription, api.Status) {
+			deadLetters := lo.FromPtr(sub.Status.DeadLetters)
+			require.Len(t, deadLetters, 1)
+			assert.Contains(t, deadLetters[0].LastError, "is not allowed")
+			return &sub, api.StatusOK()
+		})
+	mockService.EXPECT().SetCheckpoint(gomock.Any(), EventSubscriptionCheckpointConsumer, gomock.Any(), gomock.Any()).Return(api.StatusOK())
+
+	delivery.Poll(ctx)
+	assert.Zero(t, received.Load())
+}
+
+func TestEventSubscriptionDelivery_DoesNotFollowRedirects(t *testing.T) {
+	var redirected atomic.Int32
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected.Add(1)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer target.Close()
+	server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+	defer server.Close()
+
+	subscription := newTestEventSubscription(server.URL, nil)
+	delivery, mockService, ctx := setupEventSubscriptionDelivery(t, subscription, []api.Event{newTestSubscriptionEvent("event-1")})
+
+	mockService.EXPECT().ReplaceEventSubscriptionStatus(gomock.Any(), "test-subscription", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, name string, sub api.EventSubscription) (*api.EventSubscription, api.Status) {
+			deadLetters := lo.FromPtr(sub.Status.DeadLetters)
+			require.Len(t, deadLetters, 1)
+			assert.Equal(t, int32(1), deadLetters[0].Attempts)
+			return &sub, api.StatusOK()
+		})
+	mockService.EXPECT().SetCheckpoint(gomock.Any(), EventSubscriptionCheckpointConsumer, gomock.Any(), gomock.Any()).Return(api.StatusOK())
+
+	delivery.Poll(ctx)
+	assert.Zero(t, redirected.Load())
+}
+
+func TestEventSubscriptionDelivery_DeadLettersUndeliverableEvents(t *testing.T) {
+	tests := []struct {
+		name             string
+		statusCode       int
+		expectedAttempts int32
+	}{
+		{"ServerErrorIsRetried", http.StatusServiceUnavailable, eventDeliveryAttempts},
+		{"ClientErrorIsNotRetried", http.StatusBadRequest, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received.Add(1)
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			subscription := newTestEventSubscription(server.URL, nil)
+			delivery, mockService, ctx := setupEventSubscriptionDelivery(t, subscription, []api.Event{newTestSubscriptionEvent("event-1")})
+
+			mockService.EXPECT().ReplaceEventSubscriptionStatus(gomock.Any(), "test-subscription", gomock.Any()).DoAndReturn(
+				func(ctx context.Context, name string, sub api.EventSubscription) (*api.EventSubscription, api.Status) {
+					require.NotNil(t, sub.Status)
+					assert.Zero(t, lo.FromPtr(sub.Status.DeliveredCount))
+					deadLetters := lo.FromPtr(sub.Status.DeadLetters)
+					require.Len(t, deadLetters, 1)
+					assert.Equal(t, "event-1", deadLetters[0].EventName)
+					assert.Equal(t, tt.expectedAttempts, deadLetters[0].Attempts)
+					assert.True(t, api.IsStatusConditionFalse(sub.Status.Conditions, api.ConditionTypeEventSubscriptionDelivering))
+					return &sub, api.StatusOK()
+				})
+			mockService.EXPECT().SetCheckpoint(gomock.Any(), EventSubscriptionCheckpointConsumer, gomock.Any(), gomock.Any()).Return(api.StatusOK())
+
+			delivery.Poll(ctx)
+			assert.Equal(t, tt.expectedAttempts, received.Load())
+		})
+	}
+}
+
+func TestAppendDeadLetterKeepsNewest(t *testing.T) {
+	var deadLetters []api.EventSubscriptionDeadLetter
+	for i := 0; i < MaxEventSubscriptionDeadLetters+5; i++ {
+		deadLetters = appendDeadLetter(deadLetters, api.EventSubscriptionDeadLetter{Attempts: int32(i)}) //nolint:gosec
+	}
+	require.Len(t, deadLetters, MaxEventSubscriptionDeadLetters)
+	assert.Equal(t, int32(5), deadLetters[0].Attempts)
+	assert.Equal(t, int32(MaxEventSubscriptionDeadLetters+4), deadLetters[len(deadLetters)-1].Attempts)
+}
@@ -2,17 +2,16 @@ package tasks
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"net/http"
 	"strings"
-	"text/template"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/rollout"
 	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
@@ -207,24 +206,14 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 			currentVersion = v
 		}
 	}
-	errs := []error{}
-
-	var osSpec *api.DeviceOsSpec
-	if templateVersion.Status.Os != nil {
-		img, err := replaceParametersInString(templateVersion.Status.Os.Image, device)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("failed replacing parameters in OS image: %w", err))
-		} else {
-			osSpec = &api.DeviceOsSpec{Image: img}
-		}
-	}
-
-	deviceConfig, configErrs := f.getDeviceConfig(device, templateVersion)
-	errs = append(errs, configErrs...)
-
-	deviceApps, appErrs := f.getDeviceApps(device, templateVersion)
-	errs = append(errs, appErrs...)
-
+	newDeviceSpec, errs := common.RenderDeviceSpec(device, &api.DeviceSpec{
+		Applications: templateVersion.Status.Applications,
+		Config:       templateVersion.Status.Config,
+		Os:           templateVersion.Status.Os,
+		Resources:    templateVersion.Status.Resources,
+		Systemd:      templateVersion.Status.Systemd,
+		UpdatePolicy: templateVersion.Status.UpdatePolicy,
+	})
 	if len(errs) > 0 {
 		annotations := map[string]string{
 			api.DeviceAnnotationLastRolloutError: errors.Join(errs...).Error(),
@@ -236,27 +225,18 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 		return fmt.Errorf("failed generating device spec for %s/%s: %w", f.orgId, *device.Metadata.Name, errors.Join(errs...))
 	}
 
-	newDeviceSpec := api.DeviceSpec{
-		Config:       deviceConfig,
-		Os:           osSpec,
-		Systemd:      templateVersion.Status.Systemd,
-		Resources:    templateVersion.Status.Resources,
-		Applications: deviceApps,
-		UpdatePolicy: templateVersion.Status.UpdatePolicy,
-	}
-
 	errs = newDeviceSpec.Validate(false)
 	if len(errs) > 0 {
 		return fmt.Errorf("failed validating device spec for %s/%s: %w", f.orgId, *device.Metadata.Name, errors.Join(errs...))
 	}
 
-	if currentVersion == *templateVersion.Metadata.Name && api.DeviceSpecsAreEqual(newDeviceSpec, *device.Spec) {
+	if currentVersion == *templateVersion.Metadata.Name && api.DeviceSpecsAreEqual(*newDeviceSpec, *device.Spec) {
 		f.log.Debugf("Not rolling out device %s/%s because it is already at templateVersion %s", f.orgId, *device.Metadata.Name, *templateVersion.Metadata.Name)
 		return nil
 	}
 
 	f.log.Infof("Rolling out device %s/%s to templateVersion %s", f.orgId, *device.Metadata.Name, *templateVersion.Metadata.Name)
-	err := f.updateDeviceInStore(ctx, device, &newDeviceSpec, delayDeviceRender)
+	err := f.updateDeviceInStore(ctx, device, newDeviceSpec, delayDeviceRender)
 	if err != nil {
 		return fmt.Errorf("failed updating device spec: %w", err)
 	}
@@ -272,327 +252,6 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 	return err
 }
 
-func (f FleetRolloutsLogic) getDeviceApps(device *api.Device, templateVersion *api.TemplateVersion) (*[]api.ApplicationProviderSpec, []error) {
-	if templateVersion.Status.Applications == nil {
-		return nil, nil
-	}
-
-	deviceApps := []api.ApplicationProviderSpec{}
-	appErrs := []error{}
-	for appIndex, appItem := range *templateVersion.Status.Applications {
-		var newAppItem *api.ApplicationProviderSpec
-		errs := []error{}
-		appType, err := appItem.Type()
-		if err != nil {
-			appErrs = append(errs, fmt.Errorf("failed getting type for app %d: %w", appIndex, err))
-			continue
-		}
-		switch appType {
-		case api.ImageApplicationProviderType:
-			newAppItem, errs = f.replaceEnvVarValueParameters(device, appItem)
-		case api.InlineApplicationProviderType:
-			newAppItem, errs = f.replaceInlineApplicationParameters(device, appItem)
-		default:
-			errs = append(errs, fmt.Errorf("unsupported type for app %d: %s", appIndex, appType))
-		}
-
-		appErrs = append(appErrs, errs...)
-		if newAppItem != nil {
-			deviceApps = append(deviceApps, *newAppItem)
-		}
-	}
-
-	if len(appErrs) > 0 {
-		return nil, appErrs
-	}
-
-	return &deviceApps, nil
-}
-
-func (f FleetRolloutsLogic) replaceEnvVarValueParameters(device *api.Device, app api.ApplicationProviderSpec) (*api.ApplicationProviderSpec, []error) {
-	if app.EnvVars == nil {
-		return &app, nil
-	}
-
-	origEnvVars := *app.EnvVars
-	var errs []error
-	newEnvVars := make(map[string]string, len(origEnvVars))
-	for k, v := range origEnvVars {
-		newValue, err := replaceParametersInString(v, device)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("failed replacing parameters in env var %s: %w", k, err))
-			continue
-		}
-		newEnvVars[k] = newValue
-	}
-
-	if len(errs) > 0 {
-		return nil, errs
-	}
-
-	app.EnvVars = &newEnvVars
-
-	return &app, nil
-}
-
-func (f FleetRolloutsLogic) getDeviceConfig(device *api.Device, templateVersion *api.TemplateVersion) (*[]api.ConfigProviderSpec, []error) {
-	if templateVersion.Status.Config == nil {
-		return nil, nil
-	}
-
-	deviceConfig := []api.ConfigProviderSpec{}
-	configErrs := []error{}
-	for _, configItem := range *templateVersion.Status.Config {
-		var newConfigItem *api.ConfigProviderSpec
-		errs := []error{}
-
-		configType, err := configItem.Type()
-		if err != nil {
-			configErrs = append(configErrs, fmt.Errorf("%w: failed getting config type: %w", ErrUnknownConfigName, err))
-			continue
-		}
-
-		switch configType {
-		case api.GitConfigProviderType:
-			newConfigItem, errs = f.replaceGitConfigParameters(device, configItem)
-		case api.KubernetesSecretProviderType:
-			newConfigItem, errs = f.replaceKubeSecretConfigParameters(device, configItem)
-		case api.InlineConfigProviderType:
-			newConfigItem, errs = f.replaceInlineConfigParameters(device, configItem)
-		case api.HttpConfigProviderType:
-			newConfigItem, errs = f.replaceHTTPConfigParameters(device, configItem)
-		default:
-			errs = append(errs, fmt.Errorf("%w: unsupported config type %q", ErrUnknownConfigName, configType))
-		}
-
-		configErrs = append(configErrs, errs...)
-		if newConfigItem != nil {
-			deviceConfig = append(deviceConfig, *newConfigItem)
-		}
-	}
-
-	if len(configErrs) > 0 {
-		return nil, configErrs
-	}
-
-	return &deviceConfig, nil
-}
-
-func (f FleetRolloutsLogic) replaceGitConfigParameters(device *api.Device, configItem api.ConfigProviderSpec) (*api.ConfigProviderSpec, []error) {
-	gitSpec, err := configItem.AsGitConfigProviderSpec()
-	if err != nil {
-		return nil, []error{fmt.Errorf("failed to convert config to git config: %w", err)}
-	}
-
-	errs := []error{}
-
-	gitSpec.GitRef.TargetRevision, err = replaceParametersInString(gitSpec.GitRef.TargetRevision, device)
-	if err != nil {
-		errs = append(errs, fmt.Errorf("failed replacing parameters in targetRevision in git config %s: %w", gitSpec.Name, err))
-	}
-
-	gitSpec.GitRef.Path, err = replaceParametersInString(gitSpec.GitRef.Path, device)
-	if err != nil {
-		errs = append(errs, fmt.Errorf("failed replacing parameters in path in git config %s: %w", gitSpec.Name, err))
-	}
-
-	if len(errs) > 0 {
-		return nil, errs
-	}
-
-	newConfigItem := api.ConfigProviderSpec{}
-	err = newConfigItem.FromGitConfigProviderSpec(gitSpec)
-	if err != nil {
-		return nil, []error{fmt.Errorf("failed converting git config: %w", err)}
-	}
-
-	return &newConfigItem, nil
-}
-
-func (f FleetRolloutsLogic) replaceKubeSecretConfigParameters(device *api.Device, configItem api.ConfigProviderSpec) (*api.ConfigProviderSpec, []error) {
-	secretSpec, err := configItem.AsKubernetesSecretProviderSpec()
-	if err != nil {
-		return nil, []error{fmt.Errorf("failed to convert config to kubernetes secret config: %w", err)}
-	}
-
-	errs := []error{}
-
-	secretSpec.SecretRef.Name, err = replaceParametersInString(secretSpec.SecretRef.Name, device)
-	if err != nil {
-		errs = append(errs, fmt.Errorf("failed replacing parameters in name in k8s secret config %s: %w", secretSpec.Name, err))
-	}
-
-	secretSpec.SecretRef.Namespace, err = replaceParametersInString(secretSpec.SecretRef.Namespace, device)
-	if err != nil {
-		errs = append(errs, fmt.Errorf("failed replacing parameters in namespace in k8s secret config %s: %w", secretSpec.Name, err))
-	}
-
-	secretSpec.SecretRef.MountPath, err = replaceParametersInString(secretSpec.SecretRef.MountPath, device)
-	if err != nil {
-		errs = append(errs, fmt.Errorf("failed replacing parameters in mountPath in k8s secret config %s: %w", secretSpec.Name, err))
-	}
-
-	if len(errs) > 0 {
-		return nil, errs
-	}
-
-	newConfigItem := api.ConfigProviderSpec{}
-	err = newConfigItem.FromKubernetesSecretProviderSpec(secretSpec)
-	if err != nil {
-		return nil, []error{fmt.Errorf("failed converting git config: %w", err)}
-	}
-
-	return &newConfigItem, nil
-}
-
-func (f FleetRolloutsLogic) replaceInlineConfigParameters(device *api.Device, configItem api.ConfigProviderSpec) (*api.ConfigProviderSpec, []error) {
-	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
-	if err != nil {
-		return nil, []error{fmt.Errorf("failed to convert config to inline config: %w", err)}
-	}
-
-	errs := []error{}
-
-	for fileIndex, file := range inlineSpec.Inline {
-		var decodedBytes []byte
-		var err error
-
-		inlineSpec.Inline[fileIndex].Path, err = replaceParametersInString(file.Path, device)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("failed replacing parameters in path for file %d in inline config %s: %w", fileIndex, inlineSpec.Name, err))
-		}
-
-		encoding := lo.FromPtr(file.ContentEncoding)
-		if encoding == api.EncodingBase64 {
-			decodedBytes, err = base64.StdEncoding.DecodeString(file.Content)
-			if err != nil {
-				errs = append(errs, fmt.Errorf("failed base64 decoding contents for file %d in inline config %s: %w", fileIndex, inlineSpec.Name, err))
-				continue
-			}
-		} else {
-			decodedBytes = []byte(file.Content)
-		}
-
-		contentsReplaced, err := replaceParametersInString(string(decodedBytes), device)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("failed replacing parameters in contents for file %d in inline config %s: %w", fileIndex, inlineSpec.Name, err))
-			continue
-		}
-
-		if encoding == api.EncodingBase64 {
-			inlineSpec.Inline[fileIndex].Content = base64.StdEncoding.EncodeToString([]byte(contentsReplaced))
-		} else {
-			inlineSpec.Inline[fileIndex].Content = contentsReplaced
-		}
-	}
-
-	if len(errs) > 0 {
-		return nil, errs
-	}
-
-	newConfigItem := api.ConfigProviderSpec{}
-	err = newConfigItem.FromInlineConfigProviderSpec(inlineSpec)
-	if err != nil {
-		return nil, []error{fmt.Errorf("failed converting inline config: %w", err)}
-	}
-
-	return &newConfigItem, nil
-}
-
-func (f FleetRolloutsLogic) replaceInlineApplicationParameters(device *api.Device, item api.ApplicationProviderSpec) (*api.ApplicationProviderSpec, []error) {
-	appName := lo.FromPtr(item.Name)
-	inlineSpec, err := item.AsInlineApplicationProviderSpec()
-	if err != nil {
-		return nil, []error{fmt.Errorf("failed to convert to inline application provider: %w", err)}
-	}
-
-	errs := []error{}
-	for fileIndex, file := range inlineSpec.Inline {
-		var decodedBytes []byte
-		var err error
-
-		inlineSpec.Inline[fileIndex].Path, err = replaceParametersInString(file.Path, device)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("failed replacing parameters in path for file %d in inline app %s: %w", fileIndex, appName, err))
-		}
-
-		content := lo.FromPtr(file.Content)
-		encoding := lo.FromPtr(file.ContentEncoding)
-		if encoding == api.EncodingBase64 {
-			decodedBytes, err = base64.StdEncoding.DecodeString(content)
-			if err != nil {
-				errs = append(errs, fmt.Errorf("failed base64 decoding contents for file %d in inline app %s: %w", fileIndex, appName, err))
-				continue
-			}
-		} else {
-			decodedBytes = []byte(content)
-		}
-
-		contentsReplaced, err := replaceParametersInString(string(decodedBytes), device)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("failed replacing parameters in contents for file %d in inline app %s: %w", fileIndex, appName, err))
-			continue
-		}
-
-		if encoding == api.EncodingBase64 {
-			contentsReplaced = base64.StdEncoding.EncodeToString([]byte(contentsReplaced))
-			inlineSpec.Inline[fileIndex].Content = &contentsReplaced
-		} else {
-			inlineSpec.Inline[fileIndex].Content = &contentsReplaced
-		}
-	}
-
-	if len(errs) > 0 {
-		return nil, errs
-	}
-
-	newItem := api.ApplicationProviderSpec{
-		Name:    &appName,
-		EnvVars: item.EnvVars,
-		AppType: item.AppType,
-	}
-	err = newItem.FromInlineApplicationProviderSpec(inlineSpec)
-	if err != nil {
-		return nil, []error{fmt.Errorf("failed converting inline application: %w", err)}
-	}
-
-	return &newItem, nil
-}
-
-func (f FleetRolloutsLogic) replaceHTTPConfigParameters(device *api.Device, configItem api.ConfigProviderSpec) (*api.ConfigProviderSpec, []error) {
-	httpSpec, err := configItem.AsHttpConfigProviderSpec()
-	if err != nil {
-		return nil, []error{fmt.Errorf("failed to convert config to http config: %w", err)}
-	}
-
-	errs := []error{}
-
-	if httpSpec.HttpRef.Suffix != nil {
-		suffix, err := replaceParametersInString(*httpSpec.HttpRef.Suffix, device)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("failed replacing parameters in suffix in http config %s: %w", httpSpec.Name, err))
-		}
-		httpSpec.HttpRef.Suffix = &suffix
-	}
-
-	httpSpec.HttpRef.FilePath, err = replaceParametersInString(httpSpec.HttpRef.FilePath, device)
-	if err != nil {
-		errs = append(errs, fmt.Errorf("failed replacing parameters in file path in http config %s: %w", httpSpec.Name, err))
-	}
-
-	if len(errs) > 0 {
-		return nil, errs
-	}
-
-	newConfigItem := api.ConfigProviderSpec{}
-	err = newConfigItem.FromHttpConfigProviderSpec(httpSpec)
-	if err != nil {
-		return nil, []error{fmt.Errorf("failed converting http config: %w", err)}
-	}
-
-	return &newConfigItem, nil
-}
-
 func (f FleetRolloutsLogic) updateDeviceInStore(ctx context.Context, device *api.Device, newDeviceSpec *api.DeviceSpec, delayDeviceRender bool) error {
 	var status api.Status
 	for i := 0; i < 10; i++ {
@@ -618,17 +277,3 @@ func (f FleetRolloutsLogic) updateDeviceInStore(ctx context.Context, device *api
 
 	return service.ApiStatusToErr(status)
 }
-
-func replaceParametersInString(s string, device *api.Device) (string, error) {
-	t, err := template.New("t").Option("missingkey=error").Funcs(api.GetGoTemplateFuncMap()).Parse(s)
-	if err != nil {
-		return "", fmt.Errorf("invalid parameter syntax: %v", err)
-	}
-
-	output, err := api.ExecuteGoTemplateOnDevice(t, device)
-	if err != nil {
-		return "", fmt.Errorf("cannot apply parameters, possibly because they access invalid fields: %w", err)
-	}
-
-	return output, nil
-}
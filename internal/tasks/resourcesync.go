@@ -5,14 +5,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
+	"maps"
 	"net/http"
+	"reflect"
+	"slices"
 	"strings"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/service"
+	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/util/validation"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/go-git/go-billy/v5"
 	"github.com/samber/lo"
@@ -31,7 +35,28 @@ type ResourceSync struct {
 type GenericResourceMap map[string]interface{}
 
 var validFileExtensions = []string{"json", "yaml", "yml"}
-var supportedResources = []string{api.FleetKind}
+var supportedResources = []string{api.FleetKind, api.RepositoryKind, api.DeviceKind}
+
+// reservedDeviceAnnotationPrefixes are the prefixes of device annotations that are managed by the service and
+// therefore can't be synced
+var reservedDeviceAnnotationPrefixes = []string{"device-controller/", "fleet-controller/", "resourcesync-controller/"}
+
+// errDeviceNotEnrolled is returned when a device is synced before it is enrolled
+var errDeviceNotEnrolled = errors.New("device is not enrolled")
+
+// SyncedResources are the resources defined in the path of a ResourceSync, by kind
+type SyncedResources struct {
+	Fleets       []*api.Fleet
+	Repositories []*api.Repository
+	// Devices are not created by a ResourceSync, only their labels and annotations are synced
+	Devices []*api.Device
+}
+
+// deviceManagedKeys are the keys of the labels and annotations of a device that are managed by a ResourceSync
+type deviceManagedKeys struct {
+	Labels      []string `json:"labels,omitempty"`
+	Annotations []string `json:"annotations,omitempty"`
+}
 
 func NewResourceSync(serviceHandler service.Service, log logrus.FieldLogger, ignoreResourceUpdates []string) *ResourceSync {
 	return &ResourceSync{
@@ -60,10 +85,10 @@ func (r *ResourceSync) Poll(ctx context.Context) {
 		}
 
 		for i := range resourcesyncs.Items {
-			rs := &resourcesyncs.Items[i]
-			err := r.run(ctx, log, rs)
+			name := lo.FromPtr(resourcesyncs.Items[i].Metadata.Name)
+			err := r.SyncResourceSync(ctx, name)
 			if err != nil {
-				log.Errorf("resourcesync/%s: error during run: %v", *rs.Metadata.Name, err)
+				log.Errorf("resourcesync/%s: error during run: %v", name, err)
 			}
 		}
 
@@ -74,6 +99,25 @@ func (r *ResourceSync) Poll(ctx context.Context) {
 	}
 }
 
+// SyncResourceSync runs the ResourceSync, either from the poll or immediately, e.g. after a push to its repository
+// was notified.  Syncs of the same ResourceSync are serialized, and the ResourceSync is read once the previous sync
+// is done, so that the sync starts from the status it left.
+func (r *ResourceSync) SyncResourceSync(ctx context.Context, name string) error {
+	log := log.WithReqIDFromCtx(ctx, r.log)
+
+	return r.serviceHandler.WithResourceSyncLock(ctx, name, func(ctx context.Context) error {
+		rs, status := r.serviceHandler.GetResourceSync(ctx, name)
+		if status.Code == http.StatusNotFound {
+			log.Infof("resourcesync/%s: not found, skipping sync", name)
+			return nil
+		}
+		if status.Code != http.StatusOK {
+			return fmt.Errorf("fetching resourcesync: %s", status.Message)
+		}
+		return r.run(ctx, log, rs)
+	})
+}
+
 func (r *ResourceSync) run(ctx context.Context, log logrus.FieldLogger, rs *api.ResourceSync) error {
 	resourceName := lo.FromPtr(rs.Metadata.Name)
 	defer r.updateResourceSyncStatus(ctx, rs)
@@ -85,29 +129,146 @@ func (r *ResourceSync) run(ctx context.Context, log logrus.FieldLogger, rs *api.
 	}
 
 	// Parse and validate resources
-	resources, err := r.parseAndValidateResources(rs, repo, CloneGitRepo)
+	resources, needsSync, err := r.parseAndValidateResources(rs, repo, CloneGitRepo)
 	if err != nil {
-		log.Errorf("resourcesync/%s: parsing failed. error: %s", *rs.Metadata.Name, err.Error())
 		log.Errorf("resource %s: parsing failed. error: %s", resourceName, err.Error())
 		return err
 	}
-	if resources == nil {
-		// No resources to sync
-		return nil
-	}
 
-	// Parse fleets from resources
-	fleets, err := r.ParseFleetsFromResources(resources, resourceName)
+	// Parse fleets, repositories and devices from resources
+	parsed, err := r.ParseResources(resources, resourceName)
+	api.SetStatusConditionByError(&rs.Status.Conditions, api.ConditionTypeResourceSyncResourceParsed, "success", "fail", err)
 	if err != nil {
 		log.Errorf("%v", err)
 		return err
 	}
 
-	// Set the ResourceParsed condition based on the result
-	api.SetStatusConditionByError(&rs.Status.Conditions, api.ConditionTypeResourceSyncResourceParsed, "success", "fail", err)
+	if !needsSync && !isDryRun(rs) {
+		return r.detectDrift(ctx, log, rs, parsed, resourceName)
+	}
+
+	rs.Status.PendingChanges = nil
+	if !isDryRun(rs) {
+		rs.Status.DriftedResources = nil
+	}
+
+	// Repositories are synced first so that the synced fleets can reference them
+	repositoriesErr := r.SyncRepositories(ctx, log, rs, parsed.Repositories, resourceName)
+	fleetsErr := r.SyncFleets(ctx, log, rs, parsed.Fleets, resourceName)
+	devicesErr := r.SyncDevices(ctx, log, rs, parsed.Devices, resourceName)
+	err = errors.Join(fleetsErr, repositoriesErr, devicesErr)
+	switch {
+	case err != nil:
+		api.SetStatusConditionByError(&rs.Status.Conditions, api.ConditionTypeResourceSyncSynced, "success", "fail", err)
+	case isDryRun(rs):
+		pending := len(lo.FromPtr(rs.Status.PendingChanges))
+		log.Infof("Resource %s: dry run, %d changes pending", resourceName, pending)
+		api.SetStatusCondition(&rs.Status.Conditions, api.Condition{
+			Type:    api.ConditionTypeResourceSyncSynced,
+			Status:  api.ConditionStatusFalse,
+			Reason:  api.ResourceSyncDryRunReason,
+			Message: fmt.Sprintf("Dry run: %d changes were not applied", pending),
+		})
+	default:
+		if unenrolled := unenrolledDevices(rs); len(unenrolled) > 0 {
+			api.SetStatusCondition(&rs.Status.Conditions, api.Condition{
+				Type:    api.ConditionTypeResourceSyncSynced,
+				Status:  api.ConditionStatusTrue,
+				Reason:  api.ResourceSyncDevicesNotEnrolledReason,
+				Message: fmt.Sprintf("Devices are not enrolled yet: %s", strings.Join(unenrolled, ", ")),
+			})
+		}
+		setDriftedCondition(rs, nil)
+	}
+	return err
+}
+
+// detectDrift compares the resources managed by the ResourceSync with the resources of the observed commit.  Any
+// difference, other than resources that were kept because pruning is disabled, means that the resources were
+// modified outside of the repository since they were synced.  Drift is reported but not reverted; the next commit or
+// change of the ResourceSync applies the repository again.
+func (r *ResourceSync) detectDrift(ctx context.Context, log logrus.FieldLogger, rs *api.ResourceSync, parsed *SyncedResources, resourceName string) error {
+	rs.Status.PendingChanges = nil
+	err := errors.Join(
+		r.syncRepositories(ctx, log, rs, parsed.Repositories, resourceName, false),
+		r.syncFleets(ctx, log, rs, parsed.Fleets, resourceName, false),
+		r.syncDevices(ctx, log, rs, parsed.Devices, resourceName, false),
+	)
+	if err != nil {
+		log.Errorf("Resource %s: failed to detect drift. error: %s", resourceName, err.Error())
+		return err
+	}
+
+	var drifted []api.ObjectReference
+	for _, change := range lo.FromPtr(rs.Status.PendingChanges) {
+		if change.Action == api.Delete && !isPruned(rs) {
+			continue
+		}
+		// Devices that are not enrolled are synced by the next run, see NeedsSyncToHash
+		if change.Kind == api.DeviceKind && change.Action == api.Create {
+			continue
+		}
+		drifted = append(drifted, api.ObjectReference{Kind: change.Kind, Name: change.Name})
+	}
+	if len(drifted) > 0 {
+		log.Warnf("Resource %s: %d resources drifted from the repository", resourceName, len(drifted))
+	}
+	setDriftedCondition(rs, drifted)
+	return nil
+}
+
+// setDriftedCondition records the resources that drifted from the repository in the status of the ResourceSync
+func setDriftedCondition(rs *api.ResourceSync, drifted []api.ObjectReference) {
+	condition := api.Condition{
+		Type:    api.ConditionTypeResourceSyncDrifted,
+		Status:  api.ConditionStatusFalse,
+		Reason:  api.ResourceSyncInSyncReason,
+		Message: "Resources match the repository",
+	}
+	rs.Status.DriftedResources = nil
+	if len(drifted) > 0 {
+		names := lo.Map(drifted, func(ref api.ObjectReference, _ int) string {
+			return strings.ToLower(ref.Kind) + "/" + ref.Name
+		})
+		condition.Status = api.ConditionStatusTrue
+		condition.Reason = api.ResourceSyncDriftedReason
+		condition.Message = fmt.Sprintf("Resources were modified outside of the repository: %s", strings.Join(names, ", "))
+		rs.Status.DriftedResources = &drifted
+	}
+	api.SetStatusCondition(&rs.Status.Conditions, condition)
+}
+
+// isDryRun returns true if the changes of the ResourceSync are reported instead of applied
+func isDryRun(rs *api.ResourceSync) bool {
+	return lo.FromPtr(rs.Spec.DryRun)
+}
+
+// isPruned returns true if resources that are removed from the repository are deleted
+func isPruned(rs *api.ResourceSync) bool {
+	return lo.FromPtrOr(rs.Spec.Prune, true)
+}
+
+// unenrolledDevices returns the names of the devices that could not be synced because they are not enrolled yet
+func unenrolledDevices(rs *api.ResourceSync) []string {
+	if rs.Status == nil {
+		return nil
+	}
+	var names []string
+	for _, change := range lo.FromPtr(rs.Status.PendingChanges) {
+		if change.Kind == api.DeviceKind && change.Action == api.Create {
+			names = append(names, change.Name)
+		}
+	}
+	return names
+}
 
-	// Sync fleets
-	return r.SyncFleets(ctx, log, rs, fleets, resourceName)
+// addPendingChange records a change that was not applied in the status of the ResourceSync
+func addPendingChange(rs *api.ResourceSync, kind, name string, action api.ResourceSyncChangeAction) {
+	if rs.Status == nil {
+		rs.Status = &api.ResourceSyncStatus{Conditions: []api.Condition{}}
+	}
+	change := api.ResourceSyncChange{Kind: kind, Name: name, Action: action}
+	rs.Status.PendingChanges = lo.ToPtr(append(lo.FromPtr(rs.Status.PendingChanges), change))
 }
 
 // GetRepositoryAndValidateAccess gets the repository and validates it's accessible
@@ -137,22 +298,39 @@ func (r *ResourceSync) GetRepositoryAndValidateAccess(ctx context.Context, rs *a
 
 // ParseFleetsFromResources parses fleets from generic resources
 func (r *ResourceSync) ParseFleetsFromResources(resources []GenericResourceMap, resourceName string) ([]*api.Fleet, error) {
+	parsed, err := r.ParseResources(resources, resourceName)
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Fleets, nil
+}
+
+// ParseResources parses fleets, repositories and devices from generic resources
+func (r *ResourceSync) ParseResources(resources []GenericResourceMap, resourceName string) (*SyncedResources, error) {
 	owner := util.SetResourceOwner(api.ResourceSyncKind, resourceName)
-	fleets, err := r.parseFleets(resources, owner)
+	parsed, err := r.parseResources(resources, owner)
 	// Note: We can't set conditions here since we don't have access to rs
 	// The conditions will be set in the calling method
 	if err != nil {
 		err = fmt.Errorf("resource %s: error: %w", resourceName, err)
 		return nil, err
 	}
-	return fleets, nil
+	return parsed, nil
 }
 
-// SyncFleets syncs the fleets to the service
+// SyncFleets syncs the fleets to the service.  If the ResourceSync is a dry run, the changes are added to the pending
+// changes of its status instead.
 func (r *ResourceSync) SyncFleets(ctx context.Context, log logrus.FieldLogger, rs *api.ResourceSync, fleets []*api.Fleet, resourceName string) error {
 	if rs == nil {
 		return fmt.Errorf("ResourceSync is nil")
 	}
+	return r.syncFleets(ctx, log, rs, fleets, resourceName, !isDryRun(rs))
+}
+
+func (r *ResourceSync) syncFleets(ctx context.Context, log logrus.FieldLogger, rs *api.ResourceSync, fleets []*api.Fleet, resourceName string, apply bool) error {
+	if rs == nil {
+		return fmt.Errorf("ResourceSync is nil")
+	}
 
 	// Ensure Status and Conditions are initialized
 	if rs.Status == nil {
@@ -165,7 +343,7 @@ func (r *ResourceSync) SyncFleets(ctx context.Context, log logrus.FieldLogger, r
 	owner := util.SetResourceOwner(api.ResourceSyncKind, resourceName)
 
 	// Validate that no fleet names conflict with fleets owned by other ResourceSyncs
-	err := r.validateFleetNameConflicts(ctx, fleets, *owner)
+	existing, err := r.validateFleetNameConflicts(ctx, fleets, *owner)
 	if err != nil {
 		err = fmt.Errorf("resource %s: error: %w", resourceName, err)
 		log.Errorf("%v", err)
@@ -196,12 +374,33 @@ func (r *ResourceSync) SyncFleets(ctx context.Context, log logrus.FieldLogger, r
 
 	fleetsToRemove := fleetsDelta(fleetsPreOwned, fleets)
 
+	if !apply {
+		for _, fleet := range fleets {
+			current, ok := existing[*fleet.Metadata.Name]
+			switch {
+			case !ok:
+				addPendingChange(rs, api.FleetKind, *fleet.Metadata.Name, api.Create)
+			case fleetNeedsUpdate(current, fleet):
+				addPendingChange(rs, api.FleetKind, *fleet.Metadata.Name, api.Update)
+			}
+		}
+		for _, fleetToRemove := range fleetsToRemove {
+			addPendingChange(rs, api.FleetKind, fleetToRemove, api.Delete)
+		}
+		return nil
+	}
+
 	log.Infof("Resource %s: applying %d fleets ", resourceName, len(fleets))
 	createUpdateErr := r.createOrUpdateMultiple(ctx, fleets...)
 	if errors.Is(createUpdateErr, flterrors.ErrUpdatingResourceWithOwnerNotAllowed) {
 		log.Errorf("one or more fleets are managed by a different resource. %v", createUpdateErr)
 	}
-	if len(fleetsToRemove) > 0 {
+	if len(fleetsToRemove) > 0 && !isPruned(rs) {
+		log.Infof("Resource %s: not removing %d fleets because pruning is disabled", resourceName, len(fleetsToRemove))
+		for _, fleetToRemove := range fleetsToRemove {
+			addPendingChange(rs, api.FleetKind, fleetToRemove, api.Delete)
+		}
+	} else if len(fleetsToRemove) > 0 {
 		log.Infof("Resource %s: found #%d fleets to remove. removing\n", resourceName, len(fleetsToRemove))
 		for _, fleetToRemove := range fleetsToRemove {
 			status := r.serviceHandler.DeleteFleet(ctx, fleetToRemove)
@@ -236,6 +435,342 @@ func (r *ResourceSync) createOrUpdateMultiple(ctx context.Context, resources ...
 	return errors.Join(lo.Uniq(errs)...)
 }
 
+// SyncRepositories syncs the repositories to the service.  Repositories that were synced before but are no longer
+// present are removed, except for the repository the ResourceSync itself syncs from.  If the ResourceSync is a dry
+// run, the changes are added to the pending changes of its status instead.
+func (r *ResourceSync) SyncRepositories(ctx context.Context, log logrus.FieldLogger, rs *api.ResourceSync, repositories []*api.Repository, resourceName string) error {
+	if rs == nil {
+		return fmt.Errorf("ResourceSync is nil")
+	}
+	return r.syncRepositories(ctx, log, rs, repositories, resourceName, !isDryRun(rs))
+}
+
+func (r *ResourceSync) syncRepositories(ctx context.Context, log logrus.FieldLogger, rs *api.ResourceSync, repositories []*api.Repository, resourceName string, apply bool) error {
+
+	owner := util.SetResourceOwner(api.ResourceSyncKind, resourceName)
+
+	// Validate that no repository names conflict with repositories owned by other ResourceSyncs
+	existing, err := r.validateRepositoryNameConflicts(ctx, repositories, *owner)
+	if err != nil {
+		err = fmt.Errorf("resource %s: error: %w", resourceName, err)
+		log.Errorf("%v", err)
+		return err
+	}
+
+	repositoriesPreOwned := make([]api.Repository, 0)
+
+	listParams := api.ListRepositoriesParams{
+		Limit:         lo.ToPtr(int32(100)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", *owner)),
+	}
+	for {
+		listRes, status := r.serviceHandler.ListRepositories(ctx, listParams)
+		if status.Code != http.StatusOK {
+			err = fmt.Errorf("resource %s: failed to list owned repositories. error: %s", resourceName, status.Message)
+			log.Errorf("%v", err)
+			return err
+		}
+		repositoriesPreOwned = append(repositoriesPreOwned, listRes.Items...)
+		if listRes.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = listRes.Metadata.Continue
+	}
+
+	// The repository the ResourceSync syncs from is never removed
+	repositoriesToRemove := lo.Without(repositoriesDelta(repositoriesPreOwned, repositories), rs.Spec.Repository)
+
+	if !apply {
+		for _, repository := range repositories {
+			current, ok := existing[*repository.Metadata.Name]
+			switch {
+			case !ok:
+				addPendingChange(rs, api.RepositoryKind, *repository.Metadata.Name, api.Create)
+			case repositoryNeedsUpdate(current, repository):
+				addPendingChange(rs, api.RepositoryKind, *repository.Metadata.Name, api.Update)
+			}
+		}
+		for _, repositoryToRemove := range repositoriesToRemove {
+			addPendingChange(rs, api.RepositoryKind, repositoryToRemove, api.Delete)
+		}
+		return nil
+	}
+
+	var errs []error
+	if len(repositories) > 0 {
+		log.Infof("Resource %s: applying %d repositories", resourceName, len(repositories))
+	}
+	for _, repository := range repositories {
+		_, status := r.serviceHandler.ReplaceRepository(ctx, *repository.Metadata.Name, *repository)
+		if status.Code != http.StatusOK && status.Code != http.StatusCreated {
+			errs = append(errs, fmt.Errorf("repository %s: %w", *repository.Metadata.Name, service.ApiStatusToErr(status)))
+		}
+	}
+	if len(repositoriesToRemove) > 0 && !isPruned(rs) {
+		log.Infof("Resource %s: not removing %d repositories because pruning is disabled", resourceName, len(repositoriesToRemove))
+		for _, repositoryToRemove := range repositoriesToRemove {
+			addPendingChange(rs, api.RepositoryKind, repositoryToRemove, api.Delete)
+		}
+		repositoriesToRemove = nil
+	}
+	for _, repositoryToRemove := range repositoriesToRemove {
+		log.Infof("Resource %s: removing repository %s", resourceName, repositoryToRemove)
+		status := r.serviceHandler.DeleteRepository(ctx, repositoryToRemove)
+		if status.Code != http.StatusOK {
+			errs = append(errs, fmt.Errorf("failed to remove old repository %s: %w", repositoryToRemove, service.ApiStatusToErr(status)))
+		}
+	}
+	if err = errors.Join(errs...); err != nil {
+		log.Errorf("Resource %s: failed to apply repositories. error: %s", resourceName, err.Error())
+	}
+	return err
+}
+
+// SyncDevices syncs the labels and annotations of existing devices.  The keys that are set are recorded on the
+// device, so that labels and annotations that are removed from the repository are removed from the device as well.
+// Devices must be enrolled before they can be synced; devices that are not enrolled yet are skipped and added to the
+// pending changes with the Create action, so that they are synced once enrolled.  If the ResourceSync is a dry run,
+// the changes are added to the pending changes of its status instead.
+func (r *ResourceSync) SyncDevices(ctx context.Context, log logrus.FieldLogger, rs *api.ResourceSync, devices []*api.Device, resourceName string) error {
+	if rs == nil {
+		return fmt.Errorf("ResourceSync is nil")
+	}
+	return r.syncDevices(ctx, log, rs, devices, resourceName, !isDryRun(rs))
+}
+
+func (r *ResourceSync) syncDevices(ctx context.Context, log logrus.FieldLogger, rs *api.ResourceSync, devices []*api.Device, resourceName string, apply bool) error {
+	var errs []error
+	synced := make(map[string]struct{}, len(devices))
+	for _, device := range devices {
+		name := *device.Metadata.Name
+		synced[name] = struct{}{}
+		changed, err := r.syncDevice(ctx, device, resourceName, apply)
+		switch {
+		case errors.Is(err, errDeviceNotEnrolled):
+			log.Infof("Resource %s: device %s is not enrolled yet", resourceName, name)
+			addPendingChange(rs, api.DeviceKind, name, api.Create)
+		case err != nil:
+			errs = append(errs, fmt.Errorf("device %s: %w", name, err))
+		case changed && !apply:
+			addPendingChange(rs, api.DeviceKind, name, api.Update)
+		}
+	}
+
+	// Release the devices that are no longer part of the resource
+	params := api.ListDevicesParams{Limit: lo.ToPtr(int32(ItemsPerPage))}
+	annotationSelector := selector.NewAnnotationSelectorFromMapOrDie(map[string]string{api.DeviceAnnotationResourceSync: resourceName})
+	for {
+		listRes, status := r.serviceHandler.ListDevices(ctx, params, annotationSelector)
+		if status.Code != http.StatusOK {
+			errs = append(errs, fmt.Errorf("failed to list synced devices: %s", status.Message))
+			break
+		}
+		for i := range listRes.Items {
+			device := &listRes.Items[i]
+			if _, ok := synced[lo.FromPtr(device.Metadata.Name)]; ok {
+				continue
+			}
+			if !apply || !isPruned(rs) {
+				addPendingChange(rs, api.DeviceKind, lo.FromPtr(device.Metadata.Name), api.Delete)
+				continue
+			}
+			if err := r.releaseDevice(ctx, device); err != nil {
+				errs = append(errs, fmt.Errorf("device %s: %w", lo.FromPtr(device.Metadata.Name), err))
+			}
+		}
+		if listRes.Metadata.Continue == nil {
+			break
+		}
+		params.Continue = listRes.Metadata.Continue
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		log.Errorf("Resource %s: failed to sync devices. error: %s", resourceName, err.Error())
+	} else if len(devices) > 0 && apply {
+		log.Infof("Resource %s: %d devices synced successfully", resourceName, len(devices))
+	}
+	return err
+}
+
+// syncDevice sets the labels and annotations of the desired device on the existing device, and removes the ones
+// that were set by a previous sync but are no longer desired.  It returns true if the device had to be changed; the
+// changes are only made if apply is true.
+func (r *ResourceSync) syncDevice(ctx context.Context, desired *api.Device, resourceName string, apply bool) (bool, error) {
+	name := *desired.Metadata.Name
+	current, status := r.serviceHandler.GetDevice(ctx, name)
+	if status.Code == http.StatusNotFound {
+		return false, errDeviceNotEnrolled
+	}
+	if status.Code != http.StatusOK {
+		return false, service.ApiStatusToErr(status)
+	}
+
+	currentAnnotations := lo.FromPtr(current.Metadata.Annotations)
+	if managedBy, ok := currentAnnotations[api.DeviceAnnotationResourceSync]; ok && managedBy != resourceName {
+		return false, fmt.Errorf("labels and annotations are managed by ResourceSync %q", managedBy)
+	}
+	previous := parseDeviceManagedKeys(currentAnnotations[api.DeviceAnnotationResourceSyncManagedKeys])
+
+	desiredLabels := lo.FromPtr(desired.Metadata.Labels)
+	desiredAnnotations := lo.FromPtr(desired.Metadata.Annotations)
+
+	labels := maps.Clone(lo.FromPtr(current.Metadata.Labels))
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for _, key := range previous.Labels {
+		if _, ok := desiredLabels[key]; !ok {
+			delete(labels, key)
+		}
+	}
+	maps.Copy(labels, desiredLabels)
+	labelsChanged := !maps.Equal(labels, lo.FromPtr(current.Metadata.Labels))
+
+	managedKeys, err := json.Marshal(deviceManagedKeys{
+		Labels:      slices.Sorted(maps.Keys(desiredLabels)),
+		Annotations: slices.Sorted(maps.Keys(desiredAnnotations)),
+	})
+	if err != nil {
+		return false, fmt.Errorf("marshalling managed keys: %w", err)
+	}
+	annotations := maps.Clone(desiredAnnotations)
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[api.DeviceAnnotationResourceSync] = resourceName
+	annotations[api.DeviceAnnotationResourceSyncManagedKeys] = string(managedKeys)
+
+	var deleteKeys []string
+	for _, key := range previous.Annotations {
+		if _, ok := desiredAnnotations[key]; !ok {
+			if _, exists := currentAnnotations[key]; exists {
+				deleteKeys = append(deleteKeys, key)
+			}
+		}
+	}
+	annotationsChanged := len(deleteKeys) > 0
+	for key, value := range annotations {
+		if currentValue, ok := currentAnnotations[key]; !ok || currentValue != value {
+			annotationsChanged = true
+			break
+		}
+	}
+	if !apply || (!labelsChanged && !annotationsChanged) {
+		return labelsChanged || annotationsChanged, nil
+	}
+
+	if err := r.replaceDeviceLabels(ctx, current, labels); err != nil {
+		return true, err
+	}
+	if !annotationsChanged {
+		return true, nil
+	}
+	return true, service.ApiStatusToErr(r.serviceHandler.UpdateDeviceAnnotations(ctx, name, annotations, deleteKeys))
+}
+
+// releaseDevice removes the labels and annotations that were set by a ResourceSync from the device
+func (r *ResourceSync) releaseDevice(ctx context.Context, device *api.Device) error {
+	currentAnnotations := lo.FromPtr(device.Metadata.Annotations)
+	previous := parseDeviceManagedKeys(currentAnnotations[api.DeviceAnnotationResourceSyncManagedKeys])
+
+	labels := maps.Clone(lo.FromPtr(device.Metadata.Labels))
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for _, key := range previous.Labels {
+		delete(labels, key)
+	}
+	if err := r.replaceDeviceLabels(ctx, device, labels); err != nil {
+		return err
+	}
+
+	deleteKeys := append([]string{api.DeviceAnnotationResourceSync, api.DeviceAnnotationResourceSyncManagedKeys}, previous.Annotations...)
+	return service.ApiStatusToErr(r.serviceHandler.UpdateDeviceAnnotations(ctx, *device.Metadata.Name, nil, deleteKeys))
+}
+
+// replaceDeviceLabels replaces the labels of the device if they changed
+func (r *ResourceSync) replaceDeviceLabels(ctx context.Context, device *api.Device, labels map[string]string) error {
+	if maps.Equal(labels, lo.FromPtr(device.Metadata.Labels)) {
+		return nil
+	}
+	var value interface{} = labels
+	patch := api.PatchRequest{
+		{Op: api.Add, Path: "/metadata/labels", Value: &value},
+	}
+	_, status := r.serviceHandler.PatchDevice(ctx, *device.Metadata.Name, patch)
+	return service.ApiStatusToErr(status)
+}
+
+func parseDeviceManagedKeys(value string) deviceManagedKeys {
+	var managedKeys deviceManagedKeys
+	if value != "" {
+		// An invalid value means that no keys are known to be managed
+		_ = json.Unmarshal([]byte(value), &managedKeys)
+	}
+	return managedKeys
+}
+
+// Returns a list of names that are no longer present
+func repositoriesDelta(owned []api.Repository, newOwned []*api.Repository) []string {
+	newNames := lo.SliceToMap(newOwned, func(r *api.Repository) (string, struct{}) {
+		return *r.Metadata.Name, struct{}{}
+	})
+	removed := make([]string, 0)
+	for _, ownedRepository := range owned {
+		if _, found := newNames[*ownedRepository.Metadata.Name]; !found {
+			removed = append(removed, *ownedRepository.Metadata.Name)
+		}
+	}
+	return removed
+}
+
+// fleetNeedsUpdate returns true if syncing the desired fleet would change the current fleet.  The specs are compared as
+// JSON, as the stored representation of a spec may differ from the parsed one in field and key order.
+func fleetNeedsUpdate(current *api.Fleet, desired *api.Fleet) bool {
+	return !metadataMatches(current.Metadata, desired.Metadata) || !jsonEqual(current.Spec, desired.Spec)
+}
+
+// repositoryNeedsUpdate returns true if syncing the desired repository would change the current repository
+func repositoryNeedsUpdate(current *api.Repository, desired *api.Repository) bool {
+	return !metadataMatches(current.Metadata, desired.Metadata) || !jsonEqual(current.Spec, desired.Spec)
+}
+
+// metadataMatches returns true if the current metadata has the owner and labels of the desired metadata, as well as
+// its annotations.  Annotations that are set by the service are ignored.
+func metadataMatches(current, desired api.ObjectMeta) bool {
+	if lo.FromPtr(current.Owner) != lo.FromPtr(desired.Owner) {
+		return false
+	}
+	if !maps.Equal(lo.FromPtr(current.Labels), lo.FromPtr(desired.Labels)) {
+		return false
+	}
+	currentAnnotations := lo.FromPtr(current.Annotations)
+	for key, value := range lo.FromPtr(desired.Annotations) {
+		if currentValue, ok := currentAnnotations[key]; !ok || currentValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// jsonEqual returns true if the JSON representations of a and b are semantically equal
+func jsonEqual(a, b interface{}) bool {
+	normalizedA, errA := normalizeJSON(a)
+	normalizedB, errB := normalizeJSON(b)
+	return errA == nil && errB == nil && reflect.DeepEqual(normalizedA, normalizedB)
+}
+
+func normalizeJSON(value interface{}) (interface{}, error) {
+	buf, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	err = json.Unmarshal(buf, &normalized)
+	return normalized, err
+}
+
 // Returns a list of names that are no longer present
 func fleetsDelta(owned []api.Fleet, newOwned []*api.Fleet) []string {
 	dfleets := make([]string, 0)
@@ -266,6 +801,11 @@ func NeedsSyncToHash(rs *api.ResourceSync, hash string) bool {
 		return true
 	}
 
+	// Retry until the devices of the last sync are enrolled
+	if !isDryRun(rs) && len(unenrolledDevices(rs)) > 0 {
+		return true
+	}
+
 	var observedGen int64 = 0
 	if rs.Status.ObservedGeneration != nil {
 		observedGen = *rs.Status.ObservedGeneration
@@ -274,29 +814,31 @@ func NeedsSyncToHash(rs *api.ResourceSync, hash string) bool {
 	return hash != prevHash || observedGen != *rs.Metadata.Generation
 }
 
-func (r *ResourceSync) parseAndValidateResources(rs *api.ResourceSync, repo *api.Repository, gitCloneRepo cloneGitRepoFunc) ([]GenericResourceMap, error) {
+// parseAndValidateResources returns the resources in the path of the ResourceSync, and whether they need to be synced
+// because there is a new commit or the ResourceSync changed.  Resources that don't need to be synced are still
+// returned, so that they can be compared with the resources in the service to detect drift.
+func (r *ResourceSync) parseAndValidateResources(rs *api.ResourceSync, repo *api.Repository, gitCloneRepo cloneGitRepoFunc) ([]GenericResourceMap, bool, error) {
 	path := rs.Spec.Path
 	revision := rs.Spec.TargetRevision
 	mfs, hash, err := gitCloneRepo(repo, &revision, lo.ToPtr(1))
 	api.SetStatusConditionByError(&rs.Status.Conditions, api.ConditionTypeResourceSyncAccessible, "accessible", "failed to clone repository", err)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	if !NeedsSyncToHash(rs, hash) {
-		// nothing to update
-		r.log.Infof("resourcesync/%s: No new commits or path. skipping", *rs.Metadata.Name)
-		return nil, nil
+	needsSync := NeedsSyncToHash(rs, hash)
+	if needsSync {
+		api.SetStatusConditionByError(&rs.Status.Conditions, api.ConditionTypeResourceSyncSynced, "success", "fail", fmt.Errorf("out of sync"))
+		rs.Status.ObservedCommit = lo.ToPtr(hash)
+	} else {
+		r.log.Debugf("resourcesync/%s: No new commits or path. checking for drift", *rs.Metadata.Name)
 	}
-	api.SetStatusConditionByError(&rs.Status.Conditions, api.ConditionTypeResourceSyncSynced, "success", "fail", fmt.Errorf("out of sync"))
-
-	rs.Status.ObservedCommit = lo.ToPtr(hash)
 
 	// Open files
 	fileInfo, err := mfs.Stat(path)
 	api.SetStatusConditionByError(&rs.Status.Conditions, api.ConditionTypeResourceSyncAccessible, "accessible", "path not found in repository", err)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	var resources []GenericResourceMap
 	if fileInfo.IsDir() {
@@ -306,13 +848,18 @@ func (r *ResourceSync) parseAndValidateResources(rs *api.ResourceSync, repo *api
 	}
 	api.SetStatusConditionByError(&rs.Status.Conditions, api.ConditionTypeResourceSyncResourceParsed, "success", "fail", err)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 
 	}
-	return resources, nil
+	return resources, needsSync, nil
 }
 
+// extractResourcesFromDir returns the resources of the files in the directory, or of the overlay if the directory
+// has a kustomization file
 func (r *ResourceSync) extractResourcesFromDir(mfs billy.Filesystem, path string) ([]GenericResourceMap, error) {
+	if hasKustomization(mfs, path) {
+		return r.buildOverlay(mfs, path, map[string]struct{}{})
+	}
 	genericResources := []GenericResourceMap{}
 	files, err := mfs.ReadDir(path)
 	if err != nil {
@@ -333,20 +880,12 @@ func (r *ResourceSync) extractResourcesFromDir(mfs billy.Filesystem, path string
 func (r *ResourceSync) extractResourcesFromFile(mfs billy.Filesystem, path string) ([]GenericResourceMap, error) {
 	genericResources := []GenericResourceMap{}
 
-	file, err := mfs.Open(path)
+	resources, err := decodeResourcesFromFile(mfs, path)
 	if err != nil {
-		// Failed to open file....
 		return nil, err
 	}
-	defer file.Close()
-	decoder := yamlutil.NewYAMLOrJSONDecoder(file, 100)
 
-	for {
-		var resource GenericResourceMap
-		err = decoder.Decode(&resource)
-		if err != nil {
-			break
-		}
+	for _, resource := range resources {
 		kind, kindok := resource["kind"].(string)
 		meta, metaok := resource["metadata"].(map[string]interface{})
 		if !kindok || !metaok {
@@ -370,11 +909,7 @@ func (r *ResourceSync) extractResourcesFromFile(mfs billy.Filesystem, path strin
 		resource = RemoveIgnoredFields(resource, r.ignoreResourceUpdates)
 		genericResources = append(genericResources, resource)
 	}
-	if !errors.Is(err, io.EOF) {
-		return nil, err
-	}
 	return genericResources, nil
-
 }
 
 func RemoveIgnoredFields(resource GenericResourceMap, ignorePaths []string) GenericResourceMap {
@@ -406,9 +941,13 @@ func removeFieldFromMap(m map[string]interface{}, parts []string) {
 	}
 }
 
-func (r ResourceSync) parseFleets(resources []GenericResourceMap, owner *string) ([]*api.Fleet, error) {
-	fleets := make([]*api.Fleet, 0)
-	names := make(map[string]string)
+func (r ResourceSync) parseResources(resources []GenericResourceMap, owner *string) (*SyncedResources, error) {
+	parsed := &SyncedResources{
+		Fleets:       make([]*api.Fleet, 0),
+		Repositories: make([]*api.Repository, 0),
+		Devices:      make([]*api.Device, 0),
+	}
+	names := make(map[string]struct{})
 	for _, resource := range resources {
 		kind, ok := resource["kind"].(string)
 		if !ok {
@@ -432,19 +971,90 @@ func (r ResourceSync) parseFleets(resources []GenericResourceMap, owner *string)
 			if errs := fleet.Validate(); len(errs) > 0 {
 				return nil, fmt.Errorf("failed validating fleet: %w", errors.Join(errs...))
 			}
-			name, nameExists := names[*fleet.Metadata.Name]
-			if nameExists {
-				return nil, fmt.Errorf("found multiple fleet definitions with name '%s'", name)
+			if err := checkDuplicateResource(names, kind, *fleet.Metadata.Name); err != nil {
+				return nil, err
 			}
-			names[name] = name
 			fleet.Metadata.Owner = owner
-			fleets = append(fleets, &fleet)
+			parsed.Fleets = append(parsed.Fleets, &fleet)
+		case api.RepositoryKind:
+			var repository api.Repository
+			err := yamlutil.Unmarshal(buf, &repository)
+			if err != nil {
+				return nil, fmt.Errorf("decoding Repository resource: %w", err)
+			}
+			if repository.Metadata.Name == nil {
+				return nil, fmt.Errorf("decoding Repository resource: missing field .metadata.name")
+			}
+			if errs := repository.Validate(); len(errs) > 0 {
+				return nil, fmt.Errorf("failed validating repository: %w", errors.Join(errs...))
+			}
+			if err := checkDuplicateResource(names, kind, *repository.Metadata.Name); err != nil {
+				return nil, err
+			}
+			repository.Metadata.Owner = owner
+			repository.Status = nil
+			parsed.Repositories = append(parsed.Repositories, &repository)
+		case api.DeviceKind:
+			device, err := parseSyncedDevice(resource, buf)
+			if err != nil {
+				return nil, err
+			}
+			if err := checkDuplicateResource(names, kind, *device.Metadata.Name); err != nil {
+				return nil, err
+			}
+			parsed.Devices = append(parsed.Devices, device)
 		default:
 			return nil, fmt.Errorf("resource of unknown/unsupported kind %q: %v", kind, resource)
 		}
 	}
 
-	return fleets, nil
+	return parsed, nil
+}
+
+// parseSyncedDevice parses a device of which only the labels and annotations are synced
+func parseSyncedDevice(resource GenericResourceMap, buf []byte) (*api.Device, error) {
+	var device api.Device
+	if err := yamlutil.Unmarshal(buf, &device); err != nil {
+		return nil, fmt.Errorf("decoding Device resource: %w", err)
+	}
+	if device.Metadata.Name == nil {
+		return nil, fmt.Errorf("decoding Device resource: missing field .metadata.name")
+	}
+	if _, hasSpec := resource["spec"]; hasSpec {
+		return nil, fmt.Errorf("device %s: only metadata.labels and metadata.annotations of devices can be synced", *device.Metadata.Name)
+	}
+
+	var errs []error
+	errs = append(errs, validation.ValidateResourceName(device.Metadata.Name)...)
+	errs = append(errs, validation.ValidateLabels(device.Metadata.Labels)...)
+	errs = append(errs, validation.ValidateAnnotations(device.Metadata.Annotations)...)
+	for key := range lo.FromPtr(device.Metadata.Annotations) {
+		for _, prefix := range reservedDeviceAnnotationPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				errs = append(errs, fmt.Errorf("annotation %q is managed by the service", key))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("failed validating device: %w", errors.Join(errs...))
+	}
+
+	return &api.Device{
+		Metadata: api.ObjectMeta{
+			Name:        device.Metadata.Name,
+			Labels:      device.Metadata.Labels,
+			Annotations: device.Metadata.Annotations,
+		},
+	}, nil
+}
+
+func checkDuplicateResource(names map[string]struct{}, kind, name string) error {
+	key := kind + "/" + name
+	if _, exists := names[key]; exists {
+		return fmt.Errorf("found multiple %s definitions with name '%s'", strings.ToLower(kind), name)
+	}
+	names[key] = struct{}{}
+	return nil
 }
 
 func (r *ResourceSync) updateResourceSyncStatus(ctx context.Context, rs *api.ResourceSync) {
@@ -468,8 +1078,11 @@ func isValidFile(filename string) bool {
 	return false
 }
 
-func (r *ResourceSync) validateFleetNameConflicts(ctx context.Context, fleets []*api.Fleet, owner string) error {
+// validateFleetNameConflicts returns the existing fleets with the names of the fleets, or an error if any of them is
+// owned by a different ResourceSync
+func (r *ResourceSync) validateFleetNameConflicts(ctx context.Context, fleets []*api.Fleet, owner string) (map[string]*api.Fleet, error) {
 	var conflictingFleets []string
+	existing := make(map[string]*api.Fleet)
 
 	for _, fleet := range fleets {
 		fleetName := *fleet.Metadata.Name
@@ -480,15 +1093,43 @@ func (r *ResourceSync) validateFleetNameConflicts(ctx context.Context, fleets []
 			if existingFleet.Metadata.Owner != nil && *existingFleet.Metadata.Owner != owner {
 				conflictingFleets = append(conflictingFleets, fleetName)
 			}
+			existing[fleetName] = existingFleet
 		} else if status.Code != http.StatusNotFound {
-			return fmt.Errorf("failed to check existing fleet '%s': %s", fleetName, status.Message)
+			return nil, fmt.Errorf("failed to check existing fleet '%s': %s", fleetName, status.Message)
 		}
 		// If status is 404 (not found), no conflict - fleet can be created
 	}
 
 	if len(conflictingFleets) > 0 {
-		return fmt.Errorf("fleet name(s) %v conflict with existing fleets managed by different ResourceSyncs", conflictingFleets)
+		return nil, fmt.Errorf("fleet name(s) %v conflict with existing fleets managed by different ResourceSyncs", conflictingFleets)
 	}
 
-	return nil
+	return existing, nil
+}
+
+// validateRepositoryNameConflicts returns the existing repositories with the names of the repositories, or an error if
+// any of them is owned by a different ResourceSync
+func (r *ResourceSync) validateRepositoryNameConflicts(ctx context.Context, repositories []*api.Repository, owner string) (map[string]*api.Repository, error) {
+	var conflictingRepositories []string
+	existing := make(map[string]*api.Repository)
+
+	for _, repository := range repositories {
+		repositoryName := *repository.Metadata.Name
+		existingRepository, status := r.serviceHandler.GetRepository(ctx, repositoryName)
+		if status.Code == http.StatusOK {
+			// Repository exists - check if it's owned by a different ResourceSync
+			if existingRepository.Metadata.Owner != nil && *existingRepository.Metadata.Owner != owner {
+				conflictingRepositories = append(conflictingRepositories, repositoryName)
+			}
+			existing[repositoryName] = existingRepository
+		} else if status.Code != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to check existing repository '%s': %s", repositoryName, status.Message)
+		}
+	}
+
+	if len(conflictingRepositories) > 0 {
+		return nil, fmt.Errorf("repository name(s) %v conflict with existing repositories managed by different ResourceSyncs", conflictingRepositories)
+	}
+
+	return existing, nil
 }
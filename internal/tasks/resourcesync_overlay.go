@@ -0,0 +1,281 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/go-git/go-billy/v5"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/yaml"
+)
+
+// kustomizationFileNames are the names of the file that turns a directory into an overlay
+var kustomizationFileNames = []string{"kustomization.yaml", "kustomization.yml", "kustomization.json"}
+
+// Kustomization describes how the resources of an overlay directory are built.  It supports a subset of the Kustomize
+// format: the resources are read from files and directories, which may be overlays themselves, and then the
+// strategic merge patches and the name prefix and suffix of the overlay are applied to them.
+type Kustomization struct {
+	Resources []string             `json:"resources,omitempty"`
+	Patches   []KustomizationPatch `json:"patches,omitempty"`
+	// NamePrefix and NameSuffix are added to the names of fleets, so that overlays can produce fleets per environment
+	NamePrefix string `json:"namePrefix,omitempty"`
+	NameSuffix string `json:"nameSuffix,omitempty"`
+}
+
+// KustomizationPatch is a strategic merge patch that is read from a file or given inline.  Unless a target is given,
+// the patch applies to the resource with the kind and metadata.name of the patch.
+type KustomizationPatch struct {
+	Path   string                    `json:"path,omitempty"`
+	Patch  string                    `json:"patch,omitempty"`
+	Target *KustomizationPatchTarget `json:"target,omitempty"`
+}
+
+// KustomizationPatchTarget selects the resources a patch applies to.  Empty fields match any resource.
+type KustomizationPatchTarget struct {
+	Kind string `json:"kind,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// findKustomization returns the path of the kustomization file of the directory, if it has one
+func findKustomization(mfs billy.Filesystem, dir string) (string, bool) {
+	for _, name := range kustomizationFileNames {
+		path := mfs.Join(dir, name)
+		if info, err := mfs.Stat(path); err == nil && !info.IsDir() {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+func readKustomization(mfs billy.Filesystem, path string) (*Kustomization, error) {
+	file, err := mfs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	buf, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	var kustomization Kustomization
+	if err := yaml.UnmarshalStrict(buf, &kustomization); err != nil {
+		return nil, fmt.Errorf("invalid kustomization at '%s': %w", path, err)
+	}
+	return &kustomization, nil
+}
+
+// buildOverlay returns the resources of the overlay directory.  visited holds the overlays that are being built, to
+// detect cycles between overlays.
+func (r *ResourceSync) buildOverlay(mfs billy.Filesystem, dir string, visited map[string]struct{}) ([]GenericResourceMap, error) {
+	if _, ok := visited[dir]; ok {
+		return nil, fmt.Errorf("overlay '%s' includes itself", dir)
+	}
+	visited[dir] = struct{}{}
+	defer delete(visited, dir)
+
+	kustomizationPath, _ := findKustomization(mfs, dir)
+	kustomization, err := readKustomization(mfs, kustomizationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []GenericResourceMap{}
+	for _, entry := range kustomization.Resources {
+		if strings.Contains(entry, "://") {
+			return nil, fmt.Errorf("invalid resource '%s' in '%s': remote resources are not supported", entry, kustomizationPath)
+		}
+		path := mfs.Join(dir, entry)
+		info, err := mfs.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resource '%s' in '%s': %w", entry, kustomizationPath, err)
+		}
+		var entryResources []GenericResourceMap
+		switch {
+		case !info.IsDir():
+			entryResources, err = r.extractResourcesFromFile(mfs, path)
+		case hasKustomization(mfs, path):
+			entryResources, err = r.buildOverlay(mfs, path, visited)
+		default:
+			entryResources, err = r.extractResourcesFromDir(mfs, path)
+		}
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, entryResources...)
+	}
+
+	for i, patch := range kustomization.Patches {
+		var patchDocs []GenericResourceMap
+		switch {
+		case patch.Path != "" && patch.Patch != "":
+			return nil, fmt.Errorf("invalid patch #%d in '%s': only one of path and patch can be set", i+1, kustomizationPath)
+		case patch.Path != "":
+			patchDocs, err = decodeResourcesFromFile(mfs, mfs.Join(dir, patch.Path))
+		default:
+			patchDocs, err = decodeResources(strings.NewReader(patch.Patch))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid patch #%d in '%s': %w", i+1, kustomizationPath, err)
+		}
+		for _, patchDoc := range patchDocs {
+			if err := applyOverlayPatch(resources, patchDoc, patch.Target); err != nil {
+				return nil, fmt.Errorf("invalid patch #%d in '%s': %w", i+1, kustomizationPath, err)
+			}
+		}
+	}
+
+	for _, resource := range resources {
+		RemoveIgnoredFields(resource, r.ignoreResourceUpdates)
+		if kind, _ := resource["kind"].(string); kind != api.FleetKind {
+			continue
+		}
+		if meta, ok := resource["metadata"].(map[string]interface{}); ok {
+			if name, ok := meta["name"].(string); ok {
+				meta["name"] = kustomization.NamePrefix + name + kustomization.NameSuffix
+			}
+		}
+	}
+	return resources, nil
+}
+
+func hasKustomization(mfs billy.Filesystem, dir string) bool {
+	_, ok := findKustomization(mfs, dir)
+	return ok
+}
+
+// applyOverlayPatch merges the patch into the resources it applies to
+func applyOverlayPatch(resources []GenericResourceMap, patch GenericResourceMap, target *KustomizationPatchTarget) error {
+	var kind, name string
+	if target != nil {
+		kind, name = target.Kind, target.Name
+	} else {
+		kind, _ = patch["kind"].(string)
+		if meta, ok := patch["metadata"].(map[string]interface{}); ok {
+			name, _ = meta["name"].(string)
+		}
+		if kind == "" || name == "" {
+			return errors.New("patch without target must specify kind and metadata.name")
+		}
+	}
+
+	matched := false
+	for i, resource := range resources {
+		resourceKind, _ := resource["kind"].(string)
+		var resourceName string
+		if meta, ok := resource["metadata"].(map[string]interface{}); ok {
+			resourceName, _ = meta["name"].(string)
+		}
+		if (kind != "" && kind != resourceKind) || (name != "" && name != resourceName) {
+			continue
+		}
+		resources[i] = strategicMerge(resource, patch)
+		matched = true
+	}
+	if !matched {
+		return fmt.Errorf("no resource matches kind %q and name %q", kind, name)
+	}
+	return nil
+}
+
+// strategicMerge returns the result of merging the patch into the original.  Maps are merged recursively, and a null
+// value removes the key.  Lists of maps that all have a name, such as applications or config providers, are merged by
+// name; an element with "$patch: delete" removes the element of that name.  Other lists are replaced.
+func strategicMerge(original, patch map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(original))
+	for key, value := range original {
+		merged[key] = value
+	}
+	for key, patchValue := range patch {
+		if patchValue == nil {
+			delete(merged, key)
+			continue
+		}
+		switch patchTyped := patchValue.(type) {
+		case map[string]interface{}:
+			if originalMap, ok := merged[key].(map[string]interface{}); ok {
+				merged[key] = strategicMerge(originalMap, patchTyped)
+			} else {
+				merged[key] = strategicMerge(map[string]interface{}{}, patchTyped)
+			}
+		case []interface{}:
+			if originalList, ok := merged[key].([]interface{}); ok && isNamedList(originalList) && isNamedList(patchTyped) {
+				merged[key] = mergeNamedLists(originalList, patchTyped)
+			} else {
+				merged[key] = patchTyped
+			}
+		default:
+			merged[key] = patchValue
+		}
+	}
+	return merged
+}
+
+// isNamedList returns true if all elements of the list are maps with a name
+func isNamedList(list []interface{}) bool {
+	for _, element := range list {
+		m, ok := element.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		if _, ok := m["name"].(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func mergeNamedLists(original, patch []interface{}) []interface{} {
+	merged := make([]interface{}, 0, len(original)+len(patch))
+	merged = append(merged, original...)
+	for _, patchElement := range patch {
+		patchMap := patchElement.(map[string]interface{})
+		index := -1
+		for i, element := range merged {
+			if element.(map[string]interface{})["name"] == patchMap["name"] {
+				index = i
+				break
+			}
+		}
+		deleteElement := patchMap["$patch"] == "delete"
+		switch {
+		case deleteElement && index >= 0:
+			merged = append(merged[:index], merged[index+1:]...)
+		case deleteElement:
+		case index >= 0:
+			merged[index] = strategicMerge(merged[index].(map[string]interface{}), patchMap)
+		default:
+			merged = append(merged, patchMap)
+		}
+	}
+	return merged
+}
+
+func decodeResourcesFromFile(mfs billy.Filesystem, path string) ([]GenericResourceMap, error) {
+	file, err := mfs.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return decodeResources(file)
+}
+
+// decodeResources decodes all YAML or JSON documents of the reader
+func decodeResources(reader io.Reader) ([]GenericResourceMap, error) {
+	resources := []GenericResourceMap{}
+	decoder := yamlutil.NewYAMLOrJSONDecoder(reader, 100)
+	for {
+		var resource GenericResourceMap
+		err := decoder.Decode(&resource)
+		if errors.Is(err, io.EOF) {
+			return resources, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, resource)
+	}
+}
@@ -6,8 +6,13 @@ import (
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
 	"github.com/flightctl/flightctl/internal/service"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
 )
 
 func TestResourceSync_GetRepositoryAndValidateAccess_NilResourceSync(t *testing.T) {
@@ -412,3 +417,411 @@ func TestIsValidFile(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceSync_ParseResources_RepositoriesAndDevices(t *testing.T) {
+	resourceSync := NewResourceSync(nil, logrus.New(), nil)
+
+	resources := []GenericResourceMap{
+		{
+			"kind":     api.RepositoryKind,
+			"metadata": map[string]interface{}{"name": "test-repo"},
+			"spec":     map[string]interface{}{"type": "git", "url": "https://github.com/flightctl/flightctl.git"},
+		},
+		{
+			"kind": api.DeviceKind,
+			"metadata": map[string]interface{}{
+				"name":        "test-device",
+				"labels":      map[string]interface{}{"site": "factory-1"},
+				"annotations": map[string]interface{}{"example.com/rack": "r12"},
+			},
+		},
+	}
+
+	parsed, err := resourceSync.ParseResources(resources, "test-resourcesync")
+	require.NoError(t, err)
+	require.Len(t, parsed.Repositories, 1)
+	assert.Equal(t, "ResourceSync/test-resourcesync", lo.FromPtr(parsed.Repositories[0].Metadata.Owner))
+	require.Len(t, parsed.Devices, 1)
+	assert.Equal(t, map[string]string{"site": "factory-1"}, lo.FromPtr(parsed.Devices[0].Metadata.Labels))
+	assert.Nil(t, parsed.Devices[0].Metadata.Owner)
+}
+
+func TestResourceSync_ParseResources_InvalidDevices(t *testing.T) {
+	resourceSync := NewResourceSync(nil, logrus.New(), nil)
+
+	tests := []struct {
+		name          string
+		metadata      map[string]interface{}
+		spec          map[string]interface{}
+		expectedError string
+	}{
+		{
+			name:          "spec is not synced",
+			metadata:      map[string]interface{}{"name": "test-device"},
+			spec:          map[string]interface{}{"os": map[string]interface{}{"image": "quay.io/test/os:latest"}},
+			expectedError: "only metadata.labels and metadata.annotations of devices can be synced",
+		},
+		{
+			name:          "reserved annotation",
+			metadata:      map[string]interface{}{"name": "test-device", "annotations": map[string]interface{}{api.DeviceAnnotationRenderedVersion: "1"}},
+			expectedError: "is managed by the service",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := GenericResourceMap{"kind": api.DeviceKind, "metadata": tt.metadata}
+			if tt.spec != nil {
+				resource["spec"] = tt.spec
+			}
+			_, err := resourceSync.ParseResources([]GenericResourceMap{resource}, "test-resourcesync")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
+
+func TestResourceSync_ParseResources_DuplicateNames(t *testing.T) {
+	resourceSync := NewResourceSync(nil, logrus.New(), nil)
+
+	device := GenericResourceMap{"kind": api.DeviceKind, "metadata": map[string]interface{}{"name": "test-device"}}
+	_, err := resourceSync.ParseResources([]GenericResourceMap{device, device}, "test-resourcesync")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "found multiple device definitions with name 'test-device'")
+}
+
+func writeTestFile(t *testing.T, mfs billy.Filesystem, path, content string) {
+	f, err := mfs.Create(path)
+	require.NoError(t, err)
+	_, err = f.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+}
+
+func TestResourceSync_Overlay(t *testing.T) {
+	mfs := memfs.New()
+	writeTestFile(t, mfs, "/base/fleet.yaml", `apiVersion: flightctl.io/v1alpha1
+kind: Fleet
+metadata:
+  name: edge
+spec:
+  selector:
+    matchLabels:
+      fleet: edge
+  template:
+    spec:
+      os:
+        image: quay.io/test/os:base
+      applications:
+        - name: app1
+          image: quay.io/test/app1:v1
+        - name: app2
+          image: quay.io/test/app2:v1
+`)
+	writeTestFile(t, mfs, "/overlays/prod/kustomization.yaml", `resources:
+  - ../../base
+patches:
+  - path: fleet-patch.yaml
+  - target:
+      kind: Fleet
+    patch: |
+      metadata:
+        labels:
+          env: prod
+namePrefix: prod-
+`)
+	writeTestFile(t, mfs, "/overlays/prod/fleet-patch.yaml", `kind: Fleet
+metadata:
+  name: edge
+spec:
+  template:
+    spec:
+      os:
+        image: quay.io/test/os:prod
+      applications:
+        - name: app1
+          image: quay.io/test/app1:v2
+        - name: app2
+          $patch: delete
+`)
+	// Files that are not referenced by the kustomization are not part of the overlay
+	writeTestFile(t, mfs, "/overlays/prod/unrelated.yaml", `kind: Fleet
+metadata:
+  name: unrelated
+`)
+
+	resourceSync := NewResourceSync(nil, logrus.New(), nil)
+	resources, err := resourceSync.extractResourcesFromDir(mfs, "/overlays/prod")
+	require.NoError(t, err)
+
+	fleets, err := resourceSync.ParseFleetsFromResources(resources, "test-resourcesync")
+	require.NoError(t, err)
+	require.Len(t, fleets, 1)
+	fleet := fleets[0]
+	assert.Equal(t, "prod-edge", lo.FromPtr(fleet.Metadata.Name))
+	assert.Equal(t, map[string]string{"env": "prod"}, lo.FromPtr(fleet.Metadata.Labels))
+	assert.Equal(t, "quay.io/test/os:prod", fleet.Spec.Template.Spec.Os.Image)
+	apps := lo.FromPtr(fleet.Spec.Template.Spec.Applications)
+	require.Len(t, apps, 1)
+	assert.Equal(t, "app1", lo.FromPtr(apps[0].Name))
+	image, err := apps[0].AsImageApplicationProviderSpec()
+	require.NoError(t, err)
+	assert.Equal(t, "quay.io/test/app1:v2", image.Image)
+}
+
+func TestResourceSync_OverlayErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		kustomization string
+		expectedError string
+	}{
+		{
+			name:          "cycle",
+			kustomization: "resources:\n  - .\n",
+			expectedError: "includes itself",
+		},
+		{
+			name:          "remote resource",
+			kustomization: "resources:\n  - https://github.com/flightctl/flightctl\n",
+			expectedError: "remote resources are not supported",
+		},
+		{
+			name:          "unsupported field",
+			kustomization: "commonLabels:\n  env: prod\n",
+			expectedError: "invalid kustomization",
+		},
+		{
+			name:          "unmatched patch",
+			kustomization: "patches:\n  - patch: |\n      kind: Fleet\n      metadata:\n        name: missing\n",
+			expectedError: "no resource matches",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mfs := memfs.New()
+			writeTestFile(t, mfs, "/overlay/kustomization.yaml", tt.kustomization)
+			resourceSync := NewResourceSync(nil, logrus.New(), nil)
+			_, err := resourceSync.extractResourcesFromDir(mfs, "/overlay")
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedError)
+		})
+	}
+}
+
+func TestResourceSync_SyncDevices(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+	resourceSync := NewResourceSync(mockService, logrus.New(), nil)
+
+	current := &api.Device{
+		Metadata: api.ObjectMeta{
+			Name:   lo.ToPtr("test-device"),
+			Labels: &map[string]string{"site": "factory-1", "removed": "yes", "local": "kept"},
+			Annotations: &map[string]string{
+				api.DeviceAnnotationResourceSync:            "test-resourcesync",
+				api.DeviceAnnotationResourceSyncManagedKeys: `{"labels":["removed","site"],"annotations":["example.com/old"]}`,
+				"example.com/old":                           "value",
+			},
+		},
+	}
+	desired := &api.Device{
+		Metadata: api.ObjectMeta{
+			Name:        lo.ToPtr("test-device"),
+			Labels:      &map[string]string{"site": "factory-2"},
+			Annotations: &map[string]string{"example.com/rack": "r12"},
+		},
+	}
+
+	mockService.EXPECT().GetDevice(gomock.Any(), "test-device").Return(current, api.StatusOK())
+	mockService.EXPECT().PatchDevice(gomock.Any(), "test-device", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, name string, patch api.PatchRequest) (*api.Device, api.Status) {
+			require.Len(t, patch, 1)
+			assert.Equal(t, map[string]string{"site": "factory-2", "local": "kept"}, *patch[0].Value)
+			return current, api.StatusOK()
+		})
+	mockService.EXPECT().UpdateDeviceAnnotations(gomock.Any(), "test-device", gomock.Any(), []string{"example.com/old"}).DoAndReturn(
+		func(ctx context.Context, name string, annotations map[string]string, deleteKeys []string) api.Status {
+			assert.Equal(t, "r12", annotations["example.com/rack"])
+			assert.Equal(t, `{"labels":["site"],"annotations":["example.com/rack"]}`, annotations[api.DeviceAnnotationResourceSyncManagedKeys])
+			return api.StatusOK()
+		})
+	mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any()).Return(&api.DeviceList{Items: []api.Device{*current}}, api.StatusOK())
+
+	err := resourceSync.SyncDevices(context.Background(), logrus.New(), newTestResourceSync(), []*api.Device{desired}, "test-resourcesync")
+	require.NoError(t, err)
+}
+
+func TestResourceSync_SyncDevices_ManagedByOtherResourceSync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+	resourceSync := NewResourceSync(mockService, logrus.New(), nil)
+
+	current := &api.Device{
+		Metadata: api.ObjectMeta{
+			Name:        lo.ToPtr("test-device"),
+			Annotations: &map[string]string{api.DeviceAnnotationResourceSync: "other-resourcesync"},
+		},
+	}
+	mockService.EXPECT().GetDevice(gomock.Any(), "test-device").Return(current, api.StatusOK())
+	mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any()).Return(&api.DeviceList{}, api.StatusOK())
+
+	err := resourceSync.SyncDevices(context.Background(), logrus.New(), newTestResourceSync(), []*api.Device{{Metadata: api.ObjectMeta{Name: lo.ToPtr("test-device")}}}, "test-resourcesync")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `managed by ResourceSync "other-resourcesync"`)
+}
+
+func TestResourceSync_SyncDevices_NotEnrolled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+	resourceSync := NewResourceSync(mockService, logrus.New(), nil)
+
+	mockService.EXPECT().GetDevice(gomock.Any(), "test-device").Return(nil, api.StatusResourceNotFound(api.DeviceKind, "test-device"))
+	mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any()).Return(&api.DeviceList{}, api.StatusOK())
+
+	rs := newTestResourceSync()
+	rs.Status.ObservedCommit = lo.ToPtr("abc123")
+	rs.Status.ObservedGeneration = rs.Metadata.Generation
+	api.SetStatusCondition(&rs.Status.Conditions, api.Condition{Type: api.ConditionTypeResourceSyncSynced, Status: api.ConditionStatusTrue, Reason: "success"})
+	err := resourceSync.SyncDevices(context.Background(), logrus.New(), rs, []*api.Device{{Metadata: api.ObjectMeta{Name: lo.ToPtr("test-device")}}}, "test-resourcesync")
+	require.NoError(t, err)
+	assert.Equal(t, []api.ResourceSyncChange{{Kind: api.DeviceKind, Name: "test-device", Action: api.Create}}, *rs.Status.PendingChanges)
+	assert.True(t, NeedsSyncToHash(rs, "abc123"), "devices that are not enrolled must be retried")
+
+	rs.Status.PendingChanges = nil
+	assert.False(t, NeedsSyncToHash(rs, "abc123"))
+}
+
+func newTestResourceSync() *api.ResourceSync {
+	return &api.ResourceSync{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr("test-resourcesync"), Generation: lo.ToPtr(int64(1))},
+		Spec:     api.ResourceSyncSpec{Repository: "test-repo", TargetRevision: "main", Path: "/fleets"},
+		Status:   &api.ResourceSyncStatus{Conditions: []api.Condition{}},
+	}
+}
+
+func newTestSyncedFleet(name, image string, owner *string) *api.Fleet {
+	fleet := &api.Fleet{
+		Metadata: api.ObjectMeta{Name: lo.ToPtr(name), Owner: owner},
+	}
+	fleet.Spec.Template.Spec.Os = &api.DeviceOsSpec{Image: image}
+	return fleet
+}
+
+// expectOwnedFleets sets up the mock calls that list the fleets owned by the ResourceSync and check for conflicts
+func expectOwnedFleets(mockService *service.MockService, owned []*api.Fleet, desired []*api.Fleet) {
+	ownedByName := lo.SliceToMap(owned, func(f *api.Fleet) (string, *api.Fleet) { return *f.Metadata.Name, f })
+	for _, fleet := range desired {
+		name := *fleet.Metadata.Name
+		if current, ok := ownedByName[name]; ok {
+			mockService.EXPECT().GetFleet(gomock.Any(), name, gomock.Any()).Return(current, api.StatusOK())
+		} else {
+			mockService.EXPECT().GetFleet(gomock.Any(), name, gomock.Any()).Return(nil, api.StatusResourceNotFound(api.FleetKind, name))
+		}
+	}
+	items := lo.Map(owned, func(f *api.Fleet, _ int) api.Fleet { return *f })
+	mockService.EXPECT().ListFleets(gomock.Any(), gomock.Any()).Return(&api.FleetList{Items: items}, api.StatusOK())
+}
+
+func TestResourceSync_SyncFleets_DryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+	resourceSync := NewResourceSync(mockService, logrus.New(), nil)
+
+	rs := newTestResourceSync()
+	rs.Spec.DryRun = lo.ToPtr(true)
+	owner := lo.ToPtr("ResourceSync/test-resourcesync")
+	owned := []*api.Fleet{
+		newTestSyncedFleet("unchanged", "quay.io/os:v1", owner),
+		newTestSyncedFleet("updated", "quay.io/os:v1", owner),
+		newTestSyncedFleet("removed", "quay.io/os:v1", owner),
+	}
+	desired := []*api.Fleet{
+		newTestSyncedFleet("unchanged", "quay.io/os:v1", owner),
+		newTestSyncedFleet("updated", "quay.io/os:v2", owner),
+		newTestSyncedFleet("created", "quay.io/os:v1", owner),
+	}
+	// No fleet is replaced or deleted in a dry run
+	expectOwnedFleets(mockService, owned, desired)
+
+	err := resourceSync.SyncFleets(context.Background(), logrus.New(), rs, desired, "test-resourcesync")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []api.ResourceSyncChange{
+		{Kind: api.FleetKind, Name: "updated", Action: api.Update},
+		{Kind: api.FleetKind, Name: "created", Action: api.Create},
+		{Kind: api.FleetKind, Name: "removed", Action: api.Delete},
+	}, lo.FromPtr(rs.Status.PendingChanges))
+	assert.Nil(t, api.FindStatusCondition(rs.Status.Conditions, api.ConditionTypeResourceSyncSynced))
+}
+
+func TestResourceSync_SyncFleets_PruneDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockService := service.NewMockService(ctrl)
+	resourceSync := NewResourceSync(mockService, logrus.New(), nil)
+
+	rs := newTestResourceSync()
+	rs.Spec.Prune = lo.ToPtr(false)
+	owner := lo.ToPtr("ResourceSync/test-resourcesync")
+	owned := []*api.Fleet{newTestSyncedFleet("kept", "quay.io/os:v1", owner)}
+	desired := []*api.Fleet{newTestSyncedFleet("created", "quay.io/os:v1", owner)}
+	expectOwnedFleets(mockService, owned, desired)
+	mockService.EXPECT().ReplaceFleet(gomock.Any(), "created", gomock.Any()).Return(desired[0], api.StatusCreated())
+
+	err := resourceSync.SyncFleets(context.Background(), logrus.New(), rs, desired, "test-resourcesync")
+	require.NoError(t, err)
+	assert.Equal(t, []api.ResourceSyncChange{{Kind: api.FleetKind, Name: "kept", Action: api.Delete}}, lo.FromPtr(rs.Status.PendingChanges))
+	assert.True(t, api.IsStatusConditionTrue(rs.Status.Conditions, api.ConditionTypeResourceSyncSynced))
+}
+
+func TestResourceSync_DetectDrift(t *testing.T) {
+	owner := lo.ToPtr("ResourceSync/test-resourcesync")
+	tests := []struct {
+		name            string
+		prune           bool
+		owned           []*api.Fleet
+		expectedDrifted []api.ObjectReference
+	}{
+		{
+			name:  "no drift",
+			prune: true,
+			owned: []*api.Fleet{newTestSyncedFleet("fleet", "quay.io/os:v1", owner)},
+		},
+		{
+			name:            "modified spec",
+			prune:           true,
+			owned:           []*api.Fleet{newTestSyncedFleet("fleet", "quay.io/os:modified", owner)},
+			expectedDrifted: []api.ObjectReference{{Kind: api.FleetKind, Name: "fleet"}},
+		},
+		{
+			name:            "deleted fleet",
+			prune:           true,
+			expectedDrifted: []api.ObjectReference{{Kind: api.FleetKind, Name: "fleet"}},
+		},
+		{
+			name:  "fleet kept because pruning is disabled",
+			prune: false,
+			owned: []*api.Fleet{
+				newTestSyncedFleet("fleet", "quay.io/os:v1", owner),
+				newTestSyncedFleet("kept", "quay.io/os:v1", owner),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockService := service.NewMockService(ctrl)
+			resourceSync := NewResourceSync(mockService, logrus.New(), nil)
+
+			rs := newTestResourceSync()
+			rs.Spec.Prune = lo.ToPtr(tt.prune)
+			parsed := &SyncedResources{Fleets: []*api.Fleet{newTestSyncedFleet("fleet", "quay.io/os:v1", owner)}}
+			expectOwnedFleets(mockService, tt.owned, parsed.Fleets)
+			mockService.EXPECT().ListRepositories(gomock.Any(), gomock.Any()).Return(&api.RepositoryList{}, api.StatusOK())
+			mockService.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any()).Return(&api.DeviceList{}, api.StatusOK())
+
+			err := resourceSync.detectDrift(context.Background(), logrus.New(), rs, parsed, "test-resourcesync")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDrifted, lo.FromPtr(rs.Status.DriftedResources))
+			assert.Equal(t, len(tt.expectedDrifted) > 0, api.IsStatusConditionTrue(rs.Status.Conditions, api.ConditionTypeResourceSyncDrifted))
+		})
+	}
+}
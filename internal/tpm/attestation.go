@@ -0,0 +1,109 @@
+package tpm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/google/go-tpm/tpm2"
+)
+
+// AttestationKeyFromTCGCSR returns the marshaled TPM2B_PUBLIC of the attestation key (LAK) carried
+// in a TCG-CSR-IDEVID. The key is only as trustworthy as the verification of the CSR it came from.
+func AttestationKeyFromTCGCSR(csrData []byte) ([]byte, error) {
+	parsed, err := ParseTCGCSR(csrData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse TCG-CSR: %w", err)
+	}
+	if !parsed.IsValid {
+		return nil, fmt.Errorf("invalid TCG-CSR: %s", parsed.ValidationError)
+	}
+	if parsed.CSRContents.Payload == nil || len(parsed.CSRContents.Payload.AttestPub) == 0 {
+		return nil, fmt.Errorf("missing attestation public key")
+	}
+	return parsed.CSRContents.Payload.AttestPub, nil
+}
+
+// VerifyQuote verifies that quoted is a TPM2_Quote signed by the attestation key attestPub, that it
+// is qualified by nonce, and that it covers exactly the PCRs in pcrValues with those values.
+// quoted and signature are the marshaled TPM2B_ATTEST and TPMT_SIGNATURE returned by the TPM.
+func VerifyQuote(attestPub, quoted, signature, nonce []byte, pcrValues map[int][]byte) error {
+	attest, err := tpm2.Unmarshal[tpm2.TPM2BAttest](quoted)
+	if err != nil {
+		return fmt.Errorf("unmarshalling TPM2B_ATTEST: %w", err)
+	}
+	attestContents, err := attest.Contents()
+	if err != nil {
+		return fmt.Errorf("extracting TPMS_ATTEST: %w", err)
+	}
+	if attestContents.Magic != tpm2.TPMGeneratedValue {
+		return fmt.Errorf("attestation was not generated by a TPM")
+	}
+	if attestContents.Type != tpm2.TPMSTAttestQuote {
+		return fmt.Errorf("attestation is not a quote: %v", attestContents.Type)
+	}
+	if len(nonce) == 0 || !bytes.Equal(attestContents.ExtraData.Buffer, nonce) {
+		return fmt.Errorf("quote does not match the nonce")
+	}
+
+	quoteInfo, err := attestContents.Attested.Quote()
+	if err != nil {
+		return fmt.Errorf("extracting quote info: %w", err)
+	}
+	pcrs, err := quotedPCRs(&quoteInfo.PCRSelect)
+	if err != nil {
+		return err
+	}
+	if len(pcrs) != len(pcrValues) {
+		return fmt.Errorf("quote covers %d PCRs but %d values were supplied", len(pcrs), len(pcrValues))
+	}
+	// the PCR digest is the hash over the concatenated values in ascending PCR order
+	hasher := sha256.New()
+	for _, pcr := range pcrs {
+		value, ok := pcrValues[pcr]
+		if !ok {
+			return fmt.Errorf("missing value for quoted PCR %d", pcr)
+		}
+		hasher.Write(value)
+	}
+	if !bytes.Equal(hasher.Sum(nil), quoteInfo.PCRDigest.Buffer) {
+		return fmt.Errorf("PCR values do not match the quoted digest")
+	}
+
+	attestKey, err := tpm2.Unmarshal[tpm2.TPM2BPublic](attestPub)
+	if err != nil {
+		return fmt.Errorf("decoding attestation key public blob: %w", err)
+	}
+	attestKeyContents, err := attestKey.Contents()
+	if err != nil {
+		return fmt.Errorf("extracting attestation key TPMT_PUBLIC: %w", err)
+	}
+	attestCryptoKey, err := tpm2.Pub(*attestKeyContents)
+	if err != nil {
+		return fmt.Errorf("converting attestation key TPMTPublic to Go key: %w", err)
+	}
+	if err := verifyTPM2CertifySignature(quoted, signature, attestCryptoKey); err != nil {
+		return fmt.Errorf("quote signature: %w", err)
+	}
+	return nil
+}
+
+// quotedPCRs returns the PCRs selected in the SHA-256 bank in ascending order. Selections of other
+// banks are rejected since their values cannot be checked against SHA-256 reference values.
+func quotedPCRs(selection *tpm2.TPMLPCRSelection) ([]int, error) {
+	if len(selection.PCRSelections) != 1 || selection.PCRSelections[0].Hash != tpm2.TPMAlgSHA256 {
+		return nil, fmt.Errorf("quote must select exactly the SHA-256 PCR bank")
+	}
+	var pcrs []int
+	for i, b := range selection.PCRSelections[0].PCRSelect {
+		for bit := 0; bit < 8; bit++ {
+			if b&(1<<bit) != 0 {
+				pcrs = append(pcrs, i*8+bit)
+			}
+		}
+	}
+	if len(pcrs) == 0 {
+		return nil, fmt.Errorf("quote selects no PCRs")
+	}
+	return pcrs, nil
+}
@@ -0,0 +1,92 @@
+package tpm
+
+import (
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	agent_config "github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/go-tpm-tools/simulator"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpm2/transport"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_QuoteVerification(t *testing.T) {
+	require := require.New(t)
+
+	sim, err := simulator.Get()
+	require.NoError(err)
+	defer sim.Close()
+	require.NoError(setupFakeECCEKCertificate(sim))
+
+	rw := fileio.NewReadWriter(fileio.WithTestRootDir(t.TempDir()))
+	connFactory := func() (io.ReadWriteCloser, error) {
+		return simConn{sim: sim}, nil
+	}
+	c, err := newClientWithConnection(connFactory, log.NewPrefixLogger("test"), rw, &agent_config.Config{
+		TPM: agent_config.TPM{
+			Enabled:         true,
+			DevicePath:      agent_config.DefaultTPMDevicePath,
+			StorageFilePath: agent_config.DefaultTPMKeyFile,
+		},
+	}, "test-model", "test-serial")
+	require.NoError(err)
+	defer func() { _ = safeCloseSession(c.session) }()
+
+	// measure a boot component into PCR 8 and record it in an event log
+	eventLog := buildTestEventLog([]testEvent{{pcr: 8, eventType: 0x80000003, data: []byte("kernel")}})
+	digest := sha256.Sum256([]byte("kernel"))
+	_, err = tpm2.PCRExtend{
+		PCRHandle: tpm2.AuthHandle{Handle: tpm2.TPMHandle(8), Auth: tpm2.PasswordAuth(nil)},
+		Digests: tpm2.TPMLDigestValues{
+			Digests: []tpm2.TPMTHA{{HashAlg: tpm2.TPMAlgSHA256, Digest: digest[:]}},
+		},
+	}.Execute(transport.FromReadWriter(sim))
+	require.NoError(err)
+
+	csr, err := c.MakeCSR("test-name", make([]byte, 32))
+	require.NoError(err)
+	attestPub, err := AttestationKeyFromTCGCSR(csr)
+	require.NoError(err)
+
+	nonce := []byte("0123456789abcdef")
+	quote, err := c.Quote(nonce)
+	require.NoError(err)
+	require.Len(quote.PCRValues, numPCRs)
+
+	pcrValues := func() map[int][]byte {
+		values := make(map[int][]byte, len(quote.PCRValues))
+		for pcr, value := range quote.PCRValues {
+			values[pcr] = value
+		}
+		return values
+	}
+
+	require.NoError(VerifyQuote(attestPub, quote.Quoted, quote.Signature, nonce, pcrValues()))
+
+	replayed, err := ReplayEventLog(eventLog)
+	require.NoError(err)
+	require.Equal(quote.PCRValues[8], replayed[8])
+
+	// a quote for another challenge must be rejected
+	err = VerifyQuote(attestPub, quote.Quoted, quote.Signature, []byte("fedcba9876543210"), pcrValues())
+	require.ErrorContains(err, "nonce")
+
+	// PCR values that differ from the quoted ones must be rejected
+	tampered := pcrValues()
+	tampered[8] = make([]byte, sha256.Size)
+	err = VerifyQuote(attestPub, quote.Quoted, quote.Signature, nonce, tampered)
+	require.ErrorContains(err, "do not match")
+
+	missing := pcrValues()
+	delete(missing, 8)
+	err = VerifyQuote(attestPub, quote.Quoted, quote.Signature, nonce, missing)
+	require.Error(err)
+
+	// a quote must be signed by the attestation key of the device
+	err = VerifyQuote(tpm2.Marshal(*createTestTPM2BPublic(t)), quote.Quoted, quote.Signature, nonce, pcrValues())
+	require.ErrorContains(err, "signature")
+}
@@ -11,6 +11,7 @@ import (
 	"io"
 	"math/big"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	agent_config "github.com/flightctl/flightctl/internal/agent/config"
@@ -353,6 +354,35 @@ func (c *client) Close() error {
 	return nil
 }
 
+// Quote quotes all PCRs of the SHA-256 bank. The PCRs are read before and after the quote, and the
+// quote is retried if they changed in between, e.g. because a measurement was extended concurrently.
+func (c *client) Quote(nonce []byte) (*Quote, error) {
+	pcrs := make([]int, numPCRs)
+	for i := range pcrs {
+		pcrs[i] = i
+	}
+
+	for attempt := 0; attempt < maxQuoteAttempts; attempt++ {
+		before, err := c.session.ReadPCRs(pcrs)
+		if err != nil {
+			return nil, err
+		}
+		quoted, signature, err := c.session.Quote(pcrs, nonce)
+		if err != nil {
+			return nil, err
+		}
+		after, err := c.session.ReadPCRs(pcrs)
+		if err != nil {
+			return nil, err
+		}
+		if slices.EqualFunc(before, after, bytes.Equal) {
+			return &Quote{Quoted: quoted, Signature: signature, PCRValues: after}, nil
+		}
+		c.log.Debugf("PCRs changed during quote, retrying")
+	}
+	return nil, fmt.Errorf("PCRs kept changing during %d quote attempts", maxQuoteAttempts)
+}
+
 // SolveChallenge uses TPM2_ActivateCredential to decrypt an encrypted secret
 // and prove ownership of the credentials. This is used by clients to solve challenges.
 func (c *client) SolveChallenge(credentialBlob, encryptedSecret []byte) ([]byte, error) {
@@ -0,0 +1,201 @@
+package tpm
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/google/go-tpm/tpm2"
+)
+
+const (
+	// DefaultEventLogPath is where the kernel exposes the measured boot event log of the first TPM
+	DefaultEventLogPath = "/sys/kernel/security/tpm0/binary_bios_measurements"
+
+	// eventNoAction is EV_NO_ACTION, an event which is logged but not extended into a PCR
+	eventNoAction = 0x00000003
+	// sha1DigestSize is the digest size of the TCG_PCR_EVENT header that starts a crypto agile log
+	sha1DigestSize = 20
+	// maxEventSize bounds the size of a single event to reject corrupt logs early
+	maxEventSize = 1 << 24
+)
+
+var (
+	specIDEventSignature     = []byte("Spec ID Event03\x00")
+	startupLocalitySignature = []byte("StartupLocality\x00")
+)
+
+// ReplayEventLog replays the SHA-256 measurements of a TCG crypto agile event log, as exposed by
+// the kernel in binary_bios_measurements, and returns the resulting PCR values indexed by PCR.
+// Only PCRs which have at least one measurement in the log are returned.
+func ReplayEventLog(raw []byte) (map[int][]byte, error) {
+	r := &eventLogReader{data: raw}
+
+	// the log starts with a TCG_PCR_EVENT in the SHA-1 log format carrying the Spec ID event that
+	// declares the digest sizes of all banks used in the rest of the log
+	if _, err := r.uint32(); err != nil {
+		return nil, err
+	}
+	eventType, err := r.uint32()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.bytes(sha1DigestSize); err != nil {
+		return nil, err
+	}
+	specID, err := r.event()
+	if err != nil {
+		return nil, err
+	}
+	if eventType != eventNoAction || !bytes.HasPrefix(specID, specIDEventSignature) {
+		return nil, fmt.Errorf("event log is not in the crypto agile format")
+	}
+	digestSizes, err := parseSpecIDEvent(specID)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := digestSizes[uint16(tpm2.TPMAlgSHA256)]; !ok {
+		return nil, fmt.Errorf("event log has no SHA-256 bank")
+	}
+
+	pcrs := make(map[int][]byte)
+	var locality byte
+	for !r.done() {
+		pcr, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+		eventType, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+		count, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+		var sha256Digest []byte
+		for i := uint32(0); i < count; i++ {
+			alg, err := r.uint16()
+			if err != nil {
+				return nil, err
+			}
+			size, ok := digestSizes[alg]
+			if !ok {
+				return nil, fmt.Errorf("event digest uses undeclared algorithm 0x%04x", alg)
+			}
+			digest, err := r.bytes(int(size))
+			if err != nil {
+				return nil, err
+			}
+			if alg == uint16(tpm2.TPMAlgSHA256) {
+				sha256Digest = digest
+			}
+		}
+		data, err := r.event()
+		if err != nil {
+			return nil, err
+		}
+		if pcr >= numPCRs {
+			return nil, fmt.Errorf("event for invalid PCR %d", pcr)
+		}
+
+		if eventType == eventNoAction {
+			// the startup locality determines the initial value of PCR 0
+			if pcr == 0 && bytes.HasPrefix(data, startupLocalitySignature) && len(data) > len(startupLocalitySignature) {
+				locality = data[len(startupLocalitySignature)]
+			}
+			continue
+		}
+		if sha256Digest == nil {
+			return nil, fmt.Errorf("event for PCR %d has no SHA-256 digest", pcr)
+		}
+
+		value, ok := pcrs[int(pcr)]
+		if !ok {
+			value = make([]byte, sha256.Size)
+			if pcr == 0 {
+				value[sha256.Size-1] = locality
+			}
+		}
+		extended := sha256.Sum256(append(value, sha256Digest...))
+		pcrs[int(pcr)] = extended[:]
+	}
+	return pcrs, nil
+}
+
+// parseSpecIDEvent returns the digest size of each algorithm declared in a TCG_EfiSpecIDEvent
+func parseSpecIDEvent(data []byte) (map[uint16]uint16, error) {
+	r := &eventLogReader{data: data}
+	// signature, platformClass, specVersionMinor, specVersionMajor, specErrata and uintnSize
+	if _, err := r.bytes(len(specIDEventSignature) + 4 + 4); err != nil {
+		return nil, fmt.Errorf("spec ID event: %w", err)
+	}
+	count, err := r.uint32()
+	if err != nil {
+		return nil, fmt.Errorf("spec ID event: %w", err)
+	}
+	sizes := make(map[uint16]uint16, count)
+	for i := uint32(0); i < count; i++ {
+		alg, err := r.uint16()
+		if err != nil {
+			return nil, fmt.Errorf("spec ID event: %w", err)
+		}
+		size, err := r.uint16()
+		if err != nil {
+			return nil, fmt.Errorf("spec ID event: %w", err)
+		}
+		sizes[alg] = size
+	}
+	if alg256, ok := sizes[uint16(tpm2.TPMAlgSHA256)]; ok && alg256 != sha256.Size {
+		return nil, fmt.Errorf("spec ID event declares SHA-256 digests of %d bytes", alg256)
+	}
+	return sizes, nil
+}
+
+// eventLogReader reads the little endian fields of an event log
+type eventLogReader struct {
+	data []byte
+	pos  int
+}
+
+func (r *eventLogReader) done() bool {
+	return r.pos >= len(r.data)
+}
+
+func (r *eventLogReader) bytes(n int) ([]byte, error) {
+	if n < 0 || n > len(r.data)-r.pos {
+		return nil, fmt.Errorf("event log truncated at offset %d", r.pos)
+	}
+	b := r.data[r.pos : r.pos+n]
+	r.pos += n
+	return b, nil
+}
+
+func (r *eventLogReader) uint16() (uint16, error) {
+	b, err := r.bytes(2)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+func (r *eventLogReader) uint32() (uint32, error) {
+	b, err := r.bytes(4)
+	if err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+// event reads a size prefixed event data field
+func (r *eventLogReader) event() ([]byte, error) {
+	size, err := r.uint32()
+	if err != nil {
+		return nil, err
+	}
+	if size > maxEventSize {
+		return nil, fmt.Errorf("event of %d bytes at offset %d exceeds the maximum size", size, r.pos)
+	}
+	return r.bytes(int(size))
+}
@@ -0,0 +1,113 @@
+package tpm
+
+import (
+	"bytes"
+	"crypto/sha1" //nolint:gosec // SHA-1 digests are part of the event log format
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/google/go-tpm/tpm2"
+	"github.com/stretchr/testify/require"
+)
+
+type testEvent struct {
+	pcr       uint32
+	eventType uint32
+	data      []byte
+}
+
+// buildTestEventLog builds a crypto agile event log with SHA-1 and SHA-256 digests of the event data
+func buildTestEventLog(events []testEvent) []byte {
+	var buf bytes.Buffer
+	write := func(v any) { _ = binary.Write(&buf, binary.LittleEndian, v) }
+
+	var specID bytes.Buffer
+	specID.Write(specIDEventSignature)
+	_ = binary.Write(&specID, binary.LittleEndian, []uint32{0, 0}) // platform class and versions
+	_ = binary.Write(&specID, binary.LittleEndian, uint32(2))
+	_ = binary.Write(&specID, binary.LittleEndian, []uint16{uint16(tpm2.TPMAlgSHA1), sha1.Size, uint16(tpm2.TPMAlgSHA256), sha256.Size})
+	specID.WriteByte(0) // no vendor info
+
+	write(uint32(0))
+	write(uint32(eventNoAction))
+	buf.Write(make([]byte, sha1DigestSize))
+	write(uint32(specID.Len()))
+	buf.Write(specID.Bytes())
+
+	for _, e := range events {
+		write(e.pcr)
+		write(e.eventType)
+		write(uint32(2))
+		sha1Digest := sha1.Sum(e.data) //nolint:gosec
+		write(uint16(tpm2.TPMAlgSHA1))
+		buf.Write(sha1Digest[:])
+		sha256Digest := sha256.Sum256(e.data)
+		write(uint16(tpm2.TPMAlgSHA256))
+		buf.Write(sha256Digest[:])
+		write(uint32(len(e.data)))
+		buf.Write(e.data)
+	}
+	return buf.Bytes()
+}
+
+func extendTestPCR(value []byte, data []byte) []byte {
+	digest := sha256.Sum256(data)
+	extended := sha256.Sum256(append(append([]byte{}, value...), digest[:]...))
+	return extended[:]
+}
+
+func TestReplayEventLog(t *testing.T) {
+	require := require.New(t)
+
+	log := buildTestEventLog([]testEvent{
+		{pcr: 0, eventType: eventNoAction, data: append(append([]byte{}, startupLocalitySignature...), 3)},
+		{pcr: 0, eventType: 0x80000008, data: []byte("firmware")},
+		{pcr: 4, eventType: 0x80000003, data: []byte("shim")},
+		{pcr: 4, eventType: 0x80000003, data: []byte("kernel")},
+		{pcr: 7, eventType: eventNoAction, data: []byte("ignored")},
+	})
+
+	pcrs, err := ReplayEventLog(log)
+	require.NoError(err)
+	require.Len(pcrs, 2)
+
+	pcr0 := make([]byte, sha256.Size)
+	pcr0[sha256.Size-1] = 3
+	require.Equal(extendTestPCR(pcr0, []byte("firmware")), pcrs[0])
+	pcr4 := extendTestPCR(make([]byte, sha256.Size), []byte("shim"))
+	require.Equal(extendTestPCR(pcr4, []byte("kernel")), pcrs[4])
+}
+
+func TestReplayEventLogErrors(t *testing.T) {
+	valid := buildTestEventLog([]testEvent{{pcr: 4, eventType: 0x80000003, data: []byte("kernel")}})
+
+	testCases := []struct {
+		name string
+		log  []byte
+	}{
+		{
+			name: "empty",
+			log:  nil,
+		},
+		{
+			name: "truncated",
+			log:  valid[:len(valid)-1],
+		},
+		{
+			name: "SHA-1 log format",
+			log:  append([]byte{0, 0, 0, 0, 8, 0, 0, 0}, make([]byte, sha1DigestSize+4)...),
+		},
+		{
+			name: "invalid PCR",
+			log:  buildTestEventLog([]testEvent{{pcr: numPCRs, eventType: 0x80000003, data: []byte("kernel")}}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ReplayEventLog(tc.log)
+			require.Error(t, err)
+		})
+	}
+}
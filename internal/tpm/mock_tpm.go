@@ -129,6 +129,21 @@ func (mr *MockClientMockRecorder) Public() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Public", reflect.TypeOf((*MockClient)(nil).Public))
 }
 
+// Quote mocks base method.
+func (m *MockClient) Quote(nonce []byte) (*Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", nonce)
+	ret0, _ := ret[0].(*Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockClientMockRecorder) Quote(nonce any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockClient)(nil).Quote), nonce)
+}
+
 // SolveChallenge mocks base method.
 func (m *MockClient) SolveChallenge(credentialBlob, encryptedSecret []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -767,6 +782,37 @@ func (mr *MockSessionMockRecorder) LoadKey(keyType any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadKey", reflect.TypeOf((*MockSession)(nil).LoadKey), keyType)
 }
 
+// Quote mocks base method.
+func (m *MockSession) Quote(pcrs []int, qualifyingData []byte) ([]byte, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", pcrs, qualifyingData)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockSessionMockRecorder) Quote(pcrs, qualifyingData any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockSession)(nil).Quote), pcrs, qualifyingData)
+}
+
+// ReadPCRs mocks base method.
+func (m *MockSession) ReadPCRs(pcrs []int) ([][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPCRs", pcrs)
+	ret0, _ := ret[0].([][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPCRs indicates an expected call of ReadPCRs.
+func (mr *MockSessionMockRecorder) ReadPCRs(pcrs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPCRs", reflect.TypeOf((*MockSession)(nil).ReadPCRs), pcrs)
+}
+
 // RemoveApplicationKey mocks base method.
 func (m *MockSession) RemoveApplicationKey(appName string) error {
 	m.ctrl.T.Helper()
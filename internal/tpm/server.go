@@ -432,6 +432,22 @@ func VerifyTCGCSRChainOfTrustWithRoots(csrData []byte, trustedRoots *x509.CertPo
 	return nil
 }
 
+// EKCertificateFromTCGCSR returns the EK certificate embedded in a TCG-CSR-IDEVID.  The certificate is not verified.
+func EKCertificateFromTCGCSR(csrData []byte) (*x509.Certificate, error) {
+	parsed, err := ParseTCGCSR(csrData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse TCG-CSR: %w", err)
+	}
+	if parsed.CSRContents == nil || parsed.CSRContents.Payload == nil || len(parsed.CSRContents.Payload.EkCert) == 0 {
+		return nil, fmt.Errorf("missing EK certificate in TCG-CSR")
+	}
+	ekCert, err := x509.ParseCertificate(parsed.CSRContents.Payload.EkCert)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse EK certificate: %w", err)
+	}
+	return ekCert, nil
+}
+
 // VerifyTCGCSRSigningChain verifies that the supplied CSR contains a valid TCG-CSR-IDEVID bundle that
 // is certified by the supplied key
 func VerifyTCGCSRSigningChain(csrData []byte, trustedCertifyKey []byte) error {
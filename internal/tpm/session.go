@@ -339,6 +339,53 @@ func (s *tpmSession) Sign(keyType KeyType, digest []byte) ([]byte, error) {
 	return signWithKey(transport.FromReadWriter(s.conn), authHandel, digest)
 }
 
+func (s *tpmSession) Quote(pcrs []int, qualifyingData []byte) (quoted, signature []byte, err error) {
+	lakHandle, err := s.LoadKey(LAK)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading LAK for quote: %w", err)
+	}
+
+	cmd := tpm2.Quote{
+		SignHandle: tpm2.AuthHandle{
+			Handle: lakHandle.Handle,
+			Name:   lakHandle.Name,
+			Auth:   tpm2.PasswordAuth(nil),
+		},
+		QualifyingData: tpm2.TPM2BData{Buffer: qualifyingData},
+		// the LAK is a restricted key, so the scheme of its template is used
+		InScheme:  tpm2.TPMTSigScheme{Scheme: tpm2.TPMAlgNull},
+		PCRSelect: *pcrSelection(pcrs),
+	}
+
+	resp, err := cmd.Execute(transport.FromReadWriter(s.conn))
+	if err != nil {
+		return nil, nil, fmt.Errorf("TPM2_Quote failed: %w", err)
+	}
+
+	return tpm2.Marshal(resp.Quoted), tpm2.Marshal(resp.Signature), nil
+}
+
+func (s *tpmSession) ReadPCRs(pcrs []int) ([][]byte, error) {
+	values := make([][]byte, 0, len(pcrs))
+	// TPM2_PCR_Read returns at most eight digests per call
+	for start := 0; start < len(pcrs); start += 8 {
+		end := min(start+8, len(pcrs))
+		resp, err := tpm2.PCRRead{
+			PCRSelectionIn: *pcrSelection(pcrs[start:end]),
+		}.Execute(transport.FromReadWriter(s.conn))
+		if err != nil {
+			return nil, fmt.Errorf("TPM2_PCR_Read failed: %w", err)
+		}
+		if len(resp.PCRValues.Digests) != end-start {
+			return nil, fmt.Errorf("TPM2_PCR_Read returned %d of %d PCRs", len(resp.PCRValues.Digests), end-start)
+		}
+		for _, digest := range resp.PCRValues.Digests {
+			values = append(values, digest.Buffer)
+		}
+	}
+	return values, nil
+}
+
 func signWithKey(conn transport.TPM, handle tpm2.AuthHandle, digest []byte) ([]byte, error) {
 	cmd := tpm2.Sign{
 		KeyHandle: handle,
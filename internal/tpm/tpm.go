@@ -29,11 +29,28 @@ type Client interface {
 	VendorInfoCollector(ctx context.Context) string
 	// CreateApplicationKey generates a TCG CSR IDEVID bundle and a TSS2 PEM encoded file for the specified application
 	CreateApplicationKey(name string) ([]byte, []byte, error)
+	// Quote returns a quote of the SHA-256 PCR bank signed with the LAK, qualified by the nonce
+	Quote(nonce []byte) (*Quote, error)
+}
+
+// Quote is a quote of PCRs together with the PCR values it covers
+type Quote struct {
+	// Quoted is the marshaled TPM2B_ATTEST structure
+	Quoted []byte
+	// Signature is the marshaled TPMT_SIGNATURE over Quoted
+	Signature []byte
+	// PCRValues are the values of the quoted PCRs, indexed by PCR
+	PCRValues [][]byte
 }
 
 const (
 	MinNonceLength = 8
 
+	// numPCRs is the number of PCRs of a PC Client TPM
+	numPCRs = 24
+	// maxQuoteAttempts is the number of times a quote is retried when PCRs change while quoting
+	maxQuoteAttempts = 3
+
 	tpmPathTemplate     = "/dev/tpm%s"
 	rmPathTemplate      = "/dev/tpmrm%s"
 	versionPathTemplate = "/sys/class/tpm/%s/tpm_version_major"
@@ -138,6 +155,10 @@ type Session interface {
 	RemoveApplicationKey(appName string) error
 	// Sign signs data with the specified key
 	Sign(keyType KeyType, digest []byte) ([]byte, error)
+	// Quote signs the values of the PCRs in the SHA-256 bank with the LAK. PCRs must be in ascending order.
+	Quote(pcrs []int, qualifyingData []byte) (quoted, signature []byte, err error)
+	// ReadPCRs reads the values of the PCRs in the SHA-256 bank. PCRs must be in ascending order.
+	ReadPCRs(pcrs []int) ([][]byte, error)
 	// GetPublicKey gets the public key for a key type
 	GetPublicKey(keyType KeyType) (*tpm2.TPM2BPublic, error)
 	// GetEndorsementKeyCert returns the endorsement key certificate
@@ -166,6 +187,15 @@ func createPCRSelection(selection [3]byte) *tpm2.TPMLPCRSelection {
 	}
 }
 
+// pcrSelection creates a selection of the given PCRs in the SHA-256 bank
+func pcrSelection(pcrs []int) *tpm2.TPMLPCRSelection {
+	var selection [3]byte
+	for _, pcr := range pcrs {
+		selection[pcr/8] |= 1 << (pcr % 8)
+	}
+	return createPCRSelection(selection)
+}
+
 // createFullPCRSelection creates a PCR selection that includes all PCRs (0-23)
 func createFullPCRSelection() *tpm2.TPMLPCRSelection {
 	// PCRs 0-7 (all bits set)
@@ -129,6 +129,42 @@ func (s *AgentTransportHandler) PatchDeviceStatus(w http.ResponseWriter, r *http
 	transport.SetResponse(w, body, status)
 }
 
+// (POST /api/v1/devices/{name}/attestationchallenge)
+func (s *AgentTransportHandler) CreateDeviceAttestationChallenge(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+
+	fingerprint, err := ValidateDeviceAccessFromContext(ctx, name, s.ca, s.log)
+	if err != nil {
+		status := api.StatusUnauthorized(http.StatusText(http.StatusUnauthorized))
+		transport.SetResponse(w, status, status)
+		return
+	}
+
+	body, status := s.serviceHandler.CreateDeviceAttestationChallenge(ctx, fingerprint)
+	transport.SetResponse(w, body, status)
+}
+
+// (POST /api/v1/devices/{name}/attestation)
+func (s *AgentTransportHandler) CreateDeviceAttestation(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+
+	fingerprint, err := ValidateDeviceAccessFromContext(ctx, name, s.ca, s.log)
+	if err != nil {
+		status := api.StatusUnauthorized(http.StatusText(http.StatusUnauthorized))
+		transport.SetResponse(w, status, status)
+		return
+	}
+
+	var attestation api.DeviceAttestation
+	if err := json.NewDecoder(r.Body).Decode(&attestation); err != nil {
+		transport.SetParseFailureResponse(w, err)
+		return
+	}
+
+	body, status := s.serviceHandler.CreateDeviceAttestation(ctx, fingerprint, attestation)
+	transport.SetResponse(w, body, status)
+}
+
 // (POST /api/v1/enrollmentrequests)
 func (s *AgentTransportHandler) CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -0,0 +1,45 @@
+package transport
+
+import (
+	"encoding/json"
+	"net/http"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
+)
+
+// (POST /api/v1/bulkoperations)
+func (h *TransportHandler) CreateBulkOperation(w http.ResponseWriter, r *http.Request, params api.CreateBulkOperationParams) {
+	var operation api.BulkOperation
+	if err := json.NewDecoder(r.Body).Decode(&operation); err != nil {
+		SetParseFailureResponse(w, err)
+		return
+	}
+
+	body, status := h.serviceHandler.CreateBulkOperation(service.WithDryRun(r.Context(), params.DryRun), operation)
+	SetResponse(w, body, status)
+}
+
+// (GET /api/v1/bulkoperations)
+func (h *TransportHandler) ListBulkOperations(w http.ResponseWriter, r *http.Request, params api.ListBulkOperationsParams) {
+	body, status := h.serviceHandler.ListBulkOperations(r.Context(), params)
+	SetResponse(w, body, status)
+}
+
+// (GET /api/v1/bulkoperations/{name})
+func (h *TransportHandler) GetBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetBulkOperation(r.Context(), name)
+	SetResponse(w, body, status)
+}
+
+// (DELETE /api/v1/bulkoperations/{name})
+func (h *TransportHandler) DeleteBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteBulkOperation(r.Context(), name)
+	SetResponse(w, nil, status)
+}
+
+// (POST /api/v1/bulkoperations/{name}/cancel)
+func (h *TransportHandler) CancelBulkOperation(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.CancelBulkOperation(r.Context(), name)
+	SetResponse(w, body, status)
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
 )
 
 // (GET /api/v1/certificatesigningrequests)
@@ -14,14 +15,14 @@ func (h *TransportHandler) ListCertificateSigningRequests(w http.ResponseWriter,
 }
 
 // (POST /api/v1/certificatesigningrequests)
-func (h *TransportHandler) CreateCertificateSigningRequest(w http.ResponseWriter, r *http.Request) {
+func (h *TransportHandler) CreateCertificateSigningRequest(w http.ResponseWriter, r *http.Request, params api.CreateCertificateSigningRequestParams) {
 	var csr api.CertificateSigningRequest
 	if err := json.NewDecoder(r.Body).Decode(&csr); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.CreateCertificateSigningRequest(r.Context(), csr)
+	body, status := h.serviceHandler.CreateCertificateSigningRequest(service.WithDryRun(r.Context(), params.DryRun), csr)
 	SetResponse(w, body, status)
 }
 
@@ -38,26 +39,26 @@ func (h *TransportHandler) GetCertificateSigningRequest(w http.ResponseWriter, r
 }
 
 // (PATCH /api/v1/certificatesigningrequests/{name})
-func (h *TransportHandler) PatchCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) PatchCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string, params api.PatchCertificateSigningRequestParams) {
 	var patch api.PatchRequest
 	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.PatchCertificateSigningRequest(r.Context(), name, patch)
+	body, status := h.serviceHandler.PatchCertificateSigningRequest(service.WithDryRun(r.Context(), params.DryRun), name, patch)
 	SetResponse(w, body, status)
 }
 
 // (PUT /api/v1/certificatesigningrequests/{name})
-func (h *TransportHandler) ReplaceCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) ReplaceCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string, params api.ReplaceCertificateSigningRequestParams) {
 	var csr api.CertificateSigningRequest
 	if err := json.NewDecoder(r.Body).Decode(&csr); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.ReplaceCertificateSigningRequest(r.Context(), name, csr)
+	body, status := h.serviceHandler.ReplaceCertificateSigningRequest(service.WithDryRun(r.Context(), params.DryRun), name, csr)
 	SetResponse(w, body, status)
 }
 
@@ -1,6 +1,7 @@
 package transport
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -53,6 +54,14 @@ func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Re
 		}
 		return
 	}
+	// the session is closed however the request ends, so that its audit record gets an end time and its recording
+	// is completed.  The request may be canceled by then, but the session must still be closed.
+	defer func() {
+		h.log.Infof("Ending console session %s to device %s", consoleSession.UUID, deviceName)
+		if err := h.consoleSessionManager.CloseSession(context.WithoutCancel(r.Context()), consoleSession); err != nil {
+			h.log.Errorf("Error closing console session %s for device %s: %v", consoleSession.UUID, deviceName, err)
+		}
+	}()
 
 	timer := time.NewTimer(time.Minute)
 	defer timer.Stop()
@@ -120,6 +129,7 @@ func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Re
 			}
 			// if it's binary or text message, forward it to the console session
 			if msgType == websocket.BinaryMessage {
+				consoleSession.RecordInput(message)
 				consoleSession.SendCh <- message
 			} else {
 				h.log.Warningf("Received unexpected message type %d from console websocket session %s for device %s",
@@ -158,6 +168,7 @@ func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Re
 					return
 				}
 
+				consoleSession.RecordOutput(message)
 				// echo the message received from the device console back to the websocket client
 				if err := conn.WriteMessage(websocket.BinaryMessage, message); err != nil {
 					h.log.Errorf("Failed to write message to console websocket for %s: %v", deviceName, err)
@@ -169,9 +180,4 @@ func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Re
 	}()
 
 	wg.Wait()
-	h.log.Infof("Ending console session %s to device %s", consoleSession.UUID, deviceName)
-	err = h.consoleSessionManager.CloseSession(r.Context(), consoleSession)
-	if err != nil {
-		h.log.Errorf("Error closing console session %s for device %s: %v", consoleSession.UUID, deviceName, err)
-	}
 }
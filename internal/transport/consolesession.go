@@ -0,0 +1,67 @@
+package transport
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/console/recording"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/util"
+)
+
+// (GET /api/v1/consolesessions)
+func (h *TransportHandler) ListConsoleSessions(w http.ResponseWriter, r *http.Request, params api.ListConsoleSessionsParams) {
+	body, status := h.serviceHandler.ListConsoleSessions(r.Context(), params)
+	SetResponse(w, body, status)
+}
+
+// (GET /api/v1/consolesessions/{name})
+func (h *TransportHandler) GetConsoleSession(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetConsoleSession(r.Context(), name)
+	SetResponse(w, body, status)
+}
+
+// (GET /api/v1/consolesessions/{name}/recording)
+func (h *TransportHandler) GetConsoleSessionRecording(w http.ResponseWriter, r *http.Request, name string) {
+	// getting the session first makes sure that it belongs to the organization of the request
+	session, status := h.serviceHandler.GetConsoleSession(r.Context(), name)
+	if status.Code != http.StatusOK {
+		SetResponse(w, nil, status)
+		return
+	}
+	if h.consoleRecordings == nil || session.Status == nil || session.Status.Recording == nil {
+		SetResponse(w, nil, api.StatusResourceNotFound("ConsoleSession recording", name))
+		return
+	}
+
+	orgId, ok := util.GetOrgIdFromContext(r.Context())
+	if !ok {
+		orgId = store.NullOrgId
+	}
+	rc, err := recording.Open(r.Context(), h.consoleRecordings, orgId, name)
+	if err != nil {
+		if errors.Is(err, flterrors.ErrResourceNotFound) {
+			SetResponse(w, nil, api.StatusResourceNotFound("ConsoleSession recording", name))
+			return
+		}
+		SetResponse(w, nil, api.StatusInternalServerError(fmt.Sprintf("failed to open recording: %v", err)))
+		return
+	}
+	defer rc.Close()
+
+	w.Header().Set("Content-Type", recording.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+".cast"))
+	// the size is only known once the session ended; the recording of a session that is still running, or whose
+	// server went away, is sent as stored so far
+	if session.Status.EndTime != nil {
+		w.Header().Set("Content-Length", strconv.FormatInt(session.Status.Recording.Size, 10))
+	}
+	w.WriteHeader(http.StatusOK)
+	// the status is already sent, so a failure can only be reported by cutting the response short
+	_, _ = io.Copy(w, rc)
+}
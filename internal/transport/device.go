@@ -5,22 +5,28 @@ import (
 	"net/http"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
 )
 
 // (POST /api/v1/devices)
-func (h *TransportHandler) CreateDevice(w http.ResponseWriter, r *http.Request) {
+func (h *TransportHandler) CreateDevice(w http.ResponseWriter, r *http.Request, params api.CreateDeviceParams) {
 	var device api.Device
 	if err := json.NewDecoder(r.Body).Decode(&device); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.CreateDevice(r.Context(), device)
+	body, status := h.serviceHandler.CreateDevice(service.WithDryRun(r.Context(), params.DryRun), device)
 	SetResponse(w, body, status)
 }
 
 // (GET /api/v1/devices)
 func (h *TransportHandler) ListDevices(w http.ResponseWriter, r *http.Request, params api.ListDevicesParams) {
+	if params.Watch != nil && *params.Watch {
+		events, status := h.serviceHandler.WatchDevices(r.Context(), params)
+		SetWatchResponse(w, events, status)
+		return
+	}
 	body, status := h.serviceHandler.ListDevices(r.Context(), params, nil)
 	SetResponse(w, body, status)
 }
@@ -32,14 +38,14 @@ func (h *TransportHandler) GetDevice(w http.ResponseWriter, r *http.Request, nam
 }
 
 // (PUT /api/v1/devices/{name})
-func (h *TransportHandler) ReplaceDevice(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) ReplaceDevice(w http.ResponseWriter, r *http.Request, name string, params api.ReplaceDeviceParams) {
 	var device api.Device
 	if err := json.NewDecoder(r.Body).Decode(&device); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.ReplaceDevice(r.Context(), name, device, nil)
+	body, status := h.serviceHandler.ReplaceDevice(service.WithDryRun(r.Context(), params.DryRun), name, device, nil)
 	SetResponse(w, body, status)
 }
 
@@ -80,14 +86,14 @@ func (h *TransportHandler) GetRenderedDevice(w http.ResponseWriter, r *http.Requ
 }
 
 // (PATCH /api/v1/devices/{name})
-func (h *TransportHandler) PatchDevice(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) PatchDevice(w http.ResponseWriter, r *http.Request, name string, params api.PatchDeviceParams) {
 	var patch api.PatchRequest
 	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.PatchDevice(r.Context(), name, patch)
+	body, status := h.serviceHandler.PatchDevice(service.WithDryRun(r.Context(), params.DryRun), name, patch)
 	SetResponse(w, body, status)
 }
 
@@ -126,3 +132,9 @@ func (h *TransportHandler) ResumeDevices(w http.ResponseWriter, r *http.Request)
 	response, status := h.serviceHandler.ResumeDevices(r.Context(), request)
 	SetResponse(w, response, status)
 }
+
+// (GET /api/v1/devicesearch)
+func (h *TransportHandler) SearchDevices(w http.ResponseWriter, r *http.Request, params api.SearchDevicesParams) {
+	body, status := h.serviceHandler.SearchDevices(r.Context(), params)
+	SetResponse(w, body, status)
+}
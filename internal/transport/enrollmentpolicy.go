@@ -0,0 +1,63 @@
+package transport
+
+import (
+	"encoding/json"
+	"net/http"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
+)
+
+// (POST /api/v1/enrollmentpolicies)
+func (h *TransportHandler) CreateEnrollmentPolicy(w http.ResponseWriter, r *http.Request, params api.CreateEnrollmentPolicyParams) {
+	var policy api.EnrollmentPolicy
+	if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+		SetParseFailureResponse(w, err)
+		return
+	}
+
+	body, status := h.serviceHandler.CreateEnrollmentPolicy(service.WithDryRun(r.Context(), params.DryRun), policy)
+	SetResponse(w, body, status)
+}
+
+// (GET /api/v1/enrollmentpolicies)
+func (h *TransportHandler) ListEnrollmentPolicies(w http.ResponseWriter, r *http.Request, params api.ListEnrollmentPoliciesParams) {
+	body, status := h.serviceHandler.ListEnrollmentPolicies(r.Context(), params)
+	SetResponse(w, body, status)
+}
+
+// (GET /api/v1/enrollmentpolicies/{name})
+func (h *TransportHandler) GetEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetEnrollmentPolicy(r.Context(), name)
+	SetResponse(w, body, status)
+}
+
+// (PUT /api/v1/enrollmentpolicies/{name})
+func (h *TransportHandler) ReplaceEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string, params api.ReplaceEnrollmentPolicyParams) {
+	var policy api.EnrollmentPolicy
+	if err := json.NewDecoder(r.Body).Decode(&policy); err != nil {
+		SetParseFailureResponse(w, err)
+		return
+	}
+
+	body, status := h.serviceHandler.ReplaceEnrollmentPolicy(service.WithDryRun(r.Context(), params.DryRun), name, policy)
+	SetResponse(w, body, status)
+}
+
+// (DELETE /api/v1/enrollmentpolicies/{name})
+func (h *TransportHandler) DeleteEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteEnrollmentPolicy(r.Context(), name)
+	SetResponse(w, nil, status)
+}
+
+// (PATCH /api/v1/enrollmentpolicies/{name})
+func (h *TransportHandler) PatchEnrollmentPolicy(w http.ResponseWriter, r *http.Request, name string, params api.PatchEnrollmentPolicyParams) {
+	var patch api.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		SetParseFailureResponse(w, err)
+		return
+	}
+
+	body, status := h.serviceHandler.PatchEnrollmentPolicy(service.WithDryRun(r.Context(), params.DryRun), name, patch)
+	SetResponse(w, body, status)
+}
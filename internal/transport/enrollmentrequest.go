@@ -5,17 +5,18 @@ import (
 	"net/http"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
 )
 
 // (POST /api/v1/enrollmentrequests)
-func (h *TransportHandler) CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
+func (h *TransportHandler) CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request, params api.CreateEnrollmentRequestParams) {
 	var er api.EnrollmentRequest
 	if err := json.NewDecoder(r.Body).Decode(&er); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.CreateEnrollmentRequest(r.Context(), er)
+	body, status := h.serviceHandler.CreateEnrollmentRequest(service.WithDryRun(r.Context(), params.DryRun), er)
 	SetResponse(w, body, status)
 }
 
@@ -32,26 +33,26 @@ func (h *TransportHandler) GetEnrollmentRequest(w http.ResponseWriter, r *http.R
 }
 
 // (PUT /api/v1/enrollmentrequests/{name})
-func (h *TransportHandler) ReplaceEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) ReplaceEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string, params api.ReplaceEnrollmentRequestParams) {
 	var er api.EnrollmentRequest
 	if err := json.NewDecoder(r.Body).Decode(&er); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.ReplaceEnrollmentRequest(r.Context(), name, er)
+	body, status := h.serviceHandler.ReplaceEnrollmentRequest(service.WithDryRun(r.Context(), params.DryRun), name, er)
 	SetResponse(w, body, status)
 }
 
 // (PATCH /api/v1/enrollmentrequests/{name})
-func (h *TransportHandler) PatchEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) PatchEnrollmentRequest(w http.ResponseWriter, r *http.Request, name string, params api.PatchEnrollmentRequestParams) {
 	var patch api.PatchRequest
 	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.PatchEnrollmentRequest(r.Context(), name, patch)
+	body, status := h.serviceHandler.PatchEnrollmentRequest(service.WithDryRun(r.Context(), params.DryRun), name, patch)
 	SetResponse(w, body, status)
 }
 
@@ -8,6 +8,11 @@ import (
 
 // (GET /api/v1/events)
 func (h *TransportHandler) ListEvents(w http.ResponseWriter, r *http.Request, params api.ListEventsParams) {
+	if params.Watch != nil && *params.Watch {
+		events, status := h.serviceHandler.WatchEvents(r.Context(), params)
+		SetWatchResponse(w, events, status)
+		return
+	}
 	body, status := h.serviceHandler.ListEvents(r.Context(), params)
 	SetResponse(w, body, status)
 }
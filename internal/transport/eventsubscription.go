@@ -0,0 +1,63 @@
+package transport
+
+import (
+	"encoding/json"
+	"net/http"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
+)
+
+// (POST /api/v1/eventsubscriptions)
+func (h *TransportHandler) CreateEventSubscription(w http.ResponseWriter, r *http.Request, params api.CreateEventSubscriptionParams) {
+	var subscription api.EventSubscription
+	if err := json.NewDecoder(r.Body).Decode(&subscription); err != nil {
+		SetParseFailureResponse(w, err)
+		return
+	}
+
+	body, status := h.serviceHandler.CreateEventSubscription(service.WithDryRun(r.Context(), params.DryRun), subscription)
+	SetResponse(w, body, status)
+}
+
+// (GET /api/v1/eventsubscriptions)
+func (h *TransportHandler) ListEventSubscriptions(w http.ResponseWriter, r *http.Request, params api.ListEventSubscriptionsParams) {
+	body, status := h.serviceHandler.ListEventSubscriptions(r.Context(), params)
+	SetResponse(w, body, status)
+}
+
+// (GET /api/v1/eventsubscriptions/{name})
+func (h *TransportHandler) GetEventSubscription(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.GetEventSubscription(r.Context(), name)
+	SetResponse(w, body, status)
+}
+
+// (PUT /api/v1/eventsubscriptions/{name})
+func (h *TransportHandler) ReplaceEventSubscription(w http.ResponseWriter, r *http.Request, name string, params api.ReplaceEventSubscriptionParams) {
+	var subscription api.EventSubscription
+	if err := json.NewDecoder(r.Body).Decode(&subscription); err != nil {
+		SetParseFailureResponse(w, err)
+		return
+	}
+
+	body, status := h.serviceHandler.ReplaceEventSubscription(service.WithDryRun(r.Context(), params.DryRun), name, subscription)
+	SetResponse(w, body, status)
+}
+
+// (DELETE /api/v1/eventsubscriptions/{name})
+func (h *TransportHandler) DeleteEventSubscription(w http.ResponseWriter, r *http.Request, name string) {
+	status := h.serviceHandler.DeleteEventSubscription(r.Context(), name)
+	SetResponse(w, nil, status)
+}
+
+// (PATCH /api/v1/eventsubscriptions/{name})
+func (h *TransportHandler) PatchEventSubscription(w http.ResponseWriter, r *http.Request, name string, params api.PatchEventSubscriptionParams) {
+	var patch api.PatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		SetParseFailureResponse(w, err)
+		return
+	}
+
+	body, status := h.serviceHandler.PatchEventSubscription(service.WithDryRun(r.Context(), params.DryRun), name, patch)
+	SetResponse(w, body, status)
+}
@@ -5,22 +5,28 @@ import (
 	"net/http"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
 )
 
 // (POST /api/v1/fleets)
-func (h *TransportHandler) CreateFleet(w http.ResponseWriter, r *http.Request) {
+func (h *TransportHandler) CreateFleet(w http.ResponseWriter, r *http.Request, params api.CreateFleetParams) {
 	var fleet api.Fleet
 	if err := json.NewDecoder(r.Body).Decode(&fleet); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.CreateFleet(r.Context(), fleet)
+	body, status := h.serviceHandler.CreateFleet(service.WithDryRun(r.Context(), params.DryRun), fleet)
 	SetResponse(w, body, status)
 }
 
 // (GET /api/v1/fleets)
 func (h *TransportHandler) ListFleets(w http.ResponseWriter, r *http.Request, params api.ListFleetsParams) {
+	if params.Watch != nil && *params.Watch {
+		events, status := h.serviceHandler.WatchFleets(r.Context(), params)
+		SetWatchResponse(w, events, status)
+		return
+	}
 	body, status := h.serviceHandler.ListFleets(r.Context(), params)
 	SetResponse(w, body, status)
 }
@@ -32,14 +38,14 @@ func (h *TransportHandler) GetFleet(w http.ResponseWriter, r *http.Request, name
 }
 
 // (PUT /api/v1/fleets/{name})
-func (h *TransportHandler) ReplaceFleet(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) ReplaceFleet(w http.ResponseWriter, r *http.Request, name string, params api.ReplaceFleetParams) {
 	var fleet api.Fleet
 	if err := json.NewDecoder(r.Body).Decode(&fleet); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.ReplaceFleet(r.Context(), name, fleet)
+	body, status := h.serviceHandler.ReplaceFleet(service.WithDryRun(r.Context(), params.DryRun), name, fleet)
 	SetResponse(w, body, status)
 }
 
@@ -68,14 +74,14 @@ func (h *TransportHandler) ReplaceFleetStatus(w http.ResponseWriter, r *http.Req
 }
 
 // (PATCH /api/v1/fleets/{name})
-func (h *TransportHandler) PatchFleet(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) PatchFleet(w http.ResponseWriter, r *http.Request, name string, params api.PatchFleetParams) {
 	var patch api.PatchRequest
 	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.PatchFleet(r.Context(), name, patch)
+	body, status := h.serviceHandler.PatchFleet(service.WithDryRun(r.Context(), params.DryRun), name, patch)
 	SetResponse(w, body, status)
 }
 
@@ -84,3 +90,33 @@ func (h *TransportHandler) PatchFleetStatus(w http.ResponseWriter, r *http.Reque
 	status := api.StatusNotImplemented("not yet implemented")
 	SetResponse(w, nil, status)
 }
+
+// (POST /api/v1/fleets/{name}/rollout/pause)
+func (h *TransportHandler) PauseFleetRollout(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.PauseFleetRollout(r.Context(), name)
+	SetResponse(w, body, status)
+}
+
+// (POST /api/v1/fleets/{name}/rollout/resume)
+func (h *TransportHandler) ResumeFleetRollout(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.ResumeFleetRollout(r.Context(), name)
+	SetResponse(w, body, status)
+}
+
+// (POST /api/v1/fleets/{name}/rollout/abort)
+func (h *TransportHandler) AbortFleetRollout(w http.ResponseWriter, r *http.Request, name string) {
+	body, status := h.serviceHandler.AbortFleetRollout(r.Context(), name)
+	SetResponse(w, body, status)
+}
+
+// (POST /api/v1/fleets/{name}/preview)
+func (h *TransportHandler) PreviewFleet(w http.ResponseWriter, r *http.Request, name string, params api.PreviewFleetParams) {
+	var fleet api.Fleet
+	if err := json.NewDecoder(r.Body).Decode(&fleet); err != nil {
+		SetParseFailureResponse(w, err)
+		return
+	}
+
+	body, status := h.serviceHandler.PreviewFleet(r.Context(), name, fleet, params)
+	SetResponse(w, body, status)
+}
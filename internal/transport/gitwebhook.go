@@ -0,0 +1,247 @@
+package transport
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// GitWebhookPath is the path that git servers send the push notifications of a Repository to
+const GitWebhookPath = "/api/v1/webhooks/git/{repository}"
+
+// maxGitWebhookBodySize is the size limit of the payloads that are read before the webhook is authenticated.  It is
+// the limit of the payloads GitHub sends.
+const maxGitWebhookBodySize = 25 << 20
+
+// GitWebhookHandler receives the push notifications of GitHub, GitLab and Gitea webhooks and triggers an immediate
+// sync of the ResourceSyncs that track the pushed repository.  Requests are authenticated with the webhook secret of
+// the Repository rather than user credentials, since git servers can't obtain a token.  The organization of the
+// Repository is selected with the org_id query parameter.
+type GitWebhookHandler struct {
+	serviceHandler service.Service
+	log            logrus.FieldLogger
+}
+
+// GitWebhookResponse lists the objects that were notified of a push
+type GitWebhookResponse struct {
+	Triggered []api.ObjectReference `json:"triggered"`
+}
+
+type gitProvider string
+
+const (
+	gitProviderGitHub gitProvider = "github"
+	gitProviderGitLab gitProvider = "gitlab"
+	gitProviderGitea  gitProvider = "gitea"
+)
+
+// githubPushPayload is the subset of a GitHub push payload that is used.  Gitea sends the same format.
+type githubPushPayload struct {
+	Ref        string `json:"ref"`
+	Repository struct {
+		CloneURL      string `json:"clone_url"`
+		SSHURL        string `json:"ssh_url"`
+		HTMLURL       string `json:"html_url"`
+		DefaultBranch string `json:"default_branch"`
+	} `json:"repository"`
+}
+
+// gitlabPushPayload is the subset of a GitLab push or tag push payload that is used
+type gitlabPushPayload struct {
+	Ref     string `json:"ref"`
+	Project struct {
+		GitHTTPURL    string `json:"git_http_url"`
+		GitSSHURL     string `json:"git_ssh_url"`
+		WebURL        string `json:"web_url"`
+		DefaultBranch string `json:"default_branch"`
+	} `json:"project"`
+}
+
+func NewGitWebhookHandler(serviceHandler service.Service, log logrus.FieldLogger) *GitWebhookHandler {
+	return &GitWebhookHandler{
+		serviceHandler: serviceHandler,
+		log:            log,
+	}
+}
+
+func (h *GitWebhookHandler) RegisterRoutes(r chi.Router) {
+	r.Post(GitWebhookPath, h.HandlePush)
+}
+
+// (POST /api/v1/webhooks/git/{repository})
+func (h *GitWebhookHandler) HandlePush(w http.ResponseWriter, r *http.Request) {
+	name := chi.URLParam(r, "repository")
+
+	provider, eventType, ok := detectGitProvider(r.Header)
+	if !ok {
+		SetResponse(w, nil, api.StatusBadRequest("unsupported webhook: missing GitHub, GitLab or Gitea event header"))
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxGitWebhookBodySize))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			SetResponse(w, nil, api.NewFailureStatus(http.StatusRequestEntityTooLarge, http.StatusText(http.StatusRequestEntityTooLarge),
+				fmt.Sprintf("request body exceeds %d bytes", maxBytesErr.Limit)))
+			return
+		}
+		SetResponse(w, nil, api.StatusBadRequest(fmt.Sprintf("failed to read request body: %v", err)))
+		return
+	}
+
+	// a missing repository is reported as a verification failure, so that the names of repositories can't be probed
+	var secret string
+	repository, status := h.serviceHandler.GetRepository(r.Context(), name)
+	switch status.Code {
+	case http.StatusOK:
+		if secret, err = repository.Spec.GetWebhookSecret(); err != nil {
+			SetResponse(w, nil, api.StatusInternalServerError(fmt.Sprintf("failed to read repository %q: %v", name, err)))
+			return
+		}
+	case http.StatusNotFound:
+	default:
+		SetResponse(w, nil, status)
+		return
+	}
+
+	if err := verifyGitWebhook(provider, secret, r.Header, body); err != nil {
+		h.log.Warnf("rejected %s webhook of repository %q: %v", provider, name, err)
+		SetResponse(w, nil, api.StatusUnauthorized(err.Error()))
+		return
+	}
+
+	if !isGitPushEvent(provider, eventType) {
+		// e.g. the ping that is sent when the webhook is created
+		SetResponse(w, nil, api.StatusNoContent())
+		return
+	}
+
+	push, err := parseGitPush(provider, body)
+	if err != nil {
+		SetResponse(w, nil, api.StatusBadRequest(fmt.Sprintf("invalid %s push payload: %v", provider, err)))
+		return
+	}
+
+	triggered, status := h.serviceHandler.HandleGitPush(r.Context(), name, *push)
+	if status.Code != http.StatusOK {
+		SetResponse(w, nil, status)
+		return
+	}
+	SetResponse(w, GitWebhookResponse{Triggered: triggered}, api.NewSuccessStatus(http.StatusAccepted, http.StatusText(http.StatusAccepted), ""))
+}
+
+// detectGitProvider returns the git server that sent the webhook and the type of its event.  Gitea also sends the
+// GitHub headers for compatibility, so it is checked first.
+func detectGitProvider(header http.Header) (gitProvider, string, bool) {
+	if eventType := header.Get("X-Gitea-Event"); eventType != "" {
+		return gitProviderGitea, eventType, true
+	}
+	if eventType := header.Get("X-GitHub-Event"); eventType != "" {
+		return gitProviderGitHub, eventType, true
+	}
+	if eventType := header.Get("X-Gitlab-Event"); eventType != "" {
+		return gitProviderGitLab, eventType, true
+	}
+	return "", "", false
+}
+
+// verifyGitWebhook verifies that the webhook was sent by a git server that knows the secret.  GitHub and Gitea sign
+// the body with HMAC-SHA256, and GitLab sends the secret as a token.
+func verifyGitWebhook(provider gitProvider, secret string, header http.Header, body []byte) error {
+	if secret == "" {
+		return errors.New("repository does not exist or has no webhook secret")
+	}
+
+	switch provider {
+	case gitProviderGitLab:
+		token := header.Get("X-Gitlab-Token")
+		if token == "" {
+			return errors.New("missing X-Gitlab-Token header")
+		}
+		if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+			return errors.New("invalid token")
+		}
+		return nil
+	case gitProviderGitea:
+		signature := header.Get("X-Gitea-Signature")
+		if signature == "" {
+			return errors.New("missing X-Gitea-Signature header")
+		}
+		return verifyHMACSignature(secret, signature, body)
+	default:
+		signature, found := strings.CutPrefix(header.Get("X-Hub-Signature-256"), "sha256=")
+		if !found {
+			return errors.New("missing X-Hub-Signature-256 header")
+		}
+		return verifyHMACSignature(secret, signature, body)
+	}
+}
+
+func verifyHMACSignature(secret, signature string, body []byte) error {
+	expected, err := hex.DecodeString(signature)
+	if err != nil {
+		return errors.New("malformed signature")
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+func isGitPushEvent(provider gitProvider, eventType string) bool {
+	switch provider {
+	case gitProviderGitLab:
+		return eventType == "Push Hook" || eventType == "Tag Push Hook"
+	default:
+		return eventType == "push"
+	}
+}
+
+func parseGitPush(provider gitProvider, body []byte) (*service.GitPush, error) {
+	var push service.GitPush
+	switch provider {
+	case gitProviderGitLab:
+		var payload gitlabPushPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, err
+		}
+		push = service.GitPush{
+			RepositoryURLs: lo.Compact([]string{payload.Project.GitHTTPURL, payload.Project.GitSSHURL, payload.Project.WebURL}),
+			Ref:            payload.Ref,
+			DefaultBranch:  payload.Project.DefaultBranch,
+		}
+	default:
+		var payload githubPushPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, err
+		}
+		push = service.GitPush{
+			RepositoryURLs: lo.Compact([]string{payload.Repository.CloneURL, payload.Repository.SSHURL, payload.Repository.HTMLURL}),
+			Ref:            payload.Ref,
+			DefaultBranch:  payload.Repository.DefaultBranch,
+		}
+	}
+	if len(push.RepositoryURLs) == 0 {
+		return nil, errors.New("missing repository URL")
+	}
+	if push.Ref == "" {
+		return nil, errors.New("missing ref")
+	}
+	return &push, nil
+}
@@ -0,0 +1,206 @@
+package transport
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+const testWebhookSecret = "s3cret"
+
+const githubPushBody = `{"ref":"refs/heads/main","repository":{"clone_url":"https://github.com/org/fleets.git","ssh_url":"git@github.com:org/fleets.git","html_url":"https://github.com/org/fleets","default_branch":"main"}}`
+
+const gitlabPushBody = `{"ref":"refs/heads/main","project":{"git_http_url":"https://gitlab.com/org/fleets.git","git_ssh_url":"git@gitlab.com:org/fleets.git","web_url":"https://gitlab.com/org/fleets","default_branch":"main"}}`
+
+func signWebhookBody(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newWebhookTestRepository(t *testing.T, name string, webhookSecret *string) *api.Repository {
+	spec := api.RepositorySpec{}
+	require.NoError(t, spec.FromGenericRepoSpec(api.GenericRepoSpec{Type: api.Git, Url: "https://github.com/org/fleets.git", WebhookSecret: webhookSecret}))
+	return &api.Repository{Metadata: api.ObjectMeta{Name: lo.ToPtr(name)}, Spec: spec}
+}
+
+func TestGitWebhookHandler_HandlePush(t *testing.T) {
+	tests := []struct {
+		name         string
+		repository   string
+		headers      map[string]string
+		body         string
+		expectPush   *service.GitPush
+		expectedCode int
+	}{
+		{
+			name: "GitHubPush",
+			headers: map[string]string{
+				"X-GitHub-Event":      "push",
+				"X-Hub-Signature-256": "sha256=" + signWebhookBody(testWebhookSecret, githubPushBody),
+			},
+			body: githubPushBody,
+			expectPush: &service.GitPush{
+				RepositoryURLs: []string{"https://github.com/org/fleets.git", "git@github.com:org/fleets.git", "https://github.com/org/fleets"},
+				Ref:            "refs/heads/main",
+				DefaultBranch:  "main",
+			},
+			expectedCode: http.StatusAccepted,
+		},
+		{
+			name: "GiteaPush",
+			headers: map[string]string{
+				"X-Gitea-Event":     "push",
+				"X-GitHub-Event":    "push",
+				"X-Gitea-Signature": signWebhookBody(testWebhookSecret, githubPushBody),
+			},
+			body: githubPushBody,
+			expectPush: &service.GitPush{
+				RepositoryURLs: []string{"https://github.com/org/fleets.git", "git@github.com:org/fleets.git", "https://github.com/org/fleets"},
+				Ref:            "refs/heads/main",
+				DefaultBranch:  "main",
+			},
+			expectedCode: http.StatusAccepted,
+		},
+		{
+			name: "GitLabPush",
+			headers: map[string]string{
+				"X-Gitlab-Event": "Push Hook",
+				"X-Gitlab-Token": testWebhookSecret,
+			},
+			body: gitlabPushBody,
+			expectPush: &service.GitPush{
+				RepositoryURLs: []string{"https://gitlab.com/org/fleets.git", "git@gitlab.com:org/fleets.git", "https://gitlab.com/org/fleets"},
+				Ref:            "refs/heads/main",
+				DefaultBranch:  "main",
+			},
+			expectedCode: http.StatusAccepted,
+		},
+		{
+			name: "GitHubInvalidSignature",
+			headers: map[string]string{
+				"X-GitHub-Event":      "push",
+				"X-Hub-Signature-256": "sha256=" + signWebhookBody("wrong", githubPushBody),
+			},
+			body:         githubPushBody,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "GitHubMissingSignature",
+			headers: map[string]string{
+				"X-GitHub-Event": "push",
+			},
+			body:         githubPushBody,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "GitLabInvalidToken",
+			headers: map[string]string{
+				"X-Gitlab-Event": "Push Hook",
+				"X-Gitlab-Token": "wrong",
+			},
+			body:         gitlabPushBody,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "GitHubPing",
+			headers: map[string]string{
+				"X-GitHub-Event":      "ping",
+				"X-Hub-Signature-256": "sha256=" + signWebhookBody(testWebhookSecret, `{}`),
+			},
+			body:         `{}`,
+			expectedCode: http.StatusNoContent,
+		},
+		{
+			name: "GitHubOversizedBody",
+			headers: map[string]string{
+				"X-GitHub-Event":      "push",
+				"X-Hub-Signature-256": "sha256=" + signWebhookBody(testWebhookSecret, githubPushBody),
+			},
+			body:         strings.Repeat(" ", maxGitWebhookBodySize+1),
+			expectedCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:       "RepositoryWithoutSecret",
+			repository: "no-secret",
+			headers: map[string]string{
+				"X-GitHub-Event":      "push",
+				"X-Hub-Signature-256": "sha256=" + signWebhookBody("", githubPushBody),
+			},
+			body:         githubPushBody,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:       "UnknownRepository",
+			repository: "missing",
+			headers: map[string]string{
+				"X-GitHub-Event":      "push",
+				"X-Hub-Signature-256": "sha256=" + signWebhookBody(testWebhookSecret, githubPushBody),
+			},
+			body:         githubPushBody,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "UnknownProvider",
+			body:         githubPushBody,
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockService := service.NewMockService(ctrl)
+			repository := lo.Ternary(tt.repository != "", tt.repository, "fleets")
+			mockService.EXPECT().GetRepository(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(ctx context.Context, name string) (*api.Repository, api.Status) {
+					switch name {
+					case "fleets":
+						return newWebhookTestRepository(t, name, lo.ToPtr(testWebhookSecret)), api.StatusOK()
+					case "no-secret":
+						return newWebhookTestRepository(t, name, nil), api.StatusOK()
+					default:
+						return nil, api.StatusResourceNotFound(api.RepositoryKind, name)
+					}
+				}).AnyTimes()
+			triggered := []api.ObjectReference{{Kind: api.ResourceSyncKind, Name: "rs"}}
+			if tt.expectPush != nil {
+				mockService.EXPECT().HandleGitPush(gomock.Any(), repository, *tt.expectPush).DoAndReturn(
+					func(ctx context.Context, repository string, push service.GitPush) ([]api.ObjectReference, api.Status) {
+						return triggered, api.StatusOK()
+					})
+			}
+
+			router := chi.NewRouter()
+			NewGitWebhookHandler(mockService, logrus.New()).RegisterRoutes(router)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/git/"+repository, strings.NewReader(tt.body))
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.expectedCode, rec.Code)
+			if tt.expectedCode == http.StatusAccepted {
+				var response GitWebhookResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, triggered, response.Triggered)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/flightctl/flightctl/internal/api/server"
 	"github.com/flightctl/flightctl/internal/auth"
 	"github.com/flightctl/flightctl/internal/console"
+	"github.com/flightctl/flightctl/internal/console/recording"
 	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/go-chi/chi/v5"
@@ -11,8 +12,9 @@ import (
 )
 
 type TransportHandler struct {
-	serviceHandler service.Service
-	authN          auth.AuthNMiddleware
+	serviceHandler    service.Service
+	authN             auth.AuthNMiddleware
+	consoleRecordings recording.Sink
 }
 
 type WebsocketHandler struct {
@@ -24,9 +26,9 @@ type WebsocketHandler struct {
 // Make sure we conform to servers Transport interface
 var _ server.Transport = (*TransportHandler)(nil)
 
-func NewTransportHandler(serviceHandler service.Service, authN auth.AuthNMiddleware) *TransportHandler {
+func NewTransportHandler(serviceHandler service.Service, authN auth.AuthNMiddleware, consoleRecordings recording.Sink) *TransportHandler {
 
-	return &TransportHandler{serviceHandler: serviceHandler, authN: authN}
+	return &TransportHandler{serviceHandler: serviceHandler, authN: authN, consoleRecordings: consoleRecordings}
 }
 
 func NewWebsocketHandler(ca *crypto.CAClient, log logrus.FieldLogger, consoleSessionManager *console.ConsoleSessionManager) *WebsocketHandler {
@@ -0,0 +1,102 @@
+package transport
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// CRLPath is the distribution point of the CA's certificate revocation list
+	CRLPath = "/pki/v1/crl"
+	// OCSPPath is the location of the CA's OCSP responder
+	OCSPPath = "/pki/v1/ocsp"
+
+	// maxOCSPRequestSize bounds OCSP requests, which are a few hundred bytes in practice
+	maxOCSPRequestSize = 64 * 1024
+)
+
+// PKIHandler publishes the revocation status of the certificates issued by the CA.  Relying parties typically
+// can't authenticate, so the endpoints are served without authentication; the responses are signed by the CA.
+type PKIHandler struct {
+	serviceHandler service.Service
+	log            logrus.FieldLogger
+}
+
+func NewPKIHandler(serviceHandler service.Service, log logrus.FieldLogger) *PKIHandler {
+	return &PKIHandler{
+		serviceHandler: serviceHandler,
+		log:            log,
+	}
+}
+
+func (h *PKIHandler) RegisterRoutes(r chi.Router) {
+	r.Get(CRLPath, h.GetCertificateRevocationList)
+	r.Post(OCSPPath, h.PostOCSPRequest)
+	r.Get(OCSPPath+"/*", h.GetOCSPRequest)
+}
+
+// (GET /pki/v1/crl)
+func (h *PKIHandler) GetCertificateRevocationList(w http.ResponseWriter, r *http.Request) {
+	crl, status := h.serviceHandler.GetCertificateRevocationList(r.Context())
+	if status.Code != http.StatusOK {
+		SetResponse(w, nil, status)
+		return
+	}
+	h.writeDER(w, "application/pkix-crl", crl)
+}
+
+// (POST /pki/v1/ocsp)
+func (h *PKIHandler) PostOCSPRequest(w http.ResponseWriter, r *http.Request) {
+	if contentType := r.Header.Get("Content-Type"); contentType != "" && contentType != "application/ocsp-request" {
+		SetResponse(w, nil, api.StatusBadRequest(fmt.Sprintf("unsupported content type %q", contentType)))
+		return
+	}
+	request, err := io.ReadAll(io.LimitReader(r.Body, maxOCSPRequestSize))
+	if err != nil {
+		SetResponse(w, nil, api.StatusBadRequest(fmt.Sprintf("failed to read request body: %v", err)))
+		return
+	}
+	h.respondOCSP(w, r, request)
+}
+
+// (GET /pki/v1/ocsp/{request})
+// The request is the base64 encoding of the DER-encoded OCSP request, URL-encoded as described in RFC 6960 appendix A.
+func (h *PKIHandler) GetOCSPRequest(w http.ResponseWriter, r *http.Request) {
+	encoded, err := url.PathUnescape(strings.TrimPrefix(chi.URLParam(r, "*"), "/"))
+	if err != nil {
+		SetResponse(w, nil, api.StatusBadRequest(fmt.Sprintf("invalid OCSP request encoding: %v", err)))
+		return
+	}
+	request, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		SetResponse(w, nil, api.StatusBadRequest(fmt.Sprintf("invalid OCSP request encoding: %v", err)))
+		return
+	}
+	h.respondOCSP(w, r, request)
+}
+
+func (h *PKIHandler) respondOCSP(w http.ResponseWriter, r *http.Request, request []byte) {
+	resp, status := h.serviceHandler.GetOCSPResponse(r.Context(), request)
+	if status.Code != http.StatusOK {
+		SetResponse(w, nil, status)
+		return
+	}
+	h.writeDER(w, "application/ocsp-response", resp)
+}
+
+func (h *PKIHandler) writeDER(w http.ResponseWriter, contentType string, body []byte) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(body); err != nil {
+		h.log.Errorf("failed to write response: %v", err)
+	}
+}
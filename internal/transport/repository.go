@@ -5,17 +5,18 @@ import (
 	"net/http"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
 )
 
 // (POST /api/v1/repositories)
-func (h *TransportHandler) CreateRepository(w http.ResponseWriter, r *http.Request) {
+func (h *TransportHandler) CreateRepository(w http.ResponseWriter, r *http.Request, params api.CreateRepositoryParams) {
 	var rs api.Repository
 	if err := json.NewDecoder(r.Body).Decode(&rs); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.CreateRepository(r.Context(), rs)
+	body, status := h.serviceHandler.CreateRepository(service.WithDryRun(r.Context(), params.DryRun), rs)
 	SetResponse(w, body, status)
 }
 
@@ -32,14 +33,14 @@ func (h *TransportHandler) GetRepository(w http.ResponseWriter, r *http.Request,
 }
 
 // (PUT /api/v1/repositories/{name})
-func (h *TransportHandler) ReplaceRepository(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) ReplaceRepository(w http.ResponseWriter, r *http.Request, name string, params api.ReplaceRepositoryParams) {
 	var rs api.Repository
 	if err := json.NewDecoder(r.Body).Decode(&rs); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.ReplaceRepository(r.Context(), name, rs)
+	body, status := h.serviceHandler.ReplaceRepository(service.WithDryRun(r.Context(), params.DryRun), name, rs)
 	SetResponse(w, body, status)
 }
 
@@ -50,13 +51,13 @@ func (h *TransportHandler) DeleteRepository(w http.ResponseWriter, r *http.Reque
 }
 
 // (PATCH /api/v1/repositories/{name})
-func (h *TransportHandler) PatchRepository(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) PatchRepository(w http.ResponseWriter, r *http.Request, name string, params api.PatchRepositoryParams) {
 	var patch api.PatchRequest
 	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.PatchRepository(r.Context(), name, patch)
+	body, status := h.serviceHandler.PatchRepository(service.WithDryRun(r.Context(), params.DryRun), name, patch)
 	SetResponse(w, body, status)
 }
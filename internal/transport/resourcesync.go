@@ -5,17 +5,18 @@ import (
 	"net/http"
 
 	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/service"
 )
 
 // (POST /api/v1/resourcesyncs)
-func (h *TransportHandler) CreateResourceSync(w http.ResponseWriter, r *http.Request) {
+func (h *TransportHandler) CreateResourceSync(w http.ResponseWriter, r *http.Request, params api.CreateResourceSyncParams) {
 	var rs api.ResourceSync
 	if err := json.NewDecoder(r.Body).Decode(&rs); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.CreateResourceSync(r.Context(), rs)
+	body, status := h.serviceHandler.CreateResourceSync(service.WithDryRun(r.Context(), params.DryRun), rs)
 	SetResponse(w, body, status)
 }
 
@@ -32,14 +33,14 @@ func (h *TransportHandler) GetResourceSync(w http.ResponseWriter, r *http.Reques
 }
 
 // (PUT /api/v1/resourcesyncs/{name})
-func (h *TransportHandler) ReplaceResourceSync(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) ReplaceResourceSync(w http.ResponseWriter, r *http.Request, name string, params api.ReplaceResourceSyncParams) {
 	var rs api.ResourceSync
 	if err := json.NewDecoder(r.Body).Decode(&rs); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.ReplaceResourceSync(r.Context(), name, rs)
+	body, status := h.serviceHandler.ReplaceResourceSync(service.WithDryRun(r.Context(), params.DryRun), name, rs)
 	SetResponse(w, body, status)
 }
 
@@ -50,13 +51,13 @@ func (h *TransportHandler) DeleteResourceSync(w http.ResponseWriter, r *http.Req
 }
 
 // (PATCH /api/v1/resourcesyncs/{name})
-func (h *TransportHandler) PatchResourceSync(w http.ResponseWriter, r *http.Request, name string) {
+func (h *TransportHandler) PatchResourceSync(w http.ResponseWriter, r *http.Request, name string, params api.PatchResourceSyncParams) {
 	var patch api.PatchRequest
 	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
 		SetParseFailureResponse(w, err)
 		return
 	}
 
-	body, status := h.serviceHandler.PatchResourceSync(r.Context(), name, patch)
+	body, status := h.serviceHandler.PatchResourceSync(service.WithDryRun(r.Context(), params.DryRun), name, patch)
 	SetResponse(w, body, status)
 }
@@ -0,0 +1,37 @@
+package transport
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+)
+
+// SetWatchResponse streams the watch events as newline-delimited JSON, flushing each event as it is written.  The
+// service stops the watch once the request context is done, which happens when the client leaves or this returns.
+func SetWatchResponse(w http.ResponseWriter, events <-chan api.WatchEvent, status api.Status) {
+	if status.Code != http.StatusOK {
+		SetResponse(w, nil, status)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	// the write timeout of the server is meant for regular requests, a watch stays open until the client leaves
+	_ = rc.SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	_ = rc.Flush()
+
+	encoder := json.NewEncoder(w)
+	for event := range events {
+		if err := encoder.Encode(event); err != nil {
+			break
+		}
+		if err := rc.Flush(); err != nil {
+			break
+		}
+	}
+}
@@ -3,6 +3,7 @@ package validation
 import (
 	"encoding/base64"
 	"fmt"
+	"net"
 	"path/filepath"
 	"regexp"
 	"strconv"
@@ -82,10 +83,15 @@ func ValidateStringMap(m *map[string]string, path string, minLen int, maxLen int
 
 // ValidateAnnotations validates that a set of annotations are valid K8s annotations.
 func ValidateAnnotations(annotations *map[string]string) []error {
+	return ValidateAnnotationsWithPath(annotations, "metadata.annotations")
+}
+
+// ValidateAnnotationsWithPath validates that a set of annotations are valid K8s annotations, with path being the path to the annotation field.
+func ValidateAnnotationsWithPath(annotations *map[string]string, path string) []error {
 	if annotations == nil {
 		return []error{}
 	}
-	errs := k8sapivalidation.ValidateAnnotations(*annotations, fieldPathFor("metadata.annotations"))
+	errs := k8sapivalidation.ValidateAnnotations(*annotations, fieldPathFor(path))
 	return asErrors(errs)
 }
 
@@ -258,6 +264,13 @@ func ValidateBearerToken(token *string, path string) []error {
 	return asErrors(errs)
 }
 
+// IsPublicIP returns false for the loopback, private, link-local, unspecified and multicast addresses, which a
+// request sent by the service on behalf of a user must not reach.
+func IsPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsMulticast() && !ip.IsUnspecified()
+}
+
 func ValidateCSRUsages(u *[]string) []error {
 	errs := field.ErrorList{}
 	requiredAllOf := map[string]struct{}{
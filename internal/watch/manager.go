@@ -0,0 +1,148 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// watcherBufferSize is the number of notifications buffered for a watcher.  A watcher that falls further behind
+// is closed and has to watch again.
+const watcherBufferSize = 256
+
+// Watcher receives the notifications for one kind of resource in an organization.  Its channel is closed when
+// the watcher is stopped or when it did not keep up with the notifications.
+type Watcher struct {
+	orgId  uuid.UUID
+	kind   api.ResourceKind
+	ch     chan Notification
+	mu     sync.Mutex
+	closed bool
+}
+
+func (w *Watcher) ResultChan() <-chan Notification {
+	return w.ch
+}
+
+// send delivers the notification without blocking and reports whether the watcher is still open
+func (w *Watcher) send(n Notification) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.closed {
+		return false
+	}
+	select {
+	case w.ch <- n:
+		return true
+	default:
+		w.closed = true
+		close(w.ch)
+		return false
+	}
+}
+
+func (w *Watcher) close() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.closed {
+		w.closed = true
+		close(w.ch)
+	}
+}
+
+type Manager struct {
+	subscriber  Subscriber
+	broadcaster Publisher
+	watchers    sync.Map
+	log         logrus.FieldLogger
+}
+
+type BusType struct {
+	util.Singleton[Manager]
+}
+
+func (b *BusType) Initialize(ctx context.Context, provider queues.Provider, log logrus.FieldLogger) error {
+	m, err := newManager(ctx, provider, log)
+	if err != nil {
+		return err
+	}
+	_ = b.GetOrInit(m)
+	return nil
+}
+
+var Bus BusType
+
+func newManager(ctx context.Context, provider queues.Provider, log logrus.FieldLogger) (*Manager, error) {
+	broadcaster, err := NewBroadcaster(ctx, provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create publisher for resource watch: %v", err)
+	}
+	subscriber, err := NewSubscriber(ctx, provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subscriber for resource watch: %v", err)
+	}
+	return &Manager{
+		broadcaster: broadcaster,
+		subscriber:  subscriber,
+		log:         log,
+	}, nil
+}
+
+// Notify publishes a change of a resource to the watchers of all service instances.  It does nothing if the bus
+// was not initialized, as is the case in processes that do not serve watches nor change watched resources.
+func (m *Manager) Notify(ctx context.Context, orgId uuid.UUID, kind api.ResourceKind, name string, eventType api.WatchEventType) {
+	if m.broadcaster == nil {
+		return
+	}
+	n := Notification{OrgId: orgId, Kind: kind, Name: name, Type: eventType}
+	if err := m.broadcaster.Publish(ctx, n); err != nil && m.log != nil {
+		m.log.WithError(err).Warnf("failed to publish %s change of %s %s/%s", eventType, kind, orgId, name)
+	}
+}
+
+// Watch registers a watcher for the resources of the given kind in the organization.  The watcher must be
+// stopped with Stop.
+func (m *Manager) Watch(orgId uuid.UUID, kind api.ResourceKind) *Watcher {
+	w := &Watcher{
+		orgId: orgId,
+		kind:  kind,
+		ch:    make(chan Notification, watcherBufferSize),
+	}
+	m.watchers.Store(w, struct{}{})
+	return w
+}
+
+func (m *Manager) Stop(w *Watcher) {
+	m.watchers.Delete(w)
+	w.close()
+}
+
+func (m *Manager) consumeHandler(_ context.Context, n Notification) error {
+	m.watchers.Range(func(key, _ any) bool {
+		w := key.(*Watcher)
+		if w.orgId != n.OrgId || w.kind != n.Kind {
+			return true
+		}
+		if !w.send(n) {
+			m.log.Warnf("watcher of %s in %s fell behind, closing it", n.Kind, n.OrgId)
+			m.watchers.Delete(w)
+		}
+		return true
+	})
+	return nil
+}
+
+func (m *Manager) Start(ctx context.Context) error {
+	err := m.subscriber.Subscribe(ctx, m.consumeHandler)
+	if err != nil {
+		m.log.Errorf("failed to consume resource watch notifications: %v", err)
+		return err
+	}
+	return nil
+}
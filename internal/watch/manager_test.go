@@ -0,0 +1,89 @@
+package watch
+
+import (
+	"context"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// loopbackPublisher delivers published notifications directly to the manager, like a single service instance
+type loopbackPublisher struct {
+	m *Manager
+}
+
+func (p *loopbackPublisher) Publish(ctx context.Context, n Notification) error {
+	return p.m.consumeHandler(ctx, n)
+}
+
+func newTestManager() *Manager {
+	m := &Manager{log: logrus.New()}
+	m.broadcaster = &loopbackPublisher{m: m}
+	return m
+}
+
+func TestWatchDispatch(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m := newTestManager()
+	orgId := uuid.New()
+
+	devices := m.Watch(orgId, api.DeviceKind)
+	defer m.Stop(devices)
+	fleets := m.Watch(orgId, api.FleetKind)
+	defer m.Stop(fleets)
+	otherOrg := m.Watch(uuid.New(), api.DeviceKind)
+	defer m.Stop(otherOrg)
+
+	m.Notify(ctx, orgId, api.DeviceKind, "dev1", api.WatchEventModified)
+
+	require.Len(devices.ResultChan(), 1)
+	n := <-devices.ResultChan()
+	require.Equal(Notification{OrgId: orgId, Kind: api.DeviceKind, Name: "dev1", Type: api.WatchEventModified}, n)
+	require.Empty(fleets.ResultChan())
+	require.Empty(otherOrg.ResultChan())
+}
+
+func TestWatchStop(t *testing.T) {
+	require := require.New(t)
+	m := newTestManager()
+	orgId := uuid.New()
+
+	w := m.Watch(orgId, api.DeviceKind)
+	m.Stop(w)
+	// stopping twice is harmless
+	m.Stop(w)
+
+	m.Notify(context.Background(), orgId, api.DeviceKind, "dev1", api.WatchEventAdded)
+	_, ok := <-w.ResultChan()
+	require.False(ok)
+}
+
+func TestWatchSlowWatcherIsClosed(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	m := newTestManager()
+	orgId := uuid.New()
+
+	w := m.Watch(orgId, api.DeviceKind)
+	defer m.Stop(w)
+	for i := 0; i <= watcherBufferSize; i++ {
+		m.Notify(ctx, orgId, api.DeviceKind, "dev1", api.WatchEventModified)
+	}
+
+	received := 0
+	for range w.ResultChan() {
+		received++
+	}
+	require.Equal(watcherBufferSize, received)
+}
+
+func TestNotifyWithoutBus(t *testing.T) {
+	var bus BusType
+	require.NotPanics(t, func() {
+		bus.Instance().Notify(context.Background(), uuid.New(), api.DeviceKind, "dev1", api.WatchEventAdded)
+	})
+}
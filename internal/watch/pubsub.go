@@ -0,0 +1,93 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const channelName = "resource_watch_notifier"
+
+// Notification announces that a resource changed.  It does not carry the resource itself, watchers read the
+// current state of the resource from the store so that their selectors are evaluated against it.
+type Notification struct {
+	OrgId uuid.UUID          `json:"org_id"`
+	Kind  api.ResourceKind   `json:"kind"`
+	Name  string             `json:"name"`
+	Type  api.WatchEventType `json:"type"`
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, notification Notification) error
+}
+
+type Subscriber interface {
+	Subscribe(ctx context.Context, handler func(ctx context.Context, notification Notification) error) error
+}
+
+func NewBroadcaster(ctx context.Context, queuesProvider queues.Provider) (Publisher, error) {
+	queuesPublisher, err := queuesProvider.NewPubSubPublisher(ctx, channelName)
+	if err != nil {
+		return nil, err
+	}
+	return &publisher{
+		broadcaster: queuesPublisher,
+	}, nil
+}
+
+func NewSubscriber(ctx context.Context, queuesProvider queues.Provider) (Subscriber, error) {
+	subscriber, err := queuesProvider.NewPubSubSubscriber(ctx, channelName)
+	if err != nil {
+		return nil, err
+	}
+	return &consumer{
+		subscriber: subscriber,
+	}, nil
+}
+
+type publisher struct {
+	broadcaster queues.PubSubPublisher
+}
+
+func (p *publisher) Publish(ctx context.Context, notification Notification) error {
+	b, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	return p.broadcaster.Publish(ctx, b)
+}
+
+type consumer struct {
+	subscriber    queues.PubSubSubscriber
+	subscriptions []queues.Subscription
+}
+
+func (c *consumer) Subscribe(ctx context.Context, handler func(ctx context.Context, notification Notification) error) error {
+	queuesHandler := func(ctx context.Context, payload []byte, log logrus.FieldLogger) error {
+		var n Notification
+		if err := json.Unmarshal(payload, &n); err != nil {
+			log.WithError(err).Error("failed to unmarshal payload")
+			return err
+		}
+		return handler(ctx, n)
+	}
+
+	subscription, err := c.subscriber.Subscribe(ctx, queuesHandler)
+	if err != nil {
+		return err
+	}
+	c.subscriptions = append(c.subscriptions, subscription)
+	return nil
+}
+
+func (c *consumer) Close() {
+	for _, sub := range c.subscriptions {
+		sub.Close()
+	}
+	c.subscriptions = nil
+	c.subscriber.Close()
+}
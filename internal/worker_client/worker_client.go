@@ -81,6 +81,9 @@ var eventReasons = map[api.EventReason]struct{}{
 	api.EventReasonFleetRolloutDeviceSelected:  {},
 	api.EventReasonFleetRolloutBatchDispatched: {},
 	api.EventReasonDeviceConflictResolved:      {},
+	api.EventReasonResourceSyncSyncRequested:   {},
+	api.EventReasonRepositoryPushReceived:      {},
+	api.EventReasonBulkOperationProgressed:     {},
 }
 
 func shouldEmitEvent(reason api.EventReason) bool {
@@ -16,6 +16,7 @@ import (
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/tasks"
+	"github.com/flightctl/flightctl/internal/watch"
 	"github.com/flightctl/flightctl/internal/worker_client"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
 	"github.com/flightctl/flightctl/pkg/queues"
@@ -70,6 +71,10 @@ func (s *Server) Run(ctx context.Context) error {
 		s.log.WithError(err).Error("failed to create rendered version manager")
 		return err
 	}
+	if err = watch.Bus.Initialize(ctx, s.queuesProvider, s.log); err != nil {
+		s.log.WithError(err).Error("failed to create resource watch manager")
+		return err
+	}
 
 	orgCache := cache.NewOrganizationTTL(cache.DefaultTTL)
 	go orgCache.Start()
@@ -96,7 +101,11 @@ func (s *Server) Run(ctx context.Context) error {
 	serviceHandler := service.WrapWithTracing(
 		service.NewServiceHandler(s.store, workerClient, kvStore, nil, s.log, "", "", []string{}, orgResolver))
 
-	if err = tasks.LaunchConsumers(ctx, s.queuesProvider, serviceHandler, s.k8sClient, kvStore, 1, 1, s.workerMetrics); err != nil {
+	var ignoreResourceUpdates []string
+	if s.cfg.GitOps != nil {
+		ignoreResourceUpdates = s.cfg.GitOps.IgnoreResourceUpdates
+	}
+	if err = tasks.LaunchConsumers(ctx, s.queuesProvider, serviceHandler, s.k8sClient, kvStore, ignoreResourceUpdates, 1, 1, s.workerMetrics); err != nil {
 		s.log.WithError(err).Error("failed to launch consumers")
 		return err
 	}
@@ -441,7 +441,7 @@ echo "Flightctl Observability Stack uninstalled."
     mkdir -p %{buildroot}/usr/lib/systemd/system
     mkdir -p %{buildroot}/usr/lib/tmpfiles.d
     mkdir -p %{buildroot}/usr/lib/flightctl/custom-info.d
-    mkdir -p %{buildroot}/usr/lib/flightctl/hooks.d/{afterupdating,beforeupdating,afterrebooting,beforerebooting}
+    mkdir -p %{buildroot}/usr/lib/flightctl/hooks.d/{afterupdating,beforeupdating,afterrebooting,beforerebooting,beforeapplicationstart,afterapplicationstart,onrollback,onupdatefailed}
     mkdir -p %{buildroot}/usr/lib/greenboot/check/required.d
     install -m 0755 packaging/greenboot/flightctl-agent-running-check.sh %{buildroot}/usr/lib/greenboot/check/required.d/20_check_flightctl_agent.sh
     cp bin/flightctl-agent %{buildroot}/usr/bin
@@ -799,7 +799,7 @@ func (h *Harness) CreateRepository(repositorySpec v1alpha1.RepositorySpec, metad
 		Metadata: metadata,
 		Spec:     repositorySpec,
 	}
-	_, err := h.Client.CreateRepositoryWithResponse(h.Context, repository)
+	_, err := h.Client.CreateRepositoryWithResponse(h.Context, nil, repository)
 	return err
 }
 
@@ -812,7 +812,7 @@ func (h *Harness) ReplaceRepository(repositorySpec v1alpha1.RepositorySpec, meta
 		Metadata: metadata,
 		Spec:     repositorySpec,
 	}
-	_, err := h.Client.ReplaceRepositoryWithResponse(h.Context, *metadata.Name, repository)
+	_, err := h.Client.ReplaceRepositoryWithResponse(h.Context, *metadata.Name, nil, repository)
 	return err
 }
 
@@ -1793,7 +1793,7 @@ func (h *Harness) CreateGitRepository(repoName string, repositorySpec v1alpha1.R
 		Spec: repositorySpec,
 	}
 
-	_, err := h.Client.CreateRepositoryWithResponse(h.Context, repository)
+	_, err := h.Client.CreateRepositoryWithResponse(h.Context, nil, repository)
 	if err != nil {
 		// Clean up the git repository if Repository resource creation fails
 		if cleanupErr := h.DeleteGitRepositoryOnServer(repoName); cleanupErr != nil {
@@ -1844,7 +1844,7 @@ func (h *Harness) CreateResourceSync(name, repoName string, spec v1alpha1.Resour
 		Spec: spec,
 	}
 
-	_, err := h.Client.CreateResourceSyncWithResponse(h.Context, resourceSync)
+	_, err := h.Client.CreateResourceSyncWithResponse(h.Context, nil, resourceSync)
 	if err != nil {
 		return fmt.Errorf("failed to create ResourceSync: %w", err)
 	}
@@ -1876,7 +1876,7 @@ func (h *Harness) ReplaceResourceSync(name, repoName string, spec v1alpha1.Resou
 		Spec: spec,
 	}
 
-	_, err := h.Client.ReplaceResourceSyncWithResponse(h.Context, name, resourceSync)
+	_, err := h.Client.ReplaceResourceSyncWithResponse(h.Context, name, nil, resourceSync)
 	if err != nil {
 		return fmt.Errorf("failed to replace ResourceSync: %w", err)
 	}
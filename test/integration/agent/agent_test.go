@@ -148,7 +148,7 @@ var _ = Describe("Device Agent behavior", func() {
 					secondSecretKey: secondSecretValue,
 				}
 				mockSecret(h.GetMockK8sClient(), secrets)
-				resp, err := h.Client.CreateFleetWithResponse(h.Context, getTestFleet("fleet.yaml"))
+				resp, err := h.Client.CreateFleetWithResponse(h.Context, nil, getTestFleet("fleet.yaml"))
 				Expect(err).ToNot(HaveOccurred())
 				Expect(resp.HTTPResponse.StatusCode).To(Equal(http.StatusCreated))
 				approval := testutil.TestEnrollmentApproval()
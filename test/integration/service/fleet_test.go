@@ -48,3 +48,101 @@ var _ = Describe("Fleet create", func() {
 		Entry("Non-internal request should nil owner", false, ""),
 	)
 })
+
+var _ = Describe("Fleet preview", func() {
+	var (
+		suite       *ServiceTestSuite
+		internalCtx context.Context
+	)
+
+	BeforeEach(func() {
+		suite = NewServiceTestSuite()
+		suite.Setup()
+		internalCtx = context.WithValue(suite.Ctx, consts.InternalRequestCtxKey, true)
+	})
+
+	AfterEach(func() {
+		suite.Teardown()
+	})
+
+	createFleet := func(name string, matchLabels map[string]string) api.Fleet {
+		fleet := api.Fleet{
+			Metadata: api.ObjectMeta{Name: lo.ToPtr(name)},
+			Spec: api.FleetSpec{
+				Selector: &api.LabelSelector{MatchLabels: &matchLabels},
+			},
+		}
+		fleet.Spec.Template.Spec = api.DeviceSpec{
+			Os: &api.DeviceOsSpec{Image: "quay.io/flightctl/device:{{ .metadata.labels.site }}"},
+		}
+		_, status := suite.Handler.ReplaceFleet(suite.Ctx, name, fleet)
+		Expect(status.Code).To(Equal(int32(http.StatusCreated)))
+		return fleet
+	}
+
+	createDevice := func(name string, labels map[string]string, ownerFleet string) {
+		device := api.Device{
+			Metadata: api.ObjectMeta{
+				Name:   lo.ToPtr(name),
+				Labels: &labels,
+			},
+			Spec: &api.DeviceSpec{
+				Os: &api.DeviceOsSpec{Image: "quay.io/flightctl/device:v1"},
+			},
+		}
+		if ownerFleet != "" {
+			device.Metadata.Owner = lo.ToPtr("Fleet/" + ownerFleet)
+		}
+		_, status := suite.Handler.ReplaceDevice(internalCtx, name, device, nil)
+		Expect(status.Code).To(Equal(int32(http.StatusCreated)))
+	}
+
+	It("reports the devices gained, lost and newly conflicting", func() {
+		fleet := createFleet("site", map[string]string{"site": "a"})
+		createFleet("zone", map[string]string{"zone": "1"})
+		createDevice("dev-lost", map[string]string{"site": "a"}, "site")
+		createDevice("dev-gained", map[string]string{"site": "b"}, "")
+		createDevice("dev-conflict", map[string]string{"site": "b", "zone": "1"}, "zone")
+		createDevice("dev-other", map[string]string{"site": "c"}, "")
+
+		fleet.Spec.Selector.MatchLabels = &map[string]string{"site": "b"}
+		preview, status := suite.Handler.PreviewFleet(suite.Ctx, "site", fleet, api.PreviewFleetParams{})
+		Expect(status.Code).To(Equal(int32(http.StatusOK)))
+
+		Expect(preview.Summary).To(Equal(api.FleetPreviewSummary{
+			MatchingDevices: 2,
+			DevicesGained:   1,
+			DevicesLost:     1,
+			NewConflicts:    1,
+		}))
+		Expect(preview.DevicesGained).To(HaveLen(1))
+		Expect(preview.DevicesGained[0].Name).To(Equal("dev-gained"))
+		Expect(lo.FromPtr(preview.DevicesGained[0].NewOwner)).To(Equal("Fleet/site"))
+		Expect(preview.DevicesLost).To(HaveLen(1))
+		Expect(preview.DevicesLost[0].Name).To(Equal("dev-lost"))
+		Expect(preview.DevicesLost[0].NewOwner).To(BeNil())
+		Expect(preview.NewConflicts).To(HaveLen(1))
+		Expect(preview.NewConflicts[0].Name).To(Equal("dev-conflict"))
+		Expect(lo.FromPtr(preview.NewConflicts[0].NewOwner)).To(Equal("Fleet/zone"))
+		Expect(lo.FromPtr(preview.NewConflicts[0].MatchingFleets)).To(Equal([]string{"site", "zone"}))
+
+		Expect(preview.Samples).To(HaveLen(1))
+		Expect(preview.Samples[0].Name).To(Equal("dev-gained"))
+		Expect(preview.Samples[0].CurrentSpec.Os.Image).To(Equal("quay.io/flightctl/device:v1"))
+		Expect(preview.Samples[0].RenderedSpec.Os.Image).To(Equal("quay.io/flightctl/device:b"))
+
+		// the preview doesn't change the fleet
+		current, status := suite.Handler.GetFleet(suite.Ctx, "site", api.GetFleetParams{})
+		Expect(status.Code).To(Equal(int32(http.StatusOK)))
+		Expect(lo.FromPtr(current.Spec.Selector.MatchLabels)).To(Equal(map[string]string{"site": "a"}))
+	})
+
+	It("rejects a fleet whose name doesn't match", func() {
+		fleet := createFleet("site", map[string]string{"site": "a"})
+		_, status := suite.Handler.PreviewFleet(suite.Ctx, "other", fleet, api.PreviewFleetParams{})
+		Expect(status.Code).To(Equal(int32(http.StatusBadRequest)))
+
+		_, status = suite.Handler.PreviewFleet(suite.Ctx, "site", fleet, api.PreviewFleetParams{SampleSize: lo.ToPtr(int32(1000))})
+		Expect(status.Code).To(Equal(int32(http.StatusBadRequest)))
+	})
+})
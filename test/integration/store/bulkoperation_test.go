@@ -0,0 +1,103 @@
+package store_test
+
+import (
+	"context"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	testutil "github.com/flightctl/flightctl/test/util"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("BulkOperationStore", func() {
+	var (
+		log       *logrus.Logger
+		ctx       context.Context
+		orgId     uuid.UUID
+		storeInst store.Store
+		cfg       *config.Config
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.StartSpecTracerForGinkgo(suiteCtx)
+		log = flightlog.InitLogs()
+		storeInst, cfg, dbName, _ = store.PrepareDBForUnitTests(ctx, log)
+
+		orgId = uuid.New()
+		err := testutil.CreateTestOrganization(ctx, storeInst, orgId)
+		Expect(err).ToNot(HaveOccurred())
+
+		operation := api.BulkOperation{
+			Metadata: api.ObjectMeta{Name: lo.ToPtr("migrate-paris")},
+			Spec: api.BulkOperationSpec{
+				Selector:  api.BulkOperationSelector{LabelSelector: lo.ToPtr("site=paris")},
+				Operation: api.BulkOperationAction{Type: api.BulkOperationTypeAddLabels, Labels: &map[string]string{"site": "berlin"}},
+			},
+			Status: &api.BulkOperationStatus{Phase: api.BulkOperationPhasePending, MatchedDevices: 2},
+		}
+		_, err = storeInst.BulkOperation().Create(ctx, orgId, &operation, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		store.DeleteTestDB(ctx, log, cfg, storeInst, dbName)
+	})
+
+	It("Get returns the spec and status", func() {
+		operation, err := storeInst.BulkOperation().Get(ctx, orgId, "migrate-paris")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operation.Spec.Operation.Type).To(Equal(api.BulkOperationTypeAddLabels))
+		Expect(operation.Status.Phase).To(Equal(api.BulkOperationPhasePending))
+		Expect(operation.Status.MatchedDevices).To(Equal(int64(2)))
+	})
+
+	It("UpdateStatus updates the status of the version that was read", func() {
+		operation, err := storeInst.BulkOperation().Get(ctx, orgId, "migrate-paris")
+		Expect(err).ToNot(HaveOccurred())
+
+		operation.Status.Phase = api.BulkOperationPhaseRunning
+		operation.Status.ProcessedDevices = 1
+		updated, err := storeInst.BulkOperation().UpdateStatus(ctx, orgId, operation)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated.Status.Phase).To(Equal(api.BulkOperationPhaseRunning))
+		Expect(*updated.Metadata.ResourceVersion).ToNot(Equal(*operation.Metadata.ResourceVersion))
+
+		operation, err = storeInst.BulkOperation().Get(ctx, orgId, "migrate-paris")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operation.Status.ProcessedDevices).To(Equal(int64(1)))
+	})
+
+	It("UpdateStatus fails if the operation changed since it was read", func() {
+		stale, err := storeInst.BulkOperation().Get(ctx, orgId, "migrate-paris")
+		Expect(err).ToNot(HaveOccurred())
+
+		canceled, err := storeInst.BulkOperation().Get(ctx, orgId, "migrate-paris")
+		Expect(err).ToNot(HaveOccurred())
+		canceled.Status.Phase = api.BulkOperationPhaseCanceled
+		_, err = storeInst.BulkOperation().UpdateStatus(ctx, orgId, canceled)
+		Expect(err).ToNot(HaveOccurred())
+
+		stale.Status.Phase = api.BulkOperationPhaseRunning
+		_, err = storeInst.BulkOperation().UpdateStatus(ctx, orgId, stale)
+		Expect(err).To(MatchError(flterrors.ErrNoRowsUpdated))
+
+		operation, err := storeInst.BulkOperation().Get(ctx, orgId, "migrate-paris")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(operation.Status.Phase).To(Equal(api.BulkOperationPhaseCanceled))
+	})
+
+	It("Delete removes the operation", func() {
+		err := storeInst.BulkOperation().Delete(ctx, orgId, "migrate-paris", nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = storeInst.BulkOperation().Get(ctx, orgId, "migrate-paris")
+		Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+	})
+})
@@ -0,0 +1,100 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	testutil "github.com/flightctl/flightctl/test/util"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ocsp"
+)
+
+var _ = Describe("CertificateRevocationStore", func() {
+	var (
+		log       *logrus.Logger
+		ctx       context.Context
+		orgId     uuid.UUID
+		storeInst store.Store
+		cfg       *config.Config
+		dbName    string
+		revokedAt time.Time
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.StartSpecTracerForGinkgo(suiteCtx)
+		log = flightlog.InitLogs()
+		storeInst, cfg, dbName, _ = store.PrepareDBForUnitTests(ctx, log)
+		orgId = uuid.New()
+		revokedAt = time.Now().Add(-time.Minute).UTC().Truncate(time.Second)
+
+		for _, revocation := range []model.CertificateRevocation{
+			{SerialNumber: "1a", OrgID: orgId, CommonName: "device-a", Reason: ocsp.CessationOfOperation, RevokedAt: revokedAt, NotAfter: time.Now().Add(time.Hour)},
+			{SerialNumber: "1b", OrgID: orgId, CommonName: "device-b", Reason: ocsp.CessationOfOperation, RevokedAt: revokedAt, NotAfter: time.Now().Add(-time.Hour)},
+		} {
+			err := storeInst.CertificateRevocation().Revoke(ctx, &revocation)
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	AfterEach(func() {
+		store.DeleteTestDB(ctx, log, cfg, storeInst, dbName)
+	})
+
+	It("IsRevoked reports revoked serial numbers", func() {
+		revoked, err := storeInst.CertificateRevocation().IsRevoked(ctx, "1a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeTrue())
+
+		revoked, err = storeInst.CertificateRevocation().IsRevoked(ctx, "2a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revoked).To(BeFalse())
+	})
+
+	It("Revoke keeps the original revocation", func() {
+		err := storeInst.CertificateRevocation().Revoke(ctx, &model.CertificateRevocation{
+			SerialNumber: "1a",
+			OrgID:        orgId,
+			Reason:       ocsp.KeyCompromise,
+			RevokedAt:    time.Now(),
+			NotAfter:     time.Now().Add(time.Hour),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		revocation, err := storeInst.CertificateRevocation().Get(ctx, "1a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revocation.Reason).To(Equal(ocsp.CessationOfOperation))
+		Expect(revocation.CommonName).To(Equal("device-a"))
+		Expect(revocation.RevokedAt.Equal(revokedAt)).To(BeTrue())
+	})
+
+	It("Get returns not found for unknown serial numbers", func() {
+		_, err := storeInst.CertificateRevocation().Get(ctx, "2a")
+		Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+	})
+
+	It("ListUnexpired omits expired certificates", func() {
+		revocations, err := storeInst.CertificateRevocation().ListUnexpired(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revocations).To(HaveLen(1))
+		Expect(revocations[0].SerialNumber).To(Equal("1a"))
+	})
+
+	It("DeleteExpired deletes the revocations of expired certificates", func() {
+		numDeleted, err := storeInst.CertificateRevocation().DeleteExpired(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(numDeleted).To(Equal(int64(1)))
+
+		_, err = storeInst.CertificateRevocation().Get(ctx, "1b")
+		Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+		_, err = storeInst.CertificateRevocation().Get(ctx, "1a")
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
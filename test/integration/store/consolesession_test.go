@@ -0,0 +1,87 @@
+package store_test
+
+import (
+	"context"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	testutil "github.com/flightctl/flightctl/test/util"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("ConsoleSessionStore", func() {
+	var (
+		log       *logrus.Logger
+		ctx       context.Context
+		orgId     uuid.UUID
+		storeInst store.Store
+		cfg       *config.Config
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.StartSpecTracerForGinkgo(suiteCtx)
+		log = flightlog.InitLogs()
+		storeInst, cfg, dbName, _ = store.PrepareDBForUnitTests(ctx, log)
+
+		orgId = uuid.New()
+		err := testutil.CreateTestOrganization(ctx, storeInst, orgId)
+		Expect(err).ToNot(HaveOccurred())
+
+		for name, device := range map[string]string{"session-1": "device-a", "session-2": "device-b"} {
+			session := api.ConsoleSession{
+				Metadata: api.ObjectMeta{Name: lo.ToPtr(name)},
+				Spec:     api.ConsoleSessionSpec{DeviceName: device, User: "alice"},
+				Status:   &api.ConsoleSessionStatus{StartTime: time.Now()},
+			}
+			_, err = storeInst.ConsoleSession().Create(ctx, orgId, &session)
+			Expect(err).ToNot(HaveOccurred())
+		}
+	})
+
+	AfterEach(func() {
+		store.DeleteTestDB(ctx, log, cfg, storeInst, dbName)
+	})
+
+	It("Get returns the session owned by its device", func() {
+		session, err := storeInst.ConsoleSession().Get(ctx, orgId, "session-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(session.Spec.User).To(Equal("alice"))
+		Expect(session.Metadata.Owner).To(Equal(lo.ToPtr("Device/device-a")))
+		Expect(session.Status.EndTime).To(BeNil())
+	})
+
+	It("List filters sessions by device", func() {
+		fieldSelector, err := selector.NewFieldSelector("metadata.owner=Device/device-b")
+		Expect(err).ToNot(HaveOccurred())
+		sessions, err := storeInst.ConsoleSession().List(ctx, orgId, store.ListParams{FieldSelector: fieldSelector})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sessions.Items).To(HaveLen(1))
+		Expect(*sessions.Items[0].Metadata.Name).To(Equal("session-2"))
+	})
+
+	It("UpdateStatus records the end of the session", func() {
+		session, err := storeInst.ConsoleSession().Get(ctx, orgId, "session-1")
+		Expect(err).ToNot(HaveOccurred())
+
+		session.Status.EndTime = lo.ToPtr(time.Now())
+		session.Status.ExitCode = lo.ToPtr(int32(1))
+		session.Status.Recording = &api.ConsoleSessionRecording{Format: api.ConsoleSessionRecordingFormatAsciicastV2, Size: 42}
+		_, err = storeInst.ConsoleSession().UpdateStatus(ctx, orgId, session)
+		Expect(err).ToNot(HaveOccurred())
+
+		session, err = storeInst.ConsoleSession().Get(ctx, orgId, "session-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(session.Status.EndTime).ToNot(BeNil())
+		Expect(session.Status.ExitCode).To(Equal(lo.ToPtr(int32(1))))
+		Expect(session.Status.Recording.Size).To(Equal(int64(42)))
+	})
+})
@@ -319,6 +319,98 @@ var _ = Describe("DeviceStore create", func() {
 			Expect(len(devices.Items)).To(Equal(2))
 		})
 
+		It("Search matches name, labels and system info", func() {
+			testutil.CreateTestDevice(ctx, devStore, orgId, "edge-sn4711", nil, nil, &map[string]string{"alias": "Store-12"})
+			testutil.CreateTestDevice(ctx, devStore, orgId, "edge-store12-backup", nil, nil, &map[string]string{"site": "paris"})
+			device, err := devStore.Get(ctx, orgId, "mydevice-2")
+			Expect(err).ToNot(HaveOccurred())
+			device.Status.SystemInfo.AdditionalProperties = map[string]string{"productSerial": "SN4711-0002", "netIpDefault": "192.168.47.11"}
+			device.Status.SystemInfo.CustomInfo = &api.CustomDeviceInfo{"rack": "rack-7"}
+			_, err = devStore.UpdateStatus(ctx, orgId, device, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			names := func(results *api.DeviceSearchResultList) []string {
+				return lo.Map(results.Items, func(r api.DeviceSearchResult, _ int) string { return *r.Device.Metadata.Name })
+			}
+
+			results, err := devStore.Search(ctx, orgId, "sn4711", store.ListParams{Limit: 1000})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(results)).To(ConsistOf("edge-sn4711", "mydevice-2"))
+
+			results, err = devStore.Search(ctx, orgId, "192.168.47", store.ListParams{Limit: 1000})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(results)).To(Equal([]string{"mydevice-2"}))
+
+			results, err = devStore.Search(ctx, orgId, "RACK-7", store.ListParams{Limit: 1000})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(results)).To(Equal([]string{"mydevice-2"}))
+
+			results, err = devStore.Search(ctx, orgId, "site=par", store.ListParams{Limit: 1000})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(results)).To(Equal([]string{"edge-store12-backup"}))
+
+			// an exact match of the alias ranks above a partial match of the name
+			results, err = devStore.Search(ctx, orgId, "store-12", store.ListParams{Limit: 1000})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(results)).To(Equal([]string{"edge-sn4711"}))
+			Expect(results.Items[0].Score).To(BeNumerically(">=", 2000))
+
+			results, err = devStore.Search(ctx, orgId, "store", store.ListParams{
+				Limit:         1000,
+				LabelSelector: selector.NewLabelSelectorFromMapOrDie(map[string]string{"site": "paris"}),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(results)).To(Equal([]string{"edge-store12-backup"}))
+
+			// LIKE wildcards are matched literally
+			results, err = devStore.Search(ctx, orgId, "my%", store.ListParams{Limit: 1000})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results.Items).To(BeEmpty())
+
+			// the system info is matched as it is after the latest status update
+			device, err = devStore.Get(ctx, orgId, "mydevice-2")
+			Expect(err).ToNot(HaveOccurred())
+			device.Status.SystemInfo.AdditionalProperties = map[string]string{"productSerial": "SN0815-0002"}
+			_, err = devStore.UpdateStatus(ctx, orgId, device, nil)
+			Expect(err).ToNot(HaveOccurred())
+			results, err = devStore.Search(ctx, orgId, "sn4711", store.ListParams{Limit: 1000})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(results)).To(Equal([]string{"edge-sn4711"}))
+			results, err = devStore.Search(ctx, orgId, "sn0815", store.ListParams{Limit: 1000})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(names(results)).To(Equal([]string{"mydevice-2"}))
+		})
+
+		It("Search with paging", func() {
+			all, err := devStore.Search(ctx, orgId, "mydevice", store.ListParams{Limit: 1000})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(all.Items).To(HaveLen(numDevices))
+			Expect(all.Metadata.Continue).To(BeNil())
+
+			listParams := store.ListParams{Limit: 2}
+			page, err := devStore.Search(ctx, orgId, "mydevice", listParams)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Items).To(HaveLen(2))
+			Expect(*page.Metadata.RemainingItemCount).To(Equal(int64(1)))
+			found := append([]api.DeviceSearchResult{}, page.Items...)
+
+			listParams.Continue, err = store.ParseContinueString(page.Metadata.Continue)
+			Expect(err).ToNot(HaveOccurred())
+			page, err = devStore.Search(ctx, orgId, "mydevice", listParams)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page.Items).To(HaveLen(1))
+			Expect(page.Metadata.Continue).To(BeNil())
+			found = append(found, page.Items...)
+
+			for i := range all.Items {
+				Expect(found[i].Device.Metadata.Name).To(Equal(all.Items[i].Device.Metadata.Name))
+				Expect(found[i].Score).To(Equal(all.Items[i].Score))
+			}
+
+			_, err = devStore.Search(ctx, orgId, "mydevice", store.ListParams{Limit: 2, Continue: &store.Continue{Names: []string{"mydevice-1"}}})
+			Expect(err).To(MatchError(flterrors.ErrInvalidContinue))
+		})
+
 		It("CreateOrUpdateDevice create mode", func() {
 			imageName := "tv"
 			device := api.Device{
@@ -608,6 +700,30 @@ var _ = Describe("DeviceStore create", func() {
 			Expect(api.IsStatusConditionFalse(dev.Status.Conditions, api.ConditionTypeDeviceUpdating)).To(BeTrue())
 		})
 
+		It("UpdateIntegrity", func() {
+			dev, err := devStore.Get(ctx, orgId, "mydevice-1")
+			Expect(err).ToNot(HaveOccurred())
+			status := api.NewDeviceStatus()
+			status.Summary.Info = lo.ToPtr("reported by the agent")
+			dev.Status = &status
+			_, err = devStore.UpdateStatus(ctx, orgId, dev, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = devStore.UpdateIntegrity(ctx, orgId, "mydevice-1", api.DeviceIntegrityStatus{
+				Status: api.DeviceIntegrityStatusUnknown,
+				Tpm:    &api.DeviceIntegrityCheckStatus{Status: api.DeviceIntegrityCheckStatusUnknown},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			updated, err := devStore.Get(ctx, orgId, "mydevice-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Status.Integrity.Status).To(Equal(api.DeviceIntegrityStatusUnknown))
+			Expect(updated.Status.Integrity.Tpm.Status).To(Equal(api.DeviceIntegrityCheckStatusUnknown))
+			Expect(updated.Status.Summary.Info).To(Equal(lo.ToPtr("reported by the agent")))
+
+			err = devStore.UpdateIntegrity(ctx, orgId, "missing", api.DeviceIntegrityStatus{})
+			Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+		})
+
 		It("UpdateOwner", func() {
 			dev, err := devStore.Get(ctx, orgId, "mydevice-1")
 			Expect(err).ToNot(HaveOccurred())
@@ -0,0 +1,99 @@
+package store_test
+
+import (
+	"context"
+
+	api "github.com/flightctl/flightctl/api/v1alpha1"
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	flightlog "github.com/flightctl/flightctl/pkg/log"
+	testutil "github.com/flightctl/flightctl/test/util"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("EnrollmentPolicyStore", func() {
+	var (
+		log       *logrus.Logger
+		ctx       context.Context
+		orgId     uuid.UUID
+		storeInst store.Store
+		cfg       *config.Config
+		dbName    string
+	)
+
+	BeforeEach(func() {
+		ctx = testutil.StartSpecTracerForGinkgo(suiteCtx)
+		log = flightlog.InitLogs()
+		storeInst, cfg, dbName, _ = store.PrepareDBForUnitTests(ctx, log)
+
+		orgId = uuid.New()
+		err := testutil.CreateTestOrganization(ctx, storeInst, orgId)
+		Expect(err).ToNot(HaveOccurred())
+
+		policy := api.EnrollmentPolicy{
+			Metadata: api.ObjectMeta{Name: lo.ToPtr("factory-floor"), Labels: &map[string]string{"site": "paris"}},
+			Spec: api.EnrollmentPolicySpec{
+				Priority: lo.ToPtr(int32(10)),
+				Rules: []api.EnrollmentPolicyRule{{
+					Name:   "verified",
+					Action: api.EnrollmentPolicyActionApprove,
+					Match:  &api.EnrollmentPolicyMatch{TpmVerified: lo.ToPtr(true)},
+					Labels: &map[string]string{"site": "paris"},
+				}},
+			},
+		}
+		_, err = storeInst.EnrollmentPolicy().Create(ctx, orgId, &policy, nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		store.DeleteTestDB(ctx, log, cfg, storeInst, dbName)
+	})
+
+	It("Get returns the rules", func() {
+		policy, err := storeInst.EnrollmentPolicy().Get(ctx, orgId, "factory-floor")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*policy.Spec.Priority).To(Equal(int32(10)))
+		Expect(policy.Spec.Rules).To(HaveLen(1))
+		Expect(policy.Spec.Rules[0].Action).To(Equal(api.EnrollmentPolicyActionApprove))
+		Expect(*policy.Spec.Rules[0].Labels).To(HaveKeyWithValue("site", "paris"))
+	})
+
+	It("List filters by label selector", func() {
+		listParams := store.ListParams{Limit: 100, LabelSelector: selector.NewLabelSelectorFromMapOrDie(map[string]string{"site": "paris"})}
+		policies, err := storeInst.EnrollmentPolicy().List(ctx, orgId, listParams)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(policies.Items).To(HaveLen(1))
+
+		listParams.LabelSelector = selector.NewLabelSelectorFromMapOrDie(map[string]string{"site": "berlin"})
+		policies, err = storeInst.EnrollmentPolicy().List(ctx, orgId, listParams)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(policies.Items).To(BeEmpty())
+	})
+
+	It("CreateOrUpdate replaces the rules", func() {
+		policy, err := storeInst.EnrollmentPolicy().Get(ctx, orgId, "factory-floor")
+		Expect(err).ToNot(HaveOccurred())
+		policy.Spec.Rules = []api.EnrollmentPolicyRule{{Name: "reject-all", Action: api.EnrollmentPolicyActionReject}}
+		policy.Metadata.ResourceVersion = nil
+
+		updated, created, err := storeInst.EnrollmentPolicy().CreateOrUpdate(ctx, orgId, policy, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created).To(BeFalse())
+		Expect(updated.Spec.Rules).To(HaveLen(1))
+		Expect(updated.Spec.Rules[0].Name).To(Equal("reject-all"))
+	})
+
+	It("Delete removes the policy", func() {
+		err := storeInst.EnrollmentPolicy().Delete(ctx, orgId, "factory-floor", nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = storeInst.EnrollmentPolicy().Get(ctx, orgId, "factory-floor")
+		Expect(err).To(MatchError(flterrors.ErrResourceNotFound))
+	})
+})
@@ -429,6 +429,72 @@ var _ = Describe("FleetStore create", func() {
 			Expect(*updatedFleet.Metadata.Generation).To(Equal(int64(2)))
 		})
 
+		It("CreateOrUpdate dry run does not persist", func() {
+			fleet := api.Fleet{
+				Metadata: api.ObjectMeta{
+					Name: lo.ToPtr("newresourcename"),
+				},
+				Spec: api.FleetSpec{
+					Selector: &api.LabelSelector{
+						MatchLabels: &map[string]string{"key": "value"},
+					},
+				},
+			}
+			dryRunCtx := store.WithDryRun(ctx)
+			returnedFleet, created, err := storeInst.Fleet().CreateOrUpdate(dryRunCtx, orgId, &fleet, nil, true, callback)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(called).To(BeFalse())
+			Expect(*returnedFleet.Metadata.Generation).To(Equal(int64(1)))
+
+			_, err = storeInst.Fleet().Get(ctx, orgId, "newresourcename")
+			Expect(err).Should(MatchError(flterrors.ErrResourceNotFound))
+
+			existingFleet, err := storeInst.Fleet().Get(ctx, orgId, "myfleet-1")
+			Expect(err).ToNot(HaveOccurred())
+			existingFleet.Spec.Template.Spec.Os = &api.DeviceOsSpec{Image: "my new OS"}
+			existingFleet.Status = nil
+			returnedFleet, err = storeInst.Fleet().Update(dryRunCtx, orgId, existingFleet, nil, true, callback)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(called).To(BeFalse())
+			Expect(*returnedFleet.Metadata.Generation).To(Equal(int64(2)))
+
+			unchangedFleet, err := storeInst.Fleet().Get(ctx, orgId, "myfleet-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unchangedFleet.Spec.Template.Spec.Os).To(BeNil())
+			Expect(*unchangedFleet.Metadata.Generation).To(Equal(int64(1)))
+		})
+
+		It("CreateOrUpdate dry run reports conflicts", func() {
+			owner := util.SetResourceOwner(api.ResourceSyncKind, "test-resourcesync")
+			fleet := api.Fleet{
+				Metadata: api.ObjectMeta{
+					Name:  lo.ToPtr("owned-fleet"),
+					Owner: owner,
+				},
+				Spec: api.FleetSpec{
+					Selector: &api.LabelSelector{
+						MatchLabels: &map[string]string{"key": "original"},
+					},
+				},
+			}
+			_, err := storeInst.Fleet().Create(ctx, orgId, &fleet, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			fleet.Metadata.Owner = nil
+			fleet.Spec.Selector = &api.LabelSelector{MatchLabels: &map[string]string{"key": "updated"}}
+			_, _, err = storeInst.Fleet().CreateOrUpdate(store.WithDryRun(ctx), orgId, &fleet, nil, true, callback)
+			Expect(err).To(MatchError(flterrors.ErrUpdatingResourceWithOwnerNotAllowed))
+			Expect(called).To(BeFalse())
+
+			existingFleet, err := storeInst.Fleet().Get(ctx, orgId, "myfleet-1")
+			Expect(err).ToNot(HaveOccurred())
+			existingFleet.Metadata.ResourceVersion = lo.ToPtr("12345")
+			existingFleet.Spec.Template.Spec.Os = &api.DeviceOsSpec{Image: "my new OS"}
+			_, err = storeInst.Fleet().Update(store.WithDryRun(ctx), orgId, existingFleet, nil, true, callback)
+			Expect(err).To(MatchError(flterrors.ErrResourceVersionConflict))
+		})
+
 		It("UpdateStatus", func() {
 			condition := api.Condition{
 				Type:               api.ConditionTypeFleetValid,
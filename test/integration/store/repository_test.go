@@ -175,7 +175,7 @@ var _ = Describe("RepositoryStore create", func() {
 				Spec:   spec,
 				Status: nil,
 			}
-			repo, created, err := storeInst.Repository().CreateOrUpdate(ctx, orgId, &repository, eventCallback)
+			repo, created, err := storeInst.Repository().CreateOrUpdate(ctx, orgId, &repository, true, eventCallback)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(eventCallbackCalled).To(BeTrue())
 			Expect(created).To(Equal(true))
@@ -202,7 +202,7 @@ var _ = Describe("RepositoryStore create", func() {
 				Spec:   spec,
 				Status: nil,
 			}
-			repo, created, err := storeInst.Repository().CreateOrUpdate(ctx, orgId, &repository, eventCallback)
+			repo, created, err := storeInst.Repository().CreateOrUpdate(ctx, orgId, &repository, true, eventCallback)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(eventCallbackCalled).To(BeTrue())
 			Expect(created).To(Equal(false))
@@ -229,7 +229,7 @@ var _ = Describe("RepositoryStore create", func() {
 				Spec:   spec,
 				Status: nil,
 			}
-			repo, created, err := storeInst.Repository().CreateOrUpdate(ctx, orgId, &repository, eventCallback)
+			repo, created, err := storeInst.Repository().CreateOrUpdate(ctx, orgId, &repository, true, eventCallback)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(eventCallbackCalled).To(BeTrue())
 			Expect(created).To(Equal(true))
@@ -242,6 +242,40 @@ var _ = Describe("RepositoryStore create", func() {
 			Expect(repo.Status.Conditions).To(BeEmpty())
 		})
 
+		It("CreateOrUpdateRepository dry run does not persist", func() {
+			spec := api.RepositorySpec{}
+			err := spec.FromGenericRepoSpec(api.GenericRepoSpec{
+				Url:  "myotherrepo",
+				Type: "git",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			repository := api.Repository{
+				Metadata: api.ObjectMeta{
+					Name: lo.ToPtr("newresourcename"),
+				},
+				Spec: spec,
+			}
+			dryRunCtx := store.WithDryRun(ctx)
+			repo, created, err := storeInst.Repository().CreateOrUpdate(dryRunCtx, orgId, &repository, true, eventCallback)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(eventCallbackCalled).To(BeFalse())
+			Expect(created).To(BeTrue())
+			Expect(*repo.Metadata.Name).To(Equal("newresourcename"))
+			_, err = storeInst.Repository().Get(ctx, orgId, "newresourcename")
+			Expect(err).Should(MatchError(flterrors.ErrResourceNotFound))
+
+			repository.Metadata.Name = lo.ToPtr("myrepository-1")
+			_, created, err = storeInst.Repository().CreateOrUpdate(dryRunCtx, orgId, &repository, true, eventCallback)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(eventCallbackCalled).To(BeFalse())
+			Expect(created).To(BeFalse())
+			repo, err = storeInst.Repository().Get(ctx, orgId, "myrepository-1")
+			Expect(err).ToNot(HaveOccurred())
+			repoSpec, err := repo.Spec.AsGenericRepoSpec()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(repoSpec.Url).ToNot(Equal("myotherrepo"))
+		})
+
 		It("Delete repo with fleet association", func() {
 			testutil.CreateTestFleets(ctx, 1, storeInst.Fleet(), orgId, "myfleet", false, nil)
 
@@ -117,6 +117,36 @@ var _ = Describe("ResourceSyncStore create", func() {
 			Expect(len(f.Items)).To(Equal(0))
 		})
 
+		It("Delete resourcesync releases synced devices", func() {
+			rsName := "myresourcesync-1"
+			testutil.CreateTestDevice(ctx, storeInst.Device(), orgId, "synced", nil, nil, nil)
+			testutil.CreateTestDevice(ctx, storeInst.Device(), orgId, "other", nil, nil, nil)
+			err := storeInst.Device().UpdateAnnotations(ctx, orgId, "synced", map[string]string{
+				api.DeviceAnnotationResourceSync:            rsName,
+				api.DeviceAnnotationResourceSyncManagedKeys: `{"annotations":["site"]}`,
+				"site": "paris",
+			}, nil)
+			Expect(err).ToNot(HaveOccurred())
+			err = storeInst.Device().UpdateAnnotations(ctx, orgId, "other", map[string]string{
+				api.DeviceAnnotationResourceSync: "myresourcesync-2",
+			}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = storeInst.ResourceSync().Delete(ctx, orgId, rsName, func(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error {
+				return storeInst.Device().UnsetResourceSync(ctx, tx, orgId, rsName)
+			}, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			synced, err := storeInst.Device().Get(ctx, orgId, "synced")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*synced.Metadata.Annotations).ToNot(HaveKey(api.DeviceAnnotationResourceSync))
+			Expect(*synced.Metadata.Annotations).ToNot(HaveKey(api.DeviceAnnotationResourceSyncManagedKeys))
+			Expect(*synced.Metadata.Annotations).To(HaveKeyWithValue("site", "paris"))
+			other, err := storeInst.Device().Get(ctx, orgId, "other")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(*other.Metadata.Annotations).To(HaveKeyWithValue(api.DeviceAnnotationResourceSync, "myresourcesync-2"))
+		})
+
 		It("Delete resourcesync fail when not found", func() {
 			callbackCalled := false
 			err := storeInst.ResourceSync().Delete(ctx, orgId, "nonexistent", func(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error {